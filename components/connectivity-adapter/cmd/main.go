@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -8,15 +9,19 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
 	"github.com/kyma-incubator/compass/components/director/pkg/handler"
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
 
 	"github.com/gorilla/mux"
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry"
 	connector "github.com/kyma-incubator/compass/components/connectivity-adapter/internal/connectorservice"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/events"
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/health"
 	"github.com/pkg/errors"
 	"github.com/vrischmann/envconfig"
 )
 
+const serviceName = "connectivity-adapter"
+
 type config struct {
 	Address string `envconfig:"default=127.0.0.1:8080"`
 
@@ -24,6 +29,9 @@ type config struct {
 
 	AppRegistry appregistry.Config
 	Connector   connector.Config
+	Events      events.Config
+
+	Tracing tracing.Config
 }
 
 func main() {
@@ -32,6 +40,14 @@ func main() {
 	err := envconfig.InitWithPrefix(&cfg, "APP")
 	exitOnError(err, "while loading app config")
 
+	shutdownTracing, err := tracing.Configure(context.Background(), serviceName, cfg.Tracing)
+	exitOnError(err, "while configuring tracing")
+
+	defer func() {
+		err := shutdownTracing(context.Background())
+		exitOnError(err, "while shutting down tracing")
+	}()
+
 	h, err := initAPIHandler(cfg)
 	exitOnError(err, "Failed to init External Connector handler")
 
@@ -52,13 +68,17 @@ func initAPIHandler(cfg config) (http.Handler, error) {
 	router := mux.NewRouter()
 	router.HandleFunc("/v1/health", health.HandleFunc).Methods(http.MethodGet)
 
-	router.Use(correlation.AttachCorrelationIDToContext())
+	router.Use(tracing.Handler(serviceName), correlation.AttachCorrelationIDToContext())
 
 	applicationRegistryRouter := router.PathPrefix("/{app-name}/v1").Subrouter()
 	connectorRouter := router.PathPrefix("/v1/applications").Subrouter()
 
 	appRegistryRouter := applicationRegistryRouter.PathPrefix("/metadata").Subrouter()
 	appregistry.RegisterHandler(appRegistryRouter, cfg.AppRegistry)
+
+	eventsRouter := applicationRegistryRouter.PathPrefix("/events").Subrouter()
+	events.RegisterHandler(eventsRouter, cfg.Events, cfg.AppRegistry.DirectorEndpoint, cfg.AppRegistry.ClientTimeout)
+
 	err := connector.RegisterHandler(connectorRouter, cfg.Connector, cfg.AppRegistry.DirectorEndpoint, cfg.AppRegistry.ClientTimeout)
 	if err != nil {
 		return nil, err
@@ -9,21 +9,13 @@ import (
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/gqlcli"
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/res"
-	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/retry"
-	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql/graphqlizer"
-	gcli "github.com/machinebox/graphql"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 )
 
 const appNamePathVariable = "app-name"
 
-//go:generate mockery -name=GraphQLRequestBuilder -output=automock -outpkg=automock -case=underscore
-type GraphQLRequestBuilder interface {
-	GetApplicationsByName(appName string) *gcli.Request
-}
-
 type applicationMiddleware struct {
 	cliProvider gqlcli.Provider
 	logger      *log.Logger
@@ -43,10 +35,8 @@ func (mw *applicationMiddleware) Middleware(next http.Handler) http.Handler {
 
 		client := mw.cliProvider.GQLClient(r)
 		directorCli := director.NewClient(client, &graphqlizer.Graphqlizer{}, &graphqlizer.GqlFieldsProvider{})
-		query := directorCli.GetApplicationsByNameRequest(appName)
 
-		var apps GqlSuccessfulAppPage
-		err := retry.GQLRun(client.Run, r.Context(), query, &apps)
+		apps, err := directorCli.ListApplicationsByName(r.Context(), appName)
 		if err != nil {
 			wrappedErr := errors.Wrap(err, "while getting service")
 			mw.logger.Error(wrappedErr)
@@ -54,21 +44,21 @@ func (mw *applicationMiddleware) Middleware(next http.Handler) http.Handler {
 			return
 		}
 
-		if len(apps.Result.Data) == 0 {
+		if len(apps) == 0 {
 			message := fmt.Sprintf("application with name %s not found", appName)
 			mw.logger.Warn(message)
 			res.WriteErrorMessage(w, message, apperrors.CodeNotFound)
 			return
 		}
 
-		if len(apps.Result.Data) != 1 {
+		if len(apps) != 1 {
 			message := fmt.Sprintf("found more than 1 application with name %s", appName)
 			mw.logger.Warn(message)
 			res.WriteErrorMessage(w, message, apperrors.CodeInternal)
 			return
 		}
 
-		app := apps.Result.Data[0]
+		app := apps[0]
 
 		mw.logger.Infof("app '%s' details fetched successfully", appName)
 
@@ -78,7 +68,3 @@ func (mw *applicationMiddleware) Middleware(next http.Handler) http.Handler {
 		next.ServeHTTP(w, requestWithCtx)
 	})
 }
-
-type GqlSuccessfulAppPage struct {
-	Result graphql.ApplicationPageExt `json:"result"`
-}
@@ -10,10 +10,9 @@ import (
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/appdetails"
-	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/director"
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/gqlcli/automock"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
-	"github.com/kyma-incubator/compass/components/director/pkg/graphql/graphqlizer"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql/directorclient"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
@@ -37,12 +36,8 @@ func TestMiddleware(t *testing.T) {
 
 		logger, _ := test.NewNullLogger()
 
-		gqlQueryBuilder := director.NewClient(nil, &graphqlizer.Graphqlizer{}, &graphqlizer.GqlFieldsProvider{})
-		expectedQuery := gqlQueryBuilder.GetApplicationsByNameRequest(appName)
-
-		var apps appdetails.GqlSuccessfulAppPage
 		gqlClient := &automock.GraphQLClient{}
-		gqlClient.On("Run", mock.Anything, expectedQuery, &apps).Run(injectDirectorResponse(t, appPage)).Return(nil)
+		gqlClient.On("Run", mock.Anything, mock.Anything, mock.AnythingOfType("*directorclient.ApplicationPageResult")).Run(injectDirectorResponse(t, appPage)).Return(nil)
 
 		cliProvider := &automock.Provider{}
 		cliProvider.On("GQLClient", mock.AnythingOfType("*http.Request")).Return(gqlClient)
@@ -66,12 +61,8 @@ func TestMiddleware(t *testing.T) {
 		logger, _ := test.NewNullLogger()
 		emptyResponse := graphql.ApplicationPageExt{}
 
-		gqlQueryBuilder := director.NewClient(nil, &graphqlizer.Graphqlizer{}, &graphqlizer.GqlFieldsProvider{})
-		expectedQuery := gqlQueryBuilder.GetApplicationsByNameRequest(appName)
-
-		var apps appdetails.GqlSuccessfulAppPage
 		gqlClient := &automock.GraphQLClient{}
-		gqlClient.On("Run", mock.Anything, expectedQuery, &apps).Run(injectDirectorResponse(t, emptyResponse)).Return(nil)
+		gqlClient.On("Run", mock.Anything, mock.Anything, mock.AnythingOfType("*directorclient.ApplicationPageResult")).Run(injectDirectorResponse(t, emptyResponse)).Return(nil)
 
 		cliProvider := &automock.Provider{}
 		cliProvider.On("GQLClient", mock.AnythingOfType("*http.Request")).Return(gqlClient)
@@ -99,12 +90,8 @@ func TestMiddleware(t *testing.T) {
 		logger, _ := test.NewNullLogger()
 		appPage := graphql.ApplicationPageExt{ApplicationPage: graphql.ApplicationPage{},
 			Data: []*graphql.ApplicationExt{&app, &app}}
-		gqlQueryBuilder := director.NewClient(nil, &graphqlizer.Graphqlizer{}, &graphqlizer.GqlFieldsProvider{})
-		expectedQuery := gqlQueryBuilder.GetApplicationsByNameRequest(appName)
-
-		var apps appdetails.GqlSuccessfulAppPage
 		gqlClient := &automock.GraphQLClient{}
-		gqlClient.On("Run", mock.Anything, expectedQuery, &apps).Run(injectDirectorResponse(t, appPage)).Return(nil)
+		gqlClient.On("Run", mock.Anything, mock.Anything, mock.AnythingOfType("*directorclient.ApplicationPageResult")).Run(injectDirectorResponse(t, appPage)).Return(nil)
 
 		cliProvider := &automock.Provider{}
 		cliProvider.On("GQLClient", mock.AnythingOfType("*http.Request")).Return(gqlClient)
@@ -131,12 +118,8 @@ func TestMiddleware(t *testing.T) {
 	t.Run("director returns error", func(t *testing.T) {
 		logger, hook := test.NewNullLogger()
 
-		gqlQueryBuilder := director.NewClient(nil, &graphqlizer.Graphqlizer{}, &graphqlizer.GqlFieldsProvider{})
-		expectedQuery := gqlQueryBuilder.GetApplicationsByNameRequest(appName)
-
-		var apps appdetails.GqlSuccessfulAppPage
 		gqlClient := &automock.GraphQLClient{}
-		gqlClient.On("Run", mock.Anything, expectedQuery, &apps).Return(testErr)
+		gqlClient.On("Run", mock.Anything, mock.Anything, mock.AnythingOfType("*directorclient.ApplicationPageResult")).Return(testErr)
 
 		cliProvider := &automock.Provider{}
 		cliProvider.On("GQLClient", mock.AnythingOfType("*http.Request")).Return(gqlClient)
@@ -153,7 +136,7 @@ func TestMiddleware(t *testing.T) {
 		//THEN
 		assert.Equal(t, http.StatusInternalServerError, rw.Code)
 		mock.AssertExpectationsForObjects(t, gqlClient, cliProvider)
-		assertLastLogEntryError(t, "while getting service: All attempts fail:\n#1: test error\n#2: test error", hook)
+		assertLastLogEntryError(t, "while getting service: while listing applications: All attempts fail:\n#1: test error\n#2: test error", hook)
 	})
 
 }
@@ -170,7 +153,7 @@ func assertAppInContext(t *testing.T, expectedApp graphql.ApplicationExt) func(w
 
 func injectDirectorResponse(t *testing.T, result graphql.ApplicationPageExt) func(args mock.Arguments) {
 	return func(args mock.Arguments) {
-		arg, ok := args.Get(2).(*appdetails.GqlSuccessfulAppPage)
+		arg, ok := args.Get(2).(*directorclient.ApplicationPageResult)
 		if !ok {
 			t.FailNow()
 		}
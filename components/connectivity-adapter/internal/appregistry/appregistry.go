@@ -16,8 +16,9 @@ import (
 )
 
 type Config struct {
-	DirectorEndpoint string        `envconfig:"default=http://127.0.0.1:3000/graphql"`
-	ClientTimeout    time.Duration `envconfig:"default=115s"`
+	DirectorEndpoint    string        `envconfig:"default=http://127.0.0.1:3000/graphql"`
+	ClientTimeout       time.Duration `envconfig:"default=115s"`
+	CertificateValidity time.Duration `envconfig:"default=2160h"`
 }
 
 func RegisterHandler(router *mux.Router, cfg Config) {
@@ -27,7 +28,7 @@ func RegisterHandler(router *mux.Router, cfg Config) {
 	gqlCliProvider := gqlcli.NewProvider(cfg.DirectorEndpoint, cfg.ClientTimeout)
 	reqContextProvider := service.NewRequestContextProvider()
 
-	converter := service.NewConverter()
+	converter := service.NewConverter(service.NewCertificateGenerator(cfg.CertificateValidity))
 	validator := validation.NewServiceDetailsValidator()
 
 	labeler := service.NewAppLabeler()
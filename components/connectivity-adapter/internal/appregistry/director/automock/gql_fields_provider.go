@@ -2,8 +2,10 @@
 
 package automock
 
-import graphqlizer "github.com/kyma-incubator/compass/components/director/pkg/graphql/graphqlizer"
-import mock "github.com/stretchr/testify/mock"
+import (
+	graphqlizer "github.com/kyma-incubator/compass/components/director/pkg/graphql/graphqlizer"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // GqlFieldsProvider is an autogenerated mock type for the GqlFieldsProvider type
 type GqlFieldsProvider struct {
@@ -30,26 +32,6 @@ func (_m *GqlFieldsProvider) ForAPIDefinition(ctx ...graphqlizer.FieldCtx) strin
 	return r0
 }
 
-// ForApplication provides a mock function with given fields: ctx
-func (_m *GqlFieldsProvider) ForApplication(ctx ...graphqlizer.FieldCtx) string {
-	_va := make([]interface{}, len(ctx))
-	for _i := range ctx {
-		_va[_i] = ctx[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 string
-	if rf, ok := ret.Get(0).(func(...graphqlizer.FieldCtx) string); ok {
-		r0 = rf(ctx...)
-	} else {
-		r0 = ret.Get(0).(string)
-	}
-
-	return r0
-}
-
 // ForDocument provides a mock function with given fields:
 func (_m *GqlFieldsProvider) ForDocument() string {
 	ret := _m.Called()
@@ -91,37 +73,3 @@ func (_m *GqlFieldsProvider) ForLabel() string {
 
 	return r0
 }
-
-// ForPackage provides a mock function with given fields: ctx
-func (_m *GqlFieldsProvider) ForPackage(ctx ...graphqlizer.FieldCtx) string {
-	_va := make([]interface{}, len(ctx))
-	for _i := range ctx {
-		_va[_i] = ctx[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 string
-	if rf, ok := ret.Get(0).(func(...graphqlizer.FieldCtx) string); ok {
-		r0 = rf(ctx...)
-	} else {
-		r0 = ret.Get(0).(string)
-	}
-
-	return r0
-}
-
-// Page provides a mock function with given fields: item
-func (_m *GqlFieldsProvider) Page(item string) string {
-	ret := _m.Called(item)
-
-	var r0 string
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(item)
-	} else {
-		r0 = ret.Get(0).(string)
-	}
-
-	return r0
-}
@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/gqlcli"
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/retry"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql/directorclient"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql/graphqlizer"
 	gcli "github.com/machinebox/graphql"
 	"github.com/pkg/errors"
@@ -23,34 +24,30 @@ type GraphQLizer interface {
 
 //go:generate mockery -name=GqlFieldsProvider -output=automock -outpkg=automock -case=underscore
 type GqlFieldsProvider interface {
-	ForApplication(ctx ...graphqlizer.FieldCtx) string
 	ForAPIDefinition(ctx ...graphqlizer.FieldCtx) string
 	ForDocument() string
 	ForEventDefinition() string
 	ForLabel() string
-	ForPackage(ctx ...graphqlizer.FieldCtx) string
-	Page(item string) string
 }
 
-const nameKey = "name"
-
 type directorClient struct {
 	cli               gqlcli.GraphQLClient
+	reader            *directorclient.Client
 	graphqlizer       GraphQLizer
 	gqlFieldsProvider GqlFieldsProvider
 }
 
 func NewClient(cli gqlcli.GraphQLClient, graphqlizer GraphQLizer, gqlFieldsProvider GqlFieldsProvider) *directorClient {
-	return &directorClient{cli: cli, graphqlizer: graphqlizer, gqlFieldsProvider: gqlFieldsProvider}
+	return &directorClient{
+		cli:               cli,
+		reader:            directorclient.NewClient(&retryingClient{cli: cli}, directorclient.DefaultPageSize),
+		graphqlizer:       graphqlizer,
+		gqlFieldsProvider: gqlFieldsProvider,
+	}
 }
 
-// TODO: Replace with method which uses GraphQL client
-func (c *directorClient) GetApplicationsByNameRequest(appName string) *gcli.Request {
-	return gcli.NewRequest(fmt.Sprintf(`query {
-			result: applications(filter: {key:"%s", query: "\"%s\""}) {
-					%s
-			}
-	}`, nameKey, appName, c.gqlFieldsProvider.Page(c.gqlFieldsProvider.ForApplication())))
+func (c *directorClient) ListApplicationsByName(ctx context.Context, appName string) ([]*graphql.ApplicationExt, error) {
+	return c.reader.ListApplicationsByName(ctx, appName)
 }
 
 type CreatePackageResult struct {
@@ -100,53 +97,12 @@ func (c *directorClient) UpdatePackage(ctx context.Context, packageID string, in
 	return nil
 }
 
-type GetPackageResult struct {
-	Result graphql.ApplicationExt `json:"result"`
-}
-
 func (c *directorClient) GetPackage(ctx context.Context, appID string, packageID string) (graphql.PackageExt, error) {
-	gqlRequest := gcli.NewRequest(
-		fmt.Sprintf(`query {
-			result: application(id: "%s") {
-				%s
-				}
-			}`, appID, c.gqlFieldsProvider.ForApplication(graphqlizer.FieldCtx{
-			"Application.package": fmt.Sprintf(`package(id: "%s") {%s}`, packageID, c.gqlFieldsProvider.ForPackage()),
-		})))
-
-	var resp GetPackageResult
-
-	err := retry.GQLRun(c.cli.Run, ctx, gqlRequest, &resp)
-	if err != nil {
-		return graphql.PackageExt{}, errors.Wrap(err, "while doing GraphQL request")
-	}
-
-	return resp.Result.Package, nil
-}
-
-type ListPackagesResult struct {
-	Result graphql.ApplicationExt `json:"result"`
+	return c.reader.GetPackage(ctx, appID, packageID)
 }
 
 func (c *directorClient) ListPackages(ctx context.Context, appID string) ([]*graphql.PackageExt, error) {
-	gqlRequest := gcli.NewRequest(
-		fmt.Sprintf(`query {
-			result: application(id: "%s") {
-				%s
-				}
-			}`, appID, c.gqlFieldsProvider.ForApplication(),
-		))
-
-	var resp ListPackagesResult
-
-	err := retry.GQLRun(c.cli.Run, ctx, gqlRequest, &resp)
-	if err != nil {
-		return nil, errors.Wrap(err, "while doing GraphQL request")
-	}
-
-	// No pagination for now. Return first 100 packages;
-	// TODO: Implement pagination
-	return resp.Result.Packages.Data, nil
+	return c.reader.ListPackages(ctx, appID)
 }
 
 func (c *directorClient) DeletePackage(ctx context.Context, packageID string) error {
@@ -306,3 +262,11 @@ func (c *directorClient) SetApplicationLabel(ctx context.Context, appID string,
 
 	return nil
 }
+
+type retryingClient struct {
+	cli gqlcli.GraphQLClient
+}
+
+func (c *retryingClient) Run(ctx context.Context, req *gcli.Request, resp interface{}) error {
+	return retry.GQLRun(c.cli.Run, ctx, req, resp)
+}
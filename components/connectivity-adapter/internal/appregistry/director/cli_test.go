@@ -2,15 +2,16 @@ package director_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
-	"github.com/kyma-incubator/compass/components/director/pkg/graphql/graphqlizer"
 	"github.com/pkg/errors"
 
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/director"
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/director/automock"
 	gcliautomock "github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/gqlcli/automock"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql/directorclient"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	gcli "github.com/machinebox/graphql"
 	"github.com/stretchr/testify/assert"
@@ -251,11 +252,68 @@ func TestDirectorClient_UpdatePackage(t *testing.T) {
 	}
 }
 
+func TestDirectorClient_ListApplicationsByName(t *testing.T) {
+	appName := "foo"
+	successResult := []*graphql.ApplicationExt{{Application: graphql.Application{ID: "1", Name: appName}}}
+
+	tests := []struct {
+		Name           string
+		GQLClientFn    func() *gcliautomock.GraphQLClient
+		ExpectedResult []*graphql.ApplicationExt
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success - retry",
+			GQLClientFn: func() *gcliautomock.GraphQLClient {
+				am := &gcliautomock.GraphQLClient{}
+				am.On("Run", mock.Anything, queryWith(`applications(filter: {key: "name", query: "\"foo\""}`), mock.Anything).Return(testErr).Once()
+				am.On("Run", mock.Anything, queryWith(`applications(filter: {key: "name", query: "\"foo\""}`), mock.Anything).Run(func(args mock.Arguments) {
+					res, ok := args.Get(2).(*directorclient.ApplicationPageResult)
+					if !ok {
+						return
+					}
+
+					res.Result = graphql.ApplicationPageExt{Data: successResult}
+				}).Return(nil).Once()
+				return am
+			},
+			ExpectedResult: successResult,
+		},
+		{
+			Name: "Error - GraphQL client",
+			GQLClientFn: func() *gcliautomock.GraphQLClient {
+				am := &gcliautomock.GraphQLClient{}
+				am.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(testErr).Twice()
+				return am
+			},
+			ExpectedErr: testErr,
+		},
+	}
+	for _, tC := range tests {
+		t.Run(tC.Name, func(t *testing.T) {
+			gqlCli := tC.GQLClientFn()
+
+			dirCli := director.NewClient(gqlCli, nil, nil)
+
+			result, err := dirCli.ListApplicationsByName(context.TODO(), appName)
+
+			if tC.ExpectedResult != nil {
+				require.NoError(t, err)
+				assert.Equal(t, tC.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tC.ExpectedErr.Error())
+			}
+
+			mock.AssertExpectationsForObjects(t, gqlCli)
+		})
+	}
+}
+
 func TestDirectorClient_GetPackage(t *testing.T) {
 	appID := "foo"
-	packageID := "foo"
+	packageID := "bar"
 	successResult := graphql.PackageExt{Package: graphql.Package{ID: "1"}}
-	gqlRequest := gcli.NewRequest("query {\n\t\t\tresult: application(id: \"foo\") {\n\t\t\t\tfields\n\t\t\t\t}\n\t\t\t}")
 
 	tests := []struct {
 		Name           string
@@ -269,11 +327,10 @@ func TestDirectorClient_GetPackage(t *testing.T) {
 				am := &gcliautomock.GraphQLClient{}
 				am.On("Run",
 					mock.Anything,
-					gqlRequest,
+					queryWith(`package(id: "bar")`),
 					mock.Anything,
 				).Run(func(args mock.Arguments) {
-					arg := args.Get(2)
-					res, ok := arg.(*director.GetPackageResult)
+					res, ok := args.Get(2).(*directorclient.ApplicationResult)
 					if !ok {
 						return
 					}
@@ -290,16 +347,15 @@ func TestDirectorClient_GetPackage(t *testing.T) {
 				am := &gcliautomock.GraphQLClient{}
 				am.On("Run",
 					mock.Anything,
-					gqlRequest,
+					queryWith(`package(id: "bar")`),
 					mock.Anything,
 				).Return(testErr).Once()
 				am.On("Run",
 					mock.Anything,
-					gqlRequest,
+					queryWith(`package(id: "bar")`),
 					mock.Anything,
 				).Run(func(args mock.Arguments) {
-					arg := args.Get(2)
-					res, ok := arg.(*director.GetPackageResult)
+					res, ok := args.Get(2).(*directorclient.ApplicationResult)
 					if !ok {
 						return
 					}
@@ -316,7 +372,7 @@ func TestDirectorClient_GetPackage(t *testing.T) {
 				am := &gcliautomock.GraphQLClient{}
 				am.On("Run",
 					mock.Anything,
-					gqlRequest,
+					mock.Anything,
 					mock.Anything,
 				).Return(testErr).Twice()
 				return am
@@ -328,30 +384,27 @@ func TestDirectorClient_GetPackage(t *testing.T) {
 		t.Run(tC.Name, func(t *testing.T) {
 			gqlCli := tC.GQLClientFn()
 
-			gqlFieldsProvider := &automock.GqlFieldsProvider{}
-			gqlFieldsProvider.On("ForApplication", graphqlizer.FieldCtx{"Application.package": "package(id: \"foo\") {pkg-fields}"}).Return("fields").Maybe()
-			gqlFieldsProvider.On("ForPackage").Return("pkg-fields").Maybe()
-
-			dirCli := director.NewClient(gqlCli, nil, gqlFieldsProvider)
+			dirCli := director.NewClient(gqlCli, nil, nil)
 
 			result, err := dirCli.GetPackage(context.TODO(), appID, packageID)
 
 			if tC.ExpectedResult != nil {
+				require.NoError(t, err)
 				assert.Equal(t, *tC.ExpectedResult, result)
 			} else {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), tC.ExpectedErr.Error())
 			}
 
-			mock.AssertExpectationsForObjects(t, gqlCli, gqlFieldsProvider)
+			mock.AssertExpectationsForObjects(t, gqlCli)
 		})
 	}
 }
 
 func TestDirectorClient_ListPackages(t *testing.T) {
 	appID := "foo"
-	successResult := []*graphql.PackageExt{{Package: graphql.Package{ID: "1"}}, {Package: graphql.Package{ID: "2"}}}
-	gqlRequest := gcli.NewRequest("query {\n\t\t\tresult: application(id: \"foo\") {\n\t\t\t\tfields\n\t\t\t\t}\n\t\t\t}")
+	firstPage := []*graphql.PackageExt{{Package: graphql.Package{ID: "1"}}, {Package: graphql.Package{ID: "2"}}}
+	secondPage := []*graphql.PackageExt{{Package: graphql.Package{ID: "3"}}}
 
 	tests := []struct {
 		Name           string
@@ -365,20 +418,30 @@ func TestDirectorClient_ListPackages(t *testing.T) {
 				am := &gcliautomock.GraphQLClient{}
 				am.On("Run",
 					mock.Anything,
-					gqlRequest,
+					queryWith("packages(first: 100)"),
 					mock.Anything,
-				).Run(func(args mock.Arguments) {
-					arg := args.Get(2)
-					res, ok := arg.(*director.ListPackagesResult)
-					if !ok {
-						return
-					}
-
-					res.Result = graphql.ApplicationExt{Packages: graphql.PackagePageExt{Data: successResult}}
-				}).Return(nil).Once()
+				).Run(injectPackagePage(firstPage, "")).Return(nil).Once()
 				return am
 			},
-			ExpectedResult: successResult,
+			ExpectedResult: firstPage,
+		},
+		{
+			Name: "Success - more than one page",
+			GQLClientFn: func() *gcliautomock.GraphQLClient {
+				am := &gcliautomock.GraphQLClient{}
+				am.On("Run",
+					mock.Anything,
+					queryWith("packages(first: 100)"),
+					mock.Anything,
+				).Run(injectPackagePage(firstPage, "cursor")).Return(nil).Once()
+				am.On("Run",
+					mock.Anything,
+					queryWith(`packages(first: 100, after: "cursor")`),
+					mock.Anything,
+				).Run(injectPackagePage(secondPage, "")).Return(nil).Once()
+				return am
+			},
+			ExpectedResult: append(append([]*graphql.PackageExt{}, firstPage...), secondPage...),
 		},
 		{
 			Name: "Success - retry",
@@ -386,25 +449,17 @@ func TestDirectorClient_ListPackages(t *testing.T) {
 				am := &gcliautomock.GraphQLClient{}
 				am.On("Run",
 					mock.Anything,
-					gqlRequest,
+					queryWith("packages(first: 100)"),
 					mock.Anything,
 				).Return(testErr).Once()
 				am.On("Run",
 					mock.Anything,
-					gqlRequest,
+					queryWith("packages(first: 100)"),
 					mock.Anything,
-				).Run(func(args mock.Arguments) {
-					arg := args.Get(2)
-					res, ok := arg.(*director.ListPackagesResult)
-					if !ok {
-						return
-					}
-
-					res.Result = graphql.ApplicationExt{Packages: graphql.PackagePageExt{Data: successResult}}
-				}).Return(nil).Once()
+				).Run(injectPackagePage(firstPage, "")).Return(nil).Once()
 				return am
 			},
-			ExpectedResult: successResult,
+			ExpectedResult: firstPage,
 		},
 		{
 			Name: "Error - GraphQL client",
@@ -412,7 +467,7 @@ func TestDirectorClient_ListPackages(t *testing.T) {
 				am := &gcliautomock.GraphQLClient{}
 				am.On("Run",
 					mock.Anything,
-					gqlRequest,
+					mock.Anything,
 					mock.Anything,
 				).Return(testErr).Twice()
 				return am
@@ -424,21 +479,19 @@ func TestDirectorClient_ListPackages(t *testing.T) {
 		t.Run(tC.Name, func(t *testing.T) {
 			gqlCli := tC.GQLClientFn()
 
-			gqlFieldsProvider := &automock.GqlFieldsProvider{}
-			gqlFieldsProvider.On("ForApplication").Return("fields").Maybe()
-
-			dirCli := director.NewClient(gqlCli, nil, gqlFieldsProvider)
+			dirCli := director.NewClient(gqlCli, nil, nil)
 
 			result, err := dirCli.ListPackages(context.TODO(), appID)
 
 			if tC.ExpectedResult != nil {
+				require.NoError(t, err)
 				assert.Equal(t, tC.ExpectedResult, result)
 			} else {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), tC.ExpectedErr.Error())
 			}
 
-			mock.AssertExpectationsForObjects(t, gqlCli, gqlFieldsProvider)
+			mock.AssertExpectationsForObjects(t, gqlCli)
 		})
 	}
 }
@@ -1198,3 +1251,26 @@ func TestDirectorClient_SetApplicationLabel(t *testing.T) {
 		})
 	}
 }
+
+func queryWith(fragment string) interface{} {
+	return mock.MatchedBy(func(req *gcli.Request) bool {
+		return strings.Contains(req.Query(), fragment)
+	})
+}
+
+func injectPackagePage(packages []*graphql.PackageExt, endCursor string) func(args mock.Arguments) {
+	return func(args mock.Arguments) {
+		res, ok := args.Get(2).(*directorclient.ApplicationResult)
+		if !ok {
+			return
+		}
+
+		res.Result = graphql.ApplicationExt{Packages: graphql.PackagePageExt{
+			PackagePage: graphql.PackagePage{PageInfo: &graphql.PageInfo{
+				EndCursor:   graphql.PageCursor(endCursor),
+				HasNextPage: endCursor != "",
+			}},
+			Data: packages,
+		}}
+	}
+}
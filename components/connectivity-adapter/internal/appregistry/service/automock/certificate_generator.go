@@ -0,0 +1,34 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	service "github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/service"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CertificateGenerator is an autogenerated mock type for the CertificateGenerator type
+type CertificateGenerator struct {
+	mock.Mock
+}
+
+// Generate provides a mock function with given fields: commonName
+func (_m *CertificateGenerator) Generate(commonName string) (service.GeneratedCertificate, error) {
+	ret := _m.Called(commonName)
+
+	var r0 service.GeneratedCertificate
+	if rf, ok := ret.Get(0).(func(string) service.GeneratedCertificate); ok {
+		r0 = rf(commonName)
+	} else {
+		r0 = ret.Get(0).(service.GeneratedCertificate)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(commonName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
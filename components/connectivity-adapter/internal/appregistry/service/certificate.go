@@ -0,0 +1,86 @@
+package service
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const certificateKeyBits = 2048
+
+//go:generate mockery -name=CertificateGenerator -output=automock -outpkg=automock -case=underscore
+type CertificateGenerator interface {
+	Generate(commonName string) (GeneratedCertificate, error)
+}
+
+type GeneratedCertificate struct {
+	Certificate string
+	PrivateKey  string
+}
+
+type certificateGenerator struct {
+	validity time.Duration
+}
+
+// NewCertificateGenerator returns a generator of self-signed certificates used by legacy services with CertificateGen credentials
+func NewCertificateGenerator(validity time.Duration) *certificateGenerator {
+	return &certificateGenerator{validity: validity}
+}
+
+func (g *certificateGenerator) Generate(commonName string) (GeneratedCertificate, error) {
+	key, err := rsa.GenerateKey(rand.Reader, certificateKeyBits)
+	if err != nil {
+		return GeneratedCertificate{}, errors.Wrap(err, "while generating private key")
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return GeneratedCertificate{}, errors.Wrap(err, "while generating serial number")
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             now,
+		NotAfter:              now.Add(g.validity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return GeneratedCertificate{}, errors.Wrap(err, "while creating certificate")
+	}
+
+	certificate, err := encodePEM("CERTIFICATE", certDER)
+	if err != nil {
+		return GeneratedCertificate{}, errors.Wrap(err, "while encoding certificate")
+	}
+
+	privateKey, err := encodePEM("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key))
+	if err != nil {
+		return GeneratedCertificate{}, errors.Wrap(err, "while encoding private key")
+	}
+
+	return GeneratedCertificate{
+		Certificate: certificate,
+		PrivateKey:  privateKey,
+	}, nil
+}
+
+func encodePEM(blockType string, data []byte) (string, error) {
+	var buf bytes.Buffer
+	if err := pem.Encode(&buf, &pem.Block{Type: blockType, Bytes: data}); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,36 @@
+package service_test
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertificateGenerator_Generate(t *testing.T) {
+	// GIVEN
+	generator := service.NewCertificateGenerator(time.Hour)
+
+	// WHEN
+	generated, err := generator.Generate("app.kyma.local")
+
+	// THEN
+	require.NoError(t, err)
+
+	certBlock, _ := pem.Decode([]byte(generated.Certificate))
+	require.NotNil(t, certBlock)
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	require.NoError(t, err)
+	assert.Equal(t, "app.kyma.local", cert.Subject.CommonName)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), cert.NotAfter, time.Minute)
+
+	keyBlock, _ := pem.Decode([]byte(generated.PrivateKey))
+	require.NotNil(t, keyBlock)
+	key, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	require.NoError(t, err)
+	assert.Equal(t, &key.PublicKey, cert.PublicKey)
+}
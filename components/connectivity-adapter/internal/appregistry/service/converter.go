@@ -12,10 +12,14 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
-type converter struct{}
+type converter struct {
+	certGenerator CertificateGenerator
+}
 
-func NewConverter() *converter {
-	return &converter{}
+func NewConverter(certGenerator CertificateGenerator) *converter {
+	return &converter{
+		certGenerator: certGenerator,
+	}
 }
 
 const oDataSpecFormat = "%s/$metadata"
@@ -53,7 +57,7 @@ func (c *converter) DetailsToGraphQLCreateInput(deprecated model.ServiceDetails)
 			apiDef.Spec.Format = graphql.SpecFormatYaml
 		}
 
-		if deprecated.Api.Credentials != nil && (deprecated.Api.Credentials.OauthWithCSRF != nil || deprecated.Api.Credentials.BasicWithCSRF != nil) {
+		if deprecated.Api.Credentials != nil && (deprecated.Api.Credentials.OauthWithCSRF != nil || deprecated.Api.Credentials.BasicWithCSRF != nil || deprecated.Api.Credentials.CertificateGenWithCSRF != nil) {
 			defaultInstanceAuth.Credential = &graphql.CredentialDataInput{}
 
 			if deprecated.Api.Credentials.BasicWithCSRF != nil {
@@ -71,11 +75,11 @@ func (c *converter) DetailsToGraphQLCreateInput(deprecated model.ServiceDetails)
 			}
 
 			if deprecated.Api.Credentials.OauthWithCSRF != nil {
-				defaultInstanceAuth.Credential.Oauth = &graphql.OAuthCredentialDataInput{
-					ClientID:     deprecated.Api.Credentials.OauthWithCSRF.ClientID,
-					ClientSecret: deprecated.Api.Credentials.OauthWithCSRF.ClientSecret,
-					URL:          deprecated.Api.Credentials.OauthWithCSRF.URL,
+				oauth, err := c.legacyOauthToGraphQL(deprecated.Api.Credentials.OauthWithCSRF.Oauth)
+				if err != nil {
+					return graphql.PackageCreateInput{}, err
 				}
+				defaultInstanceAuth.Credential.Oauth = oauth
 
 				if deprecated.Api.Credentials.OauthWithCSRF.CSRFInfo != nil {
 					defaultInstanceAuth.RequestAuth = &graphql.CredentialRequestAuthInput{
@@ -85,7 +89,26 @@ func (c *converter) DetailsToGraphQLCreateInput(deprecated model.ServiceDetails)
 				}
 			}
 
-			// TODO deprecated.Api.Credentials.CertificateGenWithCSRF not supported
+			if deprecated.Api.Credentials.CertificateGenWithCSRF != nil {
+				commonName := deprecated.Api.Credentials.CertificateGenWithCSRF.CommonName
+				cert, err := c.certGenerator.Generate(commonName)
+				if err != nil {
+					return graphql.PackageCreateInput{}, errors.Wrapf(err, "while generating certificate for common name [%s]", commonName)
+				}
+
+				defaultInstanceAuth.Credential.CertificateGen = &graphql.CertificateGenCredentialDataInput{
+					CommonName:  commonName,
+					Certificate: cert.Certificate,
+					PrivateKey:  cert.PrivateKey,
+				}
+
+				if deprecated.Api.Credentials.CertificateGenWithCSRF.CSRFInfo != nil {
+					defaultInstanceAuth.RequestAuth = &graphql.CredentialRequestAuthInput{
+						Csrf: &graphql.CSRFTokenCredentialRequestAuthInput{
+							TokenEndpointURL: deprecated.Api.Credentials.CertificateGenWithCSRF.CSRFInfo.TokenEndpointURL,
+						}}
+				}
+			}
 		}
 
 		// old way of providing request headers
@@ -179,13 +202,12 @@ func (c *converter) DetailsToGraphQLCreateInput(deprecated model.ServiceDetails)
 
 			if deprecated.Api.SpecificationCredentials != nil {
 				if deprecated.Api.SpecificationCredentials.Oauth != nil {
-					inOauth := deprecated.Api.SpecificationCredentials.Oauth
+					oauth, err := c.legacyOauthToGraphQL(*deprecated.Api.SpecificationCredentials.Oauth)
+					if err != nil {
+						return graphql.PackageCreateInput{}, err
+					}
 					apiDef.Spec.FetchRequest.Auth.Credential = &graphql.CredentialDataInput{
-						Oauth: &graphql.OAuthCredentialDataInput{
-							URL:          inOauth.URL,
-							ClientID:     inOauth.ClientID,
-							ClientSecret: inOauth.ClientSecret,
-						},
+						Oauth: oauth,
 					}
 				}
 				if deprecated.Api.SpecificationCredentials.Basic != nil {
@@ -357,8 +379,9 @@ func (c *converter) GraphQLToServiceDetails(in graphql.PackageExt, legacyService
 		if in.DefaultInstanceAuth != nil && in.DefaultInstanceAuth.Credential != nil {
 			basicCreds, isBasic := in.DefaultInstanceAuth.Credential.(*graphql.BasicCredentialData)
 			oauthCreds, isOauth := in.DefaultInstanceAuth.Credential.(*graphql.OAuthCredentialData)
+			certGenCreds, isCertGen := in.DefaultInstanceAuth.Credential.(*graphql.CertificateGenCredentialData)
 
-			if (isBasic && basicCreds != nil) || (isOauth && oauthCreds != nil) {
+			if (isBasic && basicCreds != nil) || (isOauth && oauthCreds != nil) || (isCertGen && certGenCreds != nil) {
 				if outDeprecated.Api.Credentials == nil {
 					outDeprecated.Api.Credentials = &model.CredentialsWithCSRF{}
 				}
@@ -378,17 +401,26 @@ func (c *converter) GraphQLToServiceDetails(in graphql.PackageExt, legacyService
 
 				case *graphql.OAuthCredentialData:
 					outDeprecated.Api.Credentials.OauthWithCSRF = &model.OauthWithCSRF{
-						Oauth: model.Oauth{
-							URL:          actual.URL,
-							ClientID:     actual.ClientID,
-							ClientSecret: actual.ClientSecret,
-						},
+						Oauth: c.graphQLToLegacyOauth(actual),
 					}
 					if in.DefaultInstanceAuth.RequestAuth != nil && in.DefaultInstanceAuth.RequestAuth.Csrf != nil {
 						outDeprecated.Api.Credentials.OauthWithCSRF.CSRFInfo = &model.CSRFInfo{
 							TokenEndpointURL: in.DefaultInstanceAuth.RequestAuth.Csrf.TokenEndpointURL,
 						}
 					}
+
+				case *graphql.CertificateGenCredentialData:
+					outDeprecated.Api.Credentials.CertificateGenWithCSRF = &model.CertificateGenWithCSRF{
+						CertificateGen: model.CertificateGen{
+							CommonName:  actual.CommonName,
+							Certificate: actual.Certificate,
+						},
+					}
+					if in.DefaultInstanceAuth.RequestAuth != nil && in.DefaultInstanceAuth.RequestAuth.Csrf != nil {
+						outDeprecated.Api.Credentials.CertificateGenWithCSRF.CSRFInfo = &model.CSRFInfo{
+							TokenEndpointURL: in.DefaultInstanceAuth.RequestAuth.Csrf.TokenEndpointURL,
+						}
+					}
 				}
 			}
 		}
@@ -452,12 +484,8 @@ func (c *converter) GraphQLToServiceDetails(in graphql.PackageExt, legacyService
 						}
 					}
 					if isOauth {
-						outCred.Oauth = &model.Oauth{
-							URL:               oauth.URL,
-							ClientID:          oauth.ClientID,
-							ClientSecret:      oauth.ClientSecret,
-							RequestParameters: nil, // TODO not supported
-						}
+						outOauth := c.graphQLToLegacyOauth(oauth)
+						outCred.Oauth = &outOauth
 					}
 					outDeprecated.Api.SpecificationCredentials = outCred
 				}
@@ -490,6 +518,60 @@ func (c *converter) GraphQLToServiceDetails(in graphql.PackageExt, legacyService
 	return outDeprecated, nil
 }
 
+func (c *converter) legacyOauthToGraphQL(in model.Oauth) (*graphql.OAuthCredentialDataInput, error) {
+	out := &graphql.OAuthCredentialDataInput{
+		URL:          in.URL,
+		ClientID:     in.ClientID,
+		ClientSecret: in.ClientSecret,
+	}
+
+	if in.RequestParameters == nil {
+		return out, nil
+	}
+
+	if in.RequestParameters.Headers != nil {
+		h, err := graphql.NewHttpHeadersSerialized(*in.RequestParameters.Headers)
+		if err != nil {
+			return nil, errors.Wrap(err, "while serializing OAuth request headers")
+		}
+		out.AdditionalHeadersSerialized = &h
+	}
+
+	if in.RequestParameters.QueryParameters != nil {
+		q, err := graphql.NewQueryParamsSerialized(*in.RequestParameters.QueryParameters)
+		if err != nil {
+			return nil, errors.Wrap(err, "while serializing OAuth request query parameters")
+		}
+		out.AdditionalQueryParamsSerialized = &q
+	}
+
+	return out, nil
+}
+
+func (c *converter) graphQLToLegacyOauth(in *graphql.OAuthCredentialData) model.Oauth {
+	out := model.Oauth{
+		URL:          in.URL,
+		ClientID:     in.ClientID,
+		ClientSecret: in.ClientSecret,
+	}
+
+	if in.AdditionalHeaders == nil && in.AdditionalQueryParams == nil {
+		return out
+	}
+
+	out.RequestParameters = &model.RequestParameters{}
+	if in.AdditionalHeaders != nil {
+		headers := (map[string][]string)(*in.AdditionalHeaders)
+		out.RequestParameters.Headers = &headers
+	}
+	if in.AdditionalQueryParams != nil {
+		params := (map[string][]string)(*in.AdditionalQueryParams)
+		out.RequestParameters.QueryParameters = &params
+	}
+
+	return out
+}
+
 func (c *converter) GraphQLCreateInputToUpdateInput(in graphql.PackageCreateInput) graphql.PackageUpdateInput {
 	return graphql.PackageUpdateInput{
 		Name:                           in.Name,
@@ -6,7 +6,9 @@ import (
 
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/model"
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/service"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/service/automock"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -20,7 +22,9 @@ func TestConverter_DetailsToGraphQLCreateInput(t *testing.T) {
 		expected graphql.PackageCreateInput
 	}
 
-	conv := service.NewConverter()
+	certGenerator := &automock.CertificateGenerator{}
+	certGenerator.On("Generate", "app.kyma.local").Return(service.GeneratedCertificate{Certificate: "cert", PrivateKey: "key"}, nil)
+	conv := service.NewConverter(certGenerator)
 
 	for name, tc := range map[string]testCase{
 		"name and description propagated to api": {
@@ -291,7 +295,7 @@ func TestConverter_DetailsToGraphQLCreateInput(t *testing.T) {
 								ClientID:     "client_id",
 								ClientSecret: "client_secret",
 								URL:          "http://oauth.url",
-								RequestParameters: &model.RequestParameters{ // TODO this field is not mapped at all
+								RequestParameters: &model.RequestParameters{
 									QueryParameters: &map[string][]string{
 										"q1": {"a", "b"},
 										"q2": {"c", "d"},
@@ -311,9 +315,42 @@ func TestConverter_DetailsToGraphQLCreateInput(t *testing.T) {
 				DefaultInstanceAuth: &graphql.AuthInput{
 					Credential: &graphql.CredentialDataInput{
 						Oauth: &graphql.OAuthCredentialDataInput{
-							URL:          "http://oauth.url",
-							ClientID:     "client_id",
-							ClientSecret: "client_secret",
+							URL:                             "http://oauth.url",
+							ClientID:                        "client_id",
+							ClientSecret:                    "client_secret",
+							AdditionalHeadersSerialized:     &additionalHeadersSerialized,
+							AdditionalQueryParamsSerialized: &additionalQueryParamsSerialized,
+						},
+					},
+					RequestAuth: &graphql.CredentialRequestAuthInput{
+						Csrf: &graphql.CSRFTokenCredentialRequestAuthInput{TokenEndpointURL: "foo.bar"},
+					},
+				},
+				APIDefinitions: []*graphql.APIDefinitionInput{
+					{},
+				},
+			},
+		},
+		"API protected with generated certificate": {
+			given: model.ServiceDetails{
+				Api: &model.API{
+					Credentials: &model.CredentialsWithCSRF{
+						CertificateGenWithCSRF: &model.CertificateGenWithCSRF{
+							CertificateGen: model.CertificateGen{
+								CommonName: "app.kyma.local",
+							},
+							CSRFInfo: &model.CSRFInfo{TokenEndpointURL: "foo.bar"},
+						},
+					},
+				},
+			},
+			expected: graphql.PackageCreateInput{
+				DefaultInstanceAuth: &graphql.AuthInput{
+					Credential: &graphql.CredentialDataInput{
+						CertificateGen: &graphql.CertificateGenCredentialDataInput{
+							CommonName:  "app.kyma.local",
+							Certificate: "cert",
+							PrivateKey:  "key",
 						},
 					},
 					RequestAuth: &graphql.CredentialRequestAuthInput{
@@ -387,10 +424,15 @@ func TestConverter_DetailsToGraphQLCreateInput(t *testing.T) {
 					SpecificationUrl: "http://specification.url",
 					SpecificationCredentials: &model.Credentials{
 						Oauth: &model.Oauth{
-							URL:               "http://oauth.url",
-							ClientID:          "client_id",
-							ClientSecret:      "client_secret",
-							RequestParameters: nil, // TODO not supported
+							URL:          "http://oauth.url",
+							ClientID:     "client_id",
+							ClientSecret: "client_secret",
+							RequestParameters: &model.RequestParameters{
+								QueryParameters: &map[string][]string{
+									"q1": {"a", "b"},
+									"q2": {"c", "d"},
+								},
+							},
 						},
 					},
 				},
@@ -405,9 +447,10 @@ func TestConverter_DetailsToGraphQLCreateInput(t *testing.T) {
 								Auth: &graphql.AuthInput{
 									Credential: &graphql.CredentialDataInput{
 										Oauth: &graphql.OAuthCredentialDataInput{
-											URL:          "http://oauth.url",
-											ClientID:     "client_id",
-											ClientSecret: "client_secret",
+											URL:                             "http://oauth.url",
+											ClientID:                        "client_id",
+											ClientSecret:                    "client_secret",
+											AdditionalQueryParamsSerialized: &additionalQueryParamsSerialized,
 										},
 									},
 								},
@@ -486,6 +529,29 @@ func TestConverter_DetailsToGraphQLCreateInput(t *testing.T) {
 			assert.Equal(t, tc.expected, actual)
 		})
 	}
+
+	t.Run("error when certificate generation fails", func(t *testing.T) {
+		// GIVEN
+		failingGenerator := &automock.CertificateGenerator{}
+		failingGenerator.On("Generate", "app.kyma.local").Return(service.GeneratedCertificate{}, errors.New("test error")).Once()
+		defer failingGenerator.AssertExpectations(t)
+		failingConv := service.NewConverter(failingGenerator)
+
+		// WHEN
+		_, err := failingConv.DetailsToGraphQLCreateInput(model.ServiceDetails{
+			Api: &model.API{
+				Credentials: &model.CredentialsWithCSRF{
+					CertificateGenWithCSRF: &model.CertificateGenWithCSRF{
+						CertificateGen: model.CertificateGen{CommonName: "app.kyma.local"},
+					},
+				},
+			},
+		})
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while generating certificate for common name [app.kyma.local]")
+	})
 }
 
 func TestConverter_GraphQLToServiceDetails(t *testing.T) {
@@ -493,7 +559,7 @@ func TestConverter_GraphQLToServiceDetails(t *testing.T) {
 		given    graphql.PackageExt
 		expected model.ServiceDetails
 	}
-	conv := service.NewConverter()
+	conv := service.NewConverter(&automock.CertificateGenerator{})
 
 	testSvcRef := service.LegacyServiceReference{
 		ID:         "foo",
@@ -657,6 +723,89 @@ func TestConverter_GraphQLToServiceDetails(t *testing.T) {
 				Labels: emptyLabels(),
 			},
 		},
+		"simple API with Oauth with request parameters": {
+			given: graphql.PackageExt{
+				Package: graphql.Package{
+					DefaultInstanceAuth: &graphql.Auth{
+						Credential: &graphql.OAuthCredentialData{
+							URL:                   "http://oauth.url",
+							ClientID:              "client_id",
+							ClientSecret:          "client_secret",
+							AdditionalHeaders:     &graphql.HttpHeaders{"h1": {"e", "f"}},
+							AdditionalQueryParams: &graphql.QueryParams{"q1": {"a", "b"}},
+						},
+					},
+				},
+				APIDefinitions: graphql.APIDefinitionPageExt{
+					Data: []*graphql.APIDefinitionExt{
+						{
+							APIDefinition: graphql.APIDefinition{
+								TargetURL: "http://target.url",
+							},
+						},
+					},
+				},
+			},
+			expected: model.ServiceDetails{
+				Api: &model.API{
+					TargetUrl: "http://target.url",
+					Credentials: &model.CredentialsWithCSRF{
+						OauthWithCSRF: &model.OauthWithCSRF{
+							Oauth: model.Oauth{
+								URL:          "http://oauth.url",
+								ClientID:     "client_id",
+								ClientSecret: "client_secret",
+								RequestParameters: &model.RequestParameters{
+									Headers:         &map[string][]string{"h1": {"e", "f"}},
+									QueryParameters: &map[string][]string{"q1": {"a", "b"}},
+								},
+							},
+						},
+					},
+				},
+				Labels: emptyLabels(),
+			},
+		},
+		"simple API with generated certificate": {
+			given: graphql.PackageExt{
+				Package: graphql.Package{
+					DefaultInstanceAuth: &graphql.Auth{
+						Credential: &graphql.CertificateGenCredentialData{
+							CommonName:  "app.kyma.local",
+							Certificate: "cert",
+							PrivateKey:  "key",
+						},
+						RequestAuth: &graphql.CredentialRequestAuth{
+							Csrf: &graphql.CSRFTokenCredentialRequestAuth{TokenEndpointURL: "foo.bar"},
+						},
+					},
+				},
+				APIDefinitions: graphql.APIDefinitionPageExt{
+					Data: []*graphql.APIDefinitionExt{
+						{
+							APIDefinition: graphql.APIDefinition{
+								TargetURL: "http://target.url",
+							},
+						},
+					},
+				},
+			},
+			expected: model.ServiceDetails{
+				Api: &model.API{
+					TargetUrl: "http://target.url",
+					Credentials: &model.CredentialsWithCSRF{
+						CertificateGenWithCSRF: &model.CertificateGenWithCSRF{
+							CertificateGen: model.CertificateGen{
+								CommonName:  "app.kyma.local",
+								Certificate: "cert",
+							},
+							CSRFInfo: &model.CSRFInfo{TokenEndpointURL: "foo.bar"},
+						},
+					},
+				},
+				Labels: emptyLabels(),
+			},
+		},
 		"simple API with FetchRequest (query params and headers)": {
 			given: graphql.PackageExt{
 				APIDefinitions: graphql.APIDefinitionPageExt{
@@ -829,7 +978,7 @@ func TestConverter_ServiceDetailsToService(t *testing.T) {
 	id := "id"
 
 	//WHEN
-	conv := service.NewConverter()
+	conv := service.NewConverter(&automock.CertificateGenerator{})
 	output, err := conv.ServiceDetailsToService(input, id)
 
 	//THEN
@@ -861,7 +1010,7 @@ func TestConverter_GraphQLCreateInputToUpdateInput(t *testing.T) {
 		DefaultInstanceAuth:            &auth,
 	}
 
-	conv := service.NewConverter()
+	conv := service.NewConverter(&automock.CertificateGenerator{})
 
 	res := conv.GraphQLCreateInputToUpdateInput(in)
 
@@ -870,7 +1019,7 @@ func TestConverter_GraphQLCreateInputToUpdateInput(t *testing.T) {
 
 func TestConverter_DetailsToGraphQLInput_TestSpecsRecognition(t *testing.T) {
 	// GIVEN
-	conv := service.NewConverter()
+	conv := service.NewConverter(&automock.CertificateGenerator{})
 
 	// API
 	apiCases := []struct {
@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/retry"
 	schema "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql/directorclient"
 	"github.com/machinebox/graphql"
 )
 
@@ -17,59 +18,39 @@ type Client interface {
 
 func NewClient(gqlClient *graphql.Client) Client {
 	return client{
-		gqlClient: gqlClient,
-		timeout:   30 * time.Second,
+		reader: directorclient.NewClient(&retryingClient{
+			gqlClient: gqlClient,
+			timeout:   30 * time.Second,
+		}, directorclient.DefaultPageSize),
 	}
 }
 
 type client struct {
-	gqlClient *graphql.Client
-	timeout   time.Duration
+	reader *directorclient.Client
 }
 
 func (c client) GetApplication(ctx context.Context, systemAuthID string) (schema.ApplicationExt, apperrors.AppError) {
-	appID, err := c.getApplicationID(ctx, systemAuthID)
+	viewer, err := c.reader.GetViewer(ctx)
 	if err != nil {
 		return schema.ApplicationExt{}, apperrors.Internal(err.Error())
 	}
 
-	query := applicationQuery(appID)
-	var response ApplicationResponse
-
-	err = c.execute(ctx, c.gqlClient, query, &response)
+	application, err := c.reader.GetApplication(ctx, viewer.ID)
 	if err != nil {
 		return schema.ApplicationExt{}, apperrors.Internal(err.Error())
 	}
 
-	return response.Result, nil
+	return application, nil
 }
 
-func (c client) getApplicationID(ctx context.Context, systemAuthID string) (string, error) {
-	query := viewerQuery()
-
-	var response ViewerResponse
-
-	err := c.execute(ctx, c.gqlClient, query, &response)
-	if err != nil {
-		return "", err
-	}
-
-	return response.Result.ID, nil
-}
-
-type ViewerResponse struct {
-	Result schema.Viewer `json:"result"`
-}
-
-type ApplicationResponse struct {
-	Result schema.ApplicationExt `json:"result"`
+type retryingClient struct {
+	gqlClient *graphql.Client
+	timeout   time.Duration
 }
 
-func (c *client) execute(ctx context.Context, client *graphql.Client, query string, res interface{}) error {
-	req := graphql.NewRequest(query)
-
+func (c *retryingClient) Run(ctx context.Context, req *graphql.Request, resp interface{}) error {
 	newCtx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
-	return retry.GQLRun(client.Run, newCtx, req, res)
+	return retry.GQLRun(c.gqlClient.Run, newCtx, req, resp)
 }
@@ -0,0 +1,36 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	events "github.com/kyma-incubator/compass/components/connectivity-adapter/internal/events"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Forwarder is an autogenerated mock type for the Forwarder type
+type Forwarder struct {
+	mock.Mock
+}
+
+// Forward provides a mock function with given fields: ctx, url, event
+func (_m *Forwarder) Forward(ctx context.Context, url string, event []byte) (events.ForwardResponse, error) {
+	ret := _m.Called(ctx, url, event)
+
+	var r0 events.ForwardResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) events.ForwardResponse); ok {
+		r0 = rf(ctx, url, event)
+	} else {
+		r0 = ret.Get(0).(events.ForwardResponse)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = rf(ctx, url, event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
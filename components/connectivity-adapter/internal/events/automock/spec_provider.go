@@ -0,0 +1,37 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SpecProvider is an autogenerated mock type for the SpecProvider type
+type SpecProvider struct {
+	mock.Mock
+}
+
+// EventSpecs provides a mock function with given fields: ctx, appID
+func (_m *SpecProvider) EventSpecs(ctx context.Context, appID string) ([]string, error) {
+	ret := _m.Called(ctx, appID)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, appID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
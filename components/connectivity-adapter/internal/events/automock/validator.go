@@ -0,0 +1,31 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	events "github.com/kyma-incubator/compass/components/connectivity-adapter/internal/events"
+	apperrors "github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/apperrors"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Validator is an autogenerated mock type for the Validator type
+type Validator struct {
+	mock.Mock
+}
+
+// Validate provides a mock function with given fields: event, specs
+func (_m *Validator) Validate(event events.PublishRequest, specs []string) apperrors.AppError {
+	ret := _m.Called(event, specs)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(events.PublishRequest, []string) apperrors.AppError); ok {
+		r0 = rf(event, specs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
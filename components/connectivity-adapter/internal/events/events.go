@@ -0,0 +1,35 @@
+package events
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/appdetails"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/gqlcli"
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/sirupsen/logrus"
+)
+
+type Config struct {
+	ClientTimeout  time.Duration `envconfig:"default=30s"`
+	MaxRequestSize int64         `envconfig:"default=1048576"`
+}
+
+func RegisterHandler(router *mux.Router, cfg Config, directorEndpoint string, directorTimeout time.Duration) {
+	logger := logrus.New().WithField("component", "events").Logger
+	logger.SetReportCaller(true)
+
+	gqlCliProvider := gqlcli.NewProvider(directorEndpoint, directorTimeout)
+	appMiddleware := appdetails.NewApplicationMiddleware(gqlCliProvider, logger)
+
+	httpClient := &http.Client{
+		Transport: httputil.NewCorrelationIDTransport(httputil.NewTracingTransport(http.DefaultTransport)),
+		Timeout:   cfg.ClientTimeout,
+	}
+
+	eventsHandler := NewHandler(NewSpecProvider(), NewValidator(), NewForwarder(httpClient), cfg.MaxRequestSize, logger)
+
+	router.Use(appMiddleware.Middleware)
+	router.HandleFunc("", eventsHandler.Publish).Methods(http.MethodPost)
+}
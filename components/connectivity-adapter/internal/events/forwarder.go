@@ -0,0 +1,53 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// ForwardResponse is the response of the runtime eventing endpoint
+type ForwardResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+type forwarder struct {
+	httpClient *http.Client
+}
+
+func NewForwarder(httpClient *http.Client) *forwarder {
+	return &forwarder{httpClient: httpClient}
+}
+
+// Forward sends the event to the eventing URL of the runtime unchanged, as the runtime accepts the legacy format
+func (f *forwarder) Forward(ctx context.Context, url string, event []byte) (ForwardResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(event))
+	if err != nil {
+		return ForwardResponse{}, errors.Wrap(err, "while creating request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return ForwardResponse{}, errors.Wrapf(err, "while sending event to %s", url)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return ForwardResponse{}, errors.Wrap(err, "while reading response body")
+	}
+
+	return ForwardResponse{
+		StatusCode:  resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Body:        body,
+	}, nil
+}
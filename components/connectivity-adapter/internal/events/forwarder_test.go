@@ -0,0 +1,52 @@
+package events_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForwarder_Forward(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+			body, err := ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.Equal(t, eventBody, string(body))
+
+			rw.Header().Set("Content-Type", "application/json")
+			_, _ = rw.Write([]byte(`{"event-id":"id"}`))
+		}))
+		defer srv.Close()
+
+		forwarder := events.NewForwarder(http.DefaultClient)
+		// WHEN
+		resp, err := forwarder.Forward(context.TODO(), srv.URL, []byte(eventBody))
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.ContentType)
+		assert.Equal(t, `{"event-id":"id"}`, string(resp.Body))
+	})
+
+	t.Run("Error when runtime is not reachable", func(t *testing.T) {
+		// GIVEN
+		srv := httptest.NewServer(http.NotFoundHandler())
+		srv.Close()
+
+		forwarder := events.NewForwarder(http.DefaultClient)
+		// WHEN
+		_, err := forwarder.Forward(context.TODO(), srv.URL, []byte(eventBody))
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while sending event to "+srv.URL)
+	})
+}
@@ -0,0 +1,122 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/appdetails"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/res"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate mockery -name=SpecProvider -output=automock -outpkg=automock -case=underscore
+type SpecProvider interface {
+	EventSpecs(ctx context.Context, appID string) ([]string, error)
+}
+
+//go:generate mockery -name=Validator -output=automock -outpkg=automock -case=underscore
+type Validator interface {
+	Validate(event PublishRequest, specs []string) apperrors.AppError
+}
+
+//go:generate mockery -name=Forwarder -output=automock -outpkg=automock -case=underscore
+type Forwarder interface {
+	Forward(ctx context.Context, url string, event []byte) (ForwardResponse, error)
+}
+
+type Handler struct {
+	specProvider   SpecProvider
+	validator      Validator
+	forwarder      Forwarder
+	maxRequestSize int64
+	logger         *log.Logger
+}
+
+func NewHandler(specProvider SpecProvider, validator Validator, forwarder Forwarder, maxRequestSize int64, logger *log.Logger) *Handler {
+	return &Handler{
+		specProvider:   specProvider,
+		validator:      validator,
+		forwarder:      forwarder,
+		maxRequestSize: maxRequestSize,
+		logger:         logger,
+	}
+}
+
+// Publish validates a legacy event against the Event Definitions of the Application and forwards it to the runtime
+func (h *Handler) Publish(writer http.ResponseWriter, request *http.Request) {
+	defer h.closeBody(request)
+
+	app, err := appdetails.LoadFromContext(request.Context())
+	if err != nil {
+		h.writeErrorInternal(writer, errors.Wrap(err, "while loading Application details from context"))
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(writer, request.Body, h.maxRequestSize))
+	if err != nil {
+		h.logger.Error(errors.Wrap(err, "while reading request body"))
+		res.WriteError(writer, errors.Wrap(err, "while reading request body"), apperrors.CodeWrongInput)
+		return
+	}
+
+	var event PublishRequest
+	if err := json.Unmarshal(body, &event); err != nil {
+		wrappedErr := errors.Wrap(err, "while unmarshalling event")
+		h.logger.Error(wrappedErr)
+		res.WriteError(writer, wrappedErr, apperrors.CodeWrongInput)
+		return
+	}
+
+	specs, err := h.specProvider.EventSpecs(request.Context(), app.ID)
+	if err != nil {
+		h.writeErrorInternal(writer, errors.Wrap(err, "while fetching Event Definitions"))
+		return
+	}
+
+	if appErr := h.validator.Validate(event, specs); appErr != nil {
+		h.logger.Error(errors.Wrap(appErr, "while validating event"))
+		res.WriteAppError(writer, appErr)
+		return
+	}
+
+	if app.EventingConfiguration.DefaultURL == "" {
+		message := "eventing URL of the Application is not configured"
+		h.logger.Warn(message)
+		res.WriteErrorMessage(writer, message, apperrors.CodeNotFound)
+		return
+	}
+
+	h.logger.Infof("forwarding event of type [%s] in version [%s] for Application with ID %s", event.EventType, event.EventTypeVersion, app.ID)
+
+	resp, err := h.forwarder.Forward(request.Context(), app.EventingConfiguration.DefaultURL, body)
+	if err != nil {
+		wrappedErr := errors.Wrap(err, "while forwarding event")
+		h.logger.Error(wrappedErr)
+		res.WriteError(writer, wrappedErr, apperrors.CodeUpstreamServerCallFailed)
+		return
+	}
+
+	if resp.ContentType != "" {
+		writer.Header().Set(res.HeaderContentTypeKey, resp.ContentType)
+	}
+	writer.WriteHeader(resp.StatusCode)
+	if _, err := writer.Write(resp.Body); err != nil {
+		h.logger.Error(errors.Wrap(err, "while writing response"))
+	}
+}
+
+func (h *Handler) writeErrorInternal(writer http.ResponseWriter, err error) {
+	h.logger.Error(err)
+	res.WriteError(writer, err, apperrors.CodeInternal)
+}
+
+func (h *Handler) closeBody(rq *http.Request) {
+	err := rq.Body.Close()
+	if err != nil {
+		h.logger.Error(errors.Wrap(err, "while closing body"))
+	}
+}
@@ -0,0 +1,204 @@
+package events_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/appdetails"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/events"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/events/automock"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/res"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	appID       = "app-id"
+	eventingURL = "http://runtime.local/app/v1/events"
+	eventBody   = `{"event-type":"order.created","event-type-version":"v1","event-time":"2020-09-28T14:47:16.491Z","data":{"orderCode":"123"}}`
+)
+
+func TestHandler_Publish(t *testing.T) {
+	specs := []string{asyncAPIV1Spec}
+	testErr := errors.New("test")
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		specProvider := &automock.SpecProvider{}
+		specProvider.On("EventSpecs", mock.Anything, appID).Return(specs, nil).Once()
+		validator := &automock.Validator{}
+		validator.On("Validate", mock.MatchedBy(func(event events.PublishRequest) bool {
+			return event.EventType == "order.created" && event.EventTypeVersion == "v1"
+		}), specs).Return(nil).Once()
+		forwarder := &automock.Forwarder{}
+		forwarder.On("Forward", mock.Anything, eventingURL, []byte(eventBody)).Return(events.ForwardResponse{
+			StatusCode:  http.StatusOK,
+			ContentType: "application/json",
+			Body:        []byte(`{"event-id":"8954ad1c-78ed-4c58-a639-68bd44031de0"}`),
+		}, nil).Once()
+
+		handler := events.NewHandler(specProvider, validator, forwarder, 1024, logrus.New())
+		w := httptest.NewRecorder()
+		// WHEN
+		handler.Publish(w, fixRequest(eventBody, fixApp(eventingURL)))
+		// THEN
+		resp := w.Result()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"event-id":"8954ad1c-78ed-4c58-a639-68bd44031de0"}`, string(body))
+		specProvider.AssertExpectations(t)
+		validator.AssertExpectations(t)
+		forwarder.AssertExpectations(t)
+	})
+
+	t.Run("Relays error response of the runtime", func(t *testing.T) {
+		// GIVEN
+		specProvider := &automock.SpecProvider{}
+		specProvider.On("EventSpecs", mock.Anything, appID).Return(specs, nil).Once()
+		validator := &automock.Validator{}
+		validator.On("Validate", mock.Anything, specs).Return(nil).Once()
+		forwarder := &automock.Forwarder{}
+		forwarder.On("Forward", mock.Anything, eventingURL, []byte(eventBody)).Return(events.ForwardResponse{
+			StatusCode: http.StatusServiceUnavailable,
+			Body:       []byte("unavailable"),
+		}, nil).Once()
+
+		handler := events.NewHandler(specProvider, validator, forwarder, 1024, logrus.New())
+		w := httptest.NewRecorder()
+		// WHEN
+		handler.Publish(w, fixRequest(eventBody, fixApp(eventingURL)))
+		// THEN
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+		assert.Equal(t, "unavailable", w.Body.String())
+		forwarder.AssertExpectations(t)
+	})
+
+	t.Run("Error when Application details are missing in context", func(t *testing.T) {
+		// GIVEN
+		handler := events.NewHandler(nil, nil, nil, 1024, logrus.New())
+		w := httptest.NewRecorder()
+		// WHEN
+		handler.Publish(w, httptest.NewRequest(http.MethodPost, "/app/v1/events", strings.NewReader(eventBody)))
+		// THEN
+		assertErrorResponse(t, w.Result(), "while loading Application details from context: cannot read Application details from context", http.StatusInternalServerError)
+	})
+
+	t.Run("Error when body exceeds maximum size", func(t *testing.T) {
+		// GIVEN
+		handler := events.NewHandler(nil, nil, nil, 10, logrus.New())
+		w := httptest.NewRecorder()
+		// WHEN
+		handler.Publish(w, fixRequest(eventBody, fixApp(eventingURL)))
+		// THEN
+		assertErrorResponse(t, w.Result(), "while reading request body: http: request body too large", http.StatusBadRequest)
+	})
+
+	t.Run("Error when body is not an event", func(t *testing.T) {
+		// GIVEN
+		handler := events.NewHandler(nil, nil, nil, 1024, logrus.New())
+		w := httptest.NewRecorder()
+		// WHEN
+		handler.Publish(w, fixRequest("[]", fixApp(eventingURL)))
+		// THEN
+		assertErrorResponse(t, w.Result(), "while unmarshalling event: json: cannot unmarshal array into Go value of type events.PublishRequest", http.StatusBadRequest)
+	})
+
+	t.Run("Error when fetching Event Definitions", func(t *testing.T) {
+		// GIVEN
+		specProvider := &automock.SpecProvider{}
+		specProvider.On("EventSpecs", mock.Anything, appID).Return(nil, testErr).Once()
+
+		handler := events.NewHandler(specProvider, nil, nil, 1024, logrus.New())
+		w := httptest.NewRecorder()
+		// WHEN
+		handler.Publish(w, fixRequest(eventBody, fixApp(eventingURL)))
+		// THEN
+		assertErrorResponse(t, w.Result(), "while fetching Event Definitions: test", http.StatusInternalServerError)
+		specProvider.AssertExpectations(t)
+	})
+
+	t.Run("Error when event is invalid", func(t *testing.T) {
+		// GIVEN
+		specProvider := &automock.SpecProvider{}
+		specProvider.On("EventSpecs", mock.Anything, appID).Return(specs, nil).Once()
+		validator := &automock.Validator{}
+		validator.On("Validate", mock.Anything, specs).Return(apperrors.WrongInput("test")).Once()
+
+		handler := events.NewHandler(specProvider, validator, nil, 1024, logrus.New())
+		w := httptest.NewRecorder()
+		// WHEN
+		handler.Publish(w, fixRequest(eventBody, fixApp(eventingURL)))
+		// THEN
+		assertErrorResponse(t, w.Result(), "test", http.StatusBadRequest)
+		validator.AssertExpectations(t)
+	})
+
+	t.Run("Error when eventing URL is not configured", func(t *testing.T) {
+		// GIVEN
+		specProvider := &automock.SpecProvider{}
+		specProvider.On("EventSpecs", mock.Anything, appID).Return(specs, nil).Once()
+		validator := &automock.Validator{}
+		validator.On("Validate", mock.Anything, specs).Return(nil).Once()
+
+		handler := events.NewHandler(specProvider, validator, nil, 1024, logrus.New())
+		w := httptest.NewRecorder()
+		// WHEN
+		handler.Publish(w, fixRequest(eventBody, fixApp("")))
+		// THEN
+		assertErrorResponse(t, w.Result(), "eventing URL of the Application is not configured", http.StatusNotFound)
+	})
+
+	t.Run("Error when forwarding event", func(t *testing.T) {
+		// GIVEN
+		specProvider := &automock.SpecProvider{}
+		specProvider.On("EventSpecs", mock.Anything, appID).Return(specs, nil).Once()
+		validator := &automock.Validator{}
+		validator.On("Validate", mock.Anything, specs).Return(nil).Once()
+		forwarder := &automock.Forwarder{}
+		forwarder.On("Forward", mock.Anything, eventingURL, []byte(eventBody)).Return(events.ForwardResponse{}, testErr).Once()
+
+		handler := events.NewHandler(specProvider, validator, forwarder, 1024, logrus.New())
+		w := httptest.NewRecorder()
+		// WHEN
+		handler.Publish(w, fixRequest(eventBody, fixApp(eventingURL)))
+		// THEN
+		assertErrorResponse(t, w.Result(), "while forwarding event: test", http.StatusBadGateway)
+		forwarder.AssertExpectations(t)
+	})
+}
+
+func fixApp(eventingURL string) graphql.ApplicationExt {
+	return graphql.ApplicationExt{
+		Application: graphql.Application{ID: appID},
+		EventingConfiguration: graphql.ApplicationEventingConfiguration{
+			DefaultURL: eventingURL,
+		},
+	}
+}
+
+func fixRequest(body string, app graphql.ApplicationExt) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/app/v1/events", strings.NewReader(body))
+	return req.WithContext(appdetails.SaveToContext(context.TODO(), app))
+}
+
+func assertErrorResponse(t *testing.T, resp *http.Response, expectedError string, expectedCode int) {
+	assert.Equal(t, expectedCode, resp.StatusCode)
+
+	var errorResponse res.ErrorResponse
+	err := json.NewDecoder(resp.Body).Decode(&errorResponse)
+	require.NoError(t, err)
+	assert.Equal(t, expectedCode, errorResponse.Code)
+	assert.Equal(t, expectedError, errorResponse.Error)
+}
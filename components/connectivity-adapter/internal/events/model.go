@@ -0,0 +1,12 @@
+package events
+
+import "encoding/json"
+
+// PublishRequest is the event format of the legacy Kyma Events API
+type PublishRequest struct {
+	EventType        string          `json:"event-type"`
+	EventTypeVersion string          `json:"event-type-version"`
+	EventID          string          `json:"event-id,omitempty"`
+	EventTime        string          `json:"event-time"`
+	Data             json.RawMessage `json:"data"`
+}
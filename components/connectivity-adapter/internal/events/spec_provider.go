@@ -0,0 +1,50 @@
+package events
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/appregistry/director"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/gqlcli"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql/graphqlizer"
+	"github.com/pkg/errors"
+)
+
+type specProvider struct {
+	graphqlizer       *graphqlizer.Graphqlizer
+	gqlFieldsProvider *graphqlizer.GqlFieldsProvider
+}
+
+func NewSpecProvider() *specProvider {
+	return &specProvider{
+		graphqlizer:       &graphqlizer.Graphqlizer{},
+		gqlFieldsProvider: &graphqlizer.GqlFieldsProvider{},
+	}
+}
+
+// EventSpecs returns specifications of all Event Definitions registered for the Application
+func (p *specProvider) EventSpecs(ctx context.Context, appID string) ([]string, error) {
+	gqlCli, err := gqlcli.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading GraphQL client from context")
+	}
+
+	packages, err := director.NewClient(gqlCli, p.graphqlizer, p.gqlFieldsProvider).ListPackages(ctx, appID)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing packages")
+	}
+
+	var specs []string
+	for _, pkg := range packages {
+		if pkg == nil {
+			continue
+		}
+		for _, eventDef := range pkg.EventDefinitions.Data {
+			if eventDef == nil || eventDef.Spec == nil || eventDef.Spec.Data == nil {
+				continue
+			}
+			specs = append(specs, string(*eventDef.Spec.Data))
+		}
+	}
+
+	return specs, nil
+}
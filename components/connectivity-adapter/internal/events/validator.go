@@ -0,0 +1,167 @@
+package events
+
+import (
+	"encoding/json"
+	"regexp"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/jsonschema"
+)
+
+var (
+	eventTypeRegex        = regexp.MustCompile(`^[a-zA-Z]+([_\-.]?[a-zA-Z0-9]+)*$`)
+	eventTypeVersionRegex = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+)
+
+type validator struct{}
+
+func NewValidator() *validator {
+	return &validator{}
+}
+
+// Validate checks if the event is well-formed and described by one of the given AsyncAPI specifications.
+// Event type and version are matched against topics (AsyncAPI 1.x) or channels (AsyncAPI 2.x) named `<event-type>.<event-type-version>`.
+func (v *validator) Validate(event PublishRequest, specs []string) apperrors.AppError {
+	if err := validateRequest(event); err != nil {
+		return err
+	}
+
+	topic := event.EventType + "." + event.EventTypeVersion
+	for _, spec := range specs {
+		doc, ok := parseSpec(spec)
+		if !ok {
+			continue
+		}
+
+		payload, found := findPayloadSchema(doc, topic)
+		if !found {
+			continue
+		}
+
+		return validatePayload(event, payload, doc["components"])
+	}
+
+	return apperrors.WrongInput("event type [%s] in version [%s] is not registered for the Application", event.EventType, event.EventTypeVersion)
+}
+
+func validateRequest(event PublishRequest) apperrors.AppError {
+	if event.EventType == "" {
+		return apperrors.WrongInput("event-type is required")
+	}
+	if !eventTypeRegex.MatchString(event.EventType) {
+		return apperrors.WrongInput("event-type [%s] has invalid format", event.EventType)
+	}
+	if event.EventTypeVersion == "" {
+		return apperrors.WrongInput("event-type-version is required")
+	}
+	if !eventTypeVersionRegex.MatchString(event.EventTypeVersion) {
+		return apperrors.WrongInput("event-type-version [%s] has invalid format", event.EventTypeVersion)
+	}
+	if event.EventID != "" {
+		if _, err := uuid.Parse(event.EventID); err != nil {
+			return apperrors.WrongInput("event-id [%s] is not a valid UUID", event.EventID)
+		}
+	}
+	if event.EventTime == "" {
+		return apperrors.WrongInput("event-time is required")
+	}
+	if _, err := time.Parse(time.RFC3339, event.EventTime); err != nil {
+		return apperrors.WrongInput("event-time [%s] is not in RFC 3339 format", event.EventTime)
+	}
+	if len(event.Data) == 0 || string(event.Data) == "null" {
+		return apperrors.WrongInput("data is required")
+	}
+	return nil
+}
+
+// parseSpec reads both JSON and YAML specifications. Specifications which cannot be parsed are skipped.
+func parseSpec(spec string) (map[string]interface{}, bool) {
+	jsonSpec, err := yaml.YAMLToJSON([]byte(spec))
+	if err != nil {
+		return nil, false
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(jsonSpec, &doc); err != nil {
+		return nil, false
+	}
+
+	return doc, true
+}
+
+func findPayloadSchema(doc map[string]interface{}, topic string) (interface{}, bool) {
+	if topics, ok := doc["topics"].(map[string]interface{}); ok {
+		baseTopic, _ := doc["baseTopic"].(string)
+		for name, item := range topics {
+			if baseTopic != "" {
+				name = baseTopic + "." + name
+			}
+			if name == topic {
+				return operationPayload(item, "payload"), true
+			}
+		}
+	}
+
+	if channels, ok := doc["channels"].(map[string]interface{}); ok {
+		if item, ok := channels[topic]; ok {
+			return operationPayload(item, "message", "payload"), true
+		}
+	}
+
+	return nil, false
+}
+
+func operationPayload(item interface{}, path ...string) interface{} {
+	for _, operation := range []string{"subscribe", "publish"} {
+		current := lookup(item, operation)
+		for _, key := range path {
+			current = lookup(current, key)
+		}
+		if current != nil {
+			return current
+		}
+	}
+	return nil
+}
+
+func lookup(value interface{}, key string) interface{} {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return m[key]
+}
+
+func validatePayload(event PublishRequest, payload, components interface{}) apperrors.AppError {
+	if payload == nil {
+		return nil
+	}
+
+	// references to reusable schemas point to the components of the whole specification
+	schema := map[string]interface{}{
+		"$ref":    "#/payload",
+		"payload": payload,
+	}
+	if components != nil {
+		schema["components"] = components
+	}
+
+	payloadValidator, err := jsonschema.NewValidatorFromRawSchema(schema)
+	if err != nil {
+		// a payload schema which cannot be compiled does not block publishing of a registered event
+		return nil
+	}
+
+	result, err := payloadValidator.ValidateString(string(event.Data))
+	if err != nil {
+		return apperrors.WrongInput("while validating data of event type [%s] in version [%s]: %s", event.EventType, event.EventTypeVersion, err.Error())
+	}
+	if !result.Valid {
+		return apperrors.WrongInput("data does not match the schema of event type [%s] in version [%s]: %s", event.EventType, event.EventTypeVersion, result.Error.Error())
+	}
+
+	return nil
+}
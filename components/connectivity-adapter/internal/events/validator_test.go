@@ -0,0 +1,160 @@
+package events_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/internal/events"
+	"github.com/kyma-incubator/compass/components/connectivity-adapter/pkg/apperrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const asyncAPIV1Spec = `asyncapi: '1.0.0'
+info:
+  title: Orders
+  version: '1.0.0'
+baseTopic: order
+topics:
+  created.v1:
+    subscribe:
+      summary: Order created
+      payload:
+        type: object
+        required:
+          - orderCode
+        properties:
+          orderCode:
+            type: string
+`
+
+const asyncAPIV2Spec = `{
+  "asyncapi": "2.0.0",
+  "info": {"title": "Customers", "version": "1.0.0"},
+  "channels": {
+    "customer.created.v1": {
+      "subscribe": {
+        "message": {
+          "payload": {"$ref": "#/components/schemas/Customer"}
+        }
+      }
+    },
+    "customer.deleted.v1": {
+      "subscribe": {
+        "message": {}
+      }
+    }
+  },
+  "components": {
+    "schemas": {
+      "Customer": {
+        "type": "object",
+        "required": ["id"],
+        "properties": {"id": {"type": "integer"}}
+      }
+    }
+  }
+}`
+
+func TestValidator_Validate(t *testing.T) {
+	specs := []string{"not a spec: [", asyncAPIV1Spec, asyncAPIV2Spec}
+
+	testCases := []struct {
+		Name          string
+		Event         events.PublishRequest
+		ExpectedError string
+	}{
+		{
+			Name:  "Success for AsyncAPI 1.x topic with base topic",
+			Event: fixPublishRequest("order.created", "v1", `{"orderCode": "123"}`),
+		},
+		{
+			Name:  "Success for AsyncAPI 2.x channel with referenced schema",
+			Event: fixPublishRequest("customer.created", "v1", `{"id": 1}`),
+		},
+		{
+			Name:  "Success for channel without payload schema",
+			Event: fixPublishRequest("customer.deleted", "v1", `"anything"`),
+		},
+		{
+			Name:          "Event type not registered",
+			Event:         fixPublishRequest("order.created", "v2", `{"orderCode": "123"}`),
+			ExpectedError: "event type [order.created] in version [v2] is not registered for the Application",
+		},
+		{
+			Name:          "Data not matching AsyncAPI 1.x payload",
+			Event:         fixPublishRequest("order.created", "v1", `{"orderCode": 123}`),
+			ExpectedError: "data does not match the schema of event type [order.created] in version [v1]",
+		},
+		{
+			Name:          "Data not matching referenced AsyncAPI 2.x schema",
+			Event:         fixPublishRequest("customer.created", "v1", `{"name": "John"}`),
+			ExpectedError: "data does not match the schema of event type [customer.created] in version [v1]",
+		},
+		{
+			Name:          "Missing event type",
+			Event:         fixPublishRequest("", "v1", `{}`),
+			ExpectedError: "event-type is required",
+		},
+		{
+			Name:          "Invalid event type",
+			Event:         fixPublishRequest("order created", "v1", `{}`),
+			ExpectedError: "event-type [order created] has invalid format",
+		},
+		{
+			Name:          "Invalid event type version",
+			Event:         fixPublishRequest("order.created", "v.1", `{}`),
+			ExpectedError: "event-type-version [v.1] has invalid format",
+		},
+		{
+			Name: "Invalid event ID",
+			Event: func() events.PublishRequest {
+				event := fixPublishRequest("order.created", "v1", `{}`)
+				event.EventID = "not-uuid"
+				return event
+			}(),
+			ExpectedError: "event-id [not-uuid] is not a valid UUID",
+		},
+		{
+			Name: "Invalid event time",
+			Event: func() events.PublishRequest {
+				event := fixPublishRequest("order.created", "v1", `{}`)
+				event.EventTime = "yesterday"
+				return event
+			}(),
+			ExpectedError: "event-time [yesterday] is not in RFC 3339 format",
+		},
+		{
+			Name:          "Missing data",
+			Event:         fixPublishRequest("order.created", "v1", `null`),
+			ExpectedError: "data is required",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			validator := events.NewValidator()
+			// WHEN
+			err := validator.Validate(testCase.Event, specs)
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Equal(t, apperrors.CodeWrongInput, err.Code())
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func fixPublishRequest(eventType, version, data string) events.PublishRequest {
+	return events.PublishRequest{
+		EventType:        eventType,
+		EventTypeVersion: version,
+		EventID:          "8954ad1c-78ed-4c58-a639-68bd44031de0",
+		EventTime:        "2020-09-28T14:47:16.491Z",
+		Data:             json.RawMessage(data),
+	}
+}
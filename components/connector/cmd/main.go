@@ -10,12 +10,15 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/signal"
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
 
 	"github.com/kyma-incubator/compass/components/connector/config"
 	"github.com/kyma-incubator/compass/components/connector/internal/api"
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
+	"github.com/kyma-incubator/compass/components/connector/internal/metrics"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/vrischmann/envconfig"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
@@ -25,6 +28,8 @@ import (
 	"k8s.io/client-go/util/homedir"
 )
 
+const serviceName = "connector"
+
 func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -36,6 +41,7 @@ func main() {
 	cfg := config.Config{}
 	err := envconfig.InitWithPrefix(&cfg, "APP")
 	exitOnError(err, "Error while loading app Config")
+	exitOnError(cfg.Validate(), "Invalid app Config")
 
 	ctx, err = log.Configure(ctx, &cfg.Log)
 	exitOnError(err, "Filed to configure logger")
@@ -43,39 +49,59 @@ func main() {
 	log.C(ctx).Info("Starting Connector Service")
 	log.C(ctx).Infof("Config: %s", cfg.String())
 
+	shutdownTracing, err := tracing.Configure(ctx, serviceName, cfg.Tracing)
+	exitOnError(err, "Failed to configure tracing")
+
+	defer func() {
+		err := shutdownTracing(context.Background())
+		exitOnError(err, "Failed to shut down tracing")
+	}()
+
 	k8sClientSet, appErr := newK8SClientSet(ctx, cfg.KubernetesClient.PollInteval, cfg.KubernetesClient.PollTimeout, cfg.KubernetesClient.Timeout)
 	exitOnError(appErr, "Failed to initialize Kubernetes client.")
 
-	internalComponents, certsLoader, revokedCertsLoader := config.InitInternalComponents(cfg, k8sClientSet)
+	internalComponents, certsLoader, revokedCertsLoader, err := config.InitInternalComponents(cfg, k8sClientSet)
+	exitOnError(err, "Failed to initialize internal components")
 	go certsLoader.Run(ctx)
 	go revokedCertsLoader.Run(ctx)
 
+	metricsCollector := metrics.NewCollector()
+	prometheus.MustRegister(metricsCollector)
+
 	certificateResolver := api.NewCertificateResolver(
 		internalComponents.Authenticator,
 		internalComponents.TokenService,
 		internalComponents.CertificateService,
 		internalComponents.CSRSubjectConsts,
+		internalComponents.AllowedKeyAlgorithms,
+		cfg.CertificateValidity,
+		cfg.Renewal.Window,
 		cfg.DirectorURL,
 		cfg.CertificateSecuredConnectorURL,
-		internalComponents.RevokedCertsRepository)
+		internalComponents.RevokedCertsRepository,
+		internalComponents.RenewalService,
+		metricsCollector)
 
 	authContextMiddleware := authentication.NewAuthenticationContextMiddleware()
 
-	externalGqlServer, err := config.PrepareExternalGraphQLServer(cfg, certificateResolver, correlation.AttachCorrelationIDToContext(), log.RequestLogger(), authContextMiddleware.PropagateAuthentication)
+	externalGqlServer, err := config.PrepareExternalGraphQLServer(cfg, certificateResolver, tracing.Handler(serviceName), correlation.AttachCorrelationIDToContext(), log.RequestLogger(), authContextMiddleware.PropagateAuthentication)
 	exitOnError(err, "Failed configuring external graphQL handler")
 
-	internalGqlServer, err := config.PrepareInternalGraphQLServer(cfg, api.NewTokenResolver(internalComponents.TokenService), correlation.AttachCorrelationIDToContext(), log.RequestLogger())
+	internalGqlServer, err := config.PrepareInternalGraphQLServer(cfg, api.NewTokenResolver(internalComponents.TokenService, internalComponents.RenewalService), api.NewRevocationResolver(internalComponents.RenewalService), tracing.Handler(serviceName), correlation.AttachCorrelationIDToContext(), log.RequestLogger())
 	exitOnError(err, "Failed configuring internal graphQL handler")
 
-	hydratorServer, err := config.PrepareHydratorServer(cfg, internalComponents.TokenService, internalComponents.CSRSubjectConsts, internalComponents.RevokedCertsRepository, correlation.AttachCorrelationIDToContext(), log.RequestLogger())
+	hydratorServer, err := config.PrepareHydratorServer(cfg, internalComponents.TokenService, internalComponents.CSRSubjectConsts, internalComponents.RevokedCertsRepository, tracing.Handler(serviceName), correlation.AttachCorrelationIDToContext(), log.RequestLogger())
 	exitOnError(err, "Failed configuring hydrator handler")
 
+	metricsServer := config.PrepareMetricsServer(cfg)
+
 	wg := &sync.WaitGroup{}
-	wg.Add(3)
+	wg.Add(4)
 
 	go startServer(ctx, externalGqlServer, wg)
 	go startServer(ctx, internalGqlServer, wg)
 	go startServer(ctx, hydratorServer, wg)
+	go startServer(ctx, metricsServer, wg)
 
 	wg.Wait()
 }
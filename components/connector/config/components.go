@@ -3,11 +3,13 @@ package config
 import (
 	"time"
 
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
 	"github.com/kyma-incubator/compass/components/connector/internal/namespacedname"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
 	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/internal/secrets"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
@@ -20,24 +22,44 @@ type Components struct {
 
 	CertificateService     certificates.Service
 	RevokedCertsRepository revocation.RevokedCertificatesRepository
+	RenewalService         renewal.Service
 
-	CSRSubjectConsts certificates.CSRSubjectConsts
+	CSRSubjectConsts     certificates.CSRSubjectConsts
+	AllowedKeyAlgorithms []certificates.KeyAlgorithm
 }
 
-func InitInternalComponents(cfg Config, k8sClientSet kubernetes.Interface) (Components, certificates.Loader, revocation.Loader) {
+func InitInternalComponents(cfg Config, k8sClientSet kubernetes.Interface) (Components, certificates.Loader, revocation.Loader, error) {
 	caSecret := namespacedname.Parse(cfg.CASecret.Name)
 	rootCASecret := namespacedname.Parse(cfg.RootCASecret.Name)
 
 	certsCache := certificates.NewCertificateCache()
-	certsService := certificates.NewCertificateService(
-		certsCache,
-		certificates.NewCertificateUtility(cfg.CertificateValidityTime),
-		caSecret.Name,
-		rootCASecret.Name,
-		cfg.CASecret.CertificateKey,
-		cfg.CASecret.KeyKey,
-		cfg.RootCASecret.CertificateKey,
-	)
+	certUtil := certificates.NewCertificateUtility(cfg.AllowedKeyAlgorithms)
+
+	var signer certificates.Signer
+	switch cfg.Signer.Type {
+	case SignerTypeRemote:
+		remoteSigner, err := certificates.NewRemoteSigner(cfg.Signer.Remote)
+		if err != nil {
+			return Components{}, nil, nil, errors.Wrap(err, "while creating remote signer")
+		}
+		signer = remoteSigner
+
+		// the CA is not stored in secrets, so there is nothing to load
+		caSecret = types.NamespacedName{}
+		rootCASecret = types.NamespacedName{}
+	default:
+		signer = certificates.NewSecretSigner(
+			certsCache,
+			certUtil,
+			caSecret.Name,
+			rootCASecret.Name,
+			cfg.CASecret.CertificateKey,
+			cfg.CASecret.KeyKey,
+			cfg.RootCASecret.CertificateKey,
+		)
+	}
+
+	certsService := certificates.NewCertificateService(certUtil, signer)
 	certsLoader := certificates.NewCertificateLoader(certsCache, newSecretsRepository(k8sClientSet), caSecret, rootCASecret)
 
 	revokedCertsCache := revocation.NewCache()
@@ -49,6 +71,9 @@ func InitInternalComponents(cfg Config, k8sClientSet kubernetes.Interface) (Comp
 		time.Second,
 	)
 
+	chainsConfigMaps := namespacedname.Parse(cfg.CertificateChainsConfigMapPrefix)
+	chainRepository := renewal.NewChainRepository(k8sClientSet.CoreV1().ConfigMaps(chainsConfigMaps.Namespace), chainsConfigMaps.Name)
+
 	return Components{
 		Authenticator: authentication.NewAuthenticator(),
 		TokenService: tokens.NewTokenService(
@@ -56,8 +81,10 @@ func InitInternalComponents(cfg Config, k8sClientSet kubernetes.Interface) (Comp
 			tokens.NewTokenGenerator(cfg.Token.Length)),
 		CertificateService:     certsService,
 		RevokedCertsRepository: revokedCertsRepository,
+		RenewalService:         renewal.NewService(chainRepository, revokedCertsRepository, cfg.Renewal.MaxChainAge),
 		CSRSubjectConsts:       newCSRSubjectConsts(cfg),
-	}, certsLoader, revokedCertsLoader
+		AllowedKeyAlgorithms:   cfg.AllowedKeyAlgorithms,
+	}, certsLoader, revokedCertsLoader, nil
 }
 
 func newRevokedCertsRepository(k8sClientSet kubernetes.Interface, revokedCertsConfigMap types.NamespacedName, revokedCertsCache revocation.Cache) revocation.RevokedCertificatesRepository {
@@ -4,7 +4,17 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/pkg/errors"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
+)
+
+const (
+	SignerTypeSecret = "Secret"
+	SignerTypeRemote = "Remote"
 )
 
 type Config struct {
@@ -12,8 +22,10 @@ type Config struct {
 	InternalAddress       string `envconfig:"default=127.0.0.1:3001"`
 	APIEndpoint           string `envconfig:"default=/graphql"`
 	PlaygroundAPIEndpoint string `envconfig:"default=/graphql"`
+	MetricsAddress        string `envconfig:"default=127.0.0.1:3003"`
 
-	Log log.Config
+	Log     log.Config
+	Tracing tracing.Config
 
 	HydratorAddress string `envconfig:"default=127.0.0.1:8080"`
 
@@ -26,8 +38,10 @@ type Config struct {
 		Locality           string `envconfig:"default=Locality"`
 		Province           string `envconfig:"default=State"`
 	}
-	CertificateValidityTime time.Duration `envconfig:"default=2160h"`
-	CASecret                struct {
+	CertificateValidity  certificates.ValidityConfig
+	Renewal              renewal.Config
+	AllowedKeyAlgorithms []certificates.KeyAlgorithm `envconfig:"default=rsa2048;ecdsaP256;ecdsaP384;ed25519"`
+	CASecret             struct {
 		Name           string `envconfig:"default=kyma-integration/connector-service-app-ca"`
 		CertificateKey string `envconfig:"default=ca.crt"`
 		KeyKey         string `envconfig:"default=ca.key"`
@@ -36,9 +50,14 @@ type Config struct {
 		Name           string `envconfig:"optional"`
 		CertificateKey string `envconfig:"optional"`
 	}
+	Signer struct {
+		Type   string `envconfig:"default=Secret"`
+		Remote certificates.RemoteSignerConfig
+	}
 
-	CertificateDataHeader   string `envconfig:"default=Certificate-Data"`
-	RevocationConfigMapName string `envconfig:"default=compass-system/revocations-Config"`
+	CertificateDataHeader            string `envconfig:"default=Certificate-Data"`
+	RevocationConfigMapName          string `envconfig:"default=compass-system/revocations-Config"`
+	CertificateChainsConfigMapPrefix string `envconfig:"default=compass-system/certificate-chain"`
 
 	Token struct {
 		Length                int           `envconfig:"default=64"`
@@ -57,24 +76,52 @@ type Config struct {
 }
 
 func (c *Config) String() string {
-	return fmt.Sprintf("ExternalAddress: %s, InternalAddress: %s, APIEndpoint: %s, HydratorAddress: %s, "+
+	return fmt.Sprintf("ExternalAddress: %s, InternalAddress: %s, APIEndpoint: %s, HydratorAddress: %s, MetricsAddress: %s, "+
 		"CSRSubjectCountry: %s, CSRSubjectOrganization: %s, CSRSubjectOrganizationalUnit: %s, "+
 		"CSRSubjectLocality: %s, CSRSubjectProvince: %s, "+
-		"CertificateValidityTime: %s, CASecretName: %s, CASecretCertificateKey: %s, CASecretKeyKey: %s, "+
-		"RootCASecretName: %s, RootCASecretCertificateKey: %s, CertificateDataHeader: %s, "+
+		"CertificateValidityApplication: %s, CertificateValidityRuntime: %s, "+
+		"RenewalWindow: %s, RenewalMaxChainAge: %s, "+
+		"AllowedKeyAlgorithms: %v, CASecretName: %s, CASecretCertificateKey: %s, CASecretKeyKey: %s, "+
+		"RootCASecretName: %s, RootCASecretCertificateKey: %s, SignerType: %s, SignerRemoteURL: %s, CertificateDataHeader: %s, "+
 		"CertificateSecuredConnectorURL: %s, "+
-		"RevocationConfigMapName: %s, "+
+		"RevocationConfigMapName: %s, CertificateChainsConfigMapPrefix: %s, "+
 		"TokenLength: %d, TokenRuntimeExpiration: %s, TokenApplicationExpiration: %s, TokenCSRExpiration: %s, "+
 		"DirectorURL: %s "+
-		"KubernetesClientPollInteval: %s, KubernetesClientPollTimeout: %s",
-		c.ExternalAddress, c.InternalAddress, c.APIEndpoint, c.HydratorAddress,
+		"KubernetesClientPollInteval: %s, KubernetesClientPollTimeout: %s, "+
+		"TracingExporter: %s, TracingSamplingRatio: %v",
+		c.ExternalAddress, c.InternalAddress, c.APIEndpoint, c.HydratorAddress, c.MetricsAddress,
 		c.CSRSubject.Country, c.CSRSubject.Organization, c.CSRSubject.OrganizationalUnit,
 		c.CSRSubject.Locality, c.CSRSubject.Province,
-		c.CertificateValidityTime, c.CASecret.Name, c.CASecret.CertificateKey, c.CASecret.KeyKey,
-		c.RootCASecret.Name, c.RootCASecret.CertificateKey, c.CertificateDataHeader,
+		c.CertificateValidity.Application, c.CertificateValidity.Runtime,
+		c.Renewal.Window, c.Renewal.MaxChainAge,
+		c.AllowedKeyAlgorithms, c.CASecret.Name, c.CASecret.CertificateKey, c.CASecret.KeyKey,
+		c.RootCASecret.Name, c.RootCASecret.CertificateKey, c.Signer.Type, c.Signer.Remote.URL, c.CertificateDataHeader,
 		c.CertificateSecuredConnectorURL,
-		c.RevocationConfigMapName,
+		c.RevocationConfigMapName, c.CertificateChainsConfigMapPrefix,
 		c.Token.Length, c.Token.RuntimeExpiration.String(), c.Token.ApplicationExpiration.String(), c.Token.CSRExpiration.String(),
 		c.DirectorURL,
-		c.KubernetesClient.PollInteval, c.KubernetesClient.PollTimeout)
+		c.KubernetesClient.PollInteval, c.KubernetesClient.PollTimeout,
+		c.Tracing.Exporter, c.Tracing.SamplingRatio)
+}
+
+func (c *Config) Validate() error {
+	switch c.Signer.Type {
+	case SignerTypeSecret:
+	case SignerTypeRemote:
+		if c.Signer.Remote.URL == "" {
+			return errors.New("remote signer requires URL")
+		}
+	default:
+		return fmt.Errorf("unknown signer type %s", c.Signer.Type)
+	}
+
+	if c.Renewal.MaxChainAge < 0 {
+		return errors.New("maximum certificate chain age cannot be negative")
+	}
+
+	if err := c.CertificateValidity.Validate(c.Renewal.Window); err != nil {
+		return err
+	}
+
+	return certificates.ValidateKeyAlgorithms(c.AllowedKeyAlgorithms)
 }
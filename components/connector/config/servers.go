@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	timeouthandler "github.com/kyma-incubator/compass/components/director/pkg/handler"
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
 
 	"github.com/99designs/gqlgen/handler"
 	"github.com/gorilla/mux"
@@ -15,6 +16,7 @@ import (
 	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
 	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/internalschema"
 	"github.com/kyma-incubator/compass/components/connector/pkg/oathkeeper"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 func PrepareExternalGraphQLServer(cfg Config, certResolver api.CertificateResolver, middlewares ...mux.MiddlewareFunc) (*http.Server, error) {
@@ -26,7 +28,7 @@ func PrepareExternalGraphQLServer(cfg Config, certResolver api.CertificateResolv
 
 	externalRouter := mux.NewRouter()
 	externalRouter.HandleFunc("/", handler.Playground("Dataloader", cfg.PlaygroundAPIEndpoint))
-	externalRouter.HandleFunc(cfg.APIEndpoint, handler.GraphQL(externalExecutableSchema))
+	externalRouter.HandleFunc(cfg.APIEndpoint, handler.GraphQL(externalExecutableSchema, handler.ResolverMiddleware(tracing.ResolverMiddleware)))
 	externalRouter.HandleFunc("/healthz", healthz.NewHTTPHandler())
 
 	externalRouter.Use(middlewares...)
@@ -43,16 +45,16 @@ func PrepareExternalGraphQLServer(cfg Config, certResolver api.CertificateResolv
 	}, nil
 }
 
-func PrepareInternalGraphQLServer(cfg Config, tokenResolver api.TokenResolver, middlewares ...mux.MiddlewareFunc) (*http.Server, error) {
+func PrepareInternalGraphQLServer(cfg Config, tokenResolver api.TokenResolver, revocationResolver api.RevocationResolver, middlewares ...mux.MiddlewareFunc) (*http.Server, error) {
 	gqlInternalCfg := internalschema.Config{
-		Resolvers: &api.InternalResolver{TokenResolver: tokenResolver},
+		Resolvers: &api.InternalResolver{TokenResolver: tokenResolver, RevocationResolver: revocationResolver},
 	}
 
 	internalExecutableSchema := internalschema.NewExecutableSchema(gqlInternalCfg)
 
 	internalRouter := mux.NewRouter()
 	internalRouter.HandleFunc("/", handler.Playground("Dataloader", cfg.PlaygroundAPIEndpoint))
-	internalRouter.HandleFunc(cfg.APIEndpoint, handler.GraphQL(internalExecutableSchema))
+	internalRouter.HandleFunc(cfg.APIEndpoint, handler.GraphQL(internalExecutableSchema, handler.ResolverMiddleware(tracing.ResolverMiddleware)))
 
 	internalRouter.Use(middlewares...)
 
@@ -97,3 +99,14 @@ func PrepareHydratorServer(cfg Config, tokenService tokens.Service, subjectConst
 		ReadHeaderTimeout: cfg.ServerTimeout,
 	}, nil
 }
+
+func PrepareMetricsServer(cfg Config) *http.Server {
+	router := http.NewServeMux()
+	router.Handle("/metrics", promhttp.Handler())
+
+	return &http.Server{
+		Addr:              cfg.MetricsAddress,
+		Handler:           router,
+		ReadHeaderTimeout: cfg.ServerTimeout,
+	}
+}
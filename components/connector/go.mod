@@ -14,7 +14,8 @@ require (
 	github.com/matryer/is v1.4.0 // indirect
 	github.com/patrickmn/go-cache v2.1.0+incompatible
 	github.com/pkg/errors v0.9.1
-	github.com/stretchr/testify v1.4.0
+	github.com/prometheus/client_golang v1.6.0
+	github.com/stretchr/testify v1.6.1
 	github.com/vektah/gqlparser v1.3.1
 	github.com/vrischmann/envconfig v1.2.0
 	golang.org/x/text v0.3.4 // indirect
@@ -23,3 +24,5 @@ require (
 	k8s.io/client-go v0.17.3
 	k8s.io/kube-openapi v0.0.0-20200410163147-594e756bea31 // indirect
 )
+
+replace github.com/kyma-incubator/compass/components/director => ../director
@@ -3,12 +3,15 @@ package api
 import (
 	"context"
 	"encoding/base64"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/metrics"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
 	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
@@ -26,9 +29,14 @@ type certificateResolver struct {
 	tokenService                   tokens.Service
 	certificatesService            certificates.Service
 	csrSubjectConsts               certificates.CSRSubjectConsts
+	allowedKeyAlgorithms           []certificates.KeyAlgorithm
+	certificateValidity            certificates.ValidityConfig
+	renewalWindow                  time.Duration
 	directorURL                    string
 	certificateSecuredConnectorURL string
 	revokedCertsRepository         revocation.RevokedCertificatesRepository
+	renewalService                 renewal.Service
+	expiryRecorder                 metrics.CertificateExpiryRecorder
 }
 
 func NewCertificateResolver(
@@ -36,24 +44,34 @@ func NewCertificateResolver(
 	tokenService tokens.Service,
 	certificatesService certificates.Service,
 	csrSubjectConsts certificates.CSRSubjectConsts,
+	allowedKeyAlgorithms []certificates.KeyAlgorithm,
+	certificateValidity certificates.ValidityConfig,
+	renewalWindow time.Duration,
 	directorURL string,
 	certificateSecuredConnectorURL string,
-	revokedCertsRepository revocation.RevokedCertificatesRepository) CertificateResolver {
+	revokedCertsRepository revocation.RevokedCertificatesRepository,
+	renewalService renewal.Service,
+	expiryRecorder metrics.CertificateExpiryRecorder) CertificateResolver {
 	return &certificateResolver{
 		authenticator:                  authenticator,
 		tokenService:                   tokenService,
 		certificatesService:            certificatesService,
 		csrSubjectConsts:               csrSubjectConsts,
+		allowedKeyAlgorithms:           allowedKeyAlgorithms,
+		certificateValidity:            certificateValidity,
+		renewalWindow:                  renewalWindow,
 		directorURL:                    directorURL,
 		certificateSecuredConnectorURL: certificateSecuredConnectorURL,
 		revokedCertsRepository:         revokedCertsRepository,
+		renewalService:                 renewalService,
+		expiryRecorder:                 expiryRecorder,
 	}
 }
 
 func (r *certificateResolver) Configuration(ctx context.Context) (*externalschema.Configuration, error) {
 	log.C(ctx).Debug("Authenticating the call for configuration fetching.")
 
-	clientId, err := r.authenticator.Authenticate(ctx)
+	clientId, consumerType, err := r.authenticate(ctx)
 	if err != nil {
 		log.C(ctx).WithError(err).Error("Failed authentication while fetching the configuration. ")
 		return nil, err
@@ -67,9 +85,20 @@ func (r *certificateResolver) Configuration(ctx context.Context) (*externalschem
 		return nil, errors.Wrap(err, "Failed to create one-time token during fetching configuration process")
 	}
 
+	allowedKeyAlgorithms := make([]string, 0, len(r.allowedKeyAlgorithms))
+	for _, algorithm := range r.allowedKeyAlgorithms {
+		allowedKeyAlgorithms = append(allowedKeyAlgorithms, string(algorithm))
+	}
+
 	csrInfo := &externalschema.CertificateSigningRequestInfo{
-		Subject:      r.csrSubjectConsts.ToString(clientId),
-		KeyAlgorithm: "rsa2048",
+		Subject:              r.csrSubjectConsts.ToString(clientId),
+		KeyAlgorithm:         string(certificates.KeyAlgorithmRSA2048),
+		AllowedKeyAlgorithms: allowedKeyAlgorithms,
+		CertificateValidity:  int(r.certificateValidity.For(consumerType).Seconds()),
+		RenewalWindow:        int(r.renewalWindow.Seconds()),
+	}
+	if len(r.allowedKeyAlgorithms) > 0 {
+		csrInfo.KeyAlgorithm = string(r.allowedKeyAlgorithms[0])
 	}
 
 	log.C(ctx).Infof("Configuration for client with id %s successfully fetched.", clientId)
@@ -87,7 +116,7 @@ func (r *certificateResolver) Configuration(ctx context.Context) (*externalschem
 func (r *certificateResolver) SignCertificateSigningRequest(ctx context.Context, csr string) (*externalschema.CertificationResult, error) {
 	log.C(ctx).Debug("Authenticating the call for signing the Certificate Signing Request.")
 
-	clientId, err := r.authenticator.Authenticate(ctx)
+	clientId, consumerType, err := r.authenticate(ctx)
 	if err != nil {
 		log.C(ctx).WithError(err).Error("Failed authentication during the signing CSR process.")
 		return nil, errors.Wrap(err, "Failed to authenticate with token")
@@ -106,12 +135,19 @@ func (r *certificateResolver) SignCertificateSigningRequest(ctx context.Context,
 		CSRSubjectConsts: r.csrSubjectConsts,
 	}
 
-	encodedCertificates, err := r.certificatesService.SignCSR(ctx, rawCSR, subject)
+	encodedCertificates, err := r.certificatesService.SignCSR(ctx, rawCSR, subject, r.certificateValidity.For(consumerType))
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("Error occurred while signing the CSR with Common Name %s of client with id %s", subject.CommonName, clientId)
 		return nil, errors.Wrap(err, "Error while signing Certificate Signing Request")
 	}
 
+	// the hashes of issued certificates are recorded, so that all certificates of the client can be revoked.
+	// The certificate is already signed, so failing to record it does not fail the issuance.
+	if err := r.renewalService.RecordCertificate(ctx, clientId, consumerType, encodedCertificates.Hash, encodedCertificates.ExpiresAt); err != nil {
+		log.C(ctx).WithError(err).Errorf("Error occurred while recording the certificate issued for client with id %s, the certificate cannot be revoked together with the other certificates of the client", clientId)
+	}
+	r.expiryRecorder.RecordCertificateExpiry(clientId, consumerType, encodedCertificates.ExpiresAt)
+
 	certificationResult := certificates.ToCertificationResult(encodedCertificates)
 
 	log.C(ctx).Infof("Certificate Signing Request with Common Name %s of client with id %s successfully signed.", subject.CommonName, clientId)
@@ -140,6 +176,35 @@ func (r *certificateResolver) RevokeCertificate(ctx context.Context) (bool, erro
 	return true, nil
 }
 
+// authenticate returns the id and the consumer type of the client.
+// Calls authenticated with a certificate are renewals, so they are checked against the renewal policy.
+func (r *certificateResolver) authenticate(ctx context.Context) (string, certificates.ConsumerType, error) {
+	clientId, tokenAuthErr := r.authenticator.AuthenticateToken(ctx)
+	if tokenAuthErr == nil {
+		consumerType, err := r.renewalService.ConsumerType(ctx, clientId)
+		if err != nil {
+			return "", "", err
+		}
+
+		log.C(ctx).Debugf("Client with id %s successfully authenticated with token", clientId)
+		return clientId, consumerType, nil
+	}
+
+	clientId, certificateHash, certAuthErr := r.authenticator.AuthenticateCertificate(ctx)
+	if certAuthErr != nil {
+		return "", "", errors.Errorf("Failed to authenticate request. Token authentication error: %s. Certificate authentication error: %s",
+			tokenAuthErr.Error(), certAuthErr.Error())
+	}
+
+	consumerType, err := r.renewalService.CheckRenewal(ctx, clientId, certificateHash)
+	if err != nil {
+		return "", "", err.Append("Certificate renewal refused")
+	}
+
+	log.C(ctx).Debugf("Client with id %s successfully authenticated with certificate", clientId)
+	return clientId, consumerType, nil
+}
+
 func decodeStringFromBase64(string string) ([]byte, apperrors.AppError) {
 	bytes, err := base64.StdEncoding.DecodeString(string)
 	if err != nil {
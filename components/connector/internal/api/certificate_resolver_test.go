@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
@@ -12,6 +13,8 @@ import (
 	authenticationMocks "github.com/kyma-incubator/compass/components/connector/internal/authentication/mocks"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
 	certificatesMocks "github.com/kyma-incubator/compass/components/connector/internal/certificates/mocks"
+	metricsMocks "github.com/kyma-incubator/compass/components/connector/internal/metrics/mocks"
+	renewalMocks "github.com/kyma-incubator/compass/components/connector/internal/renewal/mocks"
 	revocationMocks "github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	tokensMocks "github.com/kyma-incubator/compass/components/connector/internal/tokens/mocks"
@@ -20,8 +23,9 @@ import (
 )
 
 const (
-	clientId        = "clientId"
-	certificateHash = "somehash"
+	clientId              = "clientId"
+	certificateHash       = "somehash"
+	issuedCertificateHash = "issuedhash"
 )
 
 var (
@@ -37,6 +41,13 @@ var (
 			Province:           "province",
 		},
 	}
+	allowedKeyAlgorithms = []certificates.KeyAlgorithm{certificates.KeyAlgorithmECDSAP256, certificates.KeyAlgorithmRSA2048}
+	certificateValidity  = certificates.ValidityConfig{
+		Application: 90 * 24 * time.Hour,
+		Runtime:     30 * 24 * time.Hour,
+	}
+	renewalWindow           = 7 * 24 * time.Hour
+	certificateExpiry       = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
 	directorURL             = "https://compass-gateway.kyma.local/director/graphql"
 	certSecuredConnectorURL = "https://compass-gateway-mtls.kyma.local/connector/graphql"
 )
@@ -52,17 +63,24 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 			CertificateChain:  certChainBase64,
 			CaCertificate:     caCertificate,
 			ClientCertificate: clientCertificate,
+			ExpiresAt:         certificateExpiry,
+			Hash:              issuedCertificateHash,
 		}
 
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 		authenticator := &authenticationMocks.Authenticator{}
-		authenticator.On("Authenticate", context.TODO()).Return(clientId, nil)
+		authenticator.On("AuthenticateToken", context.TODO()).Return(clientId, nil)
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("ConsumerType", mock.Anything, clientId).Return(certificates.RuntimeConsumer, nil)
+		renewalService.On("RecordCertificate", mock.Anything, clientId, certificates.RuntimeConsumer, issuedCertificateHash, certificateExpiry).Return(nil)
+		expiryRecorder := &metricsMocks.CertificateExpiryRecorder{}
+		expiryRecorder.On("RecordCertificateExpiry", clientId, certificates.RuntimeConsumer, certificateExpiry).Return()
 
 		certService := &certificatesMocks.Service{}
-		certService.On("SignCSR", mock.Anything, decodedCSR, subject).Return(encodedChain, nil)
+		certService.On("SignCSR", mock.Anything, decodedCSR, subject, certificateValidity.Runtime).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, allowedKeyAlgorithms, certificateValidity, renewalWindow, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, expiryRecorder)
 
 		// when
 		certificationResult, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -72,7 +90,61 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		assert.Equal(t, certChainBase64, certificationResult.CertificateChain)
 		assert.Equal(t, caCertificate, certificationResult.CaCertificate)
 		assert.Equal(t, clientCertificate, certificationResult.ClientCertificate)
-		mock.AssertExpectationsForObjects(t, tokenService, authenticator)
+		mock.AssertExpectationsForObjects(t, tokenService, authenticator, renewalService, expiryRecorder)
+	})
+
+	t.Run("should renew client certificate", func(t *testing.T) {
+		// given
+		encodedChain := certificates.EncodedCertificateChain{
+			CertificateChain:  "certChainBase64",
+			CaCertificate:     "caCertificate",
+			ClientCertificate: "clientCertificate",
+			ExpiresAt:         certificateExpiry,
+			Hash:              issuedCertificateHash,
+		}
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateToken", context.TODO()).Return("", errors.New("no token"))
+		authenticator.On("AuthenticateCertificate", context.TODO()).Return(clientId, certificateHash, nil)
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("CheckRenewal", mock.Anything, clientId, certificateHash).Return(certificates.ApplicationConsumer, nil)
+		renewalService.On("RecordCertificate", mock.Anything, clientId, certificates.ApplicationConsumer, issuedCertificateHash, certificateExpiry).Return(nil)
+		expiryRecorder := &metricsMocks.CertificateExpiryRecorder{}
+		expiryRecorder.On("RecordCertificateExpiry", clientId, certificates.ApplicationConsumer, certificateExpiry).Return()
+
+		certService := &certificatesMocks.Service{}
+		certService.On("SignCSR", mock.Anything, decodedCSR, subject, certificateValidity.Application).Return(encodedChain, nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, certService, subject.CSRSubjectConsts, allowedKeyAlgorithms, certificateValidity, renewalWindow, directorURL, certSecuredConnectorURL, nil, renewalService, expiryRecorder)
+
+		// when
+		certificationResult, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "clientCertificate", certificationResult.ClientCertificate)
+		mock.AssertExpectationsForObjects(t, authenticator, renewalService, expiryRecorder, certService)
+	})
+
+	t.Run("should return error when renewal is refused", func(t *testing.T) {
+		// given
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateToken", context.TODO()).Return("", errors.New("no token"))
+		authenticator.On("AuthenticateCertificate", context.TODO()).Return(clientId, certificateHash, nil)
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("CheckRenewal", mock.Anything, clientId, certificateHash).Return(certificates.ConsumerType(""), apperrors.Forbidden("revoked"))
+
+		certService := &certificatesMocks.Service{}
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, certService, subject.CSRSubjectConsts, allowedKeyAlgorithms, certificateValidity, renewalWindow, directorURL, certSecuredConnectorURL, nil, renewalService, nil)
+
+		// when
+		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Certificate renewal refused")
+		mock.AssertExpectationsForObjects(t, authenticator, renewalService, certService)
 	})
 
 	t.Run("should return error when unauthenticated call", func(t *testing.T) {
@@ -90,12 +162,14 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 		authenticator := &authenticationMocks.Authenticator{}
-		authenticator.On("Authenticate", context.TODO()).Return("", fmt.Errorf("error"))
+		authenticator.On("AuthenticateToken", context.TODO()).Return("", fmt.Errorf("error"))
+		authenticator.On("AuthenticateCertificate", context.TODO()).Return("", "", fmt.Errorf("error"))
+		renewalService := &renewalMocks.Service{}
 
 		certService := &certificatesMocks.Service{}
-		certService.On("SignCSR", decodedCSR, subject).Return(encodedChain, nil)
+		certService.On("SignCSR", mock.Anything, decodedCSR, subject, certificateValidity.Runtime).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, allowedKeyAlgorithms, certificateValidity, renewalWindow, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, nil)
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -120,12 +194,14 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 		authenticator := &authenticationMocks.Authenticator{}
-		authenticator.On("Authenticate", context.TODO()).Return(clientId, nil)
+		authenticator.On("AuthenticateToken", context.TODO()).Return(clientId, nil)
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("ConsumerType", mock.Anything, clientId).Return(certificates.RuntimeConsumer, nil)
 
 		certService := &certificatesMocks.Service{}
-		certService.On("SignCSR", decodedCSR, subject).Return(encodedChain, nil)
+		certService.On("SignCSR", mock.Anything, decodedCSR, subject, certificateValidity.Runtime).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, allowedKeyAlgorithms, certificateValidity, renewalWindow, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, nil)
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), "not base 64 csr")
@@ -140,12 +216,14 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 		authenticator := &authenticationMocks.Authenticator{}
-		authenticator.On("Authenticate", context.TODO()).Return(clientId, nil)
+		authenticator.On("AuthenticateToken", context.TODO()).Return(clientId, nil)
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("ConsumerType", mock.Anything, clientId).Return(certificates.RuntimeConsumer, nil)
 
 		certService := &certificatesMocks.Service{}
-		certService.On("SignCSR", mock.Anything, decodedCSR, subject).Return(certificates.EncodedCertificateChain{}, apperrors.Internal("error"))
+		certService.On("SignCSR", mock.Anything, decodedCSR, subject, certificateValidity.Runtime).Return(certificates.EncodedCertificateChain{}, apperrors.Internal("error"))
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, allowedKeyAlgorithms, certificateValidity, renewalWindow, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, nil)
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -154,6 +232,36 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		require.Error(t, err)
 		mock.AssertExpectationsForObjects(t, tokenService, authenticator, certService)
 	})
+
+	t.Run("should issue certificate when failed to record it", func(t *testing.T) {
+		// given
+		encodedChain := certificates.EncodedCertificateChain{
+			ClientCertificate: "clientCertificate",
+			ExpiresAt:         certificateExpiry,
+			Hash:              issuedCertificateHash,
+		}
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateToken", context.TODO()).Return(clientId, nil)
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("ConsumerType", mock.Anything, clientId).Return(certificates.RuntimeConsumer, nil)
+		renewalService.On("RecordCertificate", mock.Anything, clientId, certificates.RuntimeConsumer, issuedCertificateHash, certificateExpiry).Return(apperrors.Internal("error"))
+		expiryRecorder := &metricsMocks.CertificateExpiryRecorder{}
+		expiryRecorder.On("RecordCertificateExpiry", clientId, certificates.RuntimeConsumer, certificateExpiry).Return()
+
+		certService := &certificatesMocks.Service{}
+		certService.On("SignCSR", mock.Anything, decodedCSR, subject, certificateValidity.Runtime).Return(encodedChain, nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, certService, subject.CSRSubjectConsts, allowedKeyAlgorithms, certificateValidity, renewalWindow, directorURL, certSecuredConnectorURL, nil, renewalService, expiryRecorder)
+
+		// when
+		certificationResult, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "clientCertificate", certificationResult.ClientCertificate)
+		mock.AssertExpectationsForObjects(t, authenticator, renewalService, certService, expiryRecorder)
+	})
 }
 
 func TestCertificateResolver_RevokeCertificate(t *testing.T) {
@@ -165,7 +273,7 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 		revokedCertsRepository.On("Insert", certificateHash).Return(nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, allowedKeyAlgorithms, certificateValidity, renewalWindow, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil, nil)
 
 		// when
 		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
@@ -182,7 +290,7 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 		revokedCertsRepository.On("Insert", certificateHash).Return(nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, allowedKeyAlgorithms, certificateValidity, renewalWindow, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil, nil)
 
 		// when
 		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
@@ -199,7 +307,7 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 		revokedCertsRepository.On("Insert", certificateHash).Return(errors.Errorf("error"))
 
-		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, allowedKeyAlgorithms, certificateValidity, renewalWindow, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil, nil)
 
 		// when
 		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
@@ -215,12 +323,14 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 	t.Run("should return configuration", func(t *testing.T) {
 		// given
 		authenticator := &authenticationMocks.Authenticator{}
-		authenticator.On("Authenticate", context.Background()).Return(clientId, nil)
+		authenticator.On("AuthenticateToken", context.Background()).Return(clientId, nil)
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("ConsumerType", mock.Anything, clientId).Return(certificates.RuntimeConsumer, nil)
 		tokenService := &tokensMocks.Service{}
 		tokenService.On("CreateToken", mock.Anything, subject.CommonName, tokens.CSRToken).Return(token, nil)
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, allowedKeyAlgorithms, certificateValidity, renewalWindow, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, nil)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(context.Background())
@@ -231,19 +341,24 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		assert.Equal(t, &directorURL, configurationResult.ManagementPlaneInfo.DirectorURL)
 		assert.Equal(t, &certSecuredConnectorURL, configurationResult.ManagementPlaneInfo.CertificateSecuredConnectorURL)
 		assert.Equal(t, expectedSubject(subject.CSRSubjectConsts, subject.CommonName), configurationResult.CertificateSigningRequestInfo.Subject)
-		assert.Equal(t, "rsa2048", configurationResult.CertificateSigningRequestInfo.KeyAlgorithm)
+		assert.Equal(t, "ecdsaP256", configurationResult.CertificateSigningRequestInfo.KeyAlgorithm)
+		assert.Equal(t, []string{"ecdsaP256", "rsa2048"}, configurationResult.CertificateSigningRequestInfo.AllowedKeyAlgorithms)
+		assert.Equal(t, 30*24*60*60, configurationResult.CertificateSigningRequestInfo.CertificateValidity)
+		assert.Equal(t, 7*24*60*60, configurationResult.CertificateSigningRequestInfo.RenewalWindow)
 		mock.AssertExpectationsForObjects(t, tokenService, authenticator)
 	})
 
 	t.Run("should return error when failed to generate token", func(t *testing.T) {
 		// given
 		authenticator := &authenticationMocks.Authenticator{}
-		authenticator.On("Authenticate", context.Background()).Return(clientId, nil)
+		authenticator.On("AuthenticateToken", context.Background()).Return(clientId, nil)
+		renewalService := &renewalMocks.Service{}
+		renewalService.On("ConsumerType", mock.Anything, clientId).Return(certificates.RuntimeConsumer, nil)
 		tokenService := &tokensMocks.Service{}
 		tokenService.On("CreateToken", mock.Anything, subject.CommonName, tokens.CSRToken).Return("", apperrors.Internal("error"))
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, allowedKeyAlgorithms, certificateValidity, renewalWindow, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, nil)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(context.Background())
@@ -257,11 +372,13 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 	t.Run("should return error when failed to authenticate", func(t *testing.T) {
 		// given
 		authenticator := &authenticationMocks.Authenticator{}
-		authenticator.On("Authenticate", context.Background()).Return("", apperrors.Forbidden("Error"))
+		authenticator.On("AuthenticateToken", context.Background()).Return("", apperrors.Forbidden("Error"))
+		authenticator.On("AuthenticateCertificate", context.Background()).Return("", "", apperrors.Forbidden("Error"))
+		renewalService := &renewalMocks.Service{}
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, allowedKeyAlgorithms, certificateValidity, renewalWindow, directorURL, certSecuredConnectorURL, revokedCertsRepository, renewalService, nil)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(context.Background())
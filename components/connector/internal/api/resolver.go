@@ -26,6 +26,7 @@ func (r *ExternalResolver) Query() externalschema.QueryResolver {
 
 type InternalResolver struct {
 	TokenResolver
+	RevocationResolver
 }
 
 type internalMutationResolver struct {
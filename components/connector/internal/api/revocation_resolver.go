@@ -0,0 +1,40 @@
+package api
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
+	"github.com/pkg/errors"
+)
+
+type RevocationResolver interface {
+	RevokeCertificates(ctx context.Context, authIDs []string) (bool, error)
+}
+
+type revocationResolver struct {
+	renewalService renewal.Service
+}
+
+func NewRevocationResolver(renewalService renewal.Service) RevocationResolver {
+	return &revocationResolver{
+		renewalService: renewalService,
+	}
+}
+
+// RevokeCertificates revokes all certificates issued for the clients, so that the clients cannot access Compass after their System Auths are deleted
+func (r *revocationResolver) RevokeCertificates(ctx context.Context, authIDs []string) (bool, error) {
+	for _, authID := range authIDs {
+		log.C(ctx).Infof("Revoking certificates of client with id %s", authID)
+
+		if err := r.renewalService.RevokeCertificates(ctx, authID); err != nil {
+			log.C(ctx).WithError(err).Errorf("Error occurred while revoking certificates of client with id %s", authID)
+			return false, errors.Wrapf(err, "Failed to revoke certificates of client with id %s", authID)
+		}
+
+		log.C(ctx).Infof("Certificates of client with id %s successfully revoked.", authID)
+	}
+
+	return true, nil
+}
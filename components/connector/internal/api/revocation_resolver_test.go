@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	renewalMocks "github.com/kyma-incubator/compass/components/connector/internal/renewal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevocationResolver_RevokeCertificates(t *testing.T) {
+
+	t.Run("should revoke certificates of all clients", func(t *testing.T) {
+		// given
+		renewalSvc := &renewalMocks.Service{}
+		renewalSvc.On("RevokeCertificates", mock.Anything, appAuthId).Return(nil)
+		renewalSvc.On("RevokeCertificates", mock.Anything, runtimeAuthId).Return(nil)
+
+		revocationResolver := NewRevocationResolver(renewalSvc)
+
+		// when
+		revoked, err := revocationResolver.RevokeCertificates(context.Background(), []string{appAuthId, runtimeAuthId})
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, revoked)
+		mock.AssertExpectationsForObjects(t, renewalSvc)
+	})
+
+	t.Run("should return error when failed to revoke certificates", func(t *testing.T) {
+		// given
+		renewalSvc := &renewalMocks.Service{}
+		renewalSvc.On("RevokeCertificates", mock.Anything, appAuthId).Return(apperrors.Internal("error"))
+
+		revocationResolver := NewRevocationResolver(renewalSvc)
+
+		// when
+		revoked, err := revocationResolver.RevokeCertificates(context.Background(), []string{appAuthId, runtimeAuthId})
+
+		// then
+		require.Error(t, err)
+		assert.False(t, revoked)
+		mock.AssertExpectationsForObjects(t, renewalSvc)
+	})
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
 	"github.com/pkg/errors"
@@ -17,38 +19,41 @@ type TokenResolver interface {
 }
 
 type tokenResolver struct {
-	tokenService tokens.Service
+	tokenService   tokens.Service
+	renewalService renewal.Service
 }
 
-func NewTokenResolver(tokenService tokens.Service) TokenResolver {
+func NewTokenResolver(tokenService tokens.Service, renewalService renewal.Service) TokenResolver {
 	return &tokenResolver{
-		tokenService: tokenService,
+		tokenService:   tokenService,
+		renewalService: renewalService,
 	}
 }
 
 func (r *tokenResolver) GenerateApplicationToken(ctx context.Context, authID string) (*externalschema.Token, error) {
-	log.C(ctx).Infof("Generating one-time token for Application with authID %s", authID)
-
-	token, err := r.tokenService.CreateToken(ctx, authID, tokens.ApplicationToken)
-	if err != nil {
-		log.C(ctx).WithError(err).Errorf("Error occurred while creating one-time token for Application with authID %s", authID)
-		return &externalschema.Token{}, errors.Wrap(err, "Failed to create one-time token for Application")
-	}
-
-	log.C(ctx).Infof("One-time token generated successfully for Application with authID %s", authID)
-	return &externalschema.Token{Token: token}, nil
+	return r.generateToken(ctx, authID, tokens.ApplicationToken, certificates.ApplicationConsumer)
 }
 
 func (r *tokenResolver) GenerateRuntimeToken(ctx context.Context, authID string) (*externalschema.Token, error) {
-	log.C(ctx).Infof("Generating one-time token for Runtime with authID %s", authID)
+	return r.generateToken(ctx, authID, tokens.RuntimeToken, certificates.RuntimeConsumer)
+}
+
+func (r *tokenResolver) generateToken(ctx context.Context, authID string, tokenType tokens.TokenType, consumerType certificates.ConsumerType) (*externalschema.Token, error) {
+	log.C(ctx).Infof("Generating one-time token for %s with authID %s", consumerType, authID)
+
+	// pairing with a one-time token starts a new certificate chain for the client
+	if err := r.renewalService.StartChain(ctx, authID, consumerType); err != nil {
+		log.C(ctx).WithError(err).Errorf("Error occurred while starting certificate chain for %s with authID %s", consumerType, authID)
+		return &externalschema.Token{}, errors.Wrapf(err, "Failed to start certificate chain for %s", consumerType)
+	}
 
-	token, err := r.tokenService.CreateToken(ctx, authID, tokens.RuntimeToken)
+	token, err := r.tokenService.CreateToken(ctx, authID, tokenType)
 	if err != nil {
-		log.C(ctx).WithError(err).Errorf("Error occurred while creating one-time token for Runtime with authID %s", authID)
-		return &externalschema.Token{}, errors.Wrap(err, "Failed to create one-time token for Runtime")
+		log.C(ctx).WithError(err).Errorf("Error occurred while creating one-time token for %s with authID %s", consumerType, authID)
+		return &externalschema.Token{}, errors.Wrapf(err, "Failed to create one-time token for %s", consumerType)
 	}
 
-	log.C(ctx).Infof("One-time token generated successfully for Runtime with authID %s", authID)
+	log.C(ctx).Infof("One-time token generated successfully for %s with authID %s", consumerType, authID)
 	return &externalschema.Token{Token: token}, nil
 }
 
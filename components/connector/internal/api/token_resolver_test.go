@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	renewalMocks "github.com/kyma-incubator/compass/components/connector/internal/renewal/mocks"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens/mocks"
 	"github.com/stretchr/testify/assert"
@@ -25,8 +27,10 @@ func TestTokenResolver_GenerateApplicationToken(t *testing.T) {
 		// given
 		tokenSvc := &mocks.Service{}
 		tokenSvc.On("CreateToken", mock.Anything, appAuthId, tokens.ApplicationToken).Return(token, nil)
+		renewalSvc := &renewalMocks.Service{}
+		renewalSvc.On("StartChain", mock.Anything, appAuthId, certificates.ApplicationConsumer).Return(nil)
 
-		tokenResolver := NewTokenResolver(tokenSvc)
+		tokenResolver := NewTokenResolver(tokenSvc, renewalSvc)
 
 		// when
 		generatedToken, err := tokenResolver.GenerateApplicationToken(context.Background(), appAuthId)
@@ -34,15 +38,17 @@ func TestTokenResolver_GenerateApplicationToken(t *testing.T) {
 		// then
 		require.NoError(t, err)
 		assert.Equal(t, token, generatedToken.Token)
-		mock.AssertExpectationsForObjects(t, tokenSvc)
+		mock.AssertExpectationsForObjects(t, tokenSvc, renewalSvc)
 	})
 
 	t.Run("should return error when failed generate Application token", func(t *testing.T) {
 		// given
 		tokenSvc := &mocks.Service{}
 		tokenSvc.On("CreateToken", mock.Anything, appAuthId, tokens.ApplicationToken).Return("", apperrors.Internal("error"))
+		renewalSvc := &renewalMocks.Service{}
+		renewalSvc.On("StartChain", mock.Anything, appAuthId, certificates.ApplicationConsumer).Return(nil)
 
-		tokenResolver := NewTokenResolver(tokenSvc)
+		tokenResolver := NewTokenResolver(tokenSvc, renewalSvc)
 
 		// when
 		generatedToken, err := tokenResolver.GenerateApplicationToken(context.Background(), appAuthId)
@@ -50,7 +56,7 @@ func TestTokenResolver_GenerateApplicationToken(t *testing.T) {
 		// then
 		require.Error(t, err)
 		assert.Empty(t, generatedToken)
-		mock.AssertExpectationsForObjects(t, tokenSvc)
+		mock.AssertExpectationsForObjects(t, tokenSvc, renewalSvc)
 	})
 
 }
@@ -61,8 +67,10 @@ func TestTokenResolver_GenerateRuntimeToken(t *testing.T) {
 		// given
 		tokenSvc := &mocks.Service{}
 		tokenSvc.On("CreateToken", mock.Anything, runtimeAuthId, tokens.RuntimeToken).Return(token, nil)
+		renewalSvc := &renewalMocks.Service{}
+		renewalSvc.On("StartChain", mock.Anything, runtimeAuthId, certificates.RuntimeConsumer).Return(nil)
 
-		tokenResolver := NewTokenResolver(tokenSvc)
+		tokenResolver := NewTokenResolver(tokenSvc, renewalSvc)
 
 		// when
 		generatedToken, err := tokenResolver.GenerateRuntimeToken(context.Background(), runtimeAuthId)
@@ -70,15 +78,17 @@ func TestTokenResolver_GenerateRuntimeToken(t *testing.T) {
 		// then
 		require.NoError(t, err)
 		assert.Equal(t, token, generatedToken.Token)
-		mock.AssertExpectationsForObjects(t, tokenSvc)
+		mock.AssertExpectationsForObjects(t, tokenSvc, renewalSvc)
 	})
 
 	t.Run("should return error when failed generate Runtime token", func(t *testing.T) {
 		// given
 		tokenSvc := &mocks.Service{}
 		tokenSvc.On("CreateToken", mock.Anything, runtimeAuthId, tokens.RuntimeToken).Return("", apperrors.Internal("error"))
+		renewalSvc := &renewalMocks.Service{}
+		renewalSvc.On("StartChain", mock.Anything, runtimeAuthId, certificates.RuntimeConsumer).Return(nil)
 
-		tokenResolver := NewTokenResolver(tokenSvc)
+		tokenResolver := NewTokenResolver(tokenSvc, renewalSvc)
 
 		// when
 		generatedToken, err := tokenResolver.GenerateRuntimeToken(context.Background(), runtimeAuthId)
@@ -86,6 +96,6 @@ func TestTokenResolver_GenerateRuntimeToken(t *testing.T) {
 		// then
 		require.Error(t, err)
 		assert.Empty(t, generatedToken)
-		mock.AssertExpectationsForObjects(t, tokenSvc)
+		mock.AssertExpectationsForObjects(t, tokenSvc, renewalSvc)
 	})
 }
@@ -1,8 +1,8 @@
 package certificates
 
 import (
+	"crypto"
 	"crypto/rand"
-	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
 	"math/big"
@@ -14,20 +14,21 @@ import (
 //go:generate mockery -name=CertificateUtility
 type CertificateUtility interface {
 	LoadCert(encodedData []byte) (*x509.Certificate, apperrors.AppError)
-	LoadKey(encodedData []byte) (*rsa.PrivateKey, apperrors.AppError)
+	LoadKey(encodedData []byte) (crypto.Signer, apperrors.AppError)
 	LoadCSR(encodedData []byte) (*x509.CertificateRequest, apperrors.AppError)
 	CheckCSRValues(csr *x509.CertificateRequest, subject CSRSubject) apperrors.AppError
-	SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError)
+	CheckCSRKeyAlgorithm(csr *x509.CertificateRequest) apperrors.AppError
+	SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey crypto.Signer, validity time.Duration) ([]byte, apperrors.AppError)
 	AddCertificateHeaderAndFooter(crtRaw []byte) []byte
 }
 
 type certificateUtility struct {
-	certificateValidityTime time.Duration
+	allowedKeyAlgorithms []KeyAlgorithm
 }
 
-func NewCertificateUtility(certificateValidityTime time.Duration) CertificateUtility {
+func NewCertificateUtility(allowedKeyAlgorithms []KeyAlgorithm) CertificateUtility {
 	return &certificateUtility{
-		certificateValidityTime: certificateValidityTime,
+		allowedKeyAlgorithms: allowedKeyAlgorithms,
 	}
 }
 
@@ -45,7 +46,7 @@ func (cu *certificateUtility) LoadCert(encodedData []byte) (*x509.Certificate, a
 	return caCRT, nil
 }
 
-func (cu *certificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, apperrors.AppError) {
+func (cu *certificateUtility) LoadKey(encodedData []byte) (crypto.Signer, apperrors.AppError) {
 	pemBlock, _ := pem.Decode(encodedData)
 	if pemBlock == nil {
 		return nil, apperrors.Internal("Error while decoding pem block.")
@@ -55,12 +56,21 @@ func (cu *certificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, appe
 		return caPrivateKey, nil
 	}
 
+	if caPrivateKey, err := x509.ParseECPrivateKey(pemBlock.Bytes); err == nil {
+		return caPrivateKey, nil
+	}
+
 	caPrivateKey, err := x509.ParsePKCS8PrivateKey(pemBlock.Bytes)
 	if err != nil {
 		return nil, apperrors.Internal("Error while parsing private key: %s", err)
 	}
 
-	return caPrivateKey.(*rsa.PrivateKey), nil
+	signer, ok := caPrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, apperrors.Internal("Private key of type %T cannot be used for signing", caPrivateKey)
+	}
+
+	return signer, nil
 }
 
 func (cu *certificateUtility) LoadCSR(encodedData []byte) (*x509.CertificateRequest, apperrors.AppError) {
@@ -119,8 +129,23 @@ func (cu *certificateUtility) CheckCSRValues(csr *x509.CertificateRequest, subje
 	return nil
 }
 
-func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError) {
-	clientCRTTemplate := cu.prepareCRTTemplate(csr)
+func (cu *certificateUtility) CheckCSRKeyAlgorithm(csr *x509.CertificateRequest) apperrors.AppError {
+	algorithm, err := keyAlgorithmOf(csr.PublicKey)
+	if err != nil {
+		return apperrors.WrongInput("CSR: %s.", err)
+	}
+
+	for _, allowed := range cu.allowedKeyAlgorithms {
+		if algorithm == allowed {
+			return nil
+		}
+	}
+
+	return apperrors.WrongInput("CSR: Key algorithm %s is not allowed.", algorithm)
+}
+
+func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey crypto.Signer, validity time.Duration) ([]byte, apperrors.AppError) {
+	clientCRTTemplate := cu.prepareCRTTemplate(csr, caKey, validity)
 
 	clientCrtRaw, err := x509.CreateCertificate(rand.Reader, &clientCRTTemplate, caCrt, csr.PublicKey, caKey)
 	if err != nil {
@@ -130,14 +155,21 @@ func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.Certifi
 	return clientCrtRaw, nil
 }
 
-func (cu *certificateUtility) prepareCRTTemplate(csr *x509.CertificateRequest) x509.Certificate {
+func (cu *certificateUtility) prepareCRTTemplate(csr *x509.CertificateRequest, caKey crypto.Signer, validity time.Duration) x509.Certificate {
+	// The signature algorithm of the CSR can be reused only if the CA key is of the same type,
+	// otherwise the default algorithm for the CA key is chosen
+	signatureAlgorithm := x509.UnknownSignatureAlgorithm
+	if csr.PublicKeyAlgorithm == publicKeyAlgorithmOf(caKey.Public()) {
+		signatureAlgorithm = csr.SignatureAlgorithm
+	}
+
 	return x509.Certificate{
-		SignatureAlgorithm: csr.SignatureAlgorithm,
+		SignatureAlgorithm: signatureAlgorithm,
 
 		SerialNumber: big.NewInt(2),
 		Subject:      csr.Subject,
 		NotBefore:    time.Now(),
-		NotAfter:     time.Now().Add(cu.certificateValidityTime),
+		NotAfter:     time.Now().Add(validity),
 		KeyUsage:     x509.KeyUsageDigitalSignature,
 		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
 	}
@@ -1,10 +1,16 @@
 package certificates
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"math/big"
 	"testing"
 	"time"
 
@@ -223,13 +229,15 @@ var (
 	encodedCert        = []byte(cert)
 	encodedInvalidCert = []byte(invalidCert)
 	encodedInvalidKey  = []byte(invalidKey)
+
+	allowedKeyAlgorithms = []KeyAlgorithm{KeyAlgorithmRSA2048, KeyAlgorithmECDSAP256, KeyAlgorithmEd25519}
 )
 
 func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should load cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		crt, err := certificateUtility.LoadCert(encodedCert)
@@ -248,7 +256,7 @@ func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should fail decoding cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		crt, err := certificateUtility.LoadCert([]byte("invalid data"))
@@ -261,7 +269,7 @@ func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should fail parsing cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		crt, err := certificateUtility.LoadCert(encodedInvalidCert)
@@ -277,7 +285,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should load RSA key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		key, err := certificateUtility.LoadKey(encodedRSAKey)
@@ -289,7 +297,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should load key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		key, err := certificateUtility.LoadKey(encodedKey)
@@ -299,9 +307,41 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 		assert.NotNil(t, key)
 	})
 
+	t.Run("should load EC key", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		der, err := x509.MarshalECPrivateKey(ecKey)
+		require.NoError(t, err)
+
+		// when
+		key, apperr := certificateUtility.LoadKey(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+
+		// then
+		require.NoError(t, apperr)
+		assert.Equal(t, ecKey, key)
+	})
+
+	t.Run("should load Ed25519 key", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
+		_, edKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		der, err := x509.MarshalPKCS8PrivateKey(edKey)
+		require.NoError(t, err)
+
+		// when
+		key, apperr := certificateUtility.LoadKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+		// then
+		require.NoError(t, apperr)
+		assert.Equal(t, edKey, key)
+	})
+
 	t.Run("should fail decoding key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		crt, err := certificateUtility.LoadKey([]byte("invalid data"))
@@ -314,7 +354,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should fail parsing key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		crt, err := certificateUtility.LoadKey(encodedInvalidKey)
@@ -330,7 +370,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should load CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		key, err := certificateUtility.LoadCSR([]byte(CSR))
@@ -342,7 +382,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should fail decoding CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		crt, err := certificateUtility.LoadCSR([]byte("aW52YWxpZCBkYXRh"))
@@ -355,7 +395,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should fail parsing CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		crt, err := certificateUtility.LoadCSR([]byte(invalidCSR))
@@ -393,7 +433,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -414,7 +454,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -438,7 +478,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -462,7 +502,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -487,7 +527,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -511,7 +551,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -535,7 +575,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -559,7 +599,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -571,15 +611,63 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 	})
 }
 
+func TestCertificateUtility_CheckCSRKeyAlgorithm(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	shortRSAKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	p256Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	p224Key, err := ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		key           crypto.Signer
+		expectedError string
+	}{
+		{name: "should accept RSA key", key: rsaKey},
+		{name: "should accept ECDSA P-256 key", key: p256Key},
+		{name: "should accept Ed25519 key", key: edKey},
+		{name: "should reject not allowed ECDSA P-384 key", key: p384Key, expectedError: "CSR: Key algorithm ecdsaP384 is not allowed."},
+		{name: "should reject too short RSA key", key: shortRSAKey, expectedError: "CSR: RSA key size 1024 is smaller than 2048 bits."},
+		{name: "should reject unsupported elliptic curve", key: p224Key, expectedError: "CSR: elliptic curve P-224 is not supported."},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			// given
+			certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
+			csr := prepareCSR(t, testCase.key)
+
+			// when
+			apperr := certificateUtility.CheckCSRKeyAlgorithm(csr)
+
+			// then
+			if testCase.expectedError == "" {
+				require.NoError(t, apperr)
+			} else {
+				require.Error(t, apperr)
+				assert.Equal(t, apperrors.CodeWrongInput, apperr.Code())
+				assert.Equal(t, testCase.expectedError, apperr.Error())
+			}
+		})
+	}
+}
+
 func TestCertificateUtility_SignCSR(t *testing.T) {
 
 	t.Run("should sign client certificate", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 		caCrt, csr, key := prepareCrtAndKey(certificateUtility)
 
 		// when
-		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key)
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key, validityTime)
 
 		//then
 		require.NoError(t, apperr)
@@ -592,16 +680,60 @@ func TestCertificateUtility_SignCSR(t *testing.T) {
 		assert.Equal(t, validityTime, certificateValidityTime)
 	})
 
+	t.Run("should sign client certificate with EC key using RSA CA key", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
+		caCrt, _, caKey := prepareCrtAndKey(certificateUtility)
+		clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		csr := prepareCSR(t, clientKey)
+
+		// when
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, caKey, validityTime)
+
+		// then
+		require.NoError(t, apperr)
+
+		decodedCrt, err := x509.ParseCertificate(rawClientCRT)
+		require.NoError(t, err)
+		assert.Equal(t, x509.ECDSA, decodedCrt.PublicKeyAlgorithm)
+		assert.Equal(t, x509.SHA256WithRSA, decodedCrt.SignatureAlgorithm)
+		assert.NoError(t, decodedCrt.CheckSignatureFrom(caCrt))
+	})
+
+	t.Run("should sign client certificate with Ed25519 key using EC CA key", func(t *testing.T) {
+		// given
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
+		caKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		require.NoError(t, err)
+		caCrt := prepareCACrt(t, caKey)
+		_, clientKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		csr := prepareCSR(t, clientKey)
+
+		// when
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, caKey, validityTime)
+
+		// then
+		require.NoError(t, apperr)
+
+		decodedCrt, err := x509.ParseCertificate(rawClientCRT)
+		require.NoError(t, err)
+		assert.Equal(t, x509.Ed25519, decodedCrt.PublicKeyAlgorithm)
+		assert.Equal(t, x509.ECDSAWithSHA384, decodedCrt.SignatureAlgorithm)
+		assert.NoError(t, decodedCrt.CheckSignatureFrom(caCrt))
+	})
+
 	t.Run("should return when failed to create certificate", func(t *testing.T) {
 		// given
 		caCrt := &x509.Certificate{}
 		csr := &x509.CertificateRequest{}
 		key := &rsa.PrivateKey{}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 
 		// when
-		rawClientCRT, err := certificateUtility.SignCSR(caCrt, csr, key)
+		rawClientCRT, err := certificateUtility.SignCSR(caCrt, csr, key, validityTime)
 
 		// then
 		require.Error(t, err)
@@ -615,7 +747,7 @@ func TestCertificateUtility_AddCertificateHeaderAndFooter(t *testing.T) {
 
 	t.Run("should add certificate header and footer", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(allowedKeyAlgorithms)
 		certificate, apperr := certificateUtility.LoadCert([]byte(cert))
 		require.NoError(t, apperr)
 
@@ -645,7 +777,7 @@ func calculateValidityTime(certificate *x509.Certificate) time.Duration {
 	return difference
 }
 
-func prepareCrtAndKey(certificateUtility CertificateUtility) (*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey) {
+func prepareCrtAndKey(certificateUtility CertificateUtility) (*x509.Certificate, *x509.CertificateRequest, crypto.Signer) {
 	caCrt, err := certificateUtility.LoadCert(encodedCert)
 	if err != nil {
 	}
@@ -657,3 +789,33 @@ func prepareCrtAndKey(certificateUtility CertificateUtility) (*x509.Certificate,
 	}
 	return caCrt, csr, key
 }
+
+func prepareCSR(t *testing.T, key crypto.Signer) *x509.CertificateRequest {
+	rawCSR, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: commonName},
+	}, key)
+	require.NoError(t, err)
+
+	csr, err := x509.ParseCertificateRequest(rawCSR)
+	require.NoError(t, err)
+	return csr
+}
+
+func prepareCACrt(t *testing.T, key crypto.Signer) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(validityTime),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	rawCrt, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+
+	crt, err := x509.ParseCertificate(rawCrt)
+	require.NoError(t, err)
+	return crt
+}
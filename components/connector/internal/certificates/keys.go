@@ -0,0 +1,76 @@
+package certificates
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+type KeyAlgorithm string
+
+const (
+	KeyAlgorithmRSA2048   KeyAlgorithm = "rsa2048"
+	KeyAlgorithmECDSAP256 KeyAlgorithm = "ecdsaP256"
+	KeyAlgorithmECDSAP384 KeyAlgorithm = "ecdsaP384"
+	KeyAlgorithmEd25519   KeyAlgorithm = "ed25519"
+)
+
+const minRSAKeySize = 2048
+
+// ValidateKeyAlgorithms checks if at least one algorithm is given and all of them are supported
+func ValidateKeyAlgorithms(algorithms []KeyAlgorithm) error {
+	if len(algorithms) == 0 {
+		return errors.New("at least one key algorithm has to be allowed")
+	}
+
+	for _, algorithm := range algorithms {
+		switch algorithm {
+		case KeyAlgorithmRSA2048, KeyAlgorithmECDSAP256, KeyAlgorithmECDSAP384, KeyAlgorithmEd25519:
+		default:
+			return fmt.Errorf("unsupported key algorithm %s", algorithm)
+		}
+	}
+
+	return nil
+}
+
+func keyAlgorithmOf(publicKey interface{}) (KeyAlgorithm, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		if key.N.BitLen() < minRSAKeySize {
+			return "", fmt.Errorf("RSA key size %d is smaller than %d bits", key.N.BitLen(), minRSAKeySize)
+		}
+		return KeyAlgorithmRSA2048, nil
+	case *ecdsa.PublicKey:
+		switch key.Curve {
+		case elliptic.P256():
+			return KeyAlgorithmECDSAP256, nil
+		case elliptic.P384():
+			return KeyAlgorithmECDSAP384, nil
+		default:
+			return "", fmt.Errorf("elliptic curve %s is not supported", key.Curve.Params().Name)
+		}
+	case ed25519.PublicKey:
+		return KeyAlgorithmEd25519, nil
+	default:
+		return "", fmt.Errorf("public key of type %T is not supported", publicKey)
+	}
+}
+
+func publicKeyAlgorithmOf(publicKey interface{}) x509.PublicKeyAlgorithm {
+	switch publicKey.(type) {
+	case *rsa.PublicKey:
+		return x509.RSA
+	case *ecdsa.PublicKey:
+		return x509.ECDSA
+	case ed25519.PublicKey:
+		return x509.Ed25519
+	default:
+		return x509.UnknownPublicKeyAlgorithm
+	}
+}
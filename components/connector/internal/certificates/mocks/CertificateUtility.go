@@ -6,9 +6,11 @@ import (
 	apperrors "github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	certificates "github.com/kyma-incubator/compass/components/connector/internal/certificates"
 
+	crypto "crypto"
+
 	mock "github.com/stretchr/testify/mock"
 
-	rsa "crypto/rsa"
+	time "time"
 
 	x509 "crypto/x509"
 )
@@ -34,6 +36,22 @@ func (_m *CertificateUtility) AddCertificateHeaderAndFooter(crtRaw []byte) []byt
 	return r0
 }
 
+// CheckCSRKeyAlgorithm provides a mock function with given fields: csr
+func (_m *CertificateUtility) CheckCSRKeyAlgorithm(csr *x509.CertificateRequest) apperrors.AppError {
+	ret := _m.Called(csr)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(*x509.CertificateRequest) apperrors.AppError); ok {
+		r0 = rf(csr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // CheckCSRValues provides a mock function with given fields: csr, subject
 func (_m *CertificateUtility) CheckCSRValues(csr *x509.CertificateRequest, subject certificates.CSRSubject) apperrors.AppError {
 	ret := _m.Called(csr, subject)
@@ -101,15 +119,15 @@ func (_m *CertificateUtility) LoadCert(encodedData []byte) (*x509.Certificate, a
 }
 
 // LoadKey provides a mock function with given fields: encodedData
-func (_m *CertificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, apperrors.AppError) {
+func (_m *CertificateUtility) LoadKey(encodedData []byte) (crypto.Signer, apperrors.AppError) {
 	ret := _m.Called(encodedData)
 
-	var r0 *rsa.PrivateKey
-	if rf, ok := ret.Get(0).(func([]byte) *rsa.PrivateKey); ok {
+	var r0 crypto.Signer
+	if rf, ok := ret.Get(0).(func([]byte) crypto.Signer); ok {
 		r0 = rf(encodedData)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*rsa.PrivateKey)
+			r0 = ret.Get(0).(crypto.Signer)
 		}
 	}
 
@@ -125,13 +143,13 @@ func (_m *CertificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, appe
 	return r0, r1
 }
 
-// SignCSR provides a mock function with given fields: caCrt, csr, caKey
-func (_m *CertificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError) {
-	ret := _m.Called(caCrt, csr, caKey)
+// SignCSR provides a mock function with given fields: caCrt, csr, caKey, validity
+func (_m *CertificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey crypto.Signer, validity time.Duration) ([]byte, apperrors.AppError) {
+	ret := _m.Called(caCrt, csr, caKey, validity)
 
 	var r0 []byte
-	if rf, ok := ret.Get(0).(func(*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey) []byte); ok {
-		r0 = rf(caCrt, csr, caKey)
+	if rf, ok := ret.Get(0).(func(*x509.Certificate, *x509.CertificateRequest, crypto.Signer, time.Duration) []byte); ok {
+		r0 = rf(caCrt, csr, caKey, validity)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]byte)
@@ -139,8 +157,8 @@ func (_m *CertificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.Certifi
 	}
 
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(1).(func(*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey) apperrors.AppError); ok {
-		r1 = rf(caCrt, csr, caKey)
+	if rf, ok := ret.Get(1).(func(*x509.Certificate, *x509.CertificateRequest, crypto.Signer, time.Duration) apperrors.AppError); ok {
+		r1 = rf(caCrt, csr, caKey, validity)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -9,6 +9,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Service is an autogenerated mock type for the Service type
@@ -16,20 +18,20 @@ type Service struct {
 	mock.Mock
 }
 
-// SignCSR provides a mock function with given fields: ctx, encodedCSR, subject
-func (_m *Service) SignCSR(ctx context.Context, encodedCSR []byte, subject certificates.CSRSubject) (certificates.EncodedCertificateChain, apperrors.AppError) {
-	ret := _m.Called(ctx, encodedCSR, subject)
+// SignCSR provides a mock function with given fields: ctx, encodedCSR, subject, validity
+func (_m *Service) SignCSR(ctx context.Context, encodedCSR []byte, subject certificates.CSRSubject, validity time.Duration) (certificates.EncodedCertificateChain, apperrors.AppError) {
+	ret := _m.Called(ctx, encodedCSR, subject, validity)
 
 	var r0 certificates.EncodedCertificateChain
-	if rf, ok := ret.Get(0).(func(context.Context, []byte, certificates.CSRSubject) certificates.EncodedCertificateChain); ok {
-		r0 = rf(ctx, encodedCSR, subject)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, certificates.CSRSubject, time.Duration) certificates.EncodedCertificateChain); ok {
+		r0 = rf(ctx, encodedCSR, subject, validity)
 	} else {
 		r0 = ret.Get(0).(certificates.EncodedCertificateChain)
 	}
 
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(1).(func(context.Context, []byte, certificates.CSRSubject) apperrors.AppError); ok {
-		r1 = rf(ctx, encodedCSR, subject)
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, certificates.CSRSubject, time.Duration) apperrors.AppError); ok {
+		r1 = rf(ctx, encodedCSR, subject, validity)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
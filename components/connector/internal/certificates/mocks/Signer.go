@@ -0,0 +1,44 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	apperrors "github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	certificates "github.com/kyma-incubator/compass/components/connector/internal/certificates"
+
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	x509 "crypto/x509"
+)
+
+// Signer is an autogenerated mock type for the Signer type
+type Signer struct {
+	mock.Mock
+}
+
+// Sign provides a mock function with given fields: ctx, csr, validity
+func (_m *Signer) Sign(ctx context.Context, csr *x509.CertificateRequest, validity time.Duration) (certificates.SignedCertificate, apperrors.AppError) {
+	ret := _m.Called(ctx, csr, validity)
+
+	var r0 certificates.SignedCertificate
+	if rf, ok := ret.Get(0).(func(context.Context, *x509.CertificateRequest, time.Duration) certificates.SignedCertificate); ok {
+		r0 = rf(ctx, csr, validity)
+	} else {
+		r0 = ret.Get(0).(certificates.SignedCertificate)
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(context.Context, *x509.CertificateRequest, time.Duration) apperrors.AppError); ok {
+		r1 = rf(ctx, csr, validity)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
@@ -2,6 +2,7 @@ package certificates
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
 )
@@ -27,6 +28,9 @@ type EncodedCertificateChain struct {
 	CertificateChain  string
 	ClientCertificate string
 	CaCertificate     string
+	ExpiresAt         time.Time
+	// Hash is the SHA256 hash of the DER encoded client certificate, the same as the hash of the certificate provided by Istio
+	Hash string
 }
 
 func ToCertificationResult(encodedChain EncodedCertificateChain) externalschema.CertificationResult {
@@ -0,0 +1,201 @@
+package certificates
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/httputils"
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/pkg/errors"
+)
+
+const (
+	signEndpoint = "/api/v1/cfssl/sign"
+	infoEndpoint = "/api/v1/cfssl/info"
+)
+
+type RemoteSignerConfig struct {
+	URL            string        `envconfig:"optional"`
+	Profile        string        `envconfig:"optional"`
+	Label          string        `envconfig:"optional"`
+	Timeout        time.Duration `envconfig:"default=30s"`
+	ClientCertFile string        `envconfig:"optional"`
+	ClientKeyFile  string        `envconfig:"optional"`
+	CAFile         string        `envconfig:"optional"`
+}
+
+type remoteSigner struct {
+	httpClient *http.Client
+	url        string
+	profile    string
+	label      string
+}
+
+// NewRemoteSigner returns a Signer which delegates signing to an external CA exposing a CFSSL compatible API,
+// so that the CA key never leaves the external PKI or HSM
+func NewRemoteSigner(cfg RemoteSignerConfig) (Signer, error) {
+	httpClient, err := newRemoteSignerHTTPClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &remoteSigner{
+		httpClient: httpClient,
+		url:        strings.TrimSuffix(cfg.URL, "/"),
+		profile:    cfg.Profile,
+		label:      cfg.Label,
+	}, nil
+}
+
+type remoteSignRequest struct {
+	CertificateRequest string    `json:"certificate_request"`
+	Profile            string    `json:"profile,omitempty"`
+	Label              string    `json:"label,omitempty"`
+	NotBefore          time.Time `json:"not_before"`
+	NotAfter           time.Time `json:"not_after"`
+}
+
+type remoteInfoRequest struct {
+	Profile string `json:"profile,omitempty"`
+	Label   string `json:"label,omitempty"`
+}
+
+type remoteResponse struct {
+	Success bool                    `json:"success"`
+	Result  remoteCertificateResult `json:"result"`
+	Errors  []remoteError           `json:"errors"`
+}
+
+type remoteCertificateResult struct {
+	Certificate string `json:"certificate"`
+}
+
+type remoteError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (s *remoteSigner) Sign(ctx context.Context, csr *x509.CertificateRequest, validity time.Duration) (SignedCertificate, apperrors.AppError) {
+	encodedCSR := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr.Raw})
+
+	notBefore := time.Now().UTC()
+	clientCrt, err := s.call(ctx, signEndpoint, remoteSignRequest{
+		CertificateRequest: string(encodedCSR),
+		Profile:            s.profile,
+		Label:              s.label,
+		NotBefore:          notBefore,
+		NotAfter:           notBefore.Add(validity),
+	})
+	if err != nil {
+		return SignedCertificate{}, err.Append("Error while signing CSR by remote signer")
+	}
+
+	caCrt, err := s.call(ctx, infoEndpoint, remoteInfoRequest{
+		Profile: s.profile,
+		Label:   s.label,
+	})
+	if err != nil {
+		return SignedCertificate{}, err.Append("Error while fetching CA certificate from remote signer")
+	}
+
+	return SignedCertificate{
+		ClientCertificate: withTrailingNewLine(clientCrt),
+		CACertificates:    withTrailingNewLine(caCrt),
+	}, nil
+}
+
+func (s *remoteSigner) call(ctx context.Context, endpoint string, body interface{}) ([]byte, apperrors.AppError) {
+	reqBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, apperrors.Internal("Error while marshalling request: %s", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url+endpoint, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, apperrors.Internal("Error while creating request: %s", err)
+	}
+	req.Header.Set(httputils.HeaderContentType, httputils.ContentTypeApplicationJSON)
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, apperrors.UpstreamServerCallFailed("Error while calling %s: %s", endpoint, err)
+	}
+	defer httputils.Close(ctx, resp.Body)
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, apperrors.UpstreamServerCallFailed("Error while reading response of %s: %s", endpoint, err)
+	}
+
+	var remoteResp remoteResponse
+	if err := json.Unmarshal(respBody, &remoteResp); err != nil {
+		return nil, apperrors.UpstreamServerCallFailed("Error while unmarshalling response of %s with status %d: %s", endpoint, resp.StatusCode, err)
+	}
+
+	if resp.StatusCode != http.StatusOK || !remoteResp.Success {
+		return nil, apperrors.UpstreamServerCallFailed("Call to %s failed with status %d: %s", endpoint, resp.StatusCode, remoteResp.errorMessage())
+	}
+
+	if pemBlock, _ := pem.Decode([]byte(remoteResp.Result.Certificate)); pemBlock == nil {
+		return nil, apperrors.UpstreamServerCallFailed("Response of %s does not contain PEM encoded certificate", endpoint)
+	}
+
+	return []byte(remoteResp.Result.Certificate), nil
+}
+
+func (r remoteResponse) errorMessage() string {
+	messages := make([]string, 0, len(r.Errors))
+	for _, remoteErr := range r.Errors {
+		messages = append(messages, fmt.Sprintf("%s (code %d)", remoteErr.Message, remoteErr.Code))
+	}
+	return strings.Join(messages, ", ")
+}
+
+func withTrailingNewLine(pemData []byte) []byte {
+	if bytes.HasSuffix(pemData, []byte("\n")) {
+		return pemData
+	}
+	return append(pemData, '\n')
+}
+
+func newRemoteSignerHTTPClient(cfg RemoteSignerConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	tlsConfig := &tls.Config{}
+
+	if cfg.ClientCertFile != "" || cfg.ClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "while loading remote signer client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if cfg.CAFile != "" {
+		caCert, err := ioutil.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "while reading remote signer CA certificate")
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("remote signer CA certificate file does not contain valid PEM certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{
+		Transport: httputil.NewCorrelationIDTransport(httputil.NewTracingTransport(transport)),
+		Timeout:   cfg.Timeout,
+	}, nil
+}
@@ -0,0 +1,156 @@
+package certificates_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates/signertest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteSigner_Sign(t *testing.T) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caCrt := fixCACertificate(t, caKey)
+	clientCSR := fixCSR(t)
+
+	t.Run("should sign CSR", func(t *testing.T) {
+		// given
+		srv := signertest.NewServer(caCrt, caKey, time.Hour)
+		defer srv.Close()
+
+		signer, err := certificates.NewRemoteSigner(certificates.RemoteSignerConfig{URL: srv.URL, Timeout: time.Second})
+		require.NoError(t, err)
+
+		// when
+		signedCrt, apperr := signer.Sign(context.TODO(), clientCSR, validity)
+
+		// then
+		require.NoError(t, apperr)
+
+		pemBlock, _ := pem.Decode(signedCrt.ClientCertificate)
+		require.NotNil(t, pemBlock)
+		clientCrt, err := x509.ParseCertificate(pemBlock.Bytes)
+		require.NoError(t, err)
+		assert.Equal(t, clientCSR.Subject.CommonName, clientCrt.Subject.CommonName)
+		assert.NoError(t, clientCrt.CheckSignatureFrom(caCrt))
+		assert.WithinDuration(t, time.Now().Add(validity), clientCrt.NotAfter, time.Minute)
+
+		pemBlock, _ = pem.Decode(signedCrt.CACertificates)
+		require.NotNil(t, pemBlock)
+		assert.Equal(t, caCrt.Raw, pemBlock.Bytes)
+	})
+
+	t.Run("should return error when remote signer rejects CSR", func(t *testing.T) {
+		// given
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"success":false,"result":null,"errors":[{"code":2000,"message":"invalid CSR"}]}`))
+		}))
+		defer srv.Close()
+
+		signer, err := certificates.NewRemoteSigner(certificates.RemoteSignerConfig{URL: srv.URL, Timeout: time.Second})
+		require.NoError(t, err)
+
+		// when
+		_, apperr := signer.Sign(context.TODO(), clientCSR, validity)
+
+		// then
+		require.Error(t, apperr)
+		assert.Equal(t, apperrors.CodeUpstreamServerCallFailed, apperr.Code())
+		assert.Contains(t, apperr.Error(), "Call to /api/v1/cfssl/sign failed with status 400: invalid CSR (code 2000)")
+	})
+
+	t.Run("should return error when response does not contain certificate", func(t *testing.T) {
+		// given
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"success":true,"result":{"certificate":"not a certificate"}}`))
+		}))
+		defer srv.Close()
+
+		signer, err := certificates.NewRemoteSigner(certificates.RemoteSignerConfig{URL: srv.URL, Timeout: time.Second})
+		require.NoError(t, err)
+
+		// when
+		_, apperr := signer.Sign(context.TODO(), clientCSR, validity)
+
+		// then
+		require.Error(t, apperr)
+		assert.Contains(t, apperr.Error(), "Response of /api/v1/cfssl/sign does not contain PEM encoded certificate")
+	})
+
+	t.Run("should return error when remote signer is unreachable", func(t *testing.T) {
+		// given
+		srv := httptest.NewServer(http.NotFoundHandler())
+		srv.Close()
+
+		signer, err := certificates.NewRemoteSigner(certificates.RemoteSignerConfig{URL: srv.URL, Timeout: time.Second})
+		require.NoError(t, err)
+
+		// when
+		_, apperr := signer.Sign(context.TODO(), clientCSR, validity)
+
+		// then
+		require.Error(t, apperr)
+		assert.Equal(t, apperrors.CodeUpstreamServerCallFailed, apperr.Code())
+	})
+
+	t.Run("should fail when client certificate cannot be loaded", func(t *testing.T) {
+		// when
+		_, err := certificates.NewRemoteSigner(certificates.RemoteSignerConfig{
+			URL:            "http://signer.local",
+			ClientCertFile: "not-existing.crt",
+			ClientKeyFile:  "not-existing.key",
+		})
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading remote signer client certificate")
+	})
+}
+
+func fixCACertificate(t *testing.T, key *ecdsa.PrivateKey) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "remote-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	rawCrt, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+
+	crt, err := x509.ParseCertificate(rawCrt)
+	require.NoError(t, err)
+	return crt
+}
+
+func fixCSR(t *testing.T) *x509.CertificateRequest {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	rawCSR, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: appName},
+	}, key)
+	require.NoError(t, err)
+
+	csr, err := x509.ParseCertificateRequest(rawCSR)
+	require.NoError(t, err)
+	return csr
+}
@@ -2,8 +2,11 @@ package certificates
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
@@ -12,39 +15,24 @@ import (
 
 //go:generate mockery -name=Service
 type Service interface {
-	// SignCSR takes encoded CSR, validates subject and generates Certificate based on CA stored in secret
+	// SignCSR takes encoded CSR, validates subject and generates Certificate valid for the given duration using the configured Signer
 	// returns base64 encoded certificate chain
-	SignCSR(ctx context.Context, encodedCSR []byte, subject CSRSubject) (EncodedCertificateChain, apperrors.AppError)
+	SignCSR(ctx context.Context, encodedCSR []byte, subject CSRSubject, validity time.Duration) (EncodedCertificateChain, apperrors.AppError)
 }
 
 type certificateService struct {
-	certsCache           Cache
-	certUtil             CertificateUtility
-	caCertSecretName     string
-	caCertSecretKey      string
-	caKeySecretKey       string
-	rootCACertSecretName string
-	rootCACertSecretKey  string
+	certUtil CertificateUtility
+	signer   Signer
 }
 
-func NewCertificateService(
-	certsCache Cache,
-	certUtil CertificateUtility,
-	caCertSecretName, rootCACertSecretName string,
-	caCertSecretKey, caKeySecretKey, rootCACertSecretKey string) Service {
-
+func NewCertificateService(certUtil CertificateUtility, signer Signer) Service {
 	return &certificateService{
-		certsCache:           certsCache,
-		certUtil:             certUtil,
-		caCertSecretName:     caCertSecretName,
-		caCertSecretKey:      caCertSecretKey,
-		caKeySecretKey:       caKeySecretKey,
-		rootCACertSecretName: rootCACertSecretName,
-		rootCACertSecretKey:  rootCACertSecretKey,
+		certUtil: certUtil,
+		signer:   signer,
 	}
 }
 
-func (svc *certificateService) SignCSR(ctx context.Context, encodedCSR []byte, subject CSRSubject) (EncodedCertificateChain, apperrors.AppError) {
+func (svc *certificateService) SignCSR(ctx context.Context, encodedCSR []byte, subject CSRSubject, validity time.Duration) (EncodedCertificateChain, apperrors.AppError) {
 	csr, err := svc.certUtil.LoadCSR(encodedCSR)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("Error occurred while loading the CSR with Common Name %s", subject.CommonName)
@@ -59,73 +47,40 @@ func (svc *certificateService) SignCSR(ctx context.Context, encodedCSR []byte, s
 	}
 	log.C(ctx).Debugf("Successfully checked the values of the CSR with Common Name %s", subject.CommonName)
 
-	encodedCertChain, err := svc.signCSR(csr)
+	signedCrt, err := svc.signer.Sign(ctx, csr, validity)
 	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Error occurred while signing the CSR with Common Name %s", subject.CommonName)
 		return EncodedCertificateChain{}, err
 	}
 	log.C(ctx).Debugf("Successfully signed CSR with Common Name %s", subject.CommonName)
 
-	return encodedCertChain, nil
-}
-
-func (svc *certificateService) signCSR(csr *x509.CertificateRequest) (EncodedCertificateChain, apperrors.AppError) {
-	secretData, err := svc.certsCache.Get(svc.caCertSecretName)
-	if err != nil {
-		return EncodedCertificateChain{}, err
-	}
-
-	caCrt, err := svc.certUtil.LoadCert(secretData[svc.caCertSecretKey])
+	clientCrt, err := svc.certUtil.LoadCert(signedCrt.ClientCertificate)
 	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Error occurred while loading the certificate issued for Common Name %s", subject.CommonName)
 		return EncodedCertificateChain{}, err
 	}
 
-	caKey, err := svc.certUtil.LoadKey(secretData[svc.caKeySecretKey])
-	if err != nil {
-		return EncodedCertificateChain{}, err
-	}
-
-	signedCrt, err := svc.certUtil.SignCSR(caCrt, csr, caKey)
-	if err != nil {
-		return EncodedCertificateChain{}, err
-	}
+	encodedChain := encodeCertificates(signedCrt)
+	encodedChain.ExpiresAt = clientCrt.NotAfter
+	encodedChain.Hash = hashCertificate(clientCrt)
 
-	return svc.encodeCertificates(caCrt.Raw, signedCrt)
+	return encodedChain, nil
 }
 
-func (svc *certificateService) encodeCertificates(rawCaCertificate, rawClientCertificate []byte) (EncodedCertificateChain, apperrors.AppError) {
-	caCrtBytes := svc.certUtil.AddCertificateHeaderAndFooter(rawCaCertificate)
-	signedCrtBytes := svc.certUtil.AddCertificateHeaderAndFooter(rawClientCertificate)
-
-	if svc.rootCACertSecretName != "" && svc.rootCACertSecretKey != "" {
-		rootCABytes, err := svc.loadRootCACert()
-		if err != nil {
-			return EncodedCertificateChain{}, err
-		}
-
-		caCrtBytes = append(caCrtBytes, rootCABytes...)
+func (svc *certificateService) checkCSR(csr *x509.CertificateRequest, expectedSubject CSRSubject) apperrors.AppError {
+	if err := svc.certUtil.CheckCSRValues(csr, expectedSubject); err != nil {
+		return err
 	}
 
-	certChain := append(signedCrtBytes, caCrtBytes...)
-
-	return encodeCertificateBase64(certChain, signedCrtBytes, caCrtBytes), nil
+	return svc.certUtil.CheckCSRKeyAlgorithm(csr)
 }
 
-func (svc *certificateService) loadRootCACert() ([]byte, apperrors.AppError) {
-	secretData, err := svc.certsCache.Get(svc.rootCACertSecretName)
-	if err != nil {
-		return nil, err
-	}
-
-	rootCACrt, err := svc.certUtil.LoadCert(secretData[svc.rootCACertSecretKey])
-	if err != nil {
-		return nil, err
-	}
+func encodeCertificates(signedCrt SignedCertificate) EncodedCertificateChain {
+	certChain := make([]byte, 0, len(signedCrt.ClientCertificate)+len(signedCrt.CACertificates))
+	certChain = append(certChain, signedCrt.ClientCertificate...)
+	certChain = append(certChain, signedCrt.CACertificates...)
 
-	return svc.certUtil.AddCertificateHeaderAndFooter(rootCACrt.Raw), nil
-}
-
-func (svc *certificateService) checkCSR(csr *x509.CertificateRequest, expectedSubject CSRSubject) apperrors.AppError {
-	return svc.certUtil.CheckCSRValues(csr, expectedSubject)
+	return encodeCertificateBase64(certChain, signedCrt.ClientCertificate, signedCrt.CACertificates)
 }
 
 func encodeCertificateBase64(certChain, clientCRT, caCRT []byte) EncodedCertificateChain {
@@ -136,6 +91,11 @@ func encodeCertificateBase64(certChain, clientCRT, caCRT []byte) EncodedCertific
 	}
 }
 
+func hashCertificate(crt *x509.Certificate) string {
+	hash := sha256.Sum256(crt.Raw)
+	return hex.EncodeToString(hash[:])
+}
+
 func encodeStringBase64(bytes []byte) string {
 	return base64.StdEncoding.EncodeToString(bytes)
 }
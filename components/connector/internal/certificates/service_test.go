@@ -3,9 +3,12 @@ package certificates_test
 import (
 	"context"
 	"crypto/rsa"
+	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
@@ -48,6 +51,8 @@ var (
 		rootCACertificateSecretKey: rootCaEncoded,
 	}
 
+	validity = 24 * time.Hour
+
 	rootCACrt = &x509.Certificate{}
 	caCrt     = &x509.Certificate{}
 	caKey     = &rsa.PrivateKey{}
@@ -56,6 +61,7 @@ var (
 	rootCACrtBytes = []byte("rootCACertificate")
 	clientCRT      = []byte("clientCertificate")
 	clientCRTBytes = []byte("clientCertificateBytes")
+	clientCrt      = &x509.Certificate{NotAfter: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}
 	caCRTBytes     = []byte("caCRTBytes")
 	certChain      = append(clientCRTBytes, caCRTBytes...)
 
@@ -75,135 +81,55 @@ func TestCertificateService_SignCSR(t *testing.T) {
 
 	t.Run("should create certificate", func(t *testing.T) {
 		// given
-		cache := certificates.NewCertificateCache()
-		cache.Put(authSecretName, certsSecretData)
-
 		certUtils := &certificatesMocks.CertificateUtility{}
-		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
-		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("SignCSR", caCrt, csr, caKey).Return(clientCRT, nil)
-		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes)
-		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
-
-		certificatesService := certificates.NewCertificateService(
-			cache,
-			certUtils,
-			authSecretName,
-			"",
-			caCertificateSecretKey,
-			caKeySecretKey,
-			rootCACertificateSecretKey)
+		certUtils.On("CheckCSRKeyAlgorithm", csr).Return(nil)
 
-		// when
-		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		certUtils.On("LoadCert", clientCRTBytes).Return(clientCrt, nil)
 
-		// then
-		require.NoError(t, apperr)
-		assert.NotEmpty(t, encodedCertChain)
+		signer := &certificatesMocks.Signer{}
+		signer.On("Sign", context.TODO(), csr, validity).Return(certificates.SignedCertificate{
+			ClientCertificate: clientCRTBytes,
+			CACertificates:    caCRTBytes,
+		}, nil)
 
-		decodedClientCRT, err := decodeBase64(encodedCertChain.ClientCertificate)
-		require.NoError(t, err)
-		assert.Equal(t, clientCRTBytes, decodedClientCRT)
-
-		decodedChain, err := decodeBase64(encodedCertChain.CertificateChain)
-		require.NoError(t, err)
-		assert.Equal(t, certChain, decodedChain)
-
-		certUtils.AssertExpectations(t)
-	})
-
-	t.Run("should create certificate with additional root certificate", func(t *testing.T) {
-		// given
-		certChain := append(append(clientCRTBytes, caCRTBytes...), rootCACrtBytes...)
-
-		cache := certificates.NewCertificateCache()
-		cache.Put(authSecretName, certsSecretData)
-		cache.Put(rootCASecretName, rootCASecretData)
-
-		certUtils := &certificatesMocks.CertificateUtility{}
-		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil).
-			On("LoadCert", rootCaEncoded).Return(rootCACrt, nil)
-		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
-		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
-		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("SignCSR", caCrt, csr, caKey).Return(clientCRT, nil)
-		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes).Once().
-			On("AddCertificateHeaderAndFooter", rootCACrt.Raw).Return(rootCACrtBytes)
-		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
-
-		certificatesService := certificates.NewCertificateService(
-			cache,
-			certUtils,
-			authSecretName,
-			rootCASecretName,
-			caCertificateSecretKey,
-			caKeySecretKey,
-			rootCACertificateSecretKey)
+		certificatesService := certificates.NewCertificateService(certUtils, signer)
 
 		// when
-		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, validity)
 
 		// then
 		require.NoError(t, apperr)
-		assert.NotEmpty(t, encodedCertChain)
 
 		decodedClientCRT, err := decodeBase64(encodedCertChain.ClientCertificate)
 		require.NoError(t, err)
 		assert.Equal(t, clientCRTBytes, decodedClientCRT)
 
+		decodedCaCRT, err := decodeBase64(encodedCertChain.CaCertificate)
+		require.NoError(t, err)
+		assert.Equal(t, caCRTBytes, decodedCaCRT)
+
 		decodedChain, err := decodeBase64(encodedCertChain.CertificateChain)
 		require.NoError(t, err)
 		assert.Equal(t, certChain, decodedChain)
+		assert.Equal(t, clientCrt.NotAfter, encodedCertChain.ExpiresAt)
+		clientCrtHash := sha256.Sum256(clientCrt.Raw)
+		assert.Equal(t, hex.EncodeToString(clientCrtHash[:]), encodedCertChain.Hash)
 
 		certUtils.AssertExpectations(t)
-	})
-
-	t.Run("should return Not Found error when secret not found", func(t *testing.T) {
-		// given
-		cache := certificates.NewCertificateCache()
-		certUtils := &certificatesMocks.CertificateUtility{}
-		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
-		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-
-		certificatesService := certificates.NewCertificateService(
-			cache,
-			certUtils,
-			authSecretName,
-			"",
-			caCertificateSecretKey,
-			caKeySecretKey,
-			rootCACertificateSecretKey)
-
-		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
-
-		// then
-		require.Error(t, err)
-		assert.Equal(t, apperrors.CodeNotFound, err.Code())
-		assert.Empty(t, encodedChain)
-		certUtils.AssertExpectations(t)
+		signer.AssertExpectations(t)
 	})
 
 	t.Run("should return error when couldn't load csr", func(t *testing.T) {
 		// given
-		cache := certificates.NewCertificateCache()
-
 		certUtils := &certificatesMocks.CertificateUtility{}
 		certUtils.On("LoadCSR", rawCSR).Return(nil, apperrors.Internal("error"))
 
-		certificatesService := certificates.NewCertificateService(
-			cache,
-			certUtils,
-			authSecretName,
-			"",
-			caCertificateSecretKey,
-			caKeySecretKey,
-			rootCACertificateSecretKey)
+		certificatesService := certificates.NewCertificateService(certUtils, nil)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, validity)
 
 		// then
 		require.Error(t, err)
@@ -214,23 +140,14 @@ func TestCertificateService_SignCSR(t *testing.T) {
 
 	t.Run("should return error when subject check failed", func(t *testing.T) {
 		// given
-		cache := certificates.NewCertificateCache()
-
 		certUtils := &certificatesMocks.CertificateUtility{}
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(apperrors.Forbidden("error"))
 
-		certificatesService := certificates.NewCertificateService(
-			cache,
-			certUtils,
-			authSecretName,
-			"",
-			caCertificateSecretKey,
-			caKeySecretKey,
-			rootCACertificateSecretKey)
+		certificatesService := certificates.NewCertificateService(certUtils, nil)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, validity)
 
 		// then
 		require.Error(t, err)
@@ -239,94 +156,73 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.AssertExpectations(t)
 	})
 
-	t.Run("should return error when couldn't load cert", func(t *testing.T) {
+	t.Run("should return error when key algorithm is not allowed", func(t *testing.T) {
 		// given
-		cache := certificates.NewCertificateCache()
-		cache.Put(authSecretName, certsSecretData)
-
 		certUtils := &certificatesMocks.CertificateUtility{}
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("LoadCert", caCrtEncoded).Return(nil, apperrors.Internal("error"))
+		certUtils.On("CheckCSRKeyAlgorithm", csr).Return(apperrors.WrongInput("error"))
 
-		certificatesService := certificates.NewCertificateService(
-			cache,
-			certUtils,
-			authSecretName,
-			"",
-			caCertificateSecretKey,
-			caKeySecretKey,
-			rootCACertificateSecretKey)
+		certificatesService := certificates.NewCertificateService(certUtils, nil)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, validity)
 
 		// then
 		require.Error(t, err)
 		assert.Empty(t, encodedChain)
-		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
 		certUtils.AssertExpectations(t)
 	})
 
-	t.Run("should return error when couldn't load key", func(t *testing.T) {
+	t.Run("should return error when failed to sign CSR", func(t *testing.T) {
 		// given
-		cache := certificates.NewCertificateCache()
-		cache.Put(authSecretName, certsSecretData)
-
 		certUtils := &certificatesMocks.CertificateUtility{}
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
-		certUtils.On("LoadKey", caKeyEncoded).Return(nil, apperrors.Internal("error"))
-
-		certificatesService := certificates.NewCertificateService(
-			cache,
-			certUtils,
-			authSecretName,
-			"",
-			caCertificateSecretKey,
-			caKeySecretKey,
-			rootCACertificateSecretKey)
+		certUtils.On("CheckCSRKeyAlgorithm", csr).Return(nil)
+
+		signer := &certificatesMocks.Signer{}
+		signer.On("Sign", context.TODO(), csr, validity).Return(certificates.SignedCertificate{}, apperrors.Internal("error"))
+
+		certificatesService := certificates.NewCertificateService(certUtils, signer)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, validity)
 
 		// then
 		require.Error(t, err)
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeInternal, err.Code())
 		certUtils.AssertExpectations(t)
+		signer.AssertExpectations(t)
 	})
 
-	t.Run("should return error when failed to sign CSR", func(t *testing.T) {
+	t.Run("should return error when failed to load issued certificate", func(t *testing.T) {
 		// given
-		cache := certificates.NewCertificateCache()
-		cache.Put(authSecretName, certsSecretData)
-
 		certUtils := &certificatesMocks.CertificateUtility{}
-		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
-		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
-		certUtils.On("SignCSR", caCrt, csr, caKey).Return(nil, apperrors.Internal("error"))
+		certUtils.On("CheckCSRKeyAlgorithm", csr).Return(nil)
+		certUtils.On("LoadCert", clientCRTBytes).Return(nil, apperrors.Internal("error"))
+
+		signer := &certificatesMocks.Signer{}
+		signer.On("Sign", context.TODO(), csr, validity).Return(certificates.SignedCertificate{
+			ClientCertificate: clientCRTBytes,
+			CACertificates:    caCRTBytes,
+		}, nil)
 
-		certificatesService := certificates.NewCertificateService(
-			cache,
-			certUtils,
-			authSecretName,
-			"",
-			caCertificateSecretKey,
-			caKeySecretKey,
-			rootCACertificateSecretKey)
+		certificatesService := certificates.NewCertificateService(certUtils, signer)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, validity)
 
 		// then
 		require.Error(t, err)
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeInternal, err.Code())
 		certUtils.AssertExpectations(t)
+		signer.AssertExpectations(t)
 	})
 }
 
@@ -0,0 +1,100 @@
+package certificates
+
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+)
+
+//go:generate mockery -name=Signer
+type Signer interface {
+	// Sign issues a client certificate for the CSR which is valid for the given duration
+	// returns the PEM encoded client certificate together with the PEM encoded chain of CA certificates
+	Sign(ctx context.Context, csr *x509.CertificateRequest, validity time.Duration) (SignedCertificate, apperrors.AppError)
+}
+
+type SignedCertificate struct {
+	ClientCertificate []byte
+	CACertificates    []byte
+}
+
+type secretSigner struct {
+	certsCache           Cache
+	certUtil             CertificateUtility
+	caCertSecretName     string
+	caCertSecretKey      string
+	caKeySecretKey       string
+	rootCACertSecretName string
+	rootCACertSecretKey  string
+}
+
+// NewSecretSigner returns a Signer which signs CSRs in-process with the CA stored in a Kubernetes secret
+func NewSecretSigner(
+	certsCache Cache,
+	certUtil CertificateUtility,
+	caCertSecretName, rootCACertSecretName string,
+	caCertSecretKey, caKeySecretKey, rootCACertSecretKey string) Signer {
+
+	return &secretSigner{
+		certsCache:           certsCache,
+		certUtil:             certUtil,
+		caCertSecretName:     caCertSecretName,
+		caCertSecretKey:      caCertSecretKey,
+		caKeySecretKey:       caKeySecretKey,
+		rootCACertSecretName: rootCACertSecretName,
+		rootCACertSecretKey:  rootCACertSecretKey,
+	}
+}
+
+func (s *secretSigner) Sign(_ context.Context, csr *x509.CertificateRequest, validity time.Duration) (SignedCertificate, apperrors.AppError) {
+	secretData, err := s.certsCache.Get(s.caCertSecretName)
+	if err != nil {
+		return SignedCertificate{}, err
+	}
+
+	caCrt, err := s.certUtil.LoadCert(secretData[s.caCertSecretKey])
+	if err != nil {
+		return SignedCertificate{}, err
+	}
+
+	caKey, err := s.certUtil.LoadKey(secretData[s.caKeySecretKey])
+	if err != nil {
+		return SignedCertificate{}, err
+	}
+
+	signedCrt, err := s.certUtil.SignCSR(caCrt, csr, caKey, validity)
+	if err != nil {
+		return SignedCertificate{}, err
+	}
+
+	caCrtBytes := s.certUtil.AddCertificateHeaderAndFooter(caCrt.Raw)
+	if s.rootCACertSecretName != "" && s.rootCACertSecretKey != "" {
+		rootCABytes, err := s.loadRootCACert()
+		if err != nil {
+			return SignedCertificate{}, err
+		}
+
+		caCrtBytes = append(caCrtBytes, rootCABytes...)
+	}
+
+	return SignedCertificate{
+		ClientCertificate: s.certUtil.AddCertificateHeaderAndFooter(signedCrt),
+		CACertificates:    caCrtBytes,
+	}, nil
+}
+
+func (s *secretSigner) loadRootCACert() ([]byte, apperrors.AppError) {
+	secretData, err := s.certsCache.Get(s.rootCACertSecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	rootCACrt, err := s.certUtil.LoadCert(secretData[s.rootCACertSecretKey])
+	if err != nil {
+		return nil, err
+	}
+
+	return s.certUtil.AddCertificateHeaderAndFooter(rootCACrt.Raw), nil
+}
@@ -0,0 +1,142 @@
+package certificates_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	certificatesMocks "github.com/kyma-incubator/compass/components/connector/internal/certificates/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretSigner_Sign(t *testing.T) {
+
+	t.Run("should sign CSR", func(t *testing.T) {
+		// given
+		cache := certificates.NewCertificateCache()
+		cache.Put(authSecretName, certsSecretData)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
+		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, validity).Return(clientCRT, nil)
+		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes)
+		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
+
+		signer := certificates.NewSecretSigner(cache, certUtils, authSecretName, "", caCertificateSecretKey, caKeySecretKey, rootCACertificateSecretKey)
+
+		// when
+		signedCrt, err := signer.Sign(context.TODO(), csr, validity)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, clientCRTBytes, signedCrt.ClientCertificate)
+		assert.Equal(t, caCRTBytes, signedCrt.CACertificates)
+		certUtils.AssertExpectations(t)
+	})
+
+	t.Run("should sign CSR with additional root certificate", func(t *testing.T) {
+		// given
+		cache := certificates.NewCertificateCache()
+		cache.Put(authSecretName, certsSecretData)
+		cache.Put(rootCASecretName, rootCASecretData)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil).
+			On("LoadCert", rootCaEncoded).Return(rootCACrt, nil)
+		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, validity).Return(clientCRT, nil)
+		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes).Once()
+		certUtils.On("AddCertificateHeaderAndFooter", rootCACrt.Raw).Return(rootCACrtBytes).Once()
+		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
+
+		signer := certificates.NewSecretSigner(cache, certUtils, authSecretName, rootCASecretName, caCertificateSecretKey, caKeySecretKey, rootCACertificateSecretKey)
+
+		// when
+		signedCrt, err := signer.Sign(context.TODO(), csr, validity)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, clientCRTBytes, signedCrt.ClientCertificate)
+		assert.Equal(t, append(append([]byte{}, caCRTBytes...), rootCACrtBytes...), signedCrt.CACertificates)
+		certUtils.AssertExpectations(t)
+	})
+
+	t.Run("should return Not Found error when secret not found", func(t *testing.T) {
+		// given
+		signer := certificates.NewSecretSigner(certificates.NewCertificateCache(), nil, authSecretName, "", caCertificateSecretKey, caKeySecretKey, rootCACertificateSecretKey)
+
+		// when
+		signedCrt, err := signer.Sign(context.TODO(), csr, validity)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+		assert.Empty(t, signedCrt)
+	})
+
+	t.Run("should return error when couldn't load cert", func(t *testing.T) {
+		// given
+		cache := certificates.NewCertificateCache()
+		cache.Put(authSecretName, certsSecretData)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(nil, apperrors.Internal("error"))
+
+		signer := certificates.NewSecretSigner(cache, certUtils, authSecretName, "", caCertificateSecretKey, caKeySecretKey, rootCACertificateSecretKey)
+
+		// when
+		signedCrt, err := signer.Sign(context.TODO(), csr, validity)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		assert.Empty(t, signedCrt)
+		certUtils.AssertExpectations(t)
+	})
+
+	t.Run("should return error when couldn't load key", func(t *testing.T) {
+		// given
+		cache := certificates.NewCertificateCache()
+		cache.Put(authSecretName, certsSecretData)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
+		certUtils.On("LoadKey", caKeyEncoded).Return(nil, apperrors.Internal("error"))
+
+		signer := certificates.NewSecretSigner(cache, certUtils, authSecretName, "", caCertificateSecretKey, caKeySecretKey, rootCACertificateSecretKey)
+
+		// when
+		signedCrt, err := signer.Sign(context.TODO(), csr, validity)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		assert.Empty(t, signedCrt)
+		certUtils.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to sign CSR", func(t *testing.T) {
+		// given
+		cache := certificates.NewCertificateCache()
+		cache.Put(authSecretName, certsSecretData)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
+		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey, validity).Return(nil, apperrors.Internal("error"))
+
+		signer := certificates.NewSecretSigner(cache, certUtils, authSecretName, "", caCertificateSecretKey, caKeySecretKey, rootCACertificateSecretKey)
+
+		// when
+		signedCrt, err := signer.Sign(context.TODO(), csr, validity)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		assert.Empty(t, signedCrt)
+		certUtils.AssertExpectations(t)
+	})
+}
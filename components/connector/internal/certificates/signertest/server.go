@@ -0,0 +1,95 @@
+// Package signertest provides a local stand-in for a remote signer exposing a CFSSL compatible API
+package signertest
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+)
+
+type request struct {
+	CertificateRequest string    `json:"certificate_request"`
+	Profile            string    `json:"profile"`
+	Label              string    `json:"label"`
+	NotBefore          time.Time `json:"not_before"`
+	NotAfter           time.Time `json:"not_after"`
+}
+
+type response struct {
+	Success bool                   `json:"success"`
+	Result  map[string]interface{} `json:"result"`
+	Errors  []responseError        `json:"errors"`
+}
+
+type responseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewServer starts a server which signs CSRs with the given CA. Certificates are valid until the requested not_after,
+// or for the given default validity if the request does not specify it. The caller is responsible for closing it.
+func NewServer(caCrt *x509.Certificate, caKey crypto.Signer, defaultValidity time.Duration) *httptest.Server {
+	certUtil := certificates.NewCertificateUtility(nil)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/cfssl/sign", func(w http.ResponseWriter, r *http.Request) {
+		var req request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, 1001, err.Error())
+			return
+		}
+
+		csr, appErr := certUtil.LoadCSR([]byte(req.CertificateRequest))
+		if appErr != nil {
+			writeError(w, http.StatusBadRequest, 2000, appErr.Error())
+			return
+		}
+
+		validity := defaultValidity
+		if !req.NotAfter.IsZero() {
+			validity = time.Until(req.NotAfter)
+		}
+
+		rawCrt, appErr := certUtil.SignCSR(caCrt, csr, caKey, validity)
+		if appErr != nil {
+			writeError(w, http.StatusInternalServerError, 2500, appErr.Error())
+			return
+		}
+
+		writeCertificate(w, rawCrt)
+	})
+	mux.HandleFunc("/api/v1/cfssl/info", func(w http.ResponseWriter, r *http.Request) {
+		writeCertificate(w, caCrt.Raw)
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func writeCertificate(w http.ResponseWriter, rawCrt []byte) {
+	writeResponse(w, http.StatusOK, response{
+		Success: true,
+		Result: map[string]interface{}{
+			"certificate": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rawCrt})),
+		},
+		Errors: []responseError{},
+	})
+}
+
+func writeError(w http.ResponseWriter, status, code int, message string) {
+	writeResponse(w, status, response{
+		Success: false,
+		Errors:  []responseError{{Code: code, Message: message}},
+	})
+}
+
+func writeResponse(w http.ResponseWriter, status int, resp response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,47 @@
+package certificates
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type ConsumerType string
+
+const (
+	ApplicationConsumer ConsumerType = "Application"
+	RuntimeConsumer     ConsumerType = "Runtime"
+)
+
+type ValidityConfig struct {
+	Application time.Duration `envconfig:"default=2160h"`
+	Runtime     time.Duration `envconfig:"default=2160h"`
+}
+
+// For returns the validity of certificates issued for the given consumer type,
+// falling back to the Application validity for clients of unknown type
+func (c ValidityConfig) For(consumerType ConsumerType) time.Duration {
+	switch consumerType {
+	case RuntimeConsumer:
+		return c.Runtime
+	default:
+		return c.Application
+	}
+}
+
+// Validate checks if all validities are positive and longer than the renewal window
+func (c ValidityConfig) Validate(renewalWindow time.Duration) error {
+	if renewalWindow <= 0 {
+		return errors.New("renewal window has to be positive")
+	}
+
+	for _, consumerType := range []ConsumerType{ApplicationConsumer, RuntimeConsumer} {
+		validity := c.For(consumerType)
+		if validity <= renewalWindow {
+			return fmt.Errorf("certificate validity %s of %s has to be longer than renewal window %s", validity, consumerType, renewalWindow)
+		}
+	}
+
+	return nil
+}
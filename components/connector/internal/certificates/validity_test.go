@@ -0,0 +1,43 @@
+package certificates_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidityConfig_For(t *testing.T) {
+	cfg := certificates.ValidityConfig{
+		Application: 1 * time.Hour,
+		Runtime:     2 * time.Hour,
+	}
+
+	assert.Equal(t, 1*time.Hour, cfg.For(certificates.ApplicationConsumer))
+	assert.Equal(t, 2*time.Hour, cfg.For(certificates.RuntimeConsumer))
+	assert.Equal(t, 1*time.Hour, cfg.For("unknown"))
+}
+
+func TestValidityConfig_Validate(t *testing.T) {
+	cfg := certificates.ValidityConfig{
+		Application: 2 * time.Hour,
+		Runtime:     3 * time.Hour,
+	}
+
+	t.Run("should accept renewal window shorter than all validities", func(t *testing.T) {
+		require.NoError(t, cfg.Validate(time.Hour))
+	})
+
+	t.Run("should reject renewal window not shorter than any validity", func(t *testing.T) {
+		err := cfg.Validate(2 * time.Hour)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "certificate validity 2h0m0s of Application has to be longer than renewal window 2h0m0s")
+	})
+
+	t.Run("should reject non positive renewal window", func(t *testing.T) {
+		require.Error(t, cfg.Validate(0))
+	})
+}
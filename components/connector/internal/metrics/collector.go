@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+//go:generate mockery -name=CertificateExpiryRecorder
+type CertificateExpiryRecorder interface {
+	RecordCertificateExpiry(clientId string, consumerType certificates.ConsumerType, expiresAt time.Time)
+}
+
+type Collector struct {
+	certificateExpiry *prometheus.GaugeVec
+}
+
+func NewCollector() *Collector {
+	return &Collector{
+		certificateExpiry: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "compass",
+			Subsystem: "connector",
+			Name:      "client_certificate_expiry_timestamp_seconds",
+			Help:      "Expiry time of the latest certificate issued for the client",
+		}, []string{"client_id", "consumer_type"}),
+	}
+}
+
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.certificateExpiry.Describe(ch)
+}
+
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.certificateExpiry.Collect(ch)
+}
+
+func (c *Collector) RecordCertificateExpiry(clientId string, consumerType certificates.ConsumerType, expiresAt time.Time) {
+	c.certificateExpiry.WithLabelValues(clientId, string(consumerType)).Set(float64(expiresAt.Unix()))
+}
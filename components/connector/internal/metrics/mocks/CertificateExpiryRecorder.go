@@ -0,0 +1,21 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	certificates "github.com/kyma-incubator/compass/components/connector/internal/certificates"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// CertificateExpiryRecorder is an autogenerated mock type for the CertificateExpiryRecorder type
+type CertificateExpiryRecorder struct {
+	mock.Mock
+}
+
+// RecordCertificateExpiry provides a mock function with given fields: clientId, consumerType, expiresAt
+func (_m *CertificateExpiryRecorder) RecordCertificateExpiry(clientId string, consumerType certificates.ConsumerType, expiresAt time.Time) {
+	_m.Called(clientId, consumerType, expiresAt)
+}
@@ -0,0 +1,55 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	renewal "github.com/kyma-incubator/compass/components/connector/internal/renewal"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ChainRepository is an autogenerated mock type for the ChainRepository type
+type ChainRepository struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: clientId
+func (_m *ChainRepository) Get(clientId string) (renewal.Chain, bool, error) {
+	ret := _m.Called(clientId)
+
+	var r0 renewal.Chain
+	if rf, ok := ret.Get(0).(func(string) renewal.Chain); ok {
+		r0 = rf(clientId)
+	} else {
+		r0 = ret.Get(0).(renewal.Chain)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(clientId)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(clientId)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Save provides a mock function with given fields: clientId, chain
+func (_m *ChainRepository) Save(clientId string, chain renewal.Chain) error {
+	ret := _m.Called(clientId, chain)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, renewal.Chain) error); ok {
+		r0 = rf(clientId, chain)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
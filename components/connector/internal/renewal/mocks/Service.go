@@ -0,0 +1,113 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	apperrors "github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	certificates "github.com/kyma-incubator/compass/components/connector/internal/certificates"
+
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// Service is an autogenerated mock type for the Service type
+type Service struct {
+	mock.Mock
+}
+
+// CheckRenewal provides a mock function with given fields: ctx, clientId, certificateHash
+func (_m *Service) CheckRenewal(ctx context.Context, clientId string, certificateHash string) (certificates.ConsumerType, apperrors.AppError) {
+	ret := _m.Called(ctx, clientId, certificateHash)
+
+	var r0 certificates.ConsumerType
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) certificates.ConsumerType); ok {
+		r0 = rf(ctx, clientId, certificateHash)
+	} else {
+		r0 = ret.Get(0).(certificates.ConsumerType)
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) apperrors.AppError); ok {
+		r1 = rf(ctx, clientId, certificateHash)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ConsumerType provides a mock function with given fields: ctx, clientId
+func (_m *Service) ConsumerType(ctx context.Context, clientId string) (certificates.ConsumerType, apperrors.AppError) {
+	ret := _m.Called(ctx, clientId)
+
+	var r0 certificates.ConsumerType
+	if rf, ok := ret.Get(0).(func(context.Context, string) certificates.ConsumerType); ok {
+		r0 = rf(ctx, clientId)
+	} else {
+		r0 = ret.Get(0).(certificates.ConsumerType)
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(context.Context, string) apperrors.AppError); ok {
+		r1 = rf(ctx, clientId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// RecordCertificate provides a mock function with given fields: ctx, clientId, consumerType, certificateHash, expiresAt
+func (_m *Service) RecordCertificate(ctx context.Context, clientId string, consumerType certificates.ConsumerType, certificateHash string, expiresAt time.Time) apperrors.AppError {
+	ret := _m.Called(ctx, clientId, consumerType, certificateHash, expiresAt)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string, certificates.ConsumerType, string, time.Time) apperrors.AppError); ok {
+		r0 = rf(ctx, clientId, consumerType, certificateHash, expiresAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// RevokeCertificates provides a mock function with given fields: ctx, clientId
+func (_m *Service) RevokeCertificates(ctx context.Context, clientId string) apperrors.AppError {
+	ret := _m.Called(ctx, clientId)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string) apperrors.AppError); ok {
+		r0 = rf(ctx, clientId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// StartChain provides a mock function with given fields: ctx, clientId, consumerType
+func (_m *Service) StartChain(ctx context.Context, clientId string, consumerType certificates.ConsumerType) apperrors.AppError {
+	ret := _m.Called(ctx, clientId, consumerType)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string, certificates.ConsumerType) apperrors.AppError); ok {
+		r0 = rf(ctx, clientId, consumerType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,114 @@
+package renewal
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// Chain describes the certificates issued for a client since it was paired with a one-time token
+type Chain struct {
+	ConsumerType certificates.ConsumerType `json:"consumerType"`
+	StartedAt    time.Time                 `json:"startedAt"`
+	// Certificates are the unexpired certificates issued for the client, so that they can be revoked together
+	Certificates []IssuedCertificate `json:"certificates,omitempty"`
+}
+
+// IssuedCertificate is kept in the chain until it expires, expired certificates do not have to be revoked
+type IssuedCertificate struct {
+	Hash      string    `json:"hash"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+//go:generate mockery -name=Manager
+type Manager interface {
+	Get(name string, options metav1.GetOptions) (*v1.ConfigMap, error)
+	Create(configmap *v1.ConfigMap) (*v1.ConfigMap, error)
+	Update(configmap *v1.ConfigMap) (*v1.ConfigMap, error)
+}
+
+//go:generate mockery -name=ChainRepository
+type ChainRepository interface {
+	Save(clientId string, chain Chain) error
+	Get(clientId string) (Chain, bool, error)
+}
+
+const chainKey = "chain"
+
+// chainRepository keeps the chain of every client in a separate ConfigMap,
+// so that the number of clients is not limited by the maximum size of a single ConfigMap
+type chainRepository struct {
+	configMapManager    Manager
+	configMapNamePrefix string
+}
+
+func NewChainRepository(configMapManager Manager, configMapNamePrefix string) ChainRepository {
+	return &chainRepository{
+		configMapManager:    configMapManager,
+		configMapNamePrefix: configMapNamePrefix,
+	}
+}
+
+func (r *chainRepository) Save(clientId string, chain Chain) error {
+	value, err := json.Marshal(chain)
+	if err != nil {
+		return errors.Wrapf(err, "while marshalling certificate chain of client %s", clientId)
+	}
+
+	name := r.configMapName(clientId)
+	data := map[string]string{chainKey: string(value)}
+
+	_, err = r.configMapManager.Create(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Data:       data,
+	})
+	if !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		configMap, err := r.configMapManager.Get(name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		configMap.Data = data
+
+		_, err = r.configMapManager.Update(configMap)
+		return err
+	})
+}
+
+func (r *chainRepository) Get(clientId string) (Chain, bool, error) {
+	configMap, err := r.configMapManager.Get(r.configMapName(clientId), metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return Chain{}, false, nil
+		}
+		return Chain{}, false, err
+	}
+
+	value, found := configMap.Data[chainKey]
+	if !found {
+		return Chain{}, false, nil
+	}
+
+	var chain Chain
+	if err := json.Unmarshal([]byte(value), &chain); err != nil {
+		return Chain{}, false, errors.Wrapf(err, "while unmarshalling certificate chain of client %s", clientId)
+	}
+
+	return chain, true, nil
+}
+
+func (r *chainRepository) configMapName(clientId string) string {
+	return fmt.Sprintf("%s-%s", r.configMapNamePrefix, strings.ToLower(clientId))
+}
@@ -0,0 +1,154 @@
+package renewal_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestChainRepository(t *testing.T) {
+
+	configMapNamePrefix := "certificate-chain"
+	clientId := "ClientId"
+	configMapName := "certificate-chain-clientid"
+	startedAt := time.Date(2020, 12, 1, 10, 0, 0, 0, time.UTC)
+	encodedChain := `{"consumerType":"Runtime","startedAt":"2020-12-01T10:00:00Z"}`
+	chainConfigMap := func() *v1.ConfigMap {
+		return &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: configMapName},
+			Data: map[string]string{
+				"chain": encodedChain,
+			},
+		}
+	}
+	notFoundErr := k8serrors.NewNotFound(schema.GroupResource{}, configMapName)
+	alreadyExistsErr := k8serrors.NewAlreadyExists(schema.GroupResource{}, configMapName)
+
+	t.Run("should save chain in new config map", func(t *testing.T) {
+		// given
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Create", chainConfigMap()).Return(chainConfigMap(), nil)
+
+		repository := renewal.NewChainRepository(configMapManager, configMapNamePrefix)
+
+		// when
+		err := repository.Save(clientId, renewal.Chain{ConsumerType: certificates.RuntimeConsumer, StartedAt: startedAt})
+
+		// then
+		require.NoError(t, err)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should update existing config map of the client", func(t *testing.T) {
+		// given
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Create", chainConfigMap()).Return(nil, alreadyExistsErr)
+		configMapManager.On("Get", configMapName, mock.AnythingOfType("v1.GetOptions")).Return(
+			&v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: configMapName},
+				Data:       map[string]string{"chain": `{"consumerType":"Application","startedAt":"2019-12-01T10:00:00Z"}`},
+			}, nil)
+		configMapManager.On("Update", chainConfigMap()).Return(chainConfigMap(), nil)
+
+		repository := renewal.NewChainRepository(configMapManager, configMapNamePrefix)
+
+		// when
+		err := repository.Save(clientId, renewal.Chain{ConsumerType: certificates.RuntimeConsumer, StartedAt: startedAt})
+
+		// then
+		require.NoError(t, err)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to create config map", func(t *testing.T) {
+		// given
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Create", mock.AnythingOfType("*v1.ConfigMap")).Return(nil, errors.New("some error"))
+
+		repository := renewal.NewChainRepository(configMapManager, configMapNamePrefix)
+
+		// when
+		err := repository.Save(clientId, renewal.Chain{ConsumerType: certificates.RuntimeConsumer, StartedAt: startedAt})
+
+		// then
+		require.Error(t, err)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to update config map", func(t *testing.T) {
+		// given
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Create", mock.AnythingOfType("*v1.ConfigMap")).Return(nil, alreadyExistsErr)
+		configMapManager.On("Get", configMapName, mock.AnythingOfType("v1.GetOptions")).Return(&v1.ConfigMap{}, nil)
+		configMapManager.On("Update", mock.AnythingOfType("*v1.ConfigMap")).Return(nil, errors.New("some error"))
+
+		repository := renewal.NewChainRepository(configMapManager, configMapNamePrefix)
+
+		// when
+		err := repository.Save(clientId, renewal.Chain{ConsumerType: certificates.RuntimeConsumer, StartedAt: startedAt})
+
+		// then
+		require.Error(t, err)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should get chain", func(t *testing.T) {
+		// given
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", configMapName, mock.AnythingOfType("v1.GetOptions")).Return(chainConfigMap(), nil)
+
+		repository := renewal.NewChainRepository(configMapManager, configMapNamePrefix)
+
+		// when
+		chain, found, err := repository.Get(clientId)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, certificates.RuntimeConsumer, chain.ConsumerType)
+		assert.True(t, startedAt.Equal(chain.StartedAt))
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should return not found when chain is not recorded", func(t *testing.T) {
+		// given
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", configMapName, mock.AnythingOfType("v1.GetOptions")).Return(nil, notFoundErr)
+
+		repository := renewal.NewChainRepository(configMapManager, configMapNamePrefix)
+
+		// when
+		_, found, err := repository.Get(clientId)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, found)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to get config map", func(t *testing.T) {
+		// given
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", configMapName, mock.AnythingOfType("v1.GetOptions")).Return(nil, errors.New("some error"))
+
+		repository := renewal.NewChainRepository(configMapManager, configMapNamePrefix)
+
+		// when
+		_, _, err := repository.Get(clientId)
+
+		// then
+		require.Error(t, err)
+		configMapManager.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,170 @@
+package renewal
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
+)
+
+type Config struct {
+	Window      time.Duration `envconfig:"default=720h"`
+	MaxChainAge time.Duration `envconfig:"default=0"`
+}
+
+//go:generate mockery -name=Service
+type Service interface {
+	// StartChain records that the client has been paired with a one-time token, which starts a new certificate chain
+	StartChain(ctx context.Context, clientId string, consumerType certificates.ConsumerType) apperrors.AppError
+	// ConsumerType returns the consumer type of the client, Application is assumed for clients without recorded chain
+	ConsumerType(ctx context.Context, clientId string) (certificates.ConsumerType, apperrors.AppError)
+	// CheckRenewal verifies that the client authenticated with the certificate is allowed to renew it
+	// returns the consumer type of the client, the chain of a client without recorded chain starts with its first renewal
+	CheckRenewal(ctx context.Context, clientId, certificateHash string) (certificates.ConsumerType, apperrors.AppError)
+	// RecordCertificate records the hash of a certificate issued for the client in its chain and removes the expired certificates from the chain
+	RecordCertificate(ctx context.Context, clientId string, consumerType certificates.ConsumerType, certificateHash string, expiresAt time.Time) apperrors.AppError
+	// RevokeCertificates revokes all certificates recorded in the chain of the client
+	RevokeCertificates(ctx context.Context, clientId string) apperrors.AppError
+}
+
+type service struct {
+	chainRepository        ChainRepository
+	revokedCertsRepository revocation.RevokedCertificatesRepository
+	maxChainAge            time.Duration
+}
+
+// NewService returns a renewal Service. Chains older than maxChainAge cannot be renewed, zero disables the check.
+func NewService(chainRepository ChainRepository, revokedCertsRepository revocation.RevokedCertificatesRepository, maxChainAge time.Duration) Service {
+	return &service{
+		chainRepository:        chainRepository,
+		revokedCertsRepository: revokedCertsRepository,
+		maxChainAge:            maxChainAge,
+	}
+}
+
+func (s *service) StartChain(ctx context.Context, clientId string, consumerType certificates.ConsumerType) apperrors.AppError {
+	log.C(ctx).Debugf("Starting new certificate chain for %s with id %s", consumerType, clientId)
+
+	previous, _, appErr := s.getChain(ctx, clientId)
+	if appErr != nil {
+		return appErr
+	}
+
+	// unexpired certificates issued in the previous chain are still valid, so they are kept to allow revoking them
+	now := time.Now()
+	return s.saveChain(clientId, Chain{
+		ConsumerType: consumerType,
+		StartedAt:    now,
+		Certificates: unexpiredCertificates(previous.Certificates, now),
+	})
+}
+
+func (s *service) ConsumerType(ctx context.Context, clientId string) (certificates.ConsumerType, apperrors.AppError) {
+	chain, found, appErr := s.getChain(ctx, clientId)
+	if appErr != nil {
+		return "", appErr
+	}
+
+	if !found {
+		return certificates.ApplicationConsumer, nil
+	}
+
+	return chain.ConsumerType, nil
+}
+
+func (s *service) CheckRenewal(ctx context.Context, clientId, certificateHash string) (certificates.ConsumerType, apperrors.AppError) {
+	if s.revokedCertsRepository.Contains(certificateHash) {
+		return "", apperrors.Forbidden("Certificate of client with id %s is revoked", clientId)
+	}
+
+	chain, found, appErr := s.getChain(ctx, clientId)
+	if appErr != nil {
+		return "", appErr
+	}
+
+	if !found {
+		// clients paired before chains were recorded are assumed to be Applications
+		if appErr := s.StartChain(ctx, clientId, certificates.ApplicationConsumer); appErr != nil {
+			return "", appErr
+		}
+		return certificates.ApplicationConsumer, nil
+	}
+
+	if s.maxChainAge > 0 && time.Now().Sub(chain.StartedAt) > s.maxChainAge {
+		return "", apperrors.Forbidden("Certificate chain of client with id %s started at %s exceeded maximum age of %s, the client has to be paired again",
+			clientId, chain.StartedAt.Format(time.RFC3339), s.maxChainAge)
+	}
+
+	return chain.ConsumerType, nil
+}
+
+func (s *service) RecordCertificate(ctx context.Context, clientId string, consumerType certificates.ConsumerType, certificateHash string, expiresAt time.Time) apperrors.AppError {
+	chain, found, appErr := s.getChain(ctx, clientId)
+	if appErr != nil {
+		return appErr
+	}
+
+	now := time.Now()
+	if !found {
+		chain = Chain{
+			ConsumerType: consumerType,
+			StartedAt:    now,
+		}
+	}
+
+	log.C(ctx).Debugf("Recording certificate in the chain of client with id %s", clientId)
+	chain.Certificates = append(unexpiredCertificates(chain.Certificates, now), IssuedCertificate{Hash: certificateHash, ExpiresAt: expiresAt})
+	return s.saveChain(clientId, chain)
+}
+
+func (s *service) RevokeCertificates(ctx context.Context, clientId string) apperrors.AppError {
+	chain, _, appErr := s.getChain(ctx, clientId)
+	if appErr != nil {
+		return appErr
+	}
+
+	issued := unexpiredCertificates(chain.Certificates, time.Now())
+	log.C(ctx).Debugf("Revoking %d certificates of client with id %s", len(issued), clientId)
+	for _, certificate := range issued {
+		if err := s.revokedCertsRepository.Insert(certificate.Hash); err != nil {
+			return apperrors.Internal("Failed to add certificate hash of client with id %s to revocation list: %s", clientId, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *service) saveChain(clientId string, chain Chain) apperrors.AppError {
+	if err := s.chainRepository.Save(clientId, chain); err != nil {
+		return apperrors.Internal("Failed to save certificate chain of client with id %s: %s", clientId, err)
+	}
+
+	return nil
+}
+
+func (s *service) getChain(ctx context.Context, clientId string) (Chain, bool, apperrors.AppError) {
+	chain, found, err := s.chainRepository.Get(clientId)
+	if err != nil {
+		return Chain{}, false, apperrors.Internal("Failed to get certificate chain of client with id %s: %s", clientId, err)
+	}
+
+	if !found {
+		log.C(ctx).Debugf("No certificate chain recorded for client with id %s", clientId)
+	}
+
+	return chain, found, nil
+}
+
+func unexpiredCertificates(issued []IssuedCertificate, now time.Time) []IssuedCertificate {
+	var unexpired []IssuedCertificate
+	for _, certificate := range issued {
+		if certificate.ExpiresAt.After(now) {
+			unexpired = append(unexpired, certificate)
+		}
+	}
+	return unexpired
+}
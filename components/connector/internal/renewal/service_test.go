@@ -0,0 +1,417 @@
+package renewal_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal"
+	"github.com/kyma-incubator/compass/components/connector/internal/renewal/mocks"
+	revocationMocks "github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	clientId        = "clientId"
+	certificateHash = "hash"
+)
+
+var (
+	now         = time.Now()
+	maxChainAge = 365 * 24 * time.Hour
+	expiresAt   = now.Add(time.Hour)
+	expiredAt   = now.Add(-time.Hour)
+)
+
+func TestService_StartChain(t *testing.T) {
+
+	t.Run("should save new chain", func(t *testing.T) {
+		// given
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{}, false, nil)
+		chainRepository.On("Save", clientId, mock.MatchedBy(func(chain renewal.Chain) bool {
+			return chain.ConsumerType == certificates.RuntimeConsumer && !chain.StartedAt.Before(now) && len(chain.Certificates) == 0
+		})).Return(nil)
+
+		svc := fixService(chainRepository, nil)
+
+		// when
+		err := svc.StartChain(context.TODO(), clientId, certificates.RuntimeConsumer)
+
+		// then
+		require.NoError(t, err)
+		chainRepository.AssertExpectations(t)
+	})
+
+	t.Run("should keep unexpired certificates of previous chain", func(t *testing.T) {
+		// given
+		previous := []renewal.IssuedCertificate{{Hash: "expired", ExpiresAt: expiredAt}, {Hash: certificateHash, ExpiresAt: expiresAt}}
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{ConsumerType: certificates.ApplicationConsumer, StartedAt: now.Add(-time.Hour), Certificates: previous}, true, nil)
+		chainRepository.On("Save", clientId, mock.MatchedBy(func(chain renewal.Chain) bool {
+			return chain.ConsumerType == certificates.RuntimeConsumer && !chain.StartedAt.Before(now) &&
+				assert.ObjectsAreEqual([]renewal.IssuedCertificate{{Hash: certificateHash, ExpiresAt: expiresAt}}, chain.Certificates)
+		})).Return(nil)
+
+		svc := fixService(chainRepository, nil)
+
+		// when
+		err := svc.StartChain(context.TODO(), clientId, certificates.RuntimeConsumer)
+
+		// then
+		require.NoError(t, err)
+		chainRepository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to get previous chain", func(t *testing.T) {
+		// given
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{}, false, errors.New("error"))
+
+		svc := fixService(chainRepository, nil)
+
+		// when
+		err := svc.StartChain(context.TODO(), clientId, certificates.RuntimeConsumer)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		chainRepository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to save chain", func(t *testing.T) {
+		// given
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{}, false, nil)
+		chainRepository.On("Save", clientId, mock.Anything).Return(errors.New("error"))
+
+		svc := fixService(chainRepository, nil)
+
+		// when
+		err := svc.StartChain(context.TODO(), clientId, certificates.RuntimeConsumer)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		chainRepository.AssertExpectations(t)
+	})
+}
+
+func TestService_ConsumerType(t *testing.T) {
+
+	t.Run("should return consumer type of the chain", func(t *testing.T) {
+		// given
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{ConsumerType: certificates.RuntimeConsumer, StartedAt: now}, true, nil)
+
+		svc := fixService(chainRepository, nil)
+
+		// when
+		consumerType, err := svc.ConsumerType(context.TODO(), clientId)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, certificates.RuntimeConsumer, consumerType)
+		chainRepository.AssertExpectations(t)
+	})
+
+	t.Run("should return Application when chain is not recorded", func(t *testing.T) {
+		// given
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{}, false, nil)
+
+		svc := fixService(chainRepository, nil)
+
+		// when
+		consumerType, err := svc.ConsumerType(context.TODO(), clientId)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, certificates.ApplicationConsumer, consumerType)
+		chainRepository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to get chain", func(t *testing.T) {
+		// given
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{}, false, errors.New("error"))
+
+		svc := fixService(chainRepository, nil)
+
+		// when
+		_, err := svc.ConsumerType(context.TODO(), clientId)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		chainRepository.AssertExpectations(t)
+	})
+}
+
+func TestService_CheckRenewal(t *testing.T) {
+
+	t.Run("should allow renewal of chain younger than maximum age", func(t *testing.T) {
+		// given
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Contains", certificateHash).Return(false)
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{ConsumerType: certificates.RuntimeConsumer, StartedAt: now.Add(-maxChainAge + time.Hour)}, true, nil)
+
+		svc := fixService(chainRepository, revokedCertsRepository)
+
+		// when
+		consumerType, err := svc.CheckRenewal(context.TODO(), clientId, certificateHash)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, certificates.RuntimeConsumer, consumerType)
+		mock.AssertExpectationsForObjects(t, chainRepository, revokedCertsRepository)
+	})
+
+	t.Run("should refuse renewal of revoked certificate", func(t *testing.T) {
+		// given
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Contains", certificateHash).Return(true)
+		chainRepository := &mocks.ChainRepository{}
+
+		svc := fixService(chainRepository, revokedCertsRepository)
+
+		// when
+		_, err := svc.CheckRenewal(context.TODO(), clientId, certificateHash)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeForbidden, err.Code())
+		mock.AssertExpectationsForObjects(t, chainRepository, revokedCertsRepository)
+	})
+
+	t.Run("should refuse renewal of chain older than maximum age", func(t *testing.T) {
+		// given
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Contains", certificateHash).Return(false)
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{ConsumerType: certificates.RuntimeConsumer, StartedAt: now.Add(-maxChainAge - time.Second)}, true, nil)
+
+		svc := fixService(chainRepository, revokedCertsRepository)
+
+		// when
+		_, err := svc.CheckRenewal(context.TODO(), clientId, certificateHash)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeForbidden, err.Code())
+		assert.Contains(t, err.Error(), "exceeded maximum age")
+		mock.AssertExpectationsForObjects(t, chainRepository, revokedCertsRepository)
+	})
+
+	t.Run("should not check chain age when maximum age is disabled", func(t *testing.T) {
+		// given
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Contains", certificateHash).Return(false)
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{ConsumerType: certificates.RuntimeConsumer, StartedAt: now.Add(-10 * maxChainAge)}, true, nil)
+
+		svc := renewal.NewService(chainRepository, revokedCertsRepository, 0)
+
+		// when
+		consumerType, err := svc.CheckRenewal(context.TODO(), clientId, certificateHash)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, certificates.RuntimeConsumer, consumerType)
+		mock.AssertExpectationsForObjects(t, chainRepository, revokedCertsRepository)
+	})
+
+	t.Run("should allow renewal and start chain when chain is not recorded", func(t *testing.T) {
+		// given
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Contains", certificateHash).Return(false)
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{}, false, nil)
+		chainRepository.On("Save", clientId, mock.MatchedBy(func(chain renewal.Chain) bool {
+			return chain.ConsumerType == certificates.ApplicationConsumer && !chain.StartedAt.Before(now)
+		})).Return(nil)
+
+		svc := fixService(chainRepository, revokedCertsRepository)
+
+		// when
+		consumerType, err := svc.CheckRenewal(context.TODO(), clientId, certificateHash)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, certificates.ApplicationConsumer, consumerType)
+		mock.AssertExpectationsForObjects(t, chainRepository, revokedCertsRepository)
+	})
+
+	t.Run("should return error when failed to start chain of client without recorded chain", func(t *testing.T) {
+		// given
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Contains", certificateHash).Return(false)
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{}, false, nil)
+		chainRepository.On("Save", clientId, mock.Anything).Return(errors.New("error"))
+
+		svc := fixService(chainRepository, revokedCertsRepository)
+
+		// when
+		_, err := svc.CheckRenewal(context.TODO(), clientId, certificateHash)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		mock.AssertExpectationsForObjects(t, chainRepository, revokedCertsRepository)
+	})
+}
+
+func TestService_RecordCertificate(t *testing.T) {
+
+	t.Run("should append certificate to the chain and remove expired certificates", func(t *testing.T) {
+		// given
+		previous := renewal.IssuedCertificate{Hash: "previous", ExpiresAt: expiresAt}
+		chain := renewal.Chain{ConsumerType: certificates.RuntimeConsumer, StartedAt: now, Certificates: []renewal.IssuedCertificate{{Hash: "expired", ExpiresAt: expiredAt}, previous}}
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(chain, true, nil)
+		chainRepository.On("Save", clientId, renewal.Chain{ConsumerType: certificates.RuntimeConsumer, StartedAt: now, Certificates: []renewal.IssuedCertificate{previous, {Hash: certificateHash, ExpiresAt: expiresAt}}}).Return(nil)
+
+		svc := fixService(chainRepository, nil)
+
+		// when
+		err := svc.RecordCertificate(context.TODO(), clientId, certificates.RuntimeConsumer, certificateHash, expiresAt)
+
+		// then
+		require.NoError(t, err)
+		chainRepository.AssertExpectations(t)
+	})
+
+	t.Run("should start chain when chain is not recorded", func(t *testing.T) {
+		// given
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{}, false, nil)
+		chainRepository.On("Save", clientId, mock.MatchedBy(func(chain renewal.Chain) bool {
+			return chain.ConsumerType == certificates.ApplicationConsumer && !chain.StartedAt.Before(now) && assert.ObjectsAreEqual([]renewal.IssuedCertificate{{Hash: certificateHash, ExpiresAt: expiresAt}}, chain.Certificates)
+		})).Return(nil)
+
+		svc := fixService(chainRepository, nil)
+
+		// when
+		err := svc.RecordCertificate(context.TODO(), clientId, certificates.ApplicationConsumer, certificateHash, expiresAt)
+
+		// then
+		require.NoError(t, err)
+		chainRepository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to get chain", func(t *testing.T) {
+		// given
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{}, false, errors.New("error"))
+
+		svc := fixService(chainRepository, nil)
+
+		// when
+		err := svc.RecordCertificate(context.TODO(), clientId, certificates.RuntimeConsumer, certificateHash, expiresAt)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		chainRepository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to save chain", func(t *testing.T) {
+		// given
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{ConsumerType: certificates.RuntimeConsumer, StartedAt: now}, true, nil)
+		chainRepository.On("Save", clientId, mock.Anything).Return(errors.New("error"))
+
+		svc := fixService(chainRepository, nil)
+
+		// when
+		err := svc.RecordCertificate(context.TODO(), clientId, certificates.RuntimeConsumer, certificateHash, expiresAt)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		chainRepository.AssertExpectations(t)
+	})
+}
+
+func TestService_RevokeCertificates(t *testing.T) {
+
+	t.Run("should revoke all unexpired certificates of the chain", func(t *testing.T) {
+		// given
+		issued := []renewal.IssuedCertificate{{Hash: "first", ExpiresAt: expiresAt}, {Hash: "second", ExpiresAt: expiresAt}, {Hash: "expired", ExpiresAt: expiredAt}}
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{ConsumerType: certificates.RuntimeConsumer, StartedAt: now, Certificates: issued}, true, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Insert", "first").Return(nil)
+		revokedCertsRepository.On("Insert", "second").Return(nil)
+
+		svc := fixService(chainRepository, revokedCertsRepository)
+
+		// when
+		err := svc.RevokeCertificates(context.TODO(), clientId)
+
+		// then
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, chainRepository, revokedCertsRepository)
+	})
+
+	t.Run("should succeed when chain is not recorded", func(t *testing.T) {
+		// given
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{}, false, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+
+		svc := fixService(chainRepository, revokedCertsRepository)
+
+		// when
+		err := svc.RevokeCertificates(context.TODO(), clientId)
+
+		// then
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, chainRepository, revokedCertsRepository)
+	})
+
+	t.Run("should return error when failed to get chain", func(t *testing.T) {
+		// given
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{}, false, errors.New("error"))
+
+		svc := fixService(chainRepository, nil)
+
+		// when
+		err := svc.RevokeCertificates(context.TODO(), clientId)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		chainRepository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to insert certificate hash", func(t *testing.T) {
+		// given
+		chainRepository := &mocks.ChainRepository{}
+		chainRepository.On("Get", clientId).Return(renewal.Chain{ConsumerType: certificates.RuntimeConsumer, StartedAt: now, Certificates: []renewal.IssuedCertificate{{Hash: certificateHash, ExpiresAt: expiresAt}}}, true, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Insert", certificateHash).Return(errors.New("error"))
+
+		svc := fixService(chainRepository, revokedCertsRepository)
+
+		// when
+		err := svc.RevokeCertificates(context.TODO(), clientId)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		mock.AssertExpectationsForObjects(t, chainRepository, revokedCertsRepository)
+	})
+}
+
+func fixService(chainRepository renewal.ChainRepository, revokedCertsRepository *revocationMocks.RevokedCertificatesRepository) renewal.Service {
+	return renewal.NewService(chainRepository, revokedCertsRepository, maxChainAge)
+}
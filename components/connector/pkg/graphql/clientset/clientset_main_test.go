@@ -24,6 +24,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/connector/internal/api"
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
+	"github.com/kyma-incubator/compass/components/connector/internal/metrics"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	"github.com/pkg/errors"
 	"github.com/vrischmann/envconfig"
@@ -37,6 +38,8 @@ const (
 
 	testSecretName    = "test-secret"
 	testConfigMapName = "test-secret"
+
+	testChainsConfigMapPrefix = "test-chain"
 )
 
 var (
@@ -50,6 +53,8 @@ func TestMain(m *testing.M) {
 	exitOnError(err, "Error setting APP_CA_SECRET_NAME env")
 	err = os.Setenv("APP_REVOCATION_CONFIG_MAP_NAME", testConfigMapName)
 	exitOnError(err, "Error setting APP_CA_SECRET_NAME env")
+	err = os.Setenv("APP_CERTIFICATE_CHAINS_CONFIG_MAP_PREFIX", testChainsConfigMapPrefix)
+	exitOnError(err, "Error setting APP_CERTIFICATE_CHAINS_CONFIG_MAP_PREFIX env")
 
 	cfg := config.Config{}
 	err = envconfig.InitWithPrefix(&cfg, "APP")
@@ -75,7 +80,8 @@ func TestMain(m *testing.M) {
 		},
 	)
 
-	internalComponents, certsLoader, revokedCertsLoader := config.InitInternalComponents(cfg, k8sClientSet)
+	internalComponents, certsLoader, revokedCertsLoader, err := config.InitInternalComponents(cfg, k8sClientSet)
+	exitOnError(err, "Failed to initialize internal components")
 
 	go certsLoader.Run(context.TODO())
 	go revokedCertsLoader.Run(context.TODO())
@@ -88,9 +94,14 @@ func TestMain(m *testing.M) {
 		internalComponents.TokenService,
 		internalComponents.CertificateService,
 		internalComponents.CSRSubjectConsts,
+		internalComponents.AllowedKeyAlgorithms,
+		cfg.CertificateValidity,
+		cfg.Renewal.Window,
 		cfg.DirectorURL,
 		cfg.CertificateSecuredConnectorURL,
-		internalComponents.RevokedCertsRepository)
+		internalComponents.RevokedCertsRepository,
+		internalComponents.RenewalService,
+		metrics.NewCollector())
 
 	authContextTestMiddleware := func(handler http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
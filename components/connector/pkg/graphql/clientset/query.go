@@ -28,7 +28,7 @@ func (qp queryProvider) revokeCert() string {
 
 func configurationResult() string {
 	return `token { token }
-	certificateSigningRequestInfo { subject keyAlgorithm }
+	certificateSigningRequestInfo { subject keyAlgorithm allowedKeyAlgorithms certificateValidity renewalWindow }
 	managementPlaneInfo { 
 		directorURL
 		certificateSecuredConnectorURL
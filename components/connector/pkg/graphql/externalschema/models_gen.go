@@ -3,8 +3,11 @@
 package externalschema
 
 type CertificateSigningRequestInfo struct {
-	Subject      string `json:"subject"`
-	KeyAlgorithm string `json:"keyAlgorithm"`
+	Subject              string   `json:"subject"`
+	KeyAlgorithm         string   `json:"keyAlgorithm"`
+	AllowedKeyAlgorithms []string `json:"allowedKeyAlgorithms"`
+	CertificateValidity  int      `json:"certificateValidity"`
+	RenewalWindow        int      `json:"renewalWindow"`
 }
 
 type CertificationResult struct {
@@ -43,8 +43,11 @@ type DirectiveRoot struct {
 
 type ComplexityRoot struct {
 	CertificateSigningRequestInfo struct {
-		KeyAlgorithm func(childComplexity int) int
-		Subject      func(childComplexity int) int
+		AllowedKeyAlgorithms func(childComplexity int) int
+		CertificateValidity  func(childComplexity int) int
+		KeyAlgorithm         func(childComplexity int) int
+		RenewalWindow        func(childComplexity int) int
+		Subject              func(childComplexity int) int
 	}
 
 	CertificationResult struct {
@@ -101,6 +104,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "CertificateSigningRequestInfo.allowedKeyAlgorithms":
+		if e.complexity.CertificateSigningRequestInfo.AllowedKeyAlgorithms == nil {
+			break
+		}
+
+		return e.complexity.CertificateSigningRequestInfo.AllowedKeyAlgorithms(childComplexity), true
+
+	case "CertificateSigningRequestInfo.certificateValidity":
+		if e.complexity.CertificateSigningRequestInfo.CertificateValidity == nil {
+			break
+		}
+
+		return e.complexity.CertificateSigningRequestInfo.CertificateValidity(childComplexity), true
+
 	case "CertificateSigningRequestInfo.keyAlgorithm":
 		if e.complexity.CertificateSigningRequestInfo.KeyAlgorithm == nil {
 			break
@@ -108,6 +125,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.CertificateSigningRequestInfo.KeyAlgorithm(childComplexity), true
 
+	case "CertificateSigningRequestInfo.renewalWindow":
+		if e.complexity.CertificateSigningRequestInfo.RenewalWindow == nil {
+			break
+		}
+
+		return e.complexity.CertificateSigningRequestInfo.RenewalWindow(childComplexity), true
+
 	case "CertificateSigningRequestInfo.subject":
 		if e.complexity.CertificateSigningRequestInfo.Subject == nil {
 			break
@@ -294,7 +318,10 @@ type Configuration {
 # CSRInfo
 type CertificateSigningRequestInfo {
     subject: String! # eg.: "OU=Test,O=Test,L=Blacksburg,ST=Virginia,C=US,CN={ID}"
-    keyAlgorithm: String! # eg.: rsa2048
+    keyAlgorithm: String! # eg.: rsa2048, the preferred algorithm
+    allowedKeyAlgorithms: [String!]! # eg.: ["rsa2048", "ecdsaP256", "ecdsaP384", "ed25519"]
+    certificateValidity: Int! # in seconds, eg.: 7776000
+    renewalWindow: Int! # in seconds, the certificate should be renewed when it expires within that time, eg.: 2592000
 }
 
 type Query {
@@ -456,6 +483,117 @@ func (ec *executionContext) _CertificateSigningRequestInfo_keyAlgorithm(ctx cont
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _CertificateSigningRequestInfo_allowedKeyAlgorithms(ctx context.Context, field graphql.CollectedField, obj *CertificateSigningRequestInfo) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "CertificateSigningRequestInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AllowedKeyAlgorithms, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNString2ᚕstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CertificateSigningRequestInfo_certificateValidity(ctx context.Context, field graphql.CollectedField, obj *CertificateSigningRequestInfo) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "CertificateSigningRequestInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CertificateValidity, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CertificateSigningRequestInfo_renewalWindow(ctx context.Context, field graphql.CollectedField, obj *CertificateSigningRequestInfo) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "CertificateSigningRequestInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RenewalWindow, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _CertificationResult_certificateChain(ctx context.Context, field graphql.CollectedField, obj *CertificationResult) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
@@ -2147,6 +2285,21 @@ func (ec *executionContext) _CertificateSigningRequestInfo(ctx context.Context,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "allowedKeyAlgorithms":
+			out.Values[i] = ec._CertificateSigningRequestInfo_allowedKeyAlgorithms(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "certificateValidity":
+			out.Values[i] = ec._CertificateSigningRequestInfo_certificateValidity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "renewalWindow":
+			out.Values[i] = ec._CertificateSigningRequestInfo_renewalWindow(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -2643,6 +2796,20 @@ func (ec *executionContext) marshalNConfiguration2ᚖgithubᚗcomᚋkymaᚑincub
 	return ec._Configuration(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	return graphql.UnmarshalInt(v)
+}
+
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !ec.HasError(graphql.GetResolverContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
@@ -2657,6 +2824,63 @@ func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.S
 	return res
 }
 
+func (ec *executionContext) unmarshalNString2ᚕstring(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNString2ᚕstring(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		rctx := &graphql.ResolverContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithResolverContext(ctx, rctx)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
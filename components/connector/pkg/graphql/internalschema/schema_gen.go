@@ -46,6 +46,7 @@ type ComplexityRoot struct {
 	Mutation struct {
 		GenerateApplicationToken func(childComplexity int, authID string) int
 		GenerateRuntimeToken     func(childComplexity int, authID string) int
+		RevokeCertificates       func(childComplexity int, authIDs []string) int
 	}
 
 	Query struct {
@@ -60,6 +61,7 @@ type ComplexityRoot struct {
 type MutationResolver interface {
 	GenerateApplicationToken(ctx context.Context, authID string) (*externalschema.Token, error)
 	GenerateRuntimeToken(ctx context.Context, authID string) (*externalschema.Token, error)
+	RevokeCertificates(ctx context.Context, authIDs []string) (bool, error)
 }
 type QueryResolver interface {
 	IsHealthy(ctx context.Context) (bool, error)
@@ -104,6 +106,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.GenerateRuntimeToken(childComplexity, args["authID"].(string)), true
 
+	case "Mutation.revokeCertificates":
+		if e.complexity.Mutation.RevokeCertificates == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeCertificates_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeCertificates(childComplexity, args["authIDs"].([]string)), true
+
 	case "Query.isHealthy":
 		if e.complexity.Query.IsHealthy == nil {
 			break
@@ -193,6 +207,8 @@ type Mutation {
     # Tokens	
     generateApplicationToken(authID: ID!): Token!
     generateRuntimeToken(authID: ID!): Token!
+    # Certificates	
+    revokeCertificates(authIDs: [ID!]!): Boolean!
 }
 `},
 )
@@ -229,6 +245,20 @@ func (ec *executionContext) field_Mutation_generateRuntimeToken_args(ctx context
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_revokeCertificates_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 []string
+	if tmp, ok := rawArgs["authIDs"]; ok {
+		arg0, err = ec.unmarshalNID2ᚕstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["authIDs"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -367,6 +397,50 @@ func (ec *executionContext) _Mutation_generateRuntimeToken(ctx context.Context,
 	return ec.marshalNToken2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐToken(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _Mutation_revokeCertificates(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	ctx = ec.Tracer.StartFieldExecution(ctx, field)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+		ec.Tracer.EndFieldExecution(ctx)
+	}()
+	rctx := &graphql.ResolverContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+	ctx = graphql.WithResolverContext(ctx, rctx)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_revokeCertificates_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	rctx.Args = args
+	ctx = ec.Tracer.StartFieldResolverExecution(ctx, rctx)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RevokeCertificates(rctx, args["authIDs"].([]string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !ec.HasError(rctx) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	rctx.Result = res
+	ctx = ec.Tracer.StartFieldChildExecution(ctx)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _Query_isHealthy(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	ctx = ec.Tracer.StartFieldExecution(ctx, field)
 	defer func() {
@@ -1700,6 +1774,11 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "revokeCertificates":
+			out.Values[i] = ec._Mutation_revokeCertificates(ctx, field)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -2055,6 +2134,35 @@ func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.Selec
 	return res
 }
 
+func (ec *executionContext) unmarshalNID2ᚕstring(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNID2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalNID2ᚕstring(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNID2string(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
@@ -8,22 +8,32 @@ import (
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/authnmappinghandler"
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
+	"github.com/kyma-incubator/compass/components/director/internal/etag"
+	"github.com/kyma-incubator/compass/components/director/internal/persistedquery"
 	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/authenticator"
 	"github.com/vrischmann/envconfig"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/appshare"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventdef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
 	mp_package "github.com/kyma-incubator/compass/components/director/internal/domain/package"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/packageinstanceauth"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime_context"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/normalizer"
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/ownership"
+	"github.com/kyma-incubator/compass/components/director/pkg/policy"
 	"github.com/kyma-incubator/compass/components/director/pkg/scenario"
 
 	"github.com/kyma-incubator/compass/components/director/internal/error_presenter"
@@ -49,6 +59,8 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/user"
 	"github.com/kyma-incubator/compass/components/director/internal/features"
 	"github.com/kyma-incubator/compass/components/director/internal/healthz"
 	"github.com/kyma-incubator/compass/components/director/internal/oathkeeper"
@@ -70,7 +82,10 @@ import (
 	"github.com/pkg/errors"
 )
 
-const envPrefix = "APP"
+const (
+	envPrefix   = "APP"
+	serviceName = "director"
+)
 
 type config struct {
 	Address string `envconfig:"default=127.0.0.1:3000"`
@@ -94,6 +109,9 @@ type config struct {
 	JWKSEndpoint        string        `envconfig:"default=file://hack/default-jwks.json"`
 	JWKSSyncPeriod      time.Duration `envconfig:"default=5m"`
 	AllowJWTSigningNone bool          `envconfig:"default=true"`
+	JWTStrictMode       bool          `envconfig:"default=false"`
+	JWTIssuer           string        `envconfig:"optional"`
+	TrustedIssuersSrc   string        `envconfig:"optional"`
 
 	RuntimeJWKSCachePeriod time.Duration `envconfig:"default=5m"`
 
@@ -106,6 +124,16 @@ type config struct {
 
 	Features features.Config
 
+	DataLoader dataloader.Config
+
+	PersistedQuery persistedquery.Config
+
+	Tracing tracing.Config
+
+	ApplicationDeletion application.DeletionConfig
+
+	TenantOffboarding tenantoffboarding.Config
+
 	ProtectedLabelPattern string `envconfig:"default=.*_defaultEventing"`
 }
 
@@ -128,6 +156,14 @@ func main() {
 	exitOnError(err, "Failed to configure Logger")
 	logger := log.C(ctx)
 
+	shutdownTracing, err := tracing.Configure(ctx, serviceName, cfg.Tracing)
+	exitOnError(err, "Error while configuring tracing")
+
+	defer func() {
+		err := shutdownTracing(context.Background())
+		exitOnError(err, "Error while shutting down tracing")
+	}()
+
 	transact, closeFunc, err := persistence.Configure(ctx, cfg.Database)
 	exitOnError(err, "Error while establishing the connection to the database")
 
@@ -140,6 +176,7 @@ func main() {
 
 	logger.Infof("Registering metrics collectors...")
 	metricsCollector := metrics.NewCollector()
+	transact = metricsCollector.InstrumentTransactioner(transact)
 	dbStatsCollector := sqlstats.NewStatsCollector("director", transact)
 	prometheus.MustRegister(metricsCollector, dbStatsCollector)
 
@@ -148,33 +185,62 @@ func main() {
 
 	httpClient := &http.Client{
 		Timeout:   cfg.ClientTimeout,
-		Transport: httputil.NewCorrelationIDTransport(http.DefaultTransport),
+		Transport: httputil.NewCorrelationIDTransport(httputil.NewTracingTransport(http.DefaultTransport)),
+	}
+
+	rootResolver := domain.NewRootResolver(
+		&normalizer.DefaultNormalizator{},
+		transact,
+		cfgProvider,
+		cfg.OneTimeToken,
+		cfg.OAuth20,
+		pairingAdapters,
+		cfg.Features,
+		metricsCollector,
+		httpClient,
+		cfg.ProtectedLabelPattern,
+		cfg.ApplicationDeletion,
+		cfg.TenantOffboarding,
+	)
+
+	if cfg.ApplicationDeletion.GracePeriod > 0 {
+		logger.Infof("Starting Application reaper with %s deletion grace period...", cfg.ApplicationDeletion.GracePeriod)
+		go rootResolver.ApplicationReaper().Start(ctx)
 	}
 
+	logger.Infof("Starting tenant offboarding processor with %s retention period...", cfg.TenantOffboarding.RetentionPeriod)
+	go rootResolver.TenantOffboardingProcessor().Start(ctx)
+
+	ownerResolver := ownership.NewOwnerResolver(defaultWebhookRepo(), defaultPackageRepo(), defaultAPIRepo(), defaultEventAPIRepo(), defaultDocumentRepo(), defaultPackageInstanceAuthRepo(), runtime_context.NewRepository(), defaultSystemAuthRepo(), appshare.NewRepository(appshare.NewConverter()))
+
 	gqlCfg := graphql.Config{
-		Resolvers: domain.NewRootResolver(
-			&normalizer.DefaultNormalizator{},
-			transact,
-			cfgProvider,
-			cfg.OneTimeToken,
-			cfg.OAuth20,
-			pairingAdapters,
-			cfg.Features,
-			metricsCollector,
-			httpClient,
-			cfg.ProtectedLabelPattern,
-		),
+		Resolvers: rootResolver,
 		Directives: graphql.DirectiveRoot{
-			HasScenario: scenario.NewDirective(transact, label.NewRepository(label.NewConverter()), defaultPackageRepo(), defaultPackageInstanceAuthRepo()).HasScenario,
-			HasScopes:   scope.NewDirective(cfgProvider).VerifyScopes,
-			Validate:    inputvalidation.NewDirective().Validate,
+			EnforcePolicies: policy.NewDirective(transact, policy.NewEngine(cfgProvider), label.NewRepository(label.NewConverter()), ownerResolver).EnforcePolicies,
+			HasScenario:     scenario.NewDirective(transact, label.NewRepository(label.NewConverter()), defaultPackageRepo(), defaultPackageInstanceAuthRepo(), appshare.NewRepository(appshare.NewConverter())).HasScenario,
+			IsOwner:         ownership.NewDirective(transact, defaultApplicationRepo(), label.NewRepository(label.NewConverter()), ownerResolver).IsOwner,
+			HasScopes:       scope.NewDirective(cfgProvider).VerifyScopes,
+			Validate:        inputvalidation.NewDirective().Validate,
 		},
 	}
 
 	executableSchema := graphql.NewExecutableSchema(gqlCfg)
 
+	persistedQueryCache, err := persistedquery.NewCache(cfg.PersistedQuery)
+	exitOnError(err, "Error while creating persisted queries cache")
+
 	logger.Infof("Registering GraphQL endpoint on %s...", cfg.APIEndpoint)
-	authMiddleware := mp_authenticator.New(cfg.JWKSEndpoint, cfg.AllowJWTSigningNone)
+	trustedIssuers, err := mp_authenticator.LoadIssuers(ctx, cfg.TrustedIssuersSrc)
+	exitOnError(err, "Error while reading trusted issuers configuration")
+
+	authMiddleware, err := mp_authenticator.NewWithConfig(mp_authenticator.Config{
+		JWKSEndpoint:        cfg.JWKSEndpoint,
+		AllowJWTSigningNone: cfg.AllowJWTSigningNone,
+		StrictMode:          cfg.JWTStrictMode,
+		Issuer:              cfg.JWTIssuer,
+		TrustedIssuers:      trustedIssuers,
+	}, transact, tenant.NewRepository(tenant.NewConverter()), httpClient, metricsCollector)
+	exitOnError(err, "Error while creating authenticator")
 
 	if cfg.JWKSSyncPeriod != 0 {
 		logger.Infof("JWKS synchronization enabled. Sync period: %v", cfg.JWKSSyncPeriod)
@@ -192,18 +258,30 @@ func main() {
 	mainRouter := mux.NewRouter()
 	mainRouter.HandleFunc("/", handler.Playground("Dataloader", cfg.PlaygroundAPIEndpoint))
 
-	mainRouter.Use(correlation.AttachCorrelationIDToContext(), log.RequestLogger())
+	mainRouter.Use(tracing.Handler(serviceName), correlation.AttachCorrelationIDToContext(), log.RequestLogger())
 	presenter := error_presenter.NewPresenter(uid.NewService())
 
 	gqlAPIRouter := mainRouter.PathPrefix(cfg.APIEndpoint).Subrouter()
 	gqlAPIRouter.Use(authMiddleware.Handler())
 	gqlAPIRouter.Use(statusMiddleware.Handler())
-	gqlAPIRouter.HandleFunc("", metricsCollector.GraphQLHandlerWithInstrumentation(handler.GraphQL(executableSchema,
+	gqlAPIRouter.Use(dataloader.Handler(rootResolver.DataLoaders(), cfg.DataLoader))
+	gqlAPIRouter.HandleFunc("", metricsCollector.GraphQLHandlerWithInstrumentation(etag.Handler()(handler.GraphQL(executableSchema,
 		handler.ErrorPresenter(presenter.Do),
-		handler.RecoverFunc(panic_handler.RecoverFn))))
+		handler.RecoverFunc(panic_handler.RecoverFn),
+		handler.EnablePersistedQueryCache(persistedQueryCache),
+		handler.RequestMiddleware(etag.RequestMiddleware),
+		handler.RequestMiddleware(metricsCollector.GraphQLRequestMiddleware),
+		handler.ResolverMiddleware(tracing.ResolverMiddleware),
+		handler.ResolverMiddleware(metricsCollector.GraphQLResolverMiddleware)))))
+
+	userSvc := user.NewService(user.NewRepository(user.NewConverter()), uid.NewService())
+
+	logger.Infof("Bootstrapping users and user groups from static files...")
+	err = bootstrapUsers(ctx, transact, userSvc, cfg.StaticUsersSrc, cfg.StaticGroupsSrc)
+	exitOnError(err, "Error while bootstrapping users and user groups")
 
 	logger.Infof("Registering Tenant Mapping endpoint on %s...", cfg.TenantMappingEndpoint)
-	tenantMappingHandlerFunc, err := getTenantMappingHandlerFunc(transact, authenticators, cfg.StaticUsersSrc, cfg.StaticGroupsSrc, cfgProvider)
+	tenantMappingHandlerFunc, err := getTenantMappingHandlerFunc(transact, authenticators, userSvc, cfgProvider)
 	exitOnError(err, "Error while configuring tenant mapping handler")
 
 	mainRouter.HandleFunc(cfg.TenantMappingEndpoint, tenantMappingHandlerFunc)
@@ -296,27 +374,49 @@ func exitOnError(err error, context string) {
 	}
 }
 
-func getTenantMappingHandlerFunc(transact persistence.Transactioner, authenticators []authenticator.Config, staticUsersSrc string, staticGroupsSrc string, cfgProvider *configprovider.Provider) (func(writer http.ResponseWriter, request *http.Request), error) {
-	uidSvc := uid.NewService()
-	authConverter := auth.NewConverter()
-	systemAuthConverter := systemauth.NewConverter(authConverter)
-	systemAuthRepo := systemauth.NewRepository(systemAuthConverter)
-	systemAuthSvc := systemauth.NewService(systemAuthRepo, uidSvc)
-	staticUsersRepo, err := tenantmapping.NewStaticUserRepository(staticUsersSrc)
+type userBootstrapper interface {
+	Bootstrap(ctx context.Context, users []user.StaticUser, groups []user.StaticGroup) error
+}
+
+// bootstrapUsers creates the users and user groups defined in the static files, so that they can log in before any role binding is created through the API
+func bootstrapUsers(ctx context.Context, transact persistence.Transactioner, bootstrapper userBootstrapper, staticUsersSrc string, staticGroupsSrc string) error {
+	staticUsers, err := user.LoadStaticUsers(staticUsersSrc)
+	if err != nil {
+		return errors.Wrap(err, "while loading static users")
+	}
+
+	staticGroups, err := user.LoadStaticGroups(staticGroupsSrc)
 	if err != nil {
-		return nil, errors.Wrap(err, "while creating StaticUser repository instance")
+		return errors.Wrap(err, "while loading static groups")
 	}
 
-	staticGroupsRepo, err := tenantmapping.NewStaticGroupRepository(staticGroupsSrc)
+	tx, err := transact.Begin()
 	if err != nil {
-		return nil, errors.Wrap(err, "while creating StaticGroup repository instance")
+		return errors.Wrap(err, "while opening the db transaction")
+	}
+	defer transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := bootstrapper.Bootstrap(ctx, staticUsers, staticGroups); err != nil {
+		return err
 	}
 
+	return tx.Commit()
+}
+
+func getTenantMappingHandlerFunc(transact persistence.Transactioner, authenticators []authenticator.Config, userSvc tenantmapping.UserService, cfgProvider *configprovider.Provider) (func(writer http.ResponseWriter, request *http.Request), error) {
+	uidSvc := uid.NewService()
+	authConverter := auth.NewConverter()
+	systemAuthConverter := systemauth.NewConverter(authConverter)
+	systemAuthRepo := systemauth.NewRepository(systemAuthConverter)
+	systemAuthSvc := systemauth.NewService(systemAuthRepo, uidSvc)
+
 	tenantConverter := tenant.NewConverter()
 	tenantRepo := tenant.NewRepository(tenantConverter)
 
 	objectContextProviders := map[string]tenantmapping.ObjectContextProvider{
-		tenantmapping.UserObjectContextProvider:          tenantmapping.NewUserContextProvider(staticUsersRepo, staticGroupsRepo, tenantRepo),
+		tenantmapping.UserObjectContextProvider:          tenantmapping.NewUserContextProvider(userSvc, tenantRepo),
 		tenantmapping.SystemAuthObjectContextProvider:    tenantmapping.NewSystemAuthContextProvider(systemAuthSvc, cfgProvider, tenantRepo),
 		tenantmapping.AuthenticatorObjectContextProvider: tenantmapping.NewAuthenticatorContextProvider(tenantRepo),
 	}
@@ -407,3 +507,41 @@ func defaultPackageRepo() mp_package.PackageRepository {
 
 	return mp_package.NewRepository(mp_package.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter))
 }
+
+func defaultApplicationRepo() application.ApplicationRepository {
+	authConverter := auth.NewConverter()
+	frConverter := fetchrequest.NewConverter(authConverter)
+	versionConverter := version.NewConverter()
+	eventAPIConverter := eventdef.NewConverter(frConverter, versionConverter)
+	docConverter := document.NewConverter(frConverter)
+	apiConverter := api.NewConverter(frConverter, versionConverter)
+	packageConverter := mp_package.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter)
+
+	return application.NewRepository(application.NewConverter(webhook.NewConverter(authConverter), packageConverter))
+}
+
+func defaultSystemAuthRepo() systemauth.Repository {
+	return systemauth.NewRepository(systemauth.NewConverter(auth.NewConverter()))
+}
+
+func defaultWebhookRepo() webhook.WebhookRepository {
+	return webhook.NewRepository(webhook.NewConverter(auth.NewConverter()))
+}
+
+func defaultAPIRepo() api.APIRepository {
+	frConverter := fetchrequest.NewConverter(auth.NewConverter())
+
+	return api.NewRepository(api.NewConverter(frConverter, version.NewConverter()))
+}
+
+func defaultEventAPIRepo() eventdef.EventAPIRepository {
+	frConverter := fetchrequest.NewConverter(auth.NewConverter())
+
+	return eventdef.NewRepository(eventdef.NewConverter(frConverter, version.NewConverter()))
+}
+
+func defaultDocumentRepo() document.DocumentRepository {
+	frConverter := fetchrequest.NewConverter(auth.NewConverter())
+
+	return document.NewRepository(document.NewConverter(frConverter))
+}
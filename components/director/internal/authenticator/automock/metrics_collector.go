@@ -0,0 +1,25 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import mock "github.com/stretchr/testify/mock"
+
+// MetricsCollector is an autogenerated mock type for the MetricsCollector type
+type MetricsCollector struct {
+	mock.Mock
+}
+
+// RecordIntrospectionCache provides a mock function with given fields: issuer, hit
+func (_m *MetricsCollector) RecordIntrospectionCache(issuer string, hit bool) {
+	_m.Called(issuer, hit)
+}
+
+// RecordJWKSSynchronization provides a mock function with given fields: issuer, success
+func (_m *MetricsCollector) RecordJWKSSynchronization(issuer string, success bool) {
+	_m.Called(issuer, success)
+}
+
+// RecordTokenValidation provides a mock function with given fields: issuer, method, valid
+func (_m *MetricsCollector) RecordTokenValidation(issuer string, method string, valid bool) {
+	_m.Called(issuer, method, valid)
+}
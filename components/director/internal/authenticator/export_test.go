@@ -1,5 +1,5 @@
 package authenticator
 
 func (a *Authenticator) SetJWKSEndpoint(url string) {
-	a.jwksEndpoint = url
+	a.defaultIssuer.jwksEndpoint = url
 }
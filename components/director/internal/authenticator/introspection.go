@@ -0,0 +1,160 @@
+package authenticator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+const maxIntrospectionCacheEntries = 10000
+
+var errInactiveToken = errors.New("token is not active")
+
+type introspectionCacheEntry struct {
+	claims    map[string]interface{}
+	expiresAt time.Time
+}
+
+// introspector validates tokens with the RFC 7662 token introspection endpoint and caches the claims of active tokens
+type introspector struct {
+	endpoint     string
+	clientID     string
+	clientSecret string
+	cacheTTL     time.Duration
+	httpClient   *http.Client
+
+	cache map[string]introspectionCacheEntry
+	mux   sync.Mutex
+	now   func() time.Time
+}
+
+func newIntrospector(cfg IntrospectionConfig, cacheTTL time.Duration, httpClient *http.Client) *introspector {
+	return &introspector{
+		endpoint:     cfg.Endpoint,
+		clientID:     cfg.ClientID,
+		clientSecret: cfg.ClientSecret,
+		cacheTTL:     cacheTTL,
+		httpClient:   httpClient,
+		cache:        make(map[string]introspectionCacheEntry),
+		now:          time.Now,
+	}
+}
+
+// introspect returns the claims of the active token and whether they were found in the cache
+func (i *introspector) introspect(ctx context.Context, token string) (map[string]interface{}, bool, error) {
+	key := cacheKey(token)
+	if claims, ok := i.getFromCache(key); ok {
+		return claims, true, nil
+	}
+
+	claims, err := i.doIntrospect(ctx, token)
+	if err != nil {
+		return nil, false, err
+	}
+
+	active, _ := claims["active"].(bool)
+	if !active {
+		return nil, false, errInactiveToken
+	}
+
+	expiresAt := i.now().Add(i.cacheTTL)
+	if exp, ok := claims["exp"].(float64); ok {
+		tokenExpiresAt := time.Unix(int64(exp), 0)
+		if !tokenExpiresAt.After(i.now()) {
+			return nil, false, errInactiveToken
+		}
+		if tokenExpiresAt.Before(expiresAt) {
+			expiresAt = tokenExpiresAt
+		}
+	}
+
+	i.saveToCache(key, introspectionCacheEntry{claims: claims, expiresAt: expiresAt})
+	return claims, false, nil
+}
+
+func (i *introspector) doIntrospect(ctx context.Context, token string) (map[string]interface{}, error) {
+	form := url.Values{}
+	form.Set("token", token)
+	form.Set("token_type_hint", "access_token")
+
+	req, err := http.NewRequest(http.MethodPost, i.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating introspection request")
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if i.clientID != "" {
+		req.SetBasicAuth(i.clientID, i.clientSecret)
+	}
+
+	resp, err := i.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while calling introspection endpoint %s", i.endpoint)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.C(ctx).WithError(err).Error("An error has occurred while closing introspection response body.")
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("introspection endpoint %s responded with status code %d", i.endpoint, resp.StatusCode)
+	}
+
+	claims := make(map[string]interface{})
+	if err := json.NewDecoder(resp.Body).Decode(&claims); err != nil {
+		return nil, errors.Wrap(err, "while decoding introspection response")
+	}
+
+	return claims, nil
+}
+
+func (i *introspector) getFromCache(key string) (map[string]interface{}, bool) {
+	i.mux.Lock()
+	defer i.mux.Unlock()
+
+	entry, ok := i.cache[key]
+	if !ok {
+		return nil, false
+	}
+	if !entry.expiresAt.After(i.now()) {
+		delete(i.cache, key)
+		return nil, false
+	}
+	return entry.claims, true
+}
+
+func (i *introspector) saveToCache(key string, entry introspectionCacheEntry) {
+	i.mux.Lock()
+	defer i.mux.Unlock()
+
+	if len(i.cache) >= maxIntrospectionCacheEntries {
+		now := i.now()
+		for k, e := range i.cache {
+			if !e.expiresAt.After(now) {
+				delete(i.cache, k)
+			}
+		}
+		if len(i.cache) >= maxIntrospectionCacheEntries {
+			i.cache = make(map[string]introspectionCacheEntry)
+		}
+	}
+	i.cache[key] = entry
+}
+
+// cacheKey hashes the token, so that the cache does not hold the tokens themselves
+func cacheKey(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,277 @@
+package authenticator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultIssuerName            = "default"
+	defaultIntrospectionCacheTTL = time.Minute
+)
+
+// IssuerConfig describes a trusted token issuer.
+// Tokens issued by it are validated either with the keys from JWKSEndpoint or with the RFC 7662 Introspection endpoint.
+// When Audience is set, tokens which do not contain it in the aud claim are rejected.
+type IssuerConfig struct {
+	Name          string               `json:"name"`
+	Issuer        string               `json:"issuer"`
+	Audience      string               `json:"audience,omitempty"`
+	JWKSEndpoint  string               `json:"jwksEndpoint,omitempty"`
+	Introspection *IntrospectionConfig `json:"introspection,omitempty"`
+	ClaimsMapping ClaimsMapping        `json:"claimsMapping,omitempty"`
+}
+
+// IntrospectionConfig describes the RFC 7662 token introspection endpoint of an issuer.
+// CacheTTL limits how long the result of the introspection is cached, it is never cached longer than the token expiration.
+type IntrospectionConfig struct {
+	Endpoint     string `json:"endpoint"`
+	ClientID     string `json:"clientID,omitempty"`
+	ClientSecret string `json:"clientSecret,omitempty"`
+	CacheTTL     string `json:"cacheTTL,omitempty"`
+}
+
+// ClaimsMapping contains the names of the claims which hold the external tenant, the scopes and the consumer.
+// Empty values default to the claim names used in the tokens issued by the Tenant Mapping Handler.
+// The internal tenant is never read from the tokens of trusted issuers, it is resolved from the external tenant.
+type ClaimsMapping struct {
+	ExternalTenant string `json:"externalTenant,omitempty"`
+	Scopes         string `json:"scopes,omitempty"`
+	ConsumerID     string `json:"consumerID,omitempty"`
+	ConsumerType   string `json:"consumerType,omitempty"`
+}
+
+// Validate checks if the issuer configuration is complete
+func (c IssuerConfig) Validate() error {
+	if c.Name == "" {
+		return errors.New("name is required")
+	}
+	if c.Issuer == "" {
+		return errors.New("issuer is required")
+	}
+	if c.JWKSEndpoint == "" && c.Introspection == nil {
+		return errors.New("either jwksEndpoint or introspection is required")
+	}
+	if c.Introspection != nil {
+		if c.Introspection.Endpoint == "" {
+			return errors.New("introspection endpoint is required")
+		}
+		if c.Introspection.CacheTTL != "" {
+			if _, err := time.ParseDuration(c.Introspection.CacheTTL); err != nil {
+				return errors.Wrap(err, "while parsing introspection cache TTL")
+			}
+		}
+	}
+	return nil
+}
+
+// LoadIssuers reads the trusted issuers from the JSON file with the given path
+func LoadIssuers(ctx context.Context, filePath string) ([]IssuerConfig, error) {
+	if filePath == "" {
+		log.C(ctx).Info("No configuration for trusted issuers")
+		return nil, nil
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening trusted issuers configuration file")
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.C(ctx).WithError(err).Warn("An error has occurred while closing trusted issuers configuration file.")
+		}
+	}()
+
+	var issuers []IssuerConfig
+	if err := json.NewDecoder(file).Decode(&issuers); err != nil {
+		return nil, errors.Wrapf(err, "while decoding file [%s] to trusted issuers", filePath)
+	}
+
+	names := make(map[string]bool, len(issuers))
+	for _, issuer := range issuers {
+		if err := issuer.Validate(); err != nil {
+			return nil, errors.Wrapf(err, "while validating trusted issuer %q", issuer.Name)
+		}
+		if names[issuer.Name] {
+			return nil, errors.Errorf("trusted issuer %q is defined more than once", issuer.Name)
+		}
+		names[issuer.Name] = true
+	}
+
+	log.C(ctx).Infof("Successfully read configuration of %d trusted issuers", len(issuers))
+	return issuers, nil
+}
+
+func (m ClaimsMapping) withDefaults() ClaimsMapping {
+	result := m
+	if result.ExternalTenant == "" {
+		result.ExternalTenant = "externalTenant"
+	}
+	if result.Scopes == "" {
+		result.Scopes = "scopes"
+	}
+	if result.ConsumerID == "" {
+		result.ConsumerID = "consumerID"
+	}
+	if result.ConsumerType == "" {
+		result.ConsumerType = "consumerType"
+	}
+	return result
+}
+
+// mapClaims converts the raw claims of the token to Claims
+func (m ClaimsMapping) mapClaims(raw map[string]interface{}) Claims {
+	claims := Claims{
+		ExternalTenant: stringClaim(raw, m.ExternalTenant),
+		Scopes:         scopesClaim(raw, m.Scopes),
+		ConsumerID:     stringClaim(raw, m.ConsumerID),
+		ConsumerType:   consumer.ConsumerType(stringClaim(raw, m.ConsumerType)),
+	}
+	claims.Issuer = stringClaim(raw, "iss")
+	claims.Subject = stringClaim(raw, "sub")
+	return claims
+}
+
+func stringClaim(raw map[string]interface{}, key string) string {
+	value, ok := raw[key].(string)
+	if !ok {
+		return ""
+	}
+	return value
+}
+
+// scopesClaim supports both space-separated scopes, as in OAuth 2.0, and JSON arrays of scopes
+func scopesClaim(raw map[string]interface{}, key string) string {
+	switch value := raw[key].(type) {
+	case string:
+		return value
+	case []interface{}:
+		scopes := make([]string, 0, len(value))
+		for _, scope := range value {
+			if s, ok := scope.(string); ok {
+				scopes = append(scopes, s)
+			}
+		}
+		return strings.Join(scopes, " ")
+	}
+	return ""
+}
+
+// issuer holds the cached keys and the introspection client of a trusted issuer
+type issuer struct {
+	name          string
+	issuer        string
+	audience      string
+	jwksEndpoint  string
+	claimsMapping ClaimsMapping
+	introspector  *introspector
+	// trusted is false for the default issuer, which validates the tokens issued by the Tenant Mapping Handler
+	trusted bool
+
+	cachedJWKs *jwk.Set
+	mux        sync.Mutex
+}
+
+func newIssuer(cfg IssuerConfig, httpClient *http.Client) (*issuer, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "while validating trusted issuer %q", cfg.Name)
+	}
+
+	iss := &issuer{
+		name:          cfg.Name,
+		issuer:        cfg.Issuer,
+		audience:      cfg.Audience,
+		jwksEndpoint:  cfg.JWKSEndpoint,
+		claimsMapping: cfg.ClaimsMapping.withDefaults(),
+		trusted:       true,
+	}
+
+	if cfg.Introspection != nil {
+		cacheTTL := defaultIntrospectionCacheTTL
+		if cfg.Introspection.CacheTTL != "" {
+			cacheTTL, _ = time.ParseDuration(cfg.Introspection.CacheTTL)
+		}
+		iss.introspector = newIntrospector(*cfg.Introspection, cacheTTL, httpClient)
+	}
+
+	return iss, nil
+}
+
+func newDefaultIssuer(jwksEndpoint, issuerClaim string) *issuer {
+	return &issuer{
+		name:          defaultIssuerName,
+		issuer:        issuerClaim,
+		jwksEndpoint:  jwksEndpoint,
+		claimsMapping: ClaimsMapping{}.withDefaults(),
+	}
+}
+
+func (i *issuer) synchronizeJWKS(ctx context.Context) error {
+	jwks, err := FetchJWK(ctx, i.jwksEndpoint)
+	if err != nil {
+		return errors.Wrapf(err, "while fetching JWKS from endpoint %s", i.jwksEndpoint)
+	}
+
+	i.mux.Lock()
+	defer i.mux.Unlock()
+	i.cachedJWKs = jwks
+	return nil
+}
+
+func (i *issuer) keys() []jwk.Key {
+	i.mux.Lock()
+	defer i.mux.Unlock()
+	if i.cachedJWKs == nil {
+		return nil
+	}
+	return i.cachedJWKs.Keys
+}
+
+// verifyAudience checks that the token is issued for the audience of the issuer, if the audience is configured
+func (i *issuer) verifyAudience(raw map[string]interface{}) error {
+	if i.audience == "" {
+		return nil
+	}
+	switch aud := raw["aud"].(type) {
+	case string:
+		if aud == i.audience {
+			return nil
+		}
+	case []interface{}:
+		for _, a := range aud {
+			if a == i.audience {
+				return nil
+			}
+		}
+	}
+	return errors.Errorf("token is not issued for audience %s", i.audience)
+}
+
+// toClaims maps the raw claims of the token.
+// Only the tokens issued by the Tenant Mapping Handler carry the internal tenant.
+// Tokens of trusted issuers which do not contain the consumer are issued for users, identified by the subject.
+func (i *issuer) toClaims(raw map[string]interface{}) Claims {
+	claims := i.claimsMapping.mapClaims(raw)
+	if !i.trusted {
+		claims.Tenant = stringClaim(raw, "tenant")
+		return claims
+	}
+
+	if claims.ConsumerType == "" {
+		claims.ConsumerType = consumer.User
+	}
+	if claims.ConsumerID == "" {
+		claims.ConsumerID = claims.Subject
+	}
+	return claims
+}
@@ -0,0 +1,144 @@
+package authenticator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/authenticator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadIssuers(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// WHEN
+		issuers, err := authenticator.LoadIssuers(context.TODO(), "testdata/issuers/valid.json")
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []authenticator.IssuerConfig{
+			{
+				Name:         "corporate-idp",
+				Issuer:       "https://corporate-idp.local",
+				JWKSEndpoint: "https://corporate-idp.local/.well-known/jwks.json",
+				Audience:     "director",
+				ClaimsMapping: authenticator.ClaimsMapping{
+					ExternalTenant: "zid",
+					Scopes:         "scp",
+					ConsumerID:     "email",
+				},
+			},
+			{
+				Name:   "partner-idp",
+				Issuer: "https://partner-idp.local",
+				Introspection: &authenticator.IntrospectionConfig{
+					Endpoint:     "https://partner-idp.local/oauth2/introspect",
+					ClientID:     "director",
+					ClientSecret: "secret",
+					CacheTTL:     "30s",
+				},
+				ClaimsMapping: authenticator.ClaimsMapping{
+					ExternalTenant: "tenant_id",
+					Scopes:         "scope",
+				},
+			},
+		}, issuers)
+	})
+
+	t.Run("Success when file is not specified", func(t *testing.T) {
+		// WHEN
+		issuers, err := authenticator.LoadIssuers(context.TODO(), "")
+
+		// THEN
+		require.NoError(t, err)
+		assert.Empty(t, issuers)
+	})
+
+	t.Run("Error when file does not exist", func(t *testing.T) {
+		// WHEN
+		_, err := authenticator.LoadIssuers(context.TODO(), "testdata/issuers/not-existing.json")
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while opening trusted issuers configuration file")
+	})
+
+	t.Run("Error when file has invalid format", func(t *testing.T) {
+		// WHEN
+		_, err := authenticator.LoadIssuers(context.TODO(), fakeJWKSURL[len("file://"):])
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while decoding file")
+	})
+
+	t.Run("Error when issuer has neither JWKS endpoint nor introspection", func(t *testing.T) {
+		// WHEN
+		_, err := authenticator.LoadIssuers(context.TODO(), "testdata/issuers/missing-endpoints.json")
+
+		// THEN
+		require.Error(t, err)
+		assert.EqualError(t, err, `while validating trusted issuer "corporate-idp": either jwksEndpoint or introspection is required`)
+	})
+
+	t.Run("Error when issuer is duplicated", func(t *testing.T) {
+		// WHEN
+		_, err := authenticator.LoadIssuers(context.TODO(), "testdata/issuers/duplicated.json")
+
+		// THEN
+		require.Error(t, err)
+		assert.EqualError(t, err, `trusted issuer "corporate-idp" is defined more than once`)
+	})
+}
+
+func TestIssuerConfig_Validate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Config        authenticator.IssuerConfig
+		ExpectedError string
+	}{
+		{
+			Name:   "Valid with JWKS endpoint",
+			Config: authenticator.IssuerConfig{Name: "idp", Issuer: "https://idp.local", JWKSEndpoint: "https://idp.local/jwks"},
+		},
+		{
+			Name:   "Valid with introspection",
+			Config: authenticator.IssuerConfig{Name: "idp", Issuer: "https://idp.local", Introspection: &authenticator.IntrospectionConfig{Endpoint: "https://idp.local/introspect"}},
+		},
+		{
+			Name:          "Missing name",
+			Config:        authenticator.IssuerConfig{Issuer: "https://idp.local", JWKSEndpoint: "https://idp.local/jwks"},
+			ExpectedError: "name is required",
+		},
+		{
+			Name:          "Missing issuer",
+			Config:        authenticator.IssuerConfig{Name: "idp", JWKSEndpoint: "https://idp.local/jwks"},
+			ExpectedError: "issuer is required",
+		},
+		{
+			Name:          "Missing introspection endpoint",
+			Config:        authenticator.IssuerConfig{Name: "idp", Issuer: "https://idp.local", Introspection: &authenticator.IntrospectionConfig{}},
+			ExpectedError: "introspection endpoint is required",
+		},
+		{
+			Name:          "Invalid cache TTL",
+			Config:        authenticator.IssuerConfig{Name: "idp", Issuer: "https://idp.local", Introspection: &authenticator.IntrospectionConfig{Endpoint: "https://idp.local/introspect", CacheTTL: "forever"}},
+			ExpectedError: "while parsing introspection cache TTL",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			err := testCase.Config.Validate()
+
+			// THEN
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
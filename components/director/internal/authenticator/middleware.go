@@ -7,14 +7,15 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
-	"sync"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/client"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/vektah/gqlparser/gqlerror"
 
+	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 
 	"github.com/kyma-incubator/compass/components/director/internal/consumer"
 
@@ -25,36 +26,107 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/scope"
 
 	"github.com/form3tech-oss/jwt-go"
-	"github.com/lestrrat-go/jwx/jwk"
 )
 
 const (
 	AuthorizationHeaderKey = "Authorization"
 	ClientUserHeader       = "client_user"
+
+	jwtMethod           = "jwt"
+	introspectionMethod = "introspection"
 )
 
+//go:generate mockery -name=MetricsCollector -output=automock -outpkg=automock -case=underscore
+type MetricsCollector interface {
+	RecordTokenValidation(issuer, method string, valid bool)
+	RecordIntrospectionCache(issuer string, hit bool)
+	RecordJWKSSynchronization(issuer string, success bool)
+}
+
+//go:generate mockery -name=TenantRepository -output=automock -outpkg=automock -case=underscore
+type TenantRepository interface {
+	GetByExternalTenant(ctx context.Context, externalTenant string) (*model.BusinessTenantMapping, error)
+}
+
+// Config contains the default issuer, defined by JWKSEndpoint and Issuer, and the trusted issuers.
+// In StrictMode unsigned tokens and tokens without expiration time are rejected.
+// When trusted issuers are configured, unsigned tokens are always rejected, and so are tokens
+// whose iss claim matches neither a trusted issuer nor the Issuer of the default issuer.
+type Config struct {
+	JWKSEndpoint        string
+	Issuer              string
+	AllowJWTSigningNone bool
+	StrictMode          bool
+	TrustedIssuers      []IssuerConfig
+}
+
 type Authenticator struct {
-	jwksEndpoint        string
+	defaultIssuer       *issuer
+	trustedIssuers      []*issuer
 	allowJWTSigningNone bool
-	cachedJWKs          *jwk.Set
-	mux                 sync.Mutex
+	strictMode          bool
+	metricsCollector    MetricsCollector
+	transact            persistence.Transactioner
+	tenantRepo          TenantRepository
 }
 
 func New(jwksEndpoint string, allowJWTSigningNone bool) *Authenticator {
-	return &Authenticator{jwksEndpoint: jwksEndpoint, allowJWTSigningNone: allowJWTSigningNone}
+	return &Authenticator{
+		defaultIssuer:       newDefaultIssuer(jwksEndpoint, ""),
+		allowJWTSigningNone: allowJWTSigningNone,
+		metricsCollector:    noopMetricsCollector{},
+	}
+}
+
+// NewWithConfig returns an authenticator which validates the tokens of the default issuer and the trusted issuers.
+// The http client is used to call the introspection endpoints of the issuers.
+// The tenant repository resolves the internal tenant of the tokens issued by the trusted issuers.
+func NewWithConfig(cfg Config, transact persistence.Transactioner, tenantRepo TenantRepository, httpClient *http.Client, metricsCollector MetricsCollector) (*Authenticator, error) {
+	a := &Authenticator{
+		allowJWTSigningNone: cfg.AllowJWTSigningNone && !cfg.StrictMode && len(cfg.TrustedIssuers) == 0,
+		strictMode:          cfg.StrictMode,
+		metricsCollector:    metricsCollector,
+		transact:            transact,
+		tenantRepo:          tenantRepo,
+	}
+	if a.metricsCollector == nil {
+		a.metricsCollector = noopMetricsCollector{}
+	}
+	if cfg.JWKSEndpoint != "" {
+		a.defaultIssuer = newDefaultIssuer(cfg.JWKSEndpoint, cfg.Issuer)
+	}
+
+	for _, issuerCfg := range cfg.TrustedIssuers {
+		iss, err := newIssuer(issuerCfg, httpClient)
+		if err != nil {
+			return nil, err
+		}
+		a.trustedIssuers = append(a.trustedIssuers, iss)
+	}
+
+	return a, nil
 }
 
+// SynchronizeJWKS fetches the keys of all issuers. The first error is returned after all issuers are synchronized.
 func (a *Authenticator) SynchronizeJWKS(ctx context.Context) error {
 	log.C(ctx).Info("Synchronizing JWKS...")
-	a.mux.Lock()
-	defer a.mux.Unlock()
-	jwks, err := FetchJWK(ctx, a.jwksEndpoint)
-	if err != nil {
-		return errors.Wrapf(err, "while fetching JWKS from endpoint %s", a.jwksEndpoint)
+	var syncErr error
+	for _, iss := range a.issuers() {
+		if iss.jwksEndpoint == "" {
+			continue
+		}
+
+		err := iss.synchronizeJWKS(ctx)
+		a.metricsCollector.RecordJWKSSynchronization(iss.name, err == nil)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while synchronizing JWKS of issuer %s", iss.name)
+			if syncErr == nil {
+				syncErr = err
+			}
+		}
 	}
 
-	a.cachedJWKs = jwks
-	return nil
+	return syncErr
 }
 
 func (a *Authenticator) Handler() func(next http.Handler) http.Handler {
@@ -68,13 +140,22 @@ func (a *Authenticator) Handler() func(next http.Handler) http.Handler {
 				return
 			}
 
-			claims, err := a.parseClaimsWithRetry(r.Context(), bearerToken)
+			claims, iss, err := a.parseClaimsWithRetry(r.Context(), bearerToken)
 			if err != nil {
 				log.C(ctx).WithError(err).Error("An error has occurred while parsing claims. Error code: ", http.StatusUnauthorized)
 				a.writeAppError(ctx, w, err, http.StatusUnauthorized)
 				return
 			}
 
+			if iss.trusted && claims.ExternalTenant != "" {
+				claims.Tenant, err = a.resolveTenant(r.Context(), claims.ExternalTenant)
+				if err != nil {
+					log.C(ctx).WithError(err).Error("An error has occurred while resolving tenant. Error code: ", http.StatusInternalServerError)
+					a.writeAppError(ctx, w, err, http.StatusInternalServerError)
+					return
+				}
+			}
+
 			if claims.Tenant == "" && claims.ExternalTenant != "" {
 				err := apperrors.NewTenantNotFoundError(claims.ExternalTenant)
 				log.C(ctx).WithError(err).Error("Tenant not found. Error code: ", http.StatusBadRequest)
@@ -94,41 +175,175 @@ func (a *Authenticator) Handler() func(next http.Handler) http.Handler {
 	}
 }
 
-func (a *Authenticator) parseClaimsWithRetry(ctx context.Context, bearerToken string) (Claims, error) {
-	var claims Claims
-	var err error
+func (a *Authenticator) parseClaimsWithRetry(ctx context.Context, bearerToken string) (Claims, *issuer, error) {
+	if !isJWT(bearerToken) && a.hasIntrospection() {
+		return a.introspectOpaqueToken(ctx, bearerToken)
+	}
+
+	iss, err := a.findIssuer(bearerToken)
+	if err != nil {
+		return Claims{}, nil, apperrors.NewUnauthorizedError(err.Error())
+	}
+
+	if iss.jwksEndpoint == "" {
+		claims, err := a.introspect(ctx, iss, bearerToken)
+		if err != nil {
+			return Claims{}, nil, unauthorizedOrInternalError(err)
+		}
+		return claims, iss, nil
+	}
 
-	claims, err = a.parseClaims(bearerToken)
+	claims, err := a.parseClaims(iss, bearerToken)
 	if err != nil {
 		validationErr, ok := err.(*jwt.ValidationError)
 		if !ok || validationErr.Inner != rsa.ErrVerification {
-			return Claims{}, apperrors.NewUnauthorizedError(err.Error())
+			a.metricsCollector.RecordTokenValidation(iss.name, jwtMethod, false)
+			return Claims{}, nil, apperrors.NewUnauthorizedError(err.Error())
 		}
 
-		err := a.SynchronizeJWKS(ctx)
+		err := iss.synchronizeJWKS(ctx)
+		a.metricsCollector.RecordJWKSSynchronization(iss.name, err == nil)
 		if err != nil {
-			return Claims{}, apperrors.InternalErrorFrom(err, "while synchronizing JWKs during parsing token")
+			return Claims{}, nil, apperrors.InternalErrorFrom(err, "while synchronizing JWKs during parsing token")
 		}
 
-		claims, err = a.parseClaims(bearerToken)
+		claims, err = a.parseClaims(iss, bearerToken)
+		a.metricsCollector.RecordTokenValidation(iss.name, jwtMethod, err == nil)
 		if err != nil {
-			return Claims{}, apperrors.NewUnauthorizedError(err.Error())
+			return Claims{}, nil, apperrors.NewUnauthorizedError(err.Error())
+		}
+
+		return claims, iss, err
+	}
+
+	a.metricsCollector.RecordTokenValidation(iss.name, jwtMethod, true)
+	return claims, iss, nil
+}
+
+func (a *Authenticator) parseClaims(iss *issuer, bearerToken string) (Claims, error) {
+	raw := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(bearerToken, raw, a.getKeyFunc(iss))
+	if err != nil {
+		return Claims{}, err
+	}
+
+	if a.strictMode {
+		if _, ok := raw["exp"]; !ok {
+			return Claims{}, errors.New("token does not have expiration time")
+		}
+	}
+
+	if err := iss.verifyAudience(raw); err != nil {
+		return Claims{}, err
+	}
+
+	return iss.toClaims(raw), nil
+}
+
+// findIssuer returns the trusted issuer which matches the iss claim of the token, or the default issuer.
+// When trusted issuers are configured, tokens with the iss claim of an unknown issuer are rejected instead of being validated by the default issuer.
+func (a *Authenticator) findIssuer(bearerToken string) (*issuer, error) {
+	var issuerClaim string
+	raw := jwt.MapClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(bearerToken, raw); err == nil {
+		issuerClaim, _ = raw["iss"].(string)
+	}
+
+	if issuerClaim != "" {
+		for _, iss := range a.trustedIssuers {
+			if iss.issuer == issuerClaim {
+				return iss, nil
+			}
+		}
+	}
+
+	if a.defaultIssuer == nil {
+		return nil, errors.New("token is not issued by a trusted issuer")
+	}
+	if issuerClaim != "" && len(a.trustedIssuers) > 0 && issuerClaim != a.defaultIssuer.issuer {
+		return nil, errors.Errorf("token is issued by untrusted issuer %s", issuerClaim)
+	}
+	return a.defaultIssuer, nil
+}
+
+// introspectOpaqueToken introspects the token with every issuer which supports introspection, until one of them reports it as active
+func (a *Authenticator) introspectOpaqueToken(ctx context.Context, bearerToken string) (Claims, *issuer, error) {
+	var lastErr error
+	for _, iss := range a.trustedIssuers {
+		if iss.introspector == nil {
+			continue
 		}
 
-		return claims, err
+		claims, err := a.introspect(ctx, iss, bearerToken)
+		if err == nil {
+			return claims, iss, nil
+		}
+		if err != errInactiveToken {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while introspecting token with issuer %s", iss.name)
+			lastErr = err
+		}
 	}
 
-	return claims, nil
+	if lastErr != nil {
+		return Claims{}, nil, apperrors.InternalErrorFrom(lastErr, "while introspecting token")
+	}
+	return Claims{}, nil, apperrors.NewUnauthorizedError(errInactiveToken.Error())
 }
 
-func (a *Authenticator) parseClaims(bearerToken string) (Claims, error) {
-	claims := Claims{}
-	_, err := jwt.ParseWithClaims(bearerToken, &claims, a.getKeyFunc())
+func (a *Authenticator) introspect(ctx context.Context, iss *issuer, bearerToken string) (Claims, error) {
+	raw, cached, err := iss.introspector.introspect(ctx, bearerToken)
+	if err == nil && iss.verifyAudience(raw) != nil {
+		// tokens issued for other audiences are not active for the Director
+		err = errInactiveToken
+	}
+	if err == nil || err == errInactiveToken {
+		a.metricsCollector.RecordIntrospectionCache(iss.name, cached)
+		a.metricsCollector.RecordTokenValidation(iss.name, introspectionMethod, err == nil)
+	}
 	if err != nil {
 		return Claims{}, err
 	}
 
-	return claims, nil
+	return iss.toClaims(raw), nil
+}
+
+// resolveTenant returns the internal ID of the active tenant with the given external ID, or an empty string if there is no such tenant
+func (a *Authenticator) resolveTenant(ctx context.Context, externalTenant string) (string, error) {
+	tx, err := a.transact.Begin()
+	if err != nil {
+		return "", apperrors.InternalErrorFrom(err, "while opening transaction")
+	}
+	defer a.transact.RollbackUnlessCommitted(ctx, tx)
+
+	tenantMapping, err := a.tenantRepo.GetByExternalTenant(persistence.SaveToContext(ctx, tx), externalTenant)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return "", nil
+		}
+		return "", apperrors.InternalErrorFrom(err, "while getting tenant with external ID %s", externalTenant)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", apperrors.InternalErrorFrom(err, "while committing transaction")
+	}
+	return tenantMapping.ID, nil
+}
+
+func (a *Authenticator) hasIntrospection() bool {
+	for _, iss := range a.trustedIssuers {
+		if iss.introspector != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Authenticator) issuers() []*issuer {
+	issuers := make([]*issuer, 0, len(a.trustedIssuers)+1)
+	if a.defaultIssuer != nil {
+		issuers = append(issuers, a.defaultIssuer)
+	}
+	return append(issuers, a.trustedIssuers...)
 }
 
 func (a *Authenticator) getBearerToken(r *http.Request) (string, error) {
@@ -150,24 +365,22 @@ func (a *Authenticator) contextWithClaims(ctx context.Context, claims Claims) co
 	return ctxWithConsumerInfo
 }
 
-func (a *Authenticator) getKeyFunc() func(token *jwt.Token) (interface{}, error) {
+func (a *Authenticator) getKeyFunc(iss *issuer) func(token *jwt.Token) (interface{}, error) {
 	return func(token *jwt.Token) (interface{}, error) {
 		unsupportedErr := fmt.Errorf("unexpected signing method: %v", token.Method.Alg())
 
 		switch token.Method.Alg() {
 		case jwt.SigningMethodRS256.Name:
-			a.mux.Lock()
-			keys := a.cachedJWKs.Keys
-			a.mux.Unlock()
-			for _, key := range keys {
-				if key.Algorithm() == token.Method.Alg() {
+			keyID, _ := token.Header["kid"].(string)
+			for _, key := range iss.keys() {
+				if key.Algorithm() == token.Method.Alg() && (keyID == "" || key.KeyID() == keyID) {
 					return key.Materialize()
 				}
 			}
 
 			return nil, fmt.Errorf("unable to find key for algorithm %s", token.Method.Alg())
 		case jwt.SigningMethodNone.Alg():
-			if !a.allowJWTSigningNone {
+			if !a.allowJWTSigningNone || iss.trusted {
 				return nil, unsupportedErr
 			}
 			return jwt.UnsafeAllowNoneSignatureType, nil
@@ -193,3 +406,22 @@ func (a *Authenticator) writeAppError(ctx context.Context, w http.ResponseWriter
 		log.C(ctx).WithError(err).Error("An error occurred while encoding data. ")
 	}
 }
+
+func isJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+func unauthorizedOrInternalError(err error) error {
+	if err == errInactiveToken {
+		return apperrors.NewUnauthorizedError(err.Error())
+	}
+	return apperrors.InternalErrorFrom(err, "while introspecting token")
+}
+
+type noopMetricsCollector struct{}
+
+func (noopMetricsCollector) RecordTokenValidation(_, _ string, _ bool) {}
+
+func (noopMetricsCollector) RecordIntrospectionCache(_ string, _ bool) {}
+
+func (noopMetricsCollector) RecordJWKSSynchronization(_ string, _ bool) {}
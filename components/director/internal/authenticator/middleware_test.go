@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/client"
 
@@ -30,6 +31,14 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/kyma-incubator/compass/components/director/internal/authenticator"
+	"github.com/kyma-incubator/compass/components/director/internal/authenticator/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
 )
 
 const defaultTenant = "af9f84a9-1d3a-4d9f-ae0c-94f883b33b6e"
@@ -379,3 +388,606 @@ func assertGraphqlResponse(t *testing.T, expected, actual graphql.Response) {
 	assert.Equal(t, expectedErrCode, actualErrCode)
 	assert.Equal(t, expected.Errors[0].Message, actual.Errors[0].Message)
 }
+
+func TestAuthenticator_TrustedIssuers(t *testing.T) {
+	//given
+	issuerURL := "https://corporate-idp.local"
+	trustedIssuer := authenticator.IssuerConfig{
+		Name:         "corporate-idp",
+		Issuer:       issuerURL,
+		JWKSEndpoint: PublicJWKS2URL,
+		ClaimsMapping: authenticator.ClaimsMapping{
+			ExternalTenant: "zid",
+			Scopes:         "scp",
+		},
+	}
+	externalTenant := "external-tenant"
+
+	privateJWKS, err := authenticator.FetchJWK(context.TODO(), PrivateJWKSURL)
+	require.NoError(t, err)
+	privateJWKS2, err := authenticator.FetchJWK(context.TODO(), PrivateJWKS2URL)
+	require.NoError(t, err)
+
+	t.Run("Success - token of trusted issuer with mapped claims", func(t *testing.T) {
+		//given
+		metricsCollector := &automock.MetricsCollector{}
+		metricsCollector.On("RecordJWKSSynchronization", mock.Anything, true).Twice()
+		metricsCollector.On("RecordTokenValidation", trustedIssuer.Name, "jwt", true).Once()
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		tenantRepo := &automock.TenantRepository{}
+		tenantRepo.On("GetByExternalTenant", txtest.CtxWithDBMatcher(), externalTenant).Return(&model.BusinessTenantMapping{ID: defaultTenant, ExternalTenant: externalTenant}, nil).Once()
+		defer mock.AssertExpectationsForObjects(t, metricsCollector, persistTx, transact, tenantRepo)
+
+		middleware := createMiddlewareWithTenants(t, authenticator.Config{JWKSEndpoint: PublicJWKSURL, TrustedIssuers: []authenticator.IssuerConfig{trustedIssuer}}, transact, tenantRepo, nil, metricsCollector)
+		handler := testHandlerWithConsumer(t, defaultTenant, "scope-a scope-b", consumer.Consumer{ConsumerID: "john.doe", ConsumerType: consumer.User})
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+
+		token := createTokenWithClaims(t, jwt.MapClaims{
+			"iss":    issuerURL,
+			"sub":    "john.doe",
+			"tenant": "injected-tenant",
+			"zid":    externalTenant,
+			"scp":    []string{"scope-a", "scope-b"},
+		}, privateJWKS2.Keys[0])
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, "OK", rr.Body.String())
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Error - external tenant of trusted issuer does not exist or is inactive", func(t *testing.T) {
+		//given
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		tenantRepo := &automock.TenantRepository{}
+		tenantRepo.On("GetByExternalTenant", txtest.CtxWithDBMatcher(), externalTenant).Return(nil, apperrors.NewNotFoundError(resource.Tenant, externalTenant)).Once()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact, tenantRepo)
+
+		middleware := createMiddlewareWithTenants(t, authenticator.Config{JWKSEndpoint: PublicJWKSURL, TrustedIssuers: []authenticator.IssuerConfig{trustedIssuer}}, transact, tenantRepo, nil, nil)
+		handler := testHandler(t, defaultTenant, "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+
+		token := createTokenWithClaims(t, jwt.MapClaims{
+			"iss":    issuerURL,
+			"sub":    "john.doe",
+			"tenant": defaultTenant,
+			"zid":    externalTenant,
+			"scp":    "scope-a",
+		}, privateJWKS2.Keys[0])
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		var response graphql.Response
+		err = json.Unmarshal(rr.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		expected := fixGraphqlResponse(apperrors.NewTenantNotFoundError(externalTenant).Error(), apperrors.TenantNotFound)
+		assertGraphqlResponse(t, expected, response)
+	})
+
+	t.Run("Error - tenant of trusted issuer cannot be resolved", func(t *testing.T) {
+		//given
+		persistTx, transact := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		tenantRepo := &automock.TenantRepository{}
+		tenantRepo.On("GetByExternalTenant", txtest.CtxWithDBMatcher(), externalTenant).Return(nil, errors.New("db error")).Once()
+		defer mock.AssertExpectationsForObjects(t, persistTx, transact, tenantRepo)
+
+		middleware := createMiddlewareWithTenants(t, authenticator.Config{JWKSEndpoint: PublicJWKSURL, TrustedIssuers: []authenticator.IssuerConfig{trustedIssuer}}, transact, tenantRepo, nil, nil)
+		handler := testHandler(t, defaultTenant, "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+
+		token := createTokenWithClaims(t, jwt.MapClaims{
+			"iss": issuerURL,
+			"sub": "john.doe",
+			"zid": externalTenant,
+			"scp": "scope-a",
+		}, privateJWKS2.Keys[0])
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+		var response graphql.Response
+		err = json.Unmarshal(rr.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		expected := fixGraphqlResponse(fmt.Sprintf("Internal Server Error: while getting tenant with external ID %s: db error", externalTenant), apperrors.InternalError)
+		assertGraphqlResponse(t, expected, response)
+	})
+
+	t.Run("Success - token of default issuer next to trusted issuers", func(t *testing.T) {
+		//given
+		middleware := createMiddlewareWithConfig(t, authenticator.Config{JWKSEndpoint: PublicJWKSURL, TrustedIssuers: []authenticator.IssuerConfig{trustedIssuer}}, nil, nil)
+		handler := testHandler(t, defaultTenant, "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+
+		token := createTokenWithSigningMethod(t, defaultTenant, "scope-a", privateJWKS.Keys[0])
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, "OK", rr.Body.String())
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Error - token of trusted issuer signed with key of other issuer", func(t *testing.T) {
+		//given
+		middleware := createMiddlewareWithConfig(t, authenticator.Config{JWKSEndpoint: PublicJWKSURL, TrustedIssuers: []authenticator.IssuerConfig{trustedIssuer}}, nil, nil)
+		handler := testHandler(t, defaultTenant, "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+
+		token := createTokenWithClaims(t, jwt.MapClaims{"iss": issuerURL, "sub": "john.doe"}, privateJWKS.Keys[0])
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		var response graphql.Response
+		err = json.Unmarshal(rr.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		expected := fixGraphqlResponse("Unauthorized [reason=crypto/rsa: verification error]", apperrors.Unauthorized)
+		assertGraphqlResponse(t, expected, response)
+	})
+
+	t.Run("Error - token of trusted issuer with no signing method", func(t *testing.T) {
+		//given
+		middleware := createMiddlewareWithConfig(t, authenticator.Config{JWKSEndpoint: PublicJWKSURL, AllowJWTSigningNone: true, TrustedIssuers: []authenticator.IssuerConfig{trustedIssuer}}, nil, nil)
+		handler := testHandler(t, defaultTenant, "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+
+		token := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{"iss": issuerURL, "sub": "john.doe"})
+		signedToken, err := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+		require.NoError(t, err)
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", signedToken))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		var response graphql.Response
+		err = json.Unmarshal(rr.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		expected := fixGraphqlResponse("Unauthorized [reason=unexpected signing method: none]", apperrors.Unauthorized)
+		assertGraphqlResponse(t, expected, response)
+	})
+
+	t.Run("Error - token of untrusted issuer without default issuer", func(t *testing.T) {
+		//given
+		middleware := createMiddlewareWithConfig(t, authenticator.Config{TrustedIssuers: []authenticator.IssuerConfig{trustedIssuer}}, nil, nil)
+		handler := testHandler(t, defaultTenant, "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+
+		token := createTokenWithClaims(t, jwt.MapClaims{"iss": "https://other-idp.local", "sub": "john.doe"}, privateJWKS2.Keys[0])
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		var response graphql.Response
+		err = json.Unmarshal(rr.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		expected := fixGraphqlResponse("Unauthorized [reason=token is not issued by a trusted issuer]", apperrors.Unauthorized)
+		assertGraphqlResponse(t, expected, response)
+	})
+
+	t.Run("Error - token of untrusted issuer next to default issuer", func(t *testing.T) {
+		//given
+		middleware := createMiddlewareWithConfig(t, authenticator.Config{JWKSEndpoint: PublicJWKSURL, TrustedIssuers: []authenticator.IssuerConfig{trustedIssuer}}, nil, nil)
+		handler := testHandler(t, defaultTenant, "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+
+		token := createTokenWithClaims(t, jwt.MapClaims{"iss": "https://other-idp.local", "tenant": defaultTenant, "scopes": "scope-a"}, privateJWKS.Keys[0])
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		var response graphql.Response
+		err = json.Unmarshal(rr.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		expected := fixGraphqlResponse("Unauthorized [reason=token is issued by untrusted issuer https://other-idp.local]", apperrors.Unauthorized)
+		assertGraphqlResponse(t, expected, response)
+	})
+
+	t.Run("Success - token of default issuer with its issuer claim next to trusted issuers", func(t *testing.T) {
+		//given
+		middleware := createMiddlewareWithConfig(t, authenticator.Config{JWKSEndpoint: PublicJWKSURL, Issuer: "https://oathkeeper.local", TrustedIssuers: []authenticator.IssuerConfig{trustedIssuer}}, nil, nil)
+		handler := testHandler(t, defaultTenant, "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+
+		token := createTokenWithClaims(t, jwt.MapClaims{"iss": "https://oathkeeper.local", "tenant": defaultTenant, "scopes": "scope-a"}, privateJWKS.Keys[0])
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, "OK", rr.Body.String())
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Error - unsigned token of default issuer next to trusted issuers", func(t *testing.T) {
+		//given
+		middleware := createMiddlewareWithConfig(t, authenticator.Config{JWKSEndpoint: PublicJWKSURL, AllowJWTSigningNone: true, TrustedIssuers: []authenticator.IssuerConfig{trustedIssuer}}, nil, nil)
+		handler := testHandler(t, defaultTenant, "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+
+		token := createNotSingedToken(t, defaultTenant, "scope-a")
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		var response graphql.Response
+		err = json.Unmarshal(rr.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		expected := fixGraphqlResponse("Unauthorized [reason=unexpected signing method: none]", apperrors.Unauthorized)
+		assertGraphqlResponse(t, expected, response)
+	})
+
+	issuerWithAudience := trustedIssuer
+	issuerWithAudience.Audience = "director"
+
+	t.Run("Success - token of trusted issuer for its audience", func(t *testing.T) {
+		//given
+		middleware := createMiddlewareWithConfig(t, authenticator.Config{TrustedIssuers: []authenticator.IssuerConfig{issuerWithAudience}}, nil, nil)
+		handler := testHandler(t, "", "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+
+		token := createTokenWithClaims(t, jwt.MapClaims{"iss": issuerURL, "aud": []string{"other-client", "director"}, "sub": "john.doe", "scp": "scope-a"}, privateJWKS2.Keys[0])
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, "OK", rr.Body.String())
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Error - token of trusted issuer for other audience", func(t *testing.T) {
+		//given
+		middleware := createMiddlewareWithConfig(t, authenticator.Config{TrustedIssuers: []authenticator.IssuerConfig{issuerWithAudience}}, nil, nil)
+		handler := testHandler(t, "", "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+
+		token := createTokenWithClaims(t, jwt.MapClaims{"iss": issuerURL, "aud": "other-client", "sub": "john.doe", "scp": "scope-a"}, privateJWKS2.Keys[0])
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		var response graphql.Response
+		err = json.Unmarshal(rr.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		expected := fixGraphqlResponse("Unauthorized [reason=token is not issued for audience director]", apperrors.Unauthorized)
+		assertGraphqlResponse(t, expected, response)
+	})
+}
+
+func TestAuthenticator_StrictMode(t *testing.T) {
+	//given
+	privateJWKS, err := authenticator.FetchJWK(context.TODO(), PrivateJWKSURL)
+	require.NoError(t, err)
+
+	t.Run("Success - token with expiration time", func(t *testing.T) {
+		//given
+		middleware := createMiddlewareWithConfig(t, authenticator.Config{JWKSEndpoint: PublicJWKSURL, StrictMode: true}, nil, nil)
+		handler := testHandler(t, defaultTenant, "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+
+		token := createTokenWithClaims(t, jwt.MapClaims{
+			"tenant": defaultTenant,
+			"scopes": "scope-a",
+			"exp":    time.Now().Add(time.Hour).Unix(),
+		}, privateJWKS.Keys[0])
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, "OK", rr.Body.String())
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("Error - token with no signing method even if it's allowed", func(t *testing.T) {
+		//given
+		middleware := createMiddlewareWithConfig(t, authenticator.Config{JWKSEndpoint: PublicJWKSURL, AllowJWTSigningNone: true, StrictMode: true}, nil, nil)
+		handler := testHandler(t, defaultTenant, "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+
+		token := createNotSingedToken(t, defaultTenant, "scope-a")
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		var response graphql.Response
+		err = json.Unmarshal(rr.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		expected := fixGraphqlResponse("Unauthorized [reason=unexpected signing method: none]", apperrors.Unauthorized)
+		assertGraphqlResponse(t, expected, response)
+	})
+
+	t.Run("Error - token without expiration time", func(t *testing.T) {
+		//given
+		middleware := createMiddlewareWithConfig(t, authenticator.Config{JWKSEndpoint: PublicJWKSURL, StrictMode: true}, nil, nil)
+		handler := testHandler(t, defaultTenant, "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+
+		token := createTokenWithSigningMethod(t, defaultTenant, "scope-a", privateJWKS.Keys[0])
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		var response graphql.Response
+		err = json.Unmarshal(rr.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		expected := fixGraphqlResponse("Unauthorized [reason=token does not have expiration time]", apperrors.Unauthorized)
+		assertGraphqlResponse(t, expected, response)
+	})
+}
+
+func TestAuthenticator_Introspection(t *testing.T) {
+	//given
+	const (
+		activeToken   = "active-token"
+		inactiveToken = "inactive-token"
+		clientID      = "director"
+		clientSecret  = "secret"
+	)
+
+	newIntrospectionServer := func(t *testing.T, calls *int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*calls++
+			user, password, ok := r.BasicAuth()
+			require.True(t, ok)
+			require.Equal(t, clientID, user)
+			require.Equal(t, clientSecret, password)
+			require.NoError(t, r.ParseForm())
+
+			response := map[string]interface{}{"active": false}
+			if r.PostForm.Get("token") == activeToken {
+				response = map[string]interface{}{
+					"active":    true,
+					"sub":       "service-account",
+					"tenant_id": "external-tenant",
+					"scope":     "scope-a scope-b",
+					"exp":       time.Now().Add(time.Hour).Unix(),
+				}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			require.NoError(t, json.NewEncoder(w).Encode(response))
+		}))
+	}
+
+	fixIssuer := func(endpoint string) authenticator.IssuerConfig {
+		return authenticator.IssuerConfig{
+			Name:   "partner-idp",
+			Issuer: "https://partner-idp.local",
+			Introspection: &authenticator.IntrospectionConfig{
+				Endpoint:     endpoint,
+				ClientID:     clientID,
+				ClientSecret: clientSecret,
+			},
+			ClaimsMapping: authenticator.ClaimsMapping{
+				ExternalTenant: "tenant_id",
+				Scopes:         "scope",
+			},
+		}
+	}
+
+	t.Run("Success - active opaque token is cached", func(t *testing.T) {
+		//given
+		calls := 0
+		server := newIntrospectionServer(t, &calls)
+		defer server.Close()
+
+		metricsCollector := &automock.MetricsCollector{}
+		metricsCollector.On("RecordJWKSSynchronization", "default", true).Once()
+		metricsCollector.On("RecordIntrospectionCache", "partner-idp", false).Once()
+		metricsCollector.On("RecordIntrospectionCache", "partner-idp", true).Once()
+		metricsCollector.On("RecordTokenValidation", "partner-idp", "introspection", true).Twice()
+		persistTx := &persistenceautomock.PersistenceTx{}
+		persistTx.On("Commit").Return(nil).Twice()
+		transact := &persistenceautomock.Transactioner{}
+		transact.On("Begin").Return(persistTx, nil).Twice()
+		transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Twice()
+		tenantRepo := &automock.TenantRepository{}
+		tenantRepo.On("GetByExternalTenant", txtest.CtxWithDBMatcher(), "external-tenant").Return(&model.BusinessTenantMapping{ID: defaultTenant, ExternalTenant: "external-tenant"}, nil).Twice()
+		defer mock.AssertExpectationsForObjects(t, metricsCollector, persistTx, transact, tenantRepo)
+
+		middleware := createMiddlewareWithTenants(t, authenticator.Config{JWKSEndpoint: PublicJWKSURL, TrustedIssuers: []authenticator.IssuerConfig{fixIssuer(server.URL)}}, transact, tenantRepo, server.Client(), metricsCollector)
+		handler := testHandlerWithConsumer(t, defaultTenant, "scope-a scope-b", consumer.Consumer{ConsumerID: "service-account", ConsumerType: consumer.User})
+
+		for i := 0; i < 2; i++ {
+			rr := httptest.NewRecorder()
+			req := fixEmptyRequest(t)
+			req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", activeToken))
+
+			//when
+			middleware(handler).ServeHTTP(rr, req)
+
+			//then
+			assert.Equal(t, "OK", rr.Body.String())
+			assert.Equal(t, http.StatusOK, rr.Code)
+		}
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Error - inactive opaque token", func(t *testing.T) {
+		//given
+		calls := 0
+		server := newIntrospectionServer(t, &calls)
+		defer server.Close()
+
+		middleware := createMiddlewareWithConfig(t, authenticator.Config{JWKSEndpoint: PublicJWKSURL, TrustedIssuers: []authenticator.IssuerConfig{fixIssuer(server.URL)}}, server.Client(), nil)
+		handler := testHandler(t, defaultTenant, "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", inactiveToken))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		var response graphql.Response
+		err := json.Unmarshal(rr.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		expected := fixGraphqlResponse("Unauthorized [reason=token is not active]", apperrors.Unauthorized)
+		assertGraphqlResponse(t, expected, response)
+	})
+
+	t.Run("Error - active opaque token for other audience", func(t *testing.T) {
+		//given
+		calls := 0
+		server := newIntrospectionServer(t, &calls)
+		defer server.Close()
+
+		issuer := fixIssuer(server.URL)
+		issuer.Audience = "director"
+		middleware := createMiddlewareWithConfig(t, authenticator.Config{JWKSEndpoint: PublicJWKSURL, TrustedIssuers: []authenticator.IssuerConfig{issuer}}, server.Client(), nil)
+		handler := testHandler(t, defaultTenant, "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", activeToken))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		var response graphql.Response
+		err := json.Unmarshal(rr.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		expected := fixGraphqlResponse("Unauthorized [reason=token is not active]", apperrors.Unauthorized)
+		assertGraphqlResponse(t, expected, response)
+	})
+
+	t.Run("Error - introspection endpoint fails", func(t *testing.T) {
+		//given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		middleware := createMiddlewareWithConfig(t, authenticator.Config{JWKSEndpoint: PublicJWKSURL, TrustedIssuers: []authenticator.IssuerConfig{fixIssuer(server.URL)}}, server.Client(), nil)
+		handler := testHandler(t, defaultTenant, "scope-a")
+		rr := httptest.NewRecorder()
+		req := fixEmptyRequest(t)
+		req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", activeToken))
+
+		//when
+		middleware(handler).ServeHTTP(rr, req)
+
+		//then
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		var response graphql.Response
+		err := json.Unmarshal(rr.Body.Bytes(), &response)
+		require.NoError(t, err)
+
+		expected := fixGraphqlResponse(fmt.Sprintf("Internal Server Error: while introspecting token: introspection endpoint %s responded with status code 500", server.URL), apperrors.InternalError)
+		assertGraphqlResponse(t, expected, response)
+	})
+}
+
+func TestNewWithConfig(t *testing.T) {
+	t.Run("Error when trusted issuer is invalid", func(t *testing.T) {
+		//when
+		_, err := authenticator.NewWithConfig(authenticator.Config{TrustedIssuers: []authenticator.IssuerConfig{{Name: "idp"}}}, nil, nil, http.DefaultClient, nil)
+
+		//then
+		require.Error(t, err)
+		assert.EqualError(t, err, `while validating trusted issuer "idp": issuer is required`)
+	})
+}
+
+func createTokenWithClaims(t *testing.T, claims jwt.MapClaims, key jwk.Key) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+
+	materializedKey, err := key.Materialize()
+	require.NoError(t, err)
+	signedToken, err := token.SignedString(materializedKey)
+	require.NoError(t, err)
+
+	return signedToken
+}
+
+func createMiddlewareWithConfig(t *testing.T, cfg authenticator.Config, httpClient *http.Client, metricsCollector authenticator.MetricsCollector) func(next http.Handler) http.Handler {
+	return createMiddlewareWithTenants(t, cfg, nil, nil, httpClient, metricsCollector)
+}
+
+func createMiddlewareWithTenants(t *testing.T, cfg authenticator.Config, transact persistence.Transactioner, tenantRepo authenticator.TenantRepository, httpClient *http.Client, metricsCollector authenticator.MetricsCollector) func(next http.Handler) http.Handler {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	auth, err := authenticator.NewWithConfig(cfg, transact, tenantRepo, httpClient, metricsCollector)
+	require.NoError(t, err)
+	err = auth.SynchronizeJWKS(context.TODO())
+	require.NoError(t, err)
+	return auth.Handler()
+}
+
+func testHandlerWithConsumer(t *testing.T, expectedTenant, scopes string, expectedConsumer consumer.Consumer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		consumerFromContext, err := consumer.LoadFromContext(r.Context())
+		require.NoError(t, err)
+		require.Equal(t, expectedConsumer, consumerFromContext)
+
+		testHandler(t, expectedTenant, scopes)(w, r)
+	}
+}
@@ -9,6 +9,7 @@ type ConsumerType string
 
 const (
 	Runtime           ConsumerType = "Runtime"
+	RuntimeContext    ConsumerType = "Runtime Context"
 	Application       ConsumerType = "Application"
 	IntegrationSystem ConsumerType = "Integration System"
 	User              ConsumerType = "Static User"
@@ -25,6 +26,8 @@ func MapSystemAuthToConsumerType(refObj model.SystemAuthReferenceObjectType) (Co
 		return Application, nil
 	case model.RuntimeReference:
 		return Runtime, nil
+	case model.RuntimeContextReference:
+		return RuntimeContext, nil
 	case model.IntegrationSystemReference:
 		return IntegrationSystem, nil
 	}
@@ -31,6 +31,11 @@ func TestMapSystemAuthToConsumerType(t *testing.T) {
 			sysAuthRefInput: model.RuntimeReference,
 			expected:        consumer.Runtime,
 		},
+		{
+			name:            "Success - Map to runtime context",
+			sysAuthRefInput: model.RuntimeContextReference,
+			expected:        consumer.RuntimeContext,
+		},
 		{
 			name:            "Success - Map to integration system",
 			sysAuthRefInput: model.IntegrationSystemReference,
@@ -0,0 +1,41 @@
+package dataloader
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type APIDefinitionPageLoader struct {
+	loader *loader
+}
+
+func NewAPIDefinitionPageLoader(fetch func(keys []ParamPage) ([]*graphql.APIDefinitionPage, []error), maxBatch int, wait time.Duration) *APIDefinitionPageLoader {
+	return &APIDefinitionPageLoader{
+		loader: newLoader(func(keys []interface{}) ([]interface{}, []error) {
+			params := make([]ParamPage, 0, len(keys))
+			for _, key := range keys {
+				params = append(params, key.(ParamPage))
+			}
+
+			results, errs := fetch(params)
+
+			data := make([]interface{}, 0, len(results))
+			for _, result := range results {
+				data = append(data, result)
+			}
+
+			return data, errs
+		}, maxBatch, wait),
+	}
+}
+
+func (l *APIDefinitionPageLoader) Load(key ParamPage) (*graphql.APIDefinitionPage, error) {
+	data, err := l.loader.load(pageBatchKey(key.First, key.After), key.ID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	result, _ := data.(*graphql.APIDefinitionPage)
+	return result, nil
+}
@@ -0,0 +1,98 @@
+package dataloader
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type contextKey string
+
+const loadersCtxKey contextKey = "DataLoaders"
+
+type Config struct {
+	MaxBatch int           `envconfig:"default=200"`
+	Wait     time.Duration `envconfig:"default=5ms"`
+}
+
+// ParamPage is a key for loading a page of objects related to the parent object with the given ID
+type ParamPage struct {
+	ID    string
+	Ctx   context.Context
+	First *int
+	After *graphql.PageCursor
+}
+
+// ParamID is a key for loading all objects related to the parent object with the given ID
+type ParamID struct {
+	ID  string
+	Ctx context.Context
+}
+
+// FetchFuncs contains the batch functions used by the request-scoped loaders.
+// Every function has to return the results in the same order as the given keys.
+type FetchFuncs struct {
+	PackagesByApplicationID   func(keys []ParamPage) ([]*graphql.PackagePage, []error)
+	APIDefinitionsByPackageID func(keys []ParamPage) ([]*graphql.APIDefinitionPage, []error)
+	InstanceAuthsByPackageID  func(keys []ParamID) ([][]*graphql.PackageInstanceAuth, []error)
+	LabelsByApplicationID     func(keys []ParamID) ([]*graphql.Labels, []error)
+	AuthsByRuntimeID          func(keys []ParamID) ([][]*graphql.SystemAuth, []error)
+}
+
+type Loaders struct {
+	PackagesByApplicationID   *PackagePageLoader
+	APIDefinitionsByPackageID *APIDefinitionPageLoader
+	InstanceAuthsByPackageID  *PackageInstanceAuthsLoader
+	LabelsByApplicationID     *LabelsLoader
+	AuthsByRuntimeID          *SystemAuthsLoader
+}
+
+func NewLoaders(fetch FetchFuncs, cfg Config) *Loaders {
+	return &Loaders{
+		PackagesByApplicationID:   NewPackagePageLoader(fetch.PackagesByApplicationID, cfg.MaxBatch, cfg.Wait),
+		APIDefinitionsByPackageID: NewAPIDefinitionPageLoader(fetch.APIDefinitionsByPackageID, cfg.MaxBatch, cfg.Wait),
+		InstanceAuthsByPackageID:  NewPackageInstanceAuthsLoader(fetch.InstanceAuthsByPackageID, cfg.MaxBatch, cfg.Wait),
+		LabelsByApplicationID:     NewLabelsLoader(fetch.LabelsByApplicationID, cfg.MaxBatch, cfg.Wait),
+		AuthsByRuntimeID:          NewSystemAuthsLoader(fetch.AuthsByRuntimeID, cfg.MaxBatch, cfg.Wait),
+	}
+}
+
+// Handler attaches a new set of loaders to the context of every request,
+// so that objects are batched only within a single GraphQL request.
+func Handler(fetch FetchFuncs, cfg Config) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := SaveToContext(r.Context(), NewLoaders(fetch, cfg))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func SaveToContext(ctx context.Context, loaders *Loaders) context.Context {
+	return context.WithValue(ctx, loadersCtxKey, loaders)
+}
+
+// LoadFromContext returns the loaders attached to the request or nil if there are none
+func LoadFromContext(ctx context.Context) *Loaders {
+	loaders, ok := ctx.Value(loadersCtxKey).(*Loaders)
+	if !ok {
+		return nil
+	}
+
+	return loaders
+}
+
+func pageBatchKey(first *int, after *graphql.PageCursor) string {
+	var pageSize, cursor string
+	if first != nil {
+		pageSize = fmt.Sprintf("%d", *first)
+	}
+	if after != nil {
+		cursor = string(*after)
+	}
+
+	return fmt.Sprintf("%s/%s", pageSize, cursor)
+}
@@ -0,0 +1,113 @@
+package dataloader
+
+import (
+	"sync"
+	"time"
+)
+
+// fetchFunc loads the values for all given keys at once.
+// It has to return the values in the same order as the keys, and either one error per key
+// or a single error for the whole batch.
+type fetchFunc func(keys []interface{}) ([]interface{}, []error)
+
+// loader collects keys requested within the wait period into batches and resolves every batch
+// with a single call to fetch. Keys are batched together only if they share the same batch key,
+// which allows e.g. to load pages of different size separately. Keys with the same ID are fetched only once per batch.
+type loader struct {
+	fetch    fetchFunc
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	batches map[string]*loaderBatch
+}
+
+type loaderBatch struct {
+	keys    []interface{}
+	ids     map[string]int
+	data    []interface{}
+	errors  []error
+	closing bool
+	done    chan struct{}
+}
+
+func newLoader(fetch fetchFunc, maxBatch int, wait time.Duration) *loader {
+	return &loader{
+		fetch:    fetch,
+		wait:     wait,
+		maxBatch: maxBatch,
+		batches:  map[string]*loaderBatch{},
+	}
+}
+
+func (l *loader) load(batchKey string, id string, key interface{}) (interface{}, error) {
+	l.mu.Lock()
+	batch, ok := l.batches[batchKey]
+	if !ok {
+		batch = &loaderBatch{ids: map[string]int{}, done: make(chan struct{})}
+		l.batches[batchKey] = batch
+	}
+	pos := batch.keyIndex(l, batchKey, id, key)
+	l.mu.Unlock()
+
+	<-batch.done
+
+	var data interface{}
+	if pos < len(batch.data) {
+		data = batch.data[pos]
+	}
+
+	var err error
+	if len(batch.errors) == 1 {
+		err = batch.errors[0]
+	} else if pos < len(batch.errors) {
+		err = batch.errors[pos]
+	}
+
+	return data, err
+}
+
+// keyIndex appends the key to the batch and returns its position; it must be called with the loader lock held
+func (b *loaderBatch) keyIndex(l *loader, batchKey string, id string, key interface{}) int {
+	if pos, ok := b.ids[id]; ok {
+		return pos
+	}
+
+	pos := len(b.keys)
+	b.keys = append(b.keys, key)
+	b.ids[id] = pos
+
+	if pos == 0 {
+		go b.startTimer(l, batchKey)
+	}
+
+	if l.maxBatch != 0 && pos >= l.maxBatch-1 && !b.closing {
+		b.closing = true
+		delete(l.batches, batchKey)
+		go b.end(l)
+	}
+
+	return pos
+}
+
+func (b *loaderBatch) startTimer(l *loader, batchKey string) {
+	time.Sleep(l.wait)
+	l.mu.Lock()
+
+	// the batch has been closed because it reached the maximum size
+	if b.closing {
+		l.mu.Unlock()
+		return
+	}
+
+	b.closing = true
+	delete(l.batches, batchKey)
+	l.mu.Unlock()
+
+	b.end(l)
+}
+
+func (b *loaderBatch) end(l *loader) {
+	b.data, b.errors = l.fetch(b.keys)
+	close(b.done)
+}
@@ -0,0 +1,149 @@
+package dataloader
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoader_Load(t *testing.T) {
+	t.Run("batches keys requested within the wait period", func(t *testing.T) {
+		// GIVEN
+		fetch, calls := fixEchoFetchFunc()
+		l := newLoader(fetch, 0, 10*time.Millisecond)
+
+		// WHEN
+		results, errs := loadConcurrently(l, "", []string{"a", "b", "c"})
+
+		// THEN
+		assert.Equal(t, []interface{}{"a-value", "b-value", "c-value"}, results)
+		assert.Equal(t, []error{nil, nil, nil}, errs)
+		require.Len(t, *calls, 1)
+		assert.ElementsMatch(t, []interface{}{"a", "b", "c"}, (*calls)[0])
+	})
+
+	t.Run("fetches duplicated keys only once", func(t *testing.T) {
+		// GIVEN
+		fetch, calls := fixEchoFetchFunc()
+		l := newLoader(fetch, 0, 10*time.Millisecond)
+
+		// WHEN
+		results, _ := loadConcurrently(l, "", []string{"a", "a", "b"})
+
+		// THEN
+		assert.Equal(t, []interface{}{"a-value", "a-value", "b-value"}, results)
+		require.Len(t, *calls, 1)
+		assert.ElementsMatch(t, []interface{}{"a", "b"}, (*calls)[0])
+	})
+
+	t.Run("splits batches exceeding the maximum batch size", func(t *testing.T) {
+		// GIVEN
+		fetch, calls := fixEchoFetchFunc()
+		l := newLoader(fetch, 2, 10*time.Millisecond)
+
+		// WHEN
+		results, _ := loadConcurrently(l, "", []string{"a", "b", "c"})
+
+		// THEN
+		assert.Equal(t, []interface{}{"a-value", "b-value", "c-value"}, results)
+		assert.Len(t, *calls, 2)
+	})
+
+	t.Run("fetches keys with different batch keys separately", func(t *testing.T) {
+		// GIVEN
+		fetch, calls := fixEchoFetchFunc()
+		l := newLoader(fetch, 0, 10*time.Millisecond)
+		wg := sync.WaitGroup{}
+
+		// WHEN
+		for _, batchKey := range []string{"first", "second"} {
+			wg.Add(1)
+			go func(batchKey string) {
+				defer wg.Done()
+				_, _ = l.load(batchKey, "a", "a")
+			}(batchKey)
+		}
+		wg.Wait()
+
+		// THEN
+		assert.Len(t, *calls, 2)
+	})
+
+	t.Run("returns single batch error for every key", func(t *testing.T) {
+		// GIVEN
+		testErr := errors.New("test error")
+		l := newLoader(func(keys []interface{}) ([]interface{}, []error) {
+			return nil, []error{testErr}
+		}, 0, 10*time.Millisecond)
+
+		// WHEN
+		results, errs := loadConcurrently(l, "", []string{"a", "b"})
+
+		// THEN
+		assert.Equal(t, []interface{}{nil, nil}, results)
+		assert.Equal(t, []error{testErr, testErr}, errs)
+	})
+
+	t.Run("returns error for each key", func(t *testing.T) {
+		// GIVEN
+		testErr := errors.New("test error")
+		l := newLoader(func(keys []interface{}) ([]interface{}, []error) {
+			data := make([]interface{}, len(keys))
+			errs := make([]error, len(keys))
+			for i, key := range keys {
+				if key == "b" {
+					errs[i] = testErr
+					continue
+				}
+				data[i] = key
+			}
+			return data, errs
+		}, 0, 10*time.Millisecond)
+
+		// WHEN
+		data, err := l.load("", "b", "b")
+
+		// THEN
+		assert.Nil(t, data)
+		assert.Equal(t, testErr, err)
+	})
+}
+
+func fixEchoFetchFunc() (fetchFunc, *[][]interface{}) {
+	var mu sync.Mutex
+	var calls [][]interface{}
+
+	return func(keys []interface{}) ([]interface{}, []error) {
+		mu.Lock()
+		calls = append(calls, keys)
+		mu.Unlock()
+
+		data := make([]interface{}, 0, len(keys))
+		for _, key := range keys {
+			data = append(data, fmt.Sprintf("%s-value", key))
+		}
+		return data, nil
+	}, &calls
+}
+
+func loadConcurrently(l *loader, batchKey string, ids []string) ([]interface{}, []error) {
+	results := make([]interface{}, len(ids))
+	errs := make([]error, len(ids))
+
+	wg := sync.WaitGroup{}
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			results[i], errs[i] = l.load(batchKey, id, id)
+		}(i, id)
+	}
+	wg.Wait()
+
+	return results, errs
+}
@@ -0,0 +1,41 @@
+package dataloader
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type SystemAuthsLoader struct {
+	loader *loader
+}
+
+func NewSystemAuthsLoader(fetch func(keys []ParamID) ([][]*graphql.SystemAuth, []error), maxBatch int, wait time.Duration) *SystemAuthsLoader {
+	return &SystemAuthsLoader{
+		loader: newLoader(func(keys []interface{}) ([]interface{}, []error) {
+			params := make([]ParamID, 0, len(keys))
+			for _, key := range keys {
+				params = append(params, key.(ParamID))
+			}
+
+			results, errs := fetch(params)
+
+			data := make([]interface{}, 0, len(results))
+			for _, result := range results {
+				data = append(data, result)
+			}
+
+			return data, errs
+		}, maxBatch, wait),
+	}
+}
+
+func (l *SystemAuthsLoader) Load(key ParamID) ([]*graphql.SystemAuth, error) {
+	data, err := l.loader.load("", key.ID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	result, _ := data.([]*graphql.SystemAuth)
+	return result, nil
+}
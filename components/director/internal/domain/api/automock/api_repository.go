@@ -146,6 +146,29 @@ func (_m *APIRepository) ListForPackage(ctx context.Context, tenantID string, pa
 	return r0, r1
 }
 
+// ListForPackageIDs provides a mock function with given fields: ctx, tenantID, packageIDs, pageSize, cursor
+func (_m *APIRepository) ListForPackageIDs(ctx context.Context, tenantID string, packageIDs []string, pageSize int, cursor string) ([]*model.APIDefinitionPage, error) {
+	ret := _m.Called(ctx, tenantID, packageIDs, pageSize, cursor)
+
+	var r0 []*model.APIDefinitionPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int, string) []*model.APIDefinitionPage); ok {
+		r0 = rf(ctx, tenantID, packageIDs, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.APIDefinitionPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, packageIDs, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, item
 func (_m *APIRepository) Update(ctx context.Context, item *model.APIDefinition) error {
 	ret := _m.Called(ctx, item)
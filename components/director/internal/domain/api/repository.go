@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
@@ -32,6 +33,7 @@ type pgRepository struct {
 	creator         repo.Creator
 	singleGetter    repo.SingleGetter
 	pageableQuerier repo.PageableQuerier
+	unionLister     repo.UnionLister
 	updater         repo.Updater
 	deleter         repo.Deleter
 	existQuerier    repo.ExistQuerier
@@ -42,6 +44,7 @@ func NewRepository(conv APIDefinitionConverter) *pgRepository {
 	return &pgRepository{
 		singleGetter:    repo.NewSingleGetter(resource.API, apiDefTable, tenantColumn, apiDefColumns),
 		pageableQuerier: repo.NewPageableQuerier(resource.API, apiDefTable, tenantColumn, apiDefColumns),
+		unionLister:     repo.NewUnionLister(resource.API, apiDefTable, tenantColumn, apiDefColumns),
 		creator:         repo.NewCreator(resource.API, apiDefTable, apiDefColumns),
 		updater:         repo.NewUpdater(resource.API, apiDefTable, updatableColumns, tenantColumn, idColumns),
 		deleter:         repo.NewDeleter(resource.API, apiDefTable, tenantColumn),
@@ -63,6 +66,46 @@ func (r *pgRepository) ListForPackage(ctx context.Context, tenantID string, pack
 	return r.list(ctx, tenantID, pageSize, cursor, conditions)
 }
 
+func (r *pgRepository) ListForPackageIDs(ctx context.Context, tenantID string, packageIDs []string, pageSize int, cursor string) ([]*model.APIDefinitionPage, error) {
+	var apiDefCollection APIDefCollection
+	counts, err := r.unionLister.List(ctx, tenantID, packageIDs, "package_id", pageSize, cursor, "id", &apiDefCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	apiDefsByPkgID := map[string][]*model.APIDefinition{}
+	for _, apiDefEnt := range apiDefCollection {
+		m := r.conv.FromEntity(apiDefEnt)
+		apiDefsByPkgID[apiDefEnt.PkgID] = append(apiDefsByPkgID[apiDefEnt.PkgID], &m)
+	}
+
+	offset, err := pagination.DecodeOffsetCursor(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding page cursor")
+	}
+
+	apiDefPages := make([]*model.APIDefinitionPage, 0, len(packageIDs))
+	for _, pkgID := range packageIDs {
+		totalCount := counts[pkgID]
+		hasNextPage := false
+		endCursor := ""
+		if totalCount > offset+len(apiDefsByPkgID[pkgID]) {
+			hasNextPage = true
+			endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
+		}
+
+		page := &pagination.Page{
+			StartCursor: cursor,
+			EndCursor:   endCursor,
+			HasNextPage: hasNextPage,
+		}
+
+		apiDefPages = append(apiDefPages, &model.APIDefinitionPage{Data: apiDefsByPkgID[pkgID], TotalCount: totalCount, PageInfo: page})
+	}
+
+	return apiDefPages, nil
+}
+
 func (r *pgRepository) list(ctx context.Context, tenant string, pageSize int, cursor string, conditions repo.Conditions) (*model.APIDefinitionPage, error) {
 	var apiDefCollection APIDefCollection
 	page, totalCount, err := r.pageableQuerier.List(ctx, tenant, pageSize, cursor, "id", &apiDefCollection, conditions...)
@@ -154,6 +154,76 @@ func TestPgRepository_ListForPackage(t *testing.T) {
 	})
 }
 
+func TestPgRepository_ListForPackageIDs(t *testing.T) {
+	// GIVEN
+	inputPageSize := 3
+	inputCursor := ""
+	secondPkgID := "bbbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	firstApiDefID := "111111111-1111-1111-1111-111111111111"
+	firstApiDefEntity := fixFullEntityAPIDefinition(firstApiDefID, "placeholder")
+	secondApiDefID := "222222222-2222-2222-2222-222222222222"
+	secondApiDefEntity := fixFullEntityAPIDefinition(secondApiDefID, "placeholder")
+	secondApiDefEntity.PkgID = secondPkgID
+
+	selectQuery := `^\(SELECT (.+) FROM "public"."api_definitions" WHERE package_id = \$1 AND tenant_id = \$2 ORDER BY id LIMIT 3 OFFSET 0\) UNION ALL \(SELECT (.+) FROM "public"."api_definitions" WHERE package_id = \$3 AND tenant_id = \$4 ORDER BY id LIMIT 3 OFFSET 0\)`
+	countQuery := regexp.QuoteMeta(`SELECT package_id AS id, COUNT(*) AS total_count FROM "public"."api_definitions" WHERE tenant_id = $1 AND package_id IN ($2, $3) GROUP BY package_id`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		secondApiDefRow := fixAPIDefinitionRow(secondApiDefID, "placeholder")
+		secondApiDefRow[2] = secondPkgID
+		rows := sqlmock.NewRows(fixAPIDefinitionColumns()).
+			AddRow(fixAPIDefinitionRow(firstApiDefID, "placeholder")...).
+			AddRow(secondApiDefRow...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(packageID, tenantID, secondPkgID, tenantID).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(tenantID, packageID, secondPkgID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(packageID, 1).AddRow(secondPkgID, 4))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.APIDefinitionConverter{}
+		convMock.On("FromEntity", firstApiDefEntity).Return(model.APIDefinition{ID: firstApiDefID}, nil)
+		convMock.On("FromEntity", secondApiDefEntity).Return(model.APIDefinition{ID: secondApiDefID}, nil)
+		pgRepository := api.NewRepository(convMock)
+		// WHEN
+		pages, err := pgRepository.ListForPackageIDs(ctx, tenantID, []string{packageID, secondPkgID}, inputPageSize, inputCursor)
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, pages, 2)
+		require.Len(t, pages[0].Data, 1)
+		assert.Equal(t, firstApiDefID, pages[0].Data[0].ID)
+		assert.Equal(t, 1, pages[0].TotalCount)
+		assert.False(t, pages[0].PageInfo.HasNextPage)
+		require.Len(t, pages[1].Data, 1)
+		assert.Equal(t, secondApiDefID, pages[1].Data[0].ID)
+		assert.Equal(t, 4, pages[1].TotalCount)
+		assert.True(t, pages[1].PageInfo.HasNextPage)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		testError := errors.New("test error")
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(packageID, tenantID, secondPkgID, tenantID).
+			WillReturnError(testError)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		pgRepository := api.NewRepository(nil)
+		// WHEN
+		pages, err := pgRepository.ListForPackageIDs(ctx, tenantID, []string{packageID, secondPkgID}, inputPageSize, inputCursor)
+		//THEN
+		require.EqualError(t, err, "while fetching list of objects from DB: test error")
+		assert.Nil(t, pages)
+		sqlMock.AssertExpectations(t)
+	})
+}
+
 func TestPgRepository_Create(t *testing.T) {
 	//GIVEN
 	apiDefModel := fixFullAPIDefinitionModel("placeholder")
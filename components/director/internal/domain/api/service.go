@@ -18,6 +18,7 @@ type APIRepository interface {
 	GetForPackage(ctx context.Context, tenant string, id string, packageID string) (*model.APIDefinition, error)
 	Exists(ctx context.Context, tenant, id string) (bool, error)
 	ListForPackage(ctx context.Context, tenantID, packageID string, pageSize int, cursor string) (*model.APIDefinitionPage, error)
+	ListForPackageIDs(ctx context.Context, tenantID string, packageIDs []string, pageSize int, cursor string) ([]*model.APIDefinitionPage, error)
 	CreateMany(ctx context.Context, item []*model.APIDefinition) error
 	Create(ctx context.Context, item *model.APIDefinition) error
 	Update(ctx context.Context, item *model.APIDefinition) error
@@ -71,6 +72,19 @@ func (s *service) ListForPackage(ctx context.Context, packageID string, pageSize
 	return s.repo.ListForPackage(ctx, tnt, packageID, pageSize, cursor)
 }
 
+func (s *service) ListForPackageIDs(ctx context.Context, packageIDs []string, pageSize int, cursor string) ([]*model.APIDefinitionPage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize < 1 || pageSize > 100 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 100")
+	}
+
+	return s.repo.ListForPackageIDs(ctx, tnt, packageIDs, pageSize, cursor)
+}
+
 func (s *service) Get(ctx context.Context, id string) (*model.APIDefinition, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
@@ -289,6 +289,119 @@ func TestService_ListForPackage(t *testing.T) {
 	})
 }
 
+func TestService_ListForPackageIDs(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	id := "foo"
+	pkgID := "foobar"
+	name := "foo"
+	desc := "bar"
+
+	apiDefinitions := []*model.APIDefinition{
+		fixAPIDefinitionModel(id, pkgID, name, desc),
+		fixAPIDefinitionModel(id, pkgID, name, desc),
+		fixAPIDefinitionModel(id, pkgID, name, desc),
+	}
+	apiDefinitionPages := []*model.APIDefinitionPage{{
+		Data:       apiDefinitions,
+		TotalCount: len(apiDefinitions),
+		PageInfo: &pagination.Page{
+			HasNextPage: false,
+			EndCursor:   "end",
+			StartCursor: "start",
+		},
+	}}
+
+	packageIDs := []string{packageID, pkgID}
+
+	after := "test"
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		PageSize           int
+		RepositoryFn       func() *automock.APIRepository
+		ExpectedResult     []*model.APIDefinitionPage
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("ListForPackageIDs", ctx, tenantID, packageIDs, 2, after).Return(apiDefinitionPages, nil).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     apiDefinitionPages,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Return error when page size is less than 1",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				return repo
+			},
+			PageSize:           0,
+			ExpectedResult:     apiDefinitionPages,
+			ExpectedErrMessage: "page size must be between 1 and 100",
+		},
+		{
+			Name: "Return error when page size is bigger than 100",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				return repo
+			},
+			PageSize:           101,
+			ExpectedResult:     apiDefinitionPages,
+			ExpectedErrMessage: "page size must be between 1 and 100",
+		},
+		{
+			Name: "Returns error when APIDefinition listing failed",
+			RepositoryFn: func() *automock.APIRepository {
+				repo := &automock.APIRepository{}
+				repo.On("ListForPackageIDs", ctx, tenantID, packageIDs, 2, after).Return(nil, testErr).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := api.NewService(repo, nil, nil, nil)
+
+			// when
+			apiPages, err := svc.ListForPackageIDs(ctx, packageIDs, testCase.PageSize, after)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, apiPages)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := api.NewService(nil, nil, nil, nil)
+		// WHEN
+		_, err := svc.ListForPackageIDs(context.TODO(), nil, 5, "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
 func TestService_CreateToPackage(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
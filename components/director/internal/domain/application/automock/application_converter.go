@@ -69,6 +69,22 @@ func (_m *ApplicationConverter) MultipleToGraphQL(in []*model.Application) []*gr
 	return r0
 }
 
+// TemplateReferenceToGraphQL provides a mock function with given fields: in
+func (_m *ApplicationConverter) TemplateReferenceToGraphQL(in *model.ApplicationTemplateReference) *graphql.ApplicationTemplateReference {
+	ret := _m.Called(in)
+
+	var r0 *graphql.ApplicationTemplateReference
+	if rf, ok := ret.Get(0).(func(*model.ApplicationTemplateReference) *graphql.ApplicationTemplateReference); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.ApplicationTemplateReference)
+		}
+	}
+
+	return r0
+}
+
 // ToGraphQL provides a mock function with given fields: in
 func (_m *ApplicationConverter) ToGraphQL(in *model.Application) *graphql.Application {
 	ret := _m.Called(in)
@@ -10,6 +10,8 @@ import (
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -90,6 +92,52 @@ func (_m *ApplicationRepository) GetByID(ctx context.Context, tenant string, id
 	return r0, r1
 }
 
+// GetDeletedByID provides a mock function with given fields: ctx, tenant, id
+func (_m *ApplicationRepository) GetDeletedByID(ctx context.Context, tenant string, id string) (*model.Application, error) {
+	ret := _m.Called(ctx, tenant, id)
+
+	var r0 *model.Application
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Application); ok {
+		r0 = rf(ctx, tenant, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTemplateReference provides a mock function with given fields: ctx, tenant, id
+func (_m *ApplicationRepository) GetTemplateReference(ctx context.Context, tenant string, id string) (*model.ApplicationTemplateReference, error) {
+	ret := _m.Called(ctx, tenant, id)
+
+	var r0 *model.ApplicationTemplateReference
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.ApplicationTemplateReference); ok {
+		r0 = rf(ctx, tenant, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationTemplateReference)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: ctx, tenant, filter, pageSize, cursor
 func (_m *ApplicationRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
 	ret := _m.Called(ctx, tenant, filter, pageSize, cursor)
@@ -159,6 +207,71 @@ func (_m *ApplicationRepository) ListByScenarios(ctx context.Context, tenantID u
 	return r0, r1
 }
 
+// ListDeletedBefore provides a mock function with given fields: ctx, before
+func (_m *ApplicationRepository) ListDeletedBefore(ctx context.Context, before time.Time) ([]*model.Application, error) {
+	ret := _m.Called(ctx, before)
+
+	var r0 []*model.Application
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*model.Application); ok {
+		r0 = rf(ctx, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkAsDeleted provides a mock function with given fields: ctx, tenant, id, deletedAt
+func (_m *ApplicationRepository) MarkAsDeleted(ctx context.Context, tenant string, id string, deletedAt time.Time) error {
+	ret := _m.Called(ctx, tenant, id, deletedAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = rf(ctx, tenant, id, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Restore provides a mock function with given fields: ctx, tenant, id
+func (_m *ApplicationRepository) Restore(ctx context.Context, tenant string, id string) error {
+	ret := _m.Called(ctx, tenant, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, tenant, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetTemplateReference provides a mock function with given fields: ctx, tenant, id, ref
+func (_m *ApplicationRepository) SetTemplateReference(ctx context.Context, tenant string, id string, ref model.ApplicationTemplateReference) error {
+	ret := _m.Called(ctx, tenant, id, ref)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.ApplicationTemplateReference) error); ok {
+		r0 = rf(ctx, tenant, id, ref)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, item
 func (_m *ApplicationRepository) Update(ctx context.Context, item *model.Application) error {
 	ret := _m.Called(ctx, item)
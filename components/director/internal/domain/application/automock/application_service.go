@@ -10,6 +10,8 @@ import (
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -113,6 +115,29 @@ func (_m *ApplicationService) GetLabel(ctx context.Context, applicationID string
 	return r0, r1
 }
 
+// GetTemplateReference provides a mock function with given fields: ctx, id
+func (_m *ApplicationService) GetTemplateReference(ctx context.Context, id string) (*model.ApplicationTemplateReference, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.ApplicationTemplateReference
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ApplicationTemplateReference); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationTemplateReference)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: ctx, filter, pageSize, cursor
 func (_m *ApplicationService) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
 	ret := _m.Called(ctx, filter, pageSize, cursor)
@@ -136,6 +161,29 @@ func (_m *ApplicationService) List(ctx context.Context, filter []*labelfilter.La
 	return r0, r1
 }
 
+// ListByRuntimeContextID provides a mock function with given fields: ctx, runtimeContextUUID, pageSize, cursor
+func (_m *ApplicationService) ListByRuntimeContextID(ctx context.Context, runtimeContextUUID uuid.UUID, pageSize int, cursor string) (*model.ApplicationPage, error) {
+	ret := _m.Called(ctx, runtimeContextUUID, pageSize, cursor)
+
+	var r0 *model.ApplicationPage
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int, string) *model.ApplicationPage); ok {
+		r0 = rf(ctx, runtimeContextUUID, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int, string) error); ok {
+		r1 = rf(ctx, runtimeContextUUID, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListByRuntimeID provides a mock function with given fields: ctx, runtimeUUID, pageSize, cursor
 func (_m *ApplicationService) ListByRuntimeID(ctx context.Context, runtimeUUID uuid.UUID, pageSize int, cursor string) (*model.ApplicationPage, error) {
 	ret := _m.Called(ctx, runtimeUUID, pageSize, cursor)
@@ -159,6 +207,29 @@ func (_m *ApplicationService) ListByRuntimeID(ctx context.Context, runtimeUUID u
 	return r0, r1
 }
 
+// ListDeletedBefore provides a mock function with given fields: ctx, before
+func (_m *ApplicationService) ListDeletedBefore(ctx context.Context, before time.Time) ([]*model.Application, error) {
+	ret := _m.Called(ctx, before)
+
+	var r0 []*model.Application
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []*model.Application); ok {
+		r0 = rf(ctx, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListLabels provides a mock function with given fields: ctx, applicationID
 func (_m *ApplicationService) ListLabels(ctx context.Context, applicationID string) (map[string]*model.Label, error) {
 	ret := _m.Called(ctx, applicationID)
@@ -182,6 +253,80 @@ func (_m *ApplicationService) ListLabels(ctx context.Context, applicationID stri
 	return r0, r1
 }
 
+// ListLabelsForApplicationIDs provides a mock function with given fields: ctx, applicationIDs
+func (_m *ApplicationService) ListLabelsForApplicationIDs(ctx context.Context, applicationIDs []string) (map[string]map[string]*model.Label, error) {
+	ret := _m.Called(ctx, applicationIDs)
+
+	var r0 map[string]map[string]*model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]map[string]*model.Label); ok {
+		r0 = rf(ctx, applicationIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[string]*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, applicationIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRuntimeIDsInScenarios provides a mock function with given fields: ctx, id
+func (_m *ApplicationService) ListRuntimeIDsInScenarios(ctx context.Context, id string) ([]string, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkAsDeleted provides a mock function with given fields: ctx, id
+func (_m *ApplicationService) MarkAsDeleted(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Restore provides a mock function with given fields: ctx, id, gracePeriod
+func (_m *ApplicationService) Restore(ctx context.Context, id string, gracePeriod time.Duration) error {
+	ret := _m.Called(ctx, id, gracePeriod)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) error); ok {
+		r0 = rf(ctx, id, gracePeriod)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetLabel provides a mock function with given fields: ctx, label
 func (_m *ApplicationService) SetLabel(ctx context.Context, label *model.LabelInput) error {
 	ret := _m.Called(ctx, label)
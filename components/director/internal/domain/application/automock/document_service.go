@@ -2,22 +2,83 @@
 
 package automock
 
-import context "context"
-import mock "github.com/stretchr/testify/mock"
-import model "github.com/kyma-incubator/compass/components/director/internal/model"
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
 
 // DocumentService is an autogenerated mock type for the DocumentService type
 type DocumentService struct {
 	mock.Mock
 }
 
-// List provides a mock function with given fields: ctx, applicationID, pageSize, cursor
-func (_m *DocumentService) List(ctx context.Context, applicationID string, pageSize int, cursor string) (*model.DocumentPage, error) {
-	ret := _m.Called(ctx, applicationID, pageSize, cursor)
+// CreateInPackage provides a mock function with given fields: ctx, packageID, in
+func (_m *DocumentService) CreateInPackage(ctx context.Context, packageID string, in model.DocumentInput) (string, error) {
+	ret := _m.Called(ctx, packageID, in)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.DocumentInput) string); ok {
+		r0 = rf(ctx, packageID, in)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.DocumentInput) error); ok {
+		r1 = rf(ctx, packageID, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *DocumentService) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetFetchRequest provides a mock function with given fields: ctx, documentID
+func (_m *DocumentService) GetFetchRequest(ctx context.Context, documentID string) (*model.FetchRequest, error) {
+	ret := _m.Called(ctx, documentID)
+
+	var r0 *model.FetchRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.FetchRequest); ok {
+		r0 = rf(ctx, documentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FetchRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, documentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListForPackage provides a mock function with given fields: ctx, packageID, pageSize, cursor
+func (_m *DocumentService) ListForPackage(ctx context.Context, packageID string, pageSize int, cursor string) (*model.DocumentPage, error) {
+	ret := _m.Called(ctx, packageID, pageSize, cursor)
 
 	var r0 *model.DocumentPage
 	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *model.DocumentPage); ok {
-		r0 = rf(ctx, applicationID, pageSize, cursor)
+		r0 = rf(ctx, packageID, pageSize, cursor)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.DocumentPage)
@@ -26,7 +87,7 @@ func (_m *DocumentService) List(ctx context.Context, applicationID string, pageS
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
-		r1 = rf(ctx, applicationID, pageSize, cursor)
+		r1 = rf(ctx, packageID, pageSize, cursor)
 	} else {
 		r1 = ret.Error(1)
 	}
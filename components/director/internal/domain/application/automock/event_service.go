@@ -0,0 +1,96 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EventService is an autogenerated mock type for the EventService type
+type EventService struct {
+	mock.Mock
+}
+
+// CreateInPackage provides a mock function with given fields: ctx, packageID, in
+func (_m *EventService) CreateInPackage(ctx context.Context, packageID string, in model.EventDefinitionInput) (string, error) {
+	ret := _m.Called(ctx, packageID, in)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.EventDefinitionInput) string); ok {
+		r0 = rf(ctx, packageID, in)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.EventDefinitionInput) error); ok {
+		r1 = rf(ctx, packageID, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFetchRequest provides a mock function with given fields: ctx, eventAPIDefID
+func (_m *EventService) GetFetchRequest(ctx context.Context, eventAPIDefID string) (*model.FetchRequest, error) {
+	ret := _m.Called(ctx, eventAPIDefID)
+
+	var r0 *model.FetchRequest
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.FetchRequest); ok {
+		r0 = rf(ctx, eventAPIDefID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FetchRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, eventAPIDefID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListForPackage provides a mock function with given fields: ctx, packageID, pageSize, cursor
+func (_m *EventService) ListForPackage(ctx context.Context, packageID string, pageSize int, cursor string) (*model.EventDefinitionPage, error) {
+	ret := _m.Called(ctx, packageID, pageSize, cursor)
+
+	var r0 *model.EventDefinitionPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *model.EventDefinitionPage); ok {
+		r0 = rf(ctx, packageID, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.EventDefinitionPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
+		r1 = rf(ctx, packageID, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, in
+func (_m *EventService) Update(ctx context.Context, id string, in model.EventDefinitionInput) error {
+	ret := _m.Called(ctx, id, in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.EventDefinitionInput) error); ok {
+		r0 = rf(ctx, id, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
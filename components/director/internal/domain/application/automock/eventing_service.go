@@ -61,3 +61,17 @@ func (_m *EventingService) GetForApplication(ctx context.Context, app model.Appl
 
 	return r0, r1
 }
+
+// ReelectForApplications provides a mock function with given fields: ctx, appIDs
+func (_m *EventingService) ReelectForApplications(ctx context.Context, appIDs []uuid.UUID) error {
+	ret := _m.Called(ctx, appIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) error); ok {
+		r0 = rf(ctx, appIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -87,3 +87,26 @@ func (_m *LabelRepository) ListForObject(ctx context.Context, tenant string, obj
 
 	return r0, r1
 }
+
+// ListForObjectIDs provides a mock function with given fields: ctx, tenant, objectType, objectIDs
+func (_m *LabelRepository) ListForObjectIDs(ctx context.Context, tenant string, objectType model.LabelableObject, objectIDs []string) ([]*model.Label, error) {
+	ret := _m.Called(ctx, tenant, objectType, objectIDs)
+
+	var r0 []*model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.LabelableObject, []string) []*model.Label); ok {
+		r0 = rf(ctx, tenant, objectType, objectIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.LabelableObject, []string) error); ok {
+		r1 = rf(ctx, tenant, objectType, objectIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
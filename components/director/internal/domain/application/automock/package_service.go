@@ -73,3 +73,40 @@ func (_m *PackageService) ListByApplicationID(ctx context.Context, applicationID
 
 	return r0, r1
 }
+
+// ListByApplicationIDs provides a mock function with given fields: ctx, applicationIDs, pageSize, cursor
+func (_m *PackageService) ListByApplicationIDs(ctx context.Context, applicationIDs []string, pageSize int, cursor string) ([]*model.PackagePage, error) {
+	ret := _m.Called(ctx, applicationIDs, pageSize, cursor)
+
+	var r0 []*model.PackagePage
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int, string) []*model.PackagePage); ok {
+		r0 = rf(ctx, applicationIDs, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PackagePage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string, int, string) error); ok {
+		r1 = rf(ctx, applicationIDs, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, id, in
+func (_m *PackageService) Update(ctx context.Context, id string, in model.PackageUpdateInput) error {
+	ret := _m.Called(ctx, id, in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PackageUpdateInput) error); ok {
+		r0 = rf(ctx, id, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,29 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RuntimeNotifier is an autogenerated mock type for the RuntimeNotifier type
+type RuntimeNotifier struct {
+	mock.Mock
+}
+
+// NotifyApplicationDeleted provides a mock function with given fields: ctx, app, runtimeIDs
+func (_m *RuntimeNotifier) NotifyApplicationDeleted(ctx context.Context, app *model.Application, runtimeIDs []string) error {
+	ret := _m.Called(ctx, app, runtimeIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Application, []string) error); ok {
+		r0 = rf(ctx, app, runtimeIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
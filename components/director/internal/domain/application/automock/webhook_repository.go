@@ -27,3 +27,40 @@ func (_m *WebhookRepository) CreateMany(ctx context.Context, items []*model.Webh
 
 	return r0
 }
+
+// ListByApplicationID provides a mock function with given fields: ctx, tenant, applicationID
+func (_m *WebhookRepository) ListByApplicationID(ctx context.Context, tenant string, applicationID string) ([]*model.Webhook, error) {
+	ret := _m.Called(ctx, tenant, applicationID)
+
+	var r0 []*model.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*model.Webhook); ok {
+		r0 = rf(ctx, tenant, applicationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Webhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, applicationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, item
+func (_m *WebhookRepository) Update(ctx context.Context, item *model.Webhook) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Webhook) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -64,6 +64,7 @@ func (c *converter) FromEntity(entity *Entity) *model.Application {
 		IntegrationSystemID: repo.StringPtrFromNullableString(entity.IntegrationSystemID),
 		HealthCheckURL:      repo.StringPtrFromNullableString(entity.HealthCheckURL),
 		ProviderName:        repo.StringPtrFromNullableString(entity.ProviderName),
+		DeletedAt:           entity.DeletedAt,
 	}
 }
 
@@ -96,6 +97,30 @@ func (c *converter) MultipleToGraphQL(in []*model.Application) []*graphql.Applic
 	return runtimes
 }
 
+func (c *converter) TemplateReferenceToGraphQL(in *model.ApplicationTemplateReference) *graphql.ApplicationTemplateReference {
+	if in == nil {
+		return nil
+	}
+
+	values := make([]*graphql.TemplateValue, 0, len(in.Values))
+	for _, value := range in.Values {
+		if value == nil {
+			continue
+		}
+
+		values = append(values, &graphql.TemplateValue{
+			Placeholder: value.Placeholder,
+			Value:       value.Value,
+		})
+	}
+
+	return &graphql.ApplicationTemplateReference{
+		ApplicationTemplateID: in.ApplicationTemplateID,
+		Version:               in.Version,
+		Values:                values,
+	}
+}
+
 func (c *converter) CreateInputFromGraphQL(ctx context.Context, in graphql.ApplicationRegisterInput) (model.ApplicationRegisterInput, error) {
 	var labels map[string]interface{}
 	if in.Labels != nil {
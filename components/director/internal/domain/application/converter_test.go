@@ -83,6 +83,48 @@ func TestConverter_MultipleToGraphQL(t *testing.T) {
 	assert.Equal(t, expected, res)
 }
 
+func TestConverter_TemplateReferenceToGraphQL(t *testing.T) {
+	// given
+	templateID := "tttttttt-tttt-tttt-tttt-tttttttttttt"
+	testCases := []struct {
+		Name     string
+		Input    *model.ApplicationTemplateReference
+		Expected *graphql.ApplicationTemplateReference
+	}{
+		{
+			Name:     "All properties given",
+			Input:    fixModelTemplateReference(&templateID, 2),
+			Expected: fixGQLTemplateReference(&templateID, 2),
+		},
+		{
+			Name:     "Application Template deleted",
+			Input:    fixModelTemplateReference(nil, 1),
+			Expected: fixGQLTemplateReference(nil, 1),
+		},
+		{
+			Name:     "Empty",
+			Input:    &model.ApplicationTemplateReference{},
+			Expected: &graphql.ApplicationTemplateReference{Values: []*graphql.TemplateValue{}},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			converter := application.NewConverter(nil, nil)
+			res := converter.TemplateReferenceToGraphQL(testCase.Input)
+
+			// then
+			assert.Equal(t, testCase.Expected, res)
+		})
+	}
+}
+
 func TestConverter_CreateInputFromGraphQL(t *testing.T) {
 	allPropsInput := fixGQLApplicationRegisterInput("foo", "Lorem ipsum")
 	allPropsExpected := fixModelApplicationRegisterInput("foo", "Lorem ipsum")
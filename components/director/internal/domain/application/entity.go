@@ -15,6 +15,23 @@ type Entity struct {
 	StatusTimestamp     time.Time      `db:"status_timestamp"`
 	HealthCheckURL      sql.NullString `db:"healthcheck_url"`
 	IntegrationSystemID sql.NullString `db:"integration_system_id"`
+	DeletedAt           *time.Time     `db:"deleted_at"`
+}
+
+// deletionEntity is used for marking an Application as deleted and restoring it
+type deletionEntity struct {
+	ID        string     `db:"id"`
+	TenantID  string     `db:"tenant_id"`
+	DeletedAt *time.Time `db:"deleted_at"`
+}
+
+// templateReferenceEntity is used for storing the Application Template an Application was registered from
+type templateReferenceEntity struct {
+	ID                 string         `db:"id"`
+	TenantID           string         `db:"tenant_id"`
+	AppTemplateID      sql.NullString `db:"app_template_id"`
+	AppTemplateVersion sql.NullInt64  `db:"app_template_version"`
+	AppTemplateValues  sql.NullString `db:"app_template_values"`
 }
 
 type EntityCollection []Entity
@@ -9,3 +9,7 @@ func (r *Resolver) SetConverter(converter ApplicationConverter) {
 func (s *service) SetTimestampGen(timestampGen func() time.Time) {
 	s.timestampGen = timestampGen
 }
+
+func (r *Reaper) SetTimestampGen(timestampGen func() time.Time) {
+	r.timestampGen = timestampGen
+}
@@ -444,3 +444,23 @@ func fixPackagePage(packages []*model.Package) *model.PackagePage {
 		TotalCount: len(packages),
 	}
 }
+
+func fixModelTemplateReference(templateID *string, version int) *model.ApplicationTemplateReference {
+	return &model.ApplicationTemplateReference{
+		ApplicationTemplateID: templateID,
+		Version:               version,
+		Values: model.ApplicationFromTemplateInputValues{
+			{Placeholder: "name", Value: "foo"},
+		},
+	}
+}
+
+func fixGQLTemplateReference(templateID *string, version int) *graphql.ApplicationTemplateReference {
+	return &graphql.ApplicationTemplateReference{
+		ApplicationTemplateID: templateID,
+		Version:               version,
+		Values: []*graphql.TemplateValue{
+			{Placeholder: "name", Value: "foo"},
+		},
+	}
+}
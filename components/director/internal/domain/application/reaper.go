@@ -0,0 +1,182 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// DeletionConfig configures soft deletion of Applications.
+// When GracePeriod is zero, unregistered Applications are deleted immediately.
+type DeletionConfig struct {
+	GracePeriod    time.Duration `envconfig:"default=0s"`
+	ReaperInterval time.Duration `envconfig:"default=1m"`
+}
+
+// RuntimeNotifier reports the Runtimes which lost access to a permanently deleted Application.
+// Runtimes are not pushed any notification, they stop seeing the Application in the applicationsForRuntime query already when it is marked as deleted.
+//go:generate mockery -name=RuntimeNotifier -output=automock -outpkg=automock -case=underscore
+type RuntimeNotifier interface {
+	NotifyApplicationDeleted(ctx context.Context, app *model.Application, runtimeIDs []string) error
+}
+
+type Reaper struct {
+	transact persistence.Transactioner
+
+	appSvc      ApplicationService
+	eventingSvc EventingService
+	sysAuthSvc  SystemAuthService
+	oAuth20Svc  OAuth20Service
+	notifier    RuntimeNotifier
+
+	cfg          DeletionConfig
+	timestampGen timestamp.Generator
+}
+
+func NewReaper(transact persistence.Transactioner, appSvc ApplicationService, eventingSvc EventingService, sysAuthSvc SystemAuthService, oAuth20Svc OAuth20Service, notifier RuntimeNotifier, cfg DeletionConfig) *Reaper {
+	return &Reaper{
+		transact:     transact,
+		appSvc:       appSvc,
+		eventingSvc:  eventingSvc,
+		sysAuthSvc:   sysAuthSvc,
+		oAuth20Svc:   oAuth20Svc,
+		notifier:     notifier,
+		cfg:          cfg,
+		timestampGen: timestamp.DefaultGenerator(),
+	}
+}
+
+// Start permanently deletes Applications whose deletion grace period has expired, until the context is cancelled
+func (r *Reaper) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.ReaperInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("Stopping Application reaper")
+			return
+		case <-ticker.C:
+			if err := r.DeleteExpired(ctx); err != nil {
+				log.C(ctx).WithError(err).Error("Failed to delete Applications with expired deletion grace period")
+			}
+		}
+	}
+}
+
+// DeleteExpired permanently deletes Applications marked as deleted longer than the grace period and notifies the Runtimes which lost access to them
+func (r *Reaper) DeleteExpired(ctx context.Context) error {
+	apps, err := r.listExpired(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, app := range apps {
+		appCtx := tenant.SaveToContext(ctx, app.Tenant, "")
+
+		runtimeIDs, err := r.delete(appCtx, app)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to delete Application with id %s", app.ID)
+			continue
+		}
+
+		if err := r.notifier.NotifyApplicationDeleted(appCtx, app, runtimeIDs); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to notify Runtimes about deleted Application with id %s", app.ID)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reaper) listExpired(ctx context.Context) ([]*model.Application, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening transaction")
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	apps, err := r.appSvc.ListDeletedBefore(ctx, r.timestampGen().Add(-r.cfg.GracePeriod))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	return apps, nil
+}
+
+func (r *Reaper) delete(ctx context.Context, app *model.Application) ([]string, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening transaction")
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	runtimeIDs, err := r.appSvc.ListRuntimeIDsInScenarios(ctx, app.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := deleteWithRelatedResources(ctx, app.ID, r.appSvc, r.eventingSvc, r.sysAuthSvc, r.oAuth20Svc); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	log.C(ctx).Infof("Permanently deleted Application with id %s", app.ID)
+	return runtimeIDs, nil
+}
+
+// deleteWithRelatedResources deletes the Application together with its eventing configuration and OAuth 2.0 clients
+func deleteWithRelatedResources(ctx context.Context, id string, appSvc ApplicationService, eventingSvc EventingService, sysAuthSvc SystemAuthService, oAuth20Svc OAuth20Service) error {
+	appID, err := uuid.Parse(id)
+	if err != nil {
+		return errors.Wrap(err, "while parsing application ID as UUID")
+	}
+
+	if _, err = eventingSvc.CleanupAfterUnregisteringApplication(ctx, appID); err != nil {
+		return err
+	}
+
+	auths, err := sysAuthSvc.ListForObject(ctx, model.ApplicationReference, id)
+	if err != nil {
+		return err
+	}
+
+	if err = oAuth20Svc.DeleteMultipleClientCredentials(ctx, auths); err != nil {
+		return err
+	}
+
+	return appSvc.Delete(ctx, id)
+}
+
+// NewLogRuntimeNotifier returns a RuntimeNotifier which reports Runtimes affected by deleted Applications in the logs for the operators
+func NewLogRuntimeNotifier() *logRuntimeNotifier {
+	return &logRuntimeNotifier{}
+}
+
+type logRuntimeNotifier struct{}
+
+func (n *logRuntimeNotifier) NotifyApplicationDeleted(ctx context.Context, app *model.Application, runtimeIDs []string) error {
+	if len(runtimeIDs) == 0 {
+		return nil
+	}
+
+	log.C(ctx).Infof("Runtimes %v lost access to permanently deleted Application with id %s", runtimeIDs, app.ID)
+	return nil
+}
@@ -0,0 +1,145 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReaper_DeleteExpired(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	now := time.Now()
+	gracePeriod := time.Hour
+	cfg := application.DeletionConfig{GracePeriod: gracePeriod, ReaperInterval: time.Minute}
+
+	appID := uuid.New()
+	otherAppID := uuid.New()
+	app := fixModelApplication(appID.String(), "tenant-foo", "foo", "Foo")
+	otherApp := fixModelApplication(otherAppID.String(), "tenant-bar", "bar", "Bar")
+	runtimeIDs := []string{"runtime-1", "runtime-2"}
+	auths := []model.SystemAuth{{ID: "auth"}}
+
+	t.Run("Success", func(t *testing.T) {
+		persistTx, transact := fixTransactioner(3, 3)
+
+		appSvc := &automock.ApplicationService{}
+		appSvc.On("ListDeletedBefore", txtest.CtxWithDBMatcher(), now.Add(-gracePeriod)).Return([]*model.Application{app, otherApp}, nil).Once()
+		appSvc.On("ListRuntimeIDsInScenarios", txtest.CtxWithDBMatcher(), appID.String()).Return(runtimeIDs, nil).Once()
+		appSvc.On("ListRuntimeIDsInScenarios", txtest.CtxWithDBMatcher(), otherAppID.String()).Return(nil, nil).Once()
+		appSvc.On("Delete", txtest.CtxWithDBMatcher(), appID.String()).Return(nil).Once()
+		appSvc.On("Delete", txtest.CtxWithDBMatcher(), otherAppID.String()).Return(nil).Once()
+
+		eventingSvc := &automock.EventingService{}
+		eventingSvc.On("CleanupAfterUnregisteringApplication", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
+		eventingSvc.On("CleanupAfterUnregisteringApplication", txtest.CtxWithDBMatcher(), otherAppID).Return(nil, nil).Once()
+
+		sysAuthSvc := &automock.SystemAuthService{}
+		sysAuthSvc.On("ListForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, appID.String()).Return(auths, nil).Once()
+		sysAuthSvc.On("ListForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, otherAppID.String()).Return(nil, nil).Once()
+
+		oAuth20Svc := &automock.OAuth20Service{}
+		oAuth20Svc.On("DeleteMultipleClientCredentials", txtest.CtxWithDBMatcher(), auths).Return(nil).Once()
+		oAuth20Svc.On("DeleteMultipleClientCredentials", txtest.CtxWithDBMatcher(), []model.SystemAuth(nil)).Return(nil).Once()
+
+		notifier := &automock.RuntimeNotifier{}
+		notifier.On("NotifyApplicationDeleted", mock.Anything, app, runtimeIDs).Return(nil).Once()
+		notifier.On("NotifyApplicationDeleted", mock.Anything, otherApp, []string(nil)).Return(nil).Once()
+
+		reaper := application.NewReaper(transact, appSvc, eventingSvc, sysAuthSvc, oAuth20Svc, notifier, cfg)
+		reaper.SetTimestampGen(func() time.Time { return now })
+
+		// when
+		err := reaper.DeleteExpired(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, appSvc, eventingSvc, sysAuthSvc, oAuth20Svc, notifier)
+	})
+
+	t.Run("Skips Application which could not be deleted", func(t *testing.T) {
+		persistTx, transact := fixTransactioner(3, 2)
+
+		appSvc := &automock.ApplicationService{}
+		appSvc.On("ListDeletedBefore", txtest.CtxWithDBMatcher(), now.Add(-gracePeriod)).Return([]*model.Application{app, otherApp}, nil).Once()
+		appSvc.On("ListRuntimeIDsInScenarios", txtest.CtxWithDBMatcher(), appID.String()).Return(nil, testErr).Once()
+		appSvc.On("ListRuntimeIDsInScenarios", txtest.CtxWithDBMatcher(), otherAppID.String()).Return(runtimeIDs, nil).Once()
+		appSvc.On("Delete", txtest.CtxWithDBMatcher(), otherAppID.String()).Return(nil).Once()
+
+		eventingSvc := &automock.EventingService{}
+		eventingSvc.On("CleanupAfterUnregisteringApplication", txtest.CtxWithDBMatcher(), otherAppID).Return(nil, nil).Once()
+
+		sysAuthSvc := &automock.SystemAuthService{}
+		sysAuthSvc.On("ListForObject", txtest.CtxWithDBMatcher(), model.ApplicationReference, otherAppID.String()).Return(auths, nil).Once()
+
+		oAuth20Svc := &automock.OAuth20Service{}
+		oAuth20Svc.On("DeleteMultipleClientCredentials", txtest.CtxWithDBMatcher(), auths).Return(nil).Once()
+
+		notifier := &automock.RuntimeNotifier{}
+		notifier.On("NotifyApplicationDeleted", mock.Anything, otherApp, runtimeIDs).Return(testErr).Once()
+
+		reaper := application.NewReaper(transact, appSvc, eventingSvc, sysAuthSvc, oAuth20Svc, notifier, cfg)
+		reaper.SetTimestampGen(func() time.Time { return now })
+
+		// when
+		err := reaper.DeleteExpired(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, appSvc, eventingSvc, sysAuthSvc, oAuth20Svc, notifier)
+	})
+
+	t.Run("Returns error when listing expired Applications failed", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit()
+
+		appSvc := &automock.ApplicationService{}
+		appSvc.On("ListDeletedBefore", txtest.CtxWithDBMatcher(), now.Add(-gracePeriod)).Return(nil, testErr).Once()
+
+		reaper := application.NewReaper(transact, appSvc, nil, nil, nil, nil, cfg)
+		reaper.SetTimestampGen(func() time.Time { return now })
+
+		// when
+		err := reaper.DeleteExpired(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		mock.AssertExpectationsForObjects(t, persistTx, transact, appSvc)
+	})
+
+	t.Run("Returns error when transaction begin failed", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(testErr).ThatFailsOnBegin()
+
+		reaper := application.NewReaper(transact, nil, nil, nil, nil, nil, cfg)
+
+		// when
+		err := reaper.DeleteExpired(context.TODO())
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		mock.AssertExpectationsForObjects(t, persistTx, transact)
+	})
+}
+
+func fixTransactioner(begins, commits int) (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+	persistTx := &persistenceautomock.PersistenceTx{}
+	persistTx.On("Commit").Return(nil).Times(commits)
+
+	transact := &persistenceautomock.Transactioner{}
+	transact.On("Begin").Return(persistTx, nil).Times(begins)
+	transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Times(begins)
+
+	return persistTx, transact
+}
@@ -0,0 +1,680 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const packagesPageSize = 100
+
+// Reconcile creates packages (including their API Definitions, Event Definitions and Documents), webhooks and labels defined in the input which are missing in the Application
+// and updates the ones which differ from the input. Packages, API Definitions, Event Definitions and Documents of the Application which are not defined in the input
+// are reported as removed, but they are kept, as they may still be in use. If dryRun is set, the changes are only calculated.
+func (s *service) Reconcile(ctx context.Context, id string, in model.ApplicationRegisterInput, dryRun bool) ([]*model.ApplicationChange, error) {
+	appTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.appRepo.Exists(ctx, appTenant, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while checking if Application with id %s exists", id)
+	}
+	if !exists {
+		return nil, apperrors.NewNotFoundError(resource.Application, id)
+	}
+
+	pkgChanges, err := s.reconcilePackages(ctx, id, in.Packages, dryRun)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reconciling Packages of Application with id %s", id)
+	}
+
+	webhookChanges, err := s.reconcileWebhooks(ctx, appTenant, id, in.Webhooks, dryRun)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reconciling Webhooks of Application with id %s", id)
+	}
+
+	labelChanges, err := s.reconcileLabels(ctx, appTenant, id, in.Labels, dryRun)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reconciling Labels of Application with id %s", id)
+	}
+
+	changes := append(pkgChanges, webhookChanges...)
+	return append(changes, labelChanges...), nil
+}
+
+func (s *service) GetTemplateReference(ctx context.Context, id string) (*model.ApplicationTemplateReference, error) {
+	appTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ref, err := s.appRepo.GetTemplateReference(ctx, appTenant, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Application Template reference of Application with id %s", id)
+	}
+
+	return ref, nil
+}
+
+func (s *service) SetTemplateReference(ctx context.Context, id string, ref model.ApplicationTemplateReference) error {
+	appTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.appRepo.SetTemplateReference(ctx, appTenant, id, ref); err != nil {
+		return errors.Wrapf(err, "while setting Application Template reference of Application with id %s", id)
+	}
+
+	return nil
+}
+
+func (s *service) reconcilePackages(ctx context.Context, applicationID string, in []*model.PackageCreateInput, dryRun bool) ([]*model.ApplicationChange, error) {
+	existing, err := s.listAllPackages(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*model.ApplicationChange
+	var toCreate []*model.PackageCreateInput
+	defined := make(map[string]bool, len(in))
+	for _, pkgInput := range in {
+		defined[pkgInput.Name] = true
+		pkg, ok := existing[pkgInput.Name]
+		if !ok {
+			changes = append(changes, newChange(model.ApplicationChangeResourcePackage, model.ApplicationChangeOperationCreate, pkgInput.Name))
+			toCreate = append(toCreate, pkgInput)
+			continue
+		}
+
+		update := model.PackageUpdateInput{
+			Name:                           pkgInput.Name,
+			Description:                    pkgInput.Description,
+			InstanceAuthRequestInputSchema: pkgInput.InstanceAuthRequestInputSchema,
+			DefaultInstanceAuth:            pkgInput.DefaultInstanceAuth,
+		}
+		if !packageUpToDate(pkg, update) {
+			changes = append(changes, newChange(model.ApplicationChangeResourcePackage, model.ApplicationChangeOperationUpdate, pkgInput.Name))
+			if !dryRun {
+				log.C(ctx).Infof("Updating Package with id %s of Application with id %s", pkg.ID, applicationID)
+				if err := s.pkgService.Update(ctx, pkg.ID, update); err != nil {
+					return nil, errors.Wrapf(err, "while updating Package with id %s", pkg.ID)
+				}
+			}
+		}
+
+		definitionChanges, err := s.reconcilePackageDefinitions(ctx, pkg, pkgInput, dryRun)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while reconciling definitions of Package with id %s", pkg.ID)
+		}
+		changes = append(changes, definitionChanges...)
+	}
+
+	var removed []string
+	for name := range existing {
+		if !defined[name] {
+			removed = append(removed, name)
+		}
+	}
+	changes = append(changes, removedChanges(model.ApplicationChangeResourcePackage, removed, func(name string) string { return name })...)
+
+	if dryRun || len(toCreate) == 0 {
+		return changes, nil
+	}
+
+	log.C(ctx).Infof("Creating %d Packages for Application with id %s", len(toCreate), applicationID)
+	if err := s.pkgService.CreateMultiple(ctx, applicationID, toCreate); err != nil {
+		return nil, errors.Wrap(err, "while creating Packages")
+	}
+
+	return changes, nil
+}
+
+func (s *service) listAllPackages(ctx context.Context, applicationID string) (map[string]*model.Package, error) {
+	packages := make(map[string]*model.Package)
+	cursor := ""
+	for {
+		page, err := s.pkgService.ListByApplicationID(ctx, applicationID, packagesPageSize, cursor)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing Packages of Application with id %s", applicationID)
+		}
+
+		for _, pkg := range page.Data {
+			packages[pkg.Name] = pkg
+		}
+
+		if page.PageInfo == nil || !page.PageInfo.HasNextPage {
+			return packages, nil
+		}
+		cursor = page.PageInfo.EndCursor
+	}
+}
+
+func (s *service) reconcilePackageDefinitions(ctx context.Context, pkg *model.Package, in *model.PackageCreateInput, dryRun bool) ([]*model.ApplicationChange, error) {
+	apiChanges, err := s.reconcileAPIDefinitions(ctx, pkg, in.APIDefinitions, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	eventChanges, err := s.reconcileEventDefinitions(ctx, pkg, in.EventDefinitions, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	documentChanges, err := s.reconcileDocuments(ctx, pkg, in.Documents, dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := append(apiChanges, eventChanges...)
+	return append(changes, documentChanges...), nil
+}
+
+// reconcileAPIDefinitions matches API Definitions by name
+func (s *service) reconcileAPIDefinitions(ctx context.Context, pkg *model.Package, in []*model.APIDefinitionInput, dryRun bool) ([]*model.ApplicationChange, error) {
+	existing, err := s.listAllAPIDefinitions(ctx, pkg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*model.ApplicationChange
+	defined := make(map[string]bool, len(in))
+	for _, apiInput := range in {
+		defined[apiInput.Name] = true
+		key := definitionKey(pkg.Name, apiInput.Name)
+		api, ok := existing[apiInput.Name]
+		if !ok {
+			changes = append(changes, newChange(model.ApplicationChangeResourceAPIDefinition, model.ApplicationChangeOperationCreate, key))
+			if dryRun {
+				continue
+			}
+
+			log.C(ctx).Infof("Creating API Definition %s in Package with id %s", apiInput.Name, pkg.ID)
+			if _, err := s.apiService.CreateInPackage(ctx, pkg.ID, *apiInput); err != nil {
+				return nil, errors.Wrapf(err, "while creating API Definition %s", apiInput.Name)
+			}
+			continue
+		}
+
+		fetchRequest, err := s.apiService.GetFetchRequest(ctx, api.ID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while getting Fetch Request of API Definition with id %s", api.ID)
+		}
+		if apiDefinitionUpToDate(api, fetchRequest, apiInput) {
+			continue
+		}
+
+		changes = append(changes, newChange(model.ApplicationChangeResourceAPIDefinition, model.ApplicationChangeOperationUpdate, key))
+		if dryRun {
+			continue
+		}
+
+		log.C(ctx).Infof("Updating API Definition with id %s in Package with id %s", api.ID, pkg.ID)
+		if err := s.apiService.Update(ctx, api.ID, *apiInput); err != nil {
+			return nil, errors.Wrapf(err, "while updating API Definition with id %s", api.ID)
+		}
+	}
+
+	var removed []string
+	for name := range existing {
+		if !defined[name] {
+			removed = append(removed, name)
+		}
+	}
+
+	return append(changes, removedChanges(model.ApplicationChangeResourceAPIDefinition, removed, packageDefinitionKey(pkg))...), nil
+}
+
+// reconcileEventDefinitions matches Event Definitions by name
+func (s *service) reconcileEventDefinitions(ctx context.Context, pkg *model.Package, in []*model.EventDefinitionInput, dryRun bool) ([]*model.ApplicationChange, error) {
+	existing, err := s.listAllEventDefinitions(ctx, pkg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*model.ApplicationChange
+	defined := make(map[string]bool, len(in))
+	for _, eventInput := range in {
+		defined[eventInput.Name] = true
+		key := definitionKey(pkg.Name, eventInput.Name)
+		event, ok := existing[eventInput.Name]
+		if !ok {
+			changes = append(changes, newChange(model.ApplicationChangeResourceEventDefinition, model.ApplicationChangeOperationCreate, key))
+			if dryRun {
+				continue
+			}
+
+			log.C(ctx).Infof("Creating Event Definition %s in Package with id %s", eventInput.Name, pkg.ID)
+			if _, err := s.eventService.CreateInPackage(ctx, pkg.ID, *eventInput); err != nil {
+				return nil, errors.Wrapf(err, "while creating Event Definition %s", eventInput.Name)
+			}
+			continue
+		}
+
+		fetchRequest, err := s.eventService.GetFetchRequest(ctx, event.ID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while getting Fetch Request of Event Definition with id %s", event.ID)
+		}
+		if eventDefinitionUpToDate(event, fetchRequest, eventInput) {
+			continue
+		}
+
+		changes = append(changes, newChange(model.ApplicationChangeResourceEventDefinition, model.ApplicationChangeOperationUpdate, key))
+		if dryRun {
+			continue
+		}
+
+		log.C(ctx).Infof("Updating Event Definition with id %s in Package with id %s", event.ID, pkg.ID)
+		if err := s.eventService.Update(ctx, event.ID, *eventInput); err != nil {
+			return nil, errors.Wrapf(err, "while updating Event Definition with id %s", event.ID)
+		}
+	}
+
+	var removed []string
+	for name := range existing {
+		if !defined[name] {
+			removed = append(removed, name)
+		}
+	}
+
+	return append(changes, removedChanges(model.ApplicationChangeResourceEventDefinition, removed, packageDefinitionKey(pkg))...), nil
+}
+
+// reconcileDocuments matches Documents by title. Documents cannot be updated, so the ones which differ from the input are recreated
+func (s *service) reconcileDocuments(ctx context.Context, pkg *model.Package, in []*model.DocumentInput, dryRun bool) ([]*model.ApplicationChange, error) {
+	existing, err := s.listAllDocuments(ctx, pkg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*model.ApplicationChange
+	defined := make(map[string]bool, len(in))
+	for _, docInput := range in {
+		defined[docInput.Title] = true
+		key := definitionKey(pkg.Name, docInput.Title)
+		operation := model.ApplicationChangeOperationCreate
+		if doc, ok := existing[docInput.Title]; ok {
+			fetchRequest, err := s.documentService.GetFetchRequest(ctx, doc.ID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while getting Fetch Request of Document with id %s", doc.ID)
+			}
+			if documentUpToDate(doc, fetchRequest, docInput) {
+				continue
+			}
+
+			operation = model.ApplicationChangeOperationUpdate
+			changes = append(changes, newChange(model.ApplicationChangeResourceDocument, operation, key))
+			if dryRun {
+				continue
+			}
+
+			log.C(ctx).Infof("Deleting outdated Document with id %s in Package with id %s", doc.ID, pkg.ID)
+			if err := s.documentService.Delete(ctx, doc.ID); err != nil {
+				return nil, errors.Wrapf(err, "while deleting Document with id %s", doc.ID)
+			}
+		} else {
+			changes = append(changes, newChange(model.ApplicationChangeResourceDocument, operation, key))
+			if dryRun {
+				continue
+			}
+		}
+
+		log.C(ctx).Infof("Creating Document %s in Package with id %s", docInput.Title, pkg.ID)
+		if _, err := s.documentService.CreateInPackage(ctx, pkg.ID, *docInput); err != nil {
+			return nil, errors.Wrapf(err, "while creating Document %s", docInput.Title)
+		}
+	}
+
+	var removed []string
+	for title := range existing {
+		if !defined[title] {
+			removed = append(removed, title)
+		}
+	}
+
+	return append(changes, removedChanges(model.ApplicationChangeResourceDocument, removed, packageDefinitionKey(pkg))...), nil
+}
+
+func (s *service) listAllAPIDefinitions(ctx context.Context, packageID string) (map[string]*model.APIDefinition, error) {
+	apis := make(map[string]*model.APIDefinition)
+	cursor := ""
+	for {
+		page, err := s.apiService.ListForPackage(ctx, packageID, packagesPageSize, cursor)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing API Definitions of Package with id %s", packageID)
+		}
+
+		for _, api := range page.Data {
+			apis[api.Name] = api
+		}
+
+		if page.PageInfo == nil || !page.PageInfo.HasNextPage {
+			return apis, nil
+		}
+		cursor = page.PageInfo.EndCursor
+	}
+}
+
+func (s *service) listAllEventDefinitions(ctx context.Context, packageID string) (map[string]*model.EventDefinition, error) {
+	events := make(map[string]*model.EventDefinition)
+	cursor := ""
+	for {
+		page, err := s.eventService.ListForPackage(ctx, packageID, packagesPageSize, cursor)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing Event Definitions of Package with id %s", packageID)
+		}
+
+		for _, event := range page.Data {
+			events[event.Name] = event
+		}
+
+		if page.PageInfo == nil || !page.PageInfo.HasNextPage {
+			return events, nil
+		}
+		cursor = page.PageInfo.EndCursor
+	}
+}
+
+func (s *service) listAllDocuments(ctx context.Context, packageID string) (map[string]*model.Document, error) {
+	docs := make(map[string]*model.Document)
+	cursor := ""
+	for {
+		page, err := s.documentService.ListForPackage(ctx, packageID, packagesPageSize, cursor)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing Documents of Package with id %s", packageID)
+		}
+
+		for _, doc := range page.Data {
+			docs[doc.Title] = doc
+		}
+
+		if page.PageInfo == nil || !page.PageInfo.HasNextPage {
+			return docs, nil
+		}
+		cursor = page.PageInfo.EndCursor
+	}
+}
+
+// reconcileWebhooks matches webhooks by type in the order they are defined
+func (s *service) reconcileWebhooks(ctx context.Context, appTenant, applicationID string, in []*model.WebhookInput, dryRun bool) ([]*model.ApplicationChange, error) {
+	webhooks, err := s.webhookRepo.ListByApplicationID(ctx, appTenant, applicationID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing Webhooks of Application with id %s", applicationID)
+	}
+
+	existing := make(map[model.WebhookType][]*model.Webhook)
+	for _, webhook := range webhooks {
+		existing[webhook.Type] = append(existing[webhook.Type], webhook)
+	}
+
+	var changes []*model.ApplicationChange
+	var toCreate []*model.WebhookInput
+	for _, webhookInput := range in {
+		if len(existing[webhookInput.Type]) == 0 {
+			changes = append(changes, newChange(model.ApplicationChangeResourceWebhook, model.ApplicationChangeOperationCreate, string(webhookInput.Type)))
+			toCreate = append(toCreate, webhookInput)
+			continue
+		}
+
+		webhook := existing[webhookInput.Type][0]
+		existing[webhookInput.Type] = existing[webhookInput.Type][1:]
+
+		auth := webhookInput.Auth.ToAuth()
+		if webhook.URL == webhookInput.URL && reflect.DeepEqual(webhook.Auth, auth) {
+			continue
+		}
+
+		changes = append(changes, newChange(model.ApplicationChangeResourceWebhook, model.ApplicationChangeOperationUpdate, string(webhookInput.Type)))
+		if dryRun {
+			continue
+		}
+
+		webhook.URL = webhookInput.URL
+		webhook.Auth = auth
+		log.C(ctx).Infof("Updating Webhook with id %s of Application with id %s", webhook.ID, applicationID)
+		if err := s.webhookRepo.Update(ctx, webhook); err != nil {
+			return nil, errors.Wrapf(err, "while updating Webhook with id %s", webhook.ID)
+		}
+	}
+
+	if dryRun || len(toCreate) == 0 {
+		return changes, nil
+	}
+
+	newWebhooks := make([]*model.Webhook, 0, len(toCreate))
+	for _, webhookInput := range toCreate {
+		newWebhooks = append(newWebhooks, webhookInput.ToWebhook(s.uidService.Generate(), appTenant, applicationID))
+	}
+
+	log.C(ctx).Infof("Creating %d Webhooks for Application with id %s", len(newWebhooks), applicationID)
+	if err := s.webhookRepo.CreateMany(ctx, newWebhooks); err != nil {
+		return nil, errors.Wrap(err, "while creating Webhooks")
+	}
+
+	return changes, nil
+}
+
+// reconcileLabels sets labels defined in the input. Scenarios from the input are added to the ones already assigned to the Application.
+func (s *service) reconcileLabels(ctx context.Context, appTenant, applicationID string, in map[string]interface{}, dryRun bool) ([]*model.ApplicationChange, error) {
+	labels, err := s.labelRepo.ListForObject(ctx, appTenant, model.ApplicationLabelableObject, applicationID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing Labels of Application with id %s", applicationID)
+	}
+
+	keys := make([]string, 0, len(in))
+	for key := range in {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var changes []*model.ApplicationChange
+	for _, key := range keys {
+		value := in[key]
+		operation := model.ApplicationChangeOperationCreate
+		if current, ok := labels[key]; ok {
+			if key == model.ScenariosKey {
+				value = mergeScenarios(current.Value, value)
+			}
+
+			equal, err := jsonEqual(current.Value, value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while comparing values of Label with key %s", key)
+			}
+			if equal {
+				continue
+			}
+			operation = model.ApplicationChangeOperationUpdate
+		}
+
+		changes = append(changes, newChange(model.ApplicationChangeResourceLabel, operation, key))
+		if dryRun {
+			continue
+		}
+
+		labelInput := &model.LabelInput{
+			Key:        key,
+			Value:      value,
+			ObjectID:   applicationID,
+			ObjectType: model.ApplicationLabelableObject,
+		}
+		if err := s.labelUpsertService.UpsertLabel(ctx, appTenant, labelInput); err != nil {
+			return nil, errors.Wrapf(err, "while setting Label with key %s", key)
+		}
+	}
+
+	return changes, nil
+}
+
+func packageUpToDate(pkg *model.Package, in model.PackageUpdateInput) bool {
+	if !reflect.DeepEqual(pkg.Description, in.Description) {
+		return false
+	}
+
+	if !reflect.DeepEqual(pkg.DefaultInstanceAuth, in.DefaultInstanceAuth.ToAuth()) {
+		return false
+	}
+
+	if pkg.InstanceAuthRequestInputSchema == nil || in.InstanceAuthRequestInputSchema == nil {
+		return pkg.InstanceAuthRequestInputSchema == in.InstanceAuthRequestInputSchema
+	}
+
+	var current, desired interface{}
+	if json.Unmarshal([]byte(*pkg.InstanceAuthRequestInputSchema), &current) != nil || json.Unmarshal([]byte(*in.InstanceAuthRequestInputSchema), &desired) != nil {
+		return *pkg.InstanceAuthRequestInputSchema == *in.InstanceAuthRequestInputSchema
+	}
+
+	return reflect.DeepEqual(current, desired)
+}
+
+// apiDefinitionUpToDate compares the API Definition with the input. Spec data is not compared if the spec is fetched
+func apiDefinitionUpToDate(api *model.APIDefinition, fetchRequest *model.FetchRequest, in *model.APIDefinitionInput) bool {
+	var fetchRequestInput *model.FetchRequestInput
+	if in.Spec != nil {
+		fetchRequestInput = in.Spec.FetchRequest
+	}
+	if !fetchRequestUpToDate(fetchRequest, fetchRequestInput) {
+		return false
+	}
+
+	desired := in.ToAPIDefinitionWithinPackage(api.ID, api.PackageID, api.Tenant)
+	if fetchRequestInput != nil && desired.Spec != nil && api.Spec != nil {
+		desired.Spec.Data = api.Spec.Data
+	}
+
+	return reflect.DeepEqual(api, desired)
+}
+
+// eventDefinitionUpToDate compares the Event Definition with the input. Spec data is not compared if the spec is fetched
+func eventDefinitionUpToDate(event *model.EventDefinition, fetchRequest *model.FetchRequest, in *model.EventDefinitionInput) bool {
+	var fetchRequestInput *model.FetchRequestInput
+	if in.Spec != nil {
+		fetchRequestInput = in.Spec.FetchRequest
+	}
+	if !fetchRequestUpToDate(fetchRequest, fetchRequestInput) {
+		return false
+	}
+
+	desired := in.ToEventDefinitionWithinPackage(event.ID, event.PackageID, event.Tenant)
+	if fetchRequestInput != nil && desired.Spec != nil && event.Spec != nil {
+		desired.Spec.Data = event.Spec.Data
+	}
+
+	return reflect.DeepEqual(event, desired)
+}
+
+// documentUpToDate compares the Document with the input. Data is not compared if the Document is fetched
+func documentUpToDate(doc *model.Document, fetchRequest *model.FetchRequest, in *model.DocumentInput) bool {
+	if !fetchRequestUpToDate(fetchRequest, in.FetchRequest) {
+		return false
+	}
+
+	desired := in.ToDocumentWithinPackage(doc.ID, doc.Tenant, doc.PackageID)
+	if in.FetchRequest != nil {
+		desired.Data = doc.Data
+	}
+
+	return reflect.DeepEqual(doc, desired)
+}
+
+func fetchRequestUpToDate(fetchRequest *model.FetchRequest, in *model.FetchRequestInput) bool {
+	if fetchRequest == nil || in == nil {
+		return fetchRequest == nil && in == nil
+	}
+
+	desired := in.ToFetchRequest(time.Time{}, fetchRequest.ID, fetchRequest.Tenant, fetchRequest.ObjectType, fetchRequest.ObjectID)
+	return fetchRequest.URL == desired.URL && fetchRequest.Mode == desired.Mode &&
+		reflect.DeepEqual(fetchRequest.Filter, desired.Filter) && reflect.DeepEqual(fetchRequest.Auth, desired.Auth)
+}
+
+func mergeScenarios(current, desired interface{}) interface{} {
+	currentScenarios, err := label.ValueToStringsSlice(current)
+	if err != nil {
+		return desired
+	}
+
+	desiredScenarios, err := label.ValueToStringsSlice(desired)
+	if err != nil {
+		return desired
+	}
+
+	merged := currentScenarios
+	for _, scenario := range desiredScenarios {
+		if !stringsContain(merged, scenario) {
+			merged = append(merged, scenario)
+		}
+	}
+
+	return merged
+}
+
+func jsonEqual(a, b interface{}) (bool, error) {
+	aMarshalled, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+
+	bMarshalled, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+
+	var aUnmarshalled, bUnmarshalled interface{}
+	if err := json.Unmarshal(aMarshalled, &aUnmarshalled); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(bMarshalled, &bUnmarshalled); err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(aUnmarshalled, bUnmarshalled), nil
+}
+
+func stringsContain(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func definitionKey(packageName, name string) string {
+	return packageName + "/" + name
+}
+
+func packageDefinitionKey(pkg *model.Package) func(name string) string {
+	return func(name string) string {
+		return definitionKey(pkg.Name, name)
+	}
+}
+
+// removedChanges reports resources which are not defined in the input anymore. They are not deleted, as Runtimes may still use them
+func removedChanges(resource model.ApplicationChangeResource, names []string, key func(name string) string) []*model.ApplicationChange {
+	sort.Strings(names)
+
+	changes := make([]*model.ApplicationChange, 0, len(names))
+	for _, name := range names {
+		changes = append(changes, newChange(resource, model.ApplicationChangeOperationRemoved, key(name)))
+	}
+	return changes
+}
+
+func newChange(resource model.ApplicationChangeResource, operation model.ApplicationChangeOperation, key string) *model.ApplicationChange {
+	return &model.ApplicationChange{
+		Resource:  resource,
+		Operation: operation,
+		Key:       key,
+	}
+}
@@ -0,0 +1,902 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Reconcile(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	id := "foo"
+	tnt := "tenant"
+	externalTnt := "external-tnt"
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
+
+	newWebhookID := "webhook-new"
+
+	in := model.ApplicationRegisterInput{
+		Name: "foo",
+		Packages: []*model.PackageCreateInput{
+			{Name: "pkg-up-to-date", Description: str.Ptr("up to date")},
+			{Name: "pkg-outdated", Description: str.Ptr("new description")},
+			{Name: "pkg-new"},
+		},
+		Webhooks: []*model.WebhookInput{
+			{Type: model.WebhookTypeConfigurationChanged, URL: "https://new.webhook"},
+			{Type: model.WebhookTypeConfigurationChanged, URL: "https://another.webhook"},
+		},
+		Labels: map[string]interface{}{
+			model.ScenariosKey: []interface{}{"FOO"},
+			"unchanged":        "value",
+			"new":              "value",
+		},
+	}
+
+	fixPackagePage := func() *model.PackagePage {
+		return &model.PackagePage{
+			Data: []*model.Package{
+				{ID: "pkg-1", ApplicationID: id, Name: "pkg-up-to-date", Description: str.Ptr("up to date")},
+				{ID: "pkg-2", ApplicationID: id, Name: "pkg-outdated", Description: str.Ptr("old description")},
+				{ID: "pkg-3", ApplicationID: id, Name: "pkg-removed"},
+			},
+			PageInfo:   &pagination.Page{HasNextPage: false},
+			TotalCount: 3,
+		}
+	}
+	fixWebhooks := func() []*model.Webhook {
+		return []*model.Webhook{
+			{ID: "webhook-1", ApplicationID: id, Tenant: tnt, Type: model.WebhookTypeConfigurationChanged, URL: "https://old.webhook"},
+		}
+	}
+	fixLabels := func() map[string]*model.Label {
+		return map[string]*model.Label{
+			model.ScenariosKey: {Key: model.ScenariosKey, Value: []interface{}{"DEFAULT"}, ObjectID: id, ObjectType: model.ApplicationLabelableObject},
+			"unchanged":        {Key: "unchanged", Value: "value", ObjectID: id, ObjectType: model.ApplicationLabelableObject},
+		}
+	}
+
+	expectedChanges := []*model.ApplicationChange{
+		{Resource: model.ApplicationChangeResourcePackage, Operation: model.ApplicationChangeOperationUpdate, Key: "pkg-outdated"},
+		{Resource: model.ApplicationChangeResourcePackage, Operation: model.ApplicationChangeOperationCreate, Key: "pkg-new"},
+		{Resource: model.ApplicationChangeResourcePackage, Operation: model.ApplicationChangeOperationRemoved, Key: "pkg-removed"},
+		{Resource: model.ApplicationChangeResourceWebhook, Operation: model.ApplicationChangeOperationUpdate, Key: string(model.WebhookTypeConfigurationChanged)},
+		{Resource: model.ApplicationChangeResourceWebhook, Operation: model.ApplicationChangeOperationCreate, Key: string(model.WebhookTypeConfigurationChanged)},
+		{Resource: model.ApplicationChangeResourceLabel, Operation: model.ApplicationChangeOperationCreate, Key: "new"},
+		{Resource: model.ApplicationChangeResourceLabel, Operation: model.ApplicationChangeOperationUpdate, Key: model.ScenariosKey},
+	}
+
+	pkgUpdate := model.PackageUpdateInput{Name: "pkg-outdated", Description: str.Ptr("new description")}
+	pkgsToCreate := []*model.PackageCreateInput{in.Packages[2]}
+	updatedWebhook := &model.Webhook{ID: "webhook-1", ApplicationID: id, Tenant: tnt, Type: model.WebhookTypeConfigurationChanged, URL: "https://new.webhook"}
+	webhooksToCreate := []*model.Webhook{{ID: newWebhookID, ApplicationID: id, Tenant: tnt, Type: model.WebhookTypeConfigurationChanged, URL: "https://another.webhook"}}
+	newLabel := &model.LabelInput{Key: "new", Value: "value", ObjectID: id, ObjectType: model.ApplicationLabelableObject}
+	scenariosLabel := &model.LabelInput{Key: model.ScenariosKey, Value: []string{"DEFAULT", "FOO"}, ObjectID: id, ObjectType: model.ApplicationLabelableObject}
+
+	testCases := []struct {
+		Name               string
+		DryRun             bool
+		AppRepoFn          func() *automock.ApplicationRepository
+		PkgSvcFn           func() *automock.PackageService
+		WebhookRepoFn      func() *automock.WebhookRepository
+		LabelRepoFn        func() *automock.LabelRepository
+		LabelUpsertSvcFn   func() *automock.LabelUpsertService
+		UIDSvcFn           func() *automock.UIDService
+		ExpectedChanges    []*model.ApplicationChange
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, id).Return(true, nil).Once()
+				return repo
+			},
+			PkgSvcFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationID", ctx, id, 100, "").Return(fixPackagePage(), nil).Once()
+				svc.On("Update", ctx, "pkg-2", pkgUpdate).Return(nil).Once()
+				svc.On("CreateMultiple", ctx, id, pkgsToCreate).Return(nil).Once()
+				return svc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id).Return(fixWebhooks(), nil).Once()
+				repo.On("Update", ctx, updatedWebhook).Return(nil).Once()
+				repo.On("CreateMany", ctx, webhooksToCreate).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tnt, model.ApplicationLabelableObject, id).Return(fixLabels(), nil).Once()
+				return repo
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tnt, newLabel).Return(nil).Once()
+				svc.On("UpsertLabel", ctx, tnt, scenariosLabel).Return(nil).Once()
+				return svc
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(newWebhookID).Once()
+				return svc
+			},
+			ExpectedChanges: expectedChanges,
+		},
+		{
+			Name:   "Success for dry run",
+			DryRun: true,
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, id).Return(true, nil).Once()
+				return repo
+			},
+			PkgSvcFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationID", ctx, id, 100, "").Return(fixPackagePage(), nil).Once()
+				return svc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id).Return(fixWebhooks(), nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tnt, model.ApplicationLabelableObject, id).Return(fixLabels(), nil).Once()
+				return repo
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedChanges: expectedChanges,
+		},
+		{
+			Name: "Returns error when Application does not exist",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, id).Return(false, nil).Once()
+				return repo
+			},
+			PkgSvcFn: func() *automock.PackageService {
+				return &automock.PackageService{}
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				return &automock.WebhookRepository{}
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErrMessage: "Object not found",
+		},
+		{
+			Name: "Returns error when checking if Application exists fails",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, id).Return(false, testErr).Once()
+				return repo
+			},
+			PkgSvcFn: func() *automock.PackageService {
+				return &automock.PackageService{}
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				return &automock.WebhookRepository{}
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when listing Packages fails",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, id).Return(true, nil).Once()
+				return repo
+			},
+			PkgSvcFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationID", ctx, id, 100, "").Return(nil, testErr).Once()
+				return svc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				return &automock.WebhookRepository{}
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when updating Package fails",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, id).Return(true, nil).Once()
+				return repo
+			},
+			PkgSvcFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationID", ctx, id, 100, "").Return(fixPackagePage(), nil).Once()
+				svc.On("Update", ctx, "pkg-2", pkgUpdate).Return(testErr).Once()
+				return svc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				return &automock.WebhookRepository{}
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when creating Packages fails",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, id).Return(true, nil).Once()
+				return repo
+			},
+			PkgSvcFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationID", ctx, id, 100, "").Return(fixPackagePage(), nil).Once()
+				svc.On("Update", ctx, "pkg-2", pkgUpdate).Return(nil).Once()
+				svc.On("CreateMultiple", ctx, id, pkgsToCreate).Return(testErr).Once()
+				return svc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				return &automock.WebhookRepository{}
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when listing Webhooks fails",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, id).Return(true, nil).Once()
+				return repo
+			},
+			PkgSvcFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationID", ctx, id, 100, "").Return(fixPackagePage(), nil).Once()
+				svc.On("Update", ctx, "pkg-2", pkgUpdate).Return(nil).Once()
+				svc.On("CreateMultiple", ctx, id, pkgsToCreate).Return(nil).Once()
+				return svc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id).Return(nil, testErr).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when updating Webhook fails",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, id).Return(true, nil).Once()
+				return repo
+			},
+			PkgSvcFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationID", ctx, id, 100, "").Return(fixPackagePage(), nil).Once()
+				svc.On("Update", ctx, "pkg-2", pkgUpdate).Return(nil).Once()
+				svc.On("CreateMultiple", ctx, id, pkgsToCreate).Return(nil).Once()
+				return svc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id).Return(fixWebhooks(), nil).Once()
+				repo.On("Update", ctx, updatedWebhook).Return(testErr).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when creating Webhooks fails",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, id).Return(true, nil).Once()
+				return repo
+			},
+			PkgSvcFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationID", ctx, id, 100, "").Return(fixPackagePage(), nil).Once()
+				svc.On("Update", ctx, "pkg-2", pkgUpdate).Return(nil).Once()
+				svc.On("CreateMultiple", ctx, id, pkgsToCreate).Return(nil).Once()
+				return svc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id).Return(fixWebhooks(), nil).Once()
+				repo.On("Update", ctx, updatedWebhook).Return(nil).Once()
+				repo.On("CreateMany", ctx, webhooksToCreate).Return(testErr).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(newWebhookID).Once()
+				return svc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when listing Labels fails",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, id).Return(true, nil).Once()
+				return repo
+			},
+			PkgSvcFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationID", ctx, id, 100, "").Return(fixPackagePage(), nil).Once()
+				svc.On("Update", ctx, "pkg-2", pkgUpdate).Return(nil).Once()
+				svc.On("CreateMultiple", ctx, id, pkgsToCreate).Return(nil).Once()
+				return svc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id).Return(fixWebhooks(), nil).Once()
+				repo.On("Update", ctx, updatedWebhook).Return(nil).Once()
+				repo.On("CreateMany", ctx, webhooksToCreate).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tnt, model.ApplicationLabelableObject, id).Return(nil, testErr).Once()
+				return repo
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(newWebhookID).Once()
+				return svc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when setting Label fails",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, id).Return(true, nil).Once()
+				return repo
+			},
+			PkgSvcFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationID", ctx, id, 100, "").Return(fixPackagePage(), nil).Once()
+				svc.On("Update", ctx, "pkg-2", pkgUpdate).Return(nil).Once()
+				svc.On("CreateMultiple", ctx, id, pkgsToCreate).Return(nil).Once()
+				return svc
+			},
+			WebhookRepoFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByApplicationID", ctx, tnt, id).Return(fixWebhooks(), nil).Once()
+				repo.On("Update", ctx, updatedWebhook).Return(nil).Once()
+				repo.On("CreateMany", ctx, webhooksToCreate).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tnt, model.ApplicationLabelableObject, id).Return(fixLabels(), nil).Once()
+				return repo
+			},
+			LabelUpsertSvcFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tnt, newLabel).Return(testErr).Once()
+				return svc
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(newWebhookID).Once()
+				return svc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			appRepo := testCase.AppRepoFn()
+			pkgSvc := testCase.PkgSvcFn()
+			webhookRepo := testCase.WebhookRepoFn()
+			labelRepo := testCase.LabelRepoFn()
+			labelUpsertSvc := testCase.LabelUpsertSvcFn()
+			uidSvc := testCase.UIDSvcFn()
+			apiSvc := &automock.APIService{}
+			apiSvc.On("ListForPackage", ctx, mock.Anything, 100, "").Return(&model.APIDefinitionPage{}, nil)
+			eventSvc := &automock.EventService{}
+			eventSvc.On("ListForPackage", ctx, mock.Anything, 100, "").Return(&model.EventDefinitionPage{}, nil)
+			documentSvc := &automock.DocumentService{}
+			documentSvc.On("ListForPackage", ctx, mock.Anything, 100, "").Return(&model.DocumentPage{}, nil)
+
+			svc := application.NewService(nil, nil, appRepo, webhookRepo, nil, labelRepo, nil, labelUpsertSvc, nil, pkgSvc, apiSvc, eventSvc, documentSvc, uidSvc)
+
+			// when
+			changes, err := svc.Reconcile(ctx, id, in, testCase.DryRun)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedChanges, changes)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, appRepo, pkgSvc, webhookRepo, labelRepo, labelUpsertSvc, uidSvc)
+		})
+	}
+
+	t.Run("Returns error when tenant not in context", func(t *testing.T) {
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := svc.Reconcile(context.TODO(), id, in, false)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_Reconcile_PackageDefinitions(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	id := "foo"
+	tnt := "tenant"
+	externalTnt := "external-tnt"
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
+
+	pkgID := "pkg-1"
+	specURL := "https://spec.url"
+	fetchedSpecInput := &model.APISpecInput{Type: model.APISpecTypeOpenAPI, Format: model.SpecFormatJSON, FetchRequest: &model.FetchRequestInput{URL: specURL}}
+	in := model.ApplicationRegisterInput{
+		Name: "foo",
+		Packages: []*model.PackageCreateInput{
+			{
+				Name: "pkg",
+				APIDefinitions: []*model.APIDefinitionInput{
+					{Name: "api-up-to-date", TargetURL: "https://target.url", Spec: fetchedSpecInput},
+					{Name: "api-outdated", TargetURL: "https://new.url"},
+					{Name: "api-new", TargetURL: "https://target.url"},
+				},
+				EventDefinitions: []*model.EventDefinitionInput{
+					{Name: "event-outdated", Description: str.Ptr("new description")},
+					{Name: "event-new"},
+				},
+				Documents: []*model.DocumentInput{
+					{Title: "doc-up-to-date", Format: model.DocumentFormatMarkdown, Data: str.Ptr("data")},
+					{Title: "doc-outdated", Format: model.DocumentFormatMarkdown, Data: str.Ptr("new data")},
+					{Title: "doc-new", Format: model.DocumentFormatMarkdown},
+				},
+			},
+		},
+	}
+
+	fixPackagePage := func() *model.PackagePage {
+		return &model.PackagePage{
+			Data:     []*model.Package{{ID: pkgID, ApplicationID: id, Name: "pkg"}},
+			PageInfo: &pagination.Page{HasNextPage: false},
+		}
+	}
+	fixAPIPage := func() *model.APIDefinitionPage {
+		return &model.APIDefinitionPage{
+			Data: []*model.APIDefinition{
+				{ID: "api-1", PackageID: pkgID, Tenant: tnt, Name: "api-up-to-date", TargetURL: "https://target.url", Spec: &model.APISpec{Data: str.Ptr("fetched"), Type: model.APISpecTypeOpenAPI, Format: model.SpecFormatJSON}},
+				{ID: "api-2", PackageID: pkgID, Tenant: tnt, Name: "api-outdated", TargetURL: "https://old.url"},
+				{ID: "api-4", PackageID: pkgID, Tenant: tnt, Name: "api-removed"},
+			},
+			PageInfo: &pagination.Page{HasNextPage: false},
+		}
+	}
+	fixEventPage := func() *model.EventDefinitionPage {
+		return &model.EventDefinitionPage{
+			Data: []*model.EventDefinition{
+				{ID: "event-1", PackageID: pkgID, Tenant: tnt, Name: "event-outdated", Description: str.Ptr("old description")},
+				{ID: "event-3", PackageID: pkgID, Tenant: tnt, Name: "event-removed"},
+			},
+			PageInfo: &pagination.Page{HasNextPage: false},
+		}
+	}
+	fixDocumentPage := func() *model.DocumentPage {
+		return &model.DocumentPage{
+			Data: []*model.Document{
+				{ID: "doc-1", PackageID: pkgID, Tenant: tnt, Title: "doc-up-to-date", Format: model.DocumentFormatMarkdown, Data: str.Ptr("data")},
+				{ID: "doc-2", PackageID: pkgID, Tenant: tnt, Title: "doc-outdated", Format: model.DocumentFormatMarkdown, Data: str.Ptr("old data")},
+				{ID: "doc-5", PackageID: pkgID, Tenant: tnt, Title: "doc-removed", Format: model.DocumentFormatMarkdown},
+			},
+			PageInfo: &pagination.Page{HasNextPage: false},
+		}
+	}
+	specFetchRequest := &model.FetchRequest{ID: "fr-1", Tenant: tnt, URL: specURL, Mode: model.FetchModeSingle, ObjectType: model.APIFetchRequestReference, ObjectID: "api-1"}
+
+	expectedChanges := []*model.ApplicationChange{
+		{Resource: model.ApplicationChangeResourceAPIDefinition, Operation: model.ApplicationChangeOperationUpdate, Key: "pkg/api-outdated"},
+		{Resource: model.ApplicationChangeResourceAPIDefinition, Operation: model.ApplicationChangeOperationCreate, Key: "pkg/api-new"},
+		{Resource: model.ApplicationChangeResourceAPIDefinition, Operation: model.ApplicationChangeOperationRemoved, Key: "pkg/api-removed"},
+		{Resource: model.ApplicationChangeResourceEventDefinition, Operation: model.ApplicationChangeOperationUpdate, Key: "pkg/event-outdated"},
+		{Resource: model.ApplicationChangeResourceEventDefinition, Operation: model.ApplicationChangeOperationCreate, Key: "pkg/event-new"},
+		{Resource: model.ApplicationChangeResourceEventDefinition, Operation: model.ApplicationChangeOperationRemoved, Key: "pkg/event-removed"},
+		{Resource: model.ApplicationChangeResourceDocument, Operation: model.ApplicationChangeOperationUpdate, Key: "pkg/doc-outdated"},
+		{Resource: model.ApplicationChangeResourceDocument, Operation: model.ApplicationChangeOperationCreate, Key: "pkg/doc-new"},
+		{Resource: model.ApplicationChangeResourceDocument, Operation: model.ApplicationChangeOperationRemoved, Key: "pkg/doc-removed"},
+	}
+
+	testCases := []struct {
+		Name               string
+		DryRun             bool
+		APISvcFn           func() *automock.APIService
+		EventSvcFn         func() *automock.EventService
+		DocumentSvcFn      func() *automock.DocumentService
+		ExpectedChanges    []*model.ApplicationChange
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			APISvcFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("ListForPackage", ctx, pkgID, 100, "").Return(fixAPIPage(), nil).Once()
+				svc.On("GetFetchRequest", ctx, "api-1").Return(specFetchRequest, nil).Once()
+				svc.On("GetFetchRequest", ctx, "api-2").Return(nil, nil).Once()
+				svc.On("Update", ctx, "api-2", *in.Packages[0].APIDefinitions[1]).Return(nil).Once()
+				svc.On("CreateInPackage", ctx, pkgID, *in.Packages[0].APIDefinitions[2]).Return("api-3", nil).Once()
+				return svc
+			},
+			EventSvcFn: func() *automock.EventService {
+				svc := &automock.EventService{}
+				svc.On("ListForPackage", ctx, pkgID, 100, "").Return(fixEventPage(), nil).Once()
+				svc.On("GetFetchRequest", ctx, "event-1").Return(nil, nil).Once()
+				svc.On("Update", ctx, "event-1", *in.Packages[0].EventDefinitions[0]).Return(nil).Once()
+				svc.On("CreateInPackage", ctx, pkgID, *in.Packages[0].EventDefinitions[1]).Return("event-2", nil).Once()
+				return svc
+			},
+			DocumentSvcFn: func() *automock.DocumentService {
+				svc := &automock.DocumentService{}
+				svc.On("ListForPackage", ctx, pkgID, 100, "").Return(fixDocumentPage(), nil).Once()
+				svc.On("GetFetchRequest", ctx, "doc-1").Return(nil, nil).Once()
+				svc.On("GetFetchRequest", ctx, "doc-2").Return(nil, nil).Once()
+				svc.On("Delete", ctx, "doc-2").Return(nil).Once()
+				svc.On("CreateInPackage", ctx, pkgID, *in.Packages[0].Documents[1]).Return("doc-3", nil).Once()
+				svc.On("CreateInPackage", ctx, pkgID, *in.Packages[0].Documents[2]).Return("doc-4", nil).Once()
+				return svc
+			},
+			ExpectedChanges: expectedChanges,
+		},
+		{
+			Name:   "Success for dry run",
+			DryRun: true,
+			APISvcFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("ListForPackage", ctx, pkgID, 100, "").Return(fixAPIPage(), nil).Once()
+				svc.On("GetFetchRequest", ctx, "api-1").Return(specFetchRequest, nil).Once()
+				svc.On("GetFetchRequest", ctx, "api-2").Return(nil, nil).Once()
+				return svc
+			},
+			EventSvcFn: func() *automock.EventService {
+				svc := &automock.EventService{}
+				svc.On("ListForPackage", ctx, pkgID, 100, "").Return(fixEventPage(), nil).Once()
+				svc.On("GetFetchRequest", ctx, "event-1").Return(nil, nil).Once()
+				return svc
+			},
+			DocumentSvcFn: func() *automock.DocumentService {
+				svc := &automock.DocumentService{}
+				svc.On("ListForPackage", ctx, pkgID, 100, "").Return(fixDocumentPage(), nil).Once()
+				svc.On("GetFetchRequest", ctx, "doc-1").Return(nil, nil).Once()
+				svc.On("GetFetchRequest", ctx, "doc-2").Return(nil, nil).Once()
+				return svc
+			},
+			ExpectedChanges: expectedChanges,
+		},
+		{
+			Name:   "Detects changed Fetch Request of a spec",
+			DryRun: true,
+			APISvcFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("ListForPackage", ctx, pkgID, 100, "").Return(fixAPIPage(), nil).Once()
+				svc.On("GetFetchRequest", ctx, "api-1").Return(&model.FetchRequest{URL: "https://old.spec.url", Mode: model.FetchModeSingle}, nil).Once()
+				svc.On("GetFetchRequest", ctx, "api-2").Return(nil, nil).Once()
+				return svc
+			},
+			EventSvcFn: func() *automock.EventService {
+				svc := &automock.EventService{}
+				svc.On("ListForPackage", ctx, pkgID, 100, "").Return(fixEventPage(), nil).Once()
+				svc.On("GetFetchRequest", ctx, "event-1").Return(nil, nil).Once()
+				return svc
+			},
+			DocumentSvcFn: func() *automock.DocumentService {
+				svc := &automock.DocumentService{}
+				svc.On("ListForPackage", ctx, pkgID, 100, "").Return(fixDocumentPage(), nil).Once()
+				svc.On("GetFetchRequest", ctx, "doc-1").Return(nil, nil).Once()
+				svc.On("GetFetchRequest", ctx, "doc-2").Return(nil, nil).Once()
+				return svc
+			},
+			ExpectedChanges: append([]*model.ApplicationChange{
+				{Resource: model.ApplicationChangeResourceAPIDefinition, Operation: model.ApplicationChangeOperationUpdate, Key: "pkg/api-up-to-date"},
+			}, expectedChanges...),
+		},
+		{
+			Name: "Returns error when listing API Definitions fails",
+			APISvcFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("ListForPackage", ctx, pkgID, 100, "").Return(nil, testErr).Once()
+				return svc
+			},
+			EventSvcFn: func() *automock.EventService {
+				return &automock.EventService{}
+			},
+			DocumentSvcFn: func() *automock.DocumentService {
+				return &automock.DocumentService{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when updating Event Definition fails",
+			APISvcFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("ListForPackage", ctx, pkgID, 100, "").Return(fixAPIPage(), nil).Once()
+				svc.On("GetFetchRequest", ctx, "api-1").Return(specFetchRequest, nil).Once()
+				svc.On("GetFetchRequest", ctx, "api-2").Return(nil, nil).Once()
+				svc.On("Update", ctx, "api-2", *in.Packages[0].APIDefinitions[1]).Return(nil).Once()
+				svc.On("CreateInPackage", ctx, pkgID, *in.Packages[0].APIDefinitions[2]).Return("api-3", nil).Once()
+				return svc
+			},
+			EventSvcFn: func() *automock.EventService {
+				svc := &automock.EventService{}
+				svc.On("ListForPackage", ctx, pkgID, 100, "").Return(fixEventPage(), nil).Once()
+				svc.On("GetFetchRequest", ctx, "event-1").Return(nil, nil).Once()
+				svc.On("Update", ctx, "event-1", *in.Packages[0].EventDefinitions[0]).Return(testErr).Once()
+				return svc
+			},
+			DocumentSvcFn: func() *automock.DocumentService {
+				return &automock.DocumentService{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when deleting outdated Document fails",
+			APISvcFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("ListForPackage", ctx, pkgID, 100, "").Return(fixAPIPage(), nil).Once()
+				svc.On("GetFetchRequest", ctx, "api-1").Return(specFetchRequest, nil).Once()
+				svc.On("GetFetchRequest", ctx, "api-2").Return(nil, nil).Once()
+				svc.On("Update", ctx, "api-2", *in.Packages[0].APIDefinitions[1]).Return(nil).Once()
+				svc.On("CreateInPackage", ctx, pkgID, *in.Packages[0].APIDefinitions[2]).Return("api-3", nil).Once()
+				return svc
+			},
+			EventSvcFn: func() *automock.EventService {
+				svc := &automock.EventService{}
+				svc.On("ListForPackage", ctx, pkgID, 100, "").Return(fixEventPage(), nil).Once()
+				svc.On("GetFetchRequest", ctx, "event-1").Return(nil, nil).Once()
+				svc.On("Update", ctx, "event-1", *in.Packages[0].EventDefinitions[0]).Return(nil).Once()
+				svc.On("CreateInPackage", ctx, pkgID, *in.Packages[0].EventDefinitions[1]).Return("event-2", nil).Once()
+				return svc
+			},
+			DocumentSvcFn: func() *automock.DocumentService {
+				svc := &automock.DocumentService{}
+				svc.On("ListForPackage", ctx, pkgID, 100, "").Return(fixDocumentPage(), nil).Once()
+				svc.On("GetFetchRequest", ctx, "doc-1").Return(nil, nil).Once()
+				svc.On("GetFetchRequest", ctx, "doc-2").Return(nil, nil).Once()
+				svc.On("Delete", ctx, "doc-2").Return(testErr).Once()
+				return svc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			appRepo := &automock.ApplicationRepository{}
+			appRepo.On("Exists", ctx, tnt, id).Return(true, nil).Once()
+			pkgSvc := &automock.PackageService{}
+			pkgSvc.On("ListByApplicationID", ctx, id, 100, "").Return(fixPackagePage(), nil).Once()
+			webhookRepo := &automock.WebhookRepository{}
+			webhookRepo.On("ListByApplicationID", ctx, tnt, id).Return(nil, nil)
+			labelRepo := &automock.LabelRepository{}
+			labelRepo.On("ListForObject", ctx, tnt, model.ApplicationLabelableObject, id).Return(nil, nil)
+			apiSvc := testCase.APISvcFn()
+			eventSvc := testCase.EventSvcFn()
+			documentSvc := testCase.DocumentSvcFn()
+
+			svc := application.NewService(nil, nil, appRepo, webhookRepo, nil, labelRepo, nil, nil, nil, pkgSvc, apiSvc, eventSvc, documentSvc, nil)
+
+			// when
+			changes, err := svc.Reconcile(ctx, id, in, testCase.DryRun)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedChanges, changes)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, appRepo, pkgSvc, apiSvc, eventSvc, documentSvc)
+		})
+	}
+}
+
+func TestService_GetTemplateReference(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	id := "foo"
+	tnt := "tenant"
+	externalTnt := "external-tnt"
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
+
+	templateID := "tttttttt-tttt-tttt-tttt-tttttttttttt"
+	ref := fixModelTemplateReference(&templateID, 2)
+
+	t.Run("Success", func(t *testing.T) {
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("GetTemplateReference", ctx, tnt, id).Return(ref, nil).Once()
+		defer appRepo.AssertExpectations(t)
+
+		svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		result, err := svc.GetTemplateReference(ctx, id)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ref, result)
+	})
+
+	t.Run("Returns error when getting Application Template reference fails", func(t *testing.T) {
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("GetTemplateReference", ctx, tnt, id).Return(nil, testErr).Once()
+		defer appRepo.AssertExpectations(t)
+
+		svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := svc.GetTemplateReference(ctx, id)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+
+	t.Run("Returns error when tenant not in context", func(t *testing.T) {
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := svc.GetTemplateReference(context.TODO(), id)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_SetTemplateReference(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	id := "foo"
+	tnt := "tenant"
+	externalTnt := "external-tnt"
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
+
+	templateID := "tttttttt-tttt-tttt-tttt-tttttttttttt"
+	ref := fixModelTemplateReference(&templateID, 2)
+
+	t.Run("Success", func(t *testing.T) {
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("SetTemplateReference", ctx, tnt, id, *ref).Return(nil).Once()
+		defer appRepo.AssertExpectations(t)
+
+		svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := svc.SetTemplateReference(ctx, id, *ref)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Returns error when setting Application Template reference fails", func(t *testing.T) {
+		appRepo := &automock.ApplicationRepository{}
+		appRepo.On("SetTemplateReference", ctx, tnt, id, *ref).Return(testErr).Once()
+		defer appRepo.AssertExpectations(t)
+
+		svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := svc.SetTemplateReference(ctx, id, *ref)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+
+	t.Run("Returns error when tenant not in context", func(t *testing.T) {
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		err := svc.SetTemplateReference(context.TODO(), id, *ref)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
@@ -2,7 +2,11 @@ package application
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
@@ -17,11 +21,21 @@ import (
 	"github.com/pkg/errors"
 )
 
-const applicationTable string = `public.applications`
+const (
+	applicationTable string = `public.applications`
+	deletedAtColumn  string = "deleted_at"
+)
 
 var (
-	applicationColumns = []string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id", "provider_name"}
-	tenantColumn       = "tenant_id"
+	applicationColumns                = []string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id", "provider_name"}
+	deletedApplicationColumns         = append(applicationColumns, deletedAtColumn)
+	templateReferenceUpdatableColumns = []string{"app_template_id", "app_template_version", "app_template_values"}
+	templateReferenceColumns          = append([]string{"id", "tenant_id"}, templateReferenceUpdatableColumns...)
+	tenantColumn                      = "tenant_id"
+
+	// Applications marked as deleted are hidden until they are restored or permanently deleted
+	notDeletedCondition = repo.NewNullCondition(deletedAtColumn)
+	deletedCondition    = repo.NewNotNullCondition(deletedAtColumn)
 )
 
 //go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
@@ -33,29 +47,45 @@ type EntityConverter interface {
 type pgRepository struct {
 	existQuerier    repo.ExistQuerier
 	singleGetter    repo.SingleGetter
+	deletedGetter   repo.SingleGetter
 	lister          repo.Lister
 	deleter         repo.Deleter
 	pageableQuerier repo.PageableQuerier
 	creator         repo.Creator
 	updater         repo.Updater
-	conv            EntityConverter
+	deletionUpdater repo.Updater
+	globalLister    repo.ListerGlobal
+
+	globalPageableQuerier repo.PageableQuerierGlobal
+
+	templateReferenceGetter  repo.SingleGetter
+	templateReferenceUpdater repo.Updater
+	conv                     EntityConverter
 }
 
 func NewRepository(conv EntityConverter) *pgRepository {
 	return &pgRepository{
 		existQuerier:    repo.NewExistQuerier(resource.Application, applicationTable, tenantColumn),
 		singleGetter:    repo.NewSingleGetter(resource.Application, applicationTable, tenantColumn, applicationColumns),
+		deletedGetter:   repo.NewSingleGetter(resource.Application, applicationTable, tenantColumn, deletedApplicationColumns),
 		deleter:         repo.NewDeleter(resource.Application, applicationTable, tenantColumn),
 		lister:          repo.NewLister(resource.Application, applicationTable, tenantColumn, applicationColumns),
 		pageableQuerier: repo.NewPageableQuerier(resource.Application, applicationTable, tenantColumn, applicationColumns),
 		creator:         repo.NewCreator(resource.Application, applicationTable, applicationColumns),
 		updater:         repo.NewUpdater(resource.Application, applicationTable, []string{"name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id", "provider_name"}, tenantColumn, []string{"id"}),
+		deletionUpdater: repo.NewUpdater(resource.Application, applicationTable, []string{deletedAtColumn}, tenantColumn, []string{"id"}),
+		globalLister:    repo.NewListerGlobal(resource.Application, applicationTable, applicationColumns),
 		conv:            conv,
+
+		globalPageableQuerier: repo.NewPageableQuerierGlobal(resource.Application, applicationTable, applicationColumns),
+
+		templateReferenceGetter:  repo.NewSingleGetter(resource.Application, applicationTable, tenantColumn, templateReferenceColumns),
+		templateReferenceUpdater: repo.NewUpdater(resource.Application, applicationTable, templateReferenceUpdatableColumns, tenantColumn, []string{"id"}),
 	}
 }
 
 func (r *pgRepository) Exists(ctx context.Context, tenant, id string) (bool, error) {
-	return r.existQuerier.Exists(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
+	return r.existQuerier.Exists(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id), notDeletedCondition})
 }
 
 func (r *pgRepository) Delete(ctx context.Context, tenant, id string) error {
@@ -64,7 +94,7 @@ func (r *pgRepository) Delete(ctx context.Context, tenant, id string) error {
 
 func (r *pgRepository) GetByID(ctx context.Context, tenant, id string) (*model.Application, error) {
 	var appEnt Entity
-	if err := r.singleGetter.Get(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &appEnt); err != nil {
+	if err := r.singleGetter.Get(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id), notDeletedCondition}, repo.NoOrderBy, &appEnt); err != nil {
 		return nil, err
 	}
 
@@ -73,10 +103,19 @@ func (r *pgRepository) GetByID(ctx context.Context, tenant, id string) (*model.A
 	return appModel, nil
 }
 
+func (r *pgRepository) GetDeletedByID(ctx context.Context, tenant, id string) (*model.Application, error) {
+	var appEnt Entity
+	if err := r.deletedGetter.Get(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id), deletedCondition}, repo.NoOrderBy, &appEnt); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&appEnt), nil
+}
+
 func (r *pgRepository) ListAll(ctx context.Context, tenantID string) ([]*model.Application, error) {
 	var entities EntityCollection
 
-	err := r.lister.List(ctx, tenantID, &entities)
+	err := r.lister.List(ctx, tenantID, &entities, notDeletedCondition)
 
 	if err != nil {
 		return nil, err
@@ -96,7 +135,7 @@ func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelf
 		return nil, errors.Wrap(err, "while building filter query")
 	}
 
-	var conditions repo.Conditions
+	conditions := repo.Conditions{notDeletedCondition}
 	if filterSubquery != "" {
 		conditions = append(conditions, repo.NewInConditionForSubQuery("id", filterSubquery, args))
 	}
@@ -151,12 +190,23 @@ func (r *pgRepository) ListByScenarios(ctx context.Context, tenant uuid.UUID, sc
 	combinedQuery := scenariosSubquery + appHideSubquery
 	combinedArgs := append(scenariosArgs, appHideArgs...)
 
-	var conditions repo.Conditions
+	tenantConditions := repo.Conditions{repo.NewEqualCondition(tenantColumn, tenant)}
 	if combinedQuery != "" {
-		conditions = append(conditions, repo.NewInConditionForSubQuery("id", combinedQuery, combinedArgs))
+		tenantConditions = append(tenantConditions, repo.NewInConditionForSubQuery("id", combinedQuery, combinedArgs))
+	}
+
+	// Applications shared with the tenant, either without a scenario or in one of the scenarios. Hiding selectors are not applied to them.
+	sharedSubquery, sharedArgs := sharedApplicationsSubquery(tenant, scenarios)
+
+	conditions := repo.Conditions{
+		notDeletedCondition,
+		repo.NewOrCondition(
+			repo.NewAndCondition(tenantConditions...),
+			repo.NewInConditionForSubQuery("id", sharedSubquery, sharedArgs),
+		),
 	}
 
-	page, totalCount, err := r.pageableQuerier.List(ctx, tenant.String(), pageSize, cursor, "id", &appsCollection, conditions...)
+	page, totalCount, err := r.globalPageableQuerier.ListGlobal(ctx, pageSize, cursor, "id", &appsCollection, conditions...)
 
 	if err != nil {
 		return nil, err
@@ -174,6 +224,22 @@ func (r *pgRepository) ListByScenarios(ctx context.Context, tenant uuid.UUID, sc
 		PageInfo:   page}, nil
 }
 
+func sharedApplicationsSubquery(tenant uuid.UUID, scenarios []string) (string, []interface{}) {
+	args := []interface{}{tenant}
+	placeholders := make([]string, 0, len(scenarios))
+	for _, scenario := range scenarios {
+		placeholders = append(placeholders, "?")
+		args = append(args, scenario)
+	}
+
+	query := `SELECT app_id FROM public.application_shares WHERE target_tenant_id = ? AND scenario IS NULL`
+	if len(placeholders) > 0 {
+		query = fmt.Sprintf(`SELECT app_id FROM public.application_shares WHERE target_tenant_id = ? AND (scenario IS NULL OR scenario IN (%s))`, strings.Join(placeholders, ", "))
+	}
+
+	return query, args
+}
+
 func (r *pgRepository) Create(ctx context.Context, model *model.Application) error {
 	if model == nil {
 		return apperrors.NewInternalError("model can not be empty")
@@ -205,6 +271,65 @@ func (r *pgRepository) Update(ctx context.Context, model *model.Application) err
 	return r.updater.UpdateSingle(ctx, appEnt)
 }
 
+func (r *pgRepository) MarkAsDeleted(ctx context.Context, tenant, id string, deletedAt time.Time) error {
+	return r.deletionUpdater.UpdateSingle(ctx, &deletionEntity{ID: id, TenantID: tenant, DeletedAt: &deletedAt})
+}
+
+func (r *pgRepository) Restore(ctx context.Context, tenant, id string) error {
+	return r.deletionUpdater.UpdateSingle(ctx, &deletionEntity{ID: id, TenantID: tenant})
+}
+
+// GetTemplateReference returns the Application Template the Application was registered from or nil if the Application was not registered from a template
+func (r *pgRepository) GetTemplateReference(ctx context.Context, tenant, id string) (*model.ApplicationTemplateReference, error) {
+	var refEnt templateReferenceEntity
+	if err := r.templateReferenceGetter.Get(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id), notDeletedCondition}, repo.NoOrderBy, &refEnt); err != nil {
+		return nil, err
+	}
+
+	if !refEnt.AppTemplateVersion.Valid {
+		return nil, nil
+	}
+
+	var values model.ApplicationFromTemplateInputValues
+	if refEnt.AppTemplateValues.Valid {
+		if err := json.Unmarshal([]byte(refEnt.AppTemplateValues.String), &values); err != nil {
+			return nil, errors.Wrapf(err, "while unmarshalling Application Template values of Application with id %s", id)
+		}
+	}
+
+	return &model.ApplicationTemplateReference{
+		ApplicationTemplateID: repo.StringPtrFromNullableString(refEnt.AppTemplateID),
+		Version:               int(refEnt.AppTemplateVersion.Int64),
+		Values:                values,
+	}, nil
+}
+
+func (r *pgRepository) SetTemplateReference(ctx context.Context, tenant, id string, ref model.ApplicationTemplateReference) error {
+	values, err := json.Marshal(ref.Values)
+	if err != nil {
+		return errors.Wrapf(err, "while marshalling Application Template values of Application with id %s", id)
+	}
+
+	return r.templateReferenceUpdater.UpdateSingle(ctx, &templateReferenceEntity{
+		ID:                 id,
+		TenantID:           tenant,
+		AppTemplateID:      repo.NewNullableString(ref.ApplicationTemplateID),
+		AppTemplateVersion: sql.NullInt64{Int64: int64(ref.Version), Valid: true},
+		AppTemplateValues:  repo.NewValidNullableString(string(values)),
+	})
+}
+
+// ListDeletedBefore returns Applications from all tenants which were marked as deleted before the given time
+func (r *pgRepository) ListDeletedBefore(ctx context.Context, before time.Time) ([]*model.Application, error) {
+	var entities EntityCollection
+
+	if err := r.globalLister.ListGlobal(ctx, &entities, repo.NewLessThanCondition(deletedAtColumn, before)); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
 func (r *pgRepository) multipleFromEntities(entities EntityCollection) ([]*model.Application, error) {
 	var items []*model.Application
 	for _, ent := range entities {
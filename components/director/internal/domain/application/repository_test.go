@@ -29,7 +29,7 @@ func TestRepository_Exists(t *testing.T) {
 	sqlxDB, sqlMock := testdb.MockDatabase(t)
 	defer sqlMock.AssertExpectations(t)
 
-	sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM public.applications WHERE tenant_id = $1 AND id = $2")).WithArgs(
+	sqlMock.ExpectQuery(regexp.QuoteMeta("SELECT 1 FROM public.applications WHERE tenant_id = $1 AND id = $2 AND deleted_at IS NULL")).WithArgs(
 		givenTenant(), givenID()).
 		WillReturnRows(testdb.RowWhenObjectExist())
 
@@ -239,7 +239,7 @@ func TestRepository_GetByID(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id", "provider_name"}).
 			AddRow(givenID(), givenTenant(), appEntity.Name, appEntity.Description, appEntity.StatusCondition, appEntity.StatusTimestamp, appEntity.HealthCheckURL, appEntity.IntegrationSystemID, appEntity.ProviderName)
 
-		dbMock.ExpectQuery(`^SELECT (.+) FROM public.applications WHERE tenant_id = \$1 AND id = \$2$`).
+		dbMock.ExpectQuery(`^SELECT (.+) FROM public.applications WHERE tenant_id = \$1 AND id = \$2 AND deleted_at IS NULL$`).
 			WithArgs(givenTenant(), givenID()).
 			WillReturnRows(rows)
 
@@ -286,8 +286,8 @@ func TestPgRepository_List(t *testing.T) {
 	inputCursor := ""
 	totalCount := 2
 
-	pageableQuery := `^SELECT (.+) FROM public\.applications WHERE tenant_id = \$1 ORDER BY id LIMIT %d OFFSET %d$`
-	countQuery := `SELECT COUNT\(\*\) FROM public\.applications WHERE tenant_id = \$1`
+	pageableQuery := `^SELECT (.+) FROM public\.applications WHERE tenant_id = \$1 AND deleted_at IS NULL ORDER BY id LIMIT %d OFFSET %d$`
+	countQuery := `SELECT COUNT\(\*\) FROM public\.applications WHERE tenant_id = \$1 AND deleted_at IS NULL`
 
 	t.Run("Success", func(t *testing.T) {
 		// given
@@ -359,7 +359,7 @@ func TestPgRepository_ListAll(t *testing.T) {
 	appModel1 := fixDetailedModelApplication(t, app1ID, givenTenant(), "App 1", "App desc 1")
 	appModel2 := fixDetailedModelApplication(t, app2ID, givenTenant(), "App 2", "App desc 2")
 
-	listQuery := `^SELECT (.+) FROM public\.applications WHERE tenant_id = \$1`
+	listQuery := `^SELECT (.+) FROM public\.applications WHERE tenant_id = \$1 AND deleted_at IS NULL$`
 
 	t.Run("Success", func(t *testing.T) {
 		// given
@@ -443,20 +443,25 @@ func TestPgRepository_ListByRuntimeScenarios(t *testing.T) {
 		fmt.Sprintf(`%s EXCEPT SELECT "app_id" FROM public.labels WHERE "app_id" IS NOT NULL AND "tenant_id" = $11 AND "key" = $12 AND "value" @> $13 EXCEPT SELECT "app_id" FROM public.labels WHERE "app_id" IS NOT NULL AND "tenant_id" = $14 AND "key" = $15 AND "value" @> $16`, scenariosQuery),
 	)
 
-	pageableQueryRegex := `SELECT (.+) FROM public\.applications WHERE tenant_id = \$1 AND id IN \(%s\) ORDER BY id LIMIT %d OFFSET %d`
+	sharedQuery := regexp.QuoteMeta(`SELECT app_id FROM public.application_shares WHERE target_tenant_id = $11 AND (scenario IS NULL OR scenario IN ($12, $13, $14))`)
+	sharedQueryWithHidingSelectors := regexp.QuoteMeta(`SELECT app_id FROM public.application_shares WHERE target_tenant_id = $17 AND (scenario IS NULL OR scenario IN ($18, $19, $20))`)
+
+	pageableQueryRegex := `SELECT (.+) FROM public\.applications WHERE deleted_at IS NULL AND \(\(tenant_id = \$1 AND id IN \(%s\)\) OR id IN \(%s\)\) ORDER BY id LIMIT %d OFFSET %d`
 	pageableQuery := fmt.Sprintf(pageableQueryRegex,
 		applicationScenarioQuery,
+		sharedQuery,
 		pageSize,
 		0)
 
 	pageableQueryWithHidingSelectors := fmt.Sprintf(pageableQueryRegex,
 		applicationScenarioQueryWithHidingSelectors,
+		sharedQueryWithHidingSelectors,
 		pageSize,
 		0)
 
-	countQueryRegex := `SELECT COUNT\(\*\) FROM public\.applications WHERE tenant_id = \$1 AND id IN \(%s\)$`
-	countQuery := fmt.Sprintf(countQueryRegex, applicationScenarioQuery)
-	countQueryWithHidingSelectors := fmt.Sprintf(countQueryRegex, applicationScenarioQueryWithHidingSelectors)
+	countQueryRegex := `SELECT COUNT\(\*\) FROM public\.applications WHERE deleted_at IS NULL AND \(\(tenant_id = \$1 AND id IN \(%s\)\) OR id IN \(%s\)\)$`
+	countQuery := fmt.Sprintf(countQueryRegex, applicationScenarioQuery, sharedQuery)
+	countQueryWithHidingSelectors := fmt.Sprintf(countQueryRegex, applicationScenarioQueryWithHidingSelectors, sharedQueryWithHidingSelectors)
 
 	conv := application.NewConverter(nil, nil)
 	intSysID := repo.NewValidNullableString("iiiiiiiii-iiii-iiii-iiii-iiiiiiiiiiii")
@@ -476,7 +481,7 @@ func TestPgRepository_ListByRuntimeScenarios(t *testing.T) {
 			InputHidingSelectors:     nil,
 			ExpectedPageableQuery:    pageableQuery,
 			ExpectedCountQuery:       countQuery,
-			ExpectedQueriesInputArgs: []driver.Value{tenantID, tenantID, scenariosKey, "Java", tenantID, scenariosKey, "Go", tenantID, scenariosKey, "Elixir"},
+			ExpectedQueriesInputArgs: []driver.Value{tenantID, tenantID, scenariosKey, "Java", tenantID, scenariosKey, "Go", tenantID, scenariosKey, "Elixir", tenantID, "Java", "Go", "Elixir"},
 			ExpectedApplicationRows: sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id"}).
 				AddRow(app1ID, tenantID, "App ABC", "Description for application ABC", "INITIAL", timestamp, "http://domain.local/app1", intSysID).
 				AddRow(app2ID, tenantID, "App XYZ", "Description for application XYZ", "INITIAL", timestamp, "http://domain.local/app2", intSysID),
@@ -490,7 +495,7 @@ func TestPgRepository_ListByRuntimeScenarios(t *testing.T) {
 			},
 			ExpectedPageableQuery:    pageableQueryWithHidingSelectors,
 			ExpectedCountQuery:       countQueryWithHidingSelectors,
-			ExpectedQueriesInputArgs: []driver.Value{tenantID, tenantID, scenariosKey, "Java", tenantID, scenariosKey, "Go", tenantID, scenariosKey, "Elixir", tenantID, "foo", strconv.Quote("bar"), tenantID, "foo", strconv.Quote("baz")},
+			ExpectedQueriesInputArgs: []driver.Value{tenantID, tenantID, scenariosKey, "Java", tenantID, scenariosKey, "Go", tenantID, scenariosKey, "Elixir", tenantID, "foo", strconv.Quote("bar"), tenantID, "foo", strconv.Quote("baz"), tenantID, "Java", "Go", "Elixir"},
 			ExpectedApplicationRows: sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id"}).
 				AddRow(app1ID, tenantID, "App ABC", "Description for application ABC", "INITIAL", timestamp, "http://domain.local/app1", intSysID).
 				AddRow(app2ID, tenantID, "App XYZ", "Description for application XYZ", "INITIAL", timestamp, "http://domain.local/app2", intSysID),
@@ -502,7 +507,7 @@ func TestPgRepository_ListByRuntimeScenarios(t *testing.T) {
 			InputHidingSelectors:     nil,
 			ExpectedPageableQuery:    pageableQuery,
 			ExpectedCountQuery:       countQuery,
-			ExpectedQueriesInputArgs: []driver.Value{tenantID, tenantID, scenariosKey, "Java", tenantID, scenariosKey, "Go", tenantID, scenariosKey, "Elixir"},
+			ExpectedQueriesInputArgs: []driver.Value{tenantID, tenantID, scenariosKey, "Java", tenantID, scenariosKey, "Go", tenantID, scenariosKey, "Elixir", tenantID, "Java", "Go", "Elixir"},
 			ExpectedApplicationRows:  sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id"}),
 			TotalCount:               0,
 			ExpectedError:            nil,
@@ -546,3 +551,318 @@ func TestPgRepository_ListByRuntimeScenarios(t *testing.T) {
 func givenError() error {
 	return errors.New("some error")
 }
+
+func TestRepository_GetDeletedByID(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// given
+		appModel := fixDetailedModelApplication(t, givenID(), givenTenant(), "Test app", "Test app description")
+		appEntity := fixDetailedEntityApplication(t, givenID(), givenTenant(), "Test app", "Test app description")
+
+		mockConverter := &automock.EntityConverter{}
+		mockConverter.On("FromEntity", appEntity).Return(appModel, nil).Once()
+		defer mockConverter.AssertExpectations(t)
+
+		repo := application.NewRepository(mockConverter)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		deletedAt := time.Now()
+		appModel.DeletedAt = &deletedAt
+		appEntity.DeletedAt = &deletedAt
+
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id", "provider_name", "deleted_at"}).
+			AddRow(givenID(), givenTenant(), appEntity.Name, appEntity.Description, appEntity.StatusCondition, appEntity.StatusTimestamp, appEntity.HealthCheckURL, appEntity.IntegrationSystemID, appEntity.ProviderName, deletedAt)
+
+		dbMock.ExpectQuery(`^SELECT (.+), deleted_at FROM public.applications WHERE tenant_id = \$1 AND id = \$2 AND deleted_at IS NOT NULL$`).
+			WithArgs(givenTenant(), givenID()).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// when
+		actual, err := repo.GetDeletedByID(ctx, givenTenant(), givenID())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, appModel, actual)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		// given
+		repository := application.NewRepository(nil)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery("SELECT .*").
+			WithArgs(givenTenant(), givenID()).WillReturnError(givenError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		// when
+		_, err := repository.GetDeletedByID(ctx, givenTenant(), givenID())
+
+		// then
+		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_MarkAsDeleted(t *testing.T) {
+	deletedAt := time.Now()
+	updateStmt := regexp.QuoteMeta(`UPDATE public.applications SET deleted_at = ? WHERE tenant_id = ? AND id = ?`)
+
+	t.Run("Success", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(updateStmt).
+			WithArgs(deletedAt, givenTenant(), givenID()).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := application.NewRepository(nil)
+
+		// when
+		err := repo.MarkAsDeleted(ctx, givenTenant(), givenID(), deletedAt)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(updateStmt).
+			WithArgs(deletedAt, givenTenant(), givenID()).
+			WillReturnError(givenError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := application.NewRepository(nil)
+
+		// when
+		err := repo.MarkAsDeleted(ctx, givenTenant(), givenID(), deletedAt)
+
+		// then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_Restore(t *testing.T) {
+	// given
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+
+	dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.applications SET deleted_at = ? WHERE tenant_id = ? AND id = ?`)).
+		WithArgs(nil, givenTenant(), givenID()).
+		WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := application.NewRepository(nil)
+
+	// when
+	err := repo.Restore(ctx, givenTenant(), givenID())
+
+	// then
+	require.NoError(t, err)
+}
+
+func TestRepository_GetTemplateReference(t *testing.T) {
+	selectStmt := `^SELECT id, tenant_id, app_template_id, app_template_version, app_template_values FROM public.applications WHERE tenant_id = \$1 AND id = \$2 AND deleted_at IS NULL$`
+	columns := []string{"id", "tenant_id", "app_template_id", "app_template_version", "app_template_values"}
+
+	t.Run("Success", func(t *testing.T) {
+		// given
+		templateID := "tttttttt-tttt-tttt-tttt-tttttttttttt"
+		expected := fixModelTemplateReference(&templateID, 2)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(columns).
+			AddRow(givenID(), givenTenant(), templateID, 2, `[{"Placeholder":"name","Value":"foo"}]`)
+		dbMock.ExpectQuery(selectStmt).
+			WithArgs(givenTenant(), givenID()).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := application.NewRepository(nil)
+
+		// when
+		actual, err := repo.GetTemplateReference(ctx, givenTenant(), givenID())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("Success when Application Template was deleted", func(t *testing.T) {
+		// given
+		expected := fixModelTemplateReference(nil, 1)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(columns).
+			AddRow(givenID(), givenTenant(), nil, 1, `[{"Placeholder":"name","Value":"foo"}]`)
+		dbMock.ExpectQuery(selectStmt).
+			WithArgs(givenTenant(), givenID()).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := application.NewRepository(nil)
+
+		// when
+		actual, err := repo.GetTemplateReference(ctx, givenTenant(), givenID())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("Returns nil when Application was not registered from Application Template", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(columns).
+			AddRow(givenID(), givenTenant(), nil, nil, nil)
+		dbMock.ExpectQuery(selectStmt).
+			WithArgs(givenTenant(), givenID()).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := application.NewRepository(nil)
+
+		// when
+		actual, err := repo.GetTemplateReference(ctx, givenTenant(), givenID())
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(selectStmt).
+			WithArgs(givenTenant(), givenID()).
+			WillReturnError(givenError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := application.NewRepository(nil)
+
+		// when
+		_, err := repo.GetTemplateReference(ctx, givenTenant(), givenID())
+
+		// then
+		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_SetTemplateReference(t *testing.T) {
+	updateStmt := regexp.QuoteMeta(`UPDATE public.applications SET app_template_id = ?, app_template_version = ?, app_template_values = ? WHERE tenant_id = ? AND id = ?`)
+	templateID := "tttttttt-tttt-tttt-tttt-tttttttttttt"
+	ref := fixModelTemplateReference(&templateID, 2)
+
+	t.Run("Success", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(updateStmt).
+			WithArgs(templateID, 2, `[{"Placeholder":"name","Value":"foo"}]`, givenTenant(), givenID()).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := application.NewRepository(nil)
+
+		// when
+		err := repo.SetTemplateReference(ctx, givenTenant(), givenID(), *ref)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		// given
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(updateStmt).
+			WithArgs(templateID, 2, `[{"Placeholder":"name","Value":"foo"}]`, givenTenant(), givenID()).
+			WillReturnError(givenError())
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := application.NewRepository(nil)
+
+		// when
+		err := repo.SetTemplateReference(ctx, givenTenant(), givenID(), *ref)
+
+		// then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestPgRepository_ListDeletedBefore(t *testing.T) {
+	before := time.Now()
+	appEntity := fixDetailedEntityApplication(t, givenID(), givenTenant(), "App 1", "App desc 1")
+	appModel := fixDetailedModelApplication(t, givenID(), givenTenant(), "App 1", "App desc 1")
+
+	listQuery := `^SELECT (.+) FROM public\.applications WHERE deleted_at < \$1$`
+
+	t.Run("Success", func(t *testing.T) {
+		// given
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "name", "description", "status_condition", "status_timestamp", "healthcheck_url", "integration_system_id", "provider_name"}).
+			AddRow(appEntity.ID, appEntity.TenantID, appEntity.Name, appEntity.Description, appEntity.StatusCondition, appEntity.StatusTimestamp, appEntity.HealthCheckURL, appEntity.IntegrationSystemID, appEntity.ProviderName)
+
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		defer sqlMock.AssertExpectations(t)
+
+		sqlMock.ExpectQuery(listQuery).
+			WithArgs(before).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		conv := &automock.EntityConverter{}
+		conv.On("FromEntity", appEntity).Return(appModel).Once()
+		defer conv.AssertExpectations(t)
+
+		pgRepository := application.NewRepository(conv)
+
+		// when
+		apps, err := pgRepository.ListDeletedBefore(ctx, before)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, apps, 1)
+		assert.Equal(t, appModel, apps[0])
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		// given
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		defer sqlMock.AssertExpectations(t)
+
+		sqlMock.ExpectQuery(listQuery).
+			WithArgs(before).
+			WillReturnError(givenError())
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		pgRepository := application.NewRepository(nil)
+
+		// when
+		_, err := pgRepository.ListDeletedBefore(ctx, before)
+
+		// then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "error while executing SQL query")
+	})
+}
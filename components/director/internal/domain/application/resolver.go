@@ -3,7 +3,10 @@ package application
 import (
 	"context"
 	"strings"
+	"time"
 
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
@@ -31,10 +34,17 @@ type ApplicationService interface {
 	Delete(ctx context.Context, id string) error
 	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error)
 	ListByRuntimeID(ctx context.Context, runtimeUUID uuid.UUID, pageSize int, cursor string) (*model.ApplicationPage, error)
+	ListByRuntimeContextID(ctx context.Context, runtimeContextUUID uuid.UUID, pageSize int, cursor string) (*model.ApplicationPage, error)
 	SetLabel(ctx context.Context, label *model.LabelInput) error
 	GetLabel(ctx context.Context, applicationID string, key string) (*model.Label, error)
 	ListLabels(ctx context.Context, applicationID string) (map[string]*model.Label, error)
+	ListLabelsForApplicationIDs(ctx context.Context, applicationIDs []string) (map[string]map[string]*model.Label, error)
 	DeleteLabel(ctx context.Context, applicationID string, key string) error
+	MarkAsDeleted(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string, gracePeriod time.Duration) error
+	ListDeletedBefore(ctx context.Context, before time.Time) ([]*model.Application, error)
+	ListRuntimeIDsInScenarios(ctx context.Context, id string) ([]string, error)
+	GetTemplateReference(ctx context.Context, id string) (*model.ApplicationTemplateReference, error)
 }
 
 //go:generate mockery -name=ApplicationConverter -output=automock -outpkg=automock -case=underscore
@@ -44,12 +54,14 @@ type ApplicationConverter interface {
 	CreateInputFromGraphQL(ctx context.Context, in graphql.ApplicationRegisterInput) (model.ApplicationRegisterInput, error)
 	UpdateInputFromGraphQL(in graphql.ApplicationUpdateInput) model.ApplicationUpdateInput
 	GraphQLToModel(obj *graphql.Application, tenantID string) *model.Application
+	TemplateReferenceToGraphQL(in *model.ApplicationTemplateReference) *graphql.ApplicationTemplateReference
 }
 
 //go:generate mockery -name=EventingService -output=automock -outpkg=automock -case=underscore
 type EventingService interface {
 	CleanupAfterUnregisteringApplication(ctx context.Context, appID uuid.UUID) (*model.ApplicationEventingConfiguration, error)
 	GetForApplication(ctx context.Context, app model.Application) (*model.ApplicationEventingConfiguration, error)
+	ReelectForApplications(ctx context.Context, appIDs []uuid.UUID) error
 }
 
 //go:generate mockery -name=WebhookService -output=automock -outpkg=automock -case=underscore
@@ -94,7 +106,9 @@ type RuntimeService interface {
 type PackageService interface {
 	GetForApplication(ctx context.Context, id string, applicationID string) (*model.Package, error)
 	ListByApplicationID(ctx context.Context, applicationID string, pageSize int, cursor string) (*model.PackagePage, error)
+	ListByApplicationIDs(ctx context.Context, applicationIDs []string, pageSize int, cursor string) ([]*model.PackagePage, error)
 	CreateMultiple(ctx context.Context, applicationID string, in []*model.PackageCreateInput) error
+	Update(ctx context.Context, id string, in model.PackageUpdateInput) error
 }
 
 //go:generate mockery -name=PackageConverter -output=automock -outpkg=automock -case=underscore
@@ -119,6 +133,8 @@ type Resolver struct {
 	sysAuthConv      SystemAuthConverter
 	eventingSvc      EventingService
 	pkgConv          PackageConverter
+
+	deletionGracePeriod time.Duration
 }
 
 func NewResolver(transact persistence.Transactioner,
@@ -131,7 +147,8 @@ func NewResolver(transact persistence.Transactioner,
 	sysAuthConv SystemAuthConverter,
 	eventingSvc EventingService,
 	pkgSvc PackageService,
-	pkgConverter PackageConverter) *Resolver {
+	pkgConverter PackageConverter,
+	deletionGracePeriod time.Duration) *Resolver {
 	return &Resolver{
 		transact:         transact,
 		appSvc:           svc,
@@ -144,6 +161,8 @@ func NewResolver(transact persistence.Transactioner,
 		eventingSvc:      eventingSvc,
 		pkgSvc:           pkgSvc,
 		pkgConv:          pkgConverter,
+
+		deletionGracePeriod: deletionGracePeriod,
 	}
 }
 
@@ -215,6 +234,42 @@ func (r *Resolver) Application(ctx context.Context, id string) (*graphql.Applica
 }
 
 func (r *Resolver) ApplicationsForRuntime(ctx context.Context, runtimeID string, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
+	consumerInfo, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Runtime Contexts are isolated by their own scenarios, so they cannot list the Applications of the requested Runtime
+	if consumerInfo.ConsumerType == consumer.RuntimeContext {
+		log.C(ctx).Debugf("Consumer type is of type %v. Filtering response based on scenarios of the Runtime Context...", consumer.RuntimeContext)
+		return r.ApplicationsForRuntimeContext(ctx, consumerInfo.ConsumerID, first, after)
+	}
+
+	runtimeUUID, err := uuid.Parse(runtimeID)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting runtimeID to UUID")
+	}
+
+	return r.applicationsPage(ctx, first, after, func(ctx context.Context, pageSize int, cursor string) (*model.ApplicationPage, error) {
+		appPage, err := r.appSvc.ListByRuntimeID(ctx, runtimeUUID, pageSize, cursor)
+		return appPage, errors.Wrap(err, "while getting all Application for Runtime")
+	})
+}
+
+// ApplicationsForRuntimeContext returns the Applications which share a scenario with the Runtime Context
+func (r *Resolver) ApplicationsForRuntimeContext(ctx context.Context, runtimeContextID string, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
+	runtimeContextUUID, err := uuid.Parse(runtimeContextID)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting runtimeContextID to UUID")
+	}
+
+	return r.applicationsPage(ctx, first, after, func(ctx context.Context, pageSize int, cursor string) (*model.ApplicationPage, error) {
+		appPage, err := r.appSvc.ListByRuntimeContextID(ctx, runtimeContextUUID, pageSize, cursor)
+		return appPage, errors.Wrap(err, "while getting all Application for Runtime Context")
+	})
+}
+
+func (r *Resolver) applicationsPage(ctx context.Context, first *int, after *graphql.PageCursor, listFn func(ctx context.Context, pageSize int, cursor string) (*model.ApplicationPage, error)) (*graphql.ApplicationPage, error) {
 	var cursor string
 	if after != nil {
 		cursor = string(*after)
@@ -232,22 +287,33 @@ func (r *Resolver) ApplicationsForRuntime(ctx context.Context, runtimeID string,
 		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
 	}
 
-	runtimeUUID, err := uuid.Parse(runtimeID)
+	appPage, err := listFn(ctx, *first, cursor)
 	if err != nil {
-		return nil, errors.Wrap(err, "while converting runtimeID to UUID")
+		return nil, err
 	}
 
-	appPage, err := r.appSvc.ListByRuntimeID(ctx, runtimeUUID, *first, cursor)
+	err = tx.Commit()
 	if err != nil {
-		return nil, errors.Wrap(err, "while getting all Application for Runtime")
+		return nil, err
 	}
 
-	err = tx.Commit()
+	tenantID, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	// Applications shared with the tenant keep their owner, so that their Packages are read in the owner tenant
+	owners := make(map[string]string)
+	for _, app := range appPage.Data {
+		if app.Tenant != tenantID {
+			owners[app.ID] = app.Tenant
+		}
+	}
+
 	gqlApps := r.appConverter.MultipleToGraphQL(appPage.Data)
+	for _, app := range gqlApps {
+		app.OwnerTenant = owners[app.ID]
+	}
 
 	return &graphql.ApplicationPage{
 		Data:       gqlApps,
@@ -344,25 +410,43 @@ func (r *Resolver) UnregisterApplication(ctx context.Context, id string) (*graph
 		return nil, err
 	}
 
-	appID, err := uuid.Parse(app.ID)
+	if r.deletionGracePeriod > 0 {
+		log.C(ctx).Infof("Marking Application with id %s as deleted for %s", id, r.deletionGracePeriod)
+		err = r.appSvc.MarkAsDeleted(ctx, id)
+	} else {
+		err = deleteWithRelatedResources(ctx, app.ID, r.appSvc, r.eventingSvc, r.sysAuthSvc, r.oAuth20Svc)
+	}
 	if err != nil {
-		return nil, errors.Wrap(err, "while parsing application ID as UUID")
+		return nil, err
 	}
 
-	if _, err = r.eventingSvc.CleanupAfterUnregisteringApplication(ctx, appID); err != nil {
+	err = tx.Commit()
+	if err != nil {
 		return nil, err
 	}
 
-	auths, err := r.sysAuthSvc.ListForObject(ctx, model.ApplicationReference, app.ID)
+	deletedApp := r.appConverter.ToGraphQL(app)
+
+	log.C(ctx).Infof("Successfully unregistered Application with id %s", id)
+	return deletedApp, nil
+}
+
+func (r *Resolver) RestoreApplication(ctx context.Context, id string) (*graphql.Application, error) {
+	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
 	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
 
-	err = r.oAuth20Svc.DeleteMultipleClientCredentials(ctx, auths)
-	if err != nil {
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	log.C(ctx).Infof("Restoring Application with id %s", id)
+
+	if err := r.appSvc.Restore(ctx, id, r.deletionGracePeriod); err != nil {
 		return nil, err
 	}
-	err = r.appSvc.Delete(ctx, id)
+
+	app, err := r.appSvc.Get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -372,11 +456,10 @@ func (r *Resolver) UnregisterApplication(ctx context.Context, id string) (*graph
 		return nil, err
 	}
 
-	deletedApp := r.appConverter.ToGraphQL(app)
-
-	log.C(ctx).Infof("Successfully unregistered Application with id %s", id)
-	return deletedApp, nil
+	log.C(ctx).Infof("Successfully restored Application with id %s", id)
+	return r.appConverter.ToGraphQL(app), nil
 }
+
 func (r *Resolver) SetApplicationLabel(ctx context.Context, applicationID string, key string, value interface{}) (*graphql.Label, error) {
 	// TODO: Use @validation directive on input type instead, after resolving https://github.com/kyma-incubator/compass/issues/515
 	gqlLabel := graphql.LabelInput{Key: key, Value: value}
@@ -384,6 +467,10 @@ func (r *Resolver) SetApplicationLabel(ctx context.Context, applicationID string
 		return nil, errors.Wrap(err, "validation error for type LabelInput")
 	}
 
+	if key == eventing.ApplicationEventingPolicyLabel {
+		return nil, apperrors.NewInvalidDataError("label %s can be set only with setEventingPolicyForApplication mutation", key)
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -402,6 +489,13 @@ func (r *Resolver) SetApplicationLabel(ctx context.Context, applicationID string
 		return nil, err
 	}
 
+	if key == model.ScenariosKey {
+		err = r.reelectDefaultEventing(ctx, applicationID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -432,6 +526,13 @@ func (r *Resolver) DeleteApplicationLabel(ctx context.Context, applicationID str
 		return nil, err
 	}
 
+	if key == model.ScenariosKey {
+		err = r.reelectDefaultEventing(ctx, applicationID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -470,6 +571,10 @@ func (r *Resolver) Labels(ctx context.Context, obj *graphql.Application, key *st
 		return nil, apperrors.NewInternalError("Application cannot be empty")
 	}
 
+	if loaders := dataloader.LoadFromContext(ctx); loaders != nil {
+		return loaders.LabelsByApplicationID.Load(dataloader.ParamID{ID: obj.ID, Ctx: ctx})
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -572,11 +677,46 @@ func (r *Resolver) EventingConfiguration(ctx context.Context, obj *graphql.Appli
 	return eventing.ApplicationEventingConfigurationToGraphQL(eventingCfg), nil
 }
 
+func (r *Resolver) TemplateReference(ctx context.Context, obj *graphql.Application) (*graphql.ApplicationTemplateReference, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening the transaction")
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	ref, err := r.appSvc.GetTemplateReference(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing the transaction")
+	}
+
+	return r.appConverter.TemplateReferenceToGraphQL(ref), nil
+}
+
 func (r *Resolver) Packages(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.PackagePage, error) {
 	if obj == nil {
 		return nil, apperrors.NewInternalError("Application cannot be empty")
 	}
 
+	// Packages of shared Applications are read in the owner tenant, which cannot be batched with the Packages of the tenant
+	if loaders := dataloader.LoadFromContext(ctx); loaders != nil && obj.OwnerTenant == "" {
+		return loaders.PackagesByApplicationID.Load(dataloader.ParamPage{ID: obj.ID, Ctx: ctx, First: first, After: after})
+	}
+
+	ctx, err := ownerContext(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -608,6 +748,9 @@ func (r *Resolver) Packages(ctx context.Context, obj *graphql.Application, first
 	if err != nil {
 		return nil, err
 	}
+	for _, pkg := range gqlPkgs {
+		pkg.OwnerTenant = obj.OwnerTenant
+	}
 
 	return &graphql.PackagePage{
 		Data:       gqlPkgs,
@@ -625,6 +768,11 @@ func (r *Resolver) Package(ctx context.Context, obj *graphql.Application, id str
 		return nil, apperrors.NewInternalError("Application cannot be empty")
 	}
 
+	ctx, err := ownerContext(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -646,5 +794,138 @@ func (r *Resolver) Package(ctx context.Context, obj *graphql.Application, id str
 		return nil, err
 	}
 
-	return r.pkgConv.ToGraphQL(pkg)
+	gqlPkg, err := r.pkgConv.ToGraphQL(pkg)
+	if err != nil {
+		return nil, err
+	}
+	gqlPkg.OwnerTenant = obj.OwnerTenant
+
+	return gqlPkg, nil
+}
+
+// LabelsDataLoader fetches labels of multiple Applications in a single transaction
+func (r *Resolver) LabelsDataLoader(keys []dataloader.ParamID) ([]*graphql.Labels, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	ctx := keys[0].Ctx
+	applicationIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		applicationIDs = append(applicationIDs, key.ID)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	labelsByAppID, err := r.appSvc.ListLabelsForApplicationIDs(ctx, applicationIDs)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	gqlLabels := make([]*graphql.Labels, 0, len(keys))
+	for _, key := range keys {
+		resultLabels := make(map[string]interface{})
+		for _, label := range labelsByAppID[key.ID] {
+			resultLabels[label.Key] = label.Value
+		}
+
+		var labels graphql.Labels = resultLabels
+		gqlLabels = append(gqlLabels, &labels)
+	}
+
+	return gqlLabels, nil
+}
+
+// PackagesDataLoader fetches a page of Packages for multiple Applications in a single transaction
+func (r *Resolver) PackagesDataLoader(keys []dataloader.ParamPage) ([]*graphql.PackagePage, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Applications found")}
+	}
+
+	ctx := keys[0].Ctx
+	first := keys[0].First
+	if first == nil {
+		return nil, []error{apperrors.NewInvalidDataError("missing required parameter 'first'")}
+	}
+
+	var cursor string
+	if keys[0].After != nil {
+		cursor = string(*keys[0].After)
+	}
+
+	applicationIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		applicationIDs = append(applicationIDs, key.ID)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	pkgPages, err := r.pkgSvc.ListByApplicationIDs(ctx, applicationIDs, *first, cursor)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	gqlPkgPages := make([]*graphql.PackagePage, 0, len(pkgPages))
+	for _, page := range pkgPages {
+		gqlPkgs, err := r.pkgConv.MultipleToGraphQL(page.Data)
+		if err != nil {
+			return nil, []error{err}
+		}
+
+		gqlPkgPages = append(gqlPkgPages, &graphql.PackagePage{
+			Data:       gqlPkgs,
+			TotalCount: page.TotalCount,
+			PageInfo: &graphql.PageInfo{
+				StartCursor: graphql.PageCursor(page.PageInfo.StartCursor),
+				EndCursor:   graphql.PageCursor(page.PageInfo.EndCursor),
+				HasNextPage: page.PageInfo.HasNextPage,
+			},
+		})
+	}
+
+	return gqlPkgPages, nil
+}
+
+// ownerContext switches the tenant in the context to the owner of the Application, if the Application is shared with the tenant
+func ownerContext(ctx context.Context, app *graphql.Application) (context.Context, error) {
+	if app.OwnerTenant == "" {
+		return ctx, nil
+	}
+
+	return tenant.SaveOwnerToContext(ctx, app.OwnerTenant)
+}
+
+func (r *Resolver) reelectDefaultEventing(ctx context.Context, applicationID string) error {
+	appID, err := uuid.Parse(applicationID)
+	if err != nil {
+		return errors.Wrap(err, "while parsing application ID as UUID")
+	}
+
+	if err := r.eventingSvc.ReelectForApplications(ctx, []uuid.UUID{appID}); err != nil {
+		return errors.Wrap(err, "while choosing new default runtime for eventing for application")
+	}
+
+	return nil
 }
@@ -3,6 +3,7 @@ package application_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
@@ -10,12 +11,15 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
 
 	"github.com/google/uuid"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/eventing"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
@@ -133,7 +137,7 @@ func TestResolver_RegisterApplication(t *testing.T) {
 			persistTx, transact := testCase.TransactionerFn()
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
 			resolver.SetConverter(converter)
 
 			// when
@@ -278,7 +282,7 @@ func TestResolver_UpdateApplication(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
 			resolver.SetConverter(converter)
 
 			// when
@@ -570,7 +574,7 @@ func TestResolver_UnregisterApplication(t *testing.T) {
 			persistTx, transact := testCase.TransactionerFn()
 			sysAuthSvc := testCase.SysAuthServiceFn()
 			oAuth20Svc := testCase.OAuth20ServiceFn()
-			resolver := application.NewResolver(transact, svc, nil, oAuth20Svc, sysAuthSvc, nil, nil, nil, eventingSvc, nil, nil)
+			resolver := application.NewResolver(transact, svc, nil, oAuth20Svc, sysAuthSvc, nil, nil, nil, eventingSvc, nil, nil, 0)
 			resolver.SetConverter(converter)
 
 			// when
@@ -589,6 +593,191 @@ func TestResolver_UnregisterApplication(t *testing.T) {
 	}
 }
 
+func TestResolver_UnregisterApplication_WithDeletionGracePeriod(t *testing.T) {
+	// given
+	appID := uuid.New()
+	modelApplication := fixModelApplication(appID.String(), "tenant-foo", "Foo", "Bar")
+	gqlApplication := fixGQLApplication(appID.String(), "Foo", "Bar")
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name                string
+		TransactionerFn     func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn           func() *automock.ApplicationService
+		ConverterFn         func() *automock.ApplicationConverter
+		ExpectedApplication *graphql.Application
+		ExpectedErr         error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", contextParam, appID.String()).Return(modelApplication, nil).Once()
+				svc.On("MarkAsDeleted", contextParam, appID.String()).Return(nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("ToGraphQL", modelApplication).Return(gqlApplication).Once()
+				return conv
+			},
+			ExpectedApplication: gqlApplication,
+		},
+		{
+			Name:            "Returns error when marking application as deleted failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Get", contextParam, appID.String()).Return(modelApplication, nil).Once()
+				svc.On("MarkAsDeleted", contextParam, appID.String()).Return(testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+			persistTx, transact := testCase.TransactionerFn()
+			eventingSvc := &automock.EventingService{}
+			sysAuthSvc := &automock.SystemAuthService{}
+			oAuth20Svc := &automock.OAuth20Service{}
+			resolver := application.NewResolver(transact, svc, nil, oAuth20Svc, sysAuthSvc, nil, nil, nil, eventingSvc, nil, nil, time.Hour)
+			resolver.SetConverter(converter)
+
+			// when
+			result, err := resolver.UnregisterApplication(context.TODO(), appID.String())
+
+			// then
+			assert.Equal(t, testCase.ExpectedApplication, result)
+			if testCase.ExpectedErr != nil {
+				assert.EqualError(t, testCase.ExpectedErr, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, svc, converter, persistTx, transact, sysAuthSvc, oAuth20Svc, eventingSvc)
+		})
+	}
+}
+
+func TestResolver_RestoreApplication(t *testing.T) {
+	// given
+	appID := uuid.New()
+	modelApplication := fixModelApplication(appID.String(), "tenant-foo", "Foo", "Bar")
+	gqlApplication := fixGQLApplication(appID.String(), "Foo", "Bar")
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name                string
+		TransactionerFn     func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn           func() *automock.ApplicationService
+		ConverterFn         func() *automock.ApplicationConverter
+		ExpectedApplication *graphql.Application
+		ExpectedErr         error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Restore", contextParam, appID.String(), time.Hour).Return(nil).Once()
+				svc.On("Get", contextParam, appID.String()).Return(modelApplication, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("ToGraphQL", modelApplication).Return(gqlApplication).Once()
+				return conv
+			},
+			ExpectedApplication: gqlApplication,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when application restore failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Restore", contextParam, appID.String(), time.Hour).Return(testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when application retrieval failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Restore", contextParam, appID.String(), time.Hour).Return(nil).Once()
+				svc.On("Get", contextParam, appID.String()).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("Restore", contextParam, appID.String(), time.Hour).Return(nil).Once()
+				svc.On("Get", contextParam, appID.String()).Return(modelApplication, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+			persistTx, transact := testCase.TransactionerFn()
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, time.Hour)
+			resolver.SetConverter(converter)
+
+			// when
+			result, err := resolver.RestoreApplication(context.TODO(), appID.String())
+
+			// then
+			assert.Equal(t, testCase.ExpectedApplication, result)
+			if testCase.ExpectedErr != nil {
+				assert.EqualError(t, testCase.ExpectedErr, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, svc, converter, persistTx, transact)
+		})
+	}
+}
+
 func TestResolver_Application(t *testing.T) {
 	// given
 	modelApplication := fixModelApplication("foo", "tenant-foo", "Foo", "Bar")
@@ -669,7 +858,7 @@ func TestResolver_Application(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
 			resolver.SetConverter(converter)
 
 			// when
@@ -763,7 +952,7 @@ func TestResolver_Applications(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
 			resolver.SetConverter(converter)
 
 			// when
@@ -787,11 +976,23 @@ func TestResolver_ApplicationsForRuntime(t *testing.T) {
 		fixModelApplication("id2", "tenant-bar", "name", "desc"),
 	}
 
-	applicationGraphQL := []*graphql.Application{
+	applicationGraphQL := func() []*graphql.Application {
+		return []*graphql.Application{
+			fixGQLApplication("id1", "name", "desc"),
+			fixGQLApplication("id2", "name", "desc"),
+		}
+	}
+
+	sharedApplication := fixGQLApplication("id2", "name", "desc")
+	sharedApplication.OwnerTenant = "tenant-bar"
+	expectedApplications := []*graphql.Application{
 		fixGQLApplication("id1", "name", "desc"),
-		fixGQLApplication("id2", "name", "desc"),
+		sharedApplication,
 	}
 
+	ctx := tenant.SaveToContext(context.TODO(), "tenant-foo", "external-tenant-foo")
+	userConsumer := consumer.Consumer{ConsumerID: "user", ConsumerType: consumer.User}
+
 	first := 10
 	after := "test"
 	gqlAfter := graphql.PageCursor(after)
@@ -800,11 +1001,13 @@ func TestResolver_ApplicationsForRuntime(t *testing.T) {
 
 	runtimeUUID := uuid.New()
 	runtimeID := runtimeUUID.String()
+	runtimeContextUUID := uuid.New()
 	testCases := []struct {
 		Name            string
 		AppConverterFn  func() *automock.ApplicationConverter
 		AppServiceFn    func() *automock.ApplicationService
 		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		Consumer        *consumer.Consumer
 		InputRuntimeID  string
 		ExpectedResult  *graphql.ApplicationPage
 		ExpectedError   error
@@ -818,12 +1021,30 @@ func TestResolver_ApplicationsForRuntime(t *testing.T) {
 			},
 			AppConverterFn: func() *automock.ApplicationConverter {
 				appConverter := &automock.ApplicationConverter{}
-				appConverter.On("MultipleToGraphQL", modelApplications).Return(applicationGraphQL).Once()
+				appConverter.On("MultipleToGraphQL", modelApplications).Return(applicationGraphQL()).Once()
+				return appConverter
+			},
+			TransactionerFn: txGen.ThatSucceeds,
+			InputRuntimeID:  runtimeID,
+			ExpectedResult:  fixGQLApplicationPage(expectedApplications),
+			ExpectedError:   nil,
+		},
+		{
+			Name: "Success for Runtime Context consumer listing Applications of its scenarios instead of the requested Runtime",
+			AppServiceFn: func() *automock.ApplicationService {
+				appService := &automock.ApplicationService{}
+				appService.On("ListByRuntimeContextID", contextParam, runtimeContextUUID, first, after).Return(fixApplicationPage(modelApplications[:1]), nil).Once()
+				return appService
+			},
+			AppConverterFn: func() *automock.ApplicationConverter {
+				appConverter := &automock.ApplicationConverter{}
+				appConverter.On("MultipleToGraphQL", modelApplications[:1]).Return(applicationGraphQL()[:1]).Once()
 				return appConverter
 			},
 			TransactionerFn: txGen.ThatSucceeds,
+			Consumer:        &consumer.Consumer{ConsumerID: runtimeContextUUID.String(), ConsumerType: consumer.RuntimeContext},
 			InputRuntimeID:  runtimeID,
-			ExpectedResult:  fixGQLApplicationPage(applicationGraphQL),
+			ExpectedResult:  fixGQLApplicationPage(expectedApplications[:1]),
 			ExpectedError:   nil,
 		},
 		{
@@ -883,7 +1104,7 @@ func TestResolver_ApplicationsForRuntime(t *testing.T) {
 				appConverter := &automock.ApplicationConverter{}
 				return appConverter
 			},
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
 			InputRuntimeID:  "blabla",
 			ExpectedResult:  nil,
 			ExpectedError:   errors.New("invalid UUID length"),
@@ -897,10 +1118,15 @@ func TestResolver_ApplicationsForRuntime(t *testing.T) {
 			applicationConverter := testCase.AppConverterFn()
 			persistTx, transact := testCase.TransactionerFn()
 
-			resolver := application.NewResolver(transact, applicationSvc, nil, nil, nil, applicationConverter, nil, nil, nil, nil, nil)
+			resolver := application.NewResolver(transact, applicationSvc, nil, nil, nil, applicationConverter, nil, nil, nil, nil, nil, 0)
+
+			apiConsumer := userConsumer
+			if testCase.Consumer != nil {
+				apiConsumer = *testCase.Consumer
+			}
 
 			//WHEN
-			result, err := resolver.ApplicationsForRuntime(context.TODO(), testCase.InputRuntimeID, &first, &gqlAfter)
+			result, err := resolver.ApplicationsForRuntime(consumer.SaveToContext(ctx, apiConsumer), testCase.InputRuntimeID, &first, &gqlAfter)
 
 			//THEN
 			if testCase.ExpectedError != nil {
@@ -916,6 +1142,112 @@ func TestResolver_ApplicationsForRuntime(t *testing.T) {
 			transact.AssertExpectations(t)
 		})
 	}
+
+	t.Run("Returns error when consumer is missing", func(t *testing.T) {
+		//GIVEN
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
+
+		//WHEN
+		_, err := resolver.ApplicationsForRuntime(ctx, runtimeID, &first, &gqlAfter)
+
+		//THEN
+		require.Error(t, err)
+	})
+}
+
+func TestResolver_ApplicationsForRuntimeContext(t *testing.T) {
+	testError := errors.New("test error")
+
+	modelApplications := []*model.Application{
+		fixModelApplication("id1", "tenant-foo", "name", "desc"),
+	}
+
+	ctx := tenant.SaveToContext(context.TODO(), "tenant-foo", "external-tenant-foo")
+
+	first := 10
+	after := "test"
+	gqlAfter := graphql.PageCursor(after)
+
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	runtimeContextUUID := uuid.New()
+	runtimeContextID := runtimeContextUUID.String()
+	testCases := []struct {
+		Name                  string
+		AppConverterFn        func() *automock.ApplicationConverter
+		AppServiceFn          func() *automock.ApplicationService
+		TransactionerFn       func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		InputRuntimeContextID string
+		ExpectedResult        *graphql.ApplicationPage
+		ExpectedError         error
+	}{
+		{
+			Name: "Success",
+			AppServiceFn: func() *automock.ApplicationService {
+				appService := &automock.ApplicationService{}
+				appService.On("ListByRuntimeContextID", contextParam, runtimeContextUUID, first, after).Return(fixApplicationPage(modelApplications), nil).Once()
+				return appService
+			},
+			AppConverterFn: func() *automock.ApplicationConverter {
+				appConverter := &automock.ApplicationConverter{}
+				appConverter.On("MultipleToGraphQL", modelApplications).Return([]*graphql.Application{fixGQLApplication("id1", "name", "desc")}).Once()
+				return appConverter
+			},
+			TransactionerFn:       txGen.ThatSucceeds,
+			InputRuntimeContextID: runtimeContextID,
+			ExpectedResult:        fixGQLApplicationPage([]*graphql.Application{fixGQLApplication("id1", "name", "desc")}),
+		},
+		{
+			Name: "Returns error when application listing failed",
+			AppServiceFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("ListByRuntimeContextID", contextParam, runtimeContextUUID, first, after).Return(nil, testError).Once()
+				return appSvc
+			},
+			AppConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			TransactionerFn:       txGen.ThatDoesntExpectCommit,
+			InputRuntimeContextID: runtimeContextID,
+			ExpectedError:         testError,
+		},
+		{
+			Name: "Returns error when runtimeContextID is not UUID",
+			AppServiceFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			AppConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			TransactionerFn:       txGen.ThatDoesntStartTransaction,
+			InputRuntimeContextID: "blabla",
+			ExpectedError:         errors.New("invalid UUID length"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			applicationSvc := testCase.AppServiceFn()
+			applicationConverter := testCase.AppConverterFn()
+			persistTx, transact := testCase.TransactionerFn()
+
+			resolver := application.NewResolver(transact, applicationSvc, nil, nil, nil, applicationConverter, nil, nil, nil, nil, nil, 0)
+
+			//WHEN
+			result, err := resolver.ApplicationsForRuntimeContext(ctx, testCase.InputRuntimeContextID, &first, &gqlAfter)
+
+			//THEN
+			if testCase.ExpectedError != nil {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+			mock.AssertExpectationsForObjects(t, applicationSvc, applicationConverter, persistTx, transact)
+		})
+	}
 }
 
 func TestResolver_SetApplicationLabel(t *testing.T) {
@@ -993,7 +1325,7 @@ func TestResolver_SetApplicationLabel(t *testing.T) {
 			persistTx := testCase.PersistenceFn()
 			transactioner := testCase.TransactionerFn(persistTx)
 
-			resolver := application.NewResolver(transactioner, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			resolver := application.NewResolver(transactioner, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
 			resolver.SetConverter(converter)
 
 			// when
@@ -1010,7 +1342,7 @@ func TestResolver_SetApplicationLabel(t *testing.T) {
 	}
 
 	t.Run("Returns error when Label input validation failed", func(t *testing.T) {
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
 
 		// when
 		result, err := resolver.SetApplicationLabel(context.TODO(), "", "", "")
@@ -1022,10 +1354,71 @@ func TestResolver_SetApplicationLabel(t *testing.T) {
 		assert.Contains(t, err.Error(), "value=cannot be blank")
 		assert.Contains(t, err.Error(), "validation error for type LabelInput:")
 	})
-}
 
-func TestResolver_DeleteApplicationLabel(t *testing.T) {
-	// given
+	t.Run("Returns error when setting eventing policy label", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
+
+		// when
+		result, err := resolver.SetApplicationLabel(context.TODO(), applicationID, eventing.ApplicationEventingPolicyLabel, "PINNED")
+
+		// then
+		require.Nil(t, result)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "can be set only with setEventingPolicyForApplication mutation")
+	})
+
+	t.Run("Chooses new default runtime for eventing when scenarios label is set", func(t *testing.T) {
+		appID := "9f6e2e1c-1b1a-4a36-9e69-f3c0b9a3c5d2"
+		scenarios := []interface{}{"DEFAULT"}
+
+		persistTx := txtest.PersistenceContextThatExpectsCommit()
+		transactioner := txtest.TransactionerThatSucceeds(persistTx)
+		svc := &automock.ApplicationService{}
+		svc.On("SetLabel", contextParam, &model.LabelInput{
+			Key:        model.ScenariosKey,
+			Value:      scenarios,
+			ObjectID:   appID,
+			ObjectType: model.ApplicationLabelableObject,
+		}).Return(nil).Once()
+		eventingSvc := &automock.EventingService{}
+		eventingSvc.On("ReelectForApplications", contextParam, []uuid.UUID{uuid.MustParse(appID)}).Return(nil).Once()
+
+		resolver := application.NewResolver(transactioner, svc, nil, nil, nil, nil, nil, nil, eventingSvc, nil, nil, 0)
+
+		// when
+		result, err := resolver.SetApplicationLabel(context.TODO(), appID, model.ScenariosKey, scenarios)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.Label{Key: model.ScenariosKey, Value: scenarios}, result)
+		mock.AssertExpectationsForObjects(t, svc, eventingSvc, transactioner, persistTx)
+	})
+
+	t.Run("Returns error when choosing new default runtime for eventing failed", func(t *testing.T) {
+		appID := "9f6e2e1c-1b1a-4a36-9e69-f3c0b9a3c5d2"
+		scenarios := []interface{}{"DEFAULT"}
+
+		persistTx := txtest.PersistenceContextThatDoesntExpectCommit()
+		transactioner := txtest.TransactionerThatSucceeds(persistTx)
+		svc := &automock.ApplicationService{}
+		svc.On("SetLabel", contextParam, mock.Anything).Return(nil).Once()
+		eventingSvc := &automock.EventingService{}
+		eventingSvc.On("ReelectForApplications", contextParam, []uuid.UUID{uuid.MustParse(appID)}).Return(testErr).Once()
+
+		resolver := application.NewResolver(transactioner, svc, nil, nil, nil, nil, nil, nil, eventingSvc, nil, nil, 0)
+
+		// when
+		result, err := resolver.SetApplicationLabel(context.TODO(), appID, model.ScenariosKey, scenarios)
+
+		// then
+		require.Nil(t, result)
+		require.EqualError(t, err, "while choosing new default runtime for eventing for application: Test error")
+		mock.AssertExpectationsForObjects(t, svc, eventingSvc, transactioner, persistTx)
+	})
+}
+
+func TestResolver_DeleteApplicationLabel(t *testing.T) {
+	// given
 	testErr := errors.New("Test error")
 
 	applicationID := "foo"
@@ -1122,7 +1515,7 @@ func TestResolver_DeleteApplicationLabel(t *testing.T) {
 			persistTx := testCase.PersistenceFn()
 			transactioner := testCase.TransactionerFn(persistTx)
 
-			resolver := application.NewResolver(transactioner, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			resolver := application.NewResolver(transactioner, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
 			resolver.SetConverter(converter)
 
 			// when
@@ -1138,6 +1531,36 @@ func TestResolver_DeleteApplicationLabel(t *testing.T) {
 			persistTx.AssertExpectations(t)
 		})
 	}
+
+	t.Run("Chooses new default runtime for eventing when scenarios label is deleted", func(t *testing.T) {
+		appID := "9f6e2e1c-1b1a-4a36-9e69-f3c0b9a3c5d2"
+		scenariosLabel := &model.Label{
+			ID:         "b39ba24d-87fe-43fe-ac55-7f2e5ee04bcb",
+			Tenant:     "tnt",
+			Key:        model.ScenariosKey,
+			Value:      []interface{}{"DEFAULT"},
+			ObjectID:   appID,
+			ObjectType: model.ApplicationLabelableObject,
+		}
+
+		persistTx := txtest.PersistenceContextThatExpectsCommit()
+		transactioner := txtest.TransactionerThatSucceeds(persistTx)
+		svc := &automock.ApplicationService{}
+		svc.On("GetLabel", contextParam, appID, model.ScenariosKey).Return(scenariosLabel, nil).Once()
+		svc.On("DeleteLabel", contextParam, appID, model.ScenariosKey).Return(nil).Once()
+		eventingSvc := &automock.EventingService{}
+		eventingSvc.On("ReelectForApplications", contextParam, []uuid.UUID{uuid.MustParse(appID)}).Return(nil).Once()
+
+		resolver := application.NewResolver(transactioner, svc, nil, nil, nil, nil, nil, nil, eventingSvc, nil, nil, 0)
+
+		// when
+		result, err := resolver.DeleteApplicationLabel(context.TODO(), appID, model.ScenariosKey)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.Label{Key: model.ScenariosKey, Value: scenariosLabel.Value}, result)
+		mock.AssertExpectationsForObjects(t, svc, eventingSvc, transactioner, persistTx)
+	})
 }
 
 func TestResolver_Webhooks(t *testing.T) {
@@ -1239,7 +1662,7 @@ func TestResolver_Webhooks(t *testing.T) {
 			mockPersistence := testCase.PersistenceFn()
 			mockTransactioner := testCase.TransactionerFn(mockPersistence)
 
-			resolver := application.NewResolver(mockTransactioner, nil, svc, nil, nil, nil, converter, nil, nil, nil, nil)
+			resolver := application.NewResolver(mockTransactioner, nil, svc, nil, nil, nil, converter, nil, nil, nil, nil, 0)
 
 			// when
 			result, err := resolver.Webhooks(context.TODO(), app)
@@ -1336,7 +1759,7 @@ func TestResolver_Labels(t *testing.T) {
 			persistTx := testCase.PersistenceFn()
 			transact := testCase.TransactionerFn(persistTx)
 
-			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
 
 			// when
 			result, err := resolver.Labels(context.TODO(), gqlApp, &testCase.InputKey)
@@ -1444,7 +1867,7 @@ func TestResolver_Auths(t *testing.T) {
 			persist, transact := testCase.TransactionerFn()
 			conv := testCase.SysAuthConvFn()
 
-			resolver := application.NewResolver(transact, nil, nil, nil, svc, nil, nil, conv, nil, nil, nil)
+			resolver := application.NewResolver(transact, nil, nil, nil, svc, nil, nil, conv, nil, nil, nil, 0)
 
 			// when
 			result, err := resolver.Auths(context.TODO(), testCase.InputApp)
@@ -1461,7 +1884,7 @@ func TestResolver_Auths(t *testing.T) {
 	}
 
 	t.Run("Returns error when application is nil", func(t *testing.T) {
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
 		//WHEN
 		_, err := resolver.Auths(context.TODO(), nil)
 		//THEN
@@ -1555,7 +1978,7 @@ func TestResolver_EventingConfiguration(t *testing.T) {
 			eventingSvc := testCase.EventingSvcFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, nil, nil, nil, nil, converter, nil, nil, eventingSvc, nil, nil)
+			resolver := application.NewResolver(transact, nil, nil, nil, nil, converter, nil, nil, eventingSvc, nil, nil, 0)
 
 			// WHEN
 			result, err := resolver.EventingConfiguration(ctx, gqlApp)
@@ -1575,7 +1998,7 @@ func TestResolver_EventingConfiguration(t *testing.T) {
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
 		//GIVEN
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
 
 		//WHEN
 		_, err := resolver.EventingConfiguration(context.TODO(), &graphql.Application{})
@@ -1587,7 +2010,7 @@ func TestResolver_EventingConfiguration(t *testing.T) {
 
 	t.Run("Error when parent object is nil", func(t *testing.T) {
 		// GIVEN
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
 
 		// WHEN
 		result, err := resolver.EventingConfiguration(context.TODO(), nil)
@@ -1599,6 +2022,134 @@ func TestResolver_EventingConfiguration(t *testing.T) {
 	})
 }
 
+func TestResolver_TemplateReference(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+
+	applicationID := "foo"
+	templateID := "tttttttt-tttt-tttt-tttt-tttttttttttt"
+	gqlApp := fixGQLApplication(applicationID, "bar", "baz")
+	modelRef := fixModelTemplateReference(&templateID, 2)
+	gqlRef := fixGQLTemplateReference(&templateID, 2)
+
+	testErr := errors.New("this is a test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.ApplicationService
+		ConverterFn     func() *automock.ApplicationConverter
+		ExpectedOutput  *graphql.ApplicationTemplateReference
+		ExpectedError   error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), applicationID).Return(modelRef, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("TemplateReferenceToGraphQL", modelRef).Return(gqlRef).Once()
+				return conv
+			},
+			ExpectedOutput: gqlRef,
+		},
+		{
+			Name:            "Success when Application was not registered from Application Template",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), applicationID).Return(nil, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				conv := &automock.ApplicationConverter{}
+				conv.On("TemplateReferenceToGraphQL", (*model.ApplicationTemplateReference)(nil)).Return(nil).Once()
+				return conv
+			},
+			ExpectedOutput: nil,
+		},
+		{
+			Name:            "Error when getting Application Template reference failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), applicationID).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error when beginning transaction",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Error when committing transaction",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), applicationID).Return(modelRef, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, nil, nil, 0)
+
+			// WHEN
+			result, err := resolver.TemplateReference(ctx, gqlApp)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, svc, transact, persist, converter)
+		})
+	}
+
+	t.Run("Error when parent object is nil", func(t *testing.T) {
+		// GIVEN
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
+
+		// WHEN
+		result, err := resolver.TemplateReference(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Application cannot be empty")
+		assert.Nil(t, result)
+	})
+}
+
 func TestResolver_Packages(t *testing.T) {
 	// given
 	testErr := errors.New("test error")
@@ -1716,7 +2267,7 @@ func TestResolver_Packages(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter)
+			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter, 0)
 			// when
 			result, err := resolver.Packages(context.TODO(), app, &first, &gqlAfter)
 
@@ -1732,7 +2283,7 @@ func TestResolver_Packages(t *testing.T) {
 	}
 
 	t.Run("Returns error when application is nil", func(t *testing.T) {
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
 		//when
 		_, err := resolver.Packages(context.TODO(), nil, nil, nil)
 		//then
@@ -1741,6 +2292,234 @@ func TestResolver_Packages(t *testing.T) {
 	})
 }
 
+func TestResolver_PackagesDataLoader(t *testing.T) {
+	// given
+	testErr := errors.New("test error")
+
+	tenantID := "1"
+	firstAppID := "1"
+	secondAppID := "2"
+	firstModelPackages := []*model.Package{fixModelPackage("foo", tenantID, firstAppID, "Foo", "Lorem Ipsum")}
+	secondModelPackages := []*model.Package{fixModelPackage("bar", tenantID, secondAppID, "Bar", "Lorem Ipsum")}
+	firstGQLPackages := []*graphql.Package{fixGQLPackage("foo", firstAppID, "Foo", "Lorem Ipsum")}
+	secondGQLPackages := []*graphql.Package{fixGQLPackage("bar", secondAppID, "Bar", "Lorem Ipsum")}
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	first := 2
+	gqlAfter := graphql.PageCursor("test")
+	after := "test"
+	keys := []dataloader.ParamPage{
+		{ID: firstAppID, Ctx: context.TODO(), First: &first, After: &gqlAfter},
+		{ID: secondAppID, Ctx: context.TODO(), First: &first, After: &gqlAfter},
+	}
+	applicationIDs := []string{firstAppID, secondAppID}
+	modelPages := []*model.PackagePage{fixPackagePage(firstModelPackages), fixPackagePage(secondModelPackages)}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.PackageService
+		ConverterFn     func() *automock.PackageConverter
+		ExpectedResult  []*graphql.PackagePage
+		ExpectedErr     []error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), applicationIDs, first, after).Return(modelPages, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				conv := &automock.PackageConverter{}
+				conv.On("MultipleToGraphQL", firstModelPackages).Return(firstGQLPackages, nil).Once()
+				conv.On("MultipleToGraphQL", secondModelPackages).Return(secondGQLPackages, nil).Once()
+				return conv
+			},
+			ExpectedResult: []*graphql.PackagePage{fixGQLPackagePage(firstGQLPackages), fixGQLPackagePage(secondGQLPackages)},
+			ExpectedErr:    nil,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.PackageService {
+				return &automock.PackageService{}
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				return &automock.PackageConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    []error{testErr},
+		},
+		{
+			Name:            "Returns error when Packages listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), applicationIDs, first, after).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				return &automock.PackageConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    []error{testErr},
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), applicationIDs, first, after).Return(modelPages, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				return &automock.PackageConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    []error{testErr},
+		},
+		{
+			Name:            "Returns error when converting to GraphQL failed",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListByApplicationIDs", txtest.CtxWithDBMatcher(), applicationIDs, first, after).Return(modelPages, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageConverter {
+				conv := &automock.PackageConverter{}
+				conv.On("MultipleToGraphQL", firstModelPackages).Return(nil, testErr).Once()
+				return conv
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    []error{testErr},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter, 0)
+			// when
+			result, err := resolver.PackagesDataLoader(keys)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			assert.Equal(t, testCase.ExpectedErr, err)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when there are no keys", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
+		//when
+		_, err := resolver.PackagesDataLoader([]dataloader.ParamPage{})
+		//then
+		require.Len(t, err, 1)
+		assert.EqualError(t, err[0], apperrors.NewInternalError("No Applications found").Error())
+	})
+
+	t.Run("Returns error when first is missing", func(t *testing.T) {
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
+		//when
+		_, err := resolver.PackagesDataLoader([]dataloader.ParamPage{{ID: firstAppID, Ctx: context.TODO()}})
+		//then
+		require.Len(t, err, 1)
+		assert.EqualError(t, err[0], apperrors.NewInvalidDataError("missing required parameter 'first'").Error())
+	})
+}
+
+func TestResolver_LabelsDataLoader(t *testing.T) {
+	// given
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	firstAppID := "foo"
+	secondAppID := "bar"
+	keys := []dataloader.ParamID{{ID: firstAppID, Ctx: context.TODO()}, {ID: secondAppID, Ctx: context.TODO()}}
+	applicationIDs := []string{firstAppID, secondAppID}
+
+	modelLabels := map[string]map[string]*model.Label{
+		firstAppID: {
+			"key": {ID: "abc", Key: "key", Value: "val", ObjectID: firstAppID, ObjectType: model.ApplicationLabelableObject},
+		},
+		secondAppID: {},
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.ApplicationService
+		ExpectedResult  []*graphql.Labels
+		ExpectedErr     []error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("ListLabelsForApplicationIDs", txtest.CtxWithDBMatcher(), applicationIDs).Return(modelLabels, nil).Once()
+				return svc
+			},
+			ExpectedResult: []*graphql.Labels{{"key": "val"}, {}},
+			ExpectedErr:    nil,
+		},
+		{
+			Name:            "Returns error when label listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("ListLabelsForApplicationIDs", txtest.CtxWithDBMatcher(), applicationIDs).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    []error{testErr},
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.ApplicationService {
+				svc := &automock.ApplicationService{}
+				svc.On("ListLabelsForApplicationIDs", txtest.CtxWithDBMatcher(), applicationIDs).Return(modelLabels, nil).Once()
+				return svc
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    []error{testErr},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+
+			resolver := application.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
+
+			// when
+			result, err := resolver.LabelsDataLoader(keys)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			assert.Equal(t, testCase.ExpectedErr, err)
+
+			svc.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			persist.AssertExpectations(t)
+		})
+	}
+}
+
 func TestResolver_Package(t *testing.T) {
 	// given
 	id := "foo"
@@ -1859,7 +2638,7 @@ func TestResolver_Package(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter)
+			resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter, 0)
 
 			// when
 			result, err := resolver.Package(context.TODO(), testCase.Application, testCase.InputID)
@@ -1875,8 +2654,36 @@ func TestResolver_Package(t *testing.T) {
 		})
 	}
 
+	t.Run("Gets package of shared application in owner tenant", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		sharedApp := fixGQLApplication("foo", "foo", "foo")
+		sharedApp.OwnerTenant = tenantID
+		ctx := tenant.SaveToContext(context.TODO(), "target-tenant", "external-target-tenant")
+		ownerCtxMatcher := mock.MatchedBy(func(ctx context.Context) bool {
+			tnt, err := tenant.LoadFromContext(ctx)
+			return err == nil && tnt == tenantID
+		})
+
+		svc := &automock.PackageService{}
+		svc.On("GetForApplication", ownerCtxMatcher, "foo", "foo").Return(modelPackage, nil).Once()
+		converter := &automock.PackageConverter{}
+		converter.On("ToGraphQL", modelPackage).Return(fixGQLPackage(id, appId, "name", "bar"), nil).Once()
+
+		resolver := application.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, svc, converter, 0)
+		expected := fixGQLPackage(id, appId, "name", "bar")
+		expected.OwnerTenant = tenantID
+
+		// when
+		result, err := resolver.Package(ctx, sharedApp, "foo")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+		mock.AssertExpectationsForObjects(t, svc, persist, transact, converter)
+	})
+
 	t.Run("Returns error when application is nil", func(t *testing.T) {
-		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := application.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, 0)
 		//when
 		_, err := resolver.Package(context.TODO(), nil, "")
 		//then
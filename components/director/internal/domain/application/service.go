@@ -3,6 +3,8 @@ package application
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
@@ -26,6 +28,8 @@ import (
 const (
 	intSysKey = "integrationSystemID"
 	nameKey   = "name"
+
+	runtimesPageSize = 100
 )
 
 //go:generate mockery -name=ApplicationRepository -output=automock -outpkg=automock -case=underscore
@@ -38,24 +42,34 @@ type ApplicationRepository interface {
 	Create(ctx context.Context, item *model.Application) error
 	Update(ctx context.Context, item *model.Application) error
 	Delete(ctx context.Context, tenant, id string) error
+	GetDeletedByID(ctx context.Context, tenant, id string) (*model.Application, error)
+	MarkAsDeleted(ctx context.Context, tenant, id string, deletedAt time.Time) error
+	Restore(ctx context.Context, tenant, id string) error
+	ListDeletedBefore(ctx context.Context, before time.Time) ([]*model.Application, error)
+	GetTemplateReference(ctx context.Context, tenant, id string) (*model.ApplicationTemplateReference, error)
+	SetTemplateReference(ctx context.Context, tenant, id string, ref model.ApplicationTemplateReference) error
 }
 
 //go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
 type LabelRepository interface {
 	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
 	ListForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) (map[string]*model.Label, error)
+	ListForObjectIDs(ctx context.Context, tenant string, objectType model.LabelableObject, objectIDs []string) ([]*model.Label, error)
 	Delete(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, key string) error
 	DeleteAll(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) error
 }
 
 //go:generate mockery -name=WebhookRepository -output=automock -outpkg=automock -case=underscore
 type WebhookRepository interface {
+	ListByApplicationID(ctx context.Context, tenant, applicationID string) ([]*model.Webhook, error)
 	CreateMany(ctx context.Context, items []*model.Webhook) error
+	Update(ctx context.Context, item *model.Webhook) error
 }
 
 //go:generate mockery -name=RuntimeRepository -output=automock -outpkg=automock -case=underscore
 type RuntimeRepository interface {
 	Exists(ctx context.Context, tenant, id string) (bool, error)
+	List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
 }
 
 //go:generate mockery -name=IntegrationSystemRepository -output=automock -outpkg=automock -case=underscore
@@ -75,6 +89,30 @@ type ScenariosService interface {
 	AddDefaultScenarioIfEnabled(ctx context.Context, labels *map[string]interface{})
 }
 
+//go:generate mockery -name=APIService -output=automock -outpkg=automock -case=underscore
+type APIService interface {
+	ListForPackage(ctx context.Context, packageID string, pageSize int, cursor string) (*model.APIDefinitionPage, error)
+	CreateInPackage(ctx context.Context, packageID string, in model.APIDefinitionInput) (string, error)
+	Update(ctx context.Context, id string, in model.APIDefinitionInput) error
+	GetFetchRequest(ctx context.Context, apiDefID string) (*model.FetchRequest, error)
+}
+
+//go:generate mockery -name=EventService -output=automock -outpkg=automock -case=underscore
+type EventService interface {
+	ListForPackage(ctx context.Context, packageID string, pageSize int, cursor string) (*model.EventDefinitionPage, error)
+	CreateInPackage(ctx context.Context, packageID string, in model.EventDefinitionInput) (string, error)
+	Update(ctx context.Context, id string, in model.EventDefinitionInput) error
+	GetFetchRequest(ctx context.Context, eventAPIDefID string) (*model.FetchRequest, error)
+}
+
+//go:generate mockery -name=DocumentService -output=automock -outpkg=automock -case=underscore
+type DocumentService interface {
+	ListForPackage(ctx context.Context, packageID string, pageSize int, cursor string) (*model.DocumentPage, error)
+	CreateInPackage(ctx context.Context, packageID string, in model.DocumentInput) (string, error)
+	Delete(ctx context.Context, id string) error
+	GetFetchRequest(ctx context.Context, documentID string) (*model.FetchRequest, error)
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
@@ -99,10 +137,13 @@ type service struct {
 	scenariosService   ScenariosService
 	uidService         UIDService
 	pkgService         PackageService
+	apiService         APIService
+	eventService       EventService
+	documentService    DocumentService
 	timestampGen       timestamp.Generator
 }
 
-func NewService(appNameNormalizer normalizer.Normalizator, appHideCfgProvider ApplicationHideCfgProvider, app ApplicationRepository, webhook WebhookRepository, runtimeRepo RuntimeRepository, labelRepo LabelRepository, intSystemRepo IntegrationSystemRepository, labelUpsertService LabelUpsertService, scenariosService ScenariosService, pkgService PackageService, uidService UIDService) *service {
+func NewService(appNameNormalizer normalizer.Normalizator, appHideCfgProvider ApplicationHideCfgProvider, app ApplicationRepository, webhook WebhookRepository, runtimeRepo RuntimeRepository, labelRepo LabelRepository, intSystemRepo IntegrationSystemRepository, labelUpsertService LabelUpsertService, scenariosService ScenariosService, pkgService PackageService, apiService APIService, eventService EventService, documentService DocumentService, uidService UIDService) *service {
 	return &service{
 		appNameNormalizer:  appNameNormalizer,
 		appHideCfgProvider: appHideCfgProvider,
@@ -114,6 +155,9 @@ func NewService(appNameNormalizer normalizer.Normalizator, appHideCfgProvider Ap
 		labelUpsertService: labelUpsertService,
 		scenariosService:   scenariosService,
 		pkgService:         pkgService,
+		apiService:         apiService,
+		eventService:       eventService,
+		documentService:    documentService,
 		uidService:         uidService,
 		timestampGen:       timestamp.DefaultGenerator(),
 	}
@@ -153,7 +197,26 @@ func (s *service) ListByRuntimeID(ctx context.Context, runtimeID uuid.UUID, page
 		return nil, apperrors.NewInvalidDataError("runtime does not exist")
 	}
 
-	scenariosLabel, err := s.labelRepo.GetByKey(ctx, tenantID, model.RuntimeLabelableObject, runtimeID.String(), model.ScenariosKey)
+	return s.listByScenariosOf(ctx, tenantUUID, model.RuntimeLabelableObject, runtimeID.String(), pageSize, cursor)
+}
+
+// ListByRuntimeContextID returns the Applications which share a scenario with the Runtime Context
+func (s *service) ListByRuntimeContextID(ctx context.Context, runtimeContextID uuid.UUID, pageSize int, cursor string) (*model.ApplicationPage, error) {
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return nil, apperrors.NewInvalidDataError("tenantID is not UUID")
+	}
+
+	return s.listByScenariosOf(ctx, tenantUUID, model.RuntimeContextLabelableObject, runtimeContextID.String(), pageSize, cursor)
+}
+
+func (s *service) listByScenariosOf(ctx context.Context, tenantID uuid.UUID, objectType model.LabelableObject, objectID string, pageSize int, cursor string) (*model.ApplicationPage, error) {
+	scenariosLabel, err := s.labelRepo.GetByKey(ctx, tenantID.String(), objectType, objectID, model.ScenariosKey)
 	if err != nil {
 		if apperrors.IsNotFoundError(err) {
 			return &model.ApplicationPage{
@@ -162,7 +225,7 @@ func (s *service) ListByRuntimeID(ctx context.Context, runtimeID uuid.UUID, page
 				TotalCount: 0,
 			}, nil
 		}
-		return nil, errors.Wrap(err, "while getting scenarios for runtime")
+		return nil, errors.Wrapf(err, "while getting scenarios for %s", objectType)
 	}
 
 	scenarios, err := label.ValueToStringsSlice(scenariosLabel.Value)
@@ -186,7 +249,7 @@ func (s *service) ListByRuntimeID(ctx context.Context, runtimeID uuid.UUID, page
 		return nil, errors.Wrap(err, "while getting application hide selectors from config")
 	}
 
-	return s.appRepo.ListByScenarios(ctx, tenantUUID, scenarios, pageSize, cursor, hidingSelectors)
+	return s.appRepo.ListByScenarios(ctx, tenantID, scenarios, pageSize, cursor, hidingSelectors)
 }
 
 func (s *service) Get(ctx context.Context, id string) (*model.Application, error) {
@@ -224,18 +287,10 @@ func (s *service) Create(ctx context.Context, in model.ApplicationRegisterInput)
 	}
 	log.C(ctx).Debugf("Loaded Application Tenant %s from context", appTenant)
 
-	applications, err := s.appRepo.ListAll(ctx, appTenant)
-	if err != nil {
+	if err := s.ensureNameUnique(ctx, appTenant, in.Name); err != nil {
 		return "", err
 	}
 
-	normalizedName := s.appNameNormalizer.Normalize(in.Name)
-	for _, app := range applications {
-		if normalizedName == s.appNameNormalizer.Normalize(app.Name) {
-			return "", apperrors.NewNotUniqueNameError(resource.Application)
-		}
-	}
-
 	exists, err := s.ensureIntSysExists(ctx, in.IntegrationSystemID)
 	if err != nil {
 		return "", errors.Wrap(err, "while ensuring integration system exists")
@@ -346,6 +401,111 @@ func (s *service) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// MarkAsDeleted hides the Application until it is restored or permanently deleted after the deletion grace period
+func (s *service) MarkAsDeleted(ctx context.Context, id string) error {
+	appTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	exists, err := s.appRepo.Exists(ctx, appTenant, id)
+	if err != nil {
+		return errors.Wrap(err, "while checking Application existence")
+	}
+	if !exists {
+		return apperrors.NewNotFoundError(resource.Application, id)
+	}
+
+	if err := s.appRepo.MarkAsDeleted(ctx, appTenant, id, s.timestampGen()); err != nil {
+		return errors.Wrapf(err, "while marking Application with id %s as deleted", id)
+	}
+
+	return nil
+}
+
+// Restore brings back the Application marked as deleted, unless its deletion grace period has expired
+func (s *service) Restore(ctx context.Context, id string, gracePeriod time.Duration) error {
+	appTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	app, err := s.appRepo.GetDeletedByID(ctx, appTenant, id)
+	if err != nil {
+		return errors.Wrap(err, "while getting deleted Application")
+	}
+
+	// the Application could not have been permanently deleted by the reaper yet
+	if app.DeletedAt != nil && !s.timestampGen().Before(app.DeletedAt.Add(gracePeriod)) {
+		return apperrors.NewInvalidOperationError(fmt.Sprintf("deletion grace period of Application with id %s has expired", id))
+	}
+
+	// another Application could have been registered with the same name after this one was marked as deleted
+	if err := s.ensureNameUnique(ctx, appTenant, app.Name); err != nil {
+		return err
+	}
+
+	if err := s.appRepo.Restore(ctx, appTenant, id); err != nil {
+		return errors.Wrapf(err, "while restoring Application with id %s", id)
+	}
+
+	return nil
+}
+
+// ListDeletedBefore returns Applications from all tenants marked as deleted before the given time
+func (s *service) ListDeletedBefore(ctx context.Context, before time.Time) ([]*model.Application, error) {
+	apps, err := s.appRepo.ListDeletedBefore(ctx, before)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing deleted Applications")
+	}
+
+	return apps, nil
+}
+
+// ListRuntimeIDsInScenarios returns IDs of the Runtimes assigned to any of the Application scenarios
+func (s *service) ListRuntimeIDsInScenarios(ctx context.Context, id string) ([]string, error) {
+	appTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	scenariosLabel, err := s.labelRepo.GetByKey(ctx, appTenant, model.ApplicationLabelableObject, id, model.ScenariosKey)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "while getting scenarios for Application with id %s", id)
+	}
+
+	scenarios, err := label.ValueToStringsSlice(scenariosLabel.Value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting scenarios for Application with id %s", id)
+	}
+	if len(scenarios) == 0 {
+		return nil, nil
+	}
+
+	filter := []*labelfilter.LabelFilter{labelfilter.NewForKeyWithQuery(model.ScenariosKey, buildScenariosQuery(scenarios))}
+
+	var runtimeIDs []string
+	cursor := ""
+	for {
+		page, err := s.runtimeRepo.List(ctx, appTenant, filter, runtimesPageSize, cursor)
+		if err != nil {
+			return nil, errors.Wrap(err, "while listing Runtimes in Application scenarios")
+		}
+
+		for _, rtm := range page.Data {
+			runtimeIDs = append(runtimeIDs, rtm.ID)
+		}
+
+		if page.PageInfo == nil || !page.PageInfo.HasNextPage {
+			return runtimeIDs, nil
+		}
+		cursor = page.PageInfo.EndCursor
+	}
+}
+
 func (s *service) SetLabel(ctx context.Context, labelInput *model.LabelInput) error {
 	appTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
@@ -413,6 +573,30 @@ func (s *service) ListLabels(ctx context.Context, applicationID string) (map[str
 	return labels, nil
 }
 
+func (s *service) ListLabelsForApplicationIDs(ctx context.Context, applicationIDs []string) (map[string]map[string]*model.Label, error) {
+	appTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	labels, err := s.labelRepo.ListForObjectIDs(ctx, appTenant, model.ApplicationLabelableObject, applicationIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting labels for Applications")
+	}
+
+	labelsByAppID := make(map[string]map[string]*model.Label, len(applicationIDs))
+	for _, appID := range applicationIDs {
+		labelsByAppID[appID] = map[string]*model.Label{}
+	}
+	for _, l := range labels {
+		if _, ok := labelsByAppID[l.ObjectID]; ok {
+			labelsByAppID[l.ObjectID][l.Key] = l
+		}
+	}
+
+	return labelsByAppID, nil
+}
+
 func (s *service) DeleteLabel(ctx context.Context, applicationID string, key string) error {
 	appTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
@@ -448,6 +632,15 @@ func (s *service) createRelatedResources(ctx context.Context, in model.Applicati
 
 	return nil
 }
+func buildScenariosQuery(scenarios []string) string {
+	conditions := make([]string, 0, len(scenarios))
+	for _, scenario := range scenarios {
+		conditions = append(conditions, fmt.Sprintf(`@ == "%s"`, scenario))
+	}
+
+	return fmt.Sprintf(`$[*] ? (%s)`, strings.Join(conditions, " || "))
+}
+
 func createLabel(key string, value string, objectID string) *model.LabelInput {
 	return &model.LabelInput{
 		Key:        key,
@@ -457,6 +650,23 @@ func createLabel(key string, value string, objectID string) *model.LabelInput {
 	}
 }
 
+// ensureNameUnique checks the name against the Applications which are not marked as deleted
+func (s *service) ensureNameUnique(ctx context.Context, tenant, name string) error {
+	applications, err := s.appRepo.ListAll(ctx, tenant)
+	if err != nil {
+		return err
+	}
+
+	normalizedName := s.appNameNormalizer.Normalize(name)
+	for _, app := range applications {
+		if normalizedName == s.appNameNormalizer.Normalize(app.Name) {
+			return apperrors.NewNotUniqueNameError(resource.Application)
+		}
+	}
+
+	return nil
+}
+
 func (s *service) ensureIntSysExists(ctx context.Context, id *string) (bool, error) {
 	if id == nil {
 		return true, nil
@@ -851,7 +851,7 @@ func TestService_Create(t *testing.T) {
 			uidSvc := testCase.UIDServiceFn()
 			intSysRepo := testCase.IntSysRepoFn()
 			pkgSvc := testCase.PackageServiceFn()
-			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, scenariosSvc, pkgSvc, uidSvc)
+			svc := application.NewService(appNameNormalizer, nil, appRepo, webhookRepo, nil, nil, intSysRepo, labelSvc, scenariosSvc, pkgSvc, nil, nil, nil, uidSvc)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -877,7 +877,7 @@ func TestService_Create(t *testing.T) {
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		svc := application.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.Create(context.TODO(), model.ApplicationRegisterInput{})
 		assert.True(t, apperrors.IsCannotReadTenant(err))
@@ -1142,7 +1142,7 @@ func TestService_Update(t *testing.T) {
 			appRepo := testCase.AppRepoFn()
 			intSysRepo := testCase.IntSysRepoFn()
 			lblUpsrtSvc := testCase.LabelUpsertSvcFn()
-			svc := application.NewService(appNameNormalizer, nil, appRepo, nil, nil, nil, intSysRepo, lblUpsrtSvc, nil, nil, nil)
+			svc := application.NewService(appNameNormalizer, nil, appRepo, nil, nil, nil, intSysRepo, lblUpsrtSvc, nil, nil, nil, nil, nil, nil)
 			svc.SetTimestampGen(timestampGenFunc)
 
 			// when
@@ -1213,7 +1213,7 @@ func TestService_Delete(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appRepo := testCase.AppRepoFn()
-			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -1231,6 +1231,296 @@ func TestService_Delete(t *testing.T) {
 	}
 }
 
+func TestService_MarkAsDeleted(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	id := "foo"
+	tnt := "tenant"
+	externalTnt := "external-tnt"
+	deletedAt := time.Now()
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
+
+	testCases := []struct {
+		Name               string
+		AppRepoFn          func() *automock.ApplicationRepository
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, id).Return(true, nil).Once()
+				repo.On("MarkAsDeleted", ctx, tnt, id, deletedAt).Return(nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when application does not exist",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, id).Return(false, nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: apperrors.NewNotFoundError(resource.Application, id).Error(),
+		},
+		{
+			Name: "Returns error when application existence check failed",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, id).Return(false, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when marking application as deleted failed",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tnt, id).Return(true, nil).Once()
+				repo.On("MarkAsDeleted", ctx, tnt, id, deletedAt).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			appRepo := testCase.AppRepoFn()
+			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc.SetTimestampGen(func() time.Time { return deletedAt })
+
+			// when
+			err := svc.MarkAsDeleted(ctx, id)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			appRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Restore(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	id := "foo"
+	tnt := "tenant"
+	externalTnt := "external-tnt"
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
+
+	now := time.Now()
+	gracePeriod := time.Hour
+	deletedAt := now.Add(-time.Minute)
+	expiredAt := now.Add(-gracePeriod)
+
+	deletedApp := &model.Application{ID: id, Tenant: tnt, Name: "Test app", DeletedAt: &deletedAt}
+	expiredApp := &model.Application{ID: id, Tenant: tnt, Name: "Test app", DeletedAt: &expiredAt}
+	otherApp := &model.Application{ID: "bar", Tenant: tnt, Name: "Other app"}
+	conflictingApp := &model.Application{ID: "baz", Tenant: tnt, Name: "test-app"}
+
+	testCases := []struct {
+		Name               string
+		AppRepoFn          func() *automock.ApplicationRepository
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetDeletedByID", ctx, tnt, id).Return(deletedApp, nil).Once()
+				repo.On("ListAll", ctx, tnt).Return([]*model.Application{otherApp}, nil).Once()
+				repo.On("Restore", ctx, tnt, id).Return(nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when application is not marked as deleted",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetDeletedByID", ctx, tnt, id).Return(nil, apperrors.NewNotFoundError(resource.Application, id)).Once()
+				return repo
+			},
+			ExpectedErrMessage: apperrors.NewNotFoundError(resource.Application, id).Error(),
+		},
+		{
+			Name: "Returns error when deletion grace period of application has expired",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetDeletedByID", ctx, tnt, id).Return(expiredApp, nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: "deletion grace period of Application with id foo has expired",
+		},
+		{
+			Name: "Returns error when application with the same name was registered",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetDeletedByID", ctx, tnt, id).Return(deletedApp, nil).Once()
+				repo.On("ListAll", ctx, tnt).Return([]*model.Application{otherApp, conflictingApp}, nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: apperrors.NewNotUniqueNameError(resource.Application).Error(),
+		},
+		{
+			Name: "Returns error when listing applications failed",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetDeletedByID", ctx, tnt, id).Return(deletedApp, nil).Once()
+				repo.On("ListAll", ctx, tnt).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when application restore failed",
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("GetDeletedByID", ctx, tnt, id).Return(deletedApp, nil).Once()
+				repo.On("ListAll", ctx, tnt).Return([]*model.Application{otherApp}, nil).Once()
+				repo.On("Restore", ctx, tnt, id).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			appRepo := testCase.AppRepoFn()
+			svc := application.NewService(&normalizer.DefaultNormalizator{}, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc.SetTimestampGen(func() time.Time { return now })
+
+			// when
+			err := svc.Restore(ctx, id, gracePeriod)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			appRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ListRuntimeIDsInScenarios(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+	id := "foo"
+	tnt := "tenant"
+	externalTnt := "external-tnt"
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
+
+	scenariosLabel := &model.Label{Key: model.ScenariosKey, Value: []interface{}{"foo", "bar"}}
+	filter := []*labelfilter.LabelFilter{labelfilter.NewForKeyWithQuery(model.ScenariosKey, `$[*] ? (@ == "foo" || @ == "bar")`)}
+
+	testCases := []struct {
+		Name               string
+		LabelRepoFn        func() *automock.LabelRepository
+		RuntimeRepoFn      func() *automock.RuntimeRepository
+		ExpectedRuntimeIDs []string
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success for multiple pages",
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", ctx, tnt, model.ApplicationLabelableObject, id, model.ScenariosKey).Return(scenariosLabel, nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("List", ctx, tnt, filter, 100, "").Return(&model.RuntimePage{
+					Data:     []*model.Runtime{{ID: "rt1"}},
+					PageInfo: &pagination.Page{EndCursor: "next", HasNextPage: true},
+				}, nil).Once()
+				repo.On("List", ctx, tnt, filter, 100, "next").Return(&model.RuntimePage{
+					Data:     []*model.Runtime{{ID: "rt2"}},
+					PageInfo: &pagination.Page{HasNextPage: false},
+				}, nil).Once()
+				return repo
+			},
+			ExpectedRuntimeIDs: []string{"rt1", "rt2"},
+		},
+		{
+			Name: "Returns no runtimes when application has no scenarios",
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", ctx, tnt, model.ApplicationLabelableObject, id, model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(resource.Label, model.ScenariosKey)).Once()
+				return repo
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				return &automock.RuntimeRepository{}
+			},
+		},
+		{
+			Name: "Returns error when getting scenarios failed",
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", ctx, tnt, model.ApplicationLabelableObject, id, model.ScenariosKey).Return(nil, testErr).Once()
+				return repo
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				return &automock.RuntimeRepository{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when listing runtimes failed",
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("GetByKey", ctx, tnt, model.ApplicationLabelableObject, id, model.ScenariosKey).Return(scenariosLabel, nil).Once()
+				return repo
+			},
+			RuntimeRepoFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("List", ctx, tnt, filter, 100, "").Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelRepo := testCase.LabelRepoFn()
+			runtimeRepo := testCase.RuntimeRepoFn()
+			svc := application.NewService(nil, nil, nil, nil, runtimeRepo, labelRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			runtimeIDs, err := svc.ListRuntimeIDsInScenarios(ctx, id)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedRuntimeIDs, runtimeIDs)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, labelRepo, runtimeRepo)
+		})
+	}
+}
+
 func TestService_Get(t *testing.T) {
 	// given
 	testErr := errors.New("Test error")
@@ -1287,7 +1577,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			app, err := svc.Get(ctx, testCase.InputID)
@@ -1394,7 +1684,7 @@ func TestService_List(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			app, err := svc.List(ctx, testCase.InputLabelFilters, testCase.InputPageSize, after)
@@ -1675,7 +1965,7 @@ func TestService_ListByRuntimeID(t *testing.T) {
 			labelRepository := testCase.LabelRepositoryFn()
 			appRepository := testCase.AppRepositoryFn()
 			cfgProvider := testCase.ConfigProviderFn()
-			svc := application.NewService(nil, cfgProvider, appRepository, nil, runtimeRepository, labelRepository, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, cfgProvider, appRepository, nil, runtimeRepository, labelRepository, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			//WHEN
 			results, err := svc.ListByRuntimeID(ctx, testCase.Input, first, cursor)
@@ -1697,6 +1987,119 @@ func TestService_ListByRuntimeID(t *testing.T) {
 	}
 }
 
+func TestService_ListByRuntimeContextID(t *testing.T) {
+	runtimeContextUUID := uuid.New()
+	testError := errors.New("test error")
+	tenantUUID := uuid.New()
+	externalTenantUUID := uuid.New()
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantUUID.String(), externalTenantUUID.String())
+
+	first := 10
+	cursor := "test"
+	scenarios := []interface{}{"Easter", "Christmas"}
+	scenarioLabel := model.Label{
+		ID:    uuid.New().String(),
+		Key:   model.ScenariosKey,
+		Value: scenarios,
+	}
+	hidingSelectors := map[string][]string{"foo": {"bar", "baz"}}
+
+	applicationPage := fixApplicationPage([]*model.Application{
+		fixModelApplication("test1", "tenant-foo", "test1", "test1"),
+	})
+
+	testCases := []struct {
+		Name              string
+		LabelRepositoryFn func() *automock.LabelRepository
+		AppRepositoryFn   func() *automock.ApplicationRepository
+		ConfigProviderFn  func() *automock.ApplicationHideCfgProvider
+		ExpectedResult    *model.ApplicationPage
+		ExpectedError     error
+	}{
+		{
+			Name: "Success",
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				labelRepository := &automock.LabelRepository{}
+				labelRepository.On("GetByKey", ctx, tenantUUID.String(), model.RuntimeContextLabelableObject, runtimeContextUUID.String(), model.ScenariosKey).
+					Return(&scenarioLabel, nil).Once()
+				return labelRepository
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				appRepository := &automock.ApplicationRepository{}
+				appRepository.On("ListByScenarios", ctx, tenantUUID, convertToStringArray(t, scenarios), first, cursor, hidingSelectors).
+					Return(applicationPage, nil).Once()
+				return appRepository
+			},
+			ConfigProviderFn: func() *automock.ApplicationHideCfgProvider {
+				cfgProvider := &automock.ApplicationHideCfgProvider{}
+				cfgProvider.On("GetApplicationHideSelectors").Return(hidingSelectors, nil).Once()
+				return cfgProvider
+			},
+			ExpectedResult: applicationPage,
+		},
+		{
+			Name: "Success when scenarios label not set",
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				labelRepository := &automock.LabelRepository{}
+				labelRepository.On("GetByKey", ctx, tenantUUID.String(), model.RuntimeContextLabelableObject, runtimeContextUUID.String(), model.ScenariosKey).
+					Return(nil, apperrors.NewNotFoundError(resource.Label, "")).Once()
+				return labelRepository
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				return &automock.ApplicationRepository{}
+			},
+			ConfigProviderFn: func() *automock.ApplicationHideCfgProvider {
+				return &automock.ApplicationHideCfgProvider{}
+			},
+			ExpectedResult: &model.ApplicationPage{
+				Data:       []*model.Application{},
+				PageInfo:   &pagination.Page{},
+				TotalCount: 0,
+			},
+		},
+		{
+			Name: "Return error when getting scenarios failed",
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				labelRepository := &automock.LabelRepository{}
+				labelRepository.On("GetByKey", ctx, tenantUUID.String(), model.RuntimeContextLabelableObject, runtimeContextUUID.String(), model.ScenariosKey).
+					Return(nil, testError).Once()
+				return labelRepository
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				return &automock.ApplicationRepository{}
+			},
+			ConfigProviderFn: func() *automock.ApplicationHideCfgProvider {
+				return &automock.ApplicationHideCfgProvider{}
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			labelRepository := testCase.LabelRepositoryFn()
+			appRepository := testCase.AppRepositoryFn()
+			cfgProvider := testCase.ConfigProviderFn()
+			svc := application.NewService(nil, cfgProvider, appRepository, nil, nil, labelRepository, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			//WHEN
+			results, err := svc.ListByRuntimeContextID(ctx, runtimeContextUUID, first, cursor)
+
+			//THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedResult, results)
+			mock.AssertExpectationsForObjects(t, labelRepository, appRepository, cfgProvider)
+		})
+	}
+}
+
 func TestService_Exist(t *testing.T) {
 	tnt := "tenant"
 	externalTnt := "external-tnt"
@@ -1753,7 +2156,7 @@ func TestService_Exist(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			//GIVEN
 			appRepo := testCase.RepositoryFn()
-			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, nil, appRepo, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// WHEN
 			value, err := svc.Exist(ctx, testCase.InputApplicationID)
@@ -1855,7 +2258,7 @@ func TestService_SetLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelSvc := testCase.LabelServiceFn()
-			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, labelSvc, nil, nil, nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, nil, nil, labelSvc, nil, nil, nil, nil, nil, nil)
 
 			// when
 			err := svc.SetLabel(ctx, testCase.InputLabel)
@@ -1969,7 +2372,7 @@ func TestService_GetLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			l, err := svc.GetLabel(ctx, testCase.InputApplicationID, testCase.InputLabel.Key)
@@ -2085,7 +2488,7 @@ func TestService_ListLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			l, err := svc.ListLabels(ctx, testCase.InputApplicationID)
@@ -2104,6 +2507,90 @@ func TestService_ListLabel(t *testing.T) {
 	}
 }
 
+func TestService_ListLabelsForApplicationIDs(t *testing.T) {
+	// given
+	tnt := "tenant"
+	externalTnt := "external-tnt"
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
+
+	testErr := errors.New("Test error")
+
+	firstAppID := "foo"
+	secondAppID := "bar"
+	applicationIDs := []string{firstAppID, secondAppID}
+
+	firstLabel := &model.Label{
+		ID:         "5d23d9d9-3d04-4fa9-95e6-d22e1ae62c11",
+		Tenant:     tnt,
+		Key:        "first",
+		Value:      []string{"value1"},
+		ObjectID:   firstAppID,
+		ObjectType: model.ApplicationLabelableObject,
+	}
+	secondLabel := &model.Label{
+		ID:         "5d23d9d9-3d04-4fa9-95e6-d22e1ae62c12",
+		Tenant:     tnt,
+		Key:        "second",
+		Value:      "value2",
+		ObjectID:   firstAppID,
+		ObjectType: model.ApplicationLabelableObject,
+	}
+
+	testCases := []struct {
+		Name               string
+		LabelRepositoryFn  func() *automock.LabelRepository
+		ExpectedOutput     map[string]map[string]*model.Label
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObjectIDs", ctx, tnt, model.ApplicationLabelableObject, applicationIDs).Return([]*model.Label{firstLabel, secondLabel}, nil).Once()
+				return repo
+			},
+			ExpectedOutput: map[string]map[string]*model.Label{
+				firstAppID:  {"first": firstLabel, "second": secondLabel},
+				secondAppID: {},
+			},
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when labels receiving failed",
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObjectIDs", ctx, tnt, model.ApplicationLabelableObject, applicationIDs).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedOutput:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelRepo := testCase.LabelRepositoryFn()
+			svc := application.NewService(nil, nil, nil, nil, nil, labelRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			l, err := svc.ListLabelsForApplicationIDs(ctx, applicationIDs)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedOutput, l)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			labelRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestService_DeleteLabel(t *testing.T) {
 	// given
 	tnt := "tenant"
@@ -2179,7 +2666,7 @@ func TestService_DeleteLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil)
+			svc := application.NewService(nil, nil, repo, nil, nil, labelRepo, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			err := svc.DeleteLabel(ctx, testCase.InputApplicationID, testCase.InputKey)
@@ -0,0 +1,61 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+// ApplicationShareConverter is an autogenerated mock type for the ApplicationShareConverter type
+type ApplicationShareConverter struct {
+	mock.Mock
+}
+
+// InputFromGraphQL provides a mock function with given fields: in
+func (_m *ApplicationShareConverter) InputFromGraphQL(in graphql.ApplicationShareInput) model.ApplicationShareInput {
+	ret := _m.Called(in)
+
+	var r0 model.ApplicationShareInput
+	if rf, ok := ret.Get(0).(func(graphql.ApplicationShareInput) model.ApplicationShareInput); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.ApplicationShareInput)
+	}
+
+	return r0
+}
+
+// MultipleToGraphQL provides a mock function with given fields: in
+func (_m *ApplicationShareConverter) MultipleToGraphQL(in []*model.ApplicationShare) []*graphql.ApplicationShare {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.ApplicationShare
+	if rf, ok := ret.Get(0).(func([]*model.ApplicationShare) []*graphql.ApplicationShare); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.ApplicationShare)
+		}
+	}
+
+	return r0
+}
+
+// ToGraphQL provides a mock function with given fields: in
+func (_m *ApplicationShareConverter) ToGraphQL(in *model.ApplicationShare) *graphql.ApplicationShare {
+	ret := _m.Called(in)
+
+	var r0 *graphql.ApplicationShare
+	if rf, ok := ret.Get(0).(func(*model.ApplicationShare) *graphql.ApplicationShare); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.ApplicationShare)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,135 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ApplicationShareRepository is an autogenerated mock type for the ApplicationShareRepository type
+type ApplicationShareRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, item
+func (_m *ApplicationShareRepository) Create(ctx context.Context, item model.ApplicationShare) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ApplicationShare) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, tenant, id
+func (_m *ApplicationShareRepository) Delete(ctx context.Context, tenant string, id string) error {
+	ret := _m.Called(ctx, tenant, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, tenant, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: ctx, tenant, id
+func (_m *ApplicationShareRepository) GetByID(ctx context.Context, tenant string, id string) (*model.ApplicationShare, error) {
+	ret := _m.Called(ctx, tenant, id)
+
+	var r0 *model.ApplicationShare
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.ApplicationShare); ok {
+		r0 = rf(ctx, tenant, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationShare)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListForApplication provides a mock function with given fields: ctx, tenant, applicationID
+func (_m *ApplicationShareRepository) ListForApplication(ctx context.Context, tenant string, applicationID string) ([]*model.ApplicationShare, error) {
+	ret := _m.Called(ctx, tenant, applicationID)
+
+	var r0 []*model.ApplicationShare
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*model.ApplicationShare); ok {
+		r0 = rf(ctx, tenant, applicationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ApplicationShare)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, applicationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListForPackage provides a mock function with given fields: ctx, targetTenant, packageID
+func (_m *ApplicationShareRepository) ListForPackage(ctx context.Context, targetTenant string, packageID string) ([]*model.ApplicationShare, error) {
+	ret := _m.Called(ctx, targetTenant, packageID)
+
+	var r0 []*model.ApplicationShare
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*model.ApplicationShare); ok {
+		r0 = rf(ctx, targetTenant, packageID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ApplicationShare)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, targetTenant, packageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListForPackageInstanceAuth provides a mock function with given fields: ctx, targetTenant, packageInstanceAuthID
+func (_m *ApplicationShareRepository) ListForPackageInstanceAuth(ctx context.Context, targetTenant string, packageInstanceAuthID string) ([]*model.ApplicationShare, error) {
+	ret := _m.Called(ctx, targetTenant, packageInstanceAuthID)
+
+	var r0 []*model.ApplicationShare
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*model.ApplicationShare); ok {
+		r0 = rf(ctx, targetTenant, packageInstanceAuthID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ApplicationShare)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, targetTenant, packageInstanceAuthID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ApplicationShareService is an autogenerated mock type for the ApplicationShareService type
+type ApplicationShareService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, applicationID, in
+func (_m *ApplicationShareService) Create(ctx context.Context, applicationID string, in model.ApplicationShareInput) (string, error) {
+	ret := _m.Called(ctx, applicationID, in)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ApplicationShareInput) string); ok {
+		r0 = rf(ctx, applicationID, in)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.ApplicationShareInput) error); ok {
+		r1 = rf(ctx, applicationID, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *ApplicationShareService) Delete(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *ApplicationShareService) Get(ctx context.Context, id string) (*model.ApplicationShare, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.ApplicationShare
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ApplicationShare); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationShare)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListForApplication provides a mock function with given fields: ctx, applicationID
+func (_m *ApplicationShareService) ListForApplication(ctx context.Context, applicationID string) ([]*model.ApplicationShare, error) {
+	ret := _m.Called(ctx, applicationID)
+
+	var r0 []*model.ApplicationShare
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.ApplicationShare); ok {
+		r0 = rf(ctx, applicationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ApplicationShare)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, applicationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
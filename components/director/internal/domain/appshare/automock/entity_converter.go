@@ -0,0 +1,47 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	appshare "github.com/kyma-incubator/compass/components/director/internal/domain/appshare"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+// EntityConverter is an autogenerated mock type for the EntityConverter type
+type EntityConverter struct {
+	mock.Mock
+}
+
+// FromEntity provides a mock function with given fields: in
+func (_m *EntityConverter) FromEntity(in *appshare.Entity) *model.ApplicationShare {
+	ret := _m.Called(in)
+
+	var r0 *model.ApplicationShare
+	if rf, ok := ret.Get(0).(func(*appshare.Entity) *model.ApplicationShare); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationShare)
+		}
+	}
+
+	return r0
+}
+
+// ToEntity provides a mock function with given fields: in
+func (_m *EntityConverter) ToEntity(in *model.ApplicationShare) *appshare.Entity {
+	ret := _m.Called(in)
+
+	var r0 *appshare.Entity
+	if rf, ok := ret.Get(0).(func(*model.ApplicationShare) *appshare.Entity); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*appshare.Entity)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,74 @@
+package appshare
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct{}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) ToGraphQL(in *model.ApplicationShare) *graphql.ApplicationShare {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.ApplicationShare{
+		ID:            in.ID,
+		ApplicationID: in.ApplicationID,
+		TargetTenant:  in.TargetExternalTenant,
+		Scenario:      in.Scenario,
+	}
+}
+
+func (c *converter) MultipleToGraphQL(in []*model.ApplicationShare) []*graphql.ApplicationShare {
+	shares := make([]*graphql.ApplicationShare, 0, len(in))
+	for _, s := range in {
+		if s == nil {
+			continue
+		}
+
+		shares = append(shares, c.ToGraphQL(s))
+	}
+
+	return shares
+}
+
+func (c *converter) InputFromGraphQL(in graphql.ApplicationShareInput) model.ApplicationShareInput {
+	return model.ApplicationShareInput{
+		TargetExternalTenant: in.TargetTenant,
+		Scenario:             in.Scenario,
+	}
+}
+
+func (c *converter) ToEntity(in *model.ApplicationShare) *Entity {
+	if in == nil {
+		return nil
+	}
+
+	return &Entity{
+		ID:             in.ID,
+		TenantID:       in.Tenant,
+		ApplicationID:  in.ApplicationID,
+		TargetTenantID: in.TargetTenant,
+		Scenario:       repo.NewNullableString(in.Scenario),
+	}
+}
+
+func (c *converter) FromEntity(in *Entity) *model.ApplicationShare {
+	if in == nil {
+		return nil
+	}
+
+	return &model.ApplicationShare{
+		ID:            in.ID,
+		Tenant:        in.TenantID,
+		ApplicationID: in.ApplicationID,
+		TargetTenant:  in.TargetTenantID,
+		Scenario:      repo.StringPtrFromNullableString(in.Scenario),
+	}
+}
@@ -0,0 +1,118 @@
+package appshare_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/appshare"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		conv := appshare.NewConverter()
+
+		// WHEN
+		result := conv.ToGraphQL(fixModelApplicationShare(testID))
+
+		// THEN
+		assert.Equal(t, fixGQLApplicationShare(testID), result)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		// GIVEN
+		conv := appshare.NewConverter()
+
+		// WHEN
+		result := conv.ToGraphQL(nil)
+
+		// THEN
+		assert.Nil(t, result)
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	// GIVEN
+	conv := appshare.NewConverter()
+	input := []*model.ApplicationShare{fixModelApplicationShare(testID), nil}
+
+	// WHEN
+	result := conv.MultipleToGraphQL(input)
+
+	// THEN
+	assert.Equal(t, []*graphql.ApplicationShare{fixGQLApplicationShare(testID)}, result)
+}
+
+func TestConverter_InputFromGraphQL(t *testing.T) {
+	// GIVEN
+	conv := appshare.NewConverter()
+
+	// WHEN
+	result := conv.InputFromGraphQL(fixGQLApplicationShareInput())
+
+	// THEN
+	assert.Equal(t, fixModelApplicationShareInput(), result)
+}
+
+func TestConverter_ToEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		conv := appshare.NewConverter()
+
+		// WHEN
+		result := conv.ToEntity(fixModelApplicationShare(testID))
+
+		// THEN
+		assert.Equal(t, fixEntityApplicationShare(testID), result)
+	})
+
+	t.Run("Without scenario", func(t *testing.T) {
+		// GIVEN
+		conv := appshare.NewConverter()
+		share := fixModelApplicationShare(testID)
+		share.Scenario = nil
+
+		// WHEN
+		result := conv.ToEntity(share)
+
+		// THEN
+		assert.False(t, result.Scenario.Valid)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		// GIVEN
+		conv := appshare.NewConverter()
+
+		// WHEN
+		result := conv.ToEntity(nil)
+
+		// THEN
+		assert.Nil(t, result)
+	})
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		conv := appshare.NewConverter()
+
+		// WHEN
+		result := conv.FromEntity(fixEntityApplicationShare(testID))
+
+		// THEN
+		assert.Equal(t, fixModelApplicationShareWithoutExternalTenant(testID), result)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		// GIVEN
+		conv := appshare.NewConverter()
+
+		// WHEN
+		result := conv.FromEntity(nil)
+
+		// THEN
+		assert.Nil(t, result)
+	})
+}
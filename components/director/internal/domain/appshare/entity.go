@@ -0,0 +1,17 @@
+package appshare
+
+import "database/sql"
+
+type Entity struct {
+	ID             string         `db:"id"`
+	TenantID       string         `db:"tenant_id"`
+	ApplicationID  string         `db:"app_id"`
+	TargetTenantID string         `db:"target_tenant_id"`
+	Scenario       sql.NullString `db:"scenario"`
+}
+
+type EntityCollection []Entity
+
+func (c EntityCollection) Len() int {
+	return len(c)
+}
@@ -0,0 +1,75 @@
+package appshare_test
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/appshare"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	testID                = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	testTenant            = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	testExtTenant         = "external-tenant"
+	testAppID             = "cccccccc-cccc-cccc-cccc-cccccccccccc"
+	testTargetTenant      = "dddddddd-dddd-dddd-dddd-dddddddddddd"
+	testTargetExtTenant   = "external-target-tenant"
+	testPackageID         = "eeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee"
+	testPackageInstAuthID = "ffffffff-ffff-ffff-ffff-ffffffffffff"
+	testScenario          = "MARKETING"
+)
+
+var testError = errors.New("test error")
+
+func fixModelApplicationShare(id string) *model.ApplicationShare {
+	return &model.ApplicationShare{
+		ID:                   id,
+		Tenant:               testTenant,
+		ApplicationID:        testAppID,
+		TargetTenant:         testTargetTenant,
+		TargetExternalTenant: testTargetExtTenant,
+		Scenario:             str.Ptr(testScenario),
+	}
+}
+
+func fixModelApplicationShareWithoutExternalTenant(id string) *model.ApplicationShare {
+	share := fixModelApplicationShare(id)
+	share.TargetExternalTenant = ""
+	return share
+}
+
+func fixModelApplicationShareInput() model.ApplicationShareInput {
+	return model.ApplicationShareInput{
+		TargetExternalTenant: testTargetExtTenant,
+		Scenario:             str.Ptr(testScenario),
+	}
+}
+
+func fixGQLApplicationShare(id string) *graphql.ApplicationShare {
+	return &graphql.ApplicationShare{
+		ID:            id,
+		ApplicationID: testAppID,
+		TargetTenant:  testTargetExtTenant,
+		Scenario:      str.Ptr(testScenario),
+	}
+}
+
+func fixGQLApplicationShareInput() graphql.ApplicationShareInput {
+	return graphql.ApplicationShareInput{
+		TargetTenant: testTargetExtTenant,
+		Scenario:     str.Ptr(testScenario),
+	}
+}
+
+func fixEntityApplicationShare(id string) *appshare.Entity {
+	return &appshare.Entity{
+		ID:             id,
+		TenantID:       testTenant,
+		ApplicationID:  testAppID,
+		TargetTenantID: testTargetTenant,
+		Scenario:       sql.NullString{String: testScenario, Valid: true},
+	}
+}
@@ -0,0 +1,109 @@
+package appshare
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const (
+	applicationSharesTable string = `public.application_shares`
+	tenantColumn           string = `tenant_id`
+	targetTenantColumn     string = `target_tenant_id`
+
+	packageApplicationSubquery             = `SELECT app_id FROM public.packages WHERE id = ?`
+	packageInstanceAuthApplicationSubquery = `SELECT app_id FROM public.packages WHERE id IN (SELECT package_id FROM public.package_instance_auths WHERE id = ?)`
+)
+
+var applicationShareColumns = []string{"id", "tenant_id", "app_id", "target_tenant_id", "scenario"}
+
+//go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
+type EntityConverter interface {
+	ToEntity(in *model.ApplicationShare) *Entity
+	FromEntity(in *Entity) *model.ApplicationShare
+}
+
+type pgRepository struct {
+	creator      repo.Creator
+	singleGetter repo.SingleGetter
+	lister       repo.Lister
+	globalLister repo.ListerGlobal
+	deleter      repo.Deleter
+	conv         EntityConverter
+}
+
+func NewRepository(conv EntityConverter) *pgRepository {
+	return &pgRepository{
+		creator:      repo.NewCreator(resource.ApplicationShare, applicationSharesTable, applicationShareColumns),
+		singleGetter: repo.NewSingleGetter(resource.ApplicationShare, applicationSharesTable, tenantColumn, applicationShareColumns),
+		lister:       repo.NewLister(resource.ApplicationShare, applicationSharesTable, tenantColumn, applicationShareColumns),
+		globalLister: repo.NewListerGlobal(resource.ApplicationShare, applicationSharesTable, applicationShareColumns),
+		deleter:      repo.NewDeleter(resource.ApplicationShare, applicationSharesTable, tenantColumn),
+		conv:         conv,
+	}
+}
+
+func (r *pgRepository) Create(ctx context.Context, item model.ApplicationShare) error {
+	return r.creator.Create(ctx, r.conv.ToEntity(&item))
+}
+
+func (r *pgRepository) GetByID(ctx context.Context, tenant, id string) (*model.ApplicationShare, error) {
+	var entity Entity
+	if err := r.singleGetter.Get(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity), nil
+}
+
+func (r *pgRepository) ListForApplication(ctx context.Context, tenant, applicationID string) ([]*model.ApplicationShare, error) {
+	var entities EntityCollection
+	if err := r.lister.List(ctx, tenant, &entities, repo.NewEqualCondition("app_id", applicationID)); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities), nil
+}
+
+// ListForPackage lists the shares of the Application of the Package with the target tenant. The Package belongs to the tenant owning the Application.
+func (r *pgRepository) ListForPackage(ctx context.Context, targetTenant, packageID string) ([]*model.ApplicationShare, error) {
+	var entities EntityCollection
+	conditions := repo.Conditions{
+		repo.NewEqualCondition(targetTenantColumn, targetTenant),
+		repo.NewInConditionForSubQuery("app_id", packageApplicationSubquery, []interface{}{packageID}),
+	}
+	if err := r.globalLister.ListGlobal(ctx, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities), nil
+}
+
+// ListForPackageInstanceAuth lists the shares of the Application of the Package Instance Auth with the target tenant.
+// The Package Instance Auth belongs to the tenant owning the Application.
+func (r *pgRepository) ListForPackageInstanceAuth(ctx context.Context, targetTenant, packageInstanceAuthID string) ([]*model.ApplicationShare, error) {
+	var entities EntityCollection
+	conditions := repo.Conditions{
+		repo.NewEqualCondition(targetTenantColumn, targetTenant),
+		repo.NewInConditionForSubQuery("app_id", packageInstanceAuthApplicationSubquery, []interface{}{packageInstanceAuthID}),
+	}
+	if err := r.globalLister.ListGlobal(ctx, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities), nil
+}
+
+func (r *pgRepository) Delete(ctx context.Context, tenant, id string) error {
+	return r.deleter.DeleteOne(ctx, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
+func (r *pgRepository) multipleFromEntities(entities EntityCollection) []*model.ApplicationShare {
+	items := make([]*model.ApplicationShare, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, r.conv.FromEntity(&entity))
+	}
+	return items
+}
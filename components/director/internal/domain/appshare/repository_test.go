@@ -0,0 +1,270 @@
+package appshare_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/appshare"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/appshare/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var applicationShareColumns = []string{"id", "tenant_id", "app_id", "target_tenant_id", "scenario"}
+
+func fixApplicationShareRow(id string) []driver.Value {
+	return []driver.Value{id, testTenant, testAppID, testTargetTenant, testScenario}
+}
+
+func TestRepository_Create(t *testing.T) {
+	insertQuery := regexp.QuoteMeta(`INSERT INTO public.application_shares ( id, tenant_id, app_id, target_tenant_id, scenario ) VALUES ( ?, ?, ?, ?, ? )`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		shareModel := fixModelApplicationShareWithoutExternalTenant(testID)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", shareModel).Return(fixEntityApplicationShare(testID)).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(insertQuery).
+			WithArgs(testID, testTenant, testAppID, testTargetTenant, testScenario).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := appshare.NewRepository(mockConverter)
+
+		// WHEN
+		err := repo.Create(ctx, *shareModel)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when creating", func(t *testing.T) {
+		// GIVEN
+		shareModel := fixModelApplicationShareWithoutExternalTenant(testID)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", shareModel).Return(fixEntityApplicationShare(testID)).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(insertQuery).
+			WithArgs(testID, testTenant, testAppID, testTargetTenant, testScenario).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := appshare.NewRepository(mockConverter)
+
+		// WHEN
+		err := repo.Create(ctx, *shareModel)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_GetByID(t *testing.T) {
+	selectQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, target_tenant_id, scenario FROM public.application_shares WHERE tenant_id = $1 AND id = $2`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		shareModel := fixModelApplicationShareWithoutExternalTenant(testID)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", fixEntityApplicationShare(testID)).Return(shareModel).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		rows := sqlmock.NewRows(applicationShareColumns).AddRow(fixApplicationShareRow(testID)...)
+		dbMock.ExpectQuery(selectQuery).WithArgs(testTenant, testID).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := appshare.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := repo.GetByID(ctx, testTenant, testID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, shareModel, result)
+	})
+
+	t.Run("Error when not found", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(selectQuery).WithArgs(testTenant, testID).WillReturnRows(sqlmock.NewRows(applicationShareColumns))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := appshare.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.GetByID(ctx, testTenant, testID)
+
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+}
+
+func TestRepository_ListForApplication(t *testing.T) {
+	selectQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, target_tenant_id, scenario FROM public.application_shares WHERE tenant_id = $1 AND app_id = $2`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		shareModel := fixModelApplicationShareWithoutExternalTenant(testID)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", fixEntityApplicationShare(testID)).Return(shareModel).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		rows := sqlmock.NewRows(applicationShareColumns).AddRow(fixApplicationShareRow(testID)...)
+		dbMock.ExpectQuery(selectQuery).WithArgs(testTenant, testAppID).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := appshare.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := repo.ListForApplication(ctx, testTenant, testAppID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.ApplicationShare{shareModel}, result)
+	})
+
+	t.Run("Error when listing", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(selectQuery).WithArgs(testTenant, testAppID).WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := appshare.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.ListForApplication(ctx, testTenant, testAppID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_ListForPackage(t *testing.T) {
+	selectQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, target_tenant_id, scenario FROM public.application_shares WHERE target_tenant_id = $1 AND app_id IN (SELECT app_id FROM public.packages WHERE id = $2)`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		shareModel := fixModelApplicationShareWithoutExternalTenant(testID)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", fixEntityApplicationShare(testID)).Return(shareModel).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		rows := sqlmock.NewRows(applicationShareColumns).AddRow(fixApplicationShareRow(testID)...)
+		dbMock.ExpectQuery(selectQuery).WithArgs(testTargetTenant, testPackageID).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := appshare.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := repo.ListForPackage(ctx, testTargetTenant, testPackageID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.ApplicationShare{shareModel}, result)
+	})
+
+	t.Run("Error when listing", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(selectQuery).WithArgs(testTargetTenant, testPackageID).WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := appshare.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.ListForPackage(ctx, testTargetTenant, testPackageID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_ListForPackageInstanceAuth(t *testing.T) {
+	selectQuery := regexp.QuoteMeta(`SELECT id, tenant_id, app_id, target_tenant_id, scenario FROM public.application_shares WHERE target_tenant_id = $1 AND app_id IN (SELECT app_id FROM public.packages WHERE id IN (SELECT package_id FROM public.package_instance_auths WHERE id = $2))`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		shareModel := fixModelApplicationShareWithoutExternalTenant(testID)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", fixEntityApplicationShare(testID)).Return(shareModel).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		rows := sqlmock.NewRows(applicationShareColumns).AddRow(fixApplicationShareRow(testID)...)
+		dbMock.ExpectQuery(selectQuery).WithArgs(testTargetTenant, testPackageInstAuthID).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := appshare.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := repo.ListForPackageInstanceAuth(ctx, testTargetTenant, testPackageInstAuthID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.ApplicationShare{shareModel}, result)
+	})
+}
+
+func TestRepository_Delete(t *testing.T) {
+	deleteQuery := regexp.QuoteMeta(`DELETE FROM public.application_shares WHERE tenant_id = $1 AND id = $2`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(deleteQuery).WithArgs(testTenant, testID).WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := appshare.NewRepository(nil)
+
+		// WHEN
+		err := repo.Delete(ctx, testTenant, testID)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when share does not exist", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(deleteQuery).WithArgs(testTenant, testID).WillReturnResult(sqlmock.NewResult(-1, 0))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := appshare.NewRepository(nil)
+
+		// WHEN
+		err := repo.Delete(ctx, testTenant, testID)
+
+		// THEN
+		require.Error(t, err)
+	})
+}
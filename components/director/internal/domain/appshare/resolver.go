@@ -0,0 +1,110 @@
+package appshare
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+//go:generate mockery -name=ApplicationShareService -output=automock -outpkg=automock -case=underscore
+type ApplicationShareService interface {
+	Create(ctx context.Context, applicationID string, in model.ApplicationShareInput) (string, error)
+	Get(ctx context.Context, id string) (*model.ApplicationShare, error)
+	ListForApplication(ctx context.Context, applicationID string) ([]*model.ApplicationShare, error)
+	Delete(ctx context.Context, id string) error
+}
+
+//go:generate mockery -name=ApplicationShareConverter -output=automock -outpkg=automock -case=underscore
+type ApplicationShareConverter interface {
+	ToGraphQL(in *model.ApplicationShare) *graphql.ApplicationShare
+	MultipleToGraphQL(in []*model.ApplicationShare) []*graphql.ApplicationShare
+	InputFromGraphQL(in graphql.ApplicationShareInput) model.ApplicationShareInput
+}
+
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      ApplicationShareService
+	conv     ApplicationShareConverter
+}
+
+func NewResolver(transact persistence.Transactioner, svc ApplicationShareService, conv ApplicationShareConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+func (r *Resolver) ApplicationShares(ctx context.Context, applicationID string) ([]*graphql.ApplicationShare, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	shares, err := r.svc.ListForApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.MultipleToGraphQL(shares), nil
+}
+
+func (r *Resolver) ShareApplication(ctx context.Context, applicationID string, in graphql.ApplicationShareInput) (*graphql.ApplicationShare, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	id, err := r.svc.Create(ctx, applicationID, r.conv.InputFromGraphQL(in))
+	if err != nil {
+		return nil, err
+	}
+
+	share, err := r.svc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(share), nil
+}
+
+func (r *Resolver) UnshareApplication(ctx context.Context, id string) (*graphql.ApplicationShare, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	share, err := r.svc.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.svc.Delete(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(share), nil
+}
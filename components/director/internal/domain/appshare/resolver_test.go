@@ -0,0 +1,331 @@
+package appshare_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/appshare"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/appshare/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_ApplicationShares(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	modelShares := []*model.ApplicationShare{fixModelApplicationShare(testID)}
+	gqlShares := []*graphql.ApplicationShare{fixGQLApplicationShare(testID)}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcFn          func() *automock.ApplicationShareService
+		ConvFn         func() *automock.ApplicationShareConverter
+		ExpectedOutput []*graphql.ApplicationShare
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.ApplicationShareService {
+				svc := &automock.ApplicationShareService{}
+				svc.On("ListForApplication", txtest.CtxWithDBMatcher(), testAppID).Return(modelShares, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ApplicationShareConverter {
+				conv := &automock.ApplicationShareConverter{}
+				conv.On("MultipleToGraphQL", modelShares).Return(gqlShares).Once()
+				return conv
+			},
+			ExpectedOutput: gqlShares,
+		},
+		{
+			Name: "Returns error when listing Application Shares failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.ApplicationShareService {
+				svc := &automock.ApplicationShareService{}
+				svc.On("ListForApplication", txtest.CtxWithDBMatcher(), testAppID).Return(nil, testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ApplicationShareConverter {
+				return &automock.ApplicationShareConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when beginning transaction",
+			TxFn: txGen.ThatFailsOnBegin,
+			SvcFn: func() *automock.ApplicationShareService {
+				return &automock.ApplicationShareService{}
+			},
+			ConvFn: func() *automock.ApplicationShareConverter {
+				return &automock.ApplicationShareConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			SvcFn: func() *automock.ApplicationShareService {
+				svc := &automock.ApplicationShareService{}
+				svc.On("ListForApplication", txtest.CtxWithDBMatcher(), testAppID).Return(modelShares, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ApplicationShareConverter {
+				return &automock.ApplicationShareConverter{}
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.SvcFn()
+			conv := testCase.ConvFn()
+
+			resolver := appshare.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.ApplicationShares(ctx, testAppID)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_ShareApplication(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	gqlInput := fixGQLApplicationShareInput()
+	modelInput := fixModelApplicationShareInput()
+	modelShare := fixModelApplicationShare(testID)
+	gqlShare := fixGQLApplicationShare(testID)
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcFn          func() *automock.ApplicationShareService
+		ConvFn         func() *automock.ApplicationShareConverter
+		ExpectedOutput *graphql.ApplicationShare
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.ApplicationShareService {
+				svc := &automock.ApplicationShareService{}
+				svc.On("Create", txtest.CtxWithDBMatcher(), testAppID, modelInput).Return(testID, nil).Once()
+				svc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelShare, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ApplicationShareConverter {
+				conv := &automock.ApplicationShareConverter{}
+				conv.On("InputFromGraphQL", gqlInput).Return(modelInput).Once()
+				conv.On("ToGraphQL", modelShare).Return(gqlShare).Once()
+				return conv
+			},
+			ExpectedOutput: gqlShare,
+		},
+		{
+			Name: "Returns error when sharing Application failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.ApplicationShareService {
+				svc := &automock.ApplicationShareService{}
+				svc.On("Create", txtest.CtxWithDBMatcher(), testAppID, modelInput).Return("", testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ApplicationShareConverter {
+				conv := &automock.ApplicationShareConverter{}
+				conv.On("InputFromGraphQL", gqlInput).Return(modelInput).Once()
+				return conv
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when getting Application Share failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.ApplicationShareService {
+				svc := &automock.ApplicationShareService{}
+				svc.On("Create", txtest.CtxWithDBMatcher(), testAppID, modelInput).Return(testID, nil).Once()
+				svc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(nil, testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ApplicationShareConverter {
+				conv := &automock.ApplicationShareConverter{}
+				conv.On("InputFromGraphQL", gqlInput).Return(modelInput).Once()
+				return conv
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when beginning transaction",
+			TxFn: txGen.ThatFailsOnBegin,
+			SvcFn: func() *automock.ApplicationShareService {
+				return &automock.ApplicationShareService{}
+			},
+			ConvFn: func() *automock.ApplicationShareConverter {
+				return &automock.ApplicationShareConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			SvcFn: func() *automock.ApplicationShareService {
+				svc := &automock.ApplicationShareService{}
+				svc.On("Create", txtest.CtxWithDBMatcher(), testAppID, modelInput).Return(testID, nil).Once()
+				svc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelShare, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ApplicationShareConverter {
+				conv := &automock.ApplicationShareConverter{}
+				conv.On("InputFromGraphQL", gqlInput).Return(modelInput).Once()
+				return conv
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.SvcFn()
+			conv := testCase.ConvFn()
+
+			resolver := appshare.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.ShareApplication(ctx, testAppID, gqlInput)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_UnshareApplication(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	modelShare := fixModelApplicationShare(testID)
+	gqlShare := fixGQLApplicationShare(testID)
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcFn          func() *automock.ApplicationShareService
+		ConvFn         func() *automock.ApplicationShareConverter
+		ExpectedOutput *graphql.ApplicationShare
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.ApplicationShareService {
+				svc := &automock.ApplicationShareService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelShare, nil).Once()
+				svc.On("Delete", txtest.CtxWithDBMatcher(), testID).Return(nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ApplicationShareConverter {
+				conv := &automock.ApplicationShareConverter{}
+				conv.On("ToGraphQL", modelShare).Return(gqlShare).Once()
+				return conv
+			},
+			ExpectedOutput: gqlShare,
+		},
+		{
+			Name: "Returns error when getting Application Share failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.ApplicationShareService {
+				svc := &automock.ApplicationShareService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(nil, testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ApplicationShareConverter {
+				return &automock.ApplicationShareConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when deleting Application Share failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.ApplicationShareService {
+				svc := &automock.ApplicationShareService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelShare, nil).Once()
+				svc.On("Delete", txtest.CtxWithDBMatcher(), testID).Return(testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ApplicationShareConverter {
+				return &automock.ApplicationShareConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			SvcFn: func() *automock.ApplicationShareService {
+				svc := &automock.ApplicationShareService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelShare, nil).Once()
+				svc.On("Delete", txtest.CtxWithDBMatcher(), testID).Return(nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ApplicationShareConverter {
+				return &automock.ApplicationShareConverter{}
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.SvcFn()
+			conv := testCase.ConvFn()
+
+			resolver := appshare.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.UnshareApplication(ctx, testID)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
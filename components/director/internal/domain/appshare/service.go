@@ -0,0 +1,222 @@
+package appshare
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=ApplicationShareRepository -output=automock -outpkg=automock -case=underscore
+type ApplicationShareRepository interface {
+	Create(ctx context.Context, item model.ApplicationShare) error
+	GetByID(ctx context.Context, tenant, id string) (*model.ApplicationShare, error)
+	ListForApplication(ctx context.Context, tenant, applicationID string) ([]*model.ApplicationShare, error)
+	ListForPackage(ctx context.Context, targetTenant, packageID string) ([]*model.ApplicationShare, error)
+	ListForPackageInstanceAuth(ctx context.Context, targetTenant, packageInstanceAuthID string) ([]*model.ApplicationShare, error)
+	Delete(ctx context.Context, tenant, id string) error
+}
+
+//go:generate mockery -name=ApplicationRepository -output=automock -outpkg=automock -case=underscore
+type ApplicationRepository interface {
+	Exists(ctx context.Context, tenant, id string) (bool, error)
+}
+
+//go:generate mockery -name=TenantService -output=automock -outpkg=automock -case=underscore
+type TenantService interface {
+	GetExternalTenant(ctx context.Context, id string) (string, error)
+	GetInternalTenant(ctx context.Context, externalTenant string) (string, error)
+}
+
+//go:generate mockery -name=ScenariosService -output=automock -outpkg=automock -case=underscore
+type ScenariosService interface {
+	GetAvailableScenarios(ctx context.Context, tenantID string) ([]string, error)
+}
+
+//go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	repo         ApplicationShareRepository
+	appRepo      ApplicationRepository
+	tenantSvc    TenantService
+	scenariosSvc ScenariosService
+	uidService   UIDService
+}
+
+func NewService(repo ApplicationShareRepository, appRepo ApplicationRepository, tenantSvc TenantService, scenariosSvc ScenariosService, uidService UIDService) *service {
+	return &service{
+		repo:         repo,
+		appRepo:      appRepo,
+		tenantSvc:    tenantSvc,
+		scenariosSvc: scenariosSvc,
+		uidService:   uidService,
+	}
+}
+
+// Create shares the Application with the target tenant. If the share is limited to a scenario, the scenario has to exist in the target tenant.
+func (s *service) Create(ctx context.Context, applicationID string, in model.ApplicationShareInput) (string, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	exists, err := s.appRepo.Exists(ctx, tnt, applicationID)
+	if err != nil {
+		return "", errors.Wrapf(err, "while checking if Application with ID %s exists", applicationID)
+	}
+	if !exists {
+		return "", apperrors.NewNotFoundError(resource.Application, applicationID)
+	}
+
+	targetTenant, err := s.tenantSvc.GetInternalTenant(ctx, in.TargetExternalTenant)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return "", apperrors.NewInvalidDataError("tenant %s does not exist", in.TargetExternalTenant)
+		}
+		return "", err
+	}
+	if targetTenant == tnt {
+		return "", apperrors.NewInvalidDataError("Application cannot be shared with the tenant which owns it")
+	}
+
+	if in.Scenario != nil {
+		if err := s.ensureScenarioExists(ctx, targetTenant, in.TargetExternalTenant, *in.Scenario); err != nil {
+			return "", err
+		}
+	}
+
+	id := s.uidService.Generate()
+	if err := s.repo.Create(ctx, in.ToApplicationShare(id, tnt, applicationID, targetTenant)); err != nil {
+		return "", errors.Wrapf(err, "while sharing Application with ID %s", applicationID)
+	}
+
+	return id, nil
+}
+
+func (s *service) Get(ctx context.Context, id string) (*model.ApplicationShare, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	share, err := s.repo.GetByID(ctx, tnt, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Application Share with ID %s", id)
+	}
+
+	if err := s.setTargetExternalTenant(ctx, share); err != nil {
+		return nil, err
+	}
+
+	return share, nil
+}
+
+func (s *service) ListForApplication(ctx context.Context, applicationID string) ([]*model.ApplicationShare, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := s.repo.ListForApplication(ctx, tnt, applicationID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing Application Shares for Application with ID %s", applicationID)
+	}
+
+	for _, share := range shares {
+		if err := s.setTargetExternalTenant(ctx, share); err != nil {
+			return nil, err
+		}
+	}
+
+	return shares, nil
+}
+
+// Delete stops sharing the Application. The Package Instance Auths already requested by the target tenant are not removed.
+func (s *service) Delete(ctx context.Context, id string) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Delete(ctx, tnt, id); err != nil {
+		return errors.Wrapf(err, "while deleting Application Share with ID %s", id)
+	}
+
+	return nil
+}
+
+// OwnerContextForPackage switches the tenant in the context to the owner of the Package, if the Package belongs to an Application shared with the tenant.
+// Otherwise the context is returned unchanged.
+func (s *service) OwnerContextForPackage(ctx context.Context, packageID string) (context.Context, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := s.repo.ListForPackage(ctx, tnt, packageID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing Application Shares for Package with ID %s", packageID)
+	}
+
+	return ownerContext(ctx, shares)
+}
+
+// OwnerContextForPackageInstanceAuth switches the tenant in the context to the owner of the Package Instance Auth,
+// if the Package Instance Auth belongs to an Application shared with the tenant. Otherwise the context is returned unchanged.
+func (s *service) OwnerContextForPackageInstanceAuth(ctx context.Context, packageInstanceAuthID string) (context.Context, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := s.repo.ListForPackageInstanceAuth(ctx, tnt, packageInstanceAuthID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing Application Shares for Package Instance Auth with ID %s", packageInstanceAuthID)
+	}
+
+	return ownerContext(ctx, shares)
+}
+
+func (s *service) ensureScenarioExists(ctx context.Context, targetTenant, targetExternalTenant, scenario string) error {
+	notFoundErr := apperrors.NewInvalidDataError("scenario %s does not exist in tenant %s", scenario, targetExternalTenant)
+
+	scenarios, err := s.scenariosSvc.GetAvailableScenarios(ctx, targetTenant)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return notFoundErr
+		}
+		return errors.Wrapf(err, "while getting available scenarios in tenant %s", targetExternalTenant)
+	}
+
+	for _, available := range scenarios {
+		if available == scenario {
+			return nil
+		}
+	}
+
+	return notFoundErr
+}
+
+func (s *service) setTargetExternalTenant(ctx context.Context, share *model.ApplicationShare) error {
+	externalTenant, err := s.tenantSvc.GetExternalTenant(ctx, share.TargetTenant)
+	if err != nil {
+		return errors.Wrapf(err, "while getting external tenant of Application Share with ID %s", share.ID)
+	}
+
+	share.TargetExternalTenant = externalTenant
+	return nil
+}
+
+func ownerContext(ctx context.Context, shares []*model.ApplicationShare) (context.Context, error) {
+	if len(shares) == 0 {
+		return ctx, nil
+	}
+
+	return tenant.SaveOwnerToContext(ctx, shares[0].Tenant)
+}
@@ -0,0 +1,567 @@
+package appshare_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/appshare"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/appshare/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Create(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExtTenant)
+	in := fixModelApplicationShareInput()
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.ApplicationShareRepository
+		AppRepositoryFn    func() *automock.ApplicationRepository
+		TenantServiceFn    func() *automock.TenantService
+		ScenariosServiceFn func() *automock.ScenariosService
+		Input              model.ApplicationShareInput
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.ApplicationShareRepository {
+				repo := &automock.ApplicationShareRepository{}
+				repo.On("Create", ctx, in.ToApplicationShare(testID, testTenant, testAppID, testTargetTenant)).Return(nil).Once()
+				return repo
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, testTenant, testAppID).Return(true, nil).Once()
+				return repo
+			},
+			TenantServiceFn: func() *automock.TenantService {
+				svc := &automock.TenantService{}
+				svc.On("GetInternalTenant", ctx, testTargetExtTenant).Return(testTargetTenant, nil).Once()
+				return svc
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				svc := &automock.ScenariosService{}
+				svc.On("GetAvailableScenarios", ctx, testTargetTenant).Return([]string{"DEFAULT", testScenario}, nil).Once()
+				return svc
+			},
+			Input: in,
+		},
+		{
+			Name: "Success without scenario",
+			RepositoryFn: func() *automock.ApplicationShareRepository {
+				repo := &automock.ApplicationShareRepository{}
+				repo.On("Create", ctx, model.ApplicationShare{
+					ID:                   testID,
+					Tenant:               testTenant,
+					ApplicationID:        testAppID,
+					TargetTenant:         testTargetTenant,
+					TargetExternalTenant: testTargetExtTenant,
+				}).Return(nil).Once()
+				return repo
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, testTenant, testAppID).Return(true, nil).Once()
+				return repo
+			},
+			TenantServiceFn: func() *automock.TenantService {
+				svc := &automock.TenantService{}
+				svc.On("GetInternalTenant", ctx, testTargetExtTenant).Return(testTargetTenant, nil).Once()
+				return svc
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				return &automock.ScenariosService{}
+			},
+			Input: model.ApplicationShareInput{TargetExternalTenant: testTargetExtTenant},
+		},
+		{
+			Name: "Error when Application does not exist",
+			RepositoryFn: func() *automock.ApplicationShareRepository {
+				return &automock.ApplicationShareRepository{}
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, testTenant, testAppID).Return(false, nil).Once()
+				return repo
+			},
+			TenantServiceFn: func() *automock.TenantService {
+				return &automock.TenantService{}
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				return &automock.ScenariosService{}
+			},
+			Input:              in,
+			ExpectedErrMessage: apperrors.NewNotFoundError(resource.Application, testAppID).Error(),
+		},
+		{
+			Name: "Error when checking if Application exists",
+			RepositoryFn: func() *automock.ApplicationShareRepository {
+				return &automock.ApplicationShareRepository{}
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, testTenant, testAppID).Return(false, testError).Once()
+				return repo
+			},
+			TenantServiceFn: func() *automock.TenantService {
+				return &automock.TenantService{}
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				return &automock.ScenariosService{}
+			},
+			Input:              in,
+			ExpectedErrMessage: "while checking if Application with ID",
+		},
+		{
+			Name: "Error when target tenant does not exist",
+			RepositoryFn: func() *automock.ApplicationShareRepository {
+				return &automock.ApplicationShareRepository{}
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, testTenant, testAppID).Return(true, nil).Once()
+				return repo
+			},
+			TenantServiceFn: func() *automock.TenantService {
+				svc := &automock.TenantService{}
+				svc.On("GetInternalTenant", ctx, testTargetExtTenant).Return("", apperrors.NewNotFoundError(resource.Tenant, testTargetExtTenant)).Once()
+				return svc
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				return &automock.ScenariosService{}
+			},
+			Input:              in,
+			ExpectedErrMessage: "tenant external-target-tenant does not exist",
+		},
+		{
+			Name: "Error when target tenant is the owner",
+			RepositoryFn: func() *automock.ApplicationShareRepository {
+				return &automock.ApplicationShareRepository{}
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, testTenant, testAppID).Return(true, nil).Once()
+				return repo
+			},
+			TenantServiceFn: func() *automock.TenantService {
+				svc := &automock.TenantService{}
+				svc.On("GetInternalTenant", ctx, testExtTenant).Return(testTenant, nil).Once()
+				return svc
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				return &automock.ScenariosService{}
+			},
+			Input:              model.ApplicationShareInput{TargetExternalTenant: testExtTenant},
+			ExpectedErrMessage: "Application cannot be shared with the tenant which owns it",
+		},
+		{
+			Name: "Error when scenario does not exist in target tenant",
+			RepositoryFn: func() *automock.ApplicationShareRepository {
+				return &automock.ApplicationShareRepository{}
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, testTenant, testAppID).Return(true, nil).Once()
+				return repo
+			},
+			TenantServiceFn: func() *automock.TenantService {
+				svc := &automock.TenantService{}
+				svc.On("GetInternalTenant", ctx, testTargetExtTenant).Return(testTargetTenant, nil).Once()
+				return svc
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				svc := &automock.ScenariosService{}
+				svc.On("GetAvailableScenarios", ctx, testTargetTenant).Return([]string{"DEFAULT"}, nil).Once()
+				return svc
+			},
+			Input:              in,
+			ExpectedErrMessage: "scenario MARKETING does not exist in tenant external-target-tenant",
+		},
+		{
+			Name: "Error when scenarios label definition does not exist in target tenant",
+			RepositoryFn: func() *automock.ApplicationShareRepository {
+				return &automock.ApplicationShareRepository{}
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, testTenant, testAppID).Return(true, nil).Once()
+				return repo
+			},
+			TenantServiceFn: func() *automock.TenantService {
+				svc := &automock.TenantService{}
+				svc.On("GetInternalTenant", ctx, testTargetExtTenant).Return(testTargetTenant, nil).Once()
+				return svc
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				svc := &automock.ScenariosService{}
+				svc.On("GetAvailableScenarios", ctx, testTargetTenant).Return(nil, apperrors.NewNotFoundError(resource.LabelDefinition, model.ScenariosKey)).Once()
+				return svc
+			},
+			Input:              in,
+			ExpectedErrMessage: "scenario MARKETING does not exist in tenant external-target-tenant",
+		},
+		{
+			Name: "Error when getting available scenarios failed",
+			RepositoryFn: func() *automock.ApplicationShareRepository {
+				return &automock.ApplicationShareRepository{}
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, testTenant, testAppID).Return(true, nil).Once()
+				return repo
+			},
+			TenantServiceFn: func() *automock.TenantService {
+				svc := &automock.TenantService{}
+				svc.On("GetInternalTenant", ctx, testTargetExtTenant).Return(testTargetTenant, nil).Once()
+				return svc
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				svc := &automock.ScenariosService{}
+				svc.On("GetAvailableScenarios", ctx, testTargetTenant).Return(nil, testError).Once()
+				return svc
+			},
+			Input:              in,
+			ExpectedErrMessage: "while getting available scenarios in tenant external-target-tenant",
+		},
+		{
+			Name: "Error when creating share",
+			RepositoryFn: func() *automock.ApplicationShareRepository {
+				repo := &automock.ApplicationShareRepository{}
+				repo.On("Create", ctx, in.ToApplicationShare(testID, testTenant, testAppID, testTargetTenant)).Return(testError).Once()
+				return repo
+			},
+			AppRepositoryFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, testTenant, testAppID).Return(true, nil).Once()
+				return repo
+			},
+			TenantServiceFn: func() *automock.TenantService {
+				svc := &automock.TenantService{}
+				svc.On("GetInternalTenant", ctx, testTargetExtTenant).Return(testTargetTenant, nil).Once()
+				return svc
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				svc := &automock.ScenariosService{}
+				svc.On("GetAvailableScenarios", ctx, testTargetTenant).Return([]string{"DEFAULT", testScenario}, nil).Once()
+				return svc
+			},
+			Input:              in,
+			ExpectedErrMessage: "while sharing Application with ID",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			appRepo := testCase.AppRepositoryFn()
+			tenantSvc := testCase.TenantServiceFn()
+			scenariosSvc := testCase.ScenariosServiceFn()
+			uidSvc := &automock.UIDService{}
+			uidSvc.On("Generate").Return(testID).Maybe()
+			svc := appshare.NewService(repo, appRepo, tenantSvc, scenariosSvc, uidSvc)
+
+			// WHEN
+			result, err := svc.Create(ctx, testAppID, testCase.Input)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testID, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, appRepo, tenantSvc, scenariosSvc)
+		})
+	}
+
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := appshare.NewService(nil, nil, nil, nil, nil)
+
+		// WHEN
+		_, err := svc.Create(context.TODO(), testAppID, in)
+
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsCannotReadTenant(err))
+	})
+}
+
+func TestService_Get(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExtTenant)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.ApplicationShareRepository
+		TenantServiceFn    func() *automock.TenantService
+		ExpectedResult     *model.ApplicationShare
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.ApplicationShareRepository {
+				repo := &automock.ApplicationShareRepository{}
+				repo.On("GetByID", ctx, testTenant, testID).Return(fixModelApplicationShareWithoutExternalTenant(testID), nil).Once()
+				return repo
+			},
+			TenantServiceFn: func() *automock.TenantService {
+				svc := &automock.TenantService{}
+				svc.On("GetExternalTenant", ctx, testTargetTenant).Return(testTargetExtTenant, nil).Once()
+				return svc
+			},
+			ExpectedResult: fixModelApplicationShare(testID),
+		},
+		{
+			Name: "Error when getting share",
+			RepositoryFn: func() *automock.ApplicationShareRepository {
+				repo := &automock.ApplicationShareRepository{}
+				repo.On("GetByID", ctx, testTenant, testID).Return(nil, testError).Once()
+				return repo
+			},
+			TenantServiceFn: func() *automock.TenantService {
+				return &automock.TenantService{}
+			},
+			ExpectedErrMessage: "while getting Application Share with ID",
+		},
+		{
+			Name: "Error when getting external tenant",
+			RepositoryFn: func() *automock.ApplicationShareRepository {
+				repo := &automock.ApplicationShareRepository{}
+				repo.On("GetByID", ctx, testTenant, testID).Return(fixModelApplicationShareWithoutExternalTenant(testID), nil).Once()
+				return repo
+			},
+			TenantServiceFn: func() *automock.TenantService {
+				svc := &automock.TenantService{}
+				svc.On("GetExternalTenant", ctx, testTargetTenant).Return("", testError).Once()
+				return svc
+			},
+			ExpectedErrMessage: "while getting external tenant of Application Share",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			tenantSvc := testCase.TenantServiceFn()
+			svc := appshare.NewService(repo, nil, tenantSvc, nil, nil)
+
+			// WHEN
+			result, err := svc.Get(ctx, testID)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, tenantSvc)
+		})
+	}
+}
+
+func TestService_ListForApplication(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExtTenant)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.ApplicationShareRepository
+		TenantServiceFn    func() *automock.TenantService
+		ExpectedResult     []*model.ApplicationShare
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.ApplicationShareRepository {
+				repo := &automock.ApplicationShareRepository{}
+				repo.On("ListForApplication", ctx, testTenant, testAppID).Return([]*model.ApplicationShare{fixModelApplicationShareWithoutExternalTenant(testID)}, nil).Once()
+				return repo
+			},
+			TenantServiceFn: func() *automock.TenantService {
+				svc := &automock.TenantService{}
+				svc.On("GetExternalTenant", ctx, testTargetTenant).Return(testTargetExtTenant, nil).Once()
+				return svc
+			},
+			ExpectedResult: []*model.ApplicationShare{fixModelApplicationShare(testID)},
+		},
+		{
+			Name: "Error when listing shares",
+			RepositoryFn: func() *automock.ApplicationShareRepository {
+				repo := &automock.ApplicationShareRepository{}
+				repo.On("ListForApplication", ctx, testTenant, testAppID).Return(nil, testError).Once()
+				return repo
+			},
+			TenantServiceFn: func() *automock.TenantService {
+				return &automock.TenantService{}
+			},
+			ExpectedErrMessage: "while listing Application Shares for Application with ID",
+		},
+		{
+			Name: "Error when getting external tenant",
+			RepositoryFn: func() *automock.ApplicationShareRepository {
+				repo := &automock.ApplicationShareRepository{}
+				repo.On("ListForApplication", ctx, testTenant, testAppID).Return([]*model.ApplicationShare{fixModelApplicationShareWithoutExternalTenant(testID)}, nil).Once()
+				return repo
+			},
+			TenantServiceFn: func() *automock.TenantService {
+				svc := &automock.TenantService{}
+				svc.On("GetExternalTenant", ctx, testTargetTenant).Return("", testError).Once()
+				return svc
+			},
+			ExpectedErrMessage: "while getting external tenant of Application Share",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			tenantSvc := testCase.TenantServiceFn()
+			svc := appshare.NewService(repo, nil, tenantSvc, nil, nil)
+
+			// WHEN
+			result, err := svc.ListForApplication(ctx, testAppID)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, tenantSvc)
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExtTenant)
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &automock.ApplicationShareRepository{}
+		repo.On("Delete", ctx, testTenant, testID).Return(nil).Once()
+		svc := appshare.NewService(repo, nil, nil, nil, nil)
+
+		// WHEN
+		err := svc.Delete(ctx, testID)
+
+		// THEN
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Error when deleting share", func(t *testing.T) {
+		repo := &automock.ApplicationShareRepository{}
+		repo.On("Delete", ctx, testTenant, testID).Return(testError).Once()
+		svc := appshare.NewService(repo, nil, nil, nil, nil)
+
+		// WHEN
+		err := svc.Delete(ctx, testID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while deleting Application Share with ID")
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestService_OwnerContextForPackage(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTargetTenant, testTargetExtTenant)
+
+	t.Run("Switches to owner when Package is shared", func(t *testing.T) {
+		repo := &automock.ApplicationShareRepository{}
+		repo.On("ListForPackage", ctx, testTargetTenant, testPackageID).Return([]*model.ApplicationShare{fixModelApplicationShareWithoutExternalTenant(testID)}, nil).Once()
+		svc := appshare.NewService(repo, nil, nil, nil, nil)
+
+		// WHEN
+		result, err := svc.OwnerContextForPackage(ctx, testPackageID)
+
+		// THEN
+		require.NoError(t, err)
+		tnt, err := tenant.LoadFromContext(result)
+		require.NoError(t, err)
+		assert.Equal(t, testTenant, tnt)
+		sharedWith, ok := tenant.LoadSharedWithFromContext(result)
+		assert.True(t, ok)
+		assert.Equal(t, testTargetTenant, sharedWith)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Returns unchanged context when Package is not shared", func(t *testing.T) {
+		repo := &automock.ApplicationShareRepository{}
+		repo.On("ListForPackage", ctx, testTargetTenant, testPackageID).Return([]*model.ApplicationShare{}, nil).Once()
+		svc := appshare.NewService(repo, nil, nil, nil, nil)
+
+		// WHEN
+		result, err := svc.OwnerContextForPackage(ctx, testPackageID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, ctx, result)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Error when listing shares", func(t *testing.T) {
+		repo := &automock.ApplicationShareRepository{}
+		repo.On("ListForPackage", ctx, testTargetTenant, testPackageID).Return(nil, testError).Once()
+		svc := appshare.NewService(repo, nil, nil, nil, nil)
+
+		// WHEN
+		_, err := svc.OwnerContextForPackage(ctx, testPackageID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while listing Application Shares for Package with ID")
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestService_OwnerContextForPackageInstanceAuth(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTargetTenant, testTargetExtTenant)
+
+	t.Run("Switches to owner when Package Instance Auth is shared", func(t *testing.T) {
+		repo := &automock.ApplicationShareRepository{}
+		repo.On("ListForPackageInstanceAuth", ctx, testTargetTenant, testPackageInstAuthID).Return([]*model.ApplicationShare{fixModelApplicationShareWithoutExternalTenant(testID)}, nil).Once()
+		svc := appshare.NewService(repo, nil, nil, nil, nil)
+
+		// WHEN
+		result, err := svc.OwnerContextForPackageInstanceAuth(ctx, testPackageInstAuthID)
+
+		// THEN
+		require.NoError(t, err)
+		tnt, err := tenant.LoadFromContext(result)
+		require.NoError(t, err)
+		assert.Equal(t, testTenant, tnt)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Error when listing shares", func(t *testing.T) {
+		repo := &automock.ApplicationShareRepository{}
+		repo.On("ListForPackageInstanceAuth", ctx, testTargetTenant, testPackageInstAuthID).Return(nil, testError).Once()
+		svc := appshare.NewService(repo, nil, nil, nil, nil)
+
+		// WHEN
+		_, err := svc.OwnerContextForPackageInstanceAuth(ctx, testPackageInstAuthID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while listing Application Shares for Package Instance Auth with ID")
+		repo.AssertExpectations(t)
+	})
+}
@@ -57,3 +57,63 @@ func (_m *ApplicationService) Get(ctx context.Context, id string) (*model.Applic
 
 	return r0, r1
 }
+
+// GetTemplateReference provides a mock function with given fields: ctx, id
+func (_m *ApplicationService) GetTemplateReference(ctx context.Context, id string) (*model.ApplicationTemplateReference, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.ApplicationTemplateReference
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ApplicationTemplateReference); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationTemplateReference)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reconcile provides a mock function with given fields: ctx, id, in, dryRun
+func (_m *ApplicationService) Reconcile(ctx context.Context, id string, in model.ApplicationRegisterInput, dryRun bool) ([]*model.ApplicationChange, error) {
+	ret := _m.Called(ctx, id, in, dryRun)
+
+	var r0 []*model.ApplicationChange
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ApplicationRegisterInput, bool) []*model.ApplicationChange); ok {
+		r0 = rf(ctx, id, in, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ApplicationChange)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.ApplicationRegisterInput, bool) error); ok {
+		r1 = rf(ctx, id, in, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetTemplateReference provides a mock function with given fields: ctx, id, ref
+func (_m *ApplicationService) SetTemplateReference(ctx context.Context, id string, ref model.ApplicationTemplateReference) error {
+	ret := _m.Called(ctx, id, ref)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ApplicationTemplateReference) error); ok {
+		r0 = rf(ctx, id, ref)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -28,6 +28,59 @@ func (_m *ApplicationTemplateConverter) ApplicationFromTemplateInputFromGraphQL(
 	return r0
 }
 
+// ApplicationFromTemplateUpgradeInputFromGraphQL provides a mock function with given fields: in
+func (_m *ApplicationTemplateConverter) ApplicationFromTemplateUpgradeInputFromGraphQL(in *graphql.ApplicationFromTemplateUpgradeInput) model.ApplicationFromTemplateInputValues {
+	ret := _m.Called(in)
+
+	var r0 model.ApplicationFromTemplateInputValues
+	if rf, ok := ret.Get(0).(func(*graphql.ApplicationFromTemplateUpgradeInput) model.ApplicationFromTemplateInputValues); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.ApplicationFromTemplateInputValues)
+		}
+	}
+
+	return r0
+}
+
+// ApplicationFromTemplateUpgradeToGraphQL provides a mock function with given fields: in
+func (_m *ApplicationTemplateConverter) ApplicationFromTemplateUpgradeToGraphQL(in *model.ApplicationFromTemplateUpgrade) *graphql.ApplicationFromTemplateUpgrade {
+	ret := _m.Called(in)
+
+	var r0 *graphql.ApplicationFromTemplateUpgrade
+	if rf, ok := ret.Get(0).(func(*model.ApplicationFromTemplateUpgrade) *graphql.ApplicationFromTemplateUpgrade); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.ApplicationFromTemplateUpgrade)
+		}
+	}
+
+	return r0
+}
+
+// ApplicationInputJSONToGraphQL provides a mock function with given fields: jsonAppInput
+func (_m *ApplicationTemplateConverter) ApplicationInputJSONToGraphQL(jsonAppInput string) (string, error) {
+	ret := _m.Called(jsonAppInput)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(jsonAppInput)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(jsonAppInput)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InputFromGraphQL provides a mock function with given fields: in
 func (_m *ApplicationTemplateConverter) InputFromGraphQL(in graphql.ApplicationTemplateInput) (model.ApplicationTemplateInput, error) {
 	ret := _m.Called(in)
@@ -49,6 +49,7 @@ func (c *converter) ToGraphQL(in *model.ApplicationTemplate) (*graphql.Applicati
 		ApplicationInput: gqlAppInput,
 		Placeholders:     c.placeholdersToGraphql(in.Placeholders),
 		AccessLevel:      graphql.ApplicationTemplateAccessLevel(in.AccessLevel),
+		Version:          in.Version,
 	}, nil
 }
 
@@ -89,18 +90,42 @@ func (c *converter) InputFromGraphQL(in graphql.ApplicationTemplateInput) (model
 }
 
 func (c *converter) ApplicationFromTemplateInputFromGraphQL(in graphql.ApplicationFromTemplateInput) model.ApplicationFromTemplateInput {
-	var values []*model.ApplicationTemplateValueInput
-	for _, value := range in.Values {
-		valueInput := model.ApplicationTemplateValueInput{
-			Placeholder: value.Placeholder,
-			Value:       value.Value,
+	return model.ApplicationFromTemplateInput{
+		TemplateName: in.TemplateName,
+		Values:       c.valuesFromGraphql(in.Values),
+	}
+}
+
+func (c *converter) ApplicationFromTemplateUpgradeInputFromGraphQL(in *graphql.ApplicationFromTemplateUpgradeInput) model.ApplicationFromTemplateInputValues {
+	if in == nil {
+		return nil
+	}
+
+	return c.valuesFromGraphql(in.Values)
+}
+
+func (c *converter) ApplicationFromTemplateUpgradeToGraphQL(in *model.ApplicationFromTemplateUpgrade) *graphql.ApplicationFromTemplateUpgrade {
+	if in == nil {
+		return nil
+	}
+
+	changes := make([]*graphql.ApplicationChange, 0, len(in.Changes))
+	for _, change := range in.Changes {
+		if change == nil {
+			continue
 		}
-		values = append(values, &valueInput)
+
+		changes = append(changes, &graphql.ApplicationChange{
+			Resource:  graphql.ApplicationChangeResource(change.Resource),
+			Operation: graphql.ApplicationChangeOperation(change.Operation),
+			Key:       change.Key,
+		})
 	}
 
-	return model.ApplicationFromTemplateInput{
-		TemplateName: in.TemplateName,
-		Values:       values,
+	return &graphql.ApplicationFromTemplateUpgrade{
+		FromVersion: in.FromVersion,
+		ToVersion:   in.ToVersion,
+		Changes:     changes,
 	}
 }
 
@@ -121,6 +146,8 @@ func (c *converter) ToEntity(in *model.ApplicationTemplate) (*Entity, error) {
 		ApplicationInputJSON: in.ApplicationInputJSON,
 		PlaceholdersJSON:     placeholders,
 		AccessLevel:          string(in.AccessLevel),
+		TenantID:             repo.NewNullableString(in.TenantID),
+		Version:              in.Version,
 	}, nil
 }
 
@@ -141,9 +168,16 @@ func (c *converter) FromEntity(entity *Entity) (*model.ApplicationTemplate, erro
 		ApplicationInputJSON: entity.ApplicationInputJSON,
 		Placeholders:         placeholders,
 		AccessLevel:          model.ApplicationTemplateAccessLevel(entity.AccessLevel),
+		TenantID:             repo.StringPtrFromNullableString(entity.TenantID),
+		Version:              entity.Version,
 	}, nil
 }
 
+// ApplicationInputJSONToGraphQL renders the Application input JSON as GraphQL ApplicationRegisterInput
+func (c *converter) ApplicationInputJSONToGraphQL(jsonAppInput string) (string, error) {
+	return c.graphqliseApplicationCreateInput(jsonAppInput)
+}
+
 func (c *converter) graphqliseApplicationCreateInput(jsonAppInput string) (string, error) {
 	var gqlAppCreateInput graphql.ApplicationRegisterInput
 	err := json.Unmarshal([]byte(jsonAppInput), &gqlAppCreateInput)
@@ -161,6 +195,19 @@ func (c *converter) graphqliseApplicationCreateInput(jsonAppInput string) (strin
 	return gqlAppInput, nil
 }
 
+func (c *converter) valuesFromGraphql(in []*graphql.TemplateValueInput) model.ApplicationFromTemplateInputValues {
+	var values model.ApplicationFromTemplateInputValues
+	for _, value := range in {
+		valueInput := model.ApplicationTemplateValueInput{
+			Placeholder: value.Placeholder,
+			Value:       value.Value,
+		}
+		values = append(values, &valueInput)
+	}
+
+	return values
+}
+
 func (c *converter) placeholdersJSONToModel(in sql.NullString) ([]model.ApplicationTemplatePlaceholder, error) {
 	if !in.Valid || in.String == "" {
 		return nil, nil
@@ -194,8 +241,21 @@ func (c *converter) placeholdersFromGraphql(in []*graphql.PlaceholderDefinitionI
 	var placeholders []model.ApplicationTemplatePlaceholder
 	for _, p := range in {
 		np := model.ApplicationTemplatePlaceholder{
-			Name:        p.Name,
-			Description: p.Description,
+			Name:          p.Name,
+			Description:   p.Description,
+			Type:          model.StringApplicationTemplatePlaceholderType,
+			DefaultValue:  p.DefaultValue,
+			AllowedValues: p.AllowedValues,
+		}
+		if p.Type != nil {
+			np.Type = model.ApplicationTemplatePlaceholderType(*p.Type)
+		}
+		if p.Optional != nil {
+			np.Optional = *p.Optional
+		}
+		if p.JSONSchema != nil {
+			schema := string(*p.JSONSchema)
+			np.JSONSchema = &schema
 		}
 		placeholders = append(placeholders, np)
 	}
@@ -206,8 +266,16 @@ func (c *converter) placeholdersToGraphql(in []model.ApplicationTemplatePlacehol
 	var placeholders []*graphql.PlaceholderDefinition
 	for _, p := range in {
 		np := graphql.PlaceholderDefinition{
-			Name:        p.Name,
-			Description: p.Description,
+			Name:          p.Name,
+			Description:   p.Description,
+			Type:          graphql.PlaceholderType(p.ValueType()),
+			Optional:      p.Optional,
+			DefaultValue:  p.DefaultValue,
+			AllowedValues: p.AllowedValues,
+		}
+		if p.JSONSchema != nil {
+			schema := graphql.JSONSchema(*p.JSONSchema)
+			np.JSONSchema = &schema
 		}
 		placeholders = append(placeholders, &np)
 	}
@@ -218,6 +218,56 @@ func TestConverter_ApplicationFromTemplateInputFromGraphQL(t *testing.T) {
 	assert.Equal(t, expected, result)
 }
 
+func TestConverter_ApplicationFromTemplateUpgradeInputFromGraphQL(t *testing.T) {
+	// GIVEN
+	conv := apptemplate.NewConverter(nil)
+
+	t.Run("All properties given", func(t *testing.T) {
+		// WHEN
+		result := conv.ApplicationFromTemplateUpgradeInputFromGraphQL(fixGQLApplicationFromTemplateUpgradeInput())
+
+		// THEN
+		assert.Equal(t, fixModelApplicationFromTemplateUpgradeValues(), result)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		// WHEN
+		result := conv.ApplicationFromTemplateUpgradeInputFromGraphQL(nil)
+
+		// THEN
+		assert.Nil(t, result)
+	})
+}
+
+func TestConverter_ApplicationFromTemplateUpgradeToGraphQL(t *testing.T) {
+	// GIVEN
+	conv := apptemplate.NewConverter(nil)
+
+	t.Run("All properties given", func(t *testing.T) {
+		// WHEN
+		result := conv.ApplicationFromTemplateUpgradeToGraphQL(fixModelApplicationFromTemplateUpgrade(1, 2))
+
+		// THEN
+		assert.Equal(t, fixGQLApplicationFromTemplateUpgrade(1, 2), result)
+	})
+
+	t.Run("No changes", func(t *testing.T) {
+		// WHEN
+		result := conv.ApplicationFromTemplateUpgradeToGraphQL(&model.ApplicationFromTemplateUpgrade{FromVersion: 2, ToVersion: 2})
+
+		// THEN
+		assert.Equal(t, &graphql.ApplicationFromTemplateUpgrade{FromVersion: 2, ToVersion: 2, Changes: []*graphql.ApplicationChange{}}, result)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		// WHEN
+		result := conv.ApplicationFromTemplateUpgradeToGraphQL(nil)
+
+		// THEN
+		assert.Nil(t, result)
+	})
+}
+
 func TestConverter_ToEntity(t *testing.T) {
 	// given
 	appTemplateModel := fixModelAppTemplate(testID, testName)
@@ -11,6 +11,8 @@ type Entity struct {
 	ApplicationInputJSON string         `db:"application_input"`
 	PlaceholdersJSON     sql.NullString `db:"placeholders"`
 	AccessLevel          string         `db:"access_level"`
+	TenantID             sql.NullString `db:"tenant_id"`
+	Version              int            `db:"version"`
 }
 
 type EntityCollection []Entity
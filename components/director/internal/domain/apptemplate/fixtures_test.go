@@ -1,6 +1,7 @@
 package apptemplate_test
 
 import (
+	"context"
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
@@ -8,24 +9,31 @@ import (
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
 )
 
 const (
-	testTenant         = "tnt"
-	testExternalTenant = "external-tnt"
-	testID             = "foo"
-	testName           = "bar"
-	testPageSize       = 3
-	testCursor         = ""
-	appInputJSONString = `{"Name":"foo","ProviderName":"compass","Description":"Lorem ipsum","Labels":{"test":["val","val2"]},"HealthCheckURL":"https://foo.bar","Webhooks":[{"Type":"","URL":"webhook1.foo.bar","Auth":null},{"Type":"","URL":"webhook2.foo.bar","Auth":null}],"IntegrationSystemID":"iiiiiiiii-iiii-iiii-iiii-iiiiiiiiiiii"}`
-	appInputGQLString  = `{name: "foo",providerName: "compass",description: "Lorem ipsum",labels: {test:["val","val2"],},webhooks: [ {type: ,url: "webhook1.foo.bar",}, {type: ,url: "webhook2.foo.bar",} ],healthCheckURL: "https://foo.bar",integrationSystemID: "iiiiiiiii-iiii-iiii-iiii-iiiiiiiiiiii",}`
+	testTenant                = "tnt"
+	testExternalTenant        = "external-tnt"
+	testID                    = "foo"
+	testName                  = "bar"
+	testPageSize              = 3
+	testGlobalWriteScope      = "application_template:write"
+	testTenantWriteScope      = "application_template:tenant_write"
+	testPlaceholderJSONSchema = `{"type": "string", "maxLength": 2}`
+	testCursor                = ""
+	appInputJSONString        = `{"Name":"foo","ProviderName":"compass","Description":"Lorem ipsum","Labels":{"test":["val","val2"]},"HealthCheckURL":"https://foo.bar","Webhooks":[{"Type":"","URL":"webhook1.foo.bar","Auth":null},{"Type":"","URL":"webhook2.foo.bar","Auth":null}],"IntegrationSystemID":"iiiiiiiii-iiii-iiii-iiii-iiiiiiiiiiii"}`
+	appInputGQLString         = `{name: "foo",providerName: "compass",description: "Lorem ipsum",labels: {test:["val","val2"],},webhooks: [ {type: ,url: "webhook1.foo.bar",}, {type: ,url: "webhook2.foo.bar",} ],healthCheckURL: "https://foo.bar",integrationSystemID: "iiiiiiiii-iiii-iiii-iiii-iiiiiiiiiiii",}`
 )
 
 var (
@@ -33,7 +41,7 @@ var (
 	testProviderName = "provider-display-name"
 	testURL          = "http://valid.url"
 	testError        = errors.New("test error")
-	testTableColumns = []string{"id", "name", "description", "application_input", "placeholders", "access_level"}
+	testTableColumns = []string{"id", "name", "description", "application_input", "placeholders", "access_level", "tenant_id", "version"}
 )
 
 func fixModelAppTemplate(id, name string) *model.ApplicationTemplate {
@@ -45,6 +53,7 @@ func fixModelAppTemplate(id, name string) *model.ApplicationTemplate {
 		ApplicationInputJSON: appInputJSONString,
 		Placeholders:         fixModelPlaceholders(),
 		AccessLevel:          model.GlobalApplicationTemplateAccessLevel,
+		Version:              1,
 	}
 
 	return &out
@@ -67,6 +76,7 @@ func fixGQLAppTemplate(id, name string) *graphql.ApplicationTemplate {
 		ApplicationInput: appInputGQLString,
 		Placeholders:     fixGQLPlaceholders(),
 		AccessLevel:      graphql.ApplicationTemplateAccessLevelGlobal,
+		Version:          1,
 	}
 }
 
@@ -135,6 +145,7 @@ func fixEntityAppTemplate(t *testing.T, id, name string) *apptemplate.Entity {
 		ApplicationInputJSON: marshalledAppInput,
 		PlaceholdersJSON:     repo.NewValidNullableString(string(marshalledPlaceholders)),
 		AccessLevel:          string(model.GlobalApplicationTemplateAccessLevel),
+		Version:              1,
 	}
 }
 
@@ -144,26 +155,59 @@ func fixModelPlaceholders() []model.ApplicationTemplatePlaceholder {
 		{
 			Name:        "test",
 			Description: &placeholderDesc,
+			Type:        model.StringApplicationTemplatePlaceholderType,
+		},
+		{
+			Name:          "region",
+			Description:   &placeholderDesc,
+			Type:          model.EnumApplicationTemplatePlaceholderType,
+			Optional:      true,
+			DefaultValue:  str.Ptr("eu"),
+			AllowedValues: []string{"eu", "us"},
+			JSONSchema:    str.Ptr(testPlaceholderJSONSchema),
 		},
 	}
 }
 
 func fixGQLPlaceholderDefinitionInput() []*graphql.PlaceholderDefinitionInput {
 	placeholderDesc := testDescription
+	enumType := graphql.PlaceholderTypeEnum
+	optional := true
+	schema := graphql.JSONSchema(testPlaceholderJSONSchema)
 	return []*graphql.PlaceholderDefinitionInput{
 		{
 			Name:        "test",
 			Description: &placeholderDesc,
 		},
+		{
+			Name:          "region",
+			Description:   &placeholderDesc,
+			Type:          &enumType,
+			Optional:      &optional,
+			DefaultValue:  str.Ptr("eu"),
+			AllowedValues: []string{"eu", "us"},
+			JSONSchema:    &schema,
+		},
 	}
 }
 
 func fixGQLPlaceholders() []*graphql.PlaceholderDefinition {
 	placeholderDesc := testDescription
+	schema := graphql.JSONSchema(testPlaceholderJSONSchema)
 	return []*graphql.PlaceholderDefinition{
 		{
 			Name:        "test",
 			Description: &placeholderDesc,
+			Type:        graphql.PlaceholderTypeString,
+		},
+		{
+			Name:          "region",
+			Description:   &placeholderDesc,
+			Type:          graphql.PlaceholderTypeEnum,
+			Optional:      true,
+			DefaultValue:  str.Ptr("eu"),
+			AllowedValues: []string{"eu", "us"},
+			JSONSchema:    &schema,
 		},
 	}
 }
@@ -188,14 +232,58 @@ func fixModelApplicationFromTemplateInput(name string) model.ApplicationFromTemp
 	}
 }
 
+func fixModelTemplateReference(templateID string, version int, values model.ApplicationFromTemplateInputValues) model.ApplicationTemplateReference {
+	return model.ApplicationTemplateReference{
+		ApplicationTemplateID: &templateID,
+		Version:               version,
+		Values:                values,
+	}
+}
+
+func fixGQLApplicationFromTemplateUpgradeInput() *graphql.ApplicationFromTemplateUpgradeInput {
+	return &graphql.ApplicationFromTemplateUpgradeInput{
+		Values: []*graphql.TemplateValueInput{
+			{Placeholder: "c", Value: "e"},
+		},
+	}
+}
+
+func fixModelApplicationFromTemplateUpgradeValues() model.ApplicationFromTemplateInputValues {
+	return model.ApplicationFromTemplateInputValues{
+		{Placeholder: "c", Value: "e"},
+	}
+}
+
+func fixModelApplicationFromTemplateUpgrade(fromVersion, toVersion int) *model.ApplicationFromTemplateUpgrade {
+	return &model.ApplicationFromTemplateUpgrade{
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Changes: []*model.ApplicationChange{
+			{Resource: model.ApplicationChangeResourcePackage, Operation: model.ApplicationChangeOperationCreate, Key: "foo"},
+			{Resource: model.ApplicationChangeResourceLabel, Operation: model.ApplicationChangeOperationUpdate, Key: "test"},
+		},
+	}
+}
+
+func fixGQLApplicationFromTemplateUpgrade(fromVersion, toVersion int) *graphql.ApplicationFromTemplateUpgrade {
+	return &graphql.ApplicationFromTemplateUpgrade{
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Changes: []*graphql.ApplicationChange{
+			{Resource: graphql.ApplicationChangeResourcePackage, Operation: graphql.ApplicationChangeOperationCreate, Key: "foo"},
+			{Resource: graphql.ApplicationChangeResourceLabel, Operation: graphql.ApplicationChangeOperationUpdate, Key: "test"},
+		},
+	}
+}
+
 func fixAppTemplateCreateArgs(entity apptemplate.Entity) []driver.Value {
-	return []driver.Value{entity.ID, entity.Name, entity.Description, entity.ApplicationInputJSON, entity.PlaceholdersJSON, entity.AccessLevel}
+	return []driver.Value{entity.ID, entity.Name, entity.Description, entity.ApplicationInputJSON, entity.PlaceholdersJSON, entity.AccessLevel, entity.TenantID, entity.Version}
 }
 
 func fixSQLRows(entities []apptemplate.Entity) *sqlmock.Rows {
 	out := sqlmock.NewRows(testTableColumns)
 	for _, entity := range entities {
-		out.AddRow(entity.ID, entity.Name, entity.Description, entity.ApplicationInputJSON, entity.PlaceholdersJSON, entity.AccessLevel)
+		out.AddRow(entity.ID, entity.Name, entity.Description, entity.ApplicationInputJSON, entity.PlaceholdersJSON, entity.AccessLevel, entity.TenantID, entity.Version)
 	}
 	return out
 }
@@ -239,3 +327,30 @@ func fixGQLApplication(id, name string) graphql.Application {
 		HealthCheckURL: &testURL,
 	}
 }
+
+func fixModelTenantAppTemplate(id, name, tenantID string) *model.ApplicationTemplate {
+	out := fixModelAppTemplate(id, name)
+	out.AccessLevel = model.TenantApplicationTemplateAccessLevel
+	out.TenantID = &tenantID
+
+	return out
+}
+
+func fixModelTenantAppTemplateInput(name string, appInputString string) *model.ApplicationTemplateInput {
+	out := fixModelAppTemplateInput(name, appInputString)
+	out.AccessLevel = model.TenantApplicationTemplateAccessLevel
+
+	return out
+}
+
+func fixCtxWithTenantAndScopes(tenantID string, scopes ...string) context.Context {
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, testExternalTenant)
+	return scope.SaveToContext(ctx, scopes)
+}
+
+func fixScopesGetter() *automock.ScopesGetter {
+	scopesGetter := &automock.ScopesGetter{}
+	scopesGetter.On("GetRequiredScopes", "applicationTemplateAccessLevelScopes.global").Return([]string{testGlobalWriteScope, testTenantWriteScope}, nil).Maybe()
+	scopesGetter.On("GetRequiredScopes", "applicationTemplateAccessLevelScopes.tenant").Return([]string{testTenantWriteScope}, nil).Maybe()
+	return scopesGetter
+}
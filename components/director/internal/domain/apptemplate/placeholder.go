@@ -0,0 +1,185 @@
+package apptemplate
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/jsonschema"
+	"github.com/pkg/errors"
+)
+
+var placeholderRegex = regexp.MustCompile(`{{([^{}]+)}}`)
+
+type placeholderValue struct {
+	text  string
+	typed interface{}
+}
+
+// resolvePlaceholderValue returns the value for the given placeholder, falling back to its default value for missing values
+func resolvePlaceholderValue(placeholder model.ApplicationTemplatePlaceholder, values model.ApplicationFromTemplateInputValues) (placeholderValue, error) {
+	raw, err := values.FindPlaceholderValue(placeholder.Name)
+	if err != nil {
+		switch {
+		case placeholder.DefaultValue != nil:
+			raw = *placeholder.DefaultValue
+		case placeholder.Optional:
+			return placeholderValue{}, nil
+		default:
+			return placeholderValue{}, errors.Wrap(err, "required placeholder not provided")
+		}
+	}
+
+	typed, err := parsePlaceholderValue(placeholder, raw)
+	if err != nil {
+		return placeholderValue{}, err
+	}
+
+	return placeholderValue{text: raw, typed: typed}, nil
+}
+
+// parsePlaceholderValue converts the raw value to the placeholder type and validates it against the placeholder JSON schema
+func parsePlaceholderValue(placeholder model.ApplicationTemplatePlaceholder, raw string) (interface{}, error) {
+	var typed interface{}
+	switch placeholder.ValueType() {
+	case model.StringApplicationTemplatePlaceholderType:
+		typed = raw
+	case model.URLApplicationTemplatePlaceholderType:
+		u, err := url.ParseRequestURI(raw)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, apperrors.NewInvalidDataError("value for placeholder %s must be a valid URL", placeholder.Name)
+		}
+		typed = raw
+	case model.EnumApplicationTemplatePlaceholderType:
+		if !contains(placeholder.AllowedValues, raw) {
+			return nil, apperrors.NewInvalidDataError("value for placeholder %s must be one of: %s", placeholder.Name, strings.Join(placeholder.AllowedValues, ", "))
+		}
+		typed = raw
+	case model.BooleanApplicationTemplatePlaceholderType:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, apperrors.NewInvalidDataError("value for placeholder %s must be a boolean", placeholder.Name)
+		}
+		typed = b
+	case model.NumberApplicationTemplatePlaceholderType:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, apperrors.NewInvalidDataError("value for placeholder %s must be a number", placeholder.Name)
+		}
+		typed = f
+	default:
+		return nil, apperrors.NewInvalidDataError("unsupported type %s of placeholder %s", placeholder.Type, placeholder.Name)
+	}
+
+	if placeholder.JSONSchema == nil {
+		return typed, nil
+	}
+
+	validator, err := jsonschema.NewValidatorFromStringSchema(*placeholder.JSONSchema)
+	if err != nil {
+		return nil, apperrors.NewInvalidDataError("invalid JSON schema of placeholder %s: %s", placeholder.Name, err.Error())
+	}
+
+	result, err := validator.ValidateRaw(typed)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while validating value for placeholder %s", placeholder.Name)
+	}
+	if !result.Valid {
+		return nil, apperrors.NewInvalidDataError("value for placeholder %s does not match JSON schema: %s", placeholder.Name, result.Error.Error())
+	}
+
+	return typed, nil
+}
+
+// validatePlaceholders ensures that the placeholder definitions can be used for rendering Application input
+func validatePlaceholders(placeholders []model.ApplicationTemplatePlaceholder) error {
+	for _, placeholder := range placeholders {
+		if placeholder.DefaultValue != nil {
+			if _, err := parsePlaceholderValue(placeholder, *placeholder.DefaultValue); err != nil {
+				return errors.Wrapf(err, "while validating default value of placeholder %s", placeholder.Name)
+			}
+		}
+		if placeholder.JSONSchema != nil {
+			if _, err := jsonschema.NewValidatorFromStringSchema(*placeholder.JSONSchema); err != nil {
+				return apperrors.NewInvalidDataError("invalid JSON schema of placeholder %s: %s", placeholder.Name, err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+// substitutePlaceholders replaces placeholders in string values and keys of the given JSON document.
+//
+// A string consisting of a single placeholder only is replaced with the typed value, so that for example
+// boolean and number placeholders render as JSON booleans and numbers. Placeholders embedded in longer
+// strings are replaced with the text of the value. The document is re-encoded afterwards, so values
+// containing quotes or braces cannot break out of the string they are substituted into.
+func substitutePlaceholders(appInputJSON string, values map[string]placeholderValue) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(appInputJSON))
+	decoder.UseNumber()
+
+	var appInput interface{}
+	if err := decoder.Decode(&appInput); err != nil {
+		return "", errors.Wrap(err, "while unmarshalling application input JSON")
+	}
+
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(substituteInValue(appInput, values)); err != nil {
+		return "", errors.Wrap(err, "while marshalling application input JSON")
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func substituteInValue(in interface{}, values map[string]placeholderValue) interface{} {
+	switch v := in.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			out[substituteInString(key, values)] = substituteInValue(value, values)
+		}
+		return out
+	case []interface{}:
+		for i := range v {
+			v[i] = substituteInValue(v[i], values)
+		}
+		return v
+	case string:
+		if match := placeholderRegex.FindStringSubmatch(v); match != nil && match[0] == v {
+			if value, ok := values[match[1]]; ok {
+				return value.typed
+			}
+		}
+		return substituteInString(v, values)
+	default:
+		return v
+	}
+}
+
+func substituteInString(in string, values map[string]placeholderValue) string {
+	return placeholderRegex.ReplaceAllStringFunc(in, func(match string) string {
+		value, ok := values[strings.TrimSuffix(strings.TrimPrefix(match, "{{"), "}}")]
+		if !ok {
+			return match
+		}
+		return value.text
+	})
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
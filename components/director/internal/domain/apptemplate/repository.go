@@ -15,7 +15,7 @@ import (
 const tableName string = `public.app_templates`
 
 var (
-	updatableTableColumns = []string{"name", "description", "application_input", "placeholders", "access_level"}
+	updatableTableColumns = []string{"name", "description", "application_input", "placeholders", "access_level", "tenant_id", "version"}
 	idTableColumns        = []string{"id"}
 	tableColumns          = append(idTableColumns, updatableTableColumns...)
 )
@@ -59,9 +59,11 @@ func (r *repository) Create(ctx context.Context, item model.ApplicationTemplate)
 	return r.creator.Create(ctx, entity)
 }
 
-func (r *repository) Get(ctx context.Context, id string) (*model.ApplicationTemplate, error) {
+func (r *repository) Get(ctx context.Context, tenant, id string) (*model.ApplicationTemplate, error) {
+	conditions := repo.Conditions{repo.NewEqualCondition("id", id), visibleForTenantCondition(tenant)}
+
 	var entity Entity
-	if err := r.singleGetterGlobal.GetGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &entity); err != nil {
+	if err := r.singleGetterGlobal.GetGlobal(ctx, conditions, repo.NoOrderBy, &entity); err != nil {
 		return nil, err
 	}
 
@@ -73,9 +75,15 @@ func (r *repository) Get(ctx context.Context, id string) (*model.ApplicationTemp
 	return result, nil
 }
 
-func (r *repository) GetByName(ctx context.Context, name string) (*model.ApplicationTemplate, error) {
+// GetByName returns the Application Template with the given name visible for the tenant.
+// A tenant's own template takes precedence over a global one with the same name.
+func (r *repository) GetByName(ctx context.Context, tenant, name string) (*model.ApplicationTemplate, error) {
+	conditions := repo.Conditions{repo.NewEqualCondition("name", name), visibleForTenantCondition(tenant)}
+	// NULL values are sorted last in ascending order, so the tenant's template comes first
+	orderBy := repo.OrderByParams{repo.NewAscOrderBy("tenant_id")}
+
 	var entity Entity
-	if err := r.singleGetterGlobal.GetGlobal(ctx, repo.Conditions{repo.NewEqualCondition("name", name)}, repo.NoOrderBy, &entity); err != nil {
+	if err := r.singleGetterGlobal.GetGlobal(ctx, conditions, orderBy, &entity); err != nil {
 		return nil, err
 	}
 
@@ -87,13 +95,13 @@ func (r *repository) GetByName(ctx context.Context, name string) (*model.Applica
 	return result, nil
 }
 
-func (r *repository) Exists(ctx context.Context, id string) (bool, error) {
-	return r.existQuerierGlobal.ExistsGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
+func (r *repository) Exists(ctx context.Context, tenant, id string) (bool, error) {
+	return r.existQuerierGlobal.ExistsGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id), visibleForTenantCondition(tenant)})
 }
 
-func (r *repository) List(ctx context.Context, pageSize int, cursor string) (model.ApplicationTemplatePage, error) {
+func (r *repository) List(ctx context.Context, tenant string, pageSize int, cursor string) (model.ApplicationTemplatePage, error) {
 	var entityCollection EntityCollection
-	page, totalCount, err := r.pageableQuerierGlobal.ListGlobal(ctx, pageSize, cursor, "id", &entityCollection)
+	page, totalCount, err := r.pageableQuerierGlobal.ListGlobal(ctx, pageSize, cursor, "id", &entityCollection, visibleForTenantCondition(tenant))
 	if err != nil {
 		return model.ApplicationTemplatePage{}, err
 	}
@@ -124,6 +132,16 @@ func (r *repository) Update(ctx context.Context, model model.ApplicationTemplate
 	return r.updaterGlobal.UpdateSingleGlobal(ctx, entity)
 }
 
-func (r *repository) Delete(ctx context.Context, id string) error {
-	return r.deleterGlobal.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
+func (r *repository) Delete(ctx context.Context, tenant, id string) error {
+	return r.deleterGlobal.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id), visibleForTenantCondition(tenant)})
+}
+
+// visibleForTenantCondition matches global Application Templates and the ones owned by the tenant.
+// When the tenant is empty, only global Application Templates are matched.
+func visibleForTenantCondition(tenant string) repo.Condition {
+	if tenant == "" {
+		return repo.NewNullCondition("tenant_id")
+	}
+
+	return repo.NewNullOrEqualCondition("tenant_id", tenant)
 }
@@ -28,7 +28,7 @@ func TestRepository_Create(t *testing.T) {
 		mockConverter.On("ToEntity", appTemplateModel).Return(appTemplateEntity, nil).Once()
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.app_templates ( id, name, description, application_input, placeholders, access_level ) VALUES ( ?, ?, ?, ?, ?, ? )`)).
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.app_templates ( id, name, description, application_input, placeholders, access_level, tenant_id, version ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )`)).
 			WithArgs(fixAppTemplateCreateArgs(*appTemplateEntity)...).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
@@ -70,7 +70,7 @@ func TestRepository_Create(t *testing.T) {
 		mockConverter.On("ToEntity", appTemplateModel).Return(appTemplateEntity, nil).Once()
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.app_templates ( id, name, description, application_input, placeholders, access_level ) VALUES ( ?, ?, ?, ?, ?, ? )`)).
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.app_templates ( id, name, description, application_input, placeholders, access_level, tenant_id, version ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )`)).
 			WithArgs(fixAppTemplateCreateArgs(*appTemplateEntity)...).
 			WillReturnError(testError)
 
@@ -99,15 +99,15 @@ func TestRepository_Get(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		rowsToReturn := fixSQLRows([]apptemplate.Entity{*appTemplateEntity})
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level FROM public.app_templates WHERE id = $1`)).
-			WithArgs(testID).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE id = $1 AND (tenant_id IS NULL OR tenant_id = $2)`)).
+			WithArgs(testID, testTenant).
 			WillReturnRows(rowsToReturn)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		result, err := appTemplateRepo.Get(ctx, testID)
+		result, err := appTemplateRepo.Get(ctx, testTenant, testID)
 
 		// THEN
 		require.NoError(t, err)
@@ -121,15 +121,15 @@ func TestRepository_Get(t *testing.T) {
 		defer mockConverter.AssertExpectations(t)
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level FROM public.app_templates WHERE id = $1`)).
-			WithArgs(testID).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE id = $1 AND (tenant_id IS NULL OR tenant_id = $2)`)).
+			WithArgs(testID, testTenant).
 			WillReturnError(testError)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		_, err := appTemplateRepo.Get(ctx, testID)
+		_, err := appTemplateRepo.Get(ctx, testTenant, testID)
 
 		// THEN
 		require.Error(t, err)
@@ -147,15 +147,15 @@ func TestRepository_Get(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		rowsToReturn := fixSQLRows([]apptemplate.Entity{*appTemplateEntity})
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level FROM public.app_templates WHERE id = $1`)).
-			WithArgs(testID).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE id = $1 AND (tenant_id IS NULL OR tenant_id = $2)`)).
+			WithArgs(testID, testTenant).
 			WillReturnRows(rowsToReturn)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		_, err := appTemplateRepo.Get(ctx, testID)
+		_, err := appTemplateRepo.Get(ctx, testTenant, testID)
 
 		// THEN
 		require.Error(t, err)
@@ -176,15 +176,15 @@ func TestRepository_GetByName(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		rowsToReturn := fixSQLRows([]apptemplate.Entity{*appTemplateEntity})
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level FROM public.app_templates WHERE name = $1`)).
-			WithArgs(testName).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE name = $1 AND (tenant_id IS NULL OR tenant_id = $2) ORDER BY tenant_id ASC`)).
+			WithArgs(testName, testTenant).
 			WillReturnRows(rowsToReturn)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		result, err := appTemplateRepo.GetByName(ctx, testName)
+		result, err := appTemplateRepo.GetByName(ctx, testTenant, testName)
 
 		// THEN
 		require.NoError(t, err)
@@ -198,15 +198,15 @@ func TestRepository_GetByName(t *testing.T) {
 		defer mockConverter.AssertExpectations(t)
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level FROM public.app_templates WHERE name = $1`)).
-			WithArgs(testName).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE name = $1 AND (tenant_id IS NULL OR tenant_id = $2) ORDER BY tenant_id ASC`)).
+			WithArgs(testName, testTenant).
 			WillReturnError(testError)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		_, err := appTemplateRepo.GetByName(ctx, testName)
+		_, err := appTemplateRepo.GetByName(ctx, testTenant, testName)
 
 		// THEN
 		require.Error(t, err)
@@ -224,15 +224,15 @@ func TestRepository_GetByName(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		rowsToReturn := fixSQLRows([]apptemplate.Entity{*appTemplateEntity})
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level FROM public.app_templates WHERE name = $1`)).
-			WithArgs(testName).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE name = $1 AND (tenant_id IS NULL OR tenant_id = $2) ORDER BY tenant_id ASC`)).
+			WithArgs(testName, testTenant).
 			WillReturnRows(rowsToReturn)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		_, err := appTemplateRepo.GetByName(ctx, testName)
+		_, err := appTemplateRepo.GetByName(ctx, testTenant, testName)
 
 		// THEN
 		require.Error(t, err)
@@ -240,20 +240,47 @@ func TestRepository_GetByName(t *testing.T) {
 	})
 }
 
+func TestRepository_GetByName_WithoutTenant(t *testing.T) {
+	// GIVEN
+	appTemplateModel := fixModelAppTemplate(testID, testName)
+	appTemplateEntity := fixEntityAppTemplate(t, testID, testName)
+
+	mockConverter := &automock.EntityConverter{}
+	defer mockConverter.AssertExpectations(t)
+	mockConverter.On("FromEntity", appTemplateEntity).Return(appTemplateModel, nil).Once()
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+
+	rowsToReturn := fixSQLRows([]apptemplate.Entity{*appTemplateEntity})
+	dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE name = $1 AND tenant_id IS NULL ORDER BY tenant_id ASC`)).
+		WithArgs(testName).
+		WillReturnRows(rowsToReturn)
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	appTemplateRepo := apptemplate.NewRepository(mockConverter)
+
+	// WHEN
+	result, err := appTemplateRepo.GetByName(ctx, "", testName)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, appTemplateModel, result)
+}
+
 func TestRepository_Exists(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		// GIVEN
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT 1 FROM public.app_templates WHERE id = $1`)).
-			WithArgs(testID).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT 1 FROM public.app_templates WHERE id = $1 AND (tenant_id IS NULL OR tenant_id = $2)`)).
+			WithArgs(testID, testTenant).
 			WillReturnRows(testdb.RowWhenObjectExist())
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(nil)
 
 		// WHEN
-		result, err := appTemplateRepo.Exists(ctx, testID)
+		result, err := appTemplateRepo.Exists(ctx, testTenant, testID)
 
 		// THEN
 		require.NoError(t, err)
@@ -265,15 +292,15 @@ func TestRepository_Exists(t *testing.T) {
 		// GIVEN
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT 1 FROM public.app_templates WHERE id = $1`)).
-			WithArgs(testID).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT 1 FROM public.app_templates WHERE id = $1 AND (tenant_id IS NULL OR tenant_id = $2)`)).
+			WithArgs(testID, testTenant).
 			WillReturnError(testError)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(nil)
 
 		// WHEN
-		result, err := appTemplateRepo.Exists(ctx, testID)
+		result, err := appTemplateRepo.Exists(ctx, testTenant, testID)
 
 		// THEN
 		require.Error(t, err)
@@ -307,16 +334,18 @@ func TestRepository_List(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		rowsToReturn := fixSQLRows(appTemplateEntities)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level FROM public.app_templates ORDER BY id LIMIT 3 OFFSET 0`)).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE (tenant_id IS NULL OR tenant_id = $1) ORDER BY id LIMIT 3 OFFSET 0`)).
+			WithArgs(testTenant).
 			WillReturnRows(rowsToReturn)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.app_templates`)).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.app_templates WHERE (tenant_id IS NULL OR tenant_id = $1)`)).
+			WithArgs(testTenant).
 			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		result, err := appTemplateRepo.List(ctx, testPageSize, testCursor)
+		result, err := appTemplateRepo.List(ctx, testTenant, testPageSize, testCursor)
 
 		// THEN
 		require.NoError(t, err)
@@ -338,16 +367,18 @@ func TestRepository_List(t *testing.T) {
 		defer dbMock.AssertExpectations(t)
 
 		rowsToReturn := fixSQLRows(appTemplateEntities)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level FROM public.app_templates ORDER BY id LIMIT 3 OFFSET 0`)).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE (tenant_id IS NULL OR tenant_id = $1) ORDER BY id LIMIT 3 OFFSET 0`)).
+			WithArgs(testTenant).
 			WillReturnRows(rowsToReturn)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.app_templates`)).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FROM public.app_templates WHERE (tenant_id IS NULL OR tenant_id = $1)`)).
+			WithArgs(testTenant).
 			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		_, err := appTemplateRepo.List(ctx, testPageSize, testCursor)
+		_, err := appTemplateRepo.List(ctx, testTenant, testPageSize, testCursor)
 
 		// THEN
 		require.Error(t, err)
@@ -360,14 +391,15 @@ func TestRepository_List(t *testing.T) {
 		defer mockConverter.AssertExpectations(t)
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level FROM public.app_templates ORDER BY id LIMIT 3 OFFSET 0`)).
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, application_input, placeholders, access_level, tenant_id, version FROM public.app_templates WHERE (tenant_id IS NULL OR tenant_id = $1) ORDER BY id LIMIT 3 OFFSET 0`)).
+			WithArgs(testTenant).
 			WillReturnError(testError)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(mockConverter)
 
 		// WHEN
-		_, err := appTemplateRepo.List(ctx, testPageSize, testCursor)
+		_, err := appTemplateRepo.List(ctx, testTenant, testPageSize, testCursor)
 
 		// THEN
 		require.Error(t, err)
@@ -386,8 +418,8 @@ func TestRepository_Update(t *testing.T) {
 		mockConverter.On("ToEntity", appTemplateModel).Return(appTemplateEntity, nil).Once()
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.app_templates SET name = ?, description = ?, application_input = ?, placeholders = ?, access_level = ? WHERE id = ?`)).
-			WithArgs(appTemplateEntity.Name, appTemplateEntity.Description, appTemplateEntity.ApplicationInputJSON, appTemplateEntity.PlaceholdersJSON, appTemplateEntity.AccessLevel, appTemplateEntity.ID).
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.app_templates SET name = ?, description = ?, application_input = ?, placeholders = ?, access_level = ?, tenant_id = ?, version = ? WHERE id = ?`)).
+			WithArgs(appTemplateEntity.Name, appTemplateEntity.Description, appTemplateEntity.ApplicationInputJSON, appTemplateEntity.PlaceholdersJSON, appTemplateEntity.AccessLevel, appTemplateEntity.TenantID, appTemplateEntity.Version, appTemplateEntity.ID).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -410,8 +442,8 @@ func TestRepository_Update(t *testing.T) {
 		mockConverter.On("ToEntity", appTemplateModel).Return(appTemplateEntity, nil).Once()
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.app_templates SET name = ?, description = ?, application_input = ?, placeholders = ?, access_level = ? WHERE id = ?`)).
-			WithArgs(appTemplateEntity.Name, appTemplateEntity.Description, appTemplateEntity.ApplicationInputJSON, appTemplateEntity.PlaceholdersJSON, appTemplateEntity.AccessLevel, appTemplateEntity.ID).
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.app_templates SET name = ?, description = ?, application_input = ?, placeholders = ?, access_level = ?, tenant_id = ?, version = ? WHERE id = ?`)).
+			WithArgs(appTemplateEntity.Name, appTemplateEntity.Description, appTemplateEntity.ApplicationInputJSON, appTemplateEntity.PlaceholdersJSON, appTemplateEntity.AccessLevel, appTemplateEntity.TenantID, appTemplateEntity.Version, appTemplateEntity.ID).
 			WillReturnError(testError)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -449,15 +481,15 @@ func TestRepository_Delete(t *testing.T) {
 		// GIVEN
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.app_templates WHERE id = $1`)).
-			WithArgs(testID).
+		dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.app_templates WHERE id = $1 AND (tenant_id IS NULL OR tenant_id = $2)`)).
+			WithArgs(testID, testTenant).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(nil)
 
 		// WHEN
-		err := appTemplateRepo.Delete(ctx, testID)
+		err := appTemplateRepo.Delete(ctx, testTenant, testID)
 
 		// THEN
 		require.NoError(t, err)
@@ -467,15 +499,15 @@ func TestRepository_Delete(t *testing.T) {
 		// GIVEN
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
-		dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.app_templates WHERE id = $1`)).
-			WithArgs(testID).
+		dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.app_templates WHERE id = $1 AND (tenant_id IS NULL OR tenant_id = $2)`)).
+			WithArgs(testID, testTenant).
 			WillReturnError(testError)
 
 		ctx := persistence.SaveToContext(context.TODO(), db)
 		appTemplateRepo := apptemplate.NewRepository(nil)
 
 		// WHEN
-		err := appTemplateRepo.Delete(ctx, testID)
+		err := appTemplateRepo.Delete(ctx, testTenant, testID)
 
 		// THEN
 		require.Error(t, err)
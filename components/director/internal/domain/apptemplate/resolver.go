@@ -2,7 +2,6 @@ package apptemplate
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
 
@@ -33,6 +32,9 @@ type ApplicationTemplateConverter interface {
 	MultipleToGraphQL(in []*model.ApplicationTemplate) ([]*graphql.ApplicationTemplate, error)
 	InputFromGraphQL(in graphql.ApplicationTemplateInput) (model.ApplicationTemplateInput, error)
 	ApplicationFromTemplateInputFromGraphQL(in graphql.ApplicationFromTemplateInput) model.ApplicationFromTemplateInput
+	ApplicationInputJSONToGraphQL(jsonAppInput string) (string, error)
+	ApplicationFromTemplateUpgradeInputFromGraphQL(in *graphql.ApplicationFromTemplateUpgradeInput) model.ApplicationFromTemplateInputValues
+	ApplicationFromTemplateUpgradeToGraphQL(in *model.ApplicationFromTemplateUpgrade) *graphql.ApplicationFromTemplateUpgrade
 }
 
 //go:generate mockery -name=ApplicationConverter -output=automock -outpkg=automock -case=underscore
@@ -46,6 +48,9 @@ type ApplicationConverter interface {
 type ApplicationService interface {
 	Create(ctx context.Context, in model.ApplicationRegisterInput) (string, error)
 	Get(ctx context.Context, id string) (*model.Application, error)
+	Reconcile(ctx context.Context, id string, in model.ApplicationRegisterInput, dryRun bool) ([]*model.ApplicationChange, error)
+	GetTemplateReference(ctx context.Context, id string) (*model.ApplicationTemplateReference, error)
+	SetTemplateReference(ctx context.Context, id string, ref model.ApplicationTemplateReference) error
 }
 
 type Resolver struct {
@@ -179,44 +184,63 @@ func (r *Resolver) CreateApplicationTemplate(ctx context.Context, in graphql.App
 	return gqlAppTemplate, nil
 }
 
-func (r *Resolver) RegisterApplicationFromTemplate(ctx context.Context, in graphql.ApplicationFromTemplateInput) (*graphql.Application, error) {
+func (r *Resolver) ApplicationFromTemplatePreview(ctx context.Context, in graphql.ApplicationFromTemplateInput) (string, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	defer r.transact.RollbackUnlessCommitted(ctx, tx)
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	//log.Infof("Registering an Application from Application Template with name %s", in.TemplateName)
 	convertedIn := r.appTemplateConverter.ApplicationFromTemplateInputFromGraphQL(in)
 
-	log.C(ctx).Debugf("Extracting Application Template with name %s from GraphQL input", in.TemplateName)
+	log.C(ctx).Debugf("Extracting Application Template with name %s from GraphQL input", convertedIn.TemplateName)
 	appTemplate, err := r.appTemplateSvc.GetByName(ctx, convertedIn.TemplateName)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	applicationName, err := extractApplicationNameFromTemplateInput(appTemplate.ApplicationInputJSON)
+
+	log.C(ctx).Infof("Rendering preview of an Application from Application Template with name %s", convertedIn.TemplateName)
+	appCreateInputJSON, _, err := r.prepareApplicationCreateInput(ctx, appTemplate, convertedIn.Values)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	log.C(ctx).Infof("Registering an Application with name %s from Application Template with name %s", applicationName, in.TemplateName)
 
-	log.C(ctx).Debugf("Preparing ApplicationCreateInput JSON from Application Template with name %s", in.TemplateName)
-	appCreateInputJSON, err := r.appTemplateSvc.PrepareApplicationCreateInputJSON(appTemplate, convertedIn.Values)
+	err = tx.Commit()
 	if err != nil {
-		return nil, errors.Wrapf(err, "while preparing ApplicationCreateInput JSON from Application Template with name %s", in.TemplateName)
+		return "", err
 	}
 
-	log.C(ctx).Debugf("Converting ApplicationCreateInput JSON to GraphQL ApplicationRegistrationInput from Application Template with name %s", in.TemplateName)
-	appCreateInputGQL, err := r.appConverter.CreateInputJSONToGQL(appCreateInputJSON)
+	preview, err := r.appTemplateConverter.ApplicationInputJSONToGraphQL(appCreateInputJSON)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while converting ApplicationCreateInput JSON to GraphQL ApplicationRegistrationInput from Application Template with name %s", in.TemplateName)
+		return "", errors.Wrapf(err, "while converting ApplicationCreateInput JSON from Application Template with name %s to GraphQL", convertedIn.TemplateName)
 	}
 
-	log.C(ctx).Infof("Validating GraphQL ApplicationRegistrationInput from Application Template with name %s", convertedIn.TemplateName)
-	if err := inputvalidation.Validate(appCreateInputGQL); err != nil {
-		return nil, errors.Wrapf(err, "while validating application input from Application Template with name %s", convertedIn.TemplateName)
+	return preview, nil
+}
+
+func (r *Resolver) RegisterApplicationFromTemplate(ctx context.Context, in graphql.ApplicationFromTemplateInput) (*graphql.Application, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	//log.Infof("Registering an Application from Application Template with name %s", in.TemplateName)
+	convertedIn := r.appTemplateConverter.ApplicationFromTemplateInputFromGraphQL(in)
+
+	log.C(ctx).Debugf("Extracting Application Template with name %s from GraphQL input", convertedIn.TemplateName)
+	appTemplate, err := r.appTemplateSvc.GetByName(ctx, convertedIn.TemplateName)
+	if err != nil {
+		return nil, err
+	}
+
+	_, appCreateInputGQL, err := r.prepareApplicationCreateInput(ctx, appTemplate, convertedIn.Values)
+	if err != nil {
+		return nil, err
 	}
 
 	appCreateInputModel, err := r.appConverter.CreateInputFromGraphQL(ctx, appCreateInputGQL)
@@ -224,12 +248,21 @@ func (r *Resolver) RegisterApplicationFromTemplate(ctx context.Context, in graph
 		return nil, errors.Wrap(err, "while converting ApplicationFromTemplate input")
 	}
 
-	log.C(ctx).Infof("Creating an Application with name %s from Application Template with name %s", applicationName, in.TemplateName)
+	log.C(ctx).Infof("Creating an Application with name %s from Application Template with name %s", appCreateInputModel.Name, in.TemplateName)
 	id, err := r.appSvc.Create(ctx, appCreateInputModel)
 	if err != nil {
-		return nil, errors.Wrapf(err, "while creating an Application with name %s from Application Template with name %s", applicationName, in.TemplateName)
+		return nil, errors.Wrapf(err, "while creating an Application with name %s from Application Template with name %s", appCreateInputModel.Name, in.TemplateName)
+	}
+	log.C(ctx).Infof("Application with name %s and id %s successfully created from Application Template with name %s", appCreateInputModel.Name, id, in.TemplateName)
+
+	ref := model.ApplicationTemplateReference{
+		ApplicationTemplateID: &appTemplate.ID,
+		Version:               appTemplate.Version,
+		Values:                convertedIn.Values,
+	}
+	if err := r.appSvc.SetTemplateReference(ctx, id, ref); err != nil {
+		return nil, err
 	}
-	log.C(ctx).Infof("Application with name %s and id %s successfully created from Application Template with name %s", applicationName, id, in.TemplateName)
 
 	app, err := r.appSvc.Get(ctx, id)
 	if err != nil {
@@ -245,6 +278,58 @@ func (r *Resolver) RegisterApplicationFromTemplate(ctx context.Context, in graph
 	return gqlApp, nil
 }
 
+func (r *Resolver) ApplicationFromTemplateUpgradeDiff(ctx context.Context, appID string, in *graphql.ApplicationFromTemplateUpgradeInput) (*graphql.ApplicationFromTemplateUpgrade, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	log.C(ctx).Infof("Calculating changes of upgrading an Application with id %s from Application Template", appID)
+	upgrade, err := r.upgradeApplicationFromTemplate(ctx, appID, r.appTemplateConverter.ApplicationFromTemplateUpgradeInputFromGraphQL(in), true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.appTemplateConverter.ApplicationFromTemplateUpgradeToGraphQL(upgrade), nil
+}
+
+func (r *Resolver) UpgradeApplicationFromTemplate(ctx context.Context, appID string, in *graphql.ApplicationFromTemplateUpgradeInput) (*graphql.Application, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	log.C(ctx).Infof("Upgrading an Application with id %s from Application Template", appID)
+	upgrade, err := r.upgradeApplicationFromTemplate(ctx, appID, r.appTemplateConverter.ApplicationFromTemplateUpgradeInputFromGraphQL(in), false)
+	if err != nil {
+		return nil, err
+	}
+	log.C(ctx).Infof("Application with id %s successfully upgraded from Application Template version %d to version %d", appID, upgrade.FromVersion, upgrade.ToVersion)
+
+	app, err := r.appSvc.Get(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.appConverter.ToGraphQL(app), nil
+}
+
 func (r *Resolver) UpdateApplicationTemplate(ctx context.Context, id string, in graphql.ApplicationTemplateInput) (*graphql.ApplicationTemplate, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
@@ -314,14 +399,77 @@ func (r *Resolver) DeleteApplicationTemplate(ctx context.Context, id string) (*g
 	return deletedAppTemplate, nil
 }
 
-func extractApplicationNameFromTemplateInput(applicationInputJSON string) (string, error) {
-	b := []byte(applicationInputJSON)
-	data := make(map[string]interface{})
+// upgradeApplicationFromTemplate renders the current version of the Application Template the Application was registered from
+// and reconciles the Application with it. The stored placeholder values are merged with the provided ones.
+func (r *Resolver) upgradeApplicationFromTemplate(ctx context.Context, appID string, values model.ApplicationFromTemplateInputValues, dryRun bool) (*model.ApplicationFromTemplateUpgrade, error) {
+	ref, err := r.appSvc.GetTemplateReference(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	if ref == nil {
+		return nil, apperrors.NewInvalidDataError("Application with id %s was not registered from an Application Template", appID)
+	}
+	if ref.ApplicationTemplateID == nil {
+		return nil, apperrors.NewInvalidDataError("Application Template of Application with id %s no longer exists", appID)
+	}
 
-	err := json.Unmarshal(b, &data)
+	appTemplate, err := r.appTemplateSvc.Get(ctx, *ref.ApplicationTemplateID)
 	if err != nil {
-		return "", errors.Wrap(err, "while unmarshalling application input JSON")
+		return nil, err
+	}
+
+	mergedValues := ref.Values.MergeValues(values)
+	_, appCreateInputGQL, err := r.prepareApplicationCreateInput(ctx, appTemplate, mergedValues)
+	if err != nil {
+		return nil, err
+	}
+
+	appCreateInputModel, err := r.appConverter.CreateInputFromGraphQL(ctx, appCreateInputGQL)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting ApplicationFromTemplate input")
+	}
+
+	changes, err := r.appSvc.Reconcile(ctx, appID, appCreateInputModel, dryRun)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reconciling Application with id %s with Application Template with name %s", appID, appTemplate.Name)
+	}
+
+	if !dryRun {
+		newRef := model.ApplicationTemplateReference{
+			ApplicationTemplateID: &appTemplate.ID,
+			Version:               appTemplate.Version,
+			Values:                mergedValues,
+		}
+		if err := r.appSvc.SetTemplateReference(ctx, appID, newRef); err != nil {
+			return nil, err
+		}
+	}
+
+	return &model.ApplicationFromTemplateUpgrade{
+		FromVersion: ref.Version,
+		ToVersion:   appTemplate.Version,
+		Changes:     changes,
+	}, nil
+}
+
+// prepareApplicationCreateInput renders and validates the Application input from the Application Template with placeholder values substituted
+func (r *Resolver) prepareApplicationCreateInput(ctx context.Context, appTemplate *model.ApplicationTemplate, values model.ApplicationFromTemplateInputValues) (string, graphql.ApplicationRegisterInput, error) {
+	log.C(ctx).Debugf("Preparing ApplicationCreateInput JSON from Application Template with name %s", appTemplate.Name)
+	appCreateInputJSON, err := r.appTemplateSvc.PrepareApplicationCreateInputJSON(appTemplate, values)
+	if err != nil {
+		return "", graphql.ApplicationRegisterInput{}, errors.Wrapf(err, "while preparing ApplicationCreateInput JSON from Application Template with name %s", appTemplate.Name)
+	}
+
+	log.C(ctx).Debugf("Converting ApplicationCreateInput JSON to GraphQL ApplicationRegistrationInput from Application Template with name %s", appTemplate.Name)
+	appCreateInputGQL, err := r.appConverter.CreateInputJSONToGQL(appCreateInputJSON)
+	if err != nil {
+		return "", graphql.ApplicationRegisterInput{}, errors.Wrapf(err, "while converting ApplicationCreateInput JSON to GraphQL ApplicationRegistrationInput from Application Template with name %s", appTemplate.Name)
+	}
+
+	log.C(ctx).Infof("Validating GraphQL ApplicationRegistrationInput from Application Template with name %s", appTemplate.Name)
+	if err := inputvalidation.Validate(appCreateInputGQL); err != nil {
+		return "", graphql.ApplicationRegisterInput{}, errors.Wrapf(err, "while validating application input from Application Template with name %s", appTemplate.Name)
 	}
 
-	return data["name"].(string), nil
+	return appCreateInputJSON, appCreateInputGQL, nil
 }
@@ -457,6 +457,7 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 
 	gqlAppFromTemplateInput := fixGQLApplicationFromTemplateInput(testName)
 	modelAppFromTemplateInput := fixModelApplicationFromTemplateInput(testName)
+	templateRef := fixModelTemplateReference(testID, modelAppTemplate.Version, modelAppFromTemplateInput.Values)
 
 	testCases := []struct {
 		Name              string
@@ -485,6 +486,7 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 			AppSvcFn: func() *automock.ApplicationService {
 				appSvc := &automock.ApplicationService{}
 				appSvc.On("Create", txtest.CtxWithDBMatcher(), modelAppCreateInput).Return(testID, nil).Once()
+				appSvc.On("SetTemplateReference", txtest.CtxWithDBMatcher(), testID, templateRef).Return(nil).Once()
 				appSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(&modelApplication, nil).Once()
 				return appSvc
 			},
@@ -650,6 +652,35 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 			ExpectedOutput: nil,
 			ExpectedError:  testError,
 		},
+		{
+			Name: "Returns error when setting Application Template reference fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("GetByName", txtest.CtxWithDBMatcher(), testName).Return(modelAppTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, modelAppFromTemplateInput.Values).Return(jsonAppCreateInput, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ApplicationFromTemplateInputFromGraphQL", gqlAppFromTemplateInput).Return(modelAppFromTemplateInput).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Create", txtest.CtxWithDBMatcher(), modelAppCreateInput).Return(testID, nil).Once()
+				appSvc.On("SetTemplateReference", txtest.CtxWithDBMatcher(), testID, templateRef).Return(testError).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputFromGraphQL", mock.Anything, gqlAppCreateInput).Return(modelAppCreateInput, nil).Once()
+				appConv.On("CreateInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Once()
+				return appConv
+			},
+			ExpectedOutput: nil,
+			ExpectedError:  testError,
+		},
 		{
 			Name: "Returns error when getting Application fails",
 			TxFn: txGen.ThatDoesntExpectCommit,
@@ -667,6 +698,7 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 			AppSvcFn: func() *automock.ApplicationService {
 				appSvc := &automock.ApplicationService{}
 				appSvc.On("Create", txtest.CtxWithDBMatcher(), modelAppCreateInput).Return(testID, nil).Once()
+				appSvc.On("SetTemplateReference", txtest.CtxWithDBMatcher(), testID, templateRef).Return(nil).Once()
 				appSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(nil, testError).Once()
 				return appSvc
 			},
@@ -696,6 +728,7 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 			AppSvcFn: func() *automock.ApplicationService {
 				appSvc := &automock.ApplicationService{}
 				appSvc.On("Create", txtest.CtxWithDBMatcher(), modelAppCreateInput).Return(testID, nil).Once()
+				appSvc.On("SetTemplateReference", txtest.CtxWithDBMatcher(), testID, templateRef).Return(nil).Once()
 				appSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(&modelApplication, nil).Once()
 				return appSvc
 			},
@@ -742,6 +775,610 @@ func TestResolver_RegisterApplicationFromTemplate(t *testing.T) {
 	}
 }
 
+func TestResolver_ApplicationFromTemplatePreview(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	jsonAppCreateInput := fixJSONApplicationCreateInput(testName)
+	gqlAppCreateInput := fixGQLApplicationCreateInput(testName)
+	graphqlisedAppCreateInput := `{name: "foo"}`
+
+	modelAppTemplate := fixModelAppTemplateWithAppInputJSON(testID, testName, jsonAppCreateInput)
+
+	gqlAppFromTemplateInput := fixGQLApplicationFromTemplateInput(testName)
+	modelAppFromTemplateInput := fixModelApplicationFromTemplateInput(testName)
+
+	testCases := []struct {
+		Name              string
+		TxFn              func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		AppTemplateSvcFn  func() *automock.ApplicationTemplateService
+		AppTemplateConvFn func() *automock.ApplicationTemplateConverter
+		AppConvFn         func() *automock.ApplicationConverter
+		ExpectedOutput    string
+		ExpectedError     error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("GetByName", txtest.CtxWithDBMatcher(), testName).Return(modelAppTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, modelAppFromTemplateInput.Values).Return(jsonAppCreateInput, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ApplicationFromTemplateInputFromGraphQL", gqlAppFromTemplateInput).Return(modelAppFromTemplateInput).Once()
+				appTemplateConv.On("ApplicationInputJSONToGraphQL", jsonAppCreateInput).Return(graphqlisedAppCreateInput, nil).Once()
+				return appTemplateConv
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Once()
+				return appConv
+			},
+			ExpectedOutput: graphqlisedAppCreateInput,
+		},
+		{
+			Name: "Returns error when transaction begin fails",
+			TxFn: txGen.ThatFailsOnBegin,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				return &automock.ApplicationTemplateService{}
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				return &automock.ApplicationTemplateConverter{}
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when preparing ApplicationCreateInputJSON fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("GetByName", txtest.CtxWithDBMatcher(), testName).Return(modelAppTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, modelAppFromTemplateInput.Values).Return("", testError).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ApplicationFromTemplateInputFromGraphQL", gqlAppFromTemplateInput).Return(modelAppFromTemplateInput).Once()
+				return appTemplateConv
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when validating application input fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("GetByName", txtest.CtxWithDBMatcher(), testName).Return(modelAppTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, modelAppFromTemplateInput.Values).Return(jsonAppCreateInput, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ApplicationFromTemplateInputFromGraphQL", gqlAppFromTemplateInput).Return(modelAppFromTemplateInput).Once()
+				return appTemplateConv
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputJSONToGQL", jsonAppCreateInput).Return(graphql.ApplicationRegisterInput{}, nil).Once()
+				return appConv
+			},
+			ExpectedError: errors.New("while validating application input from Application Template with name"),
+		},
+		{
+			Name: "Returns error when transaction commit fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("GetByName", txtest.CtxWithDBMatcher(), testName).Return(modelAppTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, modelAppFromTemplateInput.Values).Return(jsonAppCreateInput, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ApplicationFromTemplateInputFromGraphQL", gqlAppFromTemplateInput).Return(modelAppFromTemplateInput).Once()
+				return appTemplateConv
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Once()
+				return appConv
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			appTemplateSvc := testCase.AppTemplateSvcFn()
+			appTemplateConv := testCase.AppTemplateConvFn()
+			appConv := testCase.AppConvFn()
+
+			resolver := apptemplate.NewResolver(transact, nil, appConv, appTemplateSvc, appTemplateConv)
+
+			// WHEN
+			result, err := resolver.ApplicationFromTemplatePreview(ctx, gqlAppFromTemplateInput)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			appTemplateSvc.AssertExpectations(t)
+			appTemplateConv.AssertExpectations(t)
+			appConv.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_ApplicationFromTemplateUpgradeDiff(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	jsonAppCreateInput := fixJSONApplicationCreateInput(testName)
+	modelAppCreateInput := fixModelApplicationCreateInput(testName)
+	gqlAppCreateInput := fixGQLApplicationCreateInput(testName)
+
+	modelAppTemplate := fixModelAppTemplateWithAppInputJSON(testID, testName, jsonAppCreateInput)
+	modelAppTemplate.Version = 2
+
+	storedRef := fixModelTemplateReference(testID, 1, fixModelApplicationFromTemplateInput(testName).Values)
+	deletedTemplateRef := model.ApplicationTemplateReference{Version: 1}
+
+	gqlUpgradeInput := fixGQLApplicationFromTemplateUpgradeInput()
+	upgradeValues := fixModelApplicationFromTemplateUpgradeValues()
+	mergedValues := storedRef.Values.MergeValues(upgradeValues)
+
+	modelUpgrade := fixModelApplicationFromTemplateUpgrade(1, 2)
+	gqlUpgrade := fixGQLApplicationFromTemplateUpgrade(1, 2)
+
+	testCases := []struct {
+		Name              string
+		TxFn              func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		AppTemplateSvcFn  func() *automock.ApplicationTemplateService
+		AppTemplateConvFn func() *automock.ApplicationTemplateConverter
+		AppSvcFn          func() *automock.ApplicationService
+		AppConvFn         func() *automock.ApplicationConverter
+		ExpectedOutput    *graphql.ApplicationFromTemplateUpgrade
+		ExpectedError     error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelAppTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, mergedValues).Return(jsonAppCreateInput, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ApplicationFromTemplateUpgradeInputFromGraphQL", gqlUpgradeInput).Return(upgradeValues).Once()
+				appTemplateConv.On("ApplicationFromTemplateUpgradeToGraphQL", modelUpgrade).Return(gqlUpgrade).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), testID).Return(&storedRef, nil).Once()
+				appSvc.On("Reconcile", txtest.CtxWithDBMatcher(), testID, modelAppCreateInput, true).Return(modelUpgrade.Changes, nil).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Once()
+				appConv.On("CreateInputFromGraphQL", mock.Anything, gqlAppCreateInput).Return(modelAppCreateInput, nil).Once()
+				return appConv
+			},
+			ExpectedOutput: gqlUpgrade,
+		},
+		{
+			Name: "Returns error when transaction begin fails",
+			TxFn: txGen.ThatFailsOnBegin,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				return &automock.ApplicationTemplateService{}
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				return &automock.ApplicationTemplateConverter{}
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				return &automock.ApplicationService{}
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when getting Application Template reference fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				return &automock.ApplicationTemplateService{}
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ApplicationFromTemplateUpgradeInputFromGraphQL", gqlUpgradeInput).Return(upgradeValues).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), testID).Return(nil, testError).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when Application was not registered from Application Template",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				return &automock.ApplicationTemplateService{}
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ApplicationFromTemplateUpgradeInputFromGraphQL", gqlUpgradeInput).Return(upgradeValues).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), testID).Return(nil, nil).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedError: errors.New("was not registered from an Application Template"),
+		},
+		{
+			Name: "Returns error when Application Template was deleted",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				return &automock.ApplicationTemplateService{}
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ApplicationFromTemplateUpgradeInputFromGraphQL", gqlUpgradeInput).Return(upgradeValues).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), testID).Return(&deletedTemplateRef, nil).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedError: errors.New("no longer exists"),
+		},
+		{
+			Name: "Returns error when getting Application Template fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(nil, testError).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ApplicationFromTemplateUpgradeInputFromGraphQL", gqlUpgradeInput).Return(upgradeValues).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), testID).Return(&storedRef, nil).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when preparing ApplicationCreateInputJSON fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelAppTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, mergedValues).Return("", testError).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ApplicationFromTemplateUpgradeInputFromGraphQL", gqlUpgradeInput).Return(upgradeValues).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), testID).Return(&storedRef, nil).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				return &automock.ApplicationConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when reconciling Application fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelAppTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, mergedValues).Return(jsonAppCreateInput, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ApplicationFromTemplateUpgradeInputFromGraphQL", gqlUpgradeInput).Return(upgradeValues).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), testID).Return(&storedRef, nil).Once()
+				appSvc.On("Reconcile", txtest.CtxWithDBMatcher(), testID, modelAppCreateInput, true).Return(nil, testError).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Once()
+				appConv.On("CreateInputFromGraphQL", mock.Anything, gqlAppCreateInput).Return(modelAppCreateInput, nil).Once()
+				return appConv
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when committing transaction fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			AppTemplateSvcFn: func() *automock.ApplicationTemplateService {
+				appTemplateSvc := &automock.ApplicationTemplateService{}
+				appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelAppTemplate, nil).Once()
+				appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, mergedValues).Return(jsonAppCreateInput, nil).Once()
+				return appTemplateSvc
+			},
+			AppTemplateConvFn: func() *automock.ApplicationTemplateConverter {
+				appTemplateConv := &automock.ApplicationTemplateConverter{}
+				appTemplateConv.On("ApplicationFromTemplateUpgradeInputFromGraphQL", gqlUpgradeInput).Return(upgradeValues).Once()
+				return appTemplateConv
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), testID).Return(&storedRef, nil).Once()
+				appSvc.On("Reconcile", txtest.CtxWithDBMatcher(), testID, modelAppCreateInput, true).Return(modelUpgrade.Changes, nil).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Once()
+				appConv.On("CreateInputFromGraphQL", mock.Anything, gqlAppCreateInput).Return(modelAppCreateInput, nil).Once()
+				return appConv
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			appTemplateSvc := testCase.AppTemplateSvcFn()
+			appTemplateConv := testCase.AppTemplateConvFn()
+			appSvc := testCase.AppSvcFn()
+			appConv := testCase.AppConvFn()
+
+			resolver := apptemplate.NewResolver(transact, appSvc, appConv, appTemplateSvc, appTemplateConv)
+
+			// WHEN
+			result, err := resolver.ApplicationFromTemplateUpgradeDiff(ctx, testID, gqlUpgradeInput)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			appTemplateSvc.AssertExpectations(t)
+			appTemplateConv.AssertExpectations(t)
+			appSvc.AssertExpectations(t)
+			appConv.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_UpgradeApplicationFromTemplate(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	jsonAppCreateInput := fixJSONApplicationCreateInput(testName)
+	modelAppCreateInput := fixModelApplicationCreateInput(testName)
+	gqlAppCreateInput := fixGQLApplicationCreateInput(testName)
+
+	modelAppTemplate := fixModelAppTemplateWithAppInputJSON(testID, testName, jsonAppCreateInput)
+	modelAppTemplate.Version = 2
+
+	modelApplication := fixModelApplication(testID, testName)
+	gqlApplication := fixGQLApplication(testID, testName)
+
+	storedRef := fixModelTemplateReference(testID, 1, fixModelApplicationFromTemplateInput(testName).Values)
+
+	gqlUpgradeInput := fixGQLApplicationFromTemplateUpgradeInput()
+	upgradeValues := fixModelApplicationFromTemplateUpgradeValues()
+	mergedValues := storedRef.Values.MergeValues(upgradeValues)
+	upgradedRef := fixModelTemplateReference(testID, 2, mergedValues)
+
+	changes := fixModelApplicationFromTemplateUpgrade(1, 2).Changes
+
+	appTemplateSvcFn := func() *automock.ApplicationTemplateService {
+		appTemplateSvc := &automock.ApplicationTemplateService{}
+		appTemplateSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelAppTemplate, nil).Once()
+		appTemplateSvc.On("PrepareApplicationCreateInputJSON", modelAppTemplate, mergedValues).Return(jsonAppCreateInput, nil).Once()
+		return appTemplateSvc
+	}
+	appTemplateConvFn := func() *automock.ApplicationTemplateConverter {
+		appTemplateConv := &automock.ApplicationTemplateConverter{}
+		appTemplateConv.On("ApplicationFromTemplateUpgradeInputFromGraphQL", gqlUpgradeInput).Return(upgradeValues).Once()
+		return appTemplateConv
+	}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		AppSvcFn       func() *automock.ApplicationService
+		AppConvFn      func() *automock.ApplicationConverter
+		ExpectedOutput *graphql.Application
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), testID).Return(&storedRef, nil).Once()
+				appSvc.On("Reconcile", txtest.CtxWithDBMatcher(), testID, modelAppCreateInput, false).Return(changes, nil).Once()
+				appSvc.On("SetTemplateReference", txtest.CtxWithDBMatcher(), testID, upgradedRef).Return(nil).Once()
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(&modelApplication, nil).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Once()
+				appConv.On("CreateInputFromGraphQL", mock.Anything, gqlAppCreateInput).Return(modelAppCreateInput, nil).Once()
+				appConv.On("ToGraphQL", &modelApplication).Return(&gqlApplication).Once()
+				return appConv
+			},
+			ExpectedOutput: &gqlApplication,
+		},
+		{
+			Name: "Returns error when reconciling Application fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), testID).Return(&storedRef, nil).Once()
+				appSvc.On("Reconcile", txtest.CtxWithDBMatcher(), testID, modelAppCreateInput, false).Return(nil, testError).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Once()
+				appConv.On("CreateInputFromGraphQL", mock.Anything, gqlAppCreateInput).Return(modelAppCreateInput, nil).Once()
+				return appConv
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when setting Application Template reference fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), testID).Return(&storedRef, nil).Once()
+				appSvc.On("Reconcile", txtest.CtxWithDBMatcher(), testID, modelAppCreateInput, false).Return(changes, nil).Once()
+				appSvc.On("SetTemplateReference", txtest.CtxWithDBMatcher(), testID, upgradedRef).Return(testError).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Once()
+				appConv.On("CreateInputFromGraphQL", mock.Anything, gqlAppCreateInput).Return(modelAppCreateInput, nil).Once()
+				return appConv
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when getting Application fails",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), testID).Return(&storedRef, nil).Once()
+				appSvc.On("Reconcile", txtest.CtxWithDBMatcher(), testID, modelAppCreateInput, false).Return(changes, nil).Once()
+				appSvc.On("SetTemplateReference", txtest.CtxWithDBMatcher(), testID, upgradedRef).Return(nil).Once()
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(nil, testError).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Once()
+				appConv.On("CreateInputFromGraphQL", mock.Anything, gqlAppCreateInput).Return(modelAppCreateInput, nil).Once()
+				return appConv
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when committing transaction fails",
+			TxFn: txGen.ThatFailsOnCommit,
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetTemplateReference", txtest.CtxWithDBMatcher(), testID).Return(&storedRef, nil).Once()
+				appSvc.On("Reconcile", txtest.CtxWithDBMatcher(), testID, modelAppCreateInput, false).Return(changes, nil).Once()
+				appSvc.On("SetTemplateReference", txtest.CtxWithDBMatcher(), testID, upgradedRef).Return(nil).Once()
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(&modelApplication, nil).Once()
+				return appSvc
+			},
+			AppConvFn: func() *automock.ApplicationConverter {
+				appConv := &automock.ApplicationConverter{}
+				appConv.On("CreateInputJSONToGQL", jsonAppCreateInput).Return(gqlAppCreateInput, nil).Once()
+				appConv.On("CreateInputFromGraphQL", mock.Anything, gqlAppCreateInput).Return(modelAppCreateInput, nil).Once()
+				return appConv
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			appTemplateSvc := appTemplateSvcFn()
+			appTemplateConv := appTemplateConvFn()
+			appSvc := testCase.AppSvcFn()
+			appConv := testCase.AppConvFn()
+
+			resolver := apptemplate.NewResolver(transact, appSvc, appConv, appTemplateSvc, appTemplateConv)
+
+			// WHEN
+			result, err := resolver.UpgradeApplicationFromTemplate(ctx, testID, gqlUpgradeInput)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			appTemplateSvc.AssertExpectations(t)
+			appTemplateConv.AssertExpectations(t)
+			appSvc.AssertExpectations(t)
+			appConv.AssertExpectations(t)
+		})
+	}
+}
+
 func TestResolver_UpdateApplicationTemplate(t *testing.T) {
 	// GIVEN
 	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
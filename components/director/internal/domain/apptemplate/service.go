@@ -8,21 +8,25 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
 
 	"github.com/pkg/errors"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 )
 
+const accessLevelScopesPathPrefix = "applicationTemplateAccessLevelScopes"
+
 //go:generate mockery -name=ApplicationTemplateRepository -output=automock -outpkg=automock -case=underscore
 type ApplicationTemplateRepository interface {
 	Create(ctx context.Context, item model.ApplicationTemplate) error
-	Get(ctx context.Context, id string) (*model.ApplicationTemplate, error)
-	GetByName(ctx context.Context, id string) (*model.ApplicationTemplate, error)
-	Exists(ctx context.Context, id string) (bool, error)
-	List(ctx context.Context, pageSize int, cursor string) (model.ApplicationTemplatePage, error)
+	Get(ctx context.Context, tenant, id string) (*model.ApplicationTemplate, error)
+	GetByName(ctx context.Context, tenant, name string) (*model.ApplicationTemplate, error)
+	Exists(ctx context.Context, tenant, id string) (bool, error)
+	List(ctx context.Context, tenant string, pageSize int, cursor string) (model.ApplicationTemplatePage, error)
 	Update(ctx context.Context, model model.ApplicationTemplate) error
-	Delete(ctx context.Context, id string) error
+	Delete(ctx context.Context, tenant, id string) error
 }
 
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
@@ -30,26 +34,48 @@ type UIDService interface {
 	Generate() string
 }
 
+//go:generate mockery -name=ScopesGetter -output=automock -outpkg=automock -case=underscore
+type ScopesGetter interface {
+	GetRequiredScopes(path string) ([]string, error)
+}
+
 type service struct {
 	appTemplateRepo ApplicationTemplateRepository
 
-	uidService UIDService
+	uidService   UIDService
+	scopesGetter ScopesGetter
 }
 
-func NewService(appTemplateRepo ApplicationTemplateRepository, uidService UIDService) *service {
+func NewService(appTemplateRepo ApplicationTemplateRepository, uidService UIDService, scopesGetter ScopesGetter) *service {
 	return &service{
 		appTemplateRepo: appTemplateRepo,
 		uidService:      uidService,
+		scopesGetter:    scopesGetter,
 	}
 }
 
 func (s *service) Create(ctx context.Context, in model.ApplicationTemplateInput) (string, error) {
+	if err := s.ensureAccessLevelAllowed(ctx, in.AccessLevel); err != nil {
+		return "", err
+	}
+
+	if err := validatePlaceholders(in.Placeholders); err != nil {
+		return "", err
+	}
+
+	tenantID, err := tenantForAccessLevel(ctx, in.AccessLevel)
+	if err != nil {
+		return "", err
+	}
+
 	id := s.uidService.Generate()
 	log.C(ctx).Debugf("ID %s generated for Application Template with name %s", id, in.Name)
 
 	appTemplate := in.ToApplicationTemplate(id)
+	appTemplate.TenantID = tenantID
+	appTemplate.Version = 1
 
-	err := s.appTemplateRepo.Create(ctx, appTemplate)
+	err = s.appTemplateRepo.Create(ctx, appTemplate)
 	if err != nil {
 		return "", errors.Wrapf(err, "while creating Application Template with name %s", in.Name)
 	}
@@ -58,7 +84,7 @@ func (s *service) Create(ctx context.Context, in model.ApplicationTemplateInput)
 }
 
 func (s *service) Get(ctx context.Context, id string) (*model.ApplicationTemplate, error) {
-	appTemplate, err := s.appTemplateRepo.Get(ctx, id)
+	appTemplate, err := s.appTemplateRepo.Get(ctx, loadTenantIfPresent(ctx), id)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while getting Application Template with id %s", id)
 	}
@@ -67,7 +93,7 @@ func (s *service) Get(ctx context.Context, id string) (*model.ApplicationTemplat
 }
 
 func (s *service) GetByName(ctx context.Context, name string) (*model.ApplicationTemplate, error) {
-	appTemplate, err := s.appTemplateRepo.GetByName(ctx, name)
+	appTemplate, err := s.appTemplateRepo.GetByName(ctx, loadTenantIfPresent(ctx), name)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while getting Application Template with name %s", name)
 	}
@@ -76,7 +102,7 @@ func (s *service) GetByName(ctx context.Context, name string) (*model.Applicatio
 }
 
 func (s *service) Exists(ctx context.Context, id string) (bool, error) {
-	exist, err := s.appTemplateRepo.Exists(ctx, id)
+	exist, err := s.appTemplateRepo.Exists(ctx, loadTenantIfPresent(ctx), id)
 	if err != nil {
 		return false, errors.Wrapf(err, "while getting Application Template with ID %s", id)
 	}
@@ -89,13 +115,37 @@ func (s *service) List(ctx context.Context, pageSize int, cursor string) (model.
 		return model.ApplicationTemplatePage{}, apperrors.NewInvalidDataError("page size must be between 1 and 100")
 	}
 
-	return s.appTemplateRepo.List(ctx, pageSize, cursor)
+	return s.appTemplateRepo.List(ctx, loadTenantIfPresent(ctx), pageSize, cursor)
 }
 
 func (s *service) Update(ctx context.Context, id string, in model.ApplicationTemplateInput) error {
+	current, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ensureAccessLevelAllowed(ctx, current.AccessLevel); err != nil {
+		return err
+	}
+
+	if err := s.ensureAccessLevelAllowed(ctx, in.AccessLevel); err != nil {
+		return err
+	}
+
+	if err := validatePlaceholders(in.Placeholders); err != nil {
+		return err
+	}
+
+	tenantID, err := tenantForAccessLevel(ctx, in.AccessLevel)
+	if err != nil {
+		return err
+	}
+
 	appTemplate := in.ToApplicationTemplate(id)
+	appTemplate.TenantID = tenantID
+	appTemplate.Version = current.Version + 1
 
-	err := s.appTemplateRepo.Update(ctx, appTemplate)
+	err = s.appTemplateRepo.Update(ctx, appTemplate)
 	if err != nil {
 		return errors.Wrapf(err, "while updating Application Template with ID %s", id)
 	}
@@ -104,7 +154,16 @@ func (s *service) Update(ctx context.Context, id string, in model.ApplicationTem
 }
 
 func (s *service) Delete(ctx context.Context, id string) error {
-	err := s.appTemplateRepo.Delete(ctx, id)
+	appTemplate, err := s.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ensureAccessLevelAllowed(ctx, appTemplate.AccessLevel); err != nil {
+		return err
+	}
+
+	err = s.appTemplateRepo.Delete(ctx, loadTenantIfPresent(ctx), id)
 	if err != nil {
 		return errors.Wrapf(err, "while deleting Application Template with ID %s", id)
 	}
@@ -113,13 +172,68 @@ func (s *service) Delete(ctx context.Context, id string) error {
 }
 
 func (s *service) PrepareApplicationCreateInputJSON(appTemplate *model.ApplicationTemplate, values model.ApplicationFromTemplateInputValues) (string, error) {
-	appCreateInputJSON := appTemplate.ApplicationInputJSON
+	if len(appTemplate.Placeholders) == 0 {
+		return appTemplate.ApplicationInputJSON, nil
+	}
+
+	resolved := make(map[string]placeholderValue, len(appTemplate.Placeholders))
 	for _, placeholder := range appTemplate.Placeholders {
-		newValue, err := values.FindPlaceholderValue(placeholder.Name)
+		value, err := resolvePlaceholderValue(placeholder, values)
 		if err != nil {
-			return "", errors.Wrap(err, "required placeholder not provided")
+			return "", err
+		}
+		resolved[placeholder.Name] = value
+	}
+
+	return substitutePlaceholders(appTemplate.ApplicationInputJSON, resolved)
+}
+
+// ensureAccessLevelAllowed checks if the caller has the scopes required to manage Application Templates with the given access level
+func (s *service) ensureAccessLevelAllowed(ctx context.Context, accessLevel model.ApplicationTemplateAccessLevel) error {
+	requiredScopes, err := s.scopesGetter.GetRequiredScopes(fmt.Sprintf("%s.%s", accessLevelScopesPathPrefix, strings.ToLower(string(accessLevel))))
+	if err != nil {
+		return errors.Wrapf(err, "while getting required scopes for Application Template access level %s", accessLevel)
+	}
+
+	actualScopes, err := scope.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	actual := make(map[string]struct{}, len(actualScopes))
+	for _, sc := range actualScopes {
+		actual[sc] = struct{}{}
+	}
+
+	for _, required := range requiredScopes {
+		if _, ok := actual[required]; !ok {
+			return apperrors.NewInsufficientScopesError(requiredScopes, actualScopes)
 		}
-		appCreateInputJSON = strings.ReplaceAll(appCreateInputJSON, fmt.Sprintf("{{%s}}", placeholder.Name), newValue)
 	}
-	return appCreateInputJSON, nil
+
+	return nil
+}
+
+// tenantForAccessLevel returns the tenant owning an Application Template with the given access level
+func tenantForAccessLevel(ctx context.Context, accessLevel model.ApplicationTemplateAccessLevel) (*string, error) {
+	if accessLevel != model.TenantApplicationTemplateAccessLevel {
+		return nil, nil
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant for Application Template with access level %s", accessLevel)
+	}
+
+	return &tnt, nil
+}
+
+// loadTenantIfPresent returns the tenant from the context or an empty string when the request is not made in the context of any tenant
+func loadTenantIfPresent(ctx context.Context) string {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return ""
+	}
+
+	return tnt
 }
@@ -13,13 +13,14 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestService_Create(t *testing.T) {
 	// GIVEN
-	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+	ctx := fixCtxWithTenantAndScopes(testTenant, testGlobalWriteScope, testTenantWriteScope)
 
 	uidSvcFn := func() *automock.UIDService {
 		uidSvc := &automock.UIDService{}
@@ -27,45 +28,110 @@ func TestService_Create(t *testing.T) {
 		return uidSvc
 	}
 	modelAppTemplate := fixModelAppTemplate(testID, testName)
+	modelTenantAppTemplate := fixModelTenantAppTemplate(testID, testName, testTenant)
 
 	testCases := []struct {
 		Name              string
+		Context           context.Context
 		Input             *model.ApplicationTemplateInput
 		AppTemplateRepoFn func() *automock.ApplicationTemplateRepository
+		UIDSvcFn          func() *automock.UIDService
 		ExpectedError     error
 		ExpectedOutput    string
 	}{
 		{
-			Name:  "Success",
-			Input: fixModelAppTemplateInput(testName, appInputJSONString),
+			Name:    "Success",
+			Context: ctx,
+			Input:   fixModelAppTemplateInput(testName, appInputJSONString),
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
 				appTemplateRepo.On("Create", ctx, *modelAppTemplate).Return(nil).Once()
 				return appTemplateRepo
 			},
+			UIDSvcFn:       uidSvcFn,
 			ExpectedOutput: testID,
 		},
 		{
-			Name:  "Error when creating application template",
-			Input: fixModelAppTemplateInput(testName, appInputJSONString),
+			Name:    "Success for tenant access level",
+			Context: ctx,
+			Input:   fixModelTenantAppTemplateInput(testName, appInputJSONString),
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Create", ctx, *modelTenantAppTemplate).Return(nil).Once()
+				return appTemplateRepo
+			},
+			UIDSvcFn:       uidSvcFn,
+			ExpectedOutput: testID,
+		},
+		{
+			Name:    "Error when creating application template",
+			Context: ctx,
+			Input:   fixModelAppTemplateInput(testName, appInputJSONString),
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
 				appTemplateRepo.On("Create", ctx, *modelAppTemplate).Return(testError).Once()
 				return appTemplateRepo
 			},
+			UIDSvcFn:       uidSvcFn,
 			ExpectedError:  testError,
 			ExpectedOutput: "",
 		},
+		{
+			Name:    "Error when placeholder default value is invalid",
+			Context: ctx,
+			Input: &model.ApplicationTemplateInput{
+				Name:                 testName,
+				ApplicationInputJSON: appInputJSONString,
+				AccessLevel:          model.GlobalApplicationTemplateAccessLevel,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "replicas", Type: model.NumberApplicationTemplatePlaceholderType, DefaultValue: str.Ptr("many")},
+				},
+			},
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				return &automock.ApplicationTemplateRepository{}
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedError:  errors.New("while validating default value of placeholder replicas"),
+			ExpectedOutput: "",
+		},
+		{
+			Name:    "Error when scopes for global access level are missing",
+			Context: fixCtxWithTenantAndScopes(testTenant, testTenantWriteScope),
+			Input:   fixModelAppTemplateInput(testName, appInputJSONString),
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				return &automock.ApplicationTemplateRepository{}
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedError:  errors.New("insufficient scopes provided"),
+			ExpectedOutput: "",
+		},
+		{
+			Name:    "Error when tenant is missing for tenant access level",
+			Context: scope.SaveToContext(context.TODO(), []string{testTenantWriteScope}),
+			Input:   fixModelTenantAppTemplateInput(testName, appInputJSONString),
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				return &automock.ApplicationTemplateRepository{}
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedError:  errors.New("while loading tenant for Application Template with access level TENANT"),
+			ExpectedOutput: "",
+		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
-			idSvc := uidSvcFn()
-			svc := apptemplate.NewService(appTemplateRepo, idSvc)
+			idSvc := testCase.UIDSvcFn()
+			svc := apptemplate.NewService(appTemplateRepo, idSvc, fixScopesGetter())
 
 			// WHEN
-			result, err := svc.Create(ctx, *testCase.Input)
+			result, err := svc.Create(testCase.Context, *testCase.Input)
 
 			// THEN
 			if testCase.ExpectedError != nil {
@@ -98,7 +164,7 @@ func TestService_Get(t *testing.T) {
 			Name: "Success",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("Get", ctx, testID).Return(modelAppTemplate, nil).Once()
+				appTemplateRepo.On("Get", ctx, testTenant, testID).Return(modelAppTemplate, nil).Once()
 				return appTemplateRepo
 			},
 			ExpectedOutput: modelAppTemplate,
@@ -107,7 +173,7 @@ func TestService_Get(t *testing.T) {
 			Name: "Error when getting application template",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("Get", ctx, testID).Return(nil, testError).Once()
+				appTemplateRepo.On("Get", ctx, testTenant, testID).Return(nil, testError).Once()
 				return appTemplateRepo
 			},
 			ExpectedError: testError,
@@ -117,7 +183,7 @@ func TestService_Get(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, nil)
 
 			// WHEN
 			result, err := svc.Get(ctx, testID)
@@ -152,7 +218,7 @@ func TestService_GetByName(t *testing.T) {
 			Name: "Success",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("GetByName", ctx, testName).Return(modelAppTemplate, nil).Once()
+				appTemplateRepo.On("GetByName", ctx, testTenant, testName).Return(modelAppTemplate, nil).Once()
 				return appTemplateRepo
 			},
 			ExpectedOutput: modelAppTemplate,
@@ -161,7 +227,7 @@ func TestService_GetByName(t *testing.T) {
 			Name: "Error when getting application template",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("GetByName", ctx, testName).Return(nil, testError).Once()
+				appTemplateRepo.On("GetByName", ctx, testTenant, testName).Return(nil, testError).Once()
 				return appTemplateRepo
 			},
 			ExpectedError: testError,
@@ -171,7 +237,7 @@ func TestService_GetByName(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, nil)
 
 			// WHEN
 			result, err := svc.GetByName(ctx, testName)
@@ -190,6 +256,24 @@ func TestService_GetByName(t *testing.T) {
 	}
 }
 
+func TestService_GetByName_WithoutTenant(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	modelAppTemplate := fixModelAppTemplate(testID, testName)
+
+	appTemplateRepo := &automock.ApplicationTemplateRepository{}
+	appTemplateRepo.On("GetByName", ctx, "", testName).Return(modelAppTemplate, nil).Once()
+	svc := apptemplate.NewService(appTemplateRepo, nil, nil)
+
+	// WHEN
+	result, err := svc.GetByName(ctx, testName)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, modelAppTemplate, result)
+	appTemplateRepo.AssertExpectations(t)
+}
+
 func TestService_Exists(t *testing.T) {
 	// GIVEN
 	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
@@ -204,7 +288,7 @@ func TestService_Exists(t *testing.T) {
 			Name: "Success",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("Exists", ctx, testID).Return(true, nil).Once()
+				appTemplateRepo.On("Exists", ctx, testTenant, testID).Return(true, nil).Once()
 				return appTemplateRepo
 			},
 			ExpectedOutput: true,
@@ -213,7 +297,7 @@ func TestService_Exists(t *testing.T) {
 			Name: "Error when getting application template",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("Exists", ctx, testID).Return(false, testError).Once()
+				appTemplateRepo.On("Exists", ctx, testTenant, testID).Return(false, testError).Once()
 				return appTemplateRepo
 			},
 			ExpectedError:  testError,
@@ -224,7 +308,7 @@ func TestService_Exists(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, nil)
 
 			// WHEN
 			result, err := svc.Exists(ctx, testID)
@@ -262,7 +346,7 @@ func TestService_List(t *testing.T) {
 			Name: "Success",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("List", ctx, 50, testCursor).Return(modelAppTemplate, nil).Once()
+				appTemplateRepo.On("List", ctx, testTenant, 50, testCursor).Return(modelAppTemplate, nil).Once()
 				return appTemplateRepo
 			},
 			InputPageSize:  50,
@@ -272,7 +356,7 @@ func TestService_List(t *testing.T) {
 			Name: "Error when listing application template",
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("List", ctx, 50, testCursor).Return(model.ApplicationTemplatePage{}, testError).Once()
+				appTemplateRepo.On("List", ctx, testTenant, 50, testCursor).Return(model.ApplicationTemplatePage{}, testError).Once()
 				return appTemplateRepo
 			},
 			InputPageSize:  50,
@@ -304,7 +388,7 @@ func TestService_List(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, nil)
 
 			// WHEN
 			result, err := svc.List(ctx, testCase.InputPageSize, testCursor)
@@ -325,43 +409,98 @@ func TestService_List(t *testing.T) {
 
 func TestService_Update(t *testing.T) {
 	// GIVEN
-	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+	ctx := fixCtxWithTenantAndScopes(testTenant, testGlobalWriteScope, testTenantWriteScope)
+	tenantCtx := fixCtxWithTenantAndScopes(testTenant, testTenantWriteScope)
 	modelAppTemplate := fixModelAppTemplate(testID, testName)
+	modelTenantAppTemplate := fixModelTenantAppTemplate(testID, testName, testTenant)
+	updatedAppTemplate := *modelAppTemplate
+	updatedAppTemplate.Version = modelAppTemplate.Version + 1
+	updatedTenantAppTemplate := *modelTenantAppTemplate
+	updatedTenantAppTemplate.Version = modelTenantAppTemplate.Version + 1
 
 	testCases := []struct {
 		Name              string
+		Context           context.Context
 		Input             *model.ApplicationTemplateInput
 		AppTemplateRepoFn func() *automock.ApplicationTemplateRepository
 		ExpectedError     error
 	}{
 		{
-			Name:  "Success",
-			Input: fixModelAppTemplateInput(testName, appInputJSONString),
+			Name:    "Success",
+			Context: ctx,
+			Input:   fixModelAppTemplateInput(testName, appInputJSONString),
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Get", ctx, testTenant, testID).Return(modelAppTemplate, nil).Once()
+				appTemplateRepo.On("Update", ctx, updatedAppTemplate).Return(nil).Once()
+				return appTemplateRepo
+			},
+		},
+		{
+			Name:    "Success for tenant access level",
+			Context: tenantCtx,
+			Input:   fixModelTenantAppTemplateInput(testName, appInputJSONString),
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("Update", ctx, *modelAppTemplate).Return(nil).Once()
+				appTemplateRepo.On("Get", tenantCtx, testTenant, testID).Return(modelTenantAppTemplate, nil).Once()
+				appTemplateRepo.On("Update", tenantCtx, updatedTenantAppTemplate).Return(nil).Once()
 				return appTemplateRepo
 			},
 		},
 		{
-			Name:  "Error when updating application template",
-			Input: fixModelAppTemplateInput(testName, appInputJSONString),
+			Name:    "Error when updating application template",
+			Context: ctx,
+			Input:   fixModelAppTemplateInput(testName, appInputJSONString),
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("Update", ctx, *modelAppTemplate).Return(testError).Once()
+				appTemplateRepo.On("Get", ctx, testTenant, testID).Return(modelAppTemplate, nil).Once()
+				appTemplateRepo.On("Update", ctx, updatedAppTemplate).Return(testError).Once()
 				return appTemplateRepo
 			},
 			ExpectedError: testError,
 		},
+		{
+			Name:    "Error when getting application template",
+			Context: ctx,
+			Input:   fixModelAppTemplateInput(testName, appInputJSONString),
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Get", ctx, testTenant, testID).Return(nil, testError).Once()
+				return appTemplateRepo
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name:    "Error when tenant admin updates global application template",
+			Context: tenantCtx,
+			Input:   fixModelTenantAppTemplateInput(testName, appInputJSONString),
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Get", tenantCtx, testTenant, testID).Return(modelAppTemplate, nil).Once()
+				return appTemplateRepo
+			},
+			ExpectedError: errors.New("insufficient scopes provided"),
+		},
+		{
+			Name:    "Error when tenant admin changes access level to global",
+			Context: tenantCtx,
+			Input:   fixModelAppTemplateInput(testName, appInputJSONString),
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Get", tenantCtx, testTenant, testID).Return(modelTenantAppTemplate, nil).Once()
+				return appTemplateRepo
+			},
+			ExpectedError: errors.New("insufficient scopes provided"),
+		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, fixScopesGetter())
 
 			// WHEN
-			err := svc.Update(ctx, testID, *testCase.Input)
+			err := svc.Update(testCase.Context, testID, *testCase.Input)
 
 			// THEN
 			if testCase.ExpectedError != nil {
@@ -378,39 +517,77 @@ func TestService_Update(t *testing.T) {
 
 func TestService_Delete(t *testing.T) {
 	// GIVEN
-	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+	ctx := fixCtxWithTenantAndScopes(testTenant, testGlobalWriteScope, testTenantWriteScope)
+	tenantCtx := fixCtxWithTenantAndScopes(testTenant, testTenantWriteScope)
+	modelAppTemplate := fixModelAppTemplate(testID, testName)
+	modelTenantAppTemplate := fixModelTenantAppTemplate(testID, testName, testTenant)
 
 	testCases := []struct {
 		Name              string
+		Context           context.Context
 		AppTemplateRepoFn func() *automock.ApplicationTemplateRepository
 		ExpectedError     error
 	}{
 		{
-			Name: "Success",
+			Name:    "Success",
+			Context: ctx,
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("Delete", ctx, testID).Return(nil).Once()
+				appTemplateRepo.On("Get", ctx, testTenant, testID).Return(modelAppTemplate, nil).Once()
+				appTemplateRepo.On("Delete", ctx, testTenant, testID).Return(nil).Once()
 				return appTemplateRepo
 			},
 		},
 		{
-			Name: "Error when deleting application template",
+			Name:    "Success for tenant access level",
+			Context: tenantCtx,
 			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
 				appTemplateRepo := &automock.ApplicationTemplateRepository{}
-				appTemplateRepo.On("Delete", ctx, testID).Return(testError).Once()
+				appTemplateRepo.On("Get", tenantCtx, testTenant, testID).Return(modelTenantAppTemplate, nil).Once()
+				appTemplateRepo.On("Delete", tenantCtx, testTenant, testID).Return(nil).Once()
+				return appTemplateRepo
+			},
+		},
+		{
+			Name:    "Error when deleting application template",
+			Context: ctx,
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Get", ctx, testTenant, testID).Return(modelAppTemplate, nil).Once()
+				appTemplateRepo.On("Delete", ctx, testTenant, testID).Return(testError).Once()
 				return appTemplateRepo
 			},
 			ExpectedError: testError,
 		},
+		{
+			Name:    "Error when getting application template",
+			Context: ctx,
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Get", ctx, testTenant, testID).Return(nil, testError).Once()
+				return appTemplateRepo
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name:    "Error when tenant admin deletes global application template",
+			Context: tenantCtx,
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				appTemplateRepo := &automock.ApplicationTemplateRepository{}
+				appTemplateRepo.On("Get", tenantCtx, testTenant, testID).Return(modelAppTemplate, nil).Once()
+				return appTemplateRepo
+			},
+			ExpectedError: errors.New("insufficient scopes provided"),
+		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			appTemplateRepo := testCase.AppTemplateRepoFn()
-			svc := apptemplate.NewService(appTemplateRepo, nil)
+			svc := apptemplate.NewService(appTemplateRepo, nil, fixScopesGetter())
 
 			// WHEN
-			err := svc.Delete(ctx, testID)
+			err := svc.Delete(testCase.Context, testID)
 
 			// THEN
 			if testCase.ExpectedError != nil {
@@ -427,7 +604,7 @@ func TestService_Delete(t *testing.T) {
 
 func TestService_PrepareApplicationCreateInputJSON(t *testing.T) {
 	// GIVEN
-	svc := apptemplate.NewService(nil, nil)
+	svc := apptemplate.NewService(nil, nil, nil)
 
 	testCases := []struct {
 		Name             string
@@ -460,6 +637,67 @@ func TestService_PrepareApplicationCreateInputJSON(t *testing.T) {
 			ExpectedOutput: `{"Name": "my-application", "Description": "Lorem ipsum"}`,
 			ExpectedError:  nil,
 		},
+		{
+			Name: "Success when value contains JSON special characters",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Name": "my-app", "Description": "Description: {{description}}"}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "description"},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "description", Value: `foo", "IntegrationSystemID": "{bar}`},
+			},
+			ExpectedOutput: `{"Name": "my-app", "Description": "Description: foo\", \"IntegrationSystemID\": \"{bar}"}`,
+			ExpectedError:  nil,
+		},
+		{
+			Name: "Success when with typed placeholders",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Name": "my-app", "HealthCheckURL": "{{url}}", "Labels": {"managed": "{{managed}}", "replicas": "{{replicas}}", "region": "{{region}}", "info": "{{replicas}} replicas in {{region}}"}}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "url", Type: model.URLApplicationTemplatePlaceholderType},
+					{Name: "managed", Type: model.BooleanApplicationTemplatePlaceholderType},
+					{Name: "replicas", Type: model.NumberApplicationTemplatePlaceholderType},
+					{Name: "region", Type: model.EnumApplicationTemplatePlaceholderType, AllowedValues: []string{"eu", "us"}},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "url", Value: "https://foo.bar?a=b&c=d"},
+				{Placeholder: "managed", Value: "true"},
+				{Placeholder: "replicas", Value: "3"},
+				{Placeholder: "region", Value: "eu"},
+			},
+			ExpectedOutput: `{"Name": "my-app", "HealthCheckURL": "https://foo.bar?a=b&c=d", "Labels": {"managed": true, "replicas": 3, "region": "eu", "info": "3 replicas in eu"}}`,
+			ExpectedError:  nil,
+		},
+		{
+			Name: "Success when default and optional values are used",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Name": "{{name}}", "Description": "{{description}}", "ProviderName": "provider {{description}}"}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "name", DefaultValue: str.Ptr("default-name")},
+					{Name: "description", Optional: true},
+				},
+			},
+			InputValues:    []*model.ApplicationTemplateValueInput{},
+			ExpectedOutput: `{"Name": "default-name", "Description": null, "ProviderName": "provider "}`,
+			ExpectedError:  nil,
+		},
+		{
+			Name: "Success when value matches JSON schema",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Name": "{{name}}"}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "name", JSONSchema: str.Ptr(`{"type": "string", "pattern": "^app-"}`)},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: "app-1"},
+			},
+			ExpectedOutput: `{"Name": "app-1"}`,
+			ExpectedError:  nil,
+		},
 		{
 			Name: "Returns error when required placeholder value not provided",
 			InputAppTemplate: &model.ApplicationTemplate{
@@ -472,6 +710,84 @@ func TestService_PrepareApplicationCreateInputJSON(t *testing.T) {
 			ExpectedOutput: "",
 			ExpectedError:  errors.New("required placeholder not provided: value for placeholder name 'name' not found"),
 		},
+		{
+			Name: "Returns error when value is not a valid URL",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"HealthCheckURL": "{{url}}"}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "url", Type: model.URLApplicationTemplatePlaceholderType},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "url", Value: "foo"},
+			},
+			ExpectedError: errors.New("value for placeholder url must be a valid URL"),
+		},
+		{
+			Name: "Returns error when value is not allowed",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Name": "{{region}}"}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "region", Type: model.EnumApplicationTemplatePlaceholderType, AllowedValues: []string{"eu", "us"}},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "region", Value: "asia"},
+			},
+			ExpectedError: errors.New("value for placeholder region must be one of: eu, us"),
+		},
+		{
+			Name: "Returns error when value is not a boolean",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Labels": {"managed": "{{managed}}"}}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "managed", Type: model.BooleanApplicationTemplatePlaceholderType},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "managed", Value: "yes"},
+			},
+			ExpectedError: errors.New("value for placeholder managed must be a boolean"),
+		},
+		{
+			Name: "Returns error when value is not a number",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Labels": {"replicas": "{{replicas}}"}}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "replicas", Type: model.NumberApplicationTemplatePlaceholderType},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "replicas", Value: "NaN"},
+			},
+			ExpectedError: errors.New("value for placeholder replicas must be a number"),
+		},
+		{
+			Name: "Returns error when value does not match JSON schema",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Name": "{{name}}"}`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "name", JSONSchema: str.Ptr(`{"type": "string", "pattern": "^app-"}`)},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: "foo"},
+			},
+			ExpectedError: errors.New("value for placeholder name does not match JSON schema"),
+		},
+		{
+			Name: "Returns error when application input is not a valid JSON",
+			InputAppTemplate: &model.ApplicationTemplate{
+				ApplicationInputJSON: `{"Name": "{{name}}"`,
+				Placeholders: []model.ApplicationTemplatePlaceholder{
+					{Name: "name"},
+				},
+			},
+			InputValues: []*model.ApplicationTemplateValueInput{
+				{Placeholder: "name", Value: "foo"},
+			},
+			ExpectedError: errors.New("while unmarshalling application input JSON"),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -486,7 +802,7 @@ func TestService_PrepareApplicationCreateInputJSON(t *testing.T) {
 				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
 			} else {
 				require.NoError(t, err)
-				assert.Equal(t, testCase.ExpectedOutput, result)
+				assert.JSONEq(t, testCase.ExpectedOutput, result)
 			}
 		})
 	}
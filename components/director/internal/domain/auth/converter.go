@@ -59,7 +59,10 @@ func (c *converter) InputFromGraphQL(in *graphql.AuthInput) (*model.AuthInput, e
 		return nil, nil
 	}
 
-	credential := c.credentialInputFromGraphQL(in.Credential)
+	credential, err := c.credentialInputFromGraphQL(in.Credential)
+	if err != nil {
+		return nil, err
+	}
 
 	additionalHeaders, err := c.headersFromGraphQL(in.AdditionalHeaders, in.AdditionalHeadersSerialized)
 	if err != nil {
@@ -133,11 +136,16 @@ func (c *converter) requestAuthInputFromGraphQL(in *graphql.CredentialRequestAut
 			return nil, errors.Wrap(err, "while converting CSRF AdditionalQueryParams from GraphQL input")
 		}
 
+		credential, err := c.credentialInputFromGraphQL(in.Csrf.Credential)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting CSRF Credential from GraphQL input")
+		}
+
 		csrf = &model.CSRFTokenCredentialRequestAuthInput{
 			TokenEndpointURL:      in.Csrf.TokenEndpointURL,
 			AdditionalQueryParams: additionalQueryParams,
 			AdditionalHeaders:     additionalHeaders,
-			Credential:            c.credentialInputFromGraphQL(in.Csrf.Credential),
+			Credential:            credential,
 		}
 	}
 
@@ -170,13 +178,14 @@ func (c *converter) queryParamsFromGraphQL(params *graphql.QueryParams, paramsSe
 	return p, nil
 }
 
-func (c *converter) credentialInputFromGraphQL(in *graphql.CredentialDataInput) *model.CredentialDataInput {
+func (c *converter) credentialInputFromGraphQL(in *graphql.CredentialDataInput) (*model.CredentialDataInput, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var basic *model.BasicCredentialDataInput
 	var oauth *model.OAuthCredentialDataInput
+	var certificateGen *model.CertificateGenCredentialDataInput
 
 	if in.Basic != nil {
 		basic = &model.BasicCredentialDataInput{
@@ -184,17 +193,36 @@ func (c *converter) credentialInputFromGraphQL(in *graphql.CredentialDataInput)
 			Password: in.Basic.Password,
 		}
 	} else if in.Oauth != nil {
+		additionalHeaders, err := c.headersFromGraphQL(nil, in.Oauth.AdditionalHeadersSerialized)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting OAuth AdditionalHeaders from GraphQL input")
+		}
+
+		additionalQueryParams, err := c.queryParamsFromGraphQL(nil, in.Oauth.AdditionalQueryParamsSerialized)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting OAuth AdditionalQueryParams from GraphQL input")
+		}
+
 		oauth = &model.OAuthCredentialDataInput{
-			URL:          in.Oauth.URL,
-			ClientID:     in.Oauth.ClientID,
-			ClientSecret: in.Oauth.ClientSecret,
+			URL:                   in.Oauth.URL,
+			ClientID:              in.Oauth.ClientID,
+			ClientSecret:          in.Oauth.ClientSecret,
+			AdditionalHeaders:     additionalHeaders,
+			AdditionalQueryParams: additionalQueryParams,
+		}
+	} else if in.CertificateGen != nil {
+		certificateGen = &model.CertificateGenCredentialDataInput{
+			CommonName:  in.CertificateGen.CommonName,
+			Certificate: in.CertificateGen.Certificate,
+			PrivateKey:  in.CertificateGen.PrivateKey,
 		}
 	}
 
 	return &model.CredentialDataInput{
-		Basic: basic,
-		Oauth: oauth,
-	}
+		Basic:          basic,
+		Oauth:          oauth,
+		CertificateGen: certificateGen,
+	}, nil
 }
 
 func (c *converter) credentialToGraphQL(in model.CredentialData) graphql.CredentialData {
@@ -205,10 +233,30 @@ func (c *converter) credentialToGraphQL(in model.CredentialData) graphql.Credent
 			Password: in.Basic.Password,
 		}
 	} else if in.Oauth != nil {
+		var headers *graphql.HttpHeaders
+		if len(in.Oauth.AdditionalHeaders) != 0 {
+			var value graphql.HttpHeaders = in.Oauth.AdditionalHeaders
+			headers = &value
+		}
+
+		var params *graphql.QueryParams
+		if len(in.Oauth.AdditionalQueryParams) != 0 {
+			var value graphql.QueryParams = in.Oauth.AdditionalQueryParams
+			params = &value
+		}
+
 		credential = graphql.OAuthCredentialData{
-			URL:          in.Oauth.URL,
-			ClientID:     in.Oauth.ClientID,
-			ClientSecret: in.Oauth.ClientSecret,
+			URL:                   in.Oauth.URL,
+			ClientID:              in.Oauth.ClientID,
+			ClientSecret:          in.Oauth.ClientSecret,
+			AdditionalHeaders:     headers,
+			AdditionalQueryParams: params,
+		}
+	} else if in.CertificateGen != nil {
+		credential = graphql.CertificateGenCredentialData{
+			CommonName:  in.CertificateGen.CommonName,
+			Certificate: in.CertificateGen.Certificate,
+			PrivateKey:  in.CertificateGen.PrivateKey,
 		}
 	}
 
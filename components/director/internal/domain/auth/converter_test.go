@@ -22,6 +22,16 @@ func TestConverter_ToGraphQL(t *testing.T) {
 			Input:    fixDetailedAuth(),
 			Expected: fixDetailedGQLAuth(),
 		},
+		{
+			Name:     "OAuth credential with request parameters",
+			Input:    &model.Auth{Credential: fixOAuthCredentialData()},
+			Expected: &graphql.Auth{Credential: fixGQLOAuthCredentialData()},
+		},
+		{
+			Name:     "Certificate generation credential",
+			Input:    &model.Auth{Credential: fixCertificateGenCredentialData()},
+			Expected: &graphql.Auth{Credential: fixGQLCertificateGenCredentialData()},
+		},
 		{
 			Name:     "Empty",
 			Input:    &model.Auth{},
@@ -64,6 +74,24 @@ func TestConverter_InputFromGraphQL(t *testing.T) {
 			Input:    fixDetailedGQLAuthInputDeprecated(),
 			Expected: fixDetailedAuthInput(),
 		},
+		{
+			Name: "OAuth credential with request parameters",
+			Input: &graphql.AuthInput{Credential: &graphql.CredentialDataInput{
+				Oauth: fixGQLOAuthCredentialDataInput(),
+			}},
+			Expected: &model.AuthInput{Credential: &model.CredentialDataInput{
+				Oauth: fixOAuthCredentialDataInput(),
+			}},
+		},
+		{
+			Name: "Certificate generation credential",
+			Input: &graphql.AuthInput{Credential: &graphql.CredentialDataInput{
+				CertificateGen: fixGQLCertificateGenCredentialDataInput(),
+			}},
+			Expected: &model.AuthInput{Credential: &model.CredentialDataInput{
+				CertificateGen: fixCertificateGenCredentialDataInput(),
+			}},
+		},
 		{
 			Name:     "Empty",
 			Input:    &graphql.AuthInput{},
@@ -83,3 +111,21 @@ func TestConverter_InputFromGraphQL(t *testing.T) {
 		})
 	}
 }
+
+func TestConverter_InputFromGraphQL_InvalidOAuthRequestParameters(t *testing.T) {
+	// given
+	invalidSerialized := graphql.HttpHeadersSerialized("not-a-json")
+	input := &graphql.AuthInput{Credential: &graphql.CredentialDataInput{
+		Oauth: &graphql.OAuthCredentialDataInput{
+			AdditionalHeadersSerialized: &invalidSerialized,
+		},
+	}}
+	converter := auth.NewConverter()
+
+	// when
+	_, err := converter.InputFromGraphQL(input)
+
+	// then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "while converting OAuth AdditionalHeaders from GraphQL input")
+}
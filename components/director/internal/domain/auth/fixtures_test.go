@@ -18,6 +18,10 @@ var (
 	authHeadersSerialized = graphql.HttpHeadersSerialized(authMapSerialized)
 	authParams            = graphql.QueryParams(authMap)
 	authParamsSerialized  = graphql.QueryParamsSerialized(authMapSerialized)
+
+	authCommonName  = "app-name"
+	authCertificate = "certificate"
+	authPrivateKey  = "private-key"
 )
 
 func fixDetailedAuth() *model.Auth {
@@ -155,3 +159,79 @@ func fixDetailedGQLAuthInputDeprecated() *graphql.AuthInput {
 		},
 	}
 }
+
+func fixOAuthCredentialData() model.CredentialData {
+	return model.CredentialData{
+		Oauth: &model.OAuthCredentialData{
+			ClientID:              authUsername,
+			ClientSecret:          authPassword,
+			URL:                   authEndpoint,
+			AdditionalHeaders:     authMap,
+			AdditionalQueryParams: authMap,
+		},
+	}
+}
+
+func fixGQLOAuthCredentialData() graphql.CredentialData {
+	return graphql.OAuthCredentialData{
+		ClientID:              authUsername,
+		ClientSecret:          authPassword,
+		URL:                   authEndpoint,
+		AdditionalHeaders:     &authHeaders,
+		AdditionalQueryParams: &authParams,
+	}
+}
+
+func fixOAuthCredentialDataInput() *model.OAuthCredentialDataInput {
+	return &model.OAuthCredentialDataInput{
+		ClientID:              authUsername,
+		ClientSecret:          authPassword,
+		URL:                   authEndpoint,
+		AdditionalHeaders:     authMap,
+		AdditionalQueryParams: authMap,
+	}
+}
+
+func fixGQLOAuthCredentialDataInput() *graphql.OAuthCredentialDataInput {
+	return &graphql.OAuthCredentialDataInput{
+		ClientID:                        authUsername,
+		ClientSecret:                    authPassword,
+		URL:                             authEndpoint,
+		AdditionalHeadersSerialized:     &authHeadersSerialized,
+		AdditionalQueryParamsSerialized: &authParamsSerialized,
+	}
+}
+
+func fixCertificateGenCredentialData() model.CredentialData {
+	return model.CredentialData{
+		CertificateGen: &model.CertificateGenCredentialData{
+			CommonName:  authCommonName,
+			Certificate: authCertificate,
+			PrivateKey:  authPrivateKey,
+		},
+	}
+}
+
+func fixGQLCertificateGenCredentialData() graphql.CredentialData {
+	return graphql.CertificateGenCredentialData{
+		CommonName:  authCommonName,
+		Certificate: authCertificate,
+		PrivateKey:  authPrivateKey,
+	}
+}
+
+func fixCertificateGenCredentialDataInput() *model.CertificateGenCredentialDataInput {
+	return &model.CertificateGenCredentialDataInput{
+		CommonName:  authCommonName,
+		Certificate: authCertificate,
+		PrivateKey:  authPrivateKey,
+	}
+}
+
+func fixGQLCertificateGenCredentialDataInput() *graphql.CertificateGenCredentialDataInput {
+	return &graphql.CertificateGenCredentialDataInput{
+		CommonName:  authCommonName,
+		Certificate: authCertificate,
+		PrivateKey:  authPrivateKey,
+	}
+}
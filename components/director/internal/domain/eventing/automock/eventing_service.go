@@ -17,6 +17,29 @@ type EventingService struct {
 	mock.Mock
 }
 
+// ListBindings provides a mock function with given fields: ctx
+func (_m *EventingService) ListBindings(ctx context.Context) ([]*model.ApplicationEventingBinding, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.ApplicationEventingBinding
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.ApplicationEventingBinding); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ApplicationEventingBinding)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetForApplication provides a mock function with given fields: ctx, runtimeID, app
 func (_m *EventingService) SetForApplication(ctx context.Context, runtimeID uuid.UUID, app model.Application) (*model.ApplicationEventingConfiguration, error) {
 	ret := _m.Called(ctx, runtimeID, app)
@@ -40,6 +63,29 @@ func (_m *EventingService) SetForApplication(ctx context.Context, runtimeID uuid
 	return r0, r1
 }
 
+// SetPolicyForApplication provides a mock function with given fields: ctx, app, policy
+func (_m *EventingService) SetPolicyForApplication(ctx context.Context, app model.Application, policy model.EventingPolicy) (*model.ApplicationEventingConfiguration, error) {
+	ret := _m.Called(ctx, app, policy)
+
+	var r0 *model.ApplicationEventingConfiguration
+	if rf, ok := ret.Get(0).(func(context.Context, model.Application, model.EventingPolicy) *model.ApplicationEventingConfiguration); ok {
+		r0 = rf(ctx, app, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationEventingConfiguration)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.Application, model.EventingPolicy) error); ok {
+		r1 = rf(ctx, app, policy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnsetForApplication provides a mock function with given fields: ctx, app
 func (_m *EventingService) UnsetForApplication(ctx context.Context, app model.Application) (*model.ApplicationEventingConfiguration, error) {
 	ret := _m.Called(ctx, app)
@@ -66,6 +66,52 @@ func (_m *LabelRepository) GetByKey(ctx context.Context, tenant string, objectTy
 	return r0, r1
 }
 
+// ListByKeyPattern provides a mock function with given fields: ctx, tenant, objectType, keyPattern
+func (_m *LabelRepository) ListByKeyPattern(ctx context.Context, tenant string, objectType model.LabelableObject, keyPattern string) ([]*model.Label, error) {
+	ret := _m.Called(ctx, tenant, objectType, keyPattern)
+
+	var r0 []*model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.LabelableObject, string) []*model.Label); ok {
+		r0 = rf(ctx, tenant, objectType, keyPattern)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.LabelableObject, string) error); ok {
+		r1 = rf(ctx, tenant, objectType, keyPattern)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListForObject provides a mock function with given fields: ctx, tenant, objectType, objectID
+func (_m *LabelRepository) ListForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) (map[string]*model.Label, error) {
+	ret := _m.Called(ctx, tenant, objectType, objectID)
+
+	var r0 map[string]*model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.LabelableObject, string) map[string]*model.Label); ok {
+		r0 = rf(ctx, tenant, objectType, objectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.LabelableObject, string) error); ok {
+		r1 = rf(ctx, tenant, objectType, objectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Upsert provides a mock function with given fields: ctx, label
 func (_m *LabelRepository) Upsert(ctx context.Context, label *model.Label) error {
 	ret := _m.Called(ctx, label)
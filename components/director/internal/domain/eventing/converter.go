@@ -24,3 +24,50 @@ func ApplicationEventingConfigurationToGraphQL(in *model.ApplicationEventingConf
 		DefaultURL: in.DefaultURL.String(),
 	}
 }
+
+func EventingPolicyFromGraphQL(in graphql.EventingPolicyInput) model.EventingPolicy {
+	policy := model.EventingPolicy{
+		Type: model.EventingPolicyType(in.Type),
+	}
+
+	if in.PreferredLabel != nil {
+		policy.PreferredLabel = &model.LabelSelector{
+			Key:   in.PreferredLabel.Key,
+			Value: in.PreferredLabel.Value,
+		}
+	}
+
+	return policy
+}
+
+func EventingPolicyToGraphQL(in model.EventingPolicy) *graphql.EventingPolicy {
+	policy := &graphql.EventingPolicy{
+		Type: graphql.EventingPolicyType(in.Type),
+	}
+
+	if in.PreferredLabel != nil {
+		policy.PreferredLabel = &graphql.Label{
+			Key:   in.PreferredLabel.Key,
+			Value: in.PreferredLabel.Value,
+		}
+	}
+
+	return policy
+}
+
+func ApplicationEventingBindingsToGraphQL(in []*model.ApplicationEventingBinding) []*graphql.ApplicationEventingBinding {
+	bindings := make([]*graphql.ApplicationEventingBinding, 0, len(in))
+	for _, binding := range in {
+		if binding == nil {
+			continue
+		}
+
+		bindings = append(bindings, &graphql.ApplicationEventingBinding{
+			ApplicationID: binding.ApplicationID,
+			RuntimeID:     binding.RuntimeID,
+			Policy:        EventingPolicyToGraphQL(binding.Policy),
+		})
+	}
+
+	return bindings
+}
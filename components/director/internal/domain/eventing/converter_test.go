@@ -70,3 +70,70 @@ func Test_ApplicationEventingConfigurationToGraphQL(t *testing.T) {
 		})
 	}
 }
+
+func Test_EventingPolicyFromGraphQL(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    graphql.EventingPolicyInput
+		Expected model.EventingPolicy
+	}{
+		{
+			Name: "Policy with preferred label",
+			Input: graphql.EventingPolicyInput{
+				Type:           graphql.EventingPolicyTypeLabelPreferred,
+				PreferredLabel: &graphql.LabelSelectorInput{Key: "region", Value: "eu"},
+			},
+			Expected: model.EventingPolicy{
+				Type:           model.EventingPolicyLabelPreferred,
+				PreferredLabel: &model.LabelSelector{Key: "region", Value: "eu"},
+			},
+		}, {
+			Name: "Policy without preferred label",
+			Input: graphql.EventingPolicyInput{
+				Type: graphql.EventingPolicyTypePinned,
+			},
+			Expected: model.EventingPolicy{
+				Type: model.EventingPolicyPinned,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			policy := EventingPolicyFromGraphQL(testCase.Input)
+
+			require.Equal(t, testCase.Expected, policy)
+		})
+	}
+}
+
+func Test_ApplicationEventingBindingsToGraphQL(t *testing.T) {
+	// GIVEN
+	input := []*model.ApplicationEventingBinding{
+		{
+			ApplicationID: applicationID.String(),
+			RuntimeID:     runtimeID.String(),
+			Policy: model.EventingPolicy{
+				Type:           model.EventingPolicyLabelPreferred,
+				PreferredLabel: &model.LabelSelector{Key: "region", Value: "eu"},
+			},
+		},
+		nil,
+	}
+	expected := []*graphql.ApplicationEventingBinding{
+		{
+			ApplicationID: applicationID.String(),
+			RuntimeID:     runtimeID.String(),
+			Policy: &graphql.EventingPolicy{
+				Type:           graphql.EventingPolicyTypeLabelPreferred,
+				PreferredLabel: &graphql.Label{Key: "region", Value: "eu"},
+			},
+		},
+	}
+
+	// WHEN
+	bindings := ApplicationEventingBindingsToGraphQL(input)
+
+	// THEN
+	require.Equal(t, expected, bindings)
+}
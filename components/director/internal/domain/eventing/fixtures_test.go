@@ -177,3 +177,29 @@ func fixAppEventURL(t *testing.T, appName string) url.URL {
 	eventURL := fmt.Sprintf(eventURLSchema, appName)
 	return fixValidURL(t, eventURL)
 }
+
+func fixApplicationEventingPolicyLabel(value interface{}) *model.Label {
+	return &model.Label{
+		ID:         uuid.New().String(),
+		Key:        ApplicationEventingPolicyLabel,
+		ObjectID:   applicationID.String(),
+		ObjectType: model.ApplicationLabelableObject,
+		Tenant:     tenantID.String(),
+		Value:      value,
+	}
+}
+
+func fixRuntimeDefaultEventingLabel() *model.Label {
+	return &model.Label{
+		ID:         uuid.New().String(),
+		Key:        getDefaultEventingForAppLabelKey(applicationID),
+		ObjectID:   runtimeID.String(),
+		ObjectType: model.RuntimeLabelableObject,
+		Tenant:     tenantID.String(),
+		Value:      "true",
+	}
+}
+
+func fixLabelFilterForRuntimeScenariosAndPreferredLabel() []*labelfilter.LabelFilter {
+	return append(fixLabelFilterForRuntimeScenarios(), labelfilter.NewForKeyWithQuery("region", `"eu"`))
+}
@@ -0,0 +1,65 @@
+package eventing
+
+import (
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+)
+
+// policyLabelValue is the form in which the eventing policy is stored as an Application label
+type policyLabelValue struct {
+	Type           model.EventingPolicyType `json:"type"`
+	PreferredLabel *labelSelectorValue      `json:"preferredLabel,omitempty"`
+}
+
+type labelSelectorValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func newPolicyLabelValue(policy model.EventingPolicy) policyLabelValue {
+	value := policyLabelValue{
+		Type: policy.Type,
+	}
+
+	if policy.PreferredLabel != nil {
+		value.PreferredLabel = &labelSelectorValue{
+			Key:   policy.PreferredLabel.Key,
+			Value: policy.PreferredLabel.Value,
+		}
+	}
+
+	return value
+}
+
+func policyFromLabelValue(labelValue interface{}) (model.EventingPolicy, error) {
+	marshalled, err := json.Marshal(labelValue)
+	if err != nil {
+		return model.EventingPolicy{}, errors.Wrap(err, "while marshalling eventing policy label value")
+	}
+
+	var value policyLabelValue
+	if err := json.Unmarshal(marshalled, &value); err != nil {
+		return model.EventingPolicy{}, errors.Wrap(err, "while unmarshalling eventing policy label value")
+	}
+
+	switch value.Type {
+	case model.EventingPolicyPinned, model.EventingPolicyOldest, model.EventingPolicyLabelPreferred:
+	default:
+		return model.EventingPolicy{}, errors.Errorf("unknown eventing policy type %q", value.Type)
+	}
+
+	policy := model.EventingPolicy{
+		Type: value.Type,
+	}
+
+	if value.PreferredLabel != nil {
+		policy.PreferredLabel = &model.LabelSelector{
+			Key:   value.PreferredLabel.Key,
+			Value: value.PreferredLabel.Value,
+		}
+	}
+
+	return policy, nil
+}
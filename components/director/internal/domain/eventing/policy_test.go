@@ -0,0 +1,59 @@
+package eventing
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_policyFromLabelValue(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Input         interface{}
+		Expected      model.EventingPolicy
+		ExpectedError string
+	}{
+		{
+			Name:     "Oldest policy",
+			Input:    map[string]interface{}{"type": "OLDEST"},
+			Expected: model.EventingPolicy{Type: model.EventingPolicyOldest},
+		}, {
+			Name: "Label preferred policy",
+			Input: map[string]interface{}{
+				"type":           "LABEL_PREFERRED",
+				"preferredLabel": map[string]interface{}{"key": "region", "value": "eu"},
+			},
+			Expected: model.EventingPolicy{
+				Type:           model.EventingPolicyLabelPreferred,
+				PreferredLabel: &model.LabelSelector{Key: "region", Value: "eu"},
+			},
+		}, {
+			Name:     "Value stored by newPolicyLabelValue",
+			Input:    newPolicyLabelValue(model.EventingPolicy{Type: model.EventingPolicyPinned}),
+			Expected: model.EventingPolicy{Type: model.EventingPolicyPinned},
+		}, {
+			Name:          "Unknown policy type",
+			Input:         map[string]interface{}{"type": "NEWEST"},
+			ExpectedError: `unknown eventing policy type "NEWEST"`,
+		}, {
+			Name:          "Invalid label value",
+			Input:         "OLDEST",
+			ExpectedError: "while unmarshalling eventing policy label value",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			policy, err := policyFromLabelValue(testCase.Input)
+
+			if testCase.ExpectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.Expected, policy)
+		})
+	}
+}
@@ -14,6 +14,8 @@ import (
 type EventingService interface {
 	SetForApplication(ctx context.Context, runtimeID uuid.UUID, app model.Application) (*model.ApplicationEventingConfiguration, error)
 	UnsetForApplication(ctx context.Context, app model.Application) (*model.ApplicationEventingConfiguration, error)
+	SetPolicyForApplication(ctx context.Context, app model.Application, policy model.EventingPolicy) (*model.ApplicationEventingConfiguration, error)
+	ListBindings(ctx context.Context) ([]*model.ApplicationEventingBinding, error)
 }
 
 //go:generate mockery -name=ApplicationService -output=automock -outpkg=automock -case=underscore
@@ -101,3 +103,55 @@ func (r *Resolver) UnsetEventingForApplication(ctx context.Context, appID string
 
 	return ApplicationEventingConfigurationToGraphQL(eventingCfg), nil
 }
+
+func (r *Resolver) SetEventingPolicyForApplication(ctx context.Context, appID string, in graphql.EventingPolicyInput) (*graphql.ApplicationEventingConfiguration, error) {
+	appUUID, err := uuid.Parse(appID)
+	if err != nil {
+		return nil, errors.Wrap(err, "while parsing application ID as UUID")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening the transaction")
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	app, err := r.appSvc.Get(ctx, appUUID.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting application")
+	}
+
+	eventingCfg, err := r.eventingSvc.SetPolicyForApplication(ctx, *app, EventingPolicyFromGraphQL(in))
+	if err != nil {
+		return nil, errors.Wrap(err, "while setting eventing policy for application")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while commiting the transaction")
+	}
+
+	return ApplicationEventingConfigurationToGraphQL(eventingCfg), nil
+}
+
+func (r *Resolver) EventingBindings(ctx context.Context) ([]*graphql.ApplicationEventingBinding, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening the transaction")
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	bindings, err := r.eventingSvc.ListBindings(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing eventing bindings")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while commiting the transaction")
+	}
+
+	return ApplicationEventingBindingsToGraphQL(bindings), nil
+}
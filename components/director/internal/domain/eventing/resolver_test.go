@@ -6,6 +6,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/eventing/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
@@ -305,3 +306,244 @@ func TestResolver_UnsetDefaultEventingForApplication(t *testing.T) {
 		assert.Nil(t, result)
 	})
 }
+
+func TestResolver_SetEventingPolicyForApplication(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID.String(), externalTenantID.String())
+
+	app := fixApplicationModel("test-app")
+
+	testErr := errors.New("this is a test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	defaultEveningURL := "https://eventing.domain.local/test-app/events/v1"
+	modelAppEventingCfg := fixModelApplicationEventingConfiguration(t, defaultEveningURL)
+	gqlAppEventingCfg := fixGQLApplicationEventingConfiguration(defaultEveningURL)
+
+	gqlPolicy := graphql.EventingPolicyInput{
+		Type:           graphql.EventingPolicyTypeLabelPreferred,
+		PreferredLabel: &graphql.LabelSelectorInput{Key: "region", Value: "eu"},
+	}
+	modelPolicy := model.EventingPolicy{
+		Type:           model.EventingPolicyLabelPreferred,
+		PreferredLabel: &model.LabelSelector{Key: "region", Value: "eu"},
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		EventingSvcFn   func() *automock.EventingService
+		AppSvcFn        func() *automock.ApplicationService
+		ExpectedOutput  *graphql.ApplicationEventingConfiguration
+		ExpectedError   error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			EventingSvcFn: func() *automock.EventingService {
+				eventingSvc := &automock.EventingService{}
+				eventingSvc.On("SetPolicyForApplication", txtest.CtxWithDBMatcher(), app, modelPolicy).
+					Return(modelAppEventingCfg, nil).Once()
+				return eventingSvc
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), applicationID.String()).Return(&app, nil)
+				return appSvc
+			},
+			ExpectedOutput: gqlAppEventingCfg,
+			ExpectedError:  nil,
+		}, {
+			Name:            "Error when setting the eventing policy for the application",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			EventingSvcFn: func() *automock.EventingService {
+				eventingSvc := &automock.EventingService{}
+				eventingSvc.On("SetPolicyForApplication", txtest.CtxWithDBMatcher(), app, modelPolicy).
+					Return(nil, testErr).Once()
+				return eventingSvc
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), applicationID.String()).Return(&app, nil)
+				return appSvc
+			},
+			ExpectedOutput: nil,
+			ExpectedError:  testErr,
+		}, {
+			Name:            "Error when getting application",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			EventingSvcFn: func() *automock.EventingService {
+				eventingSvc := &automock.EventingService{}
+				return eventingSvc
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), applicationID.String()).Return(nil, testErr)
+				return appSvc
+			},
+			ExpectedOutput: nil,
+			ExpectedError:  testErr,
+		}, {
+			Name:            "Error when beginning transaction",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			EventingSvcFn: func() *automock.EventingService {
+				eventingSvc := &automock.EventingService{}
+				return eventingSvc
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				return appSvc
+			},
+			ExpectedOutput: nil,
+			ExpectedError:  testErr,
+		}, {
+			Name:            "Error when committing transaction",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			EventingSvcFn: func() *automock.EventingService {
+				eventingSvc := &automock.EventingService{}
+				eventingSvc.On("SetPolicyForApplication", txtest.CtxWithDBMatcher(), app, modelPolicy).
+					Return(modelAppEventingCfg, nil).Once()
+				return eventingSvc
+			},
+			AppSvcFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("Get", txtest.CtxWithDBMatcher(), applicationID.String()).Return(&app, nil).Once()
+				return appSvc
+			},
+			ExpectedOutput: nil,
+			ExpectedError:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			eventingSvc := testCase.EventingSvcFn()
+			appSvc := testCase.AppSvcFn()
+			resolver := NewResolver(transact, eventingSvc, appSvc)
+
+			// WHEN
+			result, err := resolver.SetEventingPolicyForApplication(ctx, applicationID.String(), gqlPolicy)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, eventingSvc, appSvc, transact, persist)
+		})
+	}
+
+	t.Run("Error when application ID is not a valid UUID", func(t *testing.T) {
+		// GIVEN
+		resolver := NewResolver(nil, nil, nil)
+
+		// WHEN
+		result, err := resolver.SetEventingPolicyForApplication(ctx, "abc", gqlPolicy)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while parsing application ID as UUID")
+		assert.Nil(t, result)
+	})
+}
+
+func TestResolver_EventingBindings(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID.String(), externalTenantID.String())
+
+	testErr := errors.New("this is a test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	modelBindings := []*model.ApplicationEventingBinding{
+		{
+			ApplicationID: applicationID.String(),
+			RuntimeID:     runtimeID.String(),
+			Policy:        model.NewDefaultEventingPolicy(),
+		},
+	}
+	gqlBindings := []*graphql.ApplicationEventingBinding{
+		{
+			ApplicationID: applicationID.String(),
+			RuntimeID:     runtimeID.String(),
+			Policy:        &graphql.EventingPolicy{Type: graphql.EventingPolicyTypeOldest},
+		},
+	}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		EventingSvcFn   func() *automock.EventingService
+		ExpectedOutput  []*graphql.ApplicationEventingBinding
+		ExpectedError   error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			EventingSvcFn: func() *automock.EventingService {
+				eventingSvc := &automock.EventingService{}
+				eventingSvc.On("ListBindings", txtest.CtxWithDBMatcher()).Return(modelBindings, nil).Once()
+				return eventingSvc
+			},
+			ExpectedOutput: gqlBindings,
+			ExpectedError:  nil,
+		}, {
+			Name:            "Error when listing eventing bindings",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			EventingSvcFn: func() *automock.EventingService {
+				eventingSvc := &automock.EventingService{}
+				eventingSvc.On("ListBindings", txtest.CtxWithDBMatcher()).Return(nil, testErr).Once()
+				return eventingSvc
+			},
+			ExpectedOutput: nil,
+			ExpectedError:  testErr,
+		}, {
+			Name:            "Error when beginning transaction",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			EventingSvcFn: func() *automock.EventingService {
+				eventingSvc := &automock.EventingService{}
+				return eventingSvc
+			},
+			ExpectedOutput: nil,
+			ExpectedError:  testErr,
+		}, {
+			Name:            "Error when committing transaction",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			EventingSvcFn: func() *automock.EventingService {
+				eventingSvc := &automock.EventingService{}
+				eventingSvc.On("ListBindings", txtest.CtxWithDBMatcher()).Return(modelBindings, nil).Once()
+				return eventingSvc
+			},
+			ExpectedOutput: nil,
+			ExpectedError:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			eventingSvc := testCase.EventingSvcFn()
+			resolver := NewResolver(transact, eventingSvc, nil)
+
+			// WHEN
+			result, err := resolver.EventingBindings(ctx)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, eventingSvc, transact, persist)
+		})
+	}
+}
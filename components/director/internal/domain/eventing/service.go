@@ -2,7 +2,9 @@ package eventing
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/normalizer"
 
@@ -20,10 +22,14 @@ import (
 )
 
 const (
-	isNormalizedLabel            = "isNormalized"
-	RuntimeEventingURLLabel      = "runtime_eventServiceUrl"
-	EmptyEventingURL             = ""
-	RuntimeDefaultEventingLabelf = "%s_defaultEventing"
+	isNormalizedLabel                     = "isNormalized"
+	RuntimeEventingURLLabel               = "runtime_eventServiceUrl"
+	EmptyEventingURL                      = ""
+	RuntimeDefaultEventingLabelf          = "%s_defaultEventing"
+	RuntimeDefaultEventingLabelSuffix     = "_defaultEventing"
+	RuntimeDefaultEventingLabelPattern    = ".*_defaultEventing$"
+	ApplicationEventingPolicyLabel        = "eventingPolicy"
+	ApplicationEventingPolicyLabelPattern = "^eventingPolicy$"
 )
 
 //go:generate mockery -name=RuntimeRepository -output=automock -outpkg=automock -case=underscore
@@ -37,6 +43,8 @@ type RuntimeRepository interface {
 type LabelRepository interface {
 	Delete(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, key string) error
 	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
+	ListForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) (map[string]*model.Label, error)
+	ListByKeyPattern(ctx context.Context, tenant string, objectType model.LabelableObject, keyPattern string) ([]*model.Label, error)
 	DeleteByKey(ctx context.Context, tenant string, key string) error
 	Upsert(ctx context.Context, label *model.Label) error
 }
@@ -171,29 +179,9 @@ func (s *service) GetForApplication(ctx context.Context, app model.Application)
 		return nil, errors.Wrapf(err, "while parsing application ID: %s", app.ID)
 	}
 
-	var defaultVerified, foundDefault, foundOldest bool
-	runtime, foundDefault, err := s.getDefaultRuntimeForAppEventing(ctx, tenantID, appID)
+	runtime, err := s.ensureDefaultRuntime(ctx, tenantID, appID)
 	if err != nil {
-		return nil, errors.Wrap(err, "while getting default runtime for app eventing")
-	}
-
-	if foundDefault {
-		if defaultVerified, err = s.ensureScenariosOrDeleteLabel(ctx, tenantID, *runtime, appID); err != nil {
-			return nil, errors.Wrap(err, "while ensuring the scenarios assigned to the runtime and application")
-		}
-	}
-
-	if !defaultVerified {
-		runtime, foundOldest, err = s.getOldestRuntime(ctx, tenantID, appID)
-		if err != nil {
-			return nil, errors.Wrap(err, "while getting the oldest runtime for scenarios")
-		}
-
-		if foundOldest {
-			if err := s.setRuntimeForAppEventing(ctx, *runtime, appID); err != nil {
-				return nil, errors.Wrap(err, "while setting the runtime as default for eventing for application")
-			}
-		}
+		return nil, err
 	}
 
 	if runtime == nil {
@@ -223,6 +211,120 @@ func (s *service) GetForApplication(ctx context.Context, app model.Application)
 	return model.NewApplicationEventingConfiguration(runtimeEventingCfg.DefaultURL, appName)
 }
 
+func (s *service) SetPolicyForApplication(ctx context.Context, app model.Application, policy model.EventingPolicy) (*model.ApplicationEventingConfiguration, error) {
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	appID, err := uuid.Parse(app.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while parsing application ID: %s", app.ID)
+	}
+
+	policyLabel := model.NewLabelForApplication(app, ApplicationEventingPolicyLabel, newPolicyLabelValue(policy))
+	if err := s.labelRepo.Upsert(ctx, policyLabel); err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("while labeling the application [ID=%s] with eventing policy", appID))
+	}
+
+	if policy.Type != model.EventingPolicyPinned {
+		if _, _, err := s.unsetForApplication(ctx, tenantID, appID); err != nil {
+			return nil, errors.Wrap(err, "while deleting default eventing for application")
+		}
+	}
+
+	return s.GetForApplication(ctx, app)
+}
+
+func (s *service) GetApplicationsForDefaultRuntime(ctx context.Context, runtimeID uuid.UUID) ([]uuid.UUID, error) {
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	return s.getApplicationsForDefaultRuntime(ctx, tenantID, runtimeID)
+}
+
+func (s *service) ReelectForApplications(ctx context.Context, appIDs []uuid.UUID) error {
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	for _, appID := range appIDs {
+		if _, err := s.ensureDefaultRuntime(ctx, tenantID, appID); err != nil {
+			return errors.Wrapf(err, "while ensuring default runtime for eventing for application [ID=%s]", appID)
+		}
+	}
+
+	return nil
+}
+
+func (s *service) ReelectForRuntime(ctx context.Context, runtimeID uuid.UUID) error {
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	appIDs, err := s.getApplicationsForDefaultRuntime(ctx, tenantID, runtimeID)
+	if err != nil {
+		return err
+	}
+
+	return s.ReelectForApplications(ctx, appIDs)
+}
+
+func (s *service) ListBindings(ctx context.Context) ([]*model.ApplicationEventingBinding, error) {
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	defaultEventingLabels, err := s.labelRepo.ListByKeyPattern(ctx, tenantID, model.RuntimeLabelableObject, RuntimeDefaultEventingLabelPattern)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing runtime labels for default eventing")
+	}
+
+	policyLabels, err := s.labelRepo.ListByKeyPattern(ctx, tenantID, model.ApplicationLabelableObject, ApplicationEventingPolicyLabelPattern)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("while listing labels [key=%s]", ApplicationEventingPolicyLabel))
+	}
+
+	policies := make(map[string]model.EventingPolicy)
+	for _, policyLabel := range policyLabels {
+		policy, err := policyFromLabelValue(policyLabel.Value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while reading eventing policy of application [ID=%s]", policyLabel.ObjectID)
+		}
+		policies[policyLabel.ObjectID] = policy
+	}
+
+	bindings := make([]*model.ApplicationEventingBinding, 0, len(defaultEventingLabels))
+	for _, defaultEventingLabel := range defaultEventingLabels {
+		appID, err := getAppIDFromDefaultEventingLabelKey(defaultEventingLabel.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		policy, ok := policies[appID.String()]
+		if !ok {
+			policy = model.NewDefaultEventingPolicy()
+		}
+
+		bindings = append(bindings, &model.ApplicationEventingBinding{
+			ApplicationID: appID.String(),
+			RuntimeID:     defaultEventingLabel.ObjectID,
+			Policy:        policy,
+		})
+	}
+
+	sort.Slice(bindings, func(i, j int) bool {
+		return bindings[i].ApplicationID < bindings[j].ApplicationID
+	})
+
+	return bindings, nil
+}
+
 func (s *service) GetForRuntime(ctx context.Context, runtimeID uuid.UUID) (*model.RuntimeEventingConfiguration, error) {
 	tenantID, err := tenant.LoadFromContext(ctx)
 	if err != nil {
@@ -259,6 +361,106 @@ func (s *service) shouldNormalizeApplicationName(ctx context.Context, runtime *m
 	return notFoundErr || label.Value == "true", nil
 }
 
+func (s *service) ensureDefaultRuntime(ctx context.Context, tenantID string, appID uuid.UUID) (*model.Runtime, error) {
+	runtime, foundDefault, err := s.getDefaultRuntimeForAppEventing(ctx, tenantID, appID)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting default runtime for app eventing")
+	}
+
+	if foundDefault {
+		defaultVerified, err := s.ensureScenariosOrDeleteLabel(ctx, tenantID, *runtime, appID)
+		if err != nil {
+			return nil, errors.Wrap(err, "while ensuring the scenarios assigned to the runtime and application")
+		}
+
+		if defaultVerified {
+			return runtime, nil
+		}
+	}
+
+	policy, err := s.getPolicy(ctx, tenantID, appID)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting eventing policy for application")
+	}
+
+	runtime, found, err := s.electRuntime(ctx, tenantID, appID, policy)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting the oldest runtime for scenarios")
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	if err := s.setRuntimeForAppEventing(ctx, *runtime, appID); err != nil {
+		return nil, errors.Wrap(err, "while setting the runtime as default for eventing for application")
+	}
+
+	return runtime, nil
+}
+
+func (s *service) electRuntime(ctx context.Context, tenantID string, appID uuid.UUID, policy model.EventingPolicy) (*model.Runtime, bool, error) {
+	switch policy.Type {
+	case model.EventingPolicyPinned:
+		return nil, false, nil
+	case model.EventingPolicyLabelPreferred:
+		if policy.PreferredLabel == nil {
+			break
+		}
+
+		preferredLabelFilter, err := buildFilterForLabelSelector(*policy.PreferredLabel)
+		if err != nil {
+			return nil, false, err
+		}
+
+		runtime, found, err := s.getOldestRuntime(ctx, tenantID, appID, preferredLabelFilter)
+		if err != nil || found {
+			return runtime, found, err
+		}
+	}
+
+	return s.getOldestRuntime(ctx, tenantID, appID)
+}
+
+func (s *service) getPolicy(ctx context.Context, tenantID string, appID uuid.UUID) (model.EventingPolicy, error) {
+	policyLabel, err := s.labelRepo.GetByKey(ctx, tenantID, model.ApplicationLabelableObject, appID.String(), ApplicationEventingPolicyLabel)
+	if err != nil {
+		if !apperrors.IsNotFoundError(err) {
+			return model.EventingPolicy{}, errors.Wrap(err, fmt.Sprintf("while getting the label [key=%s] for application [ID=%s]", ApplicationEventingPolicyLabel, appID))
+		}
+
+		return model.NewDefaultEventingPolicy(), nil
+	}
+
+	return policyFromLabelValue(policyLabel.Value)
+}
+
+func (s *service) getApplicationsForDefaultRuntime(ctx context.Context, tenantID string, runtimeID uuid.UUID) ([]uuid.UUID, error) {
+	runtimeLabels, err := s.labelRepo.ListForObject(ctx, tenantID, model.RuntimeLabelableObject, runtimeID.String())
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("while listing labels for runtime [ID=%s]", runtimeID))
+	}
+
+	var appIDs []uuid.UUID
+	for key := range runtimeLabels {
+		if !strings.HasSuffix(key, RuntimeDefaultEventingLabelSuffix) {
+			continue
+		}
+
+		appID, err := getAppIDFromDefaultEventingLabelKey(key)
+		if err != nil {
+			return nil, err
+		}
+		appIDs = append(appIDs, appID)
+	}
+
+	sort.Slice(appIDs, func(i, j int) bool {
+		return appIDs[i].String() < appIDs[j].String()
+	})
+
+	return appIDs, nil
+}
+
 func (s *service) unsetForApplication(ctx context.Context, tenantID string, appID uuid.UUID) (*model.Runtime, bool, error) {
 	runtime, foundDefault, err := s.getDefaultRuntimeForAppEventing(ctx, tenantID, appID)
 	if err != nil {
@@ -357,7 +559,7 @@ func (s *service) deleteLabelFromRuntime(ctx context.Context, tenantID, labelKey
 	return nil
 }
 
-func (s *service) getOldestRuntime(ctx context.Context, tenantID string, appID uuid.UUID) (*model.Runtime, bool, error) {
+func (s *service) getOldestRuntime(ctx context.Context, tenantID string, appID uuid.UUID, additionalFilters ...*labelfilter.LabelFilter) (*model.Runtime, bool, error) {
 	runtimeScenariosFilter, hasScenarios, err := s.getScenariosFilter(ctx, tenantID, appID)
 	if err != nil {
 		return nil, false, errors.Wrap(err, fmt.Sprintf("while getting application scenarios"))
@@ -367,7 +569,7 @@ func (s *service) getOldestRuntime(ctx context.Context, tenantID string, appID u
 		return nil, false, nil
 	}
 
-	runtime, err := s.runtimeRepo.GetOldestForFilters(ctx, tenantID, runtimeScenariosFilter)
+	runtime, err := s.runtimeRepo.GetOldestForFilters(ctx, tenantID, append(runtimeScenariosFilter, additionalFilters...))
 	if err != nil {
 		if !apperrors.IsNotFoundError(err) {
 			return nil, false, errors.Wrap(err, fmt.Sprintf("while getting the oldest runtime for application [ID=%s] scenarios with filter", appID))
@@ -423,6 +625,24 @@ func buildQueryForScenarios(scenarios []string) string {
 	return query
 }
 
+func buildFilterForLabelSelector(selector model.LabelSelector) (*labelfilter.LabelFilter, error) {
+	query, err := json.Marshal(selector.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("while building query for label [key=%s]", selector.Key))
+	}
+
+	return labelfilter.NewForKeyWithQuery(selector.Key, string(query)), nil
+}
+
 func getDefaultEventingForAppLabelKey(appID uuid.UUID) string {
 	return fmt.Sprintf(RuntimeDefaultEventingLabelf, appID.String())
 }
+
+func getAppIDFromDefaultEventingLabelKey(key string) (uuid.UUID, error) {
+	appID, err := uuid.Parse(strings.TrimSuffix(key, RuntimeDefaultEventingLabelSuffix))
+	if err != nil {
+		return uuid.Nil, errors.Wrapf(err, "while parsing application ID from label [key=%s]", key)
+	}
+
+	return appID, nil
+}
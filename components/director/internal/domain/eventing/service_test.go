@@ -14,6 +14,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -727,6 +728,8 @@ func Test_GetForApplication(t *testing.T) {
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), ApplicationEventingPolicyLabel).Return(nil, apperrors.NewNotFoundError(resource.Label, ApplicationEventingPolicyLabel))
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(fixApplicationScenariosLabel(), nil)
 		labelRepo.On("Upsert", ctx, mock.MatchedBy(fixMatcherDefaultEventingForAppLabel())).Return(nil)
@@ -811,6 +814,8 @@ func Test_GetForApplication(t *testing.T) {
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(nil, apperrors.NewNotFoundError(resource.Runtime, ""))
 		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), ApplicationEventingPolicyLabel).Return(nil, apperrors.NewNotFoundError(resource.Label, ApplicationEventingPolicyLabel))
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(fixApplicationScenariosLabel(), nil)
 
@@ -833,6 +838,8 @@ func Test_GetForApplication(t *testing.T) {
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
 			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), ApplicationEventingPolicyLabel).Return(nil, apperrors.NewNotFoundError(resource.Label, ApplicationEventingPolicyLabel))
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, apperrors.NewNotFoundError(resource.Label, ""))
 		svc := NewService(nil, runtimeRepo, labelRepo)
@@ -858,6 +865,8 @@ func Test_GetForApplication(t *testing.T) {
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(nil, apperrors.NewNotFoundError(resource.Runtime, ""))
 		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), ApplicationEventingPolicyLabel).Return(nil, apperrors.NewNotFoundError(resource.Label, ApplicationEventingPolicyLabel))
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(fixApplicationScenariosLabel(), nil)
 		labelRepo.On("Delete", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String(),
@@ -897,6 +906,8 @@ func Test_GetForApplication(t *testing.T) {
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(fixRuntimes()[0], nil)
 		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), ApplicationEventingPolicyLabel).Return(nil, apperrors.NewNotFoundError(resource.Label, ApplicationEventingPolicyLabel))
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(fixApplicationScenariosLabel(), nil)
 		labelRepo.On("Upsert", ctx,
@@ -923,6 +934,8 @@ func Test_GetForApplication(t *testing.T) {
 		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
 			Return(nil, errors.New("some error"))
 		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), ApplicationEventingPolicyLabel).Return(nil, apperrors.NewNotFoundError(resource.Label, ApplicationEventingPolicyLabel))
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(fixApplicationScenariosLabel(), nil)
 		svc := NewService(nil, runtimeRepo, labelRepo)
@@ -944,6 +957,8 @@ func Test_GetForApplication(t *testing.T) {
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
 			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), ApplicationEventingPolicyLabel).Return(nil, apperrors.NewNotFoundError(resource.Label, ApplicationEventingPolicyLabel))
 		scenariosLabel := fixApplicationScenariosLabel()
 		scenariosLabel.Value = "abc"
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
@@ -967,6 +982,8 @@ func Test_GetForApplication(t *testing.T) {
 		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
 			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
 		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), ApplicationEventingPolicyLabel).Return(nil, apperrors.NewNotFoundError(resource.Label, ApplicationEventingPolicyLabel))
 		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
 			applicationID.String(), model.ScenariosKey).Return(nil, errors.New("some error"))
 		svc := NewService(nil, runtimeRepo, labelRepo)
@@ -1254,3 +1271,404 @@ func Test_GetForRuntime(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, labelRepo)
 	})
 }
+
+func Test_GetForApplicationWithPolicy(t *testing.T) {
+	appNormalizedEventURL := fixAppEventURL(t, appNameNormalizer.Normalize(app.Name))
+
+	t.Run("Success when labeling oldest runtime with preferred label for application eventing", func(t *testing.T) {
+		// GIVEN
+		ctx := fixCtxWithTenant()
+		runtimeRepo := &automock.RuntimeRepository{}
+		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
+			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenariosAndPreferredLabel()).
+			Return(fixRuntimes()[0], nil)
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), ApplicationEventingPolicyLabel).Return(fixApplicationEventingPolicyLabel(map[string]interface{}{
+			"type":           "LABEL_PREFERRED",
+			"preferredLabel": map[string]interface{}{"key": "region", "value": "eu"},
+		}), nil)
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), model.ScenariosKey).Return(fixApplicationScenariosLabel(), nil)
+		labelRepo.On("Upsert", ctx, mock.MatchedBy(fixMatcherDefaultEventingForAppLabel())).Return(nil)
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
+			runtimeID.String(), RuntimeEventingURLLabel).Return(fixRuntimeEventingURLLabel(), nil)
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
+			runtimeID.String(), isNormalizedLabel).Return(nil, apperrors.NewNotFoundError(resource.Runtime, runtimeID.String()))
+		svc := NewService(appNameNormalizer, runtimeRepo, labelRepo)
+
+		// WHEN
+		eventingCfg, err := svc.GetForApplication(ctx, app)
+
+		// THEN
+		require.NoError(t, err)
+		require.NotNil(t, eventingCfg)
+		require.Equal(t, appNormalizedEventURL, eventingCfg.DefaultURL)
+		mock.AssertExpectationsForObjects(t, runtimeRepo, labelRepo)
+	})
+
+	t.Run("Success when labeling oldest runtime for application eventing if none has preferred label", func(t *testing.T) {
+		// GIVEN
+		ctx := fixCtxWithTenant()
+		runtimeRepo := &automock.RuntimeRepository{}
+		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
+			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenariosAndPreferredLabel()).
+			Return(nil, apperrors.NewNotFoundError(resource.Runtime, "")).Once()
+		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
+			Return(fixRuntimes()[0], nil).Once()
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), ApplicationEventingPolicyLabel).Return(fixApplicationEventingPolicyLabel(map[string]interface{}{
+			"type":           "LABEL_PREFERRED",
+			"preferredLabel": map[string]interface{}{"key": "region", "value": "eu"},
+		}), nil)
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), model.ScenariosKey).Return(fixApplicationScenariosLabel(), nil)
+		labelRepo.On("Upsert", ctx, mock.MatchedBy(fixMatcherDefaultEventingForAppLabel())).Return(nil)
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
+			runtimeID.String(), RuntimeEventingURLLabel).Return(fixRuntimeEventingURLLabel(), nil)
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
+			runtimeID.String(), isNormalizedLabel).Return(nil, apperrors.NewNotFoundError(resource.Runtime, runtimeID.String()))
+		svc := NewService(appNameNormalizer, runtimeRepo, labelRepo)
+
+		// WHEN
+		eventingCfg, err := svc.GetForApplication(ctx, app)
+
+		// THEN
+		require.NoError(t, err)
+		require.NotNil(t, eventingCfg)
+		require.Equal(t, appNormalizedEventURL, eventingCfg.DefaultURL)
+		mock.AssertExpectationsForObjects(t, runtimeRepo, labelRepo)
+	})
+
+	t.Run("Success when application eventing is pinned and there is no default runtime", func(t *testing.T) {
+		// GIVEN
+		ctx := fixCtxWithTenant()
+		runtimeRepo := &automock.RuntimeRepository{}
+		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
+			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), ApplicationEventingPolicyLabel).Return(fixApplicationEventingPolicyLabel(map[string]interface{}{
+			"type": "PINNED",
+		}), nil)
+		svc := NewService(appNameNormalizer, runtimeRepo, labelRepo)
+
+		// WHEN
+		eventingCfg, err := svc.GetForApplication(ctx, app)
+
+		// THEN
+		require.NoError(t, err)
+		require.NotNil(t, eventingCfg)
+		require.Equal(t, EmptyEventingURL, eventingCfg.DefaultURL.String())
+		mock.AssertExpectationsForObjects(t, runtimeRepo, labelRepo)
+	})
+
+	t.Run("Error when eventing policy label has unknown type", func(t *testing.T) {
+		// GIVEN
+		ctx := fixCtxWithTenant()
+		runtimeRepo := &automock.RuntimeRepository{}
+		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
+			1, mock.Anything).Return(fixEmptyRuntimePage(), nil)
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), ApplicationEventingPolicyLabel).Return(fixApplicationEventingPolicyLabel(map[string]interface{}{
+			"type": "NEWEST",
+		}), nil)
+		svc := NewService(appNameNormalizer, runtimeRepo, labelRepo)
+
+		// WHEN
+		_, err := svc.GetForApplication(ctx, app)
+
+		// THEN
+		require.EqualError(t, err, `while getting eventing policy for application: unknown eventing policy type "NEWEST"`)
+		mock.AssertExpectationsForObjects(t, runtimeRepo, labelRepo)
+	})
+}
+
+func Test_SetPolicyForApplication(t *testing.T) {
+	appNormalizedEventURL := fixAppEventURL(t, appNameNormalizer.Normalize(app.Name))
+	policyLabelMatcher := func(expected policyLabelValue) func(l *model.Label) bool {
+		return func(l *model.Label) bool {
+			return l.Key == ApplicationEventingPolicyLabel && l.ObjectID == applicationID.String() &&
+				l.ObjectType == model.ApplicationLabelableObject && assert.ObjectsAreEqual(expected, l.Value)
+		}
+	}
+
+	t.Run("Success when setting oldest policy chooses the default runtime again", func(t *testing.T) {
+		// GIVEN
+		ctx := fixCtxWithTenant()
+		runtimeRepo := &automock.RuntimeRepository{}
+		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
+			1, mock.Anything).Return(fixRuntimePageWithOne(), nil).Once()
+		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
+			1, mock.Anything).Return(fixEmptyRuntimePage(), nil).Once()
+		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
+			Return(fixRuntimes()[0], nil)
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("Upsert", ctx, mock.MatchedBy(policyLabelMatcher(policyLabelValue{Type: model.EventingPolicyOldest}))).Return(nil).Once()
+		labelRepo.On("Delete", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String(),
+			getDefaultEventingForAppLabelKey(applicationID)).Return(nil)
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), ApplicationEventingPolicyLabel).Return(fixApplicationEventingPolicyLabel(map[string]interface{}{
+			"type": "OLDEST",
+		}), nil)
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), model.ScenariosKey).Return(fixApplicationScenariosLabel(), nil)
+		labelRepo.On("Upsert", ctx, mock.MatchedBy(fixMatcherDefaultEventingForAppLabel())).Return(nil).Once()
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
+			runtimeID.String(), RuntimeEventingURLLabel).Return(fixRuntimeEventingURLLabel(), nil)
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
+			runtimeID.String(), isNormalizedLabel).Return(nil, apperrors.NewNotFoundError(resource.Runtime, runtimeID.String()))
+		svc := NewService(appNameNormalizer, runtimeRepo, labelRepo)
+
+		// WHEN
+		eventingCfg, err := svc.SetPolicyForApplication(ctx, app, model.EventingPolicy{Type: model.EventingPolicyOldest})
+
+		// THEN
+		require.NoError(t, err)
+		require.NotNil(t, eventingCfg)
+		require.Equal(t, appNormalizedEventURL, eventingCfg.DefaultURL)
+		mock.AssertExpectationsForObjects(t, runtimeRepo, labelRepo)
+	})
+
+	t.Run("Success when setting pinned policy keeps the default runtime", func(t *testing.T) {
+		// GIVEN
+		ctx := fixCtxWithTenant()
+		runtimeRepo := &automock.RuntimeRepository{}
+		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
+			1, mock.Anything).Return(fixRuntimePageWithOne(), nil).Once()
+		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
+			fixLabelFilterForRuntimeScenarios()).Return(fixRuntimes()[0], nil)
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("Upsert", ctx, mock.MatchedBy(policyLabelMatcher(policyLabelValue{Type: model.EventingPolicyPinned}))).Return(nil).Once()
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), model.ScenariosKey).Return(fixApplicationScenariosLabel(), nil)
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
+			runtimeID.String(), RuntimeEventingURLLabel).Return(fixRuntimeEventingURLLabel(), nil)
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.RuntimeLabelableObject,
+			runtimeID.String(), isNormalizedLabel).Return(nil, apperrors.NewNotFoundError(resource.Runtime, runtimeID.String()))
+		svc := NewService(appNameNormalizer, runtimeRepo, labelRepo)
+
+		// WHEN
+		eventingCfg, err := svc.SetPolicyForApplication(ctx, app, model.EventingPolicy{Type: model.EventingPolicyPinned})
+
+		// THEN
+		require.NoError(t, err)
+		require.NotNil(t, eventingCfg)
+		require.Equal(t, appNormalizedEventURL, eventingCfg.DefaultURL)
+		mock.AssertExpectationsForObjects(t, runtimeRepo, labelRepo)
+	})
+
+	t.Run("Error when labeling application with eventing policy returns error", func(t *testing.T) {
+		// GIVEN
+		ctx := fixCtxWithTenant()
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("Upsert", ctx, mock.Anything).Return(errors.New("some error"))
+		svc := NewService(appNameNormalizer, nil, labelRepo)
+
+		// WHEN
+		_, err := svc.SetPolicyForApplication(ctx, app, model.EventingPolicy{Type: model.EventingPolicyOldest})
+
+		// THEN
+		require.EqualError(t, err, fmt.Sprintf("while labeling the application [ID=%s] with eventing policy: some error", applicationID))
+		mock.AssertExpectationsForObjects(t, labelRepo)
+	})
+
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		// GIVEN
+		svc := NewService(nil, nil, nil)
+
+		// WHEN
+		_, err := svc.SetPolicyForApplication(context.TODO(), app, model.EventingPolicy{Type: model.EventingPolicyOldest})
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "while loading tenant from context")
+	})
+}
+
+func Test_ReelectForRuntime(t *testing.T) {
+	t.Run("Success when runtime no longer belongs to the application scenarios", func(t *testing.T) {
+		// GIVEN
+		ctx := fixCtxWithTenant()
+		newDefaultRuntime := fixRuntimes()[1]
+		runtimeRepo := &automock.RuntimeRepository{}
+		runtimeRepo.On("List", ctx, tenantID.String(), fixLabelFilterForRuntimeDefaultEventingForApp(),
+			1, mock.Anything).Return(fixRuntimePageWithOne(), nil)
+		runtimeRepo.On("GetByFiltersAndID", ctx, tenantID.String(), runtimeID.String(),
+			fixLabelFilterForRuntimeScenarios()).Return(nil, apperrors.NewNotFoundError(resource.Runtime, runtimeID.String()))
+		runtimeRepo.On("GetOldestForFilters", ctx, tenantID.String(), fixLabelFilterForRuntimeScenarios()).
+			Return(newDefaultRuntime, nil)
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListForObject", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String()).
+			Return(map[string]*model.Label{
+				RuntimeEventingURLLabel:                         fixRuntimeEventingURLLabel(),
+				getDefaultEventingForAppLabelKey(applicationID): fixRuntimeDefaultEventingLabel(),
+			}, nil)
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), model.ScenariosKey).Return(fixApplicationScenariosLabel(), nil)
+		labelRepo.On("Delete", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String(),
+			getDefaultEventingForAppLabelKey(applicationID)).Return(nil)
+		labelRepo.On("GetByKey", ctx, tenantID.String(), model.ApplicationLabelableObject,
+			applicationID.String(), ApplicationEventingPolicyLabel).Return(nil, apperrors.NewNotFoundError(resource.Label, ApplicationEventingPolicyLabel))
+		labelRepo.On("Upsert", ctx, mock.MatchedBy(func(l *model.Label) bool {
+			return l.Key == getDefaultEventingForAppLabelKey(applicationID) && l.ObjectID == newDefaultRuntime.ID
+		})).Return(nil)
+		svc := NewService(nil, runtimeRepo, labelRepo)
+
+		// WHEN
+		err := svc.ReelectForRuntime(ctx, runtimeID)
+
+		// THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, runtimeRepo, labelRepo)
+	})
+
+	t.Run("Success when runtime is not default for any application", func(t *testing.T) {
+		// GIVEN
+		ctx := fixCtxWithTenant()
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListForObject", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String()).
+			Return(map[string]*model.Label{RuntimeEventingURLLabel: fixRuntimeEventingURLLabel()}, nil)
+		svc := NewService(nil, nil, labelRepo)
+
+		// WHEN
+		err := svc.ReelectForRuntime(ctx, runtimeID)
+
+		// THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, labelRepo)
+	})
+
+	t.Run("Error when listing runtime labels returns error", func(t *testing.T) {
+		// GIVEN
+		ctx := fixCtxWithTenant()
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListForObject", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String()).
+			Return(nil, errors.New("some error"))
+		svc := NewService(nil, nil, labelRepo)
+
+		// WHEN
+		err := svc.ReelectForRuntime(ctx, runtimeID)
+
+		// THEN
+		require.EqualError(t, err, fmt.Sprintf("while listing labels for runtime [ID=%s]: some error", runtimeID))
+		mock.AssertExpectationsForObjects(t, labelRepo)
+	})
+}
+
+func Test_GetApplicationsForDefaultRuntime(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		ctx := fixCtxWithTenant()
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListForObject", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String()).
+			Return(map[string]*model.Label{
+				RuntimeEventingURLLabel:                         fixRuntimeEventingURLLabel(),
+				getDefaultEventingForAppLabelKey(applicationID): fixRuntimeDefaultEventingLabel(),
+			}, nil)
+		svc := NewService(nil, nil, labelRepo)
+
+		// WHEN
+		appIDs, err := svc.GetApplicationsForDefaultRuntime(ctx, runtimeID)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{applicationID}, appIDs)
+		mock.AssertExpectationsForObjects(t, labelRepo)
+	})
+
+	t.Run("Error when label key does not contain application ID", func(t *testing.T) {
+		// GIVEN
+		ctx := fixCtxWithTenant()
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListForObject", ctx, tenantID.String(), model.RuntimeLabelableObject, runtimeID.String()).
+			Return(map[string]*model.Label{"foo_defaultEventing": fixRuntimeDefaultEventingLabel()}, nil)
+		svc := NewService(nil, nil, labelRepo)
+
+		// WHEN
+		_, err := svc.GetApplicationsForDefaultRuntime(ctx, runtimeID)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "while parsing application ID from label [key=foo_defaultEventing]")
+		mock.AssertExpectationsForObjects(t, labelRepo)
+	})
+}
+
+func Test_ListBindings(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		ctx := fixCtxWithTenant()
+		otherApplicationID := uuid.New()
+		otherDefaultEventingLabel := fixRuntimeDefaultEventingLabel()
+		otherDefaultEventingLabel.Key = getDefaultEventingForAppLabelKey(otherApplicationID)
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListByKeyPattern", ctx, tenantID.String(), model.RuntimeLabelableObject, RuntimeDefaultEventingLabelPattern).
+			Return([]*model.Label{fixRuntimeDefaultEventingLabel(), otherDefaultEventingLabel}, nil)
+		labelRepo.On("ListByKeyPattern", ctx, tenantID.String(), model.ApplicationLabelableObject, ApplicationEventingPolicyLabelPattern).
+			Return([]*model.Label{fixApplicationEventingPolicyLabel(map[string]interface{}{"type": "PINNED"})}, nil)
+		svc := NewService(nil, nil, labelRepo)
+
+		expected := []*model.ApplicationEventingBinding{
+			{
+				ApplicationID: applicationID.String(),
+				RuntimeID:     runtimeID.String(),
+				Policy:        model.EventingPolicy{Type: model.EventingPolicyPinned},
+			},
+			{
+				ApplicationID: otherApplicationID.String(),
+				RuntimeID:     runtimeID.String(),
+				Policy:        model.NewDefaultEventingPolicy(),
+			},
+		}
+		if expected[1].ApplicationID < expected[0].ApplicationID {
+			expected[0], expected[1] = expected[1], expected[0]
+		}
+
+		// WHEN
+		bindings, err := svc.ListBindings(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, expected, bindings)
+		mock.AssertExpectationsForObjects(t, labelRepo)
+	})
+
+	t.Run("Error when listing default eventing labels returns error", func(t *testing.T) {
+		// GIVEN
+		ctx := fixCtxWithTenant()
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListByKeyPattern", ctx, tenantID.String(), model.RuntimeLabelableObject, RuntimeDefaultEventingLabelPattern).
+			Return(nil, errors.New("some error"))
+		svc := NewService(nil, nil, labelRepo)
+
+		// WHEN
+		_, err := svc.ListBindings(ctx)
+
+		// THEN
+		require.EqualError(t, err, "while listing runtime labels for default eventing: some error")
+		mock.AssertExpectationsForObjects(t, labelRepo)
+	})
+
+	t.Run("Error when listing eventing policy labels returns error", func(t *testing.T) {
+		// GIVEN
+		ctx := fixCtxWithTenant()
+		labelRepo := &automock.LabelRepository{}
+		labelRepo.On("ListByKeyPattern", ctx, tenantID.String(), model.RuntimeLabelableObject, RuntimeDefaultEventingLabelPattern).
+			Return([]*model.Label{fixRuntimeDefaultEventingLabel()}, nil)
+		labelRepo.On("ListByKeyPattern", ctx, tenantID.String(), model.ApplicationLabelableObject, ApplicationEventingPolicyLabelPattern).
+			Return(nil, errors.New("some error"))
+		svc := NewService(nil, nil, labelRepo)
+
+		// WHEN
+		_, err := svc.ListBindings(ctx)
+
+		// THEN
+		require.EqualError(t, err, fmt.Sprintf("while listing labels [key=%s]: some error", ApplicationEventingPolicyLabel))
+		mock.AssertExpectationsForObjects(t, labelRepo)
+	})
+}
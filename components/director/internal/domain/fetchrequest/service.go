@@ -2,10 +2,15 @@ package fetchrequest
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
@@ -57,7 +62,7 @@ func (s *service) fetchAPISpec(ctx context.Context, fr *model.FetchRequest) (*st
 		return nil, s.fixStatus(model.FetchRequestStatusConditionInitial, str.Ptr(err.Error()))
 	}
 
-	resp, err := s.client.Get(fr.URL)
+	resp, err := s.doRequest(ctx, fr)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("An error has occurred while fetching API Spec.")
 		return nil, s.fixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While fetching API Spec: %s", err.Error())))
@@ -93,8 +98,8 @@ func (s *service) validateFetchRequest(fr *model.FetchRequest) error {
 		return apperrors.NewInvalidDataError("Unsupported fetch mode: %s", fr.Mode)
 	}
 
-	if fr.Auth != nil {
-		return apperrors.NewInvalidDataError("Auth for Fetch Request was provided, currently it's unsupported")
+	if fr.Auth != nil && fr.Auth.RequestAuth != nil {
+		return apperrors.NewInvalidDataError("Request Auth for Fetch Request was provided, currently it's unsupported")
 	}
 
 	if fr.Filter != nil {
@@ -103,6 +108,124 @@ func (s *service) validateFetchRequest(fr *model.FetchRequest) error {
 	return nil
 }
 
+func (s *service) doRequest(ctx context.Context, fr *model.FetchRequest) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, fr.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+
+	client := s.client
+	if fr.Auth != nil {
+		setRequestParams(req, fr.Auth.AdditionalHeaders, fr.Auth.AdditionalQueryParams)
+
+		credential := fr.Auth.Credential
+		switch {
+		case credential.Basic != nil:
+			req.SetBasicAuth(credential.Basic.Username, credential.Basic.Password)
+		case credential.Oauth != nil:
+			client = s.oauthClient(ctx, credential.Oauth)
+		case credential.CertificateGen != nil:
+			client, err = s.certificateClient(credential.CertificateGen)
+			if err != nil {
+				return nil, errors.Wrap(err, "while loading client certificate")
+			}
+		}
+	}
+
+	return client.Do(req)
+}
+
+// oauthClient returns a client which authorizes requests with a token obtained in the client credentials flow.
+// The additional headers and query parameters of the credentials are sent only to the token endpoint.
+func (s *service) oauthClient(ctx context.Context, credential *model.OAuthCredentialData) *http.Client {
+	tokenClient := &http.Client{
+		Transport: &requestParamsTransport{
+			headers:     credential.AdditionalHeaders,
+			queryParams: credential.AdditionalQueryParams,
+			base:        s.transport(),
+		},
+		Timeout: s.client.Timeout,
+	}
+
+	cfg := clientcredentials.Config{
+		ClientID:     credential.ClientID,
+		ClientSecret: credential.ClientSecret,
+		TokenURL:     credential.URL,
+	}
+
+	return &http.Client{
+		Transport: &oauth2.Transport{
+			Source: cfg.TokenSource(context.WithValue(ctx, oauth2.HTTPClient, tokenClient)),
+			Base:   s.transport(),
+		},
+		Timeout: s.client.Timeout,
+	}
+}
+
+func (s *service) certificateClient(credential *model.CertificateGenCredentialData) (*http.Client, error) {
+	cert, err := tls.X509KeyPair([]byte(credential.Certificate), []byte(credential.PrivateKey))
+	if err != nil {
+		return nil, err
+	}
+
+	transport, ok := s.transport().(*http.Transport)
+	if !ok {
+		return nil, errors.Errorf("unsupported transport %T", s.transport())
+	}
+
+	transport = transport.Clone()
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{}
+	}
+	transport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   s.client.Timeout,
+	}, nil
+}
+
+func (s *service) transport() http.RoundTripper {
+	if s.client.Transport != nil {
+		return s.client.Transport
+	}
+	return http.DefaultTransport
+}
+
+type requestParamsTransport struct {
+	headers     map[string][]string
+	queryParams map[string][]string
+	base        http.RoundTripper
+}
+
+func (t *requestParamsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	setRequestParams(req, t.headers, t.queryParams)
+
+	return t.base.RoundTrip(req)
+}
+
+func setRequestParams(req *http.Request, headers, queryParams map[string][]string) {
+	for key, values := range headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	if len(queryParams) == 0 {
+		return
+	}
+
+	query := req.URL.Query()
+	for key, values := range queryParams {
+		for _, value := range values {
+			query.Add(key, value)
+		}
+	}
+	req.URL.RawQuery = query.Encode()
+}
+
 func (s *service) fixStatus(condition model.FetchRequestStatusCondition, message *string) *model.FetchRequestStatus {
 	return &model.FetchRequestStatus{
 		Condition: condition,
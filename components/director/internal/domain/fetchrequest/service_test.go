@@ -201,3 +201,143 @@ func TestService_HandleAPISpec(t *testing.T) {
 	}
 
 }
+
+func TestService_HandleAPISpec_Auth(t *testing.T) {
+	mockSpec := "spec"
+	timestamp := time.Now()
+	ctx := context.TODO()
+
+	specURL := "http://spec.com/api"
+	tokenURL := "http://token.com/oauth/token"
+
+	testCases := []struct {
+		Name            string
+		RoundTripFn     func(t *testing.T) RoundTripFunc
+		Auth            *model.Auth
+		ExpectedOutput  *string
+		ExpectedStatus  model.FetchRequestStatusCondition
+		ExpectedMessage *string
+	}{
+		{
+			Name: "Success with basic credentials and additional request parameters",
+			RoundTripFn: func(t *testing.T) RoundTripFunc {
+				return func(req *http.Request) *http.Response {
+					username, password, ok := req.BasicAuth()
+					assert.True(t, ok)
+					assert.Equal(t, "user", username)
+					assert.Equal(t, "pass", password)
+					assert.Equal(t, "bar", req.Header.Get("foo"))
+					assert.Equal(t, "qux", req.URL.Query().Get("baz"))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(mockSpec)),
+					}
+				}
+			},
+			Auth: &model.Auth{
+				Credential: model.CredentialData{
+					Basic: &model.BasicCredentialData{Username: "user", Password: "pass"},
+				},
+				AdditionalHeaders:     map[string][]string{"foo": {"bar"}},
+				AdditionalQueryParams: map[string][]string{"baz": {"qux"}},
+			},
+			ExpectedOutput: &mockSpec,
+			ExpectedStatus: model.FetchRequestStatusConditionSucceeded,
+		},
+		{
+			Name: "Success with OAuth credentials sending additional request parameters to token endpoint",
+			RoundTripFn: func(t *testing.T) RoundTripFunc {
+				return func(req *http.Request) *http.Response {
+					if req.URL.Host == "token.com" {
+						assert.Equal(t, "bar", req.Header.Get("foo"))
+						assert.Equal(t, "qux", req.URL.Query().Get("baz"))
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Header:     http.Header{"Content-Type": {"application/json"}},
+							Body:       ioutil.NopCloser(bytes.NewBufferString(`{"access_token":"token","token_type":"bearer"}`)),
+						}
+					}
+
+					assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+					assert.Empty(t, req.Header.Get("foo"))
+					assert.Empty(t, req.URL.Query().Get("baz"))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(mockSpec)),
+					}
+				}
+			},
+			Auth: &model.Auth{
+				Credential: model.CredentialData{
+					Oauth: &model.OAuthCredentialData{
+						ClientID:              "client",
+						ClientSecret:          "secret",
+						URL:                   tokenURL,
+						AdditionalHeaders:     map[string][]string{"foo": {"bar"}},
+						AdditionalQueryParams: map[string][]string{"baz": {"qux"}},
+					},
+				},
+			},
+			ExpectedOutput: &mockSpec,
+			ExpectedStatus: model.FetchRequestStatusConditionSucceeded,
+		},
+		{
+			Name: "Failed when generated certificate is invalid",
+			RoundTripFn: func(t *testing.T) RoundTripFunc {
+				return func(req *http.Request) *http.Response {
+					t.Error("unexpected request")
+					return &http.Response{}
+				}
+			},
+			Auth: &model.Auth{
+				Credential: model.CredentialData{
+					CertificateGen: &model.CertificateGenCredentialData{CommonName: "cn", Certificate: "invalid", PrivateKey: "invalid"},
+				},
+			},
+			ExpectedStatus: model.FetchRequestStatusConditionFailed,
+		},
+		{
+			Name: "Initial when request auth is provided",
+			RoundTripFn: func(t *testing.T) RoundTripFunc {
+				return func(req *http.Request) *http.Response {
+					t.Error("unexpected request")
+					return &http.Response{}
+				}
+			},
+			Auth: &model.Auth{
+				RequestAuth: &model.CredentialRequestAuth{Csrf: &model.CSRFTokenCredentialRequestAuth{TokenEndpointURL: tokenURL}},
+			},
+			ExpectedStatus:  model.FetchRequestStatusConditionInitial,
+			ExpectedMessage: str.Ptr("Invalid data [reason=Request Auth for Fetch Request was provided, currently it's unsupported]"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			fr := &model.FetchRequest{
+				ID:   "test",
+				URL:  specURL,
+				Auth: testCase.Auth,
+				Mode: model.FetchModeSingle,
+			}
+
+			frRepo := &automock.FetchRequestRepository{}
+			frRepo.On("Update", ctx, fr).Return(nil).Once()
+
+			svc := fetchrequest.NewService(frRepo, NewTestClient(testCase.RoundTripFn(t)))
+			svc.SetTimestampGen(func() time.Time { return timestamp })
+
+			//WHEN
+			output := svc.HandleAPISpec(ctx, fr)
+
+			//THEN
+			assert.Equal(t, testCase.ExpectedOutput, output)
+			assert.Equal(t, testCase.ExpectedStatus, fr.Status.Condition)
+			if testCase.ExpectedMessage != nil {
+				assert.Equal(t, testCase.ExpectedMessage, fr.Status.Message)
+			}
+			frRepo.AssertExpectations(t)
+		})
+	}
+}
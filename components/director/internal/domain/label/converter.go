@@ -28,6 +28,7 @@ func (c *converter) ToEntity(in model.Label) (Entity, error) {
 	var appID sql.NullString
 	var rtmID sql.NullString
 	var rtmCtxID sql.NullString
+	var pkgID sql.NullString
 	switch in.ObjectType {
 	case model.ApplicationLabelableObject:
 		appID = sql.NullString{
@@ -44,6 +45,11 @@ func (c *converter) ToEntity(in model.Label) (Entity, error) {
 			Valid:  true,
 			String: in.ObjectID,
 		}
+	case model.PackageLabelableObject:
+		pkgID = sql.NullString{
+			Valid:  true,
+			String: in.ObjectID,
+		}
 	}
 
 	return Entity{
@@ -52,6 +58,7 @@ func (c *converter) ToEntity(in model.Label) (Entity, error) {
 		AppID:            appID,
 		RuntimeID:        rtmID,
 		RuntimeContextID: rtmCtxID,
+		PackageID:        pkgID,
 		Key:              in.Key,
 		Value:            string(valueMarshalled),
 	}, nil
@@ -78,6 +85,9 @@ func (c *converter) FromEntity(in Entity) (model.Label, error) {
 	} else if in.RuntimeContextID.Valid {
 		objectID = in.RuntimeContextID.String
 		objectType = model.RuntimeContextLabelableObject
+	} else if in.PackageID.Valid {
+		objectID = in.PackageID.String
+		objectType = model.PackageLabelableObject
 	}
 
 	return model.Label{
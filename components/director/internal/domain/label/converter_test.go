@@ -53,6 +53,25 @@ func TestConverter_ToEntity(t *testing.T) {
 			},
 			ExpectedErrMessage: "",
 		},
+		{
+			Name: "Package label",
+			Input: model.Label{
+				ID:         "3",
+				Key:        "pii",
+				Tenant:     "tenant",
+				ObjectType: model.PackageLabelableObject,
+				ObjectID:   "pkg",
+				Value:      stringValue,
+			},
+			Expected: label.Entity{
+				ID:        "3",
+				Key:       "pii",
+				TenantID:  "tenant",
+				PackageID: sql.NullString{String: "pkg", Valid: true},
+				Value:     string(marshalledStringValue),
+			},
+			ExpectedErrMessage: "",
+		},
 		{
 			Name: "Error",
 			Input: model.Label{
@@ -123,6 +142,25 @@ func TestConverter_FromEntity(t *testing.T) {
 			},
 			ExpectedErrMessage: "",
 		},
+		{
+			Name: "Package label",
+			Input: label.Entity{
+				ID:        "3",
+				Key:       "pii",
+				TenantID:  "tenant",
+				PackageID: sql.NullString{String: "pkg", Valid: true},
+				Value:     string(marshalledStringValue),
+			},
+			Expected: model.Label{
+				ID:         "3",
+				Key:        "pii",
+				Tenant:     "tenant",
+				ObjectType: model.PackageLabelableObject,
+				ObjectID:   "pkg",
+				Value:      stringValue,
+			},
+			ExpectedErrMessage: "",
+		},
 		{
 			Name:               "Error",
 			Input:              fixLabelEntity("1", []byte("{json")),
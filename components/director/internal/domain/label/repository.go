@@ -22,7 +22,7 @@ const (
 	tenantColumn string = "tenant_id"
 )
 
-var tableColumns = []string{"id", tenantColumn, "app_id", "runtime_id", "runtime_context_id", "key", "value"}
+var tableColumns = []string{"id", tenantColumn, "app_id", "runtime_id", "runtime_context_id", "package_id", "key", "value"}
 
 //go:generate mockery -name=Converter -output=automock -outpkg=automock -case=underscore
 type Converter interface {
@@ -39,7 +39,7 @@ type repository struct {
 
 func NewRepository(conv Converter) *repository {
 	return &repository{
-		upserter: repo.NewUpserter(resource.Label, tableName, tableColumns, []string{tenantColumn, "coalesce(app_id, '00000000-0000-0000-0000-000000000000')", "coalesce(runtime_id, '00000000-0000-0000-0000-000000000000')", "coalesce(runtime_context_id, '00000000-0000-0000-0000-000000000000')", "key"}, []string{"value"}),
+		upserter: repo.NewUpserter(resource.Label, tableName, tableColumns, []string{tenantColumn, "coalesce(app_id, '00000000-0000-0000-0000-000000000000')", "coalesce(runtime_id, '00000000-0000-0000-0000-000000000000')", "coalesce(runtime_context_id, '00000000-0000-0000-0000-000000000000')", "coalesce(package_id, '00000000-0000-0000-0000-000000000000')", "key"}, []string{"value"}),
 		lister:   repo.NewLister(resource.Label, tableName, tenantColumn, tableColumns),
 		deleter:  repo.NewDeleter(resource.Label, tableName, tenantColumn),
 		conv:     conv,
@@ -114,6 +114,34 @@ func (r *repository) ListForObject(ctx context.Context, tenant string, objectTyp
 	return labelsMap, nil
 }
 
+func (r *repository) ListForObjectIDs(ctx context.Context, tenant string, objectType model.LabelableObject, objectIDs []string) ([]*model.Label, error) {
+	if len(objectIDs) == 0 {
+		return nil, nil
+	}
+
+	conditions := repo.Conditions{
+		repo.NewInConditionForStringValues(labelableObjectField(objectType), objectIDs),
+	}
+
+	var entities Collection
+	err := r.lister.List(ctx, tenant, &entities, conditions...)
+	if err != nil {
+		return nil, errors.Wrap(err, "while fetching Labels from DB")
+	}
+
+	var labels []*model.Label
+	for _, entity := range entities {
+		m, err := r.conv.FromEntity(entity)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting Label entity to model")
+		}
+
+		labels = append(labels, &m)
+	}
+
+	return labels, nil
+}
+
 func (r *repository) ListByKey(ctx context.Context, tenant, key string) ([]*model.Label, error) {
 	persist, err := persistence.FromCtx(ctx)
 	if err != nil {
@@ -143,6 +171,31 @@ func (r *repository) ListByKey(ctx context.Context, tenant, key string) ([]*mode
 	return labels, nil
 }
 
+func (r *repository) ListByKeyPattern(ctx context.Context, tenant string, objectType model.LabelableObject, keyPattern string) ([]*model.Label, error) {
+	conditions := repo.Conditions{
+		repo.NewNotNullCondition(labelableObjectField(objectType)),
+		repo.NewRegexConditionString("key", keyPattern),
+	}
+
+	var entities Collection
+	err := r.lister.List(ctx, tenant, &entities, conditions...)
+	if err != nil {
+		return nil, errors.Wrap(err, "while fetching Labels from DB")
+	}
+
+	var labels []*model.Label
+	for _, entity := range entities {
+		m, err := r.conv.FromEntity(entity)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting Label entity to model")
+		}
+
+		labels = append(labels, &m)
+	}
+
+	return labels, nil
+}
+
 func (r *repository) Delete(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, key string) error {
 	persist, err := persistence.FromCtx(ctx)
 	if err != nil {
@@ -270,6 +323,8 @@ func labelableObjectField(objectType model.LabelableObject) string {
 		return "runtime_id"
 	case model.RuntimeContextLabelableObject:
 		return "runtime_context_id"
+	case model.PackageLabelableObject:
+		return "package_id"
 	}
 
 	return ""
@@ -125,3 +125,17 @@ func (_m *LabelRepository) ListForObject(ctx context.Context, tenant string, obj
 
 	return r0, r1
 }
+
+// Upsert provides a mock function with given fields: ctx, label
+func (_m *LabelRepository) Upsert(ctx context.Context, label *model.Label) error {
+	ret := _m.Called(ctx, label)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Label) error); ok {
+		r0 = rf(ctx, label)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -36,6 +36,48 @@ func (_m *ModelConverter) FromGraphQL(input graphql.LabelDefinitionInput, tenant
 	return r0, r1
 }
 
+// MigrationFromGraphQL provides a mock function with given fields: input
+func (_m *ModelConverter) MigrationFromGraphQL(input graphql.LabelDefinitionMigrationInput) (model.LabelDefinitionMigration, error) {
+	ret := _m.Called(input)
+
+	var r0 model.LabelDefinitionMigration
+	if rf, ok := ret.Get(0).(func(graphql.LabelDefinitionMigrationInput) model.LabelDefinitionMigration); ok {
+		r0 = rf(input)
+	} else {
+		r0 = ret.Get(0).(model.LabelDefinitionMigration)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(graphql.LabelDefinitionMigrationInput) error); ok {
+		r1 = rf(input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MigrationResultToGraphQL provides a mock function with given fields: result
+func (_m *ModelConverter) MigrationResultToGraphQL(result model.LabelDefinitionMigrationResult) (graphql.LabelDefinitionMigrationResult, error) {
+	ret := _m.Called(result)
+
+	var r0 graphql.LabelDefinitionMigrationResult
+	if rf, ok := ret.Get(0).(func(model.LabelDefinitionMigrationResult) graphql.LabelDefinitionMigrationResult); ok {
+		r0 = rf(result)
+	} else {
+		r0 = ret.Get(0).(graphql.LabelDefinitionMigrationResult)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(model.LabelDefinitionMigrationResult) error); ok {
+		r1 = rf(result)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ToGraphQL provides a mock function with given fields: definition
 func (_m *ModelConverter) ToGraphQL(definition model.LabelDefinition) (graphql.LabelDefinition, error) {
 	ret := _m.Called(definition)
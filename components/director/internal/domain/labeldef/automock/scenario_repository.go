@@ -0,0 +1,53 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+// ScenarioRepository is an autogenerated mock type for the ScenarioRepository type
+type ScenarioRepository struct {
+	mock.Mock
+}
+
+// DeleteByName provides a mock function with given fields: ctx, tenant, name
+func (_m *ScenarioRepository) DeleteByName(ctx context.Context, tenant string, name string) error {
+	ret := _m.Called(ctx, tenant, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, tenant, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// List provides a mock function with given fields: ctx, tenant
+func (_m *ScenarioRepository) List(ctx context.Context, tenant string) ([]*model.Scenario, error) {
+	ret := _m.Called(ctx, tenant)
+
+	var r0 []*model.Scenario
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Scenario); ok {
+		r0 = rf(ctx, tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Scenario)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
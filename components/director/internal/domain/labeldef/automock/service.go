@@ -96,6 +96,27 @@ func (_m *Service) List(ctx context.Context, tenant string) ([]model.LabelDefini
 	return r0, r1
 }
 
+// Migrate provides a mock function with given fields: ctx, ld, migration
+func (_m *Service) Migrate(ctx context.Context, ld model.LabelDefinition, migration model.LabelDefinitionMigration) (model.LabelDefinitionMigrationResult, error) {
+	ret := _m.Called(ctx, ld, migration)
+
+	var r0 model.LabelDefinitionMigrationResult
+	if rf, ok := ret.Get(0).(func(context.Context, model.LabelDefinition, model.LabelDefinitionMigration) model.LabelDefinitionMigrationResult); ok {
+		r0 = rf(ctx, ld, migration)
+	} else {
+		r0 = ret.Get(0).(model.LabelDefinitionMigrationResult)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.LabelDefinition, model.LabelDefinitionMigration) error); ok {
+		r1 = rf(ctx, ld, migration)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, ld
 func (_m *Service) Update(ctx context.Context, ld model.LabelDefinition) error {
 	ret := _m.Called(ctx, ld)
@@ -75,3 +75,87 @@ func (c *converter) FromEntity(in Entity) (model.LabelDefinition, error) {
 	}
 	return out, nil
 }
+
+func (c *converter) MigrationFromGraphQL(in graphql.LabelDefinitionMigrationInput) (model.LabelDefinitionMigration, error) {
+	out := model.LabelDefinitionMigration{
+		OnInvalid: model.LabelMigrationActionFail,
+	}
+	if in.OnInvalid != nil {
+		out.OnInvalid = model.LabelMigrationAction(*in.OnInvalid)
+	}
+	if in.DryRun != nil {
+		out.DryRun = *in.DryRun
+	}
+
+	if in.DefaultValue != nil {
+		defaultValue, err := normalizeValue(*in.DefaultValue)
+		if err != nil {
+			return model.LabelDefinitionMigration{}, errors.Wrap(err, "while converting default value")
+		}
+		out.DefaultValue = defaultValue
+	}
+
+	for _, mapping := range in.ValueMappings {
+		if mapping == nil {
+			continue
+		}
+
+		from, err := normalizeValue(mapping.From)
+		if err != nil {
+			return model.LabelDefinitionMigration{}, errors.Wrap(err, "while converting value mapping")
+		}
+		to, err := normalizeValue(mapping.To)
+		if err != nil {
+			return model.LabelDefinitionMigration{}, errors.Wrap(err, "while converting value mapping")
+		}
+		out.ValueMappings = append(out.ValueMappings, model.LabelValueMapping{From: from, To: to})
+	}
+
+	return out, nil
+}
+
+func (c *converter) MigrationResultToGraphQL(in model.LabelDefinitionMigrationResult) (graphql.LabelDefinitionMigrationResult, error) {
+	ld, err := c.ToGraphQL(in.LabelDefinition)
+	if err != nil {
+		return graphql.LabelDefinitionMigrationResult{}, err
+	}
+
+	changes := make([]*graphql.LabelChange, 0, len(in.Changes))
+	for _, change := range in.Changes {
+		changes = append(changes, &graphql.LabelChange{
+			ObjectType: string(change.ObjectType),
+			ObjectID:   change.ObjectID,
+			Type:       graphql.LabelChangeType(change.Type),
+			OldValue:   valuePtr(change.OldValue),
+			NewValue:   valuePtr(change.NewValue),
+		})
+	}
+
+	return graphql.LabelDefinitionMigrationResult{
+		LabelDefinition: &ld,
+		DryRun:          in.DryRun,
+		Changes:         changes,
+	}, nil
+}
+
+// normalizeValue converts the value to the representation of the label values read from the database,
+// so that they can be compared
+func normalizeValue(value interface{}) (interface{}, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshalling value")
+	}
+
+	var out interface{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, errors.Wrap(err, "while unmarshalling value")
+	}
+	return out, nil
+}
+
+func valuePtr(value interface{}) *interface{} {
+	if value == nil {
+		return nil
+	}
+	return &value
+}
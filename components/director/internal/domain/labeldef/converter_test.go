@@ -164,3 +164,77 @@ type ExampleSchema struct {
 	ID    string `json:"$id"`
 	Title string `json:"title"`
 }
+
+func TestMigrationFromGraphQL(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		// GIVEN
+		sut := labeldef.NewConverter()
+
+		// WHEN
+		actual, err := sut.MigrationFromGraphQL(graphql.LabelDefinitionMigrationInput{})
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, model.LabelDefinitionMigration{OnInvalid: model.LabelMigrationActionFail}, actual)
+	})
+
+	t.Run("All fields", func(t *testing.T) {
+		// GIVEN
+		sut := labeldef.NewConverter()
+		onInvalid := graphql.LabelMigrationActionSetDefault
+		dryRun := true
+		var defaultValue interface{} = 42
+		in := graphql.LabelDefinitionMigrationInput{
+			ValueMappings: []*graphql.LabelValueMappingInput{
+				{From: "europe", To: "eu"},
+				{From: []string{"a"}, To: []string{"b"}},
+			},
+			OnInvalid:    &onInvalid,
+			DefaultValue: &defaultValue,
+			DryRun:       &dryRun,
+		}
+
+		// WHEN
+		actual, err := sut.MigrationFromGraphQL(in)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, model.LabelDefinitionMigration{
+			ValueMappings: []model.LabelValueMapping{
+				{From: "europe", To: "eu"},
+				{From: []interface{}{"a"}, To: []interface{}{"b"}},
+			},
+			OnInvalid:    model.LabelMigrationActionSetDefault,
+			DefaultValue: float64(42),
+			DryRun:       true,
+		}, actual)
+	})
+}
+
+func TestMigrationResultToGraphQL(t *testing.T) {
+	// GIVEN
+	sut := labeldef.NewConverter()
+	in := model.LabelDefinitionMigrationResult{
+		LabelDefinition: model.LabelDefinition{Key: "region", Tenant: testTenant},
+		DryRun:          true,
+		Changes: []model.LabelChange{
+			{ObjectType: model.RuntimeLabelableObject, ObjectID: "mapped", Type: model.LabelChangeTypeMapped, OldValue: "europe", NewValue: "eu"},
+			{ObjectType: model.ApplicationLabelableObject, ObjectID: "deleted", Type: model.LabelChangeTypeDeleted, OldValue: "asia"},
+		},
+	}
+	var oldMapped, newMapped, oldDeleted interface{} = "europe", "eu", "asia"
+
+	// WHEN
+	actual, err := sut.MigrationResultToGraphQL(in)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, graphql.LabelDefinitionMigrationResult{
+		LabelDefinition: &graphql.LabelDefinition{Key: "region"},
+		DryRun:          true,
+		Changes: []*graphql.LabelChange{
+			{ObjectType: "Runtime", ObjectID: "mapped", Type: graphql.LabelChangeTypeMapped, OldValue: &oldMapped, NewValue: &newMapped},
+			{ObjectType: "Application", ObjectID: "deleted", Type: graphql.LabelChangeTypeDeleted, OldValue: &oldDeleted},
+		},
+	}, actual)
+}
@@ -35,6 +35,8 @@ type ModelConverter interface {
 	// TODO: Use model.LabelDefinitionInput
 	FromGraphQL(input graphql.LabelDefinitionInput, tenant string) (model.LabelDefinition, error)
 	ToGraphQL(definition model.LabelDefinition) (graphql.LabelDefinition, error)
+	MigrationFromGraphQL(input graphql.LabelDefinitionMigrationInput) (model.LabelDefinitionMigration, error)
+	MigrationResultToGraphQL(result model.LabelDefinitionMigrationResult) (graphql.LabelDefinitionMigrationResult, error)
 }
 
 //go:generate mockery -name=Service -output=automock -outpkg=automock -case=underscore
@@ -44,6 +46,7 @@ type Service interface {
 	List(ctx context.Context, tenant string) ([]model.LabelDefinition, error)
 	Delete(ctx context.Context, tenant string, key string, deleteRelatedLabels bool) error
 	Update(ctx context.Context, ld model.LabelDefinition) error
+	Migrate(ctx context.Context, ld model.LabelDefinition, migration model.LabelDefinitionMigration) (model.LabelDefinitionMigrationResult, error)
 }
 
 func (r *Resolver) CreateLabelDefinition(ctx context.Context, in graphql.LabelDefinitionInput) (*graphql.LabelDefinition, error) {
@@ -190,6 +193,47 @@ func (r *Resolver) UpdateLabelDefinition(ctx context.Context, in graphql.LabelDe
 	return &out, nil
 }
 
+func (r *Resolver) MigrateLabelDefinition(ctx context.Context, in graphql.LabelDefinitionInput, migration graphql.LabelDefinitionMigrationInput) (*graphql.LabelDefinitionMigrationResult, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ld, err := r.conv.FromGraphQL(in, tnt)
+	if err != nil {
+		return nil, err
+	}
+
+	migrationModel, err := r.conv.MigrationFromGraphQL(migration)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.transactioner.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while starting transaction")
+	}
+	defer r.transactioner.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	result, err := r.srv.Migrate(ctx, ld, migrationModel)
+	if err != nil {
+		return nil, errors.Wrap(err, "while migrating label definition")
+	}
+
+	out, err := r.conv.MigrationResultToGraphQL(result)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	return &out, nil
+}
+
 func (r *Resolver) DeleteLabelDefinition(ctx context.Context, key string, deleteRelatedLabels *bool) (*graphql.LabelDefinition, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
@@ -941,6 +941,181 @@ func TestUpdateLabelDefinition(t *testing.T) {
 	})
 }
 
+func TestMigrateLabelDefinition(t *testing.T) {
+	tnt := "tenant"
+	externalTnt := "external-tenant"
+	gqlLabelDefinitionInput := graphql.LabelDefinitionInput{
+		Key:    "key",
+		Schema: fixBasicInputSchema(),
+	}
+	modelLabelDefinition := model.LabelDefinition{
+		Key:    "key",
+		Tenant: tnt,
+		Schema: fixBasicSchema(t),
+	}
+	dryRun := true
+	gqlMigrationInput := graphql.LabelDefinitionMigrationInput{DryRun: &dryRun}
+	modelMigration := model.LabelDefinitionMigration{OnInvalid: model.LabelMigrationActionFail, DryRun: true}
+	modelResult := model.LabelDefinitionMigrationResult{
+		LabelDefinition: modelLabelDefinition,
+		DryRun:          true,
+		Changes:         []model.LabelChange{{ObjectType: model.RuntimeLabelableObject, ObjectID: "id", Type: model.LabelChangeTypeInvalid}},
+	}
+	gqlResult := graphql.LabelDefinitionMigrationResult{
+		LabelDefinition: &graphql.LabelDefinition{Key: "key", Schema: fixBasicInputSchema()},
+		DryRun:          true,
+		Changes:         []*graphql.LabelChange{{ObjectType: "Runtime", ObjectID: "id", Type: graphql.LabelChangeTypeInvalid}},
+	}
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name               string
+		TransactionerFn    func() (*pautomock.PersistenceTx, *pautomock.Transactioner)
+		ServiceFn          func() *automock.Service
+		ConverterFn        func() *automock.ModelConverter
+		ExpectedResult     *graphql.LabelDefinitionMigrationResult
+		ExpectedErrMessage string
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("Migrate", contextThatHasTenant(tnt), modelLabelDefinition, modelMigration).Return(modelResult, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ModelConverter {
+				conv := &automock.ModelConverter{}
+				conv.On("FromGraphQL", gqlLabelDefinitionInput, tnt).Return(modelLabelDefinition, nil).Once()
+				conv.On("MigrationFromGraphQL", gqlMigrationInput).Return(modelMigration, nil).Once()
+				conv.On("MigrationResultToGraphQL", modelResult).Return(gqlResult, nil).Once()
+				return conv
+			},
+			ExpectedResult: &gqlResult,
+		},
+		{
+			Name:            "Returns error when label definition conversion failed",
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			ServiceFn:       func() *automock.Service { return &automock.Service{} },
+			ConverterFn: func() *automock.ModelConverter {
+				conv := &automock.ModelConverter{}
+				conv.On("FromGraphQL", gqlLabelDefinitionInput, tnt).Return(model.LabelDefinition{}, testErr).Once()
+				return conv
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:            "Returns error when migration conversion failed",
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			ServiceFn:       func() *automock.Service { return &automock.Service{} },
+			ConverterFn: func() *automock.ModelConverter {
+				conv := &automock.ModelConverter{}
+				conv.On("FromGraphQL", gqlLabelDefinitionInput, tnt).Return(modelLabelDefinition, nil).Once()
+				conv.On("MigrationFromGraphQL", gqlMigrationInput).Return(model.LabelDefinitionMigration{}, testErr).Once()
+				return conv
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn:       func() *automock.Service { return &automock.Service{} },
+			ConverterFn: func() *automock.ModelConverter {
+				conv := &automock.ModelConverter{}
+				conv.On("FromGraphQL", gqlLabelDefinitionInput, tnt).Return(modelLabelDefinition, nil).Once()
+				conv.On("MigrationFromGraphQL", gqlMigrationInput).Return(modelMigration, nil).Once()
+				return conv
+			},
+			ExpectedErrMessage: "while starting transaction: test error",
+		},
+		{
+			Name:            "Returns error when migration failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("Migrate", contextThatHasTenant(tnt), modelLabelDefinition, modelMigration).Return(model.LabelDefinitionMigrationResult{}, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ModelConverter {
+				conv := &automock.ModelConverter{}
+				conv.On("FromGraphQL", gqlLabelDefinitionInput, tnt).Return(modelLabelDefinition, nil).Once()
+				conv.On("MigrationFromGraphQL", gqlMigrationInput).Return(modelMigration, nil).Once()
+				return conv
+			},
+			ExpectedErrMessage: "while migrating label definition: test error",
+		},
+		{
+			Name:            "Returns error when result conversion failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("Migrate", contextThatHasTenant(tnt), modelLabelDefinition, modelMigration).Return(modelResult, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ModelConverter {
+				conv := &automock.ModelConverter{}
+				conv.On("FromGraphQL", gqlLabelDefinitionInput, tnt).Return(modelLabelDefinition, nil).Once()
+				conv.On("MigrationFromGraphQL", gqlMigrationInput).Return(modelMigration, nil).Once()
+				conv.On("MigrationResultToGraphQL", modelResult).Return(graphql.LabelDefinitionMigrationResult{}, testErr).Once()
+				return conv
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.Service {
+				svc := &automock.Service{}
+				svc.On("Migrate", contextThatHasTenant(tnt), modelLabelDefinition, modelMigration).Return(modelResult, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.ModelConverter {
+				conv := &automock.ModelConverter{}
+				conv.On("FromGraphQL", gqlLabelDefinitionInput, tnt).Return(modelLabelDefinition, nil).Once()
+				conv.On("MigrationFromGraphQL", gqlMigrationInput).Return(modelMigration, nil).Once()
+				conv.On("MigrationResultToGraphQL", modelResult).Return(gqlResult, nil).Once()
+				return conv
+			},
+			ExpectedErrMessage: "while committing transaction: test error",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			ctx := tenant.SaveToContext(context.TODO(), tnt, externalTnt)
+			sut := labeldef.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := sut.MigrateLabelDefinition(ctx, gqlLabelDefinitionInput, gqlMigrationInput)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.EqualError(t, err, testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+
+	t.Run("Returns error when tenant is missing in context", func(t *testing.T) {
+		// GIVEN
+		sut := labeldef.NewResolver(nil, nil, nil)
+		// WHEN
+		_, err := sut.MigrateLabelDefinition(context.TODO(), gqlLabelDefinitionInput, gqlMigrationInput)
+		// THEN
+		require.EqualError(t, err, "cannot read tenant from context")
+	})
+}
+
 func getInvalidMockTransactioner() *pautomock.Transactioner {
 	mockTransactioner := &pautomock.Transactioner{}
 	mockTransactioner.On("Begin").Return(nil, errors.New("some error"))
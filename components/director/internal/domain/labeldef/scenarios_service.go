@@ -55,15 +55,7 @@ func (s *scenariosService) GetAvailableScenarios(ctx context.Context, tenantID s
 		return nil, fmt.Errorf("missing schema for `%s` label definition", model.ScenariosKey)
 	}
 
-	b, err := json.Marshal(*def.Schema)
-	if err != nil {
-		return nil, errors.Wrapf(err, "while marshaling schema")
-	}
-	sd := ScenariosDefinition{}
-	if err = json.Unmarshal(b, &sd); err != nil {
-		return nil, errors.Wrapf(err, "while unmarshaling schema to %T", sd)
-	}
-	return sd.Items.Enum, nil
+	return scenariosFromSchema(*def.Schema)
 }
 
 func (s *scenariosService) AddDefaultScenarioIfEnabled(ctx context.Context, labels *map[string]interface{}) {
@@ -80,6 +72,18 @@ func (s *scenariosService) AddDefaultScenarioIfEnabled(ctx context.Context, labe
 	}
 }
 
+func scenariosFromSchema(schema interface{}) ([]string, error) {
+	b, err := json.Marshal(schema)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while marshaling schema")
+	}
+	sd := ScenariosDefinition{}
+	if err = json.Unmarshal(b, &sd); err != nil {
+		return nil, errors.Wrapf(err, "while unmarshaling schema to %T", sd)
+	}
+	return sd.Items.Enum, nil
+}
+
 type ScenariosDefinition struct {
 	Items struct {
 		Enum []string
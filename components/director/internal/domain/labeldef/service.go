@@ -3,6 +3,7 @@ package labeldef
 import (
 	"context"
 	"fmt"
+	"reflect"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
@@ -29,6 +30,7 @@ type ScenarioAssignmentLister interface {
 
 //go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
 type LabelRepository interface {
+	Upsert(ctx context.Context, label *model.Label) error
 	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
 	ListForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) (map[string]*model.Label, error)
 	ListByKey(ctx context.Context, tenant, key string) ([]*model.Label, error)
@@ -42,25 +44,37 @@ type ScenariosService interface {
 	EnsureScenariosLabelDefinitionExists(ctx context.Context, tenant string) error
 }
 
+//go:generate mockery -name=ScenarioRepository -output=automock -outpkg=automock -case=underscore
+type ScenarioRepository interface {
+	List(ctx context.Context, tenant string) ([]*model.Scenario, error)
+	DeleteByName(ctx context.Context, tenant, name string) error
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
 }
 
+type rawValidator interface {
+	ValidateRaw(value interface{}) (jsonschema.ValidationResult, error)
+}
+
 type service struct {
 	repo                     Repository
 	labelRepo                LabelRepository
 	scenarioAssignmentLister ScenarioAssignmentLister
 	scenariosService         ScenariosService
+	scenarioRepo             ScenarioRepository
 	uidService               UIDService
 }
 
-func NewService(repo Repository, labelRepo LabelRepository, scenarioAssignmentLister ScenarioAssignmentLister, scenariosService ScenariosService, uidService UIDService) *service {
+func NewService(repo Repository, labelRepo LabelRepository, scenarioAssignmentLister ScenarioAssignmentLister, scenariosService ScenariosService, scenarioRepo ScenarioRepository, uidService UIDService) *service {
 	return &service{
 		repo:                     repo,
 		labelRepo:                labelRepo,
 		scenarioAssignmentLister: scenarioAssignmentLister,
 		scenariosService:         scenariosService,
+		scenarioRepo:             scenarioRepo,
 		uidService:               uidService,
 	}
 }
@@ -125,7 +139,71 @@ func (s *service) Update(ctx context.Context, def model.LabelDefinition) error {
 		return errors.Wrap(err, "while updating Label Definition")
 	}
 
-	return nil
+	return s.deleteRemovedScenarios(ctx, *ld)
+}
+
+// Migrate updates the schema of the label definition and migrates the existing labels to it.
+// In dry run mode the changes are only reported.
+func (s *service) Migrate(ctx context.Context, def model.LabelDefinition, migration model.LabelDefinitionMigration) (model.LabelDefinitionMigrationResult, error) {
+	ld, err := s.repo.GetByKey(ctx, def.Tenant, def.Key)
+	if err != nil {
+		return model.LabelDefinitionMigrationResult{}, errors.Wrap(err, "while receiving Label Definition")
+	}
+
+	if ld == nil {
+		return model.LabelDefinitionMigrationResult{}, errors.Errorf("definition with %s key doesn't exist", def.Key)
+	}
+
+	ld.Schema = def.Schema
+
+	var schema interface{}
+	if def.Schema != nil {
+		schema = *def.Schema
+	}
+	validator, err := jsonschema.NewValidatorFromRawSchema(schema)
+	if err != nil {
+		return model.LabelDefinitionMigrationResult{}, errors.Wrap(err, "while creating validator for new schema")
+	}
+
+	if def.Schema != nil {
+		if err := s.validateAutomaticScenarioAssignmentAgainstSchema(ctx, *def.Schema, def.Tenant, def.Key); err != nil {
+			return model.LabelDefinitionMigrationResult{}, errors.Wrap(err, "while validating Scenario Assignments against a new schema")
+		}
+	}
+
+	changes, err := s.migrateLabels(ctx, validator, migration, def.Tenant, def.Key)
+	if err != nil {
+		return model.LabelDefinitionMigrationResult{}, err
+	}
+
+	result := model.LabelDefinitionMigrationResult{
+		LabelDefinition: *ld,
+		DryRun:          migration.DryRun,
+		Changes:         changes,
+	}
+	if migration.DryRun {
+		return result, nil
+	}
+
+	for _, change := range changes {
+		if change.Type == model.LabelChangeTypeInvalid {
+			return model.LabelDefinitionMigrationResult{}, apperrors.NewInvalidDataError(fmt.Sprintf(`label with key="%s" is not valid against new schema for %s with ID="%s"`, def.Key, change.ObjectType, change.ObjectID))
+		}
+	}
+
+	if err := s.applyLabelChanges(ctx, changes, def.Tenant, def.Key); err != nil {
+		return model.LabelDefinitionMigrationResult{}, err
+	}
+
+	if err := s.repo.Update(ctx, *ld); err != nil {
+		return model.LabelDefinitionMigrationResult{}, errors.Wrap(err, "while updating Label Definition")
+	}
+
+	if err := s.deleteRemovedScenarios(ctx, *ld); err != nil {
+		return model.LabelDefinitionMigrationResult{}, err
+	}
+
+	return result, nil
 }
 
 func (s *service) Delete(ctx context.Context, tenant, key string, deleteRelatedLabels bool) error {
@@ -159,6 +237,36 @@ func (s *service) Delete(ctx context.Context, tenant, key string, deleteRelatedL
 	return s.repo.DeleteByKey(ctx, tenant, ld.Key)
 }
 
+// deleteRemovedScenarios deletes the metadata of the scenarios which are no longer in the scenarios label definition
+func (s *service) deleteRemovedScenarios(ctx context.Context, ld model.LabelDefinition) error {
+	if ld.Key != model.ScenariosKey || ld.Schema == nil {
+		return nil
+	}
+
+	names, err := scenariosFromSchema(*ld.Schema)
+	if err != nil {
+		return err
+	}
+	available := make(map[string]bool, len(names))
+	for _, name := range names {
+		available[name] = true
+	}
+
+	scenarios, err := s.scenarioRepo.List(ctx, ld.Tenant)
+	if err != nil {
+		return errors.Wrap(err, "while listing scenarios")
+	}
+	for _, scenario := range scenarios {
+		if available[scenario.Name] {
+			continue
+		}
+		if err := s.scenarioRepo.DeleteByName(ctx, ld.Tenant, scenario.Name); err != nil {
+			return errors.Wrapf(err, "while deleting scenario %s", scenario.Name)
+		}
+	}
+	return nil
+}
+
 func (s *service) validateExistingLabelsAgainstSchema(ctx context.Context, schema interface{}, tenant, key string) error {
 	existingLabels, err := s.labelRepo.ListByKey(ctx, tenant, key)
 	if err != nil {
@@ -183,6 +291,121 @@ func (s *service) validateExistingLabelsAgainstSchema(ctx context.Context, schem
 	return nil
 }
 
+func (s *service) migrateLabels(ctx context.Context, validator rawValidator, migration model.LabelDefinitionMigration, tenant, key string) ([]model.LabelChange, error) {
+	if migration.OnInvalid == model.LabelMigrationActionSetDefault {
+		valid, err := isValid(validator, migration.DefaultValue)
+		if err != nil {
+			return nil, errors.Wrap(err, "while validating default value against new schema")
+		}
+		if !valid {
+			return nil, apperrors.NewInvalidDataError("default value is not valid against new schema")
+		}
+	}
+
+	existingLabels, err := s.labelRepo.ListByKey(ctx, tenant, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing labels by key")
+	}
+
+	changes := make([]model.LabelChange, 0)
+	for _, label := range existingLabels {
+		value, mapped := mapLabelValue(label.Value, migration.ValueMappings)
+
+		valid, err := isValid(validator, value)
+		if err != nil {
+			return nil, errors.Wrap(err, "while validating existing labels against new schema")
+		}
+
+		change := model.LabelChange{
+			ObjectType: label.ObjectType,
+			ObjectID:   label.ObjectID,
+			OldValue:   label.Value,
+		}
+
+		switch {
+		case valid && !mapped:
+			continue
+		case valid:
+			change.Type = model.LabelChangeTypeMapped
+			change.NewValue = value
+		case migration.OnInvalid == model.LabelMigrationActionSetDefault:
+			change.Type = model.LabelChangeTypeDefaulted
+			change.NewValue = migration.DefaultValue
+		case migration.OnInvalid == model.LabelMigrationActionDelete:
+			change.Type = model.LabelChangeTypeDeleted
+		default:
+			change.Type = model.LabelChangeTypeInvalid
+			change.NewValue = value
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+func (s *service) applyLabelChanges(ctx context.Context, changes []model.LabelChange, tenant, key string) error {
+	for _, change := range changes {
+		if change.Type == model.LabelChangeTypeDeleted {
+			if err := s.labelRepo.Delete(ctx, tenant, change.ObjectType, change.ObjectID, key); err != nil {
+				return errors.Wrapf(err, "while deleting label for %s with ID %s", change.ObjectType, change.ObjectID)
+			}
+			continue
+		}
+
+		label, err := s.labelRepo.GetByKey(ctx, tenant, change.ObjectType, change.ObjectID, key)
+		if err != nil {
+			return errors.Wrapf(err, "while getting label for %s with ID %s", change.ObjectType, change.ObjectID)
+		}
+
+		label.Value = change.NewValue
+		if err := s.labelRepo.Upsert(ctx, label); err != nil {
+			return errors.Wrapf(err, "while updating label for %s with ID %s", change.ObjectType, change.ObjectID)
+		}
+	}
+	return nil
+}
+
+// mapLabelValue applies the first mapping equal to the value. If the value is an array and no mapping is equal to it, the mappings are applied to its elements.
+func mapLabelValue(value interface{}, mappings []model.LabelValueMapping) (interface{}, bool) {
+	if mapped, ok := findMapping(value, mappings); ok {
+		return mapped, true
+	}
+
+	elements, ok := value.([]interface{})
+	if !ok {
+		return value, false
+	}
+
+	result := make([]interface{}, 0, len(elements))
+	changed := false
+	for _, element := range elements {
+		if mapped, ok := findMapping(element, mappings); ok {
+			element = mapped
+			changed = true
+		}
+		result = append(result, element)
+	}
+	return result, changed
+}
+
+func findMapping(value interface{}, mappings []model.LabelValueMapping) (interface{}, bool) {
+	for _, mapping := range mappings {
+		if reflect.DeepEqual(value, mapping.From) {
+			return mapping.To, true
+		}
+	}
+	return nil, false
+}
+
+func isValid(validator rawValidator, value interface{}) (bool, error) {
+	result, err := validator.ValidateRaw(value)
+	if err != nil {
+		return false, err
+	}
+	return result.Valid, nil
+}
+
 func (s *service) validateAutomaticScenarioAssignmentAgainstSchema(ctx context.Context, schema interface{}, tenantID, key string) error {
 	if key != model.ScenariosKey {
 		return nil
@@ -40,7 +40,7 @@ func TestServiceCreate(t *testing.T) {
 		mockRepository.On("Create", mock.Anything, defWithID).Return(nil)
 
 		ctx := context.TODO()
-		sut := labeldef.NewService(mockRepository, nil, nil, nil, mockUID)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil, mockUID)
 		// WHEN
 		actual, err := sut.Create(ctx, in)
 		// THEN
@@ -58,7 +58,7 @@ func TestServiceCreate(t *testing.T) {
 
 		mockUID.On("Generate").Return(fixUUID())
 		mockRepository.On("Create", mock.Anything, mock.Anything).Return(errors.New("some error"))
-		sut := labeldef.NewService(mockRepository, nil, nil, nil, mockUID)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil, mockUID)
 		// WHEN
 		_, err := sut.Create(context.TODO(), model.LabelDefinition{Key: "key", Tenant: "tenant"})
 		// THEN
@@ -78,7 +78,7 @@ func TestServiceGet(t *testing.T) {
 			Tenant: "tenant",
 		}
 		mockRepository.On("GetByKey", ctx, "tenant", "key").Return(&given, nil)
-		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil, nil)
 		// WHEN
 		actual, err := sut.Get(ctx, "tenant", "key")
 		// THEN
@@ -104,7 +104,7 @@ func TestServiceGet(t *testing.T) {
 		mockScenariosSvc.On("EnsureScenariosLabelDefinitionExists", ctx, testTenant).Return(nil).Once()
 		defer mock.AssertExpectationsForObjects(t, mockRepository, mockScenariosSvc)
 
-		sut := labeldef.NewService(mockRepository, nil, nil, mockScenariosSvc, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, mockScenariosSvc, nil, nil)
 
 		// WHEN
 		actual, err := sut.Get(ctx, testTenant, testKey)
@@ -121,7 +121,7 @@ func TestServiceGet(t *testing.T) {
 		mockRepository.On("GetByKey", mock.Anything, mock.Anything, mock.Anything).
 			Return(nil, errors.New("some error"))
 
-		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := sut.Get(context.TODO(), "tenant", "key")
 		// THEN
@@ -140,7 +140,7 @@ func TestServiceGet(t *testing.T) {
 		mockScenariosSvc.On("EnsureScenariosLabelDefinitionExists", ctx, testTenant).Return(testError).Once()
 		defer mock.AssertExpectationsForObjects(t, mockScenariosSvc)
 
-		sut := labeldef.NewService(nil, nil, nil, mockScenariosSvc, nil)
+		sut := labeldef.NewService(nil, nil, nil, mockScenariosSvc, nil, nil)
 
 		// WHEN
 		actual, err := sut.Get(ctx, testTenant, testKey)
@@ -170,7 +170,7 @@ func TestServiceList(t *testing.T) {
 		}
 		mockRepository.On("List", ctx, "tenant").Return(givenDefs, nil)
 
-		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil, nil)
 		// WHEN
 		actual, err := sut.List(ctx, "tenant")
 		// THEN
@@ -184,7 +184,7 @@ func TestServiceList(t *testing.T) {
 		defer mockRepository.AssertExpectations(t)
 		ctx := context.TODO()
 		mockRepository.On("List", ctx, "tenant").Return(nil, errors.New("some error"))
-		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := sut.List(ctx, "tenant")
 		// THEN
@@ -255,7 +255,7 @@ func TestServiceUpdate(t *testing.T) {
 		mockLabelRepository.On("ListByKey", context.TODO(), tenant, key).Return(existingLabels, nil).Once()
 
 		ctx := context.TODO()
-		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil, nil, nil)
 		// WHEN
 		err := sut.Update(ctx, in)
 		// THEN
@@ -318,7 +318,7 @@ func TestServiceUpdate(t *testing.T) {
 		mockLabelRepository.On("ListByKey", context.TODO(), tenant, key).Return(existingLabels, nil).Once()
 
 		ctx := context.TODO()
-		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil, nil, nil)
 		// WHEN
 		err := sut.Update(ctx, in)
 		// THEN
@@ -332,7 +332,7 @@ func TestServiceUpdate(t *testing.T) {
 		defer mockRepository.AssertExpectations(t)
 
 		mockRepository.On("GetByKey", context.TODO(), tenant, key).Return(nil, errors.New("some error"))
-		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil, nil)
 		// WHEN
 		err := sut.Update(context.TODO(), model.LabelDefinition{Key: key, Tenant: tenant, Schema: fixBasicSchema(t)})
 		// THEN
@@ -345,7 +345,7 @@ func TestServiceUpdate(t *testing.T) {
 		defer mockRepository.AssertExpectations(t)
 
 		mockRepository.On("GetByKey", context.TODO(), tenant, key).Return(nil, nil)
-		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil, nil)
 		// WHEN
 		err := sut.Update(context.TODO(), model.LabelDefinition{Key: key, Tenant: tenant, Schema: fixBasicSchema(t)})
 		// THEN
@@ -398,7 +398,7 @@ func TestServiceUpdate(t *testing.T) {
 
 		mockLabelRepository.On("ListByKey", context.TODO(), "tenant", "firstName").Return(existingLabels, nil).Once()
 
-		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil, nil, nil)
 		// WHEN
 		err := sut.Update(context.TODO(), *ld)
 		// THEN
@@ -419,7 +419,7 @@ func TestServiceUpdate(t *testing.T) {
 		mockRepository.On("GetByKey", context.TODO(), tenant, key).Return(ld, nil).Once()
 		mockRepository.On("Update", context.TODO(), *ld).Return(nil).Once()
 
-		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil, nil)
 		// WHEN
 		err := sut.Update(context.TODO(), *ld)
 		// THEN
@@ -431,13 +431,15 @@ func TestServiceUpdate(t *testing.T) {
 		mockRepository := &automock.Repository{}
 		mockLabelRepo := &automock.LabelRepository{}
 		mockScenarioAssignmentLister := &automock.ScenarioAssignmentLister{}
-		defer mock.AssertExpectationsForObjects(t, mockRepository, mockLabelRepo, mockScenarioAssignmentLister)
-		sut := labeldef.NewService(mockRepository, mockLabelRepo, mockScenarioAssignmentLister, nil, nil)
+		mockScenarioRepo := &automock.ScenarioRepository{}
+		defer mock.AssertExpectationsForObjects(t, mockRepository, mockLabelRepo, mockScenarioAssignmentLister, mockScenarioRepo)
+		sut := labeldef.NewService(mockRepository, mockLabelRepo, mockScenarioAssignmentLister, nil, mockScenarioRepo, nil)
 
 		defaultLD := fixDefaultScenariosLabelDefinition(tenant)
 		ld := fixModifiedScenariosLabelDefinition(tenant)
 		mockRepository.On("GetByKey", mock.Anything, tenant, model.ScenariosKey).Return(&defaultLD, nil)
 		mockRepository.On("Update", context.TODO(), ld).Return(nil).Once()
+		mockScenarioRepo.On("List", context.TODO(), tenant).Return(nil, nil).Once()
 
 		mockLabelRepo.On("ListByKey", mock.Anything, tenant, model.ScenariosKey).Return(nil, nil)
 		mockScenarioAssignmentLister.On("List", mock.Anything, tenant, 100, "").Return(&model.AutomaticScenarioAssignmentPage{
@@ -457,13 +459,15 @@ func TestServiceUpdate(t *testing.T) {
 		mockRepository := &automock.Repository{}
 		mockLabelRepo := &automock.LabelRepository{}
 		mockScenarioAssignmentLister := &automock.ScenarioAssignmentLister{}
-		defer mock.AssertExpectationsForObjects(t, mockRepository, mockLabelRepo, mockScenarioAssignmentLister)
-		sut := labeldef.NewService(mockRepository, mockLabelRepo, mockScenarioAssignmentLister, nil, nil)
+		mockScenarioRepo := &automock.ScenarioRepository{}
+		defer mock.AssertExpectationsForObjects(t, mockRepository, mockLabelRepo, mockScenarioAssignmentLister, mockScenarioRepo)
+		sut := labeldef.NewService(mockRepository, mockLabelRepo, mockScenarioAssignmentLister, nil, mockScenarioRepo, nil)
 
 		defaultLD := fixDefaultScenariosLabelDefinition(tenant)
 		ld := fixModifiedScenariosLabelDefinition(tenant)
 		mockRepository.On("GetByKey", mock.Anything, tenant, model.ScenariosKey).Return(&defaultLD, nil)
 		mockRepository.On("Update", context.TODO(), ld).Return(nil).Once()
+		mockScenarioRepo.On("List", context.TODO(), tenant).Return(nil, nil).Once()
 
 		mockLabelRepo.On("ListByKey", mock.Anything, tenant, model.ScenariosKey).Return(nil, nil)
 		mockScenarioAssignmentLister.On("List", mock.Anything, tenant, 100, "").Return(&model.AutomaticScenarioAssignmentPage{
@@ -487,13 +491,70 @@ func TestServiceUpdate(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("success when updating scenarios label definition deletes metadata of removed scenarios", func(t *testing.T) {
+		// GIVEN
+		mockRepository := &automock.Repository{}
+		mockLabelRepo := &automock.LabelRepository{}
+		mockScenarioAssignmentLister := &automock.ScenarioAssignmentLister{}
+		mockScenarioRepo := &automock.ScenarioRepository{}
+		defer mock.AssertExpectationsForObjects(t, mockRepository, mockLabelRepo, mockScenarioAssignmentLister, mockScenarioRepo)
+		sut := labeldef.NewService(mockRepository, mockLabelRepo, mockScenarioAssignmentLister, nil, mockScenarioRepo, nil)
+
+		defaultLD := fixDefaultScenariosLabelDefinition(tenant)
+		ld := fixModifiedScenariosLabelDefinition(tenant)
+		mockRepository.On("GetByKey", mock.Anything, tenant, model.ScenariosKey).Return(&defaultLD, nil)
+		mockRepository.On("Update", context.TODO(), ld).Return(nil).Once()
+		mockLabelRepo.On("ListByKey", mock.Anything, tenant, model.ScenariosKey).Return(nil, nil)
+		mockScenarioAssignmentLister.On("List", mock.Anything, tenant, 100, "").Return(&model.AutomaticScenarioAssignmentPage{
+			PageInfo: &pagination.Page{
+				HasNextPage: false,
+			},
+		}, nil)
+		mockScenarioRepo.On("List", context.TODO(), tenant).Return([]*model.Scenario{
+			{Tenant: tenant, Name: "scenario-A"},
+			{Tenant: tenant, Name: "scenario-C"},
+		}, nil).Once()
+		mockScenarioRepo.On("DeleteByName", context.TODO(), tenant, "scenario-C").Return(nil).Once()
+		// WHEN
+		err := sut.Update(context.TODO(), ld)
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("returns error if metadata of removed scenarios cannot be deleted", func(t *testing.T) {
+		// GIVEN
+		mockRepository := &automock.Repository{}
+		mockLabelRepo := &automock.LabelRepository{}
+		mockScenarioAssignmentLister := &automock.ScenarioAssignmentLister{}
+		mockScenarioRepo := &automock.ScenarioRepository{}
+		defer mock.AssertExpectationsForObjects(t, mockRepository, mockLabelRepo, mockScenarioAssignmentLister, mockScenarioRepo)
+		sut := labeldef.NewService(mockRepository, mockLabelRepo, mockScenarioAssignmentLister, nil, mockScenarioRepo, nil)
+
+		defaultLD := fixDefaultScenariosLabelDefinition(tenant)
+		ld := fixModifiedScenariosLabelDefinition(tenant)
+		mockRepository.On("GetByKey", mock.Anything, tenant, model.ScenariosKey).Return(&defaultLD, nil)
+		mockRepository.On("Update", context.TODO(), ld).Return(nil).Once()
+		mockLabelRepo.On("ListByKey", mock.Anything, tenant, model.ScenariosKey).Return(nil, nil)
+		mockScenarioAssignmentLister.On("List", mock.Anything, tenant, 100, "").Return(&model.AutomaticScenarioAssignmentPage{
+			PageInfo: &pagination.Page{
+				HasNextPage: false,
+			},
+		}, nil)
+		mockScenarioRepo.On("List", context.TODO(), tenant).Return([]*model.Scenario{{Tenant: tenant, Name: "scenario-C"}}, nil).Once()
+		mockScenarioRepo.On("DeleteByName", context.TODO(), tenant, "scenario-C").Return(errors.New("some error")).Once()
+		// WHEN
+		err := sut.Update(context.TODO(), ld)
+		// THEN
+		require.EqualError(t, err, "while deleting scenario scenario-C: some error")
+	})
+
 	t.Run("returns error if cannot fetch automatic assignments", func(t *testing.T) {
 		// GIVEN
 		mockRepository := &automock.Repository{}
 		mockLabelRepo := &automock.LabelRepository{}
 		mockScenarioAssignmentLister := &automock.ScenarioAssignmentLister{}
 		defer mock.AssertExpectationsForObjects(t, mockRepository, mockLabelRepo, mockScenarioAssignmentLister)
-		sut := labeldef.NewService(mockRepository, mockLabelRepo, mockScenarioAssignmentLister, nil, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepo, mockScenarioAssignmentLister, nil, nil, nil)
 
 		defaultLD := fixDefaultScenariosLabelDefinition(tenant)
 		ld := fixModifiedScenariosLabelDefinition(tenant)
@@ -514,7 +575,7 @@ func TestServiceUpdate(t *testing.T) {
 		mockLabelRepo := &automock.LabelRepository{}
 		mockScenarioAssignmentLister := &automock.ScenarioAssignmentLister{}
 		defer mock.AssertExpectationsForObjects(t, mockRepository, mockLabelRepo, mockScenarioAssignmentLister)
-		sut := labeldef.NewService(mockRepository, mockLabelRepo, mockScenarioAssignmentLister, nil, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepo, mockScenarioAssignmentLister, nil, nil, nil)
 
 		defaultLD := fixDefaultScenariosLabelDefinition(tenant)
 		ld := fixModifiedScenariosLabelDefinition(tenant)
@@ -554,7 +615,7 @@ func TestServiceDelete(t *testing.T) {
 		mockRepository.On("DeleteByKey", ctx, tnt, given.Key).Return(nil).Once()
 		mockLabelRepository.On("ListByKey", ctx, tnt, given.Key).Return([]*model.Label{}, nil)
 
-		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil, nil, nil)
 		// WHEN
 		err := sut.Delete(ctx, tnt, given.Key, deleteRelatedResources)
 		// THEN
@@ -582,7 +643,7 @@ func TestServiceDelete(t *testing.T) {
 		mockLabelRepository.On("DeleteByKey", ctx, tnt, given.Key).Return(nil).Once()
 		mockLabelRepository.On("ListByKey", ctx, tnt, given.Key).Return([]*model.Label{}, nil).Once()
 
-		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil, nil, nil)
 		// WHEN
 		err := sut.Delete(ctx, tnt, given.Key, deleteRelatedResources)
 		// THEN
@@ -602,7 +663,7 @@ func TestServiceDelete(t *testing.T) {
 		}
 		deleteRelatedResources := false
 
-		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil, nil)
 		// WHEN
 		err := sut.Delete(ctx, tnt, given.Key, deleteRelatedResources)
 		// THEN
@@ -631,7 +692,7 @@ func TestServiceDelete(t *testing.T) {
 		mockRepository.On("GetByKey", ctx, tnt, given.Key).Return(&given, nil).Once()
 		mockLabelRepository.On("ListByKey", ctx, tnt, given.Key).Return(existingLabels, nil)
 
-		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil, nil, nil)
 		// WHEN
 		err := sut.Delete(ctx, "tenant", given.Key, deleteRelatedResources)
 		// THEN
@@ -655,7 +716,7 @@ func TestServiceDelete(t *testing.T) {
 		mockRepository.On("GetByKey", ctx, tnt, given.Key).Return(&given, nil).Once()
 		mockLabelRepository.On("ListByKey", ctx, tnt, given.Key).Return([]*model.Label{}, errors.New("test"))
 
-		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil, nil, nil)
 		// WHEN
 		err := sut.Delete(ctx, "tenant", given.Key, deleteRelatedResources)
 		// THEN
@@ -676,7 +737,7 @@ func TestServiceDelete(t *testing.T) {
 		deleteRelatedResources := false
 		mockRepository.On("GetByKey", ctx, tnt, given.Key).Return(nil, nil).Once()
 
-		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil, nil)
 		// WHEN
 		err := sut.Delete(ctx, tnt, given.Key, deleteRelatedResources)
 		// THEN
@@ -697,7 +758,7 @@ func TestServiceDelete(t *testing.T) {
 		deleteRelatedResources := false
 		mockRepository.On("GetByKey", ctx, tnt, given.Key).Return(nil, errors.New("")).Once()
 
-		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, nil, nil, nil, nil, nil)
 		// WHEN
 		err := sut.Delete(ctx, tnt, given.Key, deleteRelatedResources)
 		// THEN
@@ -724,7 +785,7 @@ func TestServiceDelete(t *testing.T) {
 		mockRepository.On("GetByKey", ctx, tnt, given.Key).Return(&given, nil).Once()
 		mockLabelRepository.On("DeleteByKey", ctx, tnt, given.Key).Return(testErr).Once()
 
-		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil, nil)
+		sut := labeldef.NewService(mockRepository, mockLabelRepository, nil, nil, nil, nil)
 		// WHEN
 		err := sut.Delete(ctx, tnt, given.Key, deleteRelatedResources)
 		// THEN
@@ -841,3 +902,275 @@ func fixLabel(id, tenant, key string, value interface{}, objectID string, object
 		ObjectType: objectType,
 	}
 }
+
+func TestServiceMigrate(t *testing.T) {
+	// GIVEN
+	tenant := "tenant"
+	key := "region"
+	testErr := errors.New("test error")
+
+	newSchema := fixSchemaFromJSON(t, `{"type": "string", "enum": ["eu", "us"]}`)
+	ld := model.LabelDefinition{ID: fixUUID(), Tenant: tenant, Key: key}
+	in := model.LabelDefinition{Tenant: tenant, Key: key, Schema: newSchema}
+	migratedLd := model.LabelDefinition{ID: fixUUID(), Tenant: tenant, Key: key, Schema: newSchema}
+
+	validLabel := fixRegionLabel(key, "valid", "eu")
+	mappedLabel := fixRegionLabel(key, "mapped", "europe")
+	invalidLabel := fixRegionLabel(key, "invalid", "asia")
+	existingLabels := []*model.Label{validLabel, mappedLabel, invalidLabel}
+	mappings := []model.LabelValueMapping{{From: "europe", To: "eu"}}
+
+	mappedChange := model.LabelChange{ObjectType: model.RuntimeLabelableObject, ObjectID: "mapped", Type: model.LabelChangeTypeMapped, OldValue: "europe", NewValue: "eu"}
+
+	testCases := []struct {
+		Name               string
+		Input              model.LabelDefinition
+		Migration          model.LabelDefinitionMigration
+		RepoFn             func() *automock.Repository
+		LabelRepoFn        func() *automock.LabelRepository
+		ExpectedChanges    []model.LabelChange
+		ExpectedErrMessage string
+	}{
+		{
+			Name:      "Success - dry run reports changes without applying them",
+			Input:     in,
+			Migration: model.LabelDefinitionMigration{ValueMappings: mappings, OnInvalid: model.LabelMigrationActionDelete, DryRun: true},
+			RepoFn:    fixRepoWithLabelDefinition(tenant, key, ld),
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", mock.Anything, tenant, key).Return(existingLabels, nil).Once()
+				return repo
+			},
+			ExpectedChanges: []model.LabelChange{
+				mappedChange,
+				{ObjectType: model.RuntimeLabelableObject, ObjectID: "invalid", Type: model.LabelChangeTypeDeleted, OldValue: "asia"},
+			},
+		},
+		{
+			Name:      "Success - dry run reports invalid labels",
+			Input:     in,
+			Migration: model.LabelDefinitionMigration{OnInvalid: model.LabelMigrationActionFail, DryRun: true},
+			RepoFn:    fixRepoWithLabelDefinition(tenant, key, ld),
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", mock.Anything, tenant, key).Return(existingLabels, nil).Once()
+				return repo
+			},
+			ExpectedChanges: []model.LabelChange{
+				{ObjectType: model.RuntimeLabelableObject, ObjectID: "mapped", Type: model.LabelChangeTypeInvalid, OldValue: "europe", NewValue: "europe"},
+				{ObjectType: model.RuntimeLabelableObject, ObjectID: "invalid", Type: model.LabelChangeTypeInvalid, OldValue: "asia", NewValue: "asia"},
+			},
+		},
+		{
+			Name:      "Success - maps values and sets default value",
+			Input:     in,
+			Migration: model.LabelDefinitionMigration{ValueMappings: mappings, OnInvalid: model.LabelMigrationActionSetDefault, DefaultValue: "us"},
+			RepoFn: func() *automock.Repository {
+				repo := fixRepoWithLabelDefinition(tenant, key, ld)()
+				repo.On("Update", mock.Anything, migratedLd).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", mock.Anything, tenant, key).Return(existingLabels, nil).Once()
+				repo.On("GetByKey", mock.Anything, tenant, model.RuntimeLabelableObject, "mapped", key).Return(fixRegionLabel(key, "mapped", "europe"), nil).Once()
+				repo.On("Upsert", mock.Anything, fixRegionLabel(key, "mapped", "eu")).Return(nil).Once()
+				repo.On("GetByKey", mock.Anything, tenant, model.RuntimeLabelableObject, "invalid", key).Return(fixRegionLabel(key, "invalid", "asia"), nil).Once()
+				repo.On("Upsert", mock.Anything, fixRegionLabel(key, "invalid", "us")).Return(nil).Once()
+				return repo
+			},
+			ExpectedChanges: []model.LabelChange{
+				mappedChange,
+				{ObjectType: model.RuntimeLabelableObject, ObjectID: "invalid", Type: model.LabelChangeTypeDefaulted, OldValue: "asia", NewValue: "us"},
+			},
+		},
+		{
+			Name:      "Success - deletes invalid labels",
+			Input:     in,
+			Migration: model.LabelDefinitionMigration{OnInvalid: model.LabelMigrationActionDelete},
+			RepoFn: func() *automock.Repository {
+				repo := fixRepoWithLabelDefinition(tenant, key, ld)()
+				repo.On("Update", mock.Anything, migratedLd).Return(nil).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", mock.Anything, tenant, key).Return(existingLabels, nil).Once()
+				repo.On("Delete", mock.Anything, tenant, model.RuntimeLabelableObject, "mapped", key).Return(nil).Once()
+				repo.On("Delete", mock.Anything, tenant, model.RuntimeLabelableObject, "invalid", key).Return(nil).Once()
+				return repo
+			},
+			ExpectedChanges: []model.LabelChange{
+				{ObjectType: model.RuntimeLabelableObject, ObjectID: "mapped", Type: model.LabelChangeTypeDeleted, OldValue: "europe"},
+				{ObjectType: model.RuntimeLabelableObject, ObjectID: "invalid", Type: model.LabelChangeTypeDeleted, OldValue: "asia"},
+			},
+		},
+		{
+			Name:  "Success - maps elements of array values",
+			Input: model.LabelDefinition{Tenant: tenant, Key: key, Schema: fixSchemaFromJSON(t, `{"type": "array", "items": {"enum": ["A", "B"]}}`)},
+			Migration: model.LabelDefinitionMigration{
+				ValueMappings: []model.LabelValueMapping{{From: "OLD", To: "B"}},
+				OnInvalid:     model.LabelMigrationActionFail,
+				DryRun:        true,
+			},
+			RepoFn: fixRepoWithLabelDefinition(tenant, key, ld),
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", mock.Anything, tenant, key).Return([]*model.Label{fixRegionLabel(key, "array", []interface{}{"OLD", "A"})}, nil).Once()
+				return repo
+			},
+			ExpectedChanges: []model.LabelChange{
+				{ObjectType: model.RuntimeLabelableObject, ObjectID: "array", Type: model.LabelChangeTypeMapped, OldValue: []interface{}{"OLD", "A"}, NewValue: []interface{}{"B", "A"}},
+			},
+		},
+		{
+			Name:      "Success - maps values when schema is removed",
+			Input:     model.LabelDefinition{Tenant: tenant, Key: key},
+			Migration: model.LabelDefinitionMigration{ValueMappings: mappings, OnInvalid: model.LabelMigrationActionFail, DryRun: true},
+			RepoFn:    fixRepoWithLabelDefinition(tenant, key, ld),
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", mock.Anything, tenant, key).Return(existingLabels, nil).Once()
+				return repo
+			},
+			ExpectedChanges: []model.LabelChange{mappedChange},
+		},
+		{
+			Name:      "Error when labels are invalid and migration fails on invalid labels",
+			Input:     in,
+			Migration: model.LabelDefinitionMigration{ValueMappings: mappings, OnInvalid: model.LabelMigrationActionFail},
+			RepoFn:    fixRepoWithLabelDefinition(tenant, key, ld),
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", mock.Anything, tenant, key).Return(existingLabels, nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: `label with key="region" is not valid against new schema for Runtime with ID="invalid"`,
+		},
+		{
+			Name:               "Error when default value does not match new schema",
+			Input:              in,
+			Migration:          model.LabelDefinitionMigration{OnInvalid: model.LabelMigrationActionSetDefault, DefaultValue: "asia"},
+			RepoFn:             fixRepoWithLabelDefinition(tenant, key, ld),
+			LabelRepoFn:        func() *automock.LabelRepository { return &automock.LabelRepository{} },
+			ExpectedErrMessage: "default value is not valid against new schema",
+		},
+		{
+			Name:      "Error when label definition does not exist",
+			Input:     in,
+			Migration: model.LabelDefinitionMigration{OnInvalid: model.LabelMigrationActionFail},
+			RepoFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("GetByKey", mock.Anything, tenant, key).Return(nil, nil).Once()
+				return repo
+			},
+			LabelRepoFn:        func() *automock.LabelRepository { return &automock.LabelRepository{} },
+			ExpectedErrMessage: "definition with region key doesn't exist",
+		},
+		{
+			Name:      "Error when labels cannot be listed",
+			Input:     in,
+			Migration: model.LabelDefinitionMigration{OnInvalid: model.LabelMigrationActionFail},
+			RepoFn:    fixRepoWithLabelDefinition(tenant, key, ld),
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", mock.Anything, tenant, key).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:      "Error when label cannot be updated",
+			Input:     in,
+			Migration: model.LabelDefinitionMigration{ValueMappings: mappings, OnInvalid: model.LabelMigrationActionDelete},
+			RepoFn:    fixRepoWithLabelDefinition(tenant, key, ld),
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", mock.Anything, tenant, key).Return(existingLabels, nil).Once()
+				repo.On("GetByKey", mock.Anything, tenant, model.RuntimeLabelableObject, "mapped", key).Return(fixRegionLabel(key, "mapped", "europe"), nil).Once()
+				repo.On("Upsert", mock.Anything, fixRegionLabel(key, "mapped", "eu")).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:      "Error when label cannot be deleted",
+			Input:     in,
+			Migration: model.LabelDefinitionMigration{OnInvalid: model.LabelMigrationActionDelete},
+			RepoFn:    fixRepoWithLabelDefinition(tenant, key, ld),
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", mock.Anything, tenant, key).Return(existingLabels, nil).Once()
+				repo.On("Delete", mock.Anything, tenant, model.RuntimeLabelableObject, "mapped", key).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:      "Error when label definition cannot be updated",
+			Input:     in,
+			Migration: model.LabelDefinitionMigration{OnInvalid: model.LabelMigrationActionFail},
+			RepoFn: func() *automock.Repository {
+				repo := fixRepoWithLabelDefinition(tenant, key, ld)()
+				repo.On("Update", mock.Anything, migratedLd).Return(testErr).Once()
+				return repo
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListByKey", mock.Anything, tenant, key).Return([]*model.Label{validLabel}, nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			labelRepo := testCase.LabelRepoFn()
+			sut := labeldef.NewService(repo, labelRepo, nil, nil, nil, nil)
+
+			// WHEN
+			result, err := sut.Migrate(context.TODO(), testCase.Input, testCase.Migration)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.Migration.DryRun, result.DryRun)
+				assert.Equal(t, testCase.Input.Schema, result.LabelDefinition.Schema)
+				assert.Equal(t, testCase.ExpectedChanges, result.Changes)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, labelRepo)
+		})
+	}
+}
+
+func fixRepoWithLabelDefinition(tenant, key string, ld model.LabelDefinition) func() *automock.Repository {
+	return func() *automock.Repository {
+		repo := &automock.Repository{}
+		def := ld
+		repo.On("GetByKey", mock.Anything, tenant, key).Return(&def, nil).Once()
+		return repo
+	}
+}
+
+func fixRegionLabel(key, objectID string, value interface{}) *model.Label {
+	return &model.Label{
+		ID:         "id-" + objectID,
+		Tenant:     "tenant",
+		Key:        key,
+		Value:      value,
+		ObjectID:   objectID,
+		ObjectType: model.RuntimeLabelableObject,
+	}
+}
+
+func fixSchemaFromJSON(t *testing.T, schema string) *interface{} {
+	var out interface{}
+	require.NoError(t, json.Unmarshal([]byte(schema), &out))
+	return &out
+}
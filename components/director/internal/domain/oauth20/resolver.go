@@ -32,6 +32,11 @@ type RuntimeService interface {
 	Exist(ctx context.Context, id string) (bool, error)
 }
 
+//go:generate mockery -name=RuntimeContextService -output=automock -outpkg=automock -case=underscore
+type RuntimeContextService interface {
+	Exist(ctx context.Context, id string) (bool, error)
+}
+
 //go:generate mockery -name=IntegrationSystemService -output=automock -outpkg=automock -case=underscore
 type IntegrationSystemService interface {
 	Exists(ctx context.Context, id string) (bool, error)
@@ -55,17 +60,22 @@ type Resolver struct {
 	systemAuthConv SystemAuthConverter
 	appSvc         ApplicationService
 	rtmSvc         RuntimeService
+	rtmCtxSvc      RuntimeContextService
 	isSvc          IntegrationSystemService
 }
 
-func NewResolver(transactioner persistence.Transactioner, svc Service, appSvc ApplicationService, rtmSvc RuntimeService, isSvc IntegrationSystemService, systemAuthSvc SystemAuthService, systemAuthConv SystemAuthConverter) *Resolver {
-	return &Resolver{transact: transactioner, svc: svc, appSvc: appSvc, rtmSvc: rtmSvc, systemAuthSvc: systemAuthSvc, isSvc: isSvc, systemAuthConv: systemAuthConv}
+func NewResolver(transactioner persistence.Transactioner, svc Service, appSvc ApplicationService, rtmSvc RuntimeService, rtmCtxSvc RuntimeContextService, isSvc IntegrationSystemService, systemAuthSvc SystemAuthService, systemAuthConv SystemAuthConverter) *Resolver {
+	return &Resolver{transact: transactioner, svc: svc, appSvc: appSvc, rtmSvc: rtmSvc, rtmCtxSvc: rtmCtxSvc, systemAuthSvc: systemAuthSvc, isSvc: isSvc, systemAuthConv: systemAuthConv}
 }
 
 func (r *Resolver) RequestClientCredentialsForRuntime(ctx context.Context, id string) (*graphql.SystemAuth, error) {
 	return r.generateClientCredentials(ctx, model.RuntimeReference, id)
 }
 
+func (r *Resolver) RequestClientCredentialsForRuntimeContext(ctx context.Context, id string) (*graphql.SystemAuth, error) {
+	return r.generateClientCredentials(ctx, model.RuntimeContextReference, id)
+}
+
 func (r *Resolver) RequestClientCredentialsForApplication(ctx context.Context, id string) (*graphql.SystemAuth, error) {
 	return r.generateClientCredentials(ctx, model.ApplicationReference, id)
 }
@@ -143,6 +153,8 @@ func (r *Resolver) checkObjectExist(ctx context.Context, objType model.SystemAut
 	switch objType {
 	case model.RuntimeReference:
 		return r.rtmSvc.Exist(ctx, objID)
+	case model.RuntimeContextReference:
+		return r.rtmCtxSvc.Exist(ctx, objID)
 	case model.ApplicationReference:
 		return r.appSvc.Exist(ctx, objID)
 	case model.IntegrationSystemReference:
@@ -38,6 +38,7 @@ func TestResolver_CommonRequestClientCredentialsSuccess(t *testing.T) {
 		AppID                      *string
 		IntSysID                   *string
 		RuntimeServiceFn           func() *automock.RuntimeService
+		RuntimeContextServiceFn    func() *automock.RuntimeContextService
 		ApplicationServiceFn       func() *automock.ApplicationService
 		IntegrationSystemServiceFn func() *automock.IntegrationSystemService
 	}{
@@ -62,6 +63,30 @@ func TestResolver_CommonRequestClientCredentialsSuccess(t *testing.T) {
 				return resolver.RequestClientCredentialsForRuntime(ctx, id)
 			},
 		},
+		{
+			Name:    "Runtime Context",
+			ObjType: model.RuntimeContextReference,
+			RuntimeServiceFn: func() *automock.RuntimeService {
+				rtmSvc := &automock.RuntimeService{}
+				return rtmSvc
+			},
+			RuntimeContextServiceFn: func() *automock.RuntimeContextService {
+				rtmCtxSvc := &automock.RuntimeContextService{}
+				rtmCtxSvc.On("Exist", txtest.CtxWithDBMatcher(), id).Return(true, nil).Once()
+				return rtmCtxSvc
+			},
+			ApplicationServiceFn: func() *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				return appSvc
+			},
+			IntegrationSystemServiceFn: func() *automock.IntegrationSystemService {
+				isSvc := &automock.IntegrationSystemService{}
+				return isSvc
+			},
+			Method: func(resolver *oauth20.Resolver, ctx context.Context, id string) (*graphql.SystemAuth, error) {
+				return resolver.RequestClientCredentialsForRuntimeContext(ctx, id)
+			},
+		},
 		{
 			Name:    "Application",
 			AppID:   &id,
@@ -121,6 +146,12 @@ func TestResolver_CommonRequestClientCredentialsSuccess(t *testing.T) {
 			rtmSvc := testCase.RuntimeServiceFn()
 			defer rtmSvc.AssertExpectations(t)
 
+			rtmCtxSvc := &automock.RuntimeContextService{}
+			if testCase.RuntimeContextServiceFn != nil {
+				rtmCtxSvc = testCase.RuntimeContextServiceFn()
+			}
+			defer rtmCtxSvc.AssertExpectations(t)
+
 			appSvc := testCase.ApplicationServiceFn()
 			defer appSvc.AssertExpectations(t)
 
@@ -136,7 +167,7 @@ func TestResolver_CommonRequestClientCredentialsSuccess(t *testing.T) {
 			systemAuthConv.On("ToGraphQL", modelSystemAuth).Return(expectedResult, nil).Once()
 			defer systemAuthConv.AssertExpectations(t)
 
-			resolver := oauth20.NewResolver(transact, svc, appSvc, rtmSvc, isSvc, systemAuthSvc, systemAuthConv)
+			resolver := oauth20.NewResolver(transact, svc, appSvc, rtmSvc, rtmCtxSvc, isSvc, systemAuthSvc, systemAuthConv)
 
 			// When
 			result, err := testCase.Method(resolver, context.TODO(), id)
@@ -365,7 +396,7 @@ func TestResolver_CommonRequestClientCredentialsError(t *testing.T) {
 			systemAuthSvc := testCase.SystemAuthServiceFn()
 			defer systemAuthSvc.AssertExpectations(t)
 
-			resolver := oauth20.NewResolver(transact, svc, nil, rtmSvc, nil, systemAuthSvc, nil)
+			resolver := oauth20.NewResolver(transact, svc, nil, rtmSvc, nil, nil, systemAuthSvc, nil)
 
 			// When
 			_, err := resolver.RequestClientCredentialsForRuntime(context.TODO(), id)
@@ -155,6 +155,11 @@ func (s *service) unregisterClient(ctx context.Context, clientID string) error {
 	}
 	defer closeBody(resp.Body)
 
+	if resp.StatusCode == http.StatusNotFound {
+		log.C(ctx).Debugf("client_id %s is already unregistered in Hydra", clientID)
+		return nil
+	}
+
 	if resp.StatusCode != http.StatusNoContent {
 		return fmt.Errorf("invalid HTTP status code: received: %d, expected %d", resp.StatusCode, http.StatusNoContent)
 	}
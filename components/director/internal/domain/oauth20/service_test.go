@@ -207,6 +207,16 @@ func TestService_DeleteClientCredentials(t *testing.T) {
 				return tc
 			},
 		},
+		{
+			Name:          "Success when client is already unregistered",
+			ExpectedError: nil,
+			HTTPServerFn: func(t *testing.T) *httptest.Server {
+				tc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+				}))
+				return tc
+			},
+		},
 		{
 			Name:          "Error - Response Status Code",
 			ExpectedError: errors.New("invalid HTTP status code: received: 500, expected 204"),
@@ -15,7 +15,7 @@ func (t *ConnectorTokenModel) Token(tokenType model.SystemAuthReferenceObjectTyp
 	switch tokenType {
 	case model.ApplicationReference:
 		return t.AppToken.Token
-	case model.RuntimeReference:
+	case model.RuntimeReference, model.RuntimeContextReference:
 		return t.RuntimeToken.Token
 	}
 	return ""
@@ -55,6 +55,27 @@ func (r *Resolver) RequestOneTimeTokenForRuntime(ctx context.Context, id string)
 	return &gqlToken, nil
 }
 
+func (r *Resolver) RequestOneTimeTokenForRuntimeContext(ctx context.Context, id string) (*graphql.OneTimeTokenForRuntime, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	token, err := r.svc.GenerateOneTimeToken(ctx, id, model.RuntimeContextReference)
+	if err != nil {
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, errors.Wrap(err, "while commiting transaction")
+	}
+
+	gqlToken := r.conv.ToGraphQLForRuntime(token)
+	return &gqlToken, nil
+}
+
 func (r *Resolver) RequestOneTimeTokenForApplication(ctx context.Context, id string) (*graphql.OneTimeTokenForApplication, error) {
 	tx, err := r.transact.Begin()
 	if err != nil {
@@ -194,6 +194,55 @@ func TestResolver_GenerateOneTimeTokenForRuntime(t *testing.T) {
 	})
 }
 
+func TestResolver_GenerateOneTimeTokenForRuntimeContext(t *testing.T) {
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	runtimeContextID := "7a3a6bd6-0c4b-4a6c-8d43-a5d4e0b8c3a1"
+	ctx := context.TODO()
+	tokenModel := model.OneTimeToken{Token: "Token", ConnectorURL: "connectorURL"}
+	expectedToken := graphql.OneTimeTokenForRuntime{TokenWithURL: graphql.TokenWithURL{Token: "Token", ConnectorURL: "connectorURL"}}
+	t.Run("Success", func(t *testing.T) {
+		//GIVEN
+		svc := &automock.TokenService{}
+		svc.On("GenerateOneTimeToken", txtest.CtxWithDBMatcher(), runtimeContextID, model.RuntimeContextReference).Return(tokenModel, nil)
+		persist, transact := txGen.ThatSucceeds()
+		conv := &automock.TokenConverter{}
+		conv.On("ToGraphQLForRuntime", tokenModel).Return(expectedToken)
+		r := onetimetoken.NewTokenResolver(transact, svc, conv)
+
+		//WHEN
+		oneTimeToken, err := r.RequestOneTimeTokenForRuntimeContext(ctx, runtimeContextID)
+
+		//THEN
+		require.NoError(t, err)
+		require.NotNil(t, oneTimeToken)
+		assert.Equal(t, expectedToken, *oneTimeToken)
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		svc.AssertExpectations(t)
+		conv.AssertExpectations(t)
+	})
+
+	t.Run("Error - service return error", func(t *testing.T) {
+		//GIVEN
+		svc := &automock.TokenService{}
+		svc.On("GenerateOneTimeToken", txtest.CtxWithDBMatcher(), runtimeContextID, model.RuntimeContextReference).Return(tokenModel, testErr)
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		conv := &automock.TokenConverter{}
+		r := onetimetoken.NewTokenResolver(transact, svc, conv)
+
+		//WHEN
+		_, err := r.RequestOneTimeTokenForRuntimeContext(ctx, runtimeContextID)
+
+		//THEN
+		require.Error(t, err)
+		persist.AssertExpectations(t)
+		transact.AssertExpectations(t)
+		svc.AssertExpectations(t)
+		conv.AssertExpectations(t)
+	})
+}
+
 func TestResolver_RawEncoded(t *testing.T) {
 	ctx := context.TODO()
 	tokenGraphql := graphql.OneTimeTokenForApplication{TokenWithURL: graphql.TokenWithURL{Token: "Token", ConnectorURL: "connectorURL"}, LegacyConnectorURL: "legacyConnectorURL"}
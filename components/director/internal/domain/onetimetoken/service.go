@@ -51,6 +51,7 @@ type ApplicationConverter interface {
 //go:generate mockery -name=ApplicationService -output=automock -outpkg=automock -case=underscore
 type ApplicationService interface {
 	Get(ctx context.Context, id string) (*model.Application, error)
+	ListLabels(ctx context.Context, applicationID string) (map[string]*model.Label, error)
 }
 
 //go:generate mockery -name=ExternalTenantsService -output=automock -outpkg=automock -case=underscore
@@ -117,11 +118,22 @@ func (s *service) getTokenFromAdapter(ctx context.Context, adapterURL string, ap
 		log.C(ctx).Infof("unable to provide client_user for internal tenant [%s] with corresponding external tenant [%s]", app.Tenant, extTenant)
 	}
 
+	appLabels, err := s.appSvc.ListLabels(ctx, app.ID)
+	if err != nil {
+		return model.OneTimeToken{}, errors.Wrapf(err, "while listing labels for application [%s]", app.ID)
+	}
+
+	labels := make(map[string]interface{}, len(appLabels))
+	for key, label := range appLabels {
+		labels[key] = label.Value
+	}
+
 	graphqlApp := s.appConverter.ToGraphQL(&app)
 	data := pairing.RequestData{
 		Application: *graphqlApp,
 		Tenant:      extTenant,
 		ClientUser:  clientUser,
+		Labels:      labels,
 	}
 
 	asJSON, err := json.Marshal(data)
@@ -173,7 +185,7 @@ func (s service) getOneTimeToken(ctx context.Context, id string, tokenType model
 	var req *gcli.Request
 
 	switch tokenType {
-	case model.RuntimeReference:
+	case model.RuntimeReference, model.RuntimeContextReference:
 		req = gcli.NewRequest(fmt.Sprintf(requestForRuntime, id))
 	case model.ApplicationReference:
 		req = gcli.NewRequest(fmt.Sprintf(requestForApplication, id))
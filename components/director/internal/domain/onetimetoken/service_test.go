@@ -117,6 +117,7 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 
 	applicationID := "5b560bbe-c45b-49e7-847f-20d63b1ac91d"
 	integrationSystemID := "fabd8d1e-7a13-485a-8176-e3ca4187bf2c"
+	givenLabels := map[string]*model.Label{"pairingTarget": {Key: "pairingTarget", Value: "my-target"}}
 
 	t.Run("Success - token for application not managed by integration system", func(t *testing.T) {
 		// GIVEN
@@ -152,6 +153,7 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 		mockAppService := &automock.ApplicationService{}
 		givenApplication := model.Application{ID: applicationID, IntegrationSystemID: &integrationSystemID, Tenant: "internal-tenant"}
 		mockAppService.On("Get", ctx, applicationID).Return(&givenApplication, nil)
+		mockAppService.On("ListLabels", ctx, applicationID).Return(givenLabels, nil)
 		adaptersMapping := map[string]string{integrationSystemID: "https://my-integration-service.url"}
 
 		mockAppConverter := &automock.ApplicationConverter{}
@@ -181,9 +183,10 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 			tenantMatches := appData.Tenant == "external-tenant"
 			clientUserMatches := appData.ClientUser == ""
 			appIDMatches := appData.Application.ID == givenGraphQLApp.ID
+			labelsMatch := appData.Labels["pairingTarget"] == "my-target"
 			urlMatches := req.URL.String() == "https://my-integration-service.url"
 
-			return urlMatches && appIDMatches && tenantMatches && clientUserMatches
+			return urlMatches && appIDMatches && tenantMatches && clientUserMatches && labelsMatch
 		})).Return(response, nil)
 
 		mockExtTenants := &automock.ExternalTenantsService{}
@@ -212,6 +215,7 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 		mockAppService := &automock.ApplicationService{}
 		givenApplication := model.Application{ID: applicationID, IntegrationSystemID: &integrationSystemID, Tenant: "internal-tenant"}
 		mockAppService.On("Get", ctx, applicationID).Return(&givenApplication, nil)
+		mockAppService.On("ListLabels", ctx, applicationID).Return(givenLabels, nil)
 		adaptersMapping := map[string]string{integrationSystemID: "https://my-integration-service.url"}
 
 		mockAppConverter := &automock.ApplicationConverter{}
@@ -241,9 +245,10 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 			tenantMatches := appData.Tenant == "external-tenant"
 			clientUserMatches := appData.ClientUser == clientUser
 			appIDMatches := appData.Application.ID == givenGraphQLApp.ID
+			labelsMatch := appData.Labels["pairingTarget"] == "my-target"
 			urlMatches := req.URL.String() == "https://my-integration-service.url"
 
-			return urlMatches && appIDMatches && tenantMatches && clientUserMatches
+			return urlMatches && appIDMatches && tenantMatches && clientUserMatches && labelsMatch
 		})).Return(response, nil)
 
 		mockExtTenants := &automock.ExternalTenantsService{}
@@ -292,6 +297,7 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 		mockAppService := &automock.ApplicationService{}
 		givenApplication := model.Application{ID: applicationID, IntegrationSystemID: &integrationSystemID, Tenant: "internal-tenant"}
 		mockAppService.On("Get", ctx, applicationID).Return(&givenApplication, nil)
+		mockAppService.On("ListLabels", ctx, applicationID).Return(givenLabels, nil)
 		adaptersMapping := map[string]string{integrationSystemID: "https://my-integration-service.url"}
 
 		mockAppConverter := &automock.ApplicationConverter{}
@@ -339,6 +345,30 @@ func TestTokenService_GetOneTimeTokenForApp(t *testing.T) {
 
 	})
 
+	t.Run("Error on listing application labels", func(t *testing.T) {
+		// GIVEN
+		ctx := context.TODO()
+
+		sysAuthSvc := &automock.SystemAuthService{}
+		sysAuthSvc.On("Create", ctx, model.ApplicationReference, applicationID, (*model.AuthInput)(nil)).
+			Return(authID, nil)
+
+		mockAppService := &automock.ApplicationService{}
+		givenApplication := model.Application{ID: applicationID, IntegrationSystemID: &integrationSystemID, Tenant: "internal-tenant"}
+		mockAppService.On("Get", ctx, applicationID).Return(&givenApplication, nil)
+		mockAppService.On("ListLabels", ctx, applicationID).Return(nil, errors.New("some error"))
+		adaptersMapping := map[string]string{integrationSystemID: "https://my-integration-service.url"}
+		mockExtTenants := &automock.ExternalTenantsService{}
+		mockExtTenants.On("GetExternalTenant", ctx, "internal-tenant").Return("external-tenant", nil)
+
+		svc := onetimetoken.NewTokenService(nil, sysAuthSvc, mockAppService, nil, mockExtTenants, nil, URL, adaptersMapping)
+		defer mock.AssertExpectationsForObjects(t, sysAuthSvc, mockAppService, mockExtTenants)
+		// WHEN
+		_, err := svc.GenerateOneTimeToken(ctx, applicationID, model.ApplicationReference)
+		// THEN
+		assert.EqualError(t, err, "while listing labels for application [5b560bbe-c45b-49e7-847f-20d63b1ac91d]: some error")
+	})
+
 	t.Run("Error on getting information about external tenant", func(t *testing.T) {
 		// GIVEN
 		ctx := context.TODO()
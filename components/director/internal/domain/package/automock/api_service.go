@@ -59,3 +59,26 @@ func (_m *APIService) ListForPackage(ctx context.Context, packageID string, page
 
 	return r0, r1
 }
+
+// ListForPackageIDs provides a mock function with given fields: ctx, packageIDs, pageSize, cursor
+func (_m *APIService) ListForPackageIDs(ctx context.Context, packageIDs []string, pageSize int, cursor string) ([]*model.APIDefinitionPage, error) {
+	ret := _m.Called(ctx, packageIDs, pageSize, cursor)
+
+	var r0 []*model.APIDefinitionPage
+	if rf, ok := ret.Get(0).(func(context.Context, []string, int, string) []*model.APIDefinitionPage); ok {
+		r0 = rf(ctx, packageIDs, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.APIDefinitionPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string, int, string) error); ok {
+		r1 = rf(ctx, packageIDs, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
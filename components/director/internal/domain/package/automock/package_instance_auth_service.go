@@ -59,3 +59,26 @@ func (_m *PackageInstanceAuthService) List(ctx context.Context, id string) ([]*m
 
 	return r0, r1
 }
+
+// ListByPackageIDs provides a mock function with given fields: ctx, packageIDs
+func (_m *PackageInstanceAuthService) ListByPackageIDs(ctx context.Context, packageIDs []string) (map[string][]*model.PackageInstanceAuth, error) {
+	ret := _m.Called(ctx, packageIDs)
+
+	var r0 map[string][]*model.PackageInstanceAuth
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string][]*model.PackageInstanceAuth); ok {
+		r0 = rf(ctx, packageIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*model.PackageInstanceAuth)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, packageIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
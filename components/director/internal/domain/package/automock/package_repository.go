@@ -155,6 +155,29 @@ func (_m *PackageRepository) ListByApplicationID(ctx context.Context, tenantID s
 	return r0, r1
 }
 
+// ListByApplicationIDs provides a mock function with given fields: ctx, tenantID, applicationIDs, pageSize, cursor
+func (_m *PackageRepository) ListByApplicationIDs(ctx context.Context, tenantID string, applicationIDs []string, pageSize int, cursor string) ([]*model.PackagePage, error) {
+	ret := _m.Called(ctx, tenantID, applicationIDs, pageSize, cursor)
+
+	var r0 []*model.PackagePage
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int, string) []*model.PackagePage); ok {
+		r0 = rf(ctx, tenantID, applicationIDs, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PackagePage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, int, string) error); ok {
+		r1 = rf(ctx, tenantID, applicationIDs, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, item
 func (_m *PackageRepository) Update(ctx context.Context, item *model.Package) error {
 	ret := _m.Called(ctx, item)
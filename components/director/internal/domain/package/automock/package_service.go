@@ -49,6 +49,20 @@ func (_m *PackageService) Delete(ctx context.Context, id string) error {
 	return r0
 }
 
+// DeleteLabel provides a mock function with given fields: ctx, packageID, key
+func (_m *PackageService) DeleteLabel(ctx context.Context, packageID string, key string) error {
+	ret := _m.Called(ctx, packageID, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, packageID, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: ctx, id
 func (_m *PackageService) Get(ctx context.Context, id string) (*model.Package, error) {
 	ret := _m.Called(ctx, id)
@@ -72,6 +86,66 @@ func (_m *PackageService) Get(ctx context.Context, id string) (*model.Package, e
 	return r0, r1
 }
 
+// GetLabel provides a mock function with given fields: ctx, packageID, key
+func (_m *PackageService) GetLabel(ctx context.Context, packageID string, key string) (*model.Label, error) {
+	ret := _m.Called(ctx, packageID, key)
+
+	var r0 *model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Label); ok {
+		r0 = rf(ctx, packageID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, packageID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListLabels provides a mock function with given fields: ctx, packageID
+func (_m *PackageService) ListLabels(ctx context.Context, packageID string) (map[string]*model.Label, error) {
+	ret := _m.Called(ctx, packageID)
+
+	var r0 map[string]*model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]*model.Label); ok {
+		r0 = rf(ctx, packageID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, packageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetLabel provides a mock function with given fields: ctx, labelInput
+func (_m *PackageService) SetLabel(ctx context.Context, labelInput *model.LabelInput) error {
+	ret := _m.Called(ctx, labelInput)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.LabelInput) error); ok {
+		r0 = rf(ctx, labelInput)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, id, in
 func (_m *PackageService) Update(ctx context.Context, id string, in model.PackageUpdateInput) error {
 	ret := _m.Called(ctx, id, in)
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
@@ -40,6 +41,7 @@ type pgRepository struct {
 	singleGetter    repo.SingleGetter
 	deleter         repo.Deleter
 	pageableQuerier repo.PageableQuerier
+	unionLister     repo.UnionLister
 	creator         repo.Creator
 	updater         repo.Updater
 	conv            EntityConverter
@@ -51,6 +53,7 @@ func NewRepository(conv EntityConverter) *pgRepository {
 		singleGetter:    repo.NewSingleGetter(resource.Package, packageTable, tenantColumn, packageColumns),
 		deleter:         repo.NewDeleter(resource.Package, packageTable, tenantColumn),
 		pageableQuerier: repo.NewPageableQuerier(resource.Package, packageTable, tenantColumn, packageColumns),
+		unionLister:     repo.NewUnionLister(resource.Package, packageTable, tenantColumn, packageColumns),
 		creator:         repo.NewCreator(resource.Package, packageTable, packageColumns),
 		updater:         repo.NewUpdater(resource.Package, packageTable, []string{"name", "description", "instance_auth_request_json_schema", "default_instance_auth"}, tenantColumn, []string{"id"}),
 		conv:            conv,
@@ -188,3 +191,46 @@ func (r *pgRepository) ListByApplicationID(ctx context.Context, tenantID string,
 		PageInfo:   page,
 	}, nil
 }
+
+func (r *pgRepository) ListByApplicationIDs(ctx context.Context, tenantID string, applicationIDs []string, pageSize int, cursor string) ([]*model.PackagePage, error) {
+	var packageCollection PackageCollection
+	counts, err := r.unionLister.List(ctx, tenantID, applicationIDs, "app_id", pageSize, cursor, "id", &packageCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	pkgByAppID := map[string][]*model.Package{}
+	for _, pkgEnt := range packageCollection {
+		m, err := r.conv.FromEntity(&pkgEnt)
+		if err != nil {
+			return nil, errors.Wrap(err, "while creating Package model from entity")
+		}
+		pkgByAppID[pkgEnt.ApplicationID] = append(pkgByAppID[pkgEnt.ApplicationID], m)
+	}
+
+	offset, err := pagination.DecodeOffsetCursor(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding page cursor")
+	}
+
+	pkgPages := make([]*model.PackagePage, 0, len(applicationIDs))
+	for _, appID := range applicationIDs {
+		totalCount := counts[appID]
+		hasNextPage := false
+		endCursor := ""
+		if totalCount > offset+len(pkgByAppID[appID]) {
+			hasNextPage = true
+			endCursor = pagination.EncodeNextOffsetCursor(offset, pageSize)
+		}
+
+		page := &pagination.Page{
+			StartCursor: cursor,
+			EndCursor:   endCursor,
+			HasNextPage: hasNextPage,
+		}
+
+		pkgPages = append(pkgPages, &model.PackagePage{Data: pkgByAppID[appID], TotalCount: totalCount, PageInfo: page})
+	}
+
+	return pkgPages, nil
+}
@@ -490,3 +490,106 @@ func TestPgRepository_ListByApplicationID(t *testing.T) {
 		sqlMock.AssertExpectations(t)
 	})
 }
+
+func TestPgRepository_ListByApplicationIDs(t *testing.T) {
+	// GIVEN
+	inputPageSize := 3
+	inputCursor := ""
+	secondAppID := "bbbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	thirdAppID := "ccccccccc-cccc-cccc-cccc-cccccccccccc"
+	firstPkgID := "111111111-1111-1111-1111-111111111111"
+	firstPkgEntity := fixEntityPackage(firstPkgID, "foo", "bar")
+	secondPkgID := "222222222-2222-2222-2222-222222222222"
+	secondPkgEntity := fixEntityPackage(secondPkgID, "foo", "bar")
+	secondPkgEntity.ApplicationID = secondAppID
+
+	selectQuery := `^\(SELECT (.+) FROM public.packages WHERE app_id = \$1 AND tenant_id = \$2 ORDER BY id LIMIT 3 OFFSET 0\) UNION ALL \(SELECT (.+) FROM public.packages WHERE app_id = \$3 AND tenant_id = \$4 ORDER BY id LIMIT 3 OFFSET 0\) UNION ALL \(SELECT (.+) FROM public.packages WHERE app_id = \$5 AND tenant_id = \$6 ORDER BY id LIMIT 3 OFFSET 0\)`
+	countQuery := regexp.QuoteMeta(`SELECT app_id AS id, COUNT(*) AS total_count FROM public.packages WHERE tenant_id = $1 AND app_id IN ($2, $3, $4) GROUP BY app_id`)
+
+	t.Run("success", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		secondPkgRow := fixPackageRow(secondPkgID, "placeholder")
+		secondPkgRow[2] = secondAppID
+		rows := sqlmock.NewRows(fixPackageColumns()).
+			AddRow(fixPackageRow(firstPkgID, "placeholder")...).
+			AddRow(secondPkgRow...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(appID, tenantID, secondAppID, tenantID, thirdAppID, tenantID).
+			WillReturnRows(rows)
+
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(tenantID, appID, secondAppID, thirdAppID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(appID, 1).AddRow(secondAppID, 5))
+
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", firstPkgEntity).Return(&model.Package{ID: firstPkgID}, nil)
+		convMock.On("FromEntity", secondPkgEntity).Return(&model.Package{ID: secondPkgID}, nil)
+		pgRepository := mp_package.NewRepository(convMock)
+		// WHEN
+		pages, err := pgRepository.ListByApplicationIDs(ctx, tenantID, []string{appID, secondAppID, thirdAppID}, inputPageSize, inputCursor)
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, pages, 3)
+		require.Len(t, pages[0].Data, 1)
+		assert.Equal(t, firstPkgID, pages[0].Data[0].ID)
+		assert.Equal(t, 1, pages[0].TotalCount)
+		assert.False(t, pages[0].PageInfo.HasNextPage)
+		require.Len(t, pages[1].Data, 1)
+		assert.Equal(t, secondPkgID, pages[1].Data[0].ID)
+		assert.Equal(t, 5, pages[1].TotalCount)
+		assert.True(t, pages[1].PageInfo.HasNextPage)
+		assert.NotEmpty(t, pages[1].PageInfo.EndCursor)
+		assert.Empty(t, pages[2].Data)
+		assert.Equal(t, 0, pages[2].TotalCount)
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		// given
+		repo := mp_package.NewRepository(nil)
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		testError := errors.New("test error")
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(appID, tenantID, secondAppID, tenantID, thirdAppID, tenantID).
+			WillReturnError(testError)
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		// when
+		pages, err := repo.ListByApplicationIDs(ctx, tenantID, []string{appID, secondAppID, thirdAppID}, inputPageSize, inputCursor)
+
+		// then
+		sqlMock.AssertExpectations(t)
+		assert.Nil(t, pages)
+		require.EqualError(t, err, fmt.Sprintf("while fetching list of objects from DB: %s", testError.Error()))
+	})
+
+	t.Run("returns error when conversion from entity to model failed", func(t *testing.T) {
+		sqlxDB, sqlMock := testdb.MockDatabase(t)
+		testErr := errors.New("test error")
+		rows := sqlmock.NewRows(fixPackageColumns()).
+			AddRow(fixPackageRow(firstPkgID, "foo")...)
+
+		sqlMock.ExpectQuery(selectQuery).
+			WithArgs(appID, tenantID, secondAppID, tenantID, thirdAppID, tenantID).
+			WillReturnRows(rows)
+		sqlMock.ExpectQuery(countQuery).
+			WithArgs(tenantID, appID, secondAppID, thirdAppID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow(appID, 1))
+		ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+		convMock := &automock.EntityConverter{}
+		convMock.On("FromEntity", firstPkgEntity).Return(&model.Package{}, testErr).Once()
+		pgRepository := mp_package.NewRepository(convMock)
+		//WHEN
+		_, err := pgRepository.ListByApplicationIDs(ctx, tenantID, []string{appID, secondAppID, thirdAppID}, inputPageSize, inputCursor)
+		//THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), testErr.Error())
+		convMock.AssertExpectations(t)
+		sqlMock.AssertExpectations(t)
+	})
+}
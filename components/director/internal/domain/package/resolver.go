@@ -3,7 +3,10 @@ package mp_package
 import (
 	"context"
 
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 
@@ -21,6 +24,10 @@ type PackageService interface {
 	Update(ctx context.Context, id string, in model.PackageUpdateInput) error
 	Delete(ctx context.Context, id string) error
 	Get(ctx context.Context, id string) (*model.Package, error)
+	SetLabel(ctx context.Context, labelInput *model.LabelInput) error
+	GetLabel(ctx context.Context, packageID string, key string) (*model.Label, error)
+	ListLabels(ctx context.Context, packageID string) (map[string]*model.Label, error)
+	DeleteLabel(ctx context.Context, packageID string, key string) error
 }
 
 //go:generate mockery -name=PackageConverter -output=automock -outpkg=automock -case=underscore
@@ -34,6 +41,7 @@ type PackageConverter interface {
 type PackageInstanceAuthService interface {
 	GetForPackage(ctx context.Context, id string, packageID string) (*model.PackageInstanceAuth, error)
 	List(ctx context.Context, id string) ([]*model.PackageInstanceAuth, error)
+	ListByPackageIDs(ctx context.Context, packageIDs []string) (map[string][]*model.PackageInstanceAuth, error)
 }
 
 //go:generate mockery -name=PackageInstanceAuthConverter -output=automock -outpkg=automock -case=underscore
@@ -45,6 +53,7 @@ type PackageInstanceAuthConverter interface {
 //go:generate mockery -name=APIService -output=automock -outpkg=automock -case=underscore
 type APIService interface {
 	ListForPackage(ctx context.Context, packageID string, pageSize int, cursor string) (*model.APIDefinitionPage, error)
+	ListForPackageIDs(ctx context.Context, packageIDs []string, pageSize int, cursor string) ([]*model.APIDefinitionPage, error)
 	GetForPackage(ctx context.Context, id string, packageID string) (*model.APIDefinition, error)
 }
 
@@ -239,11 +248,125 @@ func (r *Resolver) DeletePackage(ctx context.Context, id string) (*graphql.Packa
 	return deletedPkg, nil
 }
 
+func (r *Resolver) SetPackageLabel(ctx context.Context, packageID string, key string, value interface{}) (*graphql.Label, error) {
+	// TODO: Use @validation directive on input type instead, after resolving https://github.com/kyma-incubator/compass/issues/515
+	gqlLabel := graphql.LabelInput{Key: key, Value: value}
+	if err := inputvalidation.Validate(&gqlLabel); err != nil {
+		return nil, errors.Wrap(err, "validation error for type LabelInput")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	err = r.packageSvc.SetLabel(ctx, &model.LabelInput{
+		Key:        key,
+		Value:      value,
+		ObjectType: model.PackageLabelableObject,
+		ObjectID:   packageID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphql.Label{
+		Key:   key,
+		Value: value,
+	}, nil
+}
+
+func (r *Resolver) DeletePackageLabel(ctx context.Context, packageID string, key string) (*graphql.Label, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	label, err := r.packageSvc.GetLabel(ctx, packageID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.packageSvc.DeleteLabel(ctx, packageID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphql.Label{
+		Key:   key,
+		Value: label.Value,
+	}, nil
+}
+
+func (r *Resolver) Labels(ctx context.Context, obj *graphql.Package, key *string) (*graphql.Labels, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Package cannot be empty")
+	}
+
+	ctx, err := ownerContext(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	itemMap, err := r.packageSvc.ListLabels(ctx, obj.ID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, tx.Commit()
+		}
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	resultLabels := make(map[string]interface{})
+
+	for _, label := range itemMap {
+		if key == nil || label.Key == *key {
+			resultLabels[label.Key] = label.Value
+		}
+	}
+
+	var gqlLabels graphql.Labels = resultLabels
+	return &gqlLabels, nil
+}
+
 func (r *Resolver) InstanceAuth(ctx context.Context, obj *graphql.Package, id string) (*graphql.PackageInstanceAuth, error) {
 	if obj == nil {
 		return nil, apperrors.NewInternalError("Package cannot be empty")
 	}
 
+	ctx, err := ownerContext(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -274,6 +397,16 @@ func (r *Resolver) InstanceAuths(ctx context.Context, obj *graphql.Package) ([]*
 		return nil, apperrors.NewInternalError("Package cannot be empty")
 	}
 
+	// Resources of shared Applications are read in the owner tenant, which cannot be batched with the resources of the tenant
+	if loaders := dataloader.LoadFromContext(ctx); loaders != nil && obj.OwnerTenant == "" {
+		return loaders.InstanceAuthsByPackageID.Load(dataloader.ParamID{ID: obj.ID, Ctx: ctx})
+	}
+
+	ctx, err := ownerContext(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -295,6 +428,11 @@ func (r *Resolver) InstanceAuths(ctx context.Context, obj *graphql.Package) ([]*
 }
 
 func (r *Resolver) APIDefinition(ctx context.Context, obj *graphql.Package, id string) (*graphql.APIDefinition, error) {
+	ctx, err := ownerContext(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -320,6 +458,16 @@ func (r *Resolver) APIDefinition(ctx context.Context, obj *graphql.Package, id s
 }
 
 func (r *Resolver) APIDefinitions(ctx context.Context, obj *graphql.Package, group *string, first *int, after *graphql.PageCursor) (*graphql.APIDefinitionPage, error) {
+	// Resources of shared Applications are read in the owner tenant, which cannot be batched with the resources of the tenant
+	if loaders := dataloader.LoadFromContext(ctx); loaders != nil && obj.OwnerTenant == "" {
+		return loaders.APIDefinitionsByPackageID.Load(dataloader.ParamPage{ID: obj.ID, Ctx: ctx, First: first, After: after})
+	}
+
+	ctx, err := ownerContext(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -361,6 +509,11 @@ func (r *Resolver) APIDefinitions(ctx context.Context, obj *graphql.Package, gro
 }
 
 func (r *Resolver) EventDefinition(ctx context.Context, obj *graphql.Package, id string) (*graphql.EventDefinition, error) {
+	ctx, err := ownerContext(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -386,6 +539,11 @@ func (r *Resolver) EventDefinition(ctx context.Context, obj *graphql.Package, id
 }
 
 func (r *Resolver) EventDefinitions(ctx context.Context, obj *graphql.Package, group *string, first *int, after *graphql.PageCursor) (*graphql.EventDefinitionPage, error) {
+	ctx, err := ownerContext(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -426,6 +584,11 @@ func (r *Resolver) EventDefinitions(ctx context.Context, obj *graphql.Package, g
 }
 
 func (r *Resolver) Document(ctx context.Context, obj *graphql.Package, id string) (*graphql.Document, error) {
+	ctx, err := ownerContext(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -451,6 +614,11 @@ func (r *Resolver) Document(ctx context.Context, obj *graphql.Package, id string
 }
 
 func (r *Resolver) Documents(ctx context.Context, obj *graphql.Package, first *int, after *graphql.PageCursor) (*graphql.DocumentPage, error) {
+	ctx, err := ownerContext(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -490,3 +658,111 @@ func (r *Resolver) Documents(ctx context.Context, obj *graphql.Package, first *i
 		},
 	}, nil
 }
+
+// InstanceAuthsDataLoader fetches Package Instance Auths of multiple Packages in a single transaction
+func (r *Resolver) InstanceAuthsDataLoader(keys []dataloader.ParamID) ([][]*graphql.PackageInstanceAuth, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Packages found")}
+	}
+
+	ctx := keys[0].Ctx
+	packageIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		packageIDs = append(packageIDs, key.ID)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	authsByPkgID, err := r.packageInstanceAuthSvc.ListByPackageIDs(ctx, packageIDs)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	gqlAuths := make([][]*graphql.PackageInstanceAuth, 0, len(keys))
+	for _, key := range keys {
+		auths, err := r.packageInstanceAuthConverter.MultipleToGraphQL(authsByPkgID[key.ID])
+		if err != nil {
+			return nil, []error{err}
+		}
+
+		gqlAuths = append(gqlAuths, auths)
+	}
+
+	return gqlAuths, nil
+}
+
+// APIDefinitionsDataLoader fetches a page of API Definitions for multiple Packages in a single transaction
+func (r *Resolver) APIDefinitionsDataLoader(keys []dataloader.ParamPage) ([]*graphql.APIDefinitionPage, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Packages found")}
+	}
+
+	ctx := keys[0].Ctx
+	first := keys[0].First
+	if first == nil {
+		return nil, []error{apperrors.NewInvalidDataError("missing required parameter 'first'")}
+	}
+
+	var cursor string
+	if keys[0].After != nil {
+		cursor = string(*keys[0].After)
+	}
+
+	packageIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		packageIDs = append(packageIDs, key.ID)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	apiPages, err := r.apiSvc.ListForPackageIDs(ctx, packageIDs, *first, cursor)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	gqlAPIPages := make([]*graphql.APIDefinitionPage, 0, len(apiPages))
+	for _, page := range apiPages {
+		gqlAPIPages = append(gqlAPIPages, &graphql.APIDefinitionPage{
+			Data:       r.apiConverter.MultipleToGraphQL(page.Data),
+			TotalCount: page.TotalCount,
+			PageInfo: &graphql.PageInfo{
+				StartCursor: graphql.PageCursor(page.PageInfo.StartCursor),
+				EndCursor:   graphql.PageCursor(page.PageInfo.EndCursor),
+				HasNextPage: page.PageInfo.HasNextPage,
+			},
+		})
+	}
+
+	return gqlAPIPages, nil
+}
+
+// ownerContext switches the tenant in the context to the owner of the Package, if the Package belongs to an Application shared with the tenant
+func ownerContext(ctx context.Context, pkg *graphql.Package) (context.Context, error) {
+	if pkg == nil || pkg.OwnerTenant == "" {
+		return ctx, nil
+	}
+
+	return tenant.SaveOwnerToContext(ctx, pkg.OwnerTenant)
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 
 	mp_package "github.com/kyma-incubator/compass/components/director/internal/domain/package"
@@ -18,6 +20,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -1198,6 +1201,281 @@ func TestResolver_DeletePackage(t *testing.T) {
 	}
 }
 
+func TestResolver_SetPackageLabel(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	packageID := "foo"
+	gqlLabel := &graphql.Label{
+		Key:   "pii",
+		Value: true,
+	}
+	labelInput := &model.LabelInput{
+		Key:        gqlLabel.Key,
+		Value:      gqlLabel.Value,
+		ObjectType: model.PackageLabelableObject,
+		ObjectID:   packageID,
+	}
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.PackageService
+		ExpectedLabel   *graphql.Label
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("SetLabel", txtest.CtxWithDBMatcher(), labelInput).Return(nil).Once()
+				return svc
+			},
+			ExpectedLabel: gqlLabel,
+		},
+		{
+			Name:            "Returns error when setting label failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("SetLabel", txtest.CtxWithDBMatcher(), labelInput).Return(testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when commit transaction fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("SetLabel", txtest.CtxWithDBMatcher(), labelInput).Return(nil).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+
+			resolver := mp_package.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			result, err := resolver.SetPackageLabel(context.TODO(), packageID, gqlLabel.Key, gqlLabel.Value)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedLabel, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, svc, transact, persist)
+		})
+	}
+
+	t.Run("Returns error when label input is invalid", func(t *testing.T) {
+		resolver := mp_package.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := resolver.SetPackageLabel(context.TODO(), packageID, "", gqlLabel.Value)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "validation error for type LabelInput")
+	})
+}
+
+func TestResolver_DeletePackageLabel(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	packageID := "foo"
+	key := "pii"
+	modelLabel := &model.Label{
+		Key:        key,
+		Value:      true,
+		ObjectType: model.PackageLabelableObject,
+		ObjectID:   packageID,
+	}
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.PackageService
+		ExpectedLabel   *graphql.Label
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("GetLabel", txtest.CtxWithDBMatcher(), packageID, key).Return(modelLabel, nil).Once()
+				svc.On("DeleteLabel", txtest.CtxWithDBMatcher(), packageID, key).Return(nil).Once()
+				return svc
+			},
+			ExpectedLabel: &graphql.Label{Key: key, Value: true},
+		},
+		{
+			Name:            "Returns error when label retrieval failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("GetLabel", txtest.CtxWithDBMatcher(), packageID, key).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when label deletion failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("GetLabel", txtest.CtxWithDBMatcher(), packageID, key).Return(modelLabel, nil).Once()
+				svc.On("DeleteLabel", txtest.CtxWithDBMatcher(), packageID, key).Return(testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+
+			resolver := mp_package.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			result, err := resolver.DeletePackageLabel(context.TODO(), packageID, key)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedLabel, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, svc, transact, persist)
+		})
+	}
+}
+
+func TestResolver_Labels(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	gqlPackage := fixGQLPackage("foo", "name", "desc")
+	labels := map[string]*model.Label{
+		"pii":    {Key: "pii", Value: true, ObjectType: model.PackageLabelableObject, ObjectID: gqlPackage.ID},
+		"region": {Key: "region", Value: "x", ObjectType: model.PackageLabelableObject, ObjectID: gqlPackage.ID},
+	}
+	key := "pii"
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.PackageService
+		Key             *string
+		ExpectedLabels  *graphql.Labels
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListLabels", txtest.CtxWithDBMatcher(), gqlPackage.ID).Return(labels, nil).Once()
+				return svc
+			},
+			ExpectedLabels: &graphql.Labels{"pii": true, "region": "x"},
+		},
+		{
+			Name:            "Success when filtered by key",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListLabels", txtest.CtxWithDBMatcher(), gqlPackage.ID).Return(labels, nil).Once()
+				return svc
+			},
+			Key:            &key,
+			ExpectedLabels: &graphql.Labels{"pii": true},
+		},
+		{
+			Name:            "Returns nil when Package does not exist",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListLabels", txtest.CtxWithDBMatcher(), gqlPackage.ID).Return(nil, apperrors.NewNotFoundError(resource.Package, gqlPackage.ID)).Once()
+				return svc
+			},
+		},
+		{
+			Name:            "Returns error when labels listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PackageService {
+				svc := &automock.PackageService{}
+				svc.On("ListLabels", txtest.CtxWithDBMatcher(), gqlPackage.ID).Return(nil, testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+
+			resolver := mp_package.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			result, err := resolver.Labels(context.TODO(), gqlPackage, testCase.Key)
+
+			// then
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedLabels, result)
+
+			mock.AssertExpectationsForObjects(t, svc, transact, persist)
+		})
+	}
+
+	t.Run("Returns error when Package is nil", func(t *testing.T) {
+		resolver := mp_package.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := resolver.Labels(context.TODO(), nil, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Package cannot be empty")
+	})
+}
+
 func TestResolver_InstanceAuth(t *testing.T) {
 	// given
 	id := "foo"
@@ -1449,6 +1727,33 @@ func TestResolver_InstanceAuths(t *testing.T) {
 		})
 	}
 
+	t.Run("Lists instance auths of shared package in owner tenant", func(t *testing.T) {
+		// given
+		persist, transact := txGen.ThatSucceeds()
+		sharedPkg := fixGQLPackage(packageID, "foo", "bar")
+		sharedPkg.OwnerTenant = tenantID
+		ctx := tenant.SaveToContext(context.TODO(), "target-tenant", "external-target-tenant")
+		ctx = dataloader.SaveToContext(ctx, &dataloader.Loaders{})
+		ownerCtxMatcher := mock.MatchedBy(func(ctx context.Context) bool {
+			tnt, err := tenant.LoadFromContext(ctx)
+			return err == nil && tnt == tenantID
+		})
+
+		svc := &automock.PackageInstanceAuthService{}
+		svc.On("List", ownerCtxMatcher, packageID).Return(modelPackageInstanceAuths, nil).Once()
+		converter := &automock.PackageInstanceAuthConverter{}
+		converter.On("MultipleToGraphQL", modelPackageInstanceAuths).Return(gqlPackageInstanceAuths, nil).Once()
+
+		resolver := mp_package.NewResolver(transact, nil, svc, nil, nil, nil, nil, converter, nil, nil, nil)
+		// when
+		result, err := resolver.InstanceAuths(ctx, sharedPkg)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlPackageInstanceAuths, result)
+		mock.AssertExpectationsForObjects(t, persist, transact, svc, converter)
+	})
+
 	t.Run("Returns error when Package is nil", func(t *testing.T) {
 		resolver := mp_package.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		//when
@@ -1458,3 +1763,243 @@ func TestResolver_InstanceAuths(t *testing.T) {
 		assert.EqualError(t, err, apperrors.NewInternalError("Package cannot be empty").Error())
 	})
 }
+
+func TestResolver_APIDefinitionsDataLoader(t *testing.T) {
+	// given
+	testErr := errors.New("test error")
+
+	firstPkgID := "1"
+	secondPkgID := "2"
+	group := "group"
+	firstModelAPIs := []*model.APIDefinition{fixModelAPIDefinition("foo", firstPkgID, "Foo", "Lorem Ipsum", group)}
+	secondModelAPIs := []*model.APIDefinition{fixModelAPIDefinition("bar", secondPkgID, "Bar", "Lorem Ipsum", group)}
+	firstGQLAPIs := []*graphql.APIDefinition{fixGQLAPIDefinition("foo", firstPkgID, "Foo", "Lorem Ipsum", group)}
+	secondGQLAPIs := []*graphql.APIDefinition{fixGQLAPIDefinition("bar", secondPkgID, "Bar", "Lorem Ipsum", group)}
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	first := 2
+	gqlAfter := graphql.PageCursor("test")
+	after := "test"
+	keys := []dataloader.ParamPage{
+		{ID: firstPkgID, Ctx: context.TODO(), First: &first, After: &gqlAfter},
+		{ID: secondPkgID, Ctx: context.TODO(), First: &first, After: &gqlAfter},
+	}
+	packageIDs := []string{firstPkgID, secondPkgID}
+	modelPages := []*model.APIDefinitionPage{fixAPIDefinitionPage(firstModelAPIs), fixAPIDefinitionPage(secondModelAPIs)}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.APIService
+		ConverterFn     func() *automock.APIConverter
+		ExpectedResult  []*graphql.APIDefinitionPage
+		ExpectedErr     []error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("ListForPackageIDs", txtest.CtxWithDBMatcher(), packageIDs, first, after).Return(modelPages, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.APIConverter {
+				conv := &automock.APIConverter{}
+				conv.On("MultipleToGraphQL", firstModelAPIs).Return(firstGQLAPIs).Once()
+				conv.On("MultipleToGraphQL", secondModelAPIs).Return(secondGQLAPIs).Once()
+				return conv
+			},
+			ExpectedResult: []*graphql.APIDefinitionPage{fixGQLAPIDefinitionPage(firstGQLAPIs), fixGQLAPIDefinitionPage(secondGQLAPIs)},
+			ExpectedErr:    nil,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.APIService {
+				return &automock.APIService{}
+			},
+			ConverterFn: func() *automock.APIConverter {
+				return &automock.APIConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    []error{testErr},
+		},
+		{
+			Name:            "Returns error when APIS listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("ListForPackageIDs", txtest.CtxWithDBMatcher(), packageIDs, first, after).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.APIConverter {
+				return &automock.APIConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    []error{testErr},
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.APIService {
+				svc := &automock.APIService{}
+				svc.On("ListForPackageIDs", txtest.CtxWithDBMatcher(), packageIDs, first, after).Return(modelPages, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.APIConverter {
+				return &automock.APIConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    []error{testErr},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := mp_package.NewResolver(transact, nil, nil, svc, nil, nil, nil, nil, converter, nil, nil)
+			// when
+			result, err := resolver.APIDefinitionsDataLoader(keys)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			assert.Equal(t, testCase.ExpectedErr, err)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error when first is missing", func(t *testing.T) {
+		resolver := mp_package.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		//when
+		_, err := resolver.APIDefinitionsDataLoader([]dataloader.ParamPage{{ID: firstPkgID, Ctx: context.TODO()}})
+		//then
+		require.Len(t, err, 1)
+		assert.EqualError(t, err[0], apperrors.NewInvalidDataError("missing required parameter 'first'").Error())
+	})
+}
+
+func TestResolver_InstanceAuthsDataLoader(t *testing.T) {
+	// given
+	testErr := errors.New("test error")
+
+	firstPkgID := "1"
+	secondPkgID := "2"
+	keys := []dataloader.ParamID{{ID: firstPkgID, Ctx: context.TODO()}, {ID: secondPkgID, Ctx: context.TODO()}}
+	packageIDs := []string{firstPkgID, secondPkgID}
+
+	modelPackageInstanceAuths := []*model.PackageInstanceAuth{
+		fixModelPackageInstanceAuth("foo"),
+		fixModelPackageInstanceAuth("bar"),
+	}
+
+	gqlPackageInstanceAuths := []*graphql.PackageInstanceAuth{
+		fixGQLPackageInstanceAuth("foo"),
+		fixGQLPackageInstanceAuth("bar"),
+	}
+
+	modelAuthsByPkgID := map[string][]*model.PackageInstanceAuth{firstPkgID: modelPackageInstanceAuths}
+
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.PackageInstanceAuthService
+		ConverterFn     func() *automock.PackageInstanceAuthConverter
+		ExpectedResult  [][]*graphql.PackageInstanceAuth
+		ExpectedErr     []error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PackageInstanceAuthService {
+				svc := &automock.PackageInstanceAuthService{}
+				svc.On("ListByPackageIDs", txtest.CtxWithDBMatcher(), packageIDs).Return(modelAuthsByPkgID, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageInstanceAuthConverter {
+				conv := &automock.PackageInstanceAuthConverter{}
+				conv.On("MultipleToGraphQL", modelPackageInstanceAuths).Return(gqlPackageInstanceAuths, nil).Once()
+				conv.On("MultipleToGraphQL", []*model.PackageInstanceAuth(nil)).Return(nil, nil).Once()
+				return conv
+			},
+			ExpectedResult: [][]*graphql.PackageInstanceAuth{gqlPackageInstanceAuths, nil},
+			ExpectedErr:    nil,
+		},
+		{
+			Name:            "Returns error when Package Instance Auths listing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.PackageInstanceAuthService {
+				svc := &automock.PackageInstanceAuthService{}
+				svc.On("ListByPackageIDs", txtest.CtxWithDBMatcher(), packageIDs).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageInstanceAuthConverter {
+				return &automock.PackageInstanceAuthConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    []error{testErr},
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.PackageInstanceAuthService {
+				svc := &automock.PackageInstanceAuthService{}
+				svc.On("ListByPackageIDs", txtest.CtxWithDBMatcher(), packageIDs).Return(modelAuthsByPkgID, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageInstanceAuthConverter {
+				return &automock.PackageInstanceAuthConverter{}
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    []error{testErr},
+		},
+		{
+			Name:            "Returns error when converting to GraphQL failed",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.PackageInstanceAuthService {
+				svc := &automock.PackageInstanceAuthService{}
+				svc.On("ListByPackageIDs", txtest.CtxWithDBMatcher(), packageIDs).Return(modelAuthsByPkgID, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.PackageInstanceAuthConverter {
+				conv := &automock.PackageInstanceAuthConverter{}
+				conv.On("MultipleToGraphQL", modelPackageInstanceAuths).Return(nil, testErr).Once()
+				return conv
+			},
+			ExpectedResult: nil,
+			ExpectedErr:    []error{testErr},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			converter := testCase.ConverterFn()
+
+			resolver := mp_package.NewResolver(transact, nil, svc, nil, nil, nil, nil, converter, nil, nil, nil)
+			// when
+			result, err := resolver.InstanceAuthsDataLoader(keys)
+
+			// then
+			assert.Equal(t, testCase.ExpectedResult, result)
+			assert.Equal(t, testCase.ExpectedErr, err)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			converter.AssertExpectations(t)
+		})
+	}
+}
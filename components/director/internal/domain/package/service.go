@@ -10,6 +10,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/pkg/errors"
 )
 
@@ -23,6 +24,7 @@ type PackageRepository interface {
 	GetForApplication(ctx context.Context, tenant string, id string, applicationID string) (*model.Package, error)
 	GetByInstanceAuthID(ctx context.Context, tenant string, instanceAuthID string) (*model.Package, error)
 	ListByApplicationID(ctx context.Context, tenantID, applicationID string, pageSize int, cursor string) (*model.PackagePage, error)
+	ListByApplicationIDs(ctx context.Context, tenantID string, applicationIDs []string, pageSize int, cursor string) ([]*model.PackagePage, error)
 }
 
 //go:generate mockery -name=APIRepository -output=automock -outpkg=automock -case=underscore
@@ -46,6 +48,18 @@ type FetchRequestRepository interface {
 	Create(ctx context.Context, item *model.FetchRequest) error
 }
 
+//go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
+type LabelRepository interface {
+	GetByKey(ctx context.Context, tenant string, objectType model.LabelableObject, objectID, key string) (*model.Label, error)
+	ListForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) (map[string]*model.Label, error)
+	Delete(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, key string) error
+}
+
+//go:generate mockery -name=LabelUpsertService -output=automock -outpkg=automock -case=underscore
+type LabelUpsertService interface {
+	UpsertLabel(ctx context.Context, tenant string, labelInput *model.LabelInput) error
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
@@ -62,19 +76,23 @@ type service struct {
 	eventAPIRepo     EventAPIRepository
 	documentRepo     DocumentRepository
 	fetchRequestRepo FetchRequestRepository
+	labelRepo        LabelRepository
 
+	labelUpsertService  LabelUpsertService
 	uidService          UIDService
 	fetchRequestService FetchRequestService
 	timestampGen        timestamp.Generator
 }
 
-func NewService(pkgRepo PackageRepository, apiRepo APIRepository, eventAPIRepo EventAPIRepository, documentRepo DocumentRepository, fetchRequestRepo FetchRequestRepository, uidService UIDService, fetchRequestService FetchRequestService) *service {
+func NewService(pkgRepo PackageRepository, apiRepo APIRepository, eventAPIRepo EventAPIRepository, documentRepo DocumentRepository, fetchRequestRepo FetchRequestRepository, labelRepo LabelRepository, labelUpsertService LabelUpsertService, uidService UIDService, fetchRequestService FetchRequestService) *service {
 	return &service{
 		pkgRepo:             pkgRepo,
 		apiRepo:             apiRepo,
 		eventAPIRepo:        eventAPIRepo,
 		documentRepo:        documentRepo,
 		fetchRequestRepo:    fetchRequestRepo,
+		labelRepo:           labelRepo,
+		labelUpsertService:  labelUpsertService,
 		uidService:          uidService,
 		fetchRequestService: fetchRequestService,
 		timestampGen:        timestamp.DefaultGenerator(),
@@ -227,6 +245,102 @@ func (s *service) ListByApplicationID(ctx context.Context, applicationID string,
 	return s.pkgRepo.ListByApplicationID(ctx, tnt, applicationID, pageSize, cursor)
 }
 
+func (s *service) ListByApplicationIDs(ctx context.Context, applicationIDs []string, pageSize int, cursor string) ([]*model.PackagePage, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if pageSize < 1 || pageSize > 100 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 100")
+	}
+
+	return s.pkgRepo.ListByApplicationIDs(ctx, tnt, applicationIDs, pageSize, cursor)
+}
+
+func (s *service) SetLabel(ctx context.Context, labelInput *model.LabelInput) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if err := s.ensurePackageExists(ctx, tnt, labelInput.ObjectID); err != nil {
+		return err
+	}
+
+	err = s.labelUpsertService.UpsertLabel(ctx, tnt, labelInput)
+	if err != nil {
+		return errors.Wrapf(err, "while creating label for Package")
+	}
+
+	return nil
+}
+
+func (s *service) GetLabel(ctx context.Context, packageID string, key string) (*model.Label, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if err := s.ensurePackageExists(ctx, tnt, packageID); err != nil {
+		return nil, err
+	}
+
+	label, err := s.labelRepo.GetByKey(ctx, tnt, model.PackageLabelableObject, packageID, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting label for Package")
+	}
+
+	return label, nil
+}
+
+func (s *service) ListLabels(ctx context.Context, packageID string) (map[string]*model.Label, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if err := s.ensurePackageExists(ctx, tnt, packageID); err != nil {
+		return nil, err
+	}
+
+	labels, err := s.labelRepo.ListForObject(ctx, tnt, model.PackageLabelableObject, packageID)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting labels for Package")
+	}
+
+	return labels, nil
+}
+
+func (s *service) DeleteLabel(ctx context.Context, packageID string, key string) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if err := s.ensurePackageExists(ctx, tnt, packageID); err != nil {
+		return err
+	}
+
+	err = s.labelRepo.Delete(ctx, tnt, model.PackageLabelableObject, packageID, key)
+	if err != nil {
+		return errors.Wrapf(err, "while deleting Package label")
+	}
+
+	return nil
+}
+
+func (s *service) ensurePackageExists(ctx context.Context, tnt, packageID string) error {
+	exists, err := s.pkgRepo.Exists(ctx, tnt, packageID)
+	if err != nil {
+		return errors.Wrap(err, "while checking Package existence")
+	}
+	if !exists {
+		return apperrors.NewNotFoundError(resource.Package, packageID)
+	}
+	return nil
+}
+
 func (s *service) createRelatedResources(ctx context.Context, in model.PackageCreateInput, tenant string, packageID string) error {
 	err := s.createAPIs(ctx, packageID, tenant, in.APIDefinitions)
 	if err != nil {
@@ -426,7 +426,7 @@ func TestService_Create(t *testing.T) {
 			documentRepo := testCase.DocumentRepoFn()
 			frRepo := testCase.FetchRequestRepoFn()
 			frSvc := testCase.FetchRequestServiceFn()
-			svc := mp_package.NewService(repo, apiRepo, eventRepo, documentRepo, frRepo, uidService, frSvc)
+			svc := mp_package.NewService(repo, apiRepo, eventRepo, documentRepo, frRepo, nil, nil, uidService, frSvc)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// when
@@ -444,7 +444,7 @@ func TestService_Create(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil)
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.Create(context.TODO(), "", model.PackageCreateInput{})
 		// THEN
@@ -534,7 +534,7 @@ func TestService_Update(t *testing.T) {
 			// given
 			repo := testCase.RepositoryFn()
 
-			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil)
+			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			err := svc.Update(ctx, testCase.InputID, testCase.Input)
@@ -551,7 +551,7 @@ func TestService_Update(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil)
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		err := svc.Update(context.TODO(), "", model.PackageUpdateInput{})
 		// THEN
@@ -603,7 +603,7 @@ func TestService_Delete(t *testing.T) {
 			// given
 			repo := testCase.RepositoryFn()
 
-			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil)
+			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -620,7 +620,7 @@ func TestService_Delete(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil)
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		err := svc.Delete(context.TODO(), "")
 		// THEN
@@ -665,7 +665,7 @@ func TestService_Exist(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			pkgRepo := testCase.RepoFn()
-			svc := mp_package.NewService(pkgRepo, nil, nil, nil, nil, nil, nil)
+			svc := mp_package.NewService(pkgRepo, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// WHEN
 			result, err := svc.Exist(ctx, id)
@@ -684,7 +684,7 @@ func TestService_Exist(t *testing.T) {
 	}
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil)
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.Exist(context.TODO(), "")
 		// THEN
@@ -741,7 +741,7 @@ func TestService_Get(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil)
+			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			pkg, err := svc.Get(ctx, testCase.InputID)
@@ -759,7 +759,7 @@ func TestService_Get(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil)
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.Get(context.TODO(), "")
 		// THEN
@@ -820,7 +820,7 @@ func TestService_GetForApplication(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil)
+			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			document, err := svc.GetForApplication(ctx, testCase.InputID, testCase.ApplicationID)
@@ -838,7 +838,7 @@ func TestService_GetForApplication(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil)
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.GetForApplication(context.TODO(), "", "")
 		// THEN
@@ -895,7 +895,7 @@ func TestService_GetByInstanceAuthID(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil)
+			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			document, err := svc.GetByInstanceAuthID(ctx, testCase.InstanceAuthID)
@@ -913,7 +913,7 @@ func TestService_GetByInstanceAuthID(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil)
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.GetForApplication(context.TODO(), "", "")
 		// THEN
@@ -1005,7 +1005,7 @@ func TestService_ListByApplicationID(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil)
+			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			docs, err := svc.ListByApplicationID(ctx, applicationID, testCase.PageSize, after)
@@ -1023,7 +1023,7 @@ func TestService_ListByApplicationID(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil)
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.ListByApplicationID(context.TODO(), "", 5, "")
 		// THEN
@@ -1031,3 +1031,377 @@ func TestService_ListByApplicationID(t *testing.T) {
 		assert.Contains(t, err.Error(), "cannot read tenant from context")
 	})
 }
+
+func TestService_ListByApplicationIDs(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	applicationIDs := []string{"foo", "bar"}
+	name := "foo"
+	desc := "bar"
+
+	packages := []*model.Package{
+		fixPackageModel(t, name, desc),
+		fixPackageModel(t, name, desc),
+		fixPackageModel(t, name, desc),
+	}
+	packagePages := []*model.PackagePage{{
+		Data:       packages,
+		TotalCount: len(packages),
+		PageInfo: &pagination.Page{
+			HasNextPage: false,
+			EndCursor:   "end",
+			StartCursor: "start",
+		},
+	}}
+
+	after := "test"
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		PageSize           int
+		RepositoryFn       func() *automock.PackageRepository
+		ExpectedResult     []*model.PackagePage
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("ListByApplicationIDs", ctx, tenantID, applicationIDs, 2, after).Return(packagePages, nil).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     packagePages,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Return error when page size is less than 1",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				return repo
+			},
+			PageSize:           0,
+			ExpectedResult:     packagePages,
+			ExpectedErrMessage: "page size must be between 1 and 100",
+		},
+		{
+			Name: "Return error when page size is bigger than 100",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				return repo
+			},
+			PageSize:           101,
+			ExpectedResult:     packagePages,
+			ExpectedErrMessage: "page size must be between 1 and 100",
+		},
+		{
+			Name: "Returns error when Package listing failed",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("ListByApplicationIDs", ctx, tenantID, applicationIDs, 2, after).Return(nil, testErr).Once()
+				return repo
+			},
+			PageSize:           2,
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			pkgPages, err := svc.ListByApplicationIDs(ctx, applicationIDs, testCase.PageSize, after)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, pkgPages)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		// WHEN
+		_, err := svc.ListByApplicationIDs(context.TODO(), nil, 5, "")
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_SetLabel(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	packageID := "foo"
+	labelInput := &model.LabelInput{
+		Key:        "pii",
+		Value:      true,
+		ObjectType: model.PackageLabelableObject,
+		ObjectID:   packageID,
+	}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name                 string
+		RepositoryFn         func() *automock.PackageRepository
+		LabelUpsertServiceFn func() *automock.LabelUpsertService
+		ExpectedErrMessage   string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("Exists", ctx, tenantID, packageID).Return(true, nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tenantID, labelInput).Return(nil).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Returns error when Package does not exist",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("Exists", ctx, tenantID, packageID).Return(false, nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			ExpectedErrMessage: "Object not found",
+		},
+		{
+			Name: "Returns error when label upsert failed",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("Exists", ctx, tenantID, packageID).Return(true, nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				svc.On("UpsertLabel", ctx, tenantID, labelInput).Return(testErr).Once()
+				return svc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			labelUpsertSvc := testCase.LabelUpsertServiceFn()
+
+			svc := mp_package.NewService(repo, nil, nil, nil, nil, nil, labelUpsertSvc, nil, nil)
+
+			// when
+			err := svc.SetLabel(ctx, labelInput)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, labelUpsertSvc)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := mp_package.NewService(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		// WHEN
+		err := svc.SetLabel(context.TODO(), labelInput)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_ListLabels(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	packageID := "foo"
+	labels := map[string]*model.Label{
+		"pii": {
+			Tenant:     tenantID,
+			Key:        "pii",
+			Value:      true,
+			ObjectID:   packageID,
+			ObjectType: model.PackageLabelableObject,
+		},
+	}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.PackageRepository
+		LabelRepositoryFn  func() *automock.LabelRepository
+		ExpectedResult     map[string]*model.Label
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("Exists", ctx, tenantID, packageID).Return(true, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tenantID, model.PackageLabelableObject, packageID).Return(labels, nil).Once()
+				return repo
+			},
+			ExpectedResult: labels,
+		},
+		{
+			Name: "Returns error when Package existence check failed",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("Exists", ctx, tenantID, packageID).Return(false, testErr).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when labels listing failed",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("Exists", ctx, tenantID, packageID).Return(true, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tenantID, model.PackageLabelableObject, packageID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			labelRepo := testCase.LabelRepositoryFn()
+
+			svc := mp_package.NewService(repo, nil, nil, nil, nil, labelRepo, nil, nil, nil)
+
+			// when
+			result, err := svc.ListLabels(ctx, packageID)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, labelRepo)
+		})
+	}
+}
+
+func TestService_DeleteLabel(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	packageID := "foo"
+	key := "pii"
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.PackageRepository
+		LabelRepositoryFn  func() *automock.LabelRepository
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("Exists", ctx, tenantID, packageID).Return(true, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("Delete", ctx, tenantID, model.PackageLabelableObject, packageID, key).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Returns error when Package does not exist",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("Exists", ctx, tenantID, packageID).Return(false, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				return &automock.LabelRepository{}
+			},
+			ExpectedErrMessage: "Object not found",
+		},
+		{
+			Name: "Returns error when label deletion failed",
+			RepositoryFn: func() *automock.PackageRepository {
+				repo := &automock.PackageRepository{}
+				repo.On("Exists", ctx, tenantID, packageID).Return(true, nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("Delete", ctx, tenantID, model.PackageLabelableObject, packageID, key).Return(testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			labelRepo := testCase.LabelRepositoryFn()
+
+			svc := mp_package.NewService(repo, nil, nil, nil, nil, labelRepo, nil, nil, nil)
+
+			// when
+			err := svc.DeleteLabel(ctx, packageID, key)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, labelRepo)
+		})
+	}
+}
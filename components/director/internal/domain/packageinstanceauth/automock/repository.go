@@ -111,6 +111,29 @@ func (_m *Repository) ListByPackageID(ctx context.Context, tenantID string, pack
 	return r0, r1
 }
 
+// ListByPackageIDs provides a mock function with given fields: ctx, tenantID, packageIDs
+func (_m *Repository) ListByPackageIDs(ctx context.Context, tenantID string, packageIDs []string) ([]*model.PackageInstanceAuth, error) {
+	ret := _m.Called(ctx, tenantID, packageIDs)
+
+	var r0 []*model.PackageInstanceAuth
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) []*model.PackageInstanceAuth); ok {
+		r0 = rf(ctx, tenantID, packageIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PackageInstanceAuth)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, tenantID, packageIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, item
 func (_m *Repository) Update(ctx context.Context, item *model.PackageInstanceAuth) error {
 	ret := _m.Called(ctx, item)
@@ -0,0 +1,60 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ShareService is an autogenerated mock type for the ShareService type
+type ShareService struct {
+	mock.Mock
+}
+
+// OwnerContextForPackage provides a mock function with given fields: ctx, packageID
+func (_m *ShareService) OwnerContextForPackage(ctx context.Context, packageID string) (context.Context, error) {
+	ret := _m.Called(ctx, packageID)
+
+	var r0 context.Context
+	if rf, ok := ret.Get(0).(func(context.Context, string) context.Context); ok {
+		r0 = rf(ctx, packageID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, packageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OwnerContextForPackageInstanceAuth provides a mock function with given fields: ctx, packageInstanceAuthID
+func (_m *ShareService) OwnerContextForPackageInstanceAuth(ctx context.Context, packageInstanceAuthID string) (context.Context, error) {
+	ret := _m.Called(ctx, packageInstanceAuthID)
+
+	var r0 context.Context
+	if rf, ok := ret.Get(0).(func(context.Context, string) context.Context); ok {
+		r0 = rf(ctx, packageInstanceAuthID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, packageInstanceAuthID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -38,11 +38,12 @@ func (c *converter) ToGraphQL(in *model.PackageInstanceAuth) (*graphql.PackageIn
 	}
 
 	return &graphql.PackageInstanceAuth{
-		ID:          in.ID,
-		Context:     c.strPtrToJSONPtr(in.Context),
-		InputParams: c.strPtrToJSONPtr(in.InputParams),
-		Auth:        auth,
-		Status:      c.statusToGraphQL(in.Status),
+		ID:               in.ID,
+		RuntimeContextID: in.RuntimeContextID,
+		Context:          c.strPtrToJSONPtr(in.Context),
+		InputParams:      c.strPtrToJSONPtr(in.InputParams),
+		Auth:             auth,
+		Status:           c.statusToGraphQL(in.Status),
 	}, nil
 }
 
@@ -92,11 +93,13 @@ func (c *converter) SetInputFromGraphQL(in graphql.PackageInstanceAuthSetInput)
 
 func (c *converter) ToEntity(in model.PackageInstanceAuth) (Entity, error) {
 	out := Entity{
-		ID:          in.ID,
-		PackageID:   in.PackageID,
-		TenantID:    in.Tenant,
-		Context:     repo.NewNullableString(in.Context),
-		InputParams: repo.NewNullableString(in.InputParams),
+		ID:                in.ID,
+		PackageID:         in.PackageID,
+		RuntimeContextID:  repo.NewNullableString(in.RuntimeContextID),
+		TenantID:          in.Tenant,
+		Context:           repo.NewNullableString(in.Context),
+		InputParams:       repo.NewNullableString(in.InputParams),
+		RequesterTenantID: repo.NewNullableString(in.RequesterTenant),
 	}
 	authValue, err := c.nullStringFromAuthPtr(in.Auth)
 	if err != nil {
@@ -121,18 +124,20 @@ func (c *converter) FromEntity(in Entity) (model.PackageInstanceAuth, error) {
 	}
 
 	return model.PackageInstanceAuth{
-		ID:          in.ID,
-		PackageID:   in.PackageID,
-		Tenant:      in.TenantID,
-		Context:     repo.StringPtrFromNullableString(in.Context),
-		InputParams: repo.StringPtrFromNullableString(in.InputParams),
-		Auth:        auth,
+		ID:               in.ID,
+		PackageID:        in.PackageID,
+		RuntimeContextID: repo.StringPtrFromNullableString(in.RuntimeContextID),
+		Tenant:           in.TenantID,
+		Context:          repo.StringPtrFromNullableString(in.Context),
+		InputParams:      repo.StringPtrFromNullableString(in.InputParams),
+		Auth:             auth,
 		Status: &model.PackageInstanceAuthStatus{
 			Condition: model.PackageInstanceAuthStatusCondition(in.StatusCondition),
 			Timestamp: in.StatusTimestamp,
 			Message:   in.StatusMessage,
 			Reason:    in.StatusReason,
 		},
+		RequesterTenant: repo.StringPtrFromNullableString(in.RequesterTenantID),
 	}, nil
 }
 
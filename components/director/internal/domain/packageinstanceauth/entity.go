@@ -6,16 +6,18 @@ import (
 )
 
 type Entity struct {
-	ID              string         `db:"id"`
-	PackageID       string         `db:"package_id"`
-	TenantID        string         `db:"tenant_id"`
-	Context         sql.NullString `db:"context"`
-	InputParams     sql.NullString `db:"input_params"`
-	AuthValue       sql.NullString `db:"auth_value"`
-	StatusCondition string         `db:"status_condition"`
-	StatusTimestamp time.Time      `db:"status_timestamp"`
-	StatusMessage   string         `db:"status_message"`
-	StatusReason    string         `db:"status_reason"`
+	ID                string         `db:"id"`
+	PackageID         string         `db:"package_id"`
+	RuntimeContextID  sql.NullString `db:"runtime_context_id"`
+	TenantID          string         `db:"tenant_id"`
+	Context           sql.NullString `db:"context"`
+	InputParams       sql.NullString `db:"input_params"`
+	AuthValue         sql.NullString `db:"auth_value"`
+	StatusCondition   string         `db:"status_condition"`
+	StatusTimestamp   time.Time      `db:"status_timestamp"`
+	StatusMessage     string         `db:"status_message"`
+	StatusReason      string         `db:"status_reason"`
+	RequesterTenantID sql.NullString `db:"requester_tenant_id"`
 }
 
 type Collection []Entity
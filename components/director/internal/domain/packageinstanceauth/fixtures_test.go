@@ -23,11 +23,12 @@ var (
 	testPackageID      = "bar"
 	testTenant         = "baz"
 	testExternalTenant = "foobaz"
+	testSharedTenant   = "qux"
 	testContext        = `{"foo": "bar"}`
 	testInputParams    = `{"bar": "baz"}`
 	testError          = errors.New("test")
 	testTime           = time.Now()
-	testTableColumns   = []string{"id", "tenant_id", "package_id", "context", "input_params", "auth_value", "status_condition", "status_timestamp", "status_message", "status_reason"}
+	testTableColumns   = []string{"id", "tenant_id", "package_id", "runtime_context_id", "context", "input_params", "auth_value", "status_condition", "status_timestamp", "status_message", "status_reason", "requester_tenant_id"}
 )
 
 func fixModelPackageInstanceAuth(id, packageID, tenant string, auth *model.Auth, status *model.PackageInstanceAuthStatus) *model.PackageInstanceAuth {
@@ -226,43 +227,47 @@ func fixGQLAuthInput() *graphql.AuthInput {
 }
 
 type sqlRow struct {
-	id              string
-	tenantID        string
-	packageID       string
-	context         sql.NullString
-	inputParams     sql.NullString
-	authValue       sql.NullString
-	statusCondition string
-	statusTimestamp time.Time
-	statusMessage   string
-	statusReason    string
+	id                string
+	tenantID          string
+	packageID         string
+	runtimeContextID  sql.NullString
+	context           sql.NullString
+	inputParams       sql.NullString
+	authValue         sql.NullString
+	statusCondition   string
+	statusTimestamp   time.Time
+	statusMessage     string
+	statusReason      string
+	requesterTenantID sql.NullString
 }
 
 func fixSQLRows(rows []sqlRow) *sqlmock.Rows {
 	out := sqlmock.NewRows(testTableColumns)
 	for _, row := range rows {
-		out.AddRow(row.id, row.tenantID, row.packageID, row.context, row.inputParams, row.authValue, row.statusCondition, row.statusTimestamp, row.statusMessage, row.statusReason)
+		out.AddRow(row.id, row.tenantID, row.packageID, row.runtimeContextID, row.context, row.inputParams, row.authValue, row.statusCondition, row.statusTimestamp, row.statusMessage, row.statusReason, row.requesterTenantID)
 	}
 	return out
 }
 
 func fixSQLRowFromEntity(entity packageinstanceauth.Entity) sqlRow {
 	return sqlRow{
-		id:              entity.ID,
-		tenantID:        entity.TenantID,
-		packageID:       entity.PackageID,
-		context:         entity.Context,
-		inputParams:     entity.InputParams,
-		authValue:       entity.AuthValue,
-		statusCondition: entity.StatusCondition,
-		statusTimestamp: entity.StatusTimestamp,
-		statusMessage:   entity.StatusMessage,
-		statusReason:    entity.StatusReason,
+		id:                entity.ID,
+		tenantID:          entity.TenantID,
+		packageID:         entity.PackageID,
+		runtimeContextID:  entity.RuntimeContextID,
+		context:           entity.Context,
+		inputParams:       entity.InputParams,
+		authValue:         entity.AuthValue,
+		statusCondition:   entity.StatusCondition,
+		statusTimestamp:   entity.StatusTimestamp,
+		statusMessage:     entity.StatusMessage,
+		statusReason:      entity.StatusReason,
+		requesterTenantID: entity.RequesterTenantID,
 	}
 }
 
 func fixCreateArgs(ent packageinstanceauth.Entity) []driver.Value {
-	return []driver.Value{ent.ID, ent.TenantID, ent.PackageID, ent.Context, ent.InputParams, ent.AuthValue, ent.StatusCondition, ent.StatusTimestamp, ent.StatusMessage, ent.StatusReason}
+	return []driver.Value{ent.ID, ent.TenantID, ent.PackageID, ent.RuntimeContextID, ent.Context, ent.InputParams, ent.AuthValue, ent.StatusCondition, ent.StatusTimestamp, ent.StatusMessage, ent.StatusReason, ent.RequesterTenantID}
 }
 
 func fixSimpleModelPackageInstanceAuth(id string) *model.PackageInstanceAuth {
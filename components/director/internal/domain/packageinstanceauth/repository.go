@@ -20,7 +20,7 @@ var (
 	tenantColumn     = "tenant_id"
 	idColumns        = []string{"id"}
 	updatableColumns = []string{"auth_value", "status_condition", "status_timestamp", "status_message", "status_reason"}
-	tableColumns     = []string{"id", "tenant_id", "package_id", "context", "input_params", "auth_value", "status_condition", "status_timestamp", "status_message", "status_reason"}
+	tableColumns     = []string{"id", "tenant_id", "package_id", "runtime_context_id", "context", "input_params", "auth_value", "status_condition", "status_timestamp", "status_message", "status_reason", "requester_tenant_id"}
 )
 
 //go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
@@ -117,6 +117,25 @@ func (r *repository) ListByPackageID(ctx context.Context, tenantID string, packa
 	return r.multipleFromEntities(entities)
 }
 
+func (r *repository) ListByPackageIDs(ctx context.Context, tenantID string, packageIDs []string) ([]*model.PackageInstanceAuth, error) {
+	if len(packageIDs) == 0 {
+		return nil, nil
+	}
+
+	var entities Collection
+
+	conditions := repo.Conditions{
+		repo.NewInConditionForStringValues("package_id", packageIDs),
+	}
+
+	err := r.lister.List(ctx, tenantID, &entities, conditions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
 func (r *repository) Update(ctx context.Context, item *model.PackageInstanceAuth) error {
 	if item == nil {
 		return apperrors.NewInternalError("item cannot be nil")
@@ -32,7 +32,7 @@ func TestRepository_Create(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.package_instance_auths ( id, tenant_id, package_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )`)).
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.package_instance_auths ( id, tenant_id, package_id, runtime_context_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, requester_tenant_id ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )`)).
 			WithArgs(fixCreateArgs(*piaEntity)...).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
@@ -274,7 +274,7 @@ func TestRepository_ListByPackageID(t *testing.T) {
 			fixEntityPackageInstanceAuth(t, "bar", testPackageID, testTenant, fixModelAuth(), fixModelStatusSucceeded()),
 		}
 
-		query := `SELECT id, tenant_id, package_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason FROM public.package_instance_auths WHERE tenant_id = $1 AND package_id = $2`
+		query := `SELECT id, tenant_id, package_id, runtime_context_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, requester_tenant_id FROM public.package_instance_auths WHERE tenant_id = $1 AND package_id = $2`
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(testTenant, testPackageID).
 			WillReturnRows(fixSQLRows([]sqlRow{
@@ -310,7 +310,7 @@ func TestRepository_ListByPackageID(t *testing.T) {
 			fixEntityPackageInstanceAuth(t, "bar", testPackageID, testTenant, fixModelAuth(), fixModelStatusSucceeded()),
 		}
 
-		query := `SELECT id, tenant_id, package_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason FROM public.package_instance_auths WHERE tenant_id = $1 AND package_id = $2`
+		query := `SELECT id, tenant_id, package_id, runtime_context_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, requester_tenant_id FROM public.package_instance_auths WHERE tenant_id = $1 AND package_id = $2`
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(testTenant, testPackageID).
 			WillReturnRows(fixSQLRows([]sqlRow{
@@ -337,7 +337,7 @@ func TestRepository_ListByPackageID(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		ctx := persistence.SaveToContext(context.TODO(), db)
 
-		query := `SELECT id, tenant_id, package_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason FROM public.package_instance_auths WHERE tenant_id = $1 AND package_id = $2`
+		query := `SELECT id, tenant_id, package_id, runtime_context_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, requester_tenant_id FROM public.package_instance_auths WHERE tenant_id = $1 AND package_id = $2`
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(testTenant, testPackageID).
 			WillReturnError(testError)
@@ -354,6 +354,66 @@ func TestRepository_ListByPackageID(t *testing.T) {
 	})
 }
 
+func TestRepository_ListByPackageIDs(t *testing.T) {
+	//GIVEN
+	secondPackageID := "bar"
+	query := `SELECT id, tenant_id, package_id, runtime_context_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, requester_tenant_id FROM public.package_instance_auths WHERE tenant_id = $1 AND package_id IN ($2, $3)`
+
+	t.Run("Success", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		piaModels := []*model.PackageInstanceAuth{
+			fixModelPackageInstanceAuth("foo", testPackageID, testTenant, fixModelAuth(), fixModelStatusSucceeded()),
+			fixModelPackageInstanceAuth("bar", secondPackageID, testTenant, fixModelAuth(), fixModelStatusSucceeded()),
+		}
+		piaEntities := []*packageinstanceauth.Entity{
+			fixEntityPackageInstanceAuth(t, "foo", testPackageID, testTenant, fixModelAuth(), fixModelStatusSucceeded()),
+			fixEntityPackageInstanceAuth(t, "bar", secondPackageID, testTenant, fixModelAuth(), fixModelStatusSucceeded()),
+		}
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(testTenant, testPackageID, secondPackageID).
+			WillReturnRows(fixSQLRows([]sqlRow{
+				fixSQLRowFromEntity(*piaEntities[0]),
+				fixSQLRowFromEntity(*piaEntities[1]),
+			}))
+
+		convMock := automock.EntityConverter{}
+		convMock.On("FromEntity", *piaEntities[0]).Return(*piaModels[0], nil).Once()
+		convMock.On("FromEntity", *piaEntities[1]).Return(*piaModels[1], nil).Once()
+		pgRepository := packageinstanceauth.NewRepository(&convMock)
+
+		//WHEN
+		result, err := pgRepository.ListByPackageIDs(ctx, testTenant, []string{testPackageID, secondPackageID})
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, piaModels, result)
+		dbMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("DB Error", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(testTenant, testPackageID, secondPackageID).
+			WillReturnError(testError)
+
+		pgRepository := packageinstanceauth.NewRepository(nil)
+
+		//WHEN
+		result, err := pgRepository.ListByPackageIDs(ctx, testTenant, []string{testPackageID, secondPackageID})
+
+		//THEN
+		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+		require.Nil(t, result)
+		dbMock.AssertExpectations(t)
+	})
+}
+
 func TestRepository_Update(t *testing.T) {
 	updateStmt := `UPDATE public\.package_instance_auths SET auth_value = \?, status_condition = \?, status_timestamp = \?, status_message = \?, status_reason = \? WHERE tenant_id = \? AND id = \?`
 
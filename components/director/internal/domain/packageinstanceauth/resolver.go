@@ -33,18 +33,26 @@ type PackageService interface {
 	GetByInstanceAuthID(ctx context.Context, instanceAuthID string) (*model.Package, error)
 }
 
+//go:generate mockery -name=ShareService -output=automock -outpkg=automock -case=underscore
+type ShareService interface {
+	OwnerContextForPackage(ctx context.Context, packageID string) (context.Context, error)
+	OwnerContextForPackageInstanceAuth(ctx context.Context, packageInstanceAuthID string) (context.Context, error)
+}
+
 type Resolver struct {
 	transact persistence.Transactioner
 	svc      Service
 	pkgSvc   PackageService
+	shareSvc ShareService
 	conv     Converter
 }
 
-func NewResolver(transact persistence.Transactioner, svc Service, pkgSvc PackageService, conv Converter) *Resolver {
+func NewResolver(transact persistence.Transactioner, svc Service, pkgSvc PackageService, shareSvc ShareService, conv Converter) *Resolver {
 	return &Resolver{
 		transact: transact,
 		svc:      svc,
 		pkgSvc:   pkgSvc,
+		shareSvc: shareSvc,
 		conv:     conv,
 	}
 }
@@ -128,6 +136,11 @@ func (r *Resolver) RequestPackageInstanceAuthCreation(ctx context.Context, packa
 
 	log.C(ctx).Infof("Requesting PackageInstanceAuth creation for Package with id %s", packageID)
 
+	ctx, err = r.shareSvc.OwnerContextForPackage(ctx, packageID)
+	if err != nil {
+		return nil, err
+	}
+
 	pkg, err := r.pkgSvc.Get(ctx, packageID)
 	if err != nil {
 		return nil, err
@@ -165,6 +178,11 @@ func (r *Resolver) RequestPackageInstanceAuthDeletion(ctx context.Context, authI
 
 	log.C(ctx).Infof("Requesting PackageInstanceAuth deletion for PackageInstanceAuth with id %s", authID)
 
+	ctx, err = r.shareSvc.OwnerContextForPackageInstanceAuth(ctx, authID)
+	if err != nil {
+		return nil, err
+	}
+
 	instanceAuth, err := r.svc.Get(ctx, authID)
 	if err != nil {
 		return nil, err
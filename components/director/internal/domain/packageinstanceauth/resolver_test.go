@@ -121,7 +121,7 @@ func TestResolver_DeletePackageInstanceAuth(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := packageinstanceauth.NewResolver(transact, svc, nil, converter)
+			resolver := packageinstanceauth.NewResolver(transact, svc, nil, nil, converter)
 
 			// when
 			result, err := resolver.DeletePackageInstanceAuth(context.TODO(), id)
@@ -293,7 +293,9 @@ func TestResolver_RequestPackageInstanceAuthCreation(t *testing.T) {
 			pkgSvc := testCase.PkgServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := packageinstanceauth.NewResolver(transact, svc, pkgSvc, converter)
+			shareSvc := fixShareServiceThatKeepsContext("OwnerContextForPackage", testPackageID)
+
+			resolver := packageinstanceauth.NewResolver(transact, svc, pkgSvc, shareSvc, converter)
 
 			// when
 			result, err := resolver.RequestPackageInstanceAuthCreation(context.TODO(), testPackageID, *gqlRequestInput)
@@ -302,9 +304,60 @@ func TestResolver_RequestPackageInstanceAuthCreation(t *testing.T) {
 			assert.Equal(t, testCase.ExpectedResult, result)
 			assert.Equal(t, testCase.ExpectedErr, err)
 
-			mock.AssertExpectationsForObjects(t, persist, transact, svc, pkgSvc, converter)
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, pkgSvc, shareSvc, converter)
 		})
 	}
+
+	t.Run("Error when switching to the owner of shared Application fails", func(t *testing.T) {
+		// given
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		shareSvc := &automock.ShareService{}
+		shareSvc.On("OwnerContextForPackage", txtest.CtxWithDBMatcher(), testPackageID).Return(nil, testError).Once()
+
+		resolver := packageinstanceauth.NewResolver(transact, nil, nil, shareSvc, nil)
+
+		// when
+		result, err := resolver.RequestPackageInstanceAuthCreation(context.TODO(), testPackageID, *gqlRequestInput)
+
+		// then
+		assert.Nil(t, result)
+		assert.Equal(t, testError, err)
+
+		mock.AssertExpectationsForObjects(t, persist, transact, shareSvc)
+	})
+
+	t.Run("Success for Package of shared Application", func(t *testing.T) {
+		// given
+		ownerCtxKey := struct{}{}
+		isOwnerCtx := mock.MatchedBy(func(ctx context.Context) bool {
+			return ctx.Value(ownerCtxKey) == testTenant
+		})
+
+		persist, transact := txGen.ThatSucceeds()
+		shareSvc := &automock.ShareService{}
+		shareSvc.On("OwnerContextForPackage", txtest.CtxWithDBMatcher(), testPackageID).Return(func(ctx context.Context, _ string) context.Context {
+			return context.WithValue(ctx, ownerCtxKey, testTenant)
+		}, nil).Once()
+		pkgSvc := &automock.PackageService{}
+		pkgSvc.On("Get", isOwnerCtx, testPackageID).Return(modelPackage, nil).Once()
+		svc := &automock.Service{}
+		svc.On("Create", isOwnerCtx, testPackageID, *modelRequestInput, modelPackage.DefaultInstanceAuth, modelPackage.InstanceAuthRequestInputSchema).Return(testID, nil).Once()
+		svc.On("Get", isOwnerCtx, testID).Return(modelInstanceAuth, nil).Once()
+		converter := &automock.Converter{}
+		converter.On("RequestInputFromGraphQL", *gqlRequestInput).Return(*modelRequestInput, nil).Once()
+		converter.On("ToGraphQL", modelInstanceAuth).Return(gqlInstanceAuth, nil).Once()
+
+		resolver := packageinstanceauth.NewResolver(transact, svc, pkgSvc, shareSvc, converter)
+
+		// when
+		result, err := resolver.RequestPackageInstanceAuthCreation(context.TODO(), testPackageID, *gqlRequestInput)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, gqlInstanceAuth, result)
+
+		mock.AssertExpectationsForObjects(t, persist, transact, svc, pkgSvc, shareSvc, converter)
+	})
 }
 
 func TestResolver_SetPackageInstanceAuth(t *testing.T) {
@@ -419,7 +472,7 @@ func TestResolver_SetPackageInstanceAuth(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := packageinstanceauth.NewResolver(transact, svc, nil, converter)
+			resolver := packageinstanceauth.NewResolver(transact, svc, nil, nil, converter)
 
 			// when
 			result, err := resolver.SetPackageInstanceAuth(context.TODO(), testAuthID, *gqlSetInput)
@@ -620,7 +673,9 @@ func TestResolver_RequestPackageInstanceAuthDeletion(t *testing.T) {
 			packageSvc := testCase.PackageServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := packageinstanceauth.NewResolver(transact, svc, packageSvc, converter)
+			shareSvc := fixShareServiceThatKeepsContext("OwnerContextForPackageInstanceAuth", id)
+
+			resolver := packageinstanceauth.NewResolver(transact, svc, packageSvc, shareSvc, converter)
 
 			// when
 			result, err := resolver.RequestPackageInstanceAuthDeletion(context.TODO(), id)
@@ -629,7 +684,33 @@ func TestResolver_RequestPackageInstanceAuthDeletion(t *testing.T) {
 			assert.Equal(t, testCase.ExpectedResult, result)
 			assert.Equal(t, testCase.ExpectedErr, err)
 
-			mock.AssertExpectationsForObjects(t, svc, converter, transact, persist, packageSvc)
+			mock.AssertExpectationsForObjects(t, svc, converter, transact, persist, packageSvc, shareSvc)
 		})
 	}
+
+	t.Run("Error when switching to the owner of shared Application fails", func(t *testing.T) {
+		// given
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		shareSvc := &automock.ShareService{}
+		shareSvc.On("OwnerContextForPackageInstanceAuth", txtest.CtxWithDBMatcher(), id).Return(nil, testErr).Once()
+
+		resolver := packageinstanceauth.NewResolver(transact, nil, nil, shareSvc, nil)
+
+		// when
+		result, err := resolver.RequestPackageInstanceAuthDeletion(context.TODO(), id)
+
+		// then
+		assert.Nil(t, result)
+		assert.Equal(t, testErr, err)
+
+		mock.AssertExpectationsForObjects(t, persist, transact, shareSvc)
+	})
+}
+
+func fixShareServiceThatKeepsContext(method, id string) *automock.ShareService {
+	shareSvc := &automock.ShareService{}
+	shareSvc.On(method, txtest.CtxWithDBMatcher(), id).Return(func(ctx context.Context, _ string) context.Context {
+		return ctx
+	}, nil).Maybe()
+	return shareSvc
 }
@@ -7,10 +7,12 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 	"github.com/kyma-incubator/compass/components/director/pkg/jsonschema"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/pkg/errors"
 )
 
@@ -20,6 +22,7 @@ type Repository interface {
 	GetByID(ctx context.Context, tenantID string, id string) (*model.PackageInstanceAuth, error)
 	GetForPackage(ctx context.Context, tenant string, id string, packageID string) (*model.PackageInstanceAuth, error)
 	ListByPackageID(ctx context.Context, tenantID string, packageID string) ([]*model.PackageInstanceAuth, error)
+	ListByPackageIDs(ctx context.Context, tenantID string, packageIDs []string) ([]*model.PackageInstanceAuth, error)
 	Update(ctx context.Context, item *model.PackageInstanceAuth) error
 	Delete(ctx context.Context, tenantID string, id string) error
 }
@@ -59,6 +62,16 @@ func (s *service) Create(ctx context.Context, packageID string, in model.Package
 	log.C(ctx).Debugf("ID %s generated for PackageInstanceAuth for Package with id %s", id, packageID)
 	pkgInstAuth := in.ToPackageInstanceAuth(id, packageID, tnt, defaultAuth, nil)
 
+	if consumerInfo, err := consumer.LoadFromContext(ctx); err == nil && consumerInfo.ConsumerType == consumer.RuntimeContext {
+		log.C(ctx).Debugf("PackageInstanceAuth with id %s is requested by Runtime Context with id %s", id, consumerInfo.ConsumerID)
+		pkgInstAuth.RuntimeContextID = &consumerInfo.ConsumerID
+	}
+
+	if sharedWith, ok := tenant.LoadSharedWithFromContext(ctx); ok {
+		log.C(ctx).Debugf("PackageInstanceAuth with id %s is requested by tenant %s which the Application is shared with", id, sharedWith)
+		pkgInstAuth.RequesterTenant = &sharedWith
+	}
+
 	err = s.setCreationStatusFromAuth(ctx, &pkgInstAuth, defaultAuth)
 	if err != nil {
 		return "", errors.Wrapf(err, "while setting creation status for PackageInstanceAuth with id %s", id)
@@ -83,6 +96,10 @@ func (s *service) Get(ctx context.Context, id string) (*model.PackageInstanceAut
 		return nil, errors.Wrapf(err, "while getting PackageInstanceAuth with id %s", id)
 	}
 
+	if !isVisible(ctx, instanceAuth) {
+		return nil, apperrors.NewNotFoundError(resource.PackageInstanceAuth, id)
+	}
+
 	return instanceAuth, nil
 }
 
@@ -97,6 +114,10 @@ func (s *service) GetForPackage(ctx context.Context, id string, packageID string
 		return nil, errors.Wrapf(err, "while getting Package Instance Auth with ID: [%s]", id)
 	}
 
+	if !isVisible(ctx, pkg) {
+		return nil, apperrors.NewNotFoundError(resource.PackageInstanceAuth, id)
+	}
+
 	return pkg, nil
 }
 
@@ -111,7 +132,26 @@ func (s *service) List(ctx context.Context, packageID string) ([]*model.PackageI
 		return nil, errors.Wrap(err, "while listing Package Instance Auths")
 	}
 
-	return pkgInstanceAuths, nil
+	return filterVisible(ctx, pkgInstanceAuths), nil
+}
+
+func (s *service) ListByPackageIDs(ctx context.Context, packageIDs []string) (map[string][]*model.PackageInstanceAuth, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pkgInstanceAuths, err := s.repo.ListByPackageIDs(ctx, tnt, packageIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Package Instance Auths for multiple Packages")
+	}
+
+	pkgInstanceAuthsByPkgID := make(map[string][]*model.PackageInstanceAuth, len(packageIDs))
+	for _, pia := range filterVisible(ctx, pkgInstanceAuths) {
+		pkgInstanceAuthsByPkgID[pia.PackageID] = append(pkgInstanceAuthsByPkgID[pia.PackageID], pia)
+	}
+
+	return pkgInstanceAuthsByPkgID, nil
 }
 
 func (s *service) SetAuth(ctx context.Context, id string, in model.PackageInstanceAuthSetInput) error {
@@ -187,6 +227,31 @@ func (s *service) Delete(ctx context.Context, id string) error {
 	return errors.Wrapf(err, "while deleting PackageInstanceAuth with id %s", id)
 }
 
+// isVisible checks if the Package Instance Auth can be read with the context. The tenant which an Application is shared with
+// reads only the Package Instance Auths it requested.
+func isVisible(ctx context.Context, instanceAuth *model.PackageInstanceAuth) bool {
+	sharedWith, ok := tenant.LoadSharedWithFromContext(ctx)
+	if !ok || instanceAuth == nil {
+		return true
+	}
+
+	return instanceAuth.RequesterTenant != nil && *instanceAuth.RequesterTenant == sharedWith
+}
+
+func filterVisible(ctx context.Context, instanceAuths []*model.PackageInstanceAuth) []*model.PackageInstanceAuth {
+	if _, ok := tenant.LoadSharedWithFromContext(ctx); !ok {
+		return instanceAuths
+	}
+
+	visible := make([]*model.PackageInstanceAuth, 0, len(instanceAuths))
+	for _, instanceAuth := range instanceAuths {
+		if isVisible(ctx, instanceAuth) {
+			visible = append(visible, instanceAuth)
+		}
+	}
+	return visible
+}
+
 func (s *service) setUpdateAuthAndStatus(ctx context.Context, instanceAuth *model.PackageInstanceAuth, in model.PackageInstanceAuthSetInput) error {
 	if instanceAuth == nil {
 		return nil
@@ -5,10 +5,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/packageinstanceauth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/packageinstanceauth/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
 	"github.com/pkg/errors"
@@ -80,6 +82,27 @@ func TestService_Get(t *testing.T) {
 		})
 	}
 
+	t.Run("Returns not found error when Package Instance Auth was requested by another tenant", func(t *testing.T) {
+		sharedCtx := tenant.SaveToContext(context.TODO(), testSharedTenant, "external-shared")
+		sharedCtx, err := tenant.SaveOwnerToContext(sharedCtx, tnt)
+		require.NoError(t, err)
+
+		instanceAuthRepo := &automock.Repository{}
+		instanceAuthRepo.On("GetByID", contextThatHasTenant(tnt), tnt, id).Return(modelInstanceAuth, nil).Once()
+
+		svc := packageinstanceauth.NewService(instanceAuthRepo, nil)
+
+		// WHEN
+		result, err := svc.Get(sharedCtx, id)
+
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+		assert.Nil(t, result)
+
+		instanceAuthRepo.AssertExpectations(t)
+	})
+
 	t.Run("Error when tenant not in context", func(t *testing.T) {
 		svc := packageinstanceauth.NewService(nil, nil)
 
@@ -584,6 +607,57 @@ func TestService_Create(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "cannot read tenant from context")
 	})
+
+	t.Run("Success when requested by Runtime Context", func(t *testing.T) {
+		runtimeContextID := "rtm-ctx-id"
+		consumerCtx := context.WithValue(ctx, consumer.ConsumerKey, consumer.Consumer{ConsumerID: runtimeContextID, ConsumerType: consumer.RuntimeContext})
+
+		modelExpectedRuntimeContextInstanceAuth := fixModelPackageInstanceAuth(testID, testPackageID, testTenant, modelAuth, fixModelStatusSucceeded())
+		modelExpectedRuntimeContextInstanceAuth.RuntimeContextID = &runtimeContextID
+
+		instanceAuthRepo := &automock.Repository{}
+		instanceAuthRepo.On("Create", contextThatHasTenant(testTenant), modelExpectedRuntimeContextInstanceAuth).Return(nil).Once()
+		uidSvc := &automock.UIDService{}
+		uidSvc.On("Generate").Return(testID).Once()
+
+		svc := packageinstanceauth.NewService(instanceAuthRepo, uidSvc)
+		svc.SetTimestampGen(func() time.Time { return testTime })
+
+		// WHEN
+		result, err := svc.Create(consumerCtx, testPackageID, *modelRequestInput, modelAuth, nil)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, testID, result)
+
+		mock.AssertExpectationsForObjects(t, instanceAuthRepo, uidSvc)
+	})
+
+	t.Run("Success when requested by tenant which the Application is shared with", func(t *testing.T) {
+		sharedCtx := tenant.SaveToContext(context.TODO(), testSharedTenant, "external-shared")
+		sharedCtx, err := tenant.SaveOwnerToContext(sharedCtx, testTenant)
+		require.NoError(t, err)
+
+		modelExpectedSharedInstanceAuth := fixModelPackageInstanceAuth(testID, testPackageID, testTenant, modelAuth, fixModelStatusSucceeded())
+		modelExpectedSharedInstanceAuth.RequesterTenant = str.Ptr(testSharedTenant)
+
+		instanceAuthRepo := &automock.Repository{}
+		instanceAuthRepo.On("Create", contextThatHasTenant(testTenant), modelExpectedSharedInstanceAuth).Return(nil).Once()
+		uidSvc := &automock.UIDService{}
+		uidSvc.On("Generate").Return(testID).Once()
+
+		svc := packageinstanceauth.NewService(instanceAuthRepo, uidSvc)
+		svc.SetTimestampGen(func() time.Time { return testTime })
+
+		// WHEN
+		result, err := svc.Create(sharedCtx, testPackageID, *modelRequestInput, modelAuth, nil)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, testID, result)
+
+		mock.AssertExpectationsForObjects(t, instanceAuthRepo, uidSvc)
+	})
 }
 
 func TestService_ListByApplicationID(t *testing.T) {
@@ -652,6 +726,28 @@ func TestService_ListByApplicationID(t *testing.T) {
 			repo.AssertExpectations(t)
 		})
 	}
+	t.Run("Lists only Package Instance Auths requested by tenant which the Application is shared with", func(t *testing.T) {
+		sharedCtx := tenant.SaveToContext(context.TODO(), testSharedTenant, "external-shared")
+		sharedCtx, err := tenant.SaveOwnerToContext(sharedCtx, tnt)
+		require.NoError(t, err)
+
+		requestedBySharedTenant := fixSimpleModelPackageInstanceAuth("bar")
+		requestedBySharedTenant.RequesterTenant = str.Ptr(testSharedTenant)
+
+		repo := &automock.Repository{}
+		repo.On("ListByPackageID", contextThatHasTenant(tnt), tnt, id).Return(append(packageInstanceAuths, requestedBySharedTenant), nil).Once()
+
+		svc := packageinstanceauth.NewService(repo, nil)
+
+		// WHEN
+		pia, err := svc.List(sharedCtx, id)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.PackageInstanceAuth{requestedBySharedTenant}, pia)
+
+		repo.AssertExpectations(t)
+	})
 	t.Run("Error when tenant not in context", func(t *testing.T) {
 		svc := packageinstanceauth.NewService(nil, nil)
 		// WHEN
@@ -662,6 +758,87 @@ func TestService_ListByApplicationID(t *testing.T) {
 	})
 }
 
+func TestService_ListByPackageIDs(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	tnt := testTenant
+	externalTnt := testExternalTenant
+	firstPkgID := "foo"
+	secondPkgID := "bar"
+	packageIDs := []string{firstPkgID, secondPkgID}
+
+	firstPkgInstanceAuth := fixModelPackageInstanceAuth("1", firstPkgID, tnt, nil, nil)
+	secondPkgInstanceAuth := fixModelPackageInstanceAuth("2", firstPkgID, tnt, nil, nil)
+	thirdPkgInstanceAuth := fixModelPackageInstanceAuth("3", secondPkgID, tnt, nil, nil)
+
+	packageInstanceAuths := []*model.PackageInstanceAuth{firstPkgInstanceAuth, secondPkgInstanceAuth, thirdPkgInstanceAuth}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.Repository
+		ExpectedResult     map[string][]*model.PackageInstanceAuth
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("ListByPackageIDs", ctx, tnt, packageIDs).Return(packageInstanceAuths, nil).Once()
+				return repo
+			},
+			ExpectedResult: map[string][]*model.PackageInstanceAuth{
+				firstPkgID:  {firstPkgInstanceAuth, secondPkgInstanceAuth},
+				secondPkgID: {thirdPkgInstanceAuth},
+			},
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when Package Instance Auth listing failed",
+			RepositoryFn: func() *automock.Repository {
+				repo := &automock.Repository{}
+				repo.On("ListByPackageIDs", ctx, tnt, packageIDs).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := packageinstanceauth.NewService(repo, nil)
+
+			// when
+			pia, err := svc.ListByPackageIDs(ctx, packageIDs)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, pia)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := packageinstanceauth.NewService(nil, nil)
+		// WHEN
+		_, err := svc.ListByPackageIDs(context.TODO(), packageIDs)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
 func TestService_RequestDeletion(t *testing.T) {
 	// GIVEN
 	tnt := testTenant
@@ -9,8 +9,10 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime_context"
 
 	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/appshare"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
@@ -26,9 +28,12 @@ import (
 	packageutil "github.com/kyma-incubator/compass/components/director/internal/domain/package"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/packageinstanceauth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenario"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/user"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/viewer"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook"
@@ -68,7 +73,14 @@ type RootResolver struct {
 	tenant              *tenant.Resolver
 	mpPackage           *packageutil.Resolver
 	packageInstanceAuth *packageinstanceauth.Resolver
+	appShare            *appshare.Resolver
 	scenarioAssignment  *scenarioassignment.Resolver
+	scenario            *scenario.Resolver
+	user                *user.Resolver
+	tenantOffboarding   *tenantoffboarding.Resolver
+
+	appReaper                  *application.Reaper
+	tenantOffboardingProcessor *tenantoffboarding.Processor
 }
 
 func NewRootResolver(
@@ -82,10 +94,12 @@ func NewRootResolver(
 	metricsCollector *metrics.Collector,
 	httpClient *http.Client,
 	protectedLabelPattern string,
+	appDeletionCfg application.DeletionConfig,
+	tenantOffboardingCfg tenantoffboarding.Config,
 ) *RootResolver {
 	oAuth20HTTPClient := &http.Client{
 		Timeout:   oAuth20Cfg.HTTPClientTimeout,
-		Transport: httputil.NewCorrelationIDTransport(http.DefaultTransport),
+		Transport: httputil.NewCorrelationIDTransport(httputil.NewTracingTransport(http.DefaultTransport)),
 	}
 	metricsCollector.InstrumentOAuth20HTTPClient(oAuth20HTTPClient)
 
@@ -109,6 +123,10 @@ func NewRootResolver(
 	appTemplateConverter := apptemplate.NewConverter(appConverter)
 	packageInstanceAuthConv := packageinstanceauth.NewConverter(authConverter)
 	assignmentConv := scenarioassignment.NewConverter()
+	userConverter := user.NewConverter()
+	scenarioConverter := scenario.NewConverter()
+	appShareConverter := appshare.NewConverter()
+	tenantOffboardingConverter := tenantoffboarding.NewConverter()
 
 	healthcheckRepo := healthcheck.NewRepository()
 	runtimeRepo := runtime.NewRepository()
@@ -128,13 +146,17 @@ func NewRootResolver(
 	packageRepo := packageutil.NewRepository(packageConverter)
 	packageInstanceAuthRepo := packageinstanceauth.NewRepository(packageInstanceAuthConv)
 	scenarioAssignmentRepo := scenarioassignment.NewRepository(assignmentConv)
+	userRepo := user.NewRepository(userConverter)
+	scenarioRepo := scenario.NewRepository(scenarioConverter)
+	appShareRepo := appshare.NewRepository(appShareConverter)
+	tenantOffboardingRepo := tenantoffboarding.NewRepository(tenantOffboardingConverter)
 
 	connectorGCLI := graphql_client.NewGraphQLClient(oneTimeTokenCfg.OneTimeTokenURL, httpClient.Timeout)
 
 	uidSvc := uid.NewService()
 	labelUpsertSvc := label.NewLabelUpsertService(labelRepo, labelDefRepo, uidSvc)
 	scenariosSvc := labeldef.NewScenariosService(labelDefRepo, uidSvc, featuresConfig.DefaultScenarioEnabled)
-	appTemplateSvc := apptemplate.NewService(appTemplateRepo, uidSvc)
+	appTemplateSvc := apptemplate.NewService(appTemplateRepo, uidSvc, cfgProvider)
 
 	fetchRequestSvc := fetchrequest.NewService(fetchRequestRepo, httpClient)
 	apiSvc := api.NewService(apiRepo, fetchRequestRepo, uidSvc, fetchRequestSvc)
@@ -144,44 +166,81 @@ func NewRootResolver(
 	scenarioAssignmentEngine := scenarioassignment.NewEngine(labelUpsertSvc, labelRepo, scenarioAssignmentRepo)
 	scenarioAssignmentSvc := scenarioassignment.NewService(scenarioAssignmentRepo, scenariosSvc, scenarioAssignmentEngine)
 	runtimeSvc := runtime.NewService(runtimeRepo, labelRepo, scenariosSvc, labelUpsertSvc, uidSvc, scenarioAssignmentEngine, protectedLabelPattern)
-	runtimeCtxSvc := runtime_context.NewService(runtimeContextRepo, labelRepo, labelUpsertSvc, uidSvc)
+	runtimeCtxSvc := runtime_context.NewService(runtimeContextRepo, labelRepo, scenariosSvc, labelUpsertSvc, uidSvc, scenarioAssignmentEngine)
 	healthCheckSvc := healthcheck.NewService(healthcheckRepo)
-	labelDefSvc := labeldef.NewService(labelDefRepo, labelRepo, scenarioAssignmentRepo, scenariosSvc, uidSvc)
+	labelDefSvc := labeldef.NewService(labelDefRepo, labelRepo, scenarioAssignmentRepo, scenariosSvc, scenarioRepo, uidSvc)
 	systemAuthSvc := systemauth.NewService(systemAuthRepo, uidSvc)
 	tenantSvc := tenant.NewService(tenantRepo, uidSvc)
 	oAuth20Svc := oauth20.NewService(cfgProvider, uidSvc, oAuth20Cfg, oAuth20HTTPClient)
 	intSysSvc := integrationsystem.NewService(intSysRepo, uidSvc)
 	eventingSvc := eventing.NewService(appNameNormalizer, runtimeRepo, labelRepo)
-	packageSvc := packageutil.NewService(packageRepo, apiRepo, eventAPIRepo, docRepo, fetchRequestRepo, uidSvc, fetchRequestSvc)
-	appSvc := application.NewService(appNameNormalizer, cfgProvider, applicationRepo, webhookRepo, runtimeRepo, labelRepo, intSysRepo, labelUpsertSvc, scenariosSvc, packageSvc, uidSvc)
+	packageSvc := packageutil.NewService(packageRepo, apiRepo, eventAPIRepo, docRepo, fetchRequestRepo, labelRepo, labelUpsertSvc, uidSvc, fetchRequestSvc)
+	appSvc := application.NewService(appNameNormalizer, cfgProvider, applicationRepo, webhookRepo, runtimeRepo, labelRepo, intSysRepo, labelUpsertSvc, scenariosSvc, packageSvc, apiSvc, eventAPISvc, docSvc, uidSvc)
 	tokenSvc := onetimetoken.NewTokenService(connectorGCLI, systemAuthSvc, appSvc, appConverter, tenantSvc, httpClient, oneTimeTokenCfg.ConnectorURL, pairingAdaptersMapping)
 	packageInstanceAuthSvc := packageinstanceauth.NewService(packageInstanceAuthRepo, uidSvc)
+	userSvc := user.NewService(userRepo, uidSvc)
+	scenarioSvc := scenario.NewService(scenarioRepo, labelDefSvc, scenariosSvc, appSvc, runtimeSvc, runtimeCtxSvc, uidSvc)
+	appShareSvc := appshare.NewService(appShareRepo, applicationRepo, tenantSvc, scenariosSvc, uidSvc)
+	tenantOffboardingSvc := tenantoffboarding.NewService(tenantOffboardingRepo, tenantRepo, uidSvc)
+	tenantDataExporter := tenantoffboarding.NewExporter(appSvc, appConverter, runtimeSvc, runtimeConverter, labelDefSvc, labelDefConverter)
+
+	var tenantOffboardingNotifier tenantoffboarding.RuntimeNotifier = tenantoffboarding.NewLogRuntimeNotifier()
+	if tenantOffboardingCfg.RuntimeNotificationURL != "" {
+		tenantOffboardingNotifier = tenantoffboarding.NewWebhookRuntimeNotifier(httpClient, tenantOffboardingCfg.RuntimeNotificationURL)
+	}
 
 	return &RootResolver{
-		appNameNormalizer:   appNameNormalizer,
-		app:                 application.NewResolver(transact, appSvc, webhookSvc, oAuth20Svc, systemAuthSvc, appConverter, webhookConverter, systemAuthConverter, eventingSvc, packageSvc, packageConverter),
-		appTemplate:         apptemplate.NewResolver(transact, appSvc, appConverter, appTemplateSvc, appTemplateConverter),
-		api:                 api.NewResolver(transact, apiSvc, appSvc, runtimeSvc, packageSvc, apiConverter, frConverter),
-		eventAPI:            eventdef.NewResolver(transact, eventAPISvc, appSvc, packageSvc, eventAPIConverter, frConverter),
-		eventing:            eventing.NewResolver(transact, eventingSvc, appSvc),
-		doc:                 document.NewResolver(transact, docSvc, appSvc, packageSvc, frConverter),
-		runtime:             runtime.NewResolver(transact, runtimeSvc, scenarioAssignmentSvc, systemAuthSvc, oAuth20Svc, runtimeConverter, systemAuthConverter, eventingSvc),
-		runtimeContext:      runtime_context.NewResolver(transact, runtimeCtxSvc, runtimeContextConverter),
-		healthCheck:         healthcheck.NewResolver(healthCheckSvc),
-		webhook:             webhook.NewResolver(transact, webhookSvc, appSvc, webhookConverter),
-		labelDef:            labeldef.NewResolver(transact, labelDefSvc, labelDefConverter),
-		token:               onetimetoken.NewTokenResolver(transact, tokenSvc, tokenConverter),
-		systemAuth:          systemauth.NewResolver(transact, systemAuthSvc, oAuth20Svc, systemAuthConverter),
-		oAuth20:             oauth20.NewResolver(transact, oAuth20Svc, appSvc, runtimeSvc, intSysSvc, systemAuthSvc, systemAuthConverter),
-		intSys:              integrationsystem.NewResolver(transact, intSysSvc, systemAuthSvc, oAuth20Svc, intSysConverter, systemAuthConverter),
-		viewer:              viewer.NewViewerResolver(),
-		tenant:              tenant.NewResolver(transact, tenantSvc, tenantConverter),
-		mpPackage:           packageutil.NewResolver(transact, packageSvc, packageInstanceAuthSvc, apiSvc, eventAPISvc, docSvc, packageConverter, packageInstanceAuthConv, apiConverter, eventAPIConverter, docConverter),
-		packageInstanceAuth: packageinstanceauth.NewResolver(transact, packageInstanceAuthSvc, packageSvc, packageInstanceAuthConv),
-		scenarioAssignment:  scenarioassignment.NewResolver(transact, scenarioAssignmentSvc, assignmentConv),
+		appNameNormalizer:          appNameNormalizer,
+		app:                        application.NewResolver(transact, appSvc, webhookSvc, oAuth20Svc, systemAuthSvc, appConverter, webhookConverter, systemAuthConverter, eventingSvc, packageSvc, packageConverter, appDeletionCfg.GracePeriod),
+		appTemplate:                apptemplate.NewResolver(transact, appSvc, appConverter, appTemplateSvc, appTemplateConverter),
+		api:                        api.NewResolver(transact, apiSvc, appSvc, runtimeSvc, packageSvc, apiConverter, frConverter),
+		eventAPI:                   eventdef.NewResolver(transact, eventAPISvc, appSvc, packageSvc, eventAPIConverter, frConverter),
+		eventing:                   eventing.NewResolver(transact, eventingSvc, appSvc),
+		doc:                        document.NewResolver(transact, docSvc, appSvc, packageSvc, frConverter),
+		runtime:                    runtime.NewResolver(transact, runtimeSvc, scenarioAssignmentSvc, systemAuthSvc, oAuth20Svc, runtimeConverter, systemAuthConverter, eventingSvc),
+		runtimeContext:             runtime_context.NewResolver(transact, runtimeCtxSvc, systemAuthSvc, oAuth20Svc, runtimeContextConverter, systemAuthConverter),
+		healthCheck:                healthcheck.NewResolver(healthCheckSvc),
+		webhook:                    webhook.NewResolver(transact, webhookSvc, appSvc, webhookConverter),
+		labelDef:                   labeldef.NewResolver(transact, labelDefSvc, labelDefConverter),
+		token:                      onetimetoken.NewTokenResolver(transact, tokenSvc, tokenConverter),
+		systemAuth:                 systemauth.NewResolver(transact, systemAuthSvc, oAuth20Svc, systemAuthConverter),
+		oAuth20:                    oauth20.NewResolver(transact, oAuth20Svc, appSvc, runtimeSvc, runtimeCtxSvc, intSysSvc, systemAuthSvc, systemAuthConverter),
+		intSys:                     integrationsystem.NewResolver(transact, intSysSvc, systemAuthSvc, oAuth20Svc, intSysConverter, systemAuthConverter),
+		viewer:                     viewer.NewViewerResolver(),
+		tenant:                     tenant.NewResolver(transact, tenantSvc, tenantConverter),
+		mpPackage:                  packageutil.NewResolver(transact, packageSvc, packageInstanceAuthSvc, apiSvc, eventAPISvc, docSvc, packageConverter, packageInstanceAuthConv, apiConverter, eventAPIConverter, docConverter),
+		packageInstanceAuth:        packageinstanceauth.NewResolver(transact, packageInstanceAuthSvc, packageSvc, appShareSvc, packageInstanceAuthConv),
+		appShare:                   appshare.NewResolver(transact, appShareSvc, appShareConverter),
+		scenarioAssignment:         scenarioassignment.NewResolver(transact, scenarioAssignmentSvc, assignmentConv),
+		scenario:                   scenario.NewResolver(transact, scenarioSvc, scenarioConverter, appConverter, runtimeConverter, runtimeContextConverter),
+		user:                       user.NewResolver(transact, userSvc, userConverter),
+		tenantOffboarding:          tenantoffboarding.NewResolver(transact, tenantOffboardingSvc, tenantOffboardingConverter),
+		appReaper:                  application.NewReaper(transact, appSvc, eventingSvc, systemAuthSvc, oAuth20Svc, application.NewLogRuntimeNotifier(), appDeletionCfg),
+		tenantOffboardingProcessor: tenantoffboarding.NewProcessor(transact, tenantOffboardingRepo, tenantRepo, systemAuthSvc, oAuth20Svc, tenantoffboarding.NewConnectorCertificateRevoker(connectorGCLI), tenantDataExporter, tenantOffboardingNotifier, tenantOffboardingCfg),
+	}
+}
+
+// DataLoaders returns the batch functions used for loading objects related to many parent objects at once
+func (r *RootResolver) DataLoaders() dataloader.FetchFuncs {
+	return dataloader.FetchFuncs{
+		PackagesByApplicationID:   r.app.PackagesDataLoader,
+		LabelsByApplicationID:     r.app.LabelsDataLoader,
+		APIDefinitionsByPackageID: r.mpPackage.APIDefinitionsDataLoader,
+		InstanceAuthsByPackageID:  r.mpPackage.InstanceAuthsDataLoader,
+		AuthsByRuntimeID:          r.runtime.AuthsDataLoader,
 	}
 }
 
+// ApplicationReaper returns the job permanently deleting Applications after the deletion grace period
+func (r *RootResolver) ApplicationReaper() *application.Reaper {
+	return r.appReaper
+}
+
+// TenantOffboardingProcessor returns the job completing the offboarding of deactivated tenants
+func (r *RootResolver) TenantOffboardingProcessor() *tenantoffboarding.Processor {
+	return r.tenantOffboardingProcessor
+}
+
 func (r *RootResolver) Mutation() graphql.MutationResolver {
 	return &mutationResolver{r}
 }
@@ -223,6 +282,10 @@ func (r *RootResolver) OneTimeTokenForRuntime() graphql.OneTimeTokenForRuntimeRe
 	return &oneTimeTokenForRuntimeResolver{r}
 }
 
+func (r *RootResolver) Scenario() graphql.ScenarioResolver {
+	return &scenarioResolver{r}
+}
+
 type queryResolver struct {
 	*RootResolver
 }
@@ -237,9 +300,13 @@ func (r *queryResolver) Applications(ctx context.Context, filter []*graphql.Labe
 		return nil, err
 	}
 
-	if consumerInfo.ConsumerType == consumer.Runtime {
+	switch consumerInfo.ConsumerType {
+	case consumer.Runtime:
 		log.C(ctx).Debugf("Consumer type is of type %v. Filtering response based on scenarios...", consumer.Runtime)
 		return r.app.ApplicationsForRuntime(ctx, consumerInfo.ConsumerID, first, after)
+	case consumer.RuntimeContext:
+		log.C(ctx).Debugf("Consumer type is of type %v. Filtering response based on scenarios...", consumer.RuntimeContext)
+		return r.app.ApplicationsForRuntimeContext(ctx, consumerInfo.ConsumerID, first, after)
 	}
 
 	return r.app.Applications(ctx, filter, first, after)
@@ -254,6 +321,12 @@ func (r *queryResolver) ApplicationTemplates(ctx context.Context, first *int, af
 func (r *queryResolver) ApplicationTemplate(ctx context.Context, id string) (*graphql.ApplicationTemplate, error) {
 	return r.appTemplate.ApplicationTemplate(ctx, id)
 }
+func (r *queryResolver) ApplicationFromTemplatePreview(ctx context.Context, in graphql.ApplicationFromTemplateInput) (string, error) {
+	return r.appTemplate.ApplicationFromTemplatePreview(ctx, in)
+}
+func (r *queryResolver) ApplicationFromTemplateUpgradeDiff(ctx context.Context, appID string, in *graphql.ApplicationFromTemplateUpgradeInput) (*graphql.ApplicationFromTemplateUpgrade, error) {
+	return r.appTemplate.ApplicationFromTemplateUpgradeDiff(ctx, appID, in)
+}
 func (r *queryResolver) ApplicationsForRuntime(ctx context.Context, runtimeID string, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
 	apps, err := r.app.ApplicationsForRuntime(ctx, runtimeID, first, after)
 	if err != nil {
@@ -279,6 +352,9 @@ func (r *queryResolver) ApplicationsForRuntime(ctx context.Context, runtimeID st
 
 	return apps, nil
 }
+func (r *queryResolver) ApplicationShares(ctx context.Context, applicationID string) ([]*graphql.ApplicationShare, error) {
+	return r.appShare.ApplicationShares(ctx, applicationID)
+}
 func (r *queryResolver) Runtimes(ctx context.Context, filter []*graphql.LabelFilter, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
 	return r.runtime.Runtimes(ctx, filter, first, after)
 }
@@ -297,6 +373,12 @@ func (r *queryResolver) LabelDefinitions(ctx context.Context) ([]*graphql.LabelD
 func (r *queryResolver) LabelDefinition(ctx context.Context, key string) (*graphql.LabelDefinition, error) {
 	return r.labelDef.LabelDefinition(ctx, key)
 }
+func (r *queryResolver) Scenarios(ctx context.Context) ([]*graphql.Scenario, error) {
+	return r.scenario.Scenarios(ctx)
+}
+func (r *queryResolver) Scenario(ctx context.Context, name string) (*graphql.Scenario, error) {
+	return r.scenario.Scenario(ctx, name)
+}
 func (r *queryResolver) HealthChecks(ctx context.Context, types []graphql.HealthCheckType, origin *string, first *int, after *graphql.PageCursor) (*graphql.HealthCheckPage, error) {
 	return r.healthCheck.HealthChecks(ctx, types, origin, first, after)
 }
@@ -311,6 +393,10 @@ func (r *queryResolver) Tenants(ctx context.Context) ([]*graphql.Tenant, error)
 	return r.tenant.Tenants(ctx)
 }
 
+func (r *queryResolver) TenantOffboarding(ctx context.Context, tenant string) (*graphql.TenantOffboarding, error) {
+	return r.tenantOffboarding.TenantOffboarding(ctx, tenant)
+}
+
 func (r *queryResolver) AutomaticScenarioAssignmentForScenario(ctx context.Context, scenarioName string) (*graphql.AutomaticScenarioAssignment, error) {
 	return r.scenarioAssignment.GetAutomaticScenarioAssignmentForScenarioName(ctx, scenarioName)
 }
@@ -323,6 +409,26 @@ func (r *queryResolver) AutomaticScenarioAssignments(ctx context.Context, first
 	return r.scenarioAssignment.AutomaticScenarioAssignments(ctx, first, after)
 }
 
+func (r *queryResolver) EventingBindings(ctx context.Context) ([]*graphql.ApplicationEventingBinding, error) {
+	return r.eventing.EventingBindings(ctx)
+}
+
+func (r *queryResolver) Users(ctx context.Context) ([]*graphql.User, error) {
+	return r.user.Users(ctx)
+}
+
+func (r *queryResolver) UserGroups(ctx context.Context) ([]*graphql.UserGroup, error) {
+	return r.user.UserGroups(ctx)
+}
+
+func (r *queryResolver) Roles(ctx context.Context) ([]*graphql.Role, error) {
+	return r.user.Roles(ctx)
+}
+
+func (r *queryResolver) RoleBindings(ctx context.Context) ([]*graphql.RoleBinding, error) {
+	return r.user.RoleBindings(ctx)
+}
+
 type mutationResolver struct {
 	*RootResolver
 }
@@ -336,12 +442,24 @@ func (r *mutationResolver) UpdateApplication(ctx context.Context, id string, in
 func (r *mutationResolver) UnregisterApplication(ctx context.Context, id string) (*graphql.Application, error) {
 	return r.app.UnregisterApplication(ctx, id)
 }
+func (r *mutationResolver) RestoreApplication(ctx context.Context, id string) (*graphql.Application, error) {
+	return r.app.RestoreApplication(ctx, id)
+}
+func (r *mutationResolver) ShareApplication(ctx context.Context, applicationID string, in graphql.ApplicationShareInput) (*graphql.ApplicationShare, error) {
+	return r.appShare.ShareApplication(ctx, applicationID, in)
+}
+func (r *mutationResolver) UnshareApplication(ctx context.Context, id string) (*graphql.ApplicationShare, error) {
+	return r.appShare.UnshareApplication(ctx, id)
+}
 func (r *mutationResolver) CreateApplicationTemplate(ctx context.Context, in graphql.ApplicationTemplateInput) (*graphql.ApplicationTemplate, error) {
 	return r.appTemplate.CreateApplicationTemplate(ctx, in)
 }
 func (r *mutationResolver) RegisterApplicationFromTemplate(ctx context.Context, in graphql.ApplicationFromTemplateInput) (*graphql.Application, error) {
 	return r.appTemplate.RegisterApplicationFromTemplate(ctx, in)
 }
+func (r *mutationResolver) UpgradeApplicationFromTemplate(ctx context.Context, appID string, in *graphql.ApplicationFromTemplateUpgradeInput) (*graphql.Application, error) {
+	return r.appTemplate.UpgradeApplicationFromTemplate(ctx, appID, in)
+}
 func (r *mutationResolver) UpdateApplicationTemplate(ctx context.Context, id string, in graphql.ApplicationTemplateInput) (*graphql.ApplicationTemplate, error) {
 	return r.appTemplate.UpdateApplicationTemplate(ctx, id, in)
 }
@@ -404,9 +522,21 @@ func (r *mutationResolver) CreateLabelDefinition(ctx context.Context, in graphql
 func (r *mutationResolver) UpdateLabelDefinition(ctx context.Context, in graphql.LabelDefinitionInput) (*graphql.LabelDefinition, error) {
 	return r.labelDef.UpdateLabelDefinition(ctx, in)
 }
+func (r *mutationResolver) MigrateLabelDefinition(ctx context.Context, in graphql.LabelDefinitionInput, migration graphql.LabelDefinitionMigrationInput) (*graphql.LabelDefinitionMigrationResult, error) {
+	return r.labelDef.MigrateLabelDefinition(ctx, in, migration)
+}
 func (r *mutationResolver) DeleteLabelDefinition(ctx context.Context, key string, deleteRelatedLabels *bool) (*graphql.LabelDefinition, error) {
 	return r.labelDef.DeleteLabelDefinition(ctx, key, deleteRelatedLabels)
 }
+func (r *mutationResolver) CreateScenario(ctx context.Context, in graphql.ScenarioInput) (*graphql.Scenario, error) {
+	return r.scenario.CreateScenario(ctx, in)
+}
+func (r *mutationResolver) UpdateScenario(ctx context.Context, in graphql.ScenarioInput) (*graphql.Scenario, error) {
+	return r.scenario.UpdateScenario(ctx, in)
+}
+func (r *mutationResolver) DeleteScenario(ctx context.Context, name string) (*graphql.Scenario, error) {
+	return r.scenario.DeleteScenario(ctx, name)
+}
 func (r *mutationResolver) SetApplicationLabel(ctx context.Context, applicationID string, key string, value interface{}) (*graphql.Label, error) {
 	return r.app.SetApplicationLabel(ctx, applicationID, key, value)
 }
@@ -428,6 +558,12 @@ func (r *mutationResolver) RequestOneTimeTokenForRuntime(ctx context.Context, id
 func (r *mutationResolver) RequestClientCredentialsForRuntime(ctx context.Context, id string) (*graphql.SystemAuth, error) {
 	return r.oAuth20.RequestClientCredentialsForRuntime(ctx, id)
 }
+func (r *mutationResolver) RequestOneTimeTokenForRuntimeContext(ctx context.Context, id string) (*graphql.OneTimeTokenForRuntime, error) {
+	return r.token.RequestOneTimeTokenForRuntimeContext(ctx, id)
+}
+func (r *mutationResolver) RequestClientCredentialsForRuntimeContext(ctx context.Context, id string) (*graphql.SystemAuth, error) {
+	return r.oAuth20.RequestClientCredentialsForRuntimeContext(ctx, id)
+}
 func (r *mutationResolver) RequestClientCredentialsForApplication(ctx context.Context, id string) (*graphql.SystemAuth, error) {
 	return r.oAuth20.RequestClientCredentialsForApplication(ctx, id)
 }
@@ -438,6 +574,10 @@ func (r *mutationResolver) DeleteSystemAuthForRuntime(ctx context.Context, authI
 	fn := r.systemAuth.GenericDeleteSystemAuth(model.RuntimeReference)
 	return fn(ctx, authID)
 }
+func (r *mutationResolver) DeleteSystemAuthForRuntimeContext(ctx context.Context, authID string) (*graphql.SystemAuth, error) {
+	fn := r.systemAuth.GenericDeleteSystemAuth(model.RuntimeContextReference)
+	return fn(ctx, authID)
+}
 func (r *mutationResolver) DeleteSystemAuthForApplication(ctx context.Context, authID string) (*graphql.SystemAuth, error) {
 	fn := r.systemAuth.GenericDeleteSystemAuth(model.ApplicationReference)
 	return fn(ctx, authID)
@@ -464,6 +604,10 @@ func (r *mutationResolver) DeleteDefaultEventingForApplication(ctx context.Conte
 	return r.eventing.UnsetEventingForApplication(ctx, appID)
 }
 
+func (r *mutationResolver) SetEventingPolicyForApplication(ctx context.Context, appID string, in graphql.EventingPolicyInput) (*graphql.ApplicationEventingConfiguration, error) {
+	return r.eventing.SetEventingPolicyForApplication(ctx, appID, in)
+}
+
 func (r *mutationResolver) AddAPIDefinitionToPackage(ctx context.Context, packageID string, in graphql.APIDefinitionInput) (*graphql.APIDefinition, error) {
 	return r.api.AddAPIDefinitionToPackage(ctx, packageID, in)
 }
@@ -495,6 +639,48 @@ func (r *mutationResolver) UpdatePackage(ctx context.Context, id string, in grap
 func (r *mutationResolver) DeletePackage(ctx context.Context, id string) (*graphql.Package, error) {
 	return r.mpPackage.DeletePackage(ctx, id)
 }
+func (r *mutationResolver) SetPackageLabel(ctx context.Context, packageID string, key string, value interface{}) (*graphql.Label, error) {
+	return r.mpPackage.SetPackageLabel(ctx, packageID, key, value)
+}
+func (r *mutationResolver) DeletePackageLabel(ctx context.Context, packageID string, key string) (*graphql.Label, error) {
+	return r.mpPackage.DeletePackageLabel(ctx, packageID, key)
+}
+
+func (r *mutationResolver) CreateUser(ctx context.Context, in graphql.UserInput) (*graphql.User, error) {
+	return r.user.CreateUser(ctx, in)
+}
+func (r *mutationResolver) DeleteUser(ctx context.Context, id string) (*graphql.User, error) {
+	return r.user.DeleteUser(ctx, id)
+}
+func (r *mutationResolver) CreateUserGroup(ctx context.Context, in graphql.UserGroupInput) (*graphql.UserGroup, error) {
+	return r.user.CreateUserGroup(ctx, in)
+}
+func (r *mutationResolver) DeleteUserGroup(ctx context.Context, id string) (*graphql.UserGroup, error) {
+	return r.user.DeleteUserGroup(ctx, id)
+}
+func (r *mutationResolver) CreateRole(ctx context.Context, in graphql.RoleInput) (*graphql.Role, error) {
+	return r.user.CreateRole(ctx, in)
+}
+func (r *mutationResolver) UpdateRole(ctx context.Context, id string, in graphql.RoleInput) (*graphql.Role, error) {
+	return r.user.UpdateRole(ctx, id, in)
+}
+func (r *mutationResolver) DeleteRole(ctx context.Context, id string) (*graphql.Role, error) {
+	return r.user.DeleteRole(ctx, id)
+}
+func (r *mutationResolver) CreateRoleBinding(ctx context.Context, in graphql.RoleBindingInput) (*graphql.RoleBinding, error) {
+	return r.user.CreateRoleBinding(ctx, in)
+}
+func (r *mutationResolver) DeleteRoleBinding(ctx context.Context, id string) (*graphql.RoleBinding, error) {
+	return r.user.DeleteRoleBinding(ctx, id)
+}
+
+func (r *mutationResolver) OffboardTenant(ctx context.Context, tenant string, exportData *bool) (*graphql.TenantOffboarding, error) {
+	return r.tenantOffboarding.OffboardTenant(ctx, tenant, exportData)
+}
+
+func (r *mutationResolver) RequestTenantDataExport(ctx context.Context, tenant string) (*graphql.TenantOffboarding, error) {
+	return r.tenantOffboarding.RequestTenantDataExport(ctx, tenant)
+}
 
 func (r *mutationResolver) DeleteAutomaticScenarioAssignmentForScenario(ctx context.Context, scenarioName string) (*graphql.AutomaticScenarioAssignment, error) {
 	return r.scenarioAssignment.DeleteAutomaticScenarioAssignmentForScenario(ctx, scenarioName)
@@ -524,6 +710,9 @@ func (r *applicationResolver) Webhooks(ctx context.Context, obj *graphql.Applica
 func (r *applicationResolver) EventingConfiguration(ctx context.Context, obj *graphql.Application) (*graphql.ApplicationEventingConfiguration, error) {
 	return r.app.EventingConfiguration(ctx, obj)
 }
+func (r *applicationResolver) TemplateReference(ctx context.Context, obj *graphql.Application) (*graphql.ApplicationTemplateReference, error) {
+	return r.app.TemplateReference(ctx, obj)
+}
 func (r *applicationResolver) Packages(ctx context.Context, obj *graphql.Application, first *int, after *graphql.PageCursor) (*graphql.PackagePage, error) {
 	return r.app.Packages(ctx, obj, first, after)
 }
@@ -599,6 +788,26 @@ func (r *runtimeContextResolver) Labels(ctx context.Context, obj *graphql.Runtim
 	return r.runtimeContext.Labels(ctx, obj, key)
 }
 
+func (r *runtimeContextResolver) Auths(ctx context.Context, obj *graphql.RuntimeContext) ([]*graphql.SystemAuth, error) {
+	return r.runtimeContext.Auths(ctx, obj)
+}
+
+type scenarioResolver struct {
+	*RootResolver
+}
+
+func (r *scenarioResolver) Applications(ctx context.Context, obj *graphql.Scenario, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
+	return r.scenario.Applications(ctx, obj, first, after)
+}
+
+func (r *scenarioResolver) Runtimes(ctx context.Context, obj *graphql.Scenario, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
+	return r.scenario.Runtimes(ctx, obj, first, after)
+}
+
+func (r *scenarioResolver) RuntimeContexts(ctx context.Context, obj *graphql.Scenario, first *int, after *graphql.PageCursor) (*graphql.RuntimeContextPage, error) {
+	return r.scenario.RuntimeContexts(ctx, obj, first, after)
+}
+
 type PackageResolver struct{ *RootResolver }
 
 func (r *PackageResolver) InstanceAuth(ctx context.Context, obj *graphql.Package, id string) (*graphql.PackageInstanceAuth, error) {
@@ -607,6 +816,9 @@ func (r *PackageResolver) InstanceAuth(ctx context.Context, obj *graphql.Package
 func (r *PackageResolver) InstanceAuths(ctx context.Context, obj *graphql.Package) ([]*graphql.PackageInstanceAuth, error) {
 	return r.mpPackage.InstanceAuths(ctx, obj)
 }
+func (r *PackageResolver) Labels(ctx context.Context, obj *graphql.Package, key *string) (*graphql.Labels, error) {
+	return r.mpPackage.Labels(ctx, obj, key)
+}
 func (r *PackageResolver) APIDefinitions(ctx context.Context, obj *graphql.Package, group *string, first *int, after *graphql.PageCursor) (*graphql.APIDefinitionPage, error) {
 	return r.mpPackage.APIDefinitions(ctx, obj, group, first, after)
 }
@@ -16,6 +16,29 @@ type EventingService struct {
 	mock.Mock
 }
 
+// GetApplicationsForDefaultRuntime provides a mock function with given fields: ctx, runtimeID
+func (_m *EventingService) GetApplicationsForDefaultRuntime(ctx context.Context, runtimeID uuid.UUID) ([]uuid.UUID, error) {
+	ret := _m.Called(ctx, runtimeID)
+
+	var r0 []uuid.UUID
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []uuid.UUID); ok {
+		r0 = rf(ctx, runtimeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uuid.UUID)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, runtimeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetForRuntime provides a mock function with given fields: ctx, runtimeID
 func (_m *EventingService) GetForRuntime(ctx context.Context, runtimeID uuid.UUID) (*model.RuntimeEventingConfiguration, error) {
 	ret := _m.Called(ctx, runtimeID)
@@ -38,3 +61,31 @@ func (_m *EventingService) GetForRuntime(ctx context.Context, runtimeID uuid.UUI
 
 	return r0, r1
 }
+
+// ReelectForApplications provides a mock function with given fields: ctx, appIDs
+func (_m *EventingService) ReelectForApplications(ctx context.Context, appIDs []uuid.UUID) error {
+	ret := _m.Called(ctx, appIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uuid.UUID) error); ok {
+		r0 = rf(ctx, appIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReelectForRuntime provides a mock function with given fields: ctx, runtimeID
+func (_m *EventingService) ReelectForRuntime(ctx context.Context, runtimeID uuid.UUID) error {
+	ret := _m.Called(ctx, runtimeID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, runtimeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
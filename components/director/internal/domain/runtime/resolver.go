@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
@@ -27,6 +28,9 @@ import (
 //go:generate mockery -name=EventingService -output=automock -outpkg=automock -case=underscore
 type EventingService interface {
 	GetForRuntime(ctx context.Context, runtimeID uuid.UUID) (*model.RuntimeEventingConfiguration, error)
+	GetApplicationsForDefaultRuntime(ctx context.Context, runtimeID uuid.UUID) ([]uuid.UUID, error)
+	ReelectForApplications(ctx context.Context, appIDs []uuid.UUID) error
+	ReelectForRuntime(ctx context.Context, runtimeID uuid.UUID) error
 }
 
 //go:generate mockery -name=OAuth20Service -output=automock -outpkg=automock -case=underscore
@@ -68,6 +72,7 @@ type SystemAuthConverter interface {
 //go:generate mockery -name=SystemAuthService -output=automock -outpkg=automock -case=underscore
 type SystemAuthService interface {
 	ListForObject(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error)
+	ListForObjectIDs(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectIDs []string) (map[string][]model.SystemAuth, error)
 }
 
 type Resolver struct {
@@ -209,6 +214,11 @@ func (r *Resolver) UpdateRuntime(ctx context.Context, id string, in graphql.Runt
 		return nil, err
 	}
 
+	err = r.reelectDefaultEventing(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
 	runtime, err := r.runtimeService.Get(ctx, id)
 	if err != nil {
 		return nil, err
@@ -253,6 +263,16 @@ func (r *Resolver) DeleteRuntime(ctx context.Context, id string) (*graphql.Runti
 		return nil, err
 	}
 
+	runtimeID, err := uuid.Parse(runtime.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "while parsing runtime ID as UUID")
+	}
+
+	appIDs, err := r.eventingSvc.GetApplicationsForDefaultRuntime(ctx, runtimeID)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting applications with the runtime as default for eventing")
+	}
+
 	deletedRuntime := r.converter.ToGraphQL(runtime)
 
 	err = r.runtimeService.Delete(ctx, id)
@@ -260,6 +280,11 @@ func (r *Resolver) DeleteRuntime(ctx context.Context, id string) (*graphql.Runti
 		return nil, err
 	}
 
+	err = r.eventingSvc.ReelectForApplications(ctx, appIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "while choosing new default runtime for eventing for applications")
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -329,6 +354,13 @@ func (r *Resolver) SetRuntimeLabel(ctx context.Context, runtimeID string, key st
 		return nil, err
 	}
 
+	if key == model.ScenariosKey {
+		err = r.reelectDefaultEventing(ctx, runtimeID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	label, err := r.runtimeService.GetLabel(ctx, runtimeID, key)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while getting label with key: [%s]", key)
@@ -364,6 +396,13 @@ func (r *Resolver) DeleteRuntimeLabel(ctx context.Context, runtimeID string, key
 		return nil, err
 	}
 
+	if key == model.ScenariosKey {
+		err = r.reelectDefaultEventing(ctx, runtimeID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -416,6 +455,10 @@ func (r *Resolver) Auths(ctx context.Context, obj *graphql.Runtime) ([]*graphql.
 		return nil, apperrors.NewInternalError("Runtime cannot be empty")
 	}
 
+	if loaders := dataloader.LoadFromContext(ctx); loaders != nil {
+		return loaders.AuthsByRuntimeID.Load(dataloader.ParamID{ID: obj.ID, Ctx: ctx})
+	}
+
 	tx, err := r.transact.Begin()
 	if err != nil {
 		return nil, err
@@ -512,3 +555,63 @@ func (r *Resolver) deleteAssociatedScenarioAssignments(ctx context.Context, runt
 
 	return nil
 }
+
+func (r *Resolver) reelectDefaultEventing(ctx context.Context, runtimeID string) error {
+	runtimeUUID, err := uuid.Parse(runtimeID)
+	if err != nil {
+		return errors.Wrap(err, "while parsing runtime ID as UUID")
+	}
+
+	if err := r.eventingSvc.ReelectForRuntime(ctx, runtimeUUID); err != nil {
+		return errors.Wrap(err, "while choosing new default runtime for eventing for applications")
+	}
+
+	return nil
+}
+
+// AuthsDataLoader fetches System Auths of multiple Runtimes in a single transaction
+func (r *Resolver) AuthsDataLoader(keys []dataloader.ParamID) ([][]*graphql.SystemAuth, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Runtimes found")}
+	}
+
+	ctx := keys[0].Ctx
+	runtimeIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		runtimeIDs = append(runtimeIDs, key.ID)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	sysAuthsByRuntimeID, err := r.sysAuthSvc.ListForObjectIDs(ctx, model.RuntimeReference, runtimeIDs)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	gqlSysAuths := make([][]*graphql.SystemAuth, 0, len(keys))
+	for _, key := range keys {
+		var out []*graphql.SystemAuth
+		for _, sa := range sysAuthsByRuntimeID[key.ID] {
+			c, err := r.sysAuthConv.ToGraphQL(&sa)
+			if err != nil {
+				return nil, []error{err}
+			}
+			out = append(out, c)
+		}
+
+		gqlSysAuths = append(gqlSysAuths, out)
+	}
+
+	return gqlSysAuths, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/kyma-incubator/compass/components/director/internal/dataloader"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
@@ -176,8 +177,10 @@ func TestResolver_CreateRuntime(t *testing.T) {
 
 func TestResolver_UpdateRuntime(t *testing.T) {
 	// given
-	modelRuntime := fixModelRuntime(t, "foo", "tenant-foo", "Foo", "Lorem ipsum")
-	gqlRuntime := fixGQLRuntime(t, "foo", "Foo", "Lorem ipsum")
+	runtimeID := "f35c0a8a-7a16-4a43-a2b8-13b42a2c1a2f"
+	runtimeUUID := uuid.MustParse(runtimeID)
+	modelRuntime := fixModelRuntime(t, runtimeID, "tenant-foo", "Foo", "Lorem ipsum")
+	gqlRuntime := fixGQLRuntime(t, runtimeID, "Foo", "Lorem ipsum")
 	testErr := errors.New("Test error")
 
 	desc := "Lorem ipsum"
@@ -189,7 +192,6 @@ func TestResolver_UpdateRuntime(t *testing.T) {
 		Name:        "Foo",
 		Description: &desc,
 	}
-	runtimeID := "foo"
 
 	testCases := []struct {
 		Name            string
@@ -197,6 +199,7 @@ func TestResolver_UpdateRuntime(t *testing.T) {
 		TransactionerFn func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner
 		ServiceFn       func() *automock.RuntimeService
 		ConverterFn     func() *automock.RuntimeConverter
+		EventingSvcFn   func() *automock.EventingService
 		RuntimeID       string
 		Input           graphql.RuntimeInput
 		ExpectedRuntime *graphql.Runtime
@@ -218,7 +221,7 @@ func TestResolver_UpdateRuntime(t *testing.T) {
 			},
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
 				svc.On("Update", contextParam, runtimeID, modelInput).Return(nil).Once()
 				return svc
 			},
@@ -228,6 +231,11 @@ func TestResolver_UpdateRuntime(t *testing.T) {
 				conv.On("ToGraphQL", modelRuntime).Return(gqlRuntime).Once()
 				return conv
 			},
+			EventingSvcFn: func() *automock.EventingService {
+				svc := &automock.EventingService{}
+				svc.On("ReelectForRuntime", contextParam, runtimeUUID).Return(nil).Once()
+				return svc
+			},
 			RuntimeID:       runtimeID,
 			Input:           gqlInput,
 			ExpectedRuntime: gqlRuntime,
@@ -256,6 +264,9 @@ func TestResolver_UpdateRuntime(t *testing.T) {
 				conv.On("InputFromGraphQL", gqlInput).Return(modelInput).Once()
 				return conv
 			},
+			EventingSvcFn: func() *automock.EventingService {
+				return &automock.EventingService{}
+			},
 			RuntimeID:       runtimeID,
 			Input:           gqlInput,
 			ExpectedRuntime: nil,
@@ -277,7 +288,7 @@ func TestResolver_UpdateRuntime(t *testing.T) {
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Update", contextParam, runtimeID, modelInput).Return(nil).Once()
-				svc.On("Get", contextParam, "foo").Return(nil, testErr).Once()
+				svc.On("Get", contextParam, runtimeID).Return(nil, testErr).Once()
 				return svc
 			},
 			ConverterFn: func() *automock.RuntimeConverter {
@@ -285,11 +296,49 @@ func TestResolver_UpdateRuntime(t *testing.T) {
 				conv.On("InputFromGraphQL", gqlInput).Return(modelInput).Once()
 				return conv
 			},
+			EventingSvcFn: func() *automock.EventingService {
+				svc := &automock.EventingService{}
+				svc.On("ReelectForRuntime", contextParam, runtimeUUID).Return(nil).Once()
+				return svc
+			},
 			RuntimeID:       runtimeID,
 			Input:           gqlInput,
 			ExpectedRuntime: nil,
 			ExpectedErr:     testErr,
 		},
+		{
+			Name: "Returns error when choosing new default runtime for eventing failed",
+			PersistenceFn: func() *persistenceautomock.PersistenceTx {
+				persistTx := &persistenceautomock.PersistenceTx{}
+				return persistTx
+			},
+			TransactionerFn: func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner {
+				transact := &persistenceautomock.Transactioner{}
+				transact.On("Begin").Return(persistTx, nil).Once()
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Once()
+
+				return transact
+			},
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("Update", contextParam, runtimeID, modelInput).Return(nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				conv := &automock.RuntimeConverter{}
+				conv.On("InputFromGraphQL", gqlInput).Return(modelInput).Once()
+				return conv
+			},
+			EventingSvcFn: func() *automock.EventingService {
+				svc := &automock.EventingService{}
+				svc.On("ReelectForRuntime", contextParam, runtimeUUID).Return(testErr).Once()
+				return svc
+			},
+			RuntimeID:       runtimeID,
+			Input:           gqlInput,
+			ExpectedRuntime: nil,
+			ExpectedErr:     errors.Wrap(testErr, "while choosing new default runtime for eventing for applications"),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -298,25 +347,33 @@ func TestResolver_UpdateRuntime(t *testing.T) {
 			transact := testCase.TransactionerFn(persistTx)
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
+			eventingSvc := testCase.EventingSvcFn()
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil)
+			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, eventingSvc)
 
 			// when
 			result, err := resolver.UpdateRuntime(context.TODO(), testCase.RuntimeID, testCase.Input)
 
 			// then
 			assert.Equal(t, testCase.ExpectedRuntime, result)
-			assert.Equal(t, testCase.ExpectedErr, err)
+			if testCase.ExpectedErr != nil {
+				assert.EqualError(t, err, testCase.ExpectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
 
-			mock.AssertExpectationsForObjects(t, svc, converter, transact, persistTx)
+			mock.AssertExpectationsForObjects(t, svc, converter, transact, persistTx, eventingSvc)
 		})
 	}
 }
 
 func TestResolver_DeleteRuntime(t *testing.T) {
 	// given
-	modelRuntime := fixModelRuntime(t, "foo", "tenant-foo", "Foo", "Bar")
-	gqlRuntime := fixGQLRuntime(t, "foo", "Foo", "Bar")
+	runtimeID := "f35c0a8a-7a16-4a43-a2b8-13b42a2c1a2f"
+	runtimeUUID := uuid.MustParse(runtimeID)
+	appIDs := []uuid.UUID{uuid.MustParse("9a4d3ac1-1b5b-4d6c-9d0e-0f0b5f6a2d3e")}
+	modelRuntime := fixModelRuntime(t, runtimeID, "tenant-foo", "Foo", "Bar")
+	gqlRuntime := fixGQLRuntime(t, runtimeID, "Foo", "Bar")
 	testErr := errors.New("Test error")
 	scenariosNotFoundErr := apperrors.NewNotFoundError(resource.Label, "")
 	scenarioAssignmentNotFoundErr := apperrors.NewNotFoundError(resource.AutomaticScenarioAssigment, "")
@@ -326,6 +383,21 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 	singleScenarioLabel := &model.Label{Key: model.ScenariosKey, Value: []interface{}{"scenario-0"}}
 	multiScenariosLabel := &model.Label{Key: model.ScenariosKey, Value: []interface{}{"scenario-0", "scenario-1", "scenario-2", "scenario-3"}}
 
+	eventingSvcThatReelects := func() *automock.EventingService {
+		svc := &automock.EventingService{}
+		svc.On("GetApplicationsForDefaultRuntime", contextParam, runtimeUUID).Return(appIDs, nil).Once()
+		svc.On("ReelectForApplications", contextParam, appIDs).Return(nil).Once()
+		return svc
+	}
+	eventingSvcThatGetsApplications := func() *automock.EventingService {
+		svc := &automock.EventingService{}
+		svc.On("GetApplicationsForDefaultRuntime", contextParam, runtimeUUID).Return(appIDs, nil).Once()
+		return svc
+	}
+	emptyEventingSvc := func() *automock.EventingService {
+		return &automock.EventingService{}
+	}
+
 	testCases := []struct {
 		Name                 string
 		TransactionerFn      func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
@@ -334,6 +406,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		SysAuthServiceFn     func() *automock.SystemAuthService
 		OAuth20ServiceFn     func() *automock.OAuth20Service
 		ConverterFn          func() *automock.RuntimeConverter
+		EventingServiceFn    func() *automock.EventingService
 		InputID              string
 		ExpectedRuntime      *graphql.Runtime
 		ExpectedErr          error
@@ -343,9 +416,9 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
-				svc.On("Delete", contextParam, "foo").Return(nil).Once()
-				svc.On("GetLabel", contextParam, "foo", model.ScenariosKey).Return(nil, scenariosNotFoundErr).Once()
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
+				svc.On("Delete", contextParam, runtimeID).Return(nil).Once()
+				svc.On("GetLabel", contextParam, runtimeID, model.ScenariosKey).Return(nil, scenariosNotFoundErr).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -368,18 +441,19 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 
 				return svc
 			},
-			InputID:         "foo",
-			ExpectedRuntime: gqlRuntime,
-			ExpectedErr:     nil,
+			EventingServiceFn: eventingSvcThatReelects,
+			InputID:           runtimeID,
+			ExpectedRuntime:   gqlRuntime,
+			ExpectedErr:       nil,
 		},
 		{
 			Name:            "Returns error when runtime deletion failed",
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
-				svc.On("Delete", contextParam, "foo").Return(testErr).Once()
-				svc.On("GetLabel", contextParam, "foo", model.ScenariosKey).Return(nil, scenariosNotFoundErr).Once()
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
+				svc.On("Delete", contextParam, runtimeID).Return(testErr).Once()
+				svc.On("GetLabel", contextParam, runtimeID, model.ScenariosKey).Return(nil, scenariosNotFoundErr).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -402,16 +476,17 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 
 				return svc
 			},
-			InputID:         "foo",
-			ExpectedRuntime: nil,
-			ExpectedErr:     testErr,
+			EventingServiceFn: eventingSvcThatGetsApplications,
+			InputID:           runtimeID,
+			ExpectedRuntime:   nil,
+			ExpectedErr:       testErr,
 		},
 		{
 			Name:            "Returns error when runtime retrieval failed",
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(nil, testErr).Once()
+				svc.On("Get", contextParam, runtimeID).Return(nil, testErr).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -430,9 +505,10 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc := &automock.OAuth20Service{}
 				return svc
 			},
-			InputID:         "foo",
-			ExpectedRuntime: nil,
-			ExpectedErr:     testErr,
+			EventingServiceFn: emptyEventingSvc,
+			InputID:           runtimeID,
+			ExpectedRuntime:   nil,
+			ExpectedErr:       testErr,
 		},
 		{
 			Name:            "Returns error when transaction starting failed",
@@ -457,18 +533,19 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc := &automock.OAuth20Service{}
 				return svc
 			},
-			InputID:         "foo",
-			ExpectedRuntime: nil,
-			ExpectedErr:     testErr,
+			EventingServiceFn: emptyEventingSvc,
+			InputID:           runtimeID,
+			ExpectedRuntime:   nil,
+			ExpectedErr:       testErr,
 		},
 		{
 			Name:            "Returns error when transaction commit failed",
 			TransactionerFn: txGen.ThatFailsOnCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
 				svc.On("Delete", contextParam, modelRuntime.ID).Return(nil)
-				svc.On("GetLabel", contextParam, "foo", model.ScenariosKey).Return(nil, scenariosNotFoundErr).Once()
+				svc.On("GetLabel", contextParam, runtimeID, model.ScenariosKey).Return(nil, scenariosNotFoundErr).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -490,16 +567,17 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc.On("DeleteMultipleClientCredentials", contextParam, testAuths).Return(nil)
 				return svc
 			},
-			InputID:         "foo",
-			ExpectedRuntime: nil,
-			ExpectedErr:     testErr,
+			EventingServiceFn: eventingSvcThatReelects,
+			InputID:           runtimeID,
+			ExpectedRuntime:   nil,
+			ExpectedErr:       testErr,
 		},
 		{
 			Name:            "Return error when listing all auths failed",
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -519,16 +597,17 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc := &automock.OAuth20Service{}
 				return svc
 			},
-			InputID:         "foo",
-			ExpectedRuntime: nil,
-			ExpectedErr:     testErr,
+			EventingServiceFn: emptyEventingSvc,
+			InputID:           runtimeID,
+			ExpectedRuntime:   nil,
+			ExpectedErr:       testErr,
 		},
 		{
 			Name:            "Return error when removing oauth from hydra",
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
 
 				return svc
 			},
@@ -550,17 +629,18 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc.On("DeleteMultipleClientCredentials", contextParam, testAuths).Return(testErr)
 				return svc
 			},
-			InputID:         "foo",
-			ExpectedRuntime: nil,
-			ExpectedErr:     testErr,
+			EventingServiceFn: emptyEventingSvc,
+			InputID:           runtimeID,
+			ExpectedRuntime:   nil,
+			ExpectedErr:       testErr,
 		},
 		{
 			Name:            "Returns error when listing scenarios label",
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
-				svc.On("GetLabel", contextParam, "foo", model.ScenariosKey).Return(nil, testErr)
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
+				svc.On("GetLabel", contextParam, runtimeID, model.ScenariosKey).Return(nil, testErr)
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -582,18 +662,19 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 
 				return svc
 			},
-			InputID:         "foo",
-			ExpectedRuntime: nil,
-			ExpectedErr:     testErr,
+			EventingServiceFn: emptyEventingSvc,
+			InputID:           runtimeID,
+			ExpectedRuntime:   nil,
+			ExpectedErr:       testErr,
 		},
 		{
 			Name:            "Returns empty scenarios when listing scenarios label should succeed",
 			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
-				svc.On("GetLabel", contextParam, "foo", model.ScenariosKey).Return(emptyScenariosLabel, nil)
-				svc.On("Delete", contextParam, "foo").Return(nil).Once()
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
+				svc.On("GetLabel", contextParam, runtimeID, model.ScenariosKey).Return(emptyScenariosLabel, nil)
+				svc.On("Delete", contextParam, runtimeID).Return(nil).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -617,17 +698,18 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 
 				return svc
 			},
-			InputID:         "foo",
-			ExpectedRuntime: gqlRuntime,
-			ExpectedErr:     nil,
+			EventingServiceFn: eventingSvcThatReelects,
+			InputID:           runtimeID,
+			ExpectedRuntime:   gqlRuntime,
+			ExpectedErr:       nil,
 		},
 		{
 			Name:            "Returns scenario when listing scenarios label and error when listing scenario assignments should fail",
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
-				svc.On("GetLabel", contextParam, "foo", model.ScenariosKey).Return(singleScenarioLabel, nil)
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
+				svc.On("GetLabel", contextParam, runtimeID, model.ScenariosKey).Return(singleScenarioLabel, nil)
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -653,18 +735,19 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 
 				return svc
 			},
-			InputID:         "foo",
-			ExpectedRuntime: nil,
-			ExpectedErr:     testErr,
+			EventingServiceFn: emptyEventingSvc,
+			InputID:           runtimeID,
+			ExpectedRuntime:   nil,
+			ExpectedErr:       testErr,
 		},
 		{
 			Name:            "Returns scenario when listing scenarios label and not found when listing scenario assignments should succeed",
 			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
-				svc.On("GetLabel", contextParam, "foo", model.ScenariosKey).Return(singleScenarioLabel, nil)
-				svc.On("Delete", contextParam, "foo").Return(nil).Once()
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
+				svc.On("GetLabel", contextParam, runtimeID, model.ScenariosKey).Return(singleScenarioLabel, nil)
+				svc.On("Delete", contextParam, runtimeID).Return(nil).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -690,17 +773,18 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 
 				return svc
 			},
-			InputID:         "foo",
-			ExpectedRuntime: gqlRuntime,
-			ExpectedErr:     nil,
+			EventingServiceFn: eventingSvcThatReelects,
+			InputID:           runtimeID,
+			ExpectedRuntime:   gqlRuntime,
+			ExpectedErr:       nil,
 		},
 		{
 			Name:            "Returns scenario when listing scenarios label and scenario assignment when listing scenario assignments but fails on deletion of scenario assignment should fail",
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
-				svc.On("GetLabel", contextParam, "foo", model.ScenariosKey).Return(singleScenarioLabel, nil)
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
+				svc.On("GetLabel", contextParam, runtimeID, model.ScenariosKey).Return(singleScenarioLabel, nil)
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -727,18 +811,19 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 
 				return svc
 			},
-			InputID:         "foo",
-			ExpectedRuntime: nil,
-			ExpectedErr:     testErr,
+			EventingServiceFn: emptyEventingSvc,
+			InputID:           runtimeID,
+			ExpectedRuntime:   nil,
+			ExpectedErr:       testErr,
 		},
 		{
 			Name:            "Returns scenario when listing scenarios label and scenario assignment when listing scenario assignments and succeeds on deletion of scenario assignment should succeed",
 			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
-				svc.On("GetLabel", contextParam, "foo", model.ScenariosKey).Return(singleScenarioLabel, nil)
-				svc.On("Delete", contextParam, "foo").Return(nil).Once()
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
+				svc.On("GetLabel", contextParam, runtimeID, model.ScenariosKey).Return(singleScenarioLabel, nil)
+				svc.On("Delete", contextParam, runtimeID).Return(nil).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -766,18 +851,19 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 
 				return svc
 			},
-			InputID:         "foo",
-			ExpectedRuntime: gqlRuntime,
-			ExpectedErr:     nil,
+			EventingServiceFn: eventingSvcThatReelects,
+			InputID:           runtimeID,
+			ExpectedRuntime:   gqlRuntime,
+			ExpectedErr:       nil,
 		},
 		{
 			Name:            "Returns multiple scenarios when listing scenarios label and only some are created by a scenario assignment should succeed",
 			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
-				svc.On("GetLabel", contextParam, "foo", model.ScenariosKey).Return(multiScenariosLabel, nil)
-				svc.On("Delete", contextParam, "foo").Return(nil).Once()
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
+				svc.On("GetLabel", contextParam, runtimeID, model.ScenariosKey).Return(multiScenariosLabel, nil)
+				svc.On("Delete", contextParam, runtimeID).Return(nil).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -815,18 +901,19 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 
 				return svc
 			},
-			InputID:         "foo",
-			ExpectedRuntime: gqlRuntime,
-			ExpectedErr:     nil,
+			EventingServiceFn: eventingSvcThatReelects,
+			InputID:           runtimeID,
+			ExpectedRuntime:   gqlRuntime,
+			ExpectedErr:       nil,
 		},
 		{
 			Name:            "Returns multiple scenarios when listing scenarios label and all are created by a scenario assignment should succeed",
 			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
-				svc.On("GetLabel", contextParam, "foo", model.ScenariosKey).Return(multiScenariosLabel, nil)
-				svc.On("Delete", contextParam, "foo").Return(nil).Once()
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
+				svc.On("GetLabel", contextParam, runtimeID, model.ScenariosKey).Return(multiScenariosLabel, nil)
+				svc.On("Delete", contextParam, runtimeID).Return(nil).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -867,18 +954,19 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 
 				return svc
 			},
-			InputID:         "foo",
-			ExpectedRuntime: gqlRuntime,
-			ExpectedErr:     nil,
+			EventingServiceFn: eventingSvcThatReelects,
+			InputID:           runtimeID,
+			ExpectedRuntime:   gqlRuntime,
+			ExpectedErr:       nil,
 		},
 		{
 			Name:            "Returns multiple scenarios when listing scenarios label and none are created by a scenario assignment should succeed",
 			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
-				svc.On("GetLabel", contextParam, "foo", model.ScenariosKey).Return(multiScenariosLabel, nil)
-				svc.On("Delete", contextParam, "foo").Return(nil).Once()
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
+				svc.On("GetLabel", contextParam, runtimeID, model.ScenariosKey).Return(multiScenariosLabel, nil)
+				svc.On("Delete", contextParam, runtimeID).Return(nil).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -911,9 +999,82 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 
 				return svc
 			},
-			InputID:         "foo",
-			ExpectedRuntime: gqlRuntime,
-			ExpectedErr:     nil,
+			EventingServiceFn: eventingSvcThatReelects,
+			InputID:           runtimeID,
+			ExpectedRuntime:   gqlRuntime,
+			ExpectedErr:       nil,
+		},
+		{
+			Name:            "Returns error when getting applications for default runtime failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
+				svc.On("GetLabel", contextParam, runtimeID, model.ScenariosKey).Return(nil, scenariosNotFoundErr).Once()
+				return svc
+			},
+			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
+				return &automock.ScenarioAssignmentService{}
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				return &automock.RuntimeConverter{}
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("ListForObject", contextParam, model.RuntimeReference, modelRuntime.ID).Return(testAuths, nil)
+				return svc
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				svc := &automock.OAuth20Service{}
+				svc.On("DeleteMultipleClientCredentials", contextParam, testAuths).Return(nil)
+				return svc
+			},
+			EventingServiceFn: func() *automock.EventingService {
+				svc := &automock.EventingService{}
+				svc.On("GetApplicationsForDefaultRuntime", contextParam, runtimeUUID).Return(nil, testErr).Once()
+				return svc
+			},
+			InputID:         runtimeID,
+			ExpectedRuntime: nil,
+			ExpectedErr:     errors.Wrap(testErr, "while getting applications with the runtime as default for eventing"),
+		},
+		{
+			Name:            "Returns error when choosing new default runtime for eventing failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("Get", contextParam, runtimeID).Return(modelRuntime, nil).Once()
+				svc.On("Delete", contextParam, runtimeID).Return(nil).Once()
+				svc.On("GetLabel", contextParam, runtimeID, model.ScenariosKey).Return(nil, scenariosNotFoundErr).Once()
+				return svc
+			},
+			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
+				return &automock.ScenarioAssignmentService{}
+			},
+			ConverterFn: func() *automock.RuntimeConverter {
+				conv := &automock.RuntimeConverter{}
+				conv.On("ToGraphQL", modelRuntime).Return(gqlRuntime).Once()
+				return conv
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("ListForObject", contextParam, model.RuntimeReference, modelRuntime.ID).Return(testAuths, nil)
+				return svc
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				svc := &automock.OAuth20Service{}
+				svc.On("DeleteMultipleClientCredentials", contextParam, testAuths).Return(nil)
+				return svc
+			},
+			EventingServiceFn: func() *automock.EventingService {
+				svc := &automock.EventingService{}
+				svc.On("GetApplicationsForDefaultRuntime", contextParam, runtimeUUID).Return(appIDs, nil).Once()
+				svc.On("ReelectForApplications", contextParam, appIDs).Return(testErr).Once()
+				return svc
+			},
+			InputID:         runtimeID,
+			ExpectedRuntime: nil,
+			ExpectedErr:     errors.Wrap(testErr, "while choosing new default runtime for eventing for applications"),
 		},
 	}
 
@@ -925,8 +1086,9 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 			converter := testCase.ConverterFn()
 			sysAuthSvc := testCase.SysAuthServiceFn()
 			oAuth20Svc := testCase.OAuth20ServiceFn()
+			eventingSvc := testCase.EventingServiceFn()
 
-			resolver := runtime.NewResolver(transact, svc, scenarioAssignmentSvc, sysAuthSvc, oAuth20Svc, converter, nil, nil)
+			resolver := runtime.NewResolver(transact, svc, scenarioAssignmentSvc, sysAuthSvc, oAuth20Svc, converter, nil, eventingSvc)
 
 			// when
 			result, err := resolver.DeleteRuntime(context.TODO(), testCase.InputID)
@@ -939,7 +1101,7 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, svc, scenarioAssignmentSvc, converter, transact, persistTx, sysAuthSvc, oAuth20Svc)
+			mock.AssertExpectationsForObjects(t, svc, scenarioAssignmentSvc, converter, transact, persistTx, sysAuthSvc, oAuth20Svc, eventingSvc)
 		})
 	}
 }
@@ -1298,6 +1460,39 @@ func TestResolver_SetRuntimeLabel(t *testing.T) {
 		})
 	}
 
+	t.Run("Chooses new default runtime for eventing when scenarios label is set", func(t *testing.T) {
+		// given
+		runtimeUUID := uuid.New()
+		scenarios := []interface{}{"DEFAULT"}
+		scenariosLabelInput := &model.LabelInput{
+			Key:        model.ScenariosKey,
+			Value:      scenarios,
+			ObjectID:   runtimeUUID.String(),
+			ObjectType: model.RuntimeLabelableObject,
+		}
+		persistTx := &persistenceautomock.PersistenceTx{}
+		persistTx.On("Commit").Return(nil).Once()
+		transact := &persistenceautomock.Transactioner{}
+		transact.On("Begin").Return(persistTx, nil).Once()
+		transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Once()
+		svc := &automock.RuntimeService{}
+		svc.On("SetLabel", contextParam, scenariosLabelInput).Return(nil).Once()
+		svc.On("GetLabel", contextParam, runtimeUUID.String(), model.ScenariosKey).Return(&model.Label{Key: model.ScenariosKey, Value: scenarios}, nil).Once()
+		eventingSvc := &automock.EventingService{}
+		eventingSvc.On("ReelectForRuntime", contextParam, runtimeUUID).Return(nil).Once()
+
+		resolver := runtime.NewResolver(transact, svc, nil, nil, nil, nil, nil, eventingSvc)
+
+		// when
+		result, err := resolver.SetRuntimeLabel(context.TODO(), runtimeUUID.String(), model.ScenariosKey, scenarios)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.Label{Key: model.ScenariosKey, Value: scenarios}, result)
+
+		mock.AssertExpectationsForObjects(t, svc, transact, persistTx, eventingSvc)
+	})
+
 	t.Run("Returns error when Label input validation failed", func(t *testing.T) {
 		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil)
 
@@ -1443,6 +1638,33 @@ func TestResolver_DeleteRuntimeLabel(t *testing.T) {
 			mock.AssertExpectationsForObjects(t, svc, converter, transact, persistTx)
 		})
 	}
+
+	t.Run("Chooses new default runtime for eventing when scenarios label is deleted", func(t *testing.T) {
+		// given
+		runtimeUUID := uuid.New()
+		scenarios := []interface{}{"DEFAULT"}
+		persistTx := &persistenceautomock.PersistenceTx{}
+		persistTx.On("Commit").Return(nil).Once()
+		transact := &persistenceautomock.Transactioner{}
+		transact.On("Begin").Return(persistTx, nil).Once()
+		transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Once()
+		svc := &automock.RuntimeService{}
+		svc.On("GetLabel", contextParam, runtimeUUID.String(), model.ScenariosKey).Return(&model.Label{Key: model.ScenariosKey, Value: scenarios}, nil).Once()
+		svc.On("DeleteLabel", contextParam, runtimeUUID.String(), model.ScenariosKey).Return(nil).Once()
+		eventingSvc := &automock.EventingService{}
+		eventingSvc.On("ReelectForRuntime", contextParam, runtimeUUID).Return(nil).Once()
+
+		resolver := runtime.NewResolver(transact, svc, nil, nil, nil, nil, nil, eventingSvc)
+
+		// when
+		result, err := resolver.DeleteRuntimeLabel(context.TODO(), runtimeUUID.String(), model.ScenariosKey)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.Label{Key: model.ScenariosKey, Value: scenarios}, result)
+
+		mock.AssertExpectationsForObjects(t, svc, transact, persistTx, eventingSvc)
+	})
 }
 
 func TestResolver_Labels(t *testing.T) {
@@ -1829,6 +2051,142 @@ func TestResolver_Auths(t *testing.T) {
 	})
 }
 
+func TestResolver_AuthsDataLoader(t *testing.T) {
+	// GIVEN
+	tnt := "tnt"
+	externalTnt := "external-tnt"
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
+
+	firstRuntimeID := "foo"
+	secondRuntimeID := "bar"
+	keys := []dataloader.ParamID{{ID: firstRuntimeID, Ctx: ctx}, {ID: secondRuntimeID, Ctx: ctx}}
+	runtimeIDs := []string{firstRuntimeID, secondRuntimeID}
+
+	modelSysAuths := []model.SystemAuth{
+		fixModelSystemAuth("bar", tnt, firstRuntimeID, fixModelAuth()),
+		fixModelSystemAuth("baz", tnt, firstRuntimeID, fixModelAuth()),
+		fixModelSystemAuth("faz", tnt, secondRuntimeID, fixModelAuth()),
+	}
+	modelSysAuthsByRuntimeID := map[string][]model.SystemAuth{
+		firstRuntimeID:  modelSysAuths[:2],
+		secondRuntimeID: modelSysAuths[2:],
+	}
+
+	gqlSysAuths := []*graphql.SystemAuth{
+		fixGQLSystemAuth("bar", fixGQLAuth()),
+		fixGQLSystemAuth("baz", fixGQLAuth()),
+		fixGQLSystemAuth("faz", fixGQLAuth()),
+	}
+
+	testErr := errors.New("this is a test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SysAuthSvcFn    func() *automock.SystemAuthService
+		SysAuthConvFn   func() *automock.SystemAuthConverter
+		ExpectedOutput  [][]*graphql.SystemAuth
+		ExpectedError   error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			SysAuthSvcFn: func() *automock.SystemAuthService {
+				sysAuthSvc := &automock.SystemAuthService{}
+				sysAuthSvc.On("ListForObjectIDs", txtest.CtxWithDBMatcher(), model.RuntimeReference, runtimeIDs).Return(modelSysAuthsByRuntimeID, nil).Once()
+				return sysAuthSvc
+			},
+			SysAuthConvFn: func() *automock.SystemAuthConverter {
+				sysAuthConv := &automock.SystemAuthConverter{}
+				sysAuthConv.On("ToGraphQL", &modelSysAuths[0]).Return(gqlSysAuths[0], nil).Once()
+				sysAuthConv.On("ToGraphQL", &modelSysAuths[1]).Return(gqlSysAuths[1], nil).Once()
+				sysAuthConv.On("ToGraphQL", &modelSysAuths[2]).Return(gqlSysAuths[2], nil).Once()
+				return sysAuthConv
+			},
+			ExpectedOutput: [][]*graphql.SystemAuth{gqlSysAuths[:2], gqlSysAuths[2:]},
+			ExpectedError:  nil,
+		},
+		{
+			Name:            "Error when listing for objects",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			SysAuthSvcFn: func() *automock.SystemAuthService {
+				sysAuthSvc := &automock.SystemAuthService{}
+				sysAuthSvc.On("ListForObjectIDs", txtest.CtxWithDBMatcher(), model.RuntimeReference, runtimeIDs).Return(nil, testErr).Once()
+				return sysAuthSvc
+			},
+			SysAuthConvFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedOutput: nil,
+			ExpectedError:  testErr,
+		},
+		{
+			Name:            "Error when beginning transaction",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			SysAuthSvcFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			SysAuthConvFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedOutput: nil,
+			ExpectedError:  testErr,
+		},
+		{
+			Name:            "Error when committing transaction",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			SysAuthSvcFn: func() *automock.SystemAuthService {
+				sysAuthSvc := &automock.SystemAuthService{}
+				sysAuthSvc.On("ListForObjectIDs", txtest.CtxWithDBMatcher(), model.RuntimeReference, runtimeIDs).Return(modelSysAuthsByRuntimeID, nil).Once()
+				return sysAuthSvc
+			},
+			SysAuthConvFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedOutput: nil,
+			ExpectedError:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			sysAuthSvc := testCase.SysAuthSvcFn()
+			sysAuthConv := testCase.SysAuthConvFn()
+
+			resolver := runtime.NewResolver(transact, nil, nil, sysAuthSvc, nil, nil, sysAuthConv, nil)
+
+			// WHEN
+			result, errs := resolver.AuthsDataLoader(keys)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.Nil(t, errs)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, sysAuthSvc, sysAuthConv, transact, persist)
+		})
+	}
+
+	t.Run("Error when there are no keys", func(t *testing.T) {
+		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		result, errs := resolver.AuthsDataLoader(nil)
+
+		// THEN
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Error(), "No Runtimes found")
+		assert.Nil(t, result)
+	})
+}
+
 func TestResolver_EventingConfiguration(t *testing.T) {
 	// GIVEN
 	tnt := "tnt"
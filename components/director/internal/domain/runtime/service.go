@@ -10,6 +10,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 
@@ -17,7 +18,11 @@ import (
 	"github.com/pkg/errors"
 )
 
-const IsNormalizedLabel = "isNormalized"
+const (
+	IsNormalizedLabel = "isNormalized"
+	// IntegrationSystemLabel holds the ID of the Integration System which registered the Runtime. It is set by the Director and cannot be modified through the API.
+	IntegrationSystemLabel = "integrationSystemID"
+)
 
 //go:generate mockery -name=RuntimeRepository -output=automock -outpkg=automock -case=underscore
 type RuntimeRepository interface {
@@ -73,6 +78,7 @@ type service struct {
 	scenarioAssignmentEngine ScenarioAssignmentEngine
 
 	protectedLabelPattern string
+	readOnlyLabelPattern  string
 }
 
 func NewService(repo RuntimeRepository,
@@ -90,6 +96,7 @@ func NewService(repo RuntimeRepository,
 		uidService:               uidService,
 		scenarioAssignmentEngine: scenarioAssignmentEngine,
 		protectedLabelPattern:    protectedLabelPattern,
+		readOnlyLabelPattern:     fmt.Sprintf("^%s$|%s", IntegrationSystemLabel, protectedLabelPattern),
 	}
 }
 
@@ -191,12 +198,16 @@ func (s *service) Create(ctx context.Context, in model.RuntimeInput) (string, er
 	}
 
 	log.C(ctx).Debugf("Removing protected labels. Labels before: %+v", in.Labels)
-	in.Labels, err = unsafeExtractUnProtectedLabels(in.Labels, s.protectedLabelPattern)
+	in.Labels, err = unsafeExtractUnProtectedLabels(in.Labels, s.readOnlyLabelPattern)
 	if err != nil {
 		return "", err
 	}
 	log.C(ctx).Debugf("Successfully stripped protected labels. Resulting labels after operation are: %+v", in.Labels)
 
+	if consumerInfo, err := consumer.LoadFromContext(ctx); err == nil && consumerInfo.ConsumerType == consumer.IntegrationSystem {
+		in.Labels[IntegrationSystemLabel] = consumerInfo.ConsumerID
+	}
+
 	err = s.labelUpsertService.UpsertMultipleLabels(ctx, rtmTenant, model.RuntimeLabelableObject, id, in.Labels)
 	if err != nil {
 		return id, errors.Wrapf(err, "while creating multiple labels for Runtime")
@@ -231,14 +242,14 @@ func (s *service) Update(ctx context.Context, id string, in model.RuntimeInput)
 	}
 
 	log.C(ctx).Debugf("Removing protected labels. Labels before: %+v", in.Labels)
-	in.Labels, err = unsafeExtractUnProtectedLabels(in.Labels, s.protectedLabelPattern)
+	in.Labels, err = unsafeExtractUnProtectedLabels(in.Labels, s.readOnlyLabelPattern)
 	if err != nil {
 		return err
 	}
 	log.C(ctx).Debugf("Successfully stripped protected labels. Resulting labels after operation are: %+v", in.Labels)
 
 	// NOTE: The db layer does not support OR currently so multiple label patterns can't be implemented easily
-	err = s.labelRepo.DeleteByKeyNegationPattern(ctx, rtmTenant, model.RuntimeLabelableObject, id, s.protectedLabelPattern)
+	err = s.labelRepo.DeleteByKeyNegationPattern(ctx, rtmTenant, model.RuntimeLabelableObject, id, s.readOnlyLabelPattern)
 	if err != nil {
 		return errors.Wrapf(err, "while deleting all labels for Runtime")
 	}
@@ -308,7 +319,7 @@ func (s *service) SetLabel(ctx context.Context, labelInput *model.LabelInput) er
 		return err
 	}
 
-	protected, err := isProtected(labelInput.Key, s.protectedLabelPattern)
+	protected, err := isProtected(labelInput.Key, s.readOnlyLabelPattern)
 	if err != nil {
 		return err
 	}
@@ -398,7 +409,7 @@ func (s *service) DeleteLabel(ctx context.Context, runtimeID string, key string)
 		return err
 	}
 
-	protected, err := isProtected(key, s.protectedLabelPattern)
+	protected, err := isProtected(key, s.readOnlyLabelPattern)
 	if err != nil {
 		return err
 	}
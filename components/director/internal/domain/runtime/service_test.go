@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
@@ -272,6 +273,43 @@ func TestService_Create(t *testing.T) {
 		})
 	}
 
+	t.Run("Success sets Integration System label when registered by Integration System", func(t *testing.T) {
+		// given
+		intSysID := "int-sys"
+		intSysCtx := consumer.SaveToContext(ctx, consumer.Consumer{ConsumerID: intSysID, ConsumerType: consumer.IntegrationSystem})
+		in := model.RuntimeInput{
+			Name: "foo.bar-not",
+			Labels: map[string]interface{}{
+				runtime.IntegrationSystemLabel: "spoofed",
+			},
+		}
+		expectedLabels := map[string]interface{}{
+			runtime.IsNormalizedLabel:      "true",
+			runtime.IntegrationSystemLabel: intSysID,
+		}
+
+		repo := &automock.RuntimeRepository{}
+		repo.On("Create", intSysCtx, mock.Anything).Return(nil).Once()
+		scenariosSvc := &automock.ScenariosService{}
+		scenariosSvc.On("EnsureScenariosLabelDefinitionExists", intSysCtx, tnt).Return(nil).Once()
+		scenariosSvc.On("AddDefaultScenarioIfEnabled", intSysCtx, mock.Anything).Once()
+		labelSvc := &automock.LabelUpsertService{}
+		labelSvc.On("UpsertMultipleLabels", intSysCtx, tnt, model.RuntimeLabelableObject, id, expectedLabels).Return(nil).Once()
+		idSvc := &automock.UIDService{}
+		idSvc.On("Generate").Return(id).Once()
+		engineSvc := &automock.ScenarioAssignmentEngine{}
+		engineSvc.On("MergeScenariosFromInputLabelsAndAssignments", intSysCtx, mock.Anything).Return(nil, nil).Once()
+		defer mock.AssertExpectationsForObjects(t, repo, scenariosSvc, labelSvc, idSvc, engineSvc)
+
+		svc := runtime.NewService(repo, nil, scenariosSvc, labelSvc, idSvc, engineSvc, ".*_defaultEventing$")
+
+		// when
+		_, err := svc.Create(intSysCtx, in)
+
+		// then
+		require.NoError(t, err)
+	})
+
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
 		svc := runtime.NewService(nil, nil, nil, nil, nil, nil, ".*_defaultEventing$")
@@ -1770,6 +1808,36 @@ func TestService_SetLabel(t *testing.T) {
 			InputLabel:         &modelProtectedLabelInput,
 			ExpectedErrMessage: "could not set protected label key protected_defaultEventing",
 		},
+		{
+			Name: "Returns an error when trying to set Integration System label",
+			RepositoryFn: func() *automock.RuntimeRepository {
+				repo := &automock.RuntimeRepository{}
+				repo.On("Exists", ctx, tnt, runtimeID).Return(true, nil).Once()
+				return repo
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				svc := &automock.LabelUpsertService{}
+				return svc
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", ctx, tnt, model.RuntimeLabelableObject, runtimeID).Return(labelMap, nil).Once()
+				repo.On("Delete", ctx, tnt, model.RuntimeLabelableObject, runtimeID, model.ScenariosKey).Return(nil).Once()
+				return repo
+			},
+			EngineServiceFn: func() *automock.ScenarioAssignmentEngine {
+				var nilInterface []interface{}
+
+				svc := &automock.ScenarioAssignmentEngine{}
+				svc.On("GetScenariosForSelectorLabels", ctx, map[string]string{}).Return([]string{}, nil).Once()
+				svc.On("GetScenariosForSelectorLabels", ctx, map[string]string{runtime.IntegrationSystemLabel: "int-sys"}).Return([]string{}, nil).Once()
+				svc.On("MergeScenarios", nilInterface, []interface{}{}, []interface{}{}).Return([]interface{}{}, nil).Once()
+				return svc
+			},
+			InputRuntimeID:     runtimeID,
+			InputLabel:         &model.LabelInput{Key: runtime.IntegrationSystemLabel, Value: "int-sys", ObjectID: runtimeID, ObjectType: model.RuntimeLabelableObject},
+			ExpectedErrMessage: "could not set protected label key " + runtime.IntegrationSystemLabel,
+		},
 	}
 
 	for _, testCase := range testCases {
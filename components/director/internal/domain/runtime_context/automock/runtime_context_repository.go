@@ -134,6 +134,29 @@ func (_m *RuntimeContextRepository) List(ctx context.Context, runtimeID string,
 	return r0, r1
 }
 
+// ListAll provides a mock function with given fields: ctx, tenant, filter, pageSize, cursor
+func (_m *RuntimeContextRepository) ListAll(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimeContextPage, error) {
+	ret := _m.Called(ctx, tenant, filter, pageSize, cursor)
+
+	var r0 *model.RuntimeContextPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) *model.RuntimeContextPage); ok {
+		r0 = rf(ctx, tenant, filter, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RuntimeContextPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*labelfilter.LabelFilter, int, string) error); ok {
+		r1 = rf(ctx, tenant, filter, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, item
 func (_m *RuntimeContextRepository) Update(ctx context.Context, item *model.RuntimeContext) error {
 	ret := _m.Called(ctx, item)
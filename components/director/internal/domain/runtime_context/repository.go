@@ -112,6 +112,15 @@ func (r RuntimeContextCollection) Len() int {
 }
 
 func (r *pgRepository) List(ctx context.Context, runtimeID string, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimeContextPage, error) {
+	return r.list(ctx, tenant, repo.Conditions{repo.NewEqualCondition("runtime_id", runtimeID)}, filter, pageSize, cursor)
+}
+
+// ListAll lists Runtime Contexts of all Runtimes in the tenant
+func (r *pgRepository) ListAll(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimeContextPage, error) {
+	return r.list(ctx, tenant, repo.Conditions{}, filter, pageSize, cursor)
+}
+
+func (r *pgRepository) list(ctx context.Context, tenant string, conditions repo.Conditions, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimeContextPage, error) {
 	var runtimeCtxsCollection RuntimeContextCollection
 	tenantID, err := uuid.Parse(tenant)
 	if err != nil {
@@ -122,9 +131,6 @@ func (r *pgRepository) List(ctx context.Context, runtimeID string, tenant string
 		return nil, errors.Wrap(err, "while building filter query")
 	}
 
-	conditions := repo.Conditions{
-		repo.NewEqualCondition("runtime_id", runtimeID),
-	}
 	if filterSubquery != "" {
 		conditions = append(conditions, repo.NewInConditionForSubQuery("id", filterSubquery, args))
 	}
@@ -304,6 +304,60 @@ func TestPgRepository_List_WithFiltersShouldReturnRuntimeModelsForRuntimeEntitie
 	assert.Equal(t, tenantID, modelRuntimePage.Data[1].Tenant)
 }
 
+func TestPgRepository_ListAll_ShouldReturnRuntimeContextsOfAllRuntimes(t *testing.T) {
+	// given
+	tenantID := uuid.New().String()
+	runtimeID := uuid.New().String()
+	otherRuntimeID := uuid.New().String()
+	runtimeCtx1ID := uuid.New().String()
+	runtimeCtx2ID := uuid.New().String()
+
+	rowSize := 2
+
+	sqlxDB, sqlMock := testdb.MockDatabase(t)
+	defer sqlMock.AssertExpectations(t)
+
+	rows := sqlmock.NewRows([]string{"id", "runtime_id", "tenant_id", "key", "value"}).
+		AddRow(runtimeCtx1ID, runtimeID, tenantID, "key", "val").
+		AddRow(runtimeCtx2ID, otherRuntimeID, tenantID, "key", "val")
+
+	filterQuery := `  AND id IN 
+						\(SELECT "runtime_context_id" FROM public.labels 
+							WHERE "runtime_context_id" IS NOT NULL 
+							AND "tenant_id" = \$2 
+							AND "key" = \$3\)`
+	sqlQuery := fmt.Sprintf(`^SELECT (.+) FROM public.runtime_contexts 
+								WHERE tenant_id = \$1 %s ORDER BY id LIMIT %d OFFSET 0`, filterQuery, rowSize)
+
+	sqlMock.ExpectQuery(sqlQuery).
+		WithArgs(tenantID, tenantID, "foo").
+		WillReturnRows(rows)
+
+	countRows := sqlMock.NewRows([]string{"count"}).AddRow(rowSize)
+
+	countQuery := fmt.Sprintf(`^SELECT COUNT\(\*\) FROM public.runtime_contexts WHERE tenant_id = \$1 %s`, filterQuery)
+	sqlMock.ExpectQuery(countQuery).
+		WithArgs(tenantID, tenantID, "foo").
+		WillReturnRows(countRows)
+
+	ctx := persistence.SaveToContext(context.TODO(), sqlxDB)
+
+	filter := []*labelfilter.LabelFilter{{Key: "foo"}}
+
+	pgRepository := runtime_context.NewRepository()
+
+	// when
+	modelRuntimePage, err := pgRepository.ListAll(ctx, tenantID, filter, rowSize, "")
+
+	//then
+	require.NoError(t, err)
+	require.NotNil(t, modelRuntimePage)
+	assert.Equal(t, rowSize, modelRuntimePage.TotalCount)
+	require.Len(t, modelRuntimePage.Data, 2)
+	assert.Equal(t, runtimeID, modelRuntimePage.Data[0].RuntimeID)
+	assert.Equal(t, otherRuntimeID, modelRuntimePage.Data[1].RuntimeID)
+}
+
 func TestPgRepository_Create_ShouldCreateRuntimeEntityFromValidModel(t *testing.T) {
 	// given
 	runtimeID := uuid.New().String()
@@ -35,17 +35,38 @@ type RuntimeContextConverter interface {
 	InputFromGraphQL(in graphql.RuntimeContextInput, runtimeID string) model.RuntimeContextInput
 }
 
+//go:generate mockery -name=SystemAuthService -output=automock -outpkg=automock -case=underscore
+type SystemAuthService interface {
+	ListForObject(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error)
+}
+
+//go:generate mockery -name=SystemAuthConverter -output=automock -outpkg=automock -case=underscore
+type SystemAuthConverter interface {
+	ToGraphQL(in *model.SystemAuth) (*graphql.SystemAuth, error)
+}
+
+//go:generate mockery -name=OAuth20Service -output=automock -outpkg=automock -case=underscore
+type OAuth20Service interface {
+	DeleteMultipleClientCredentials(ctx context.Context, auths []model.SystemAuth) error
+}
+
 type Resolver struct {
 	transact              persistence.Transactioner
 	runtimeContextService RuntimeContextService
+	sysAuthSvc            SystemAuthService
+	oAuth20Svc            OAuth20Service
 	converter             RuntimeContextConverter
+	sysAuthConv           SystemAuthConverter
 }
 
-func NewResolver(transact persistence.Transactioner, runtimeContextService RuntimeContextService, conv RuntimeContextConverter) *Resolver {
+func NewResolver(transact persistence.Transactioner, runtimeContextService RuntimeContextService, sysAuthSvc SystemAuthService, oAuth20Svc OAuth20Service, conv RuntimeContextConverter, sysAuthConv SystemAuthConverter) *Resolver {
 	return &Resolver{
 		transact:              transact,
 		runtimeContextService: runtimeContextService,
+		sysAuthSvc:            sysAuthSvc,
+		oAuth20Svc:            oAuth20Svc,
 		converter:             conv,
+		sysAuthConv:           sysAuthConv,
 	}
 }
 
@@ -228,25 +249,19 @@ func (r *Resolver) DeleteRuntimeContext(ctx context.Context, id string) (*graphq
 		return nil, err
 	}
 
-	// TODO: Revisit when Runtime Context credentials and ASA are introduced
-	/*auths, err := r.sysAuthSvc.ListForObject(ctx, model.RuntimeReference, runtime.ID)
-	if err != nil {
-		return nil, err
+	if runtimeID != runtimeContext.RuntimeID {
+		log.C(ctx).Errorf("Runtime context owner mismatch: runtime context is owned by runtime with id %s which is different from calling runtime id %s", runtimeContext.RuntimeID, runtimeID)
+		return nil, apperrors.NewUnauthorizedError("runtime context not accessible")
 	}
 
-	err = r.oAuth20Svc.DeleteMultipleClientCredentials(ctx, auths)
+	auths, err := r.sysAuthSvc.ListForObject(ctx, model.RuntimeContextReference, runtimeContext.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	err = r.deleteAssociatedScenarioAssignments(ctx, runtime.ID)
+	err = r.oAuth20Svc.DeleteMultipleClientCredentials(ctx, auths)
 	if err != nil {
 		return nil, err
-	}*/
-
-	if runtimeID != runtimeContext.RuntimeID {
-		log.C(ctx).Errorf("Runtime context owner mismatch: runtime context is owned by runtime with id %s which is different from calling runtime id %s", runtimeContext.RuntimeID, runtimeID)
-		return nil, apperrors.NewUnauthorizedError("runtime context not accessible")
 	}
 
 	deletedRuntimeContext := r.converter.ToGraphQL(runtimeContext)
@@ -302,6 +317,41 @@ func (r *Resolver) Labels(ctx context.Context, obj *graphql.RuntimeContext, key
 	return &gqlLabels, nil
 }
 
+func (r *Resolver) Auths(ctx context.Context, obj *graphql.RuntimeContext) ([]*graphql.SystemAuth, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Runtime Context cannot be empty")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	sysAuths, err := r.sysAuthSvc.ListForObject(ctx, model.RuntimeContextReference, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*graphql.SystemAuth
+	for _, sa := range sysAuths {
+		c, err := r.sysAuthConv.ToGraphQL(&sa)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+
+	return out, nil
+}
+
 func (r *Resolver) getRuntimeID(ctx context.Context) (string, error) {
 	consumerInfo, err := consumer.LoadFromContext(ctx)
 	if err != nil {
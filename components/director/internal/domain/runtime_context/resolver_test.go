@@ -6,6 +6,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/consumer"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime_context"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
@@ -23,6 +24,7 @@ import (
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var contextParam = mock.MatchedBy(func(ctx context.Context) bool {
@@ -212,7 +214,7 @@ func TestResolver_CreateRuntimeContext(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := runtime_context.NewResolver(transact, svc, converter)
+			resolver := runtime_context.NewResolver(transact, svc, nil, nil, converter, nil)
 
 			c := testCase.Consumer
 			if c == nil {
@@ -453,7 +455,7 @@ func TestResolver_UpdateRuntimeContext(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := runtime_context.NewResolver(transact, svc, converter)
+			resolver := runtime_context.NewResolver(transact, svc, nil, nil, converter, nil)
 
 			c := testCase.Consumer
 			if c == nil {
@@ -496,6 +498,7 @@ func TestResolver_DeleteRuntimeContext(t *testing.T) {
 		Key:   key,
 		Value: val,
 	}
+	auths := []model.SystemAuth{{ID: "auth-id"}}
 	testErr := errors.New("Test error")
 
 	txGen := txtest.NewTransactionContextGenerator(testErr)
@@ -504,6 +507,8 @@ func TestResolver_DeleteRuntimeContext(t *testing.T) {
 		Name                   string
 		TransactionerFn        func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
 		ServiceFn              func() *automock.RuntimeContextService
+		SysAuthServiceFn       func() *automock.SystemAuthService
+		OAuth20ServiceFn       func() *automock.OAuth20Service
 		ConverterFn            func() *automock.RuntimeContextConverter
 		InputID                string
 		ExpectedRuntimeContext *graphql.RuntimeContext
@@ -519,6 +524,16 @@ func TestResolver_DeleteRuntimeContext(t *testing.T) {
 				svc.On("Delete", contextParam, "foo").Return(nil).Once()
 				return svc
 			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				sysAuthSvc := &automock.SystemAuthService{}
+				sysAuthSvc.On("ListForObject", contextParam, model.RuntimeContextReference, modelRuntimeContext.ID).Return(auths, nil).Once()
+				return sysAuthSvc
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				oAuth20Svc := &automock.OAuth20Service{}
+				oAuth20Svc.On("DeleteMultipleClientCredentials", contextParam, auths).Return(nil).Once()
+				return oAuth20Svc
+			},
 			ConverterFn: func() *automock.RuntimeContextConverter {
 				conv := &automock.RuntimeContextConverter{}
 				conv.On("ToGraphQL", modelRuntimeContext).Return(gqlRuntimeContext).Once()
@@ -600,6 +615,16 @@ func TestResolver_DeleteRuntimeContext(t *testing.T) {
 				svc.On("Delete", contextParam, "foo").Return(testErr).Once()
 				return svc
 			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				sysAuthSvc := &automock.SystemAuthService{}
+				sysAuthSvc.On("ListForObject", contextParam, model.RuntimeContextReference, modelRuntimeContext.ID).Return(auths, nil).Once()
+				return sysAuthSvc
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				oAuth20Svc := &automock.OAuth20Service{}
+				oAuth20Svc.On("DeleteMultipleClientCredentials", contextParam, auths).Return(nil).Once()
+				return oAuth20Svc
+			},
 			ConverterFn: func() *automock.RuntimeContextConverter {
 				conv := &automock.RuntimeContextConverter{}
 				conv.On("ToGraphQL", modelRuntimeContext).Return(gqlRuntimeContext).Once()
@@ -609,6 +634,51 @@ func TestResolver_DeleteRuntimeContext(t *testing.T) {
 			ExpectedRuntimeContext: nil,
 			ExpectedErr:            testErr,
 		},
+		{
+			Name:            "Returns error when listing system auths failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.RuntimeContextService {
+				svc := &automock.RuntimeContextService{}
+				svc.On("Get", contextParam, "foo").Return(modelRuntimeContext, nil).Once()
+				return svc
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				sysAuthSvc := &automock.SystemAuthService{}
+				sysAuthSvc.On("ListForObject", contextParam, model.RuntimeContextReference, modelRuntimeContext.ID).Return(nil, testErr).Once()
+				return sysAuthSvc
+			},
+			ConverterFn: func() *automock.RuntimeContextConverter {
+				return &automock.RuntimeContextConverter{}
+			},
+			InputID:                id,
+			ExpectedRuntimeContext: nil,
+			ExpectedErr:            testErr,
+		},
+		{
+			Name:            "Returns error when deleting client credentials failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.RuntimeContextService {
+				svc := &automock.RuntimeContextService{}
+				svc.On("Get", contextParam, "foo").Return(modelRuntimeContext, nil).Once()
+				return svc
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				sysAuthSvc := &automock.SystemAuthService{}
+				sysAuthSvc.On("ListForObject", contextParam, model.RuntimeContextReference, modelRuntimeContext.ID).Return(auths, nil).Once()
+				return sysAuthSvc
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				oAuth20Svc := &automock.OAuth20Service{}
+				oAuth20Svc.On("DeleteMultipleClientCredentials", contextParam, auths).Return(testErr).Once()
+				return oAuth20Svc
+			},
+			ConverterFn: func() *automock.RuntimeContextConverter {
+				return &automock.RuntimeContextConverter{}
+			},
+			InputID:                id,
+			ExpectedRuntimeContext: nil,
+			ExpectedErr:            testErr,
+		},
 		{
 			Name:            "Returns error when runtime context retrieval failed",
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
@@ -649,6 +719,16 @@ func TestResolver_DeleteRuntimeContext(t *testing.T) {
 				svc.On("Delete", contextParam, modelRuntimeContext.ID).Return(nil)
 				return svc
 			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				sysAuthSvc := &automock.SystemAuthService{}
+				sysAuthSvc.On("ListForObject", contextParam, model.RuntimeContextReference, modelRuntimeContext.ID).Return(auths, nil).Once()
+				return sysAuthSvc
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				oAuth20Svc := &automock.OAuth20Service{}
+				oAuth20Svc.On("DeleteMultipleClientCredentials", contextParam, auths).Return(nil).Once()
+				return oAuth20Svc
+			},
 			ConverterFn: func() *automock.RuntimeContextConverter {
 				conv := &automock.RuntimeContextConverter{}
 				conv.On("ToGraphQL", modelRuntimeContext).Return(gqlRuntimeContext).Once()
@@ -665,8 +745,16 @@ func TestResolver_DeleteRuntimeContext(t *testing.T) {
 			persistTx, transact := testCase.TransactionerFn()
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
+			sysAuthSvc := &automock.SystemAuthService{}
+			if testCase.SysAuthServiceFn != nil {
+				sysAuthSvc = testCase.SysAuthServiceFn()
+			}
+			oAuth20Svc := &automock.OAuth20Service{}
+			if testCase.OAuth20ServiceFn != nil {
+				oAuth20Svc = testCase.OAuth20ServiceFn()
+			}
 
-			resolver := runtime_context.NewResolver(transact, svc, converter)
+			resolver := runtime_context.NewResolver(transact, svc, sysAuthSvc, oAuth20Svc, converter, nil)
 
 			c := testCase.Consumer
 			if c == nil {
@@ -688,7 +776,7 @@ func TestResolver_DeleteRuntimeContext(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, svc, converter, transact, persistTx)
+			mock.AssertExpectationsForObjects(t, svc, sysAuthSvc, oAuth20Svc, converter, transact, persistTx)
 		})
 	}
 }
@@ -892,7 +980,7 @@ func TestResolver_RuntimeContext(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := runtime_context.NewResolver(transact, svc, converter)
+			resolver := runtime_context.NewResolver(transact, svc, nil, nil, converter, nil)
 
 			c := testCase.Consumer
 			if c == nil {
@@ -1105,7 +1193,7 @@ func TestResolver_RuntimeContexts(t *testing.T) {
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 
-			resolver := runtime_context.NewResolver(transact, svc, converter)
+			resolver := runtime_context.NewResolver(transact, svc, nil, nil, converter, nil)
 
 			c := testCase.Consumer
 			if c == nil {
@@ -1252,7 +1340,7 @@ func TestResolver_Labels(t *testing.T) {
 			svc := testCase.ServiceFn()
 			transact := testCase.TransactionerFn(persistTx)
 
-			resolver := runtime_context.NewResolver(transact, svc, nil)
+			resolver := runtime_context.NewResolver(transact, svc, nil, nil, nil, nil)
 
 			// when
 			result, err := resolver.Labels(context.TODO(), gqlRuntimeContext, &testCase.InputKey)
@@ -1265,3 +1353,129 @@ func TestResolver_Labels(t *testing.T) {
 		})
 	}
 }
+
+func TestResolver_Auths(t *testing.T) {
+	// GIVEN
+	tnt := "tnt"
+	externalTnt := "external-tnt"
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
+
+	parentRuntimeContext := &graphql.RuntimeContext{ID: "foo", Key: "key", Value: "value"}
+
+	modelSysAuths := []model.SystemAuth{
+		{ID: "bar", TenantID: &tnt, RuntimeContextID: &parentRuntimeContext.ID},
+		{ID: "baz", TenantID: &tnt, RuntimeContextID: &parentRuntimeContext.ID},
+	}
+
+	gqlSysAuths := []*graphql.SystemAuth{
+		{ID: "bar"},
+		{ID: "baz"},
+	}
+
+	testErr := errors.New("this is a test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SysAuthSvcFn    func() *automock.SystemAuthService
+		SysAuthConvFn   func() *automock.SystemAuthConverter
+		ExpectedOutput  []*graphql.SystemAuth
+		ExpectedError   error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			SysAuthSvcFn: func() *automock.SystemAuthService {
+				sysAuthSvc := &automock.SystemAuthService{}
+				sysAuthSvc.On("ListForObject", txtest.CtxWithDBMatcher(), model.RuntimeContextReference, parentRuntimeContext.ID).Return(modelSysAuths, nil).Once()
+				return sysAuthSvc
+			},
+			SysAuthConvFn: func() *automock.SystemAuthConverter {
+				sysAuthConv := &automock.SystemAuthConverter{}
+				sysAuthConv.On("ToGraphQL", &modelSysAuths[0]).Return(gqlSysAuths[0], nil).Once()
+				sysAuthConv.On("ToGraphQL", &modelSysAuths[1]).Return(gqlSysAuths[1], nil).Once()
+				return sysAuthConv
+			},
+			ExpectedOutput: gqlSysAuths,
+			ExpectedError:  nil,
+		},
+		{
+			Name:            "Error when listing for object",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			SysAuthSvcFn: func() *automock.SystemAuthService {
+				sysAuthSvc := &automock.SystemAuthService{}
+				sysAuthSvc.On("ListForObject", txtest.CtxWithDBMatcher(), model.RuntimeContextReference, parentRuntimeContext.ID).Return(nil, testErr).Once()
+				return sysAuthSvc
+			},
+			SysAuthConvFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedOutput: nil,
+			ExpectedError:  testErr,
+		},
+		{
+			Name:            "Error when beginning transaction",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			SysAuthSvcFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			SysAuthConvFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedOutput: nil,
+			ExpectedError:  testErr,
+		},
+		{
+			Name:            "Error when committing transaction",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			SysAuthSvcFn: func() *automock.SystemAuthService {
+				sysAuthSvc := &automock.SystemAuthService{}
+				sysAuthSvc.On("ListForObject", txtest.CtxWithDBMatcher(), model.RuntimeContextReference, parentRuntimeContext.ID).Return(modelSysAuths, nil).Once()
+				return sysAuthSvc
+			},
+			SysAuthConvFn: func() *automock.SystemAuthConverter {
+				return &automock.SystemAuthConverter{}
+			},
+			ExpectedOutput: nil,
+			ExpectedError:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			sysAuthSvc := testCase.SysAuthSvcFn()
+			sysAuthConv := testCase.SysAuthConvFn()
+
+			resolver := runtime_context.NewResolver(transact, nil, sysAuthSvc, nil, nil, sysAuthConv)
+
+			// WHEN
+			result, err := resolver.Auths(ctx, parentRuntimeContext)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, sysAuthSvc, sysAuthConv, transact, persist)
+		})
+	}
+
+	t.Run("Error when parent object is nil", func(t *testing.T) {
+		resolver := runtime_context.NewResolver(nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		result, err := resolver.Auths(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Runtime Context cannot be empty")
+		assert.Nil(t, result)
+	})
+}
@@ -19,6 +19,7 @@ type RuntimeContextRepository interface {
 	GetByID(ctx context.Context, tenant, id string) (*model.RuntimeContext, error)
 	GetByFiltersGlobal(ctx context.Context, filter []*labelfilter.LabelFilter) (*model.RuntimeContext, error)
 	List(ctx context.Context, runtimeID string, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimeContextPage, error)
+	ListAll(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimeContextPage, error)
 	Create(ctx context.Context, item *model.RuntimeContext) error
 	Update(ctx context.Context, item *model.RuntimeContext) error
 	Delete(ctx context.Context, tenant, id string) error
@@ -38,6 +39,17 @@ type LabelUpsertService interface {
 	UpsertLabel(ctx context.Context, tenant string, labelInput *model.LabelInput) error
 }
 
+//go:generate mockery -name=ScenariosService -output=automock -outpkg=automock -case=underscore
+type ScenariosService interface {
+	EnsureScenariosLabelDefinitionExists(ctx context.Context, tenant string) error
+	AddDefaultScenarioIfEnabled(ctx context.Context, labels *map[string]interface{})
+}
+
+//go:generate mockery -name=ScenarioAssignmentEngine -output=automock -outpkg=automock -case=underscore
+type ScenarioAssignmentEngine interface {
+	MergeScenariosFromInputLabelsAndAssignments(ctx context.Context, inputLabels map[string]interface{}) ([]interface{}, error)
+}
+
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
 type UIDService interface {
 	Generate() string
@@ -47,19 +59,25 @@ type service struct {
 	repo      RuntimeContextRepository
 	labelRepo LabelRepository
 
-	labelUpsertService LabelUpsertService
-	uidService         UIDService
+	labelUpsertService       LabelUpsertService
+	uidService               UIDService
+	scenariosService         ScenariosService
+	scenarioAssignmentEngine ScenarioAssignmentEngine
 }
 
 func NewService(repo RuntimeContextRepository,
 	labelRepo LabelRepository,
+	scenariosService ScenariosService,
 	labelUpsertService LabelUpsertService,
-	uidService UIDService) *service {
+	uidService UIDService,
+	scenarioAssignmentEngine ScenarioAssignmentEngine) *service {
 	return &service{
-		repo:               repo,
-		labelRepo:          labelRepo,
-		labelUpsertService: labelUpsertService,
-		uidService:         uidService,
+		repo:                     repo,
+		labelRepo:                labelRepo,
+		scenariosService:         scenariosService,
+		labelUpsertService:       labelUpsertService,
+		uidService:               uidService,
+		scenarioAssignmentEngine: scenarioAssignmentEngine,
 	}
 }
 
@@ -76,6 +94,20 @@ func (s *service) List(ctx context.Context, runtimeID string, filter []*labelfil
 	return s.repo.List(ctx, runtimeID, rtmCtxTenant, filter, pageSize, cursor)
 }
 
+// ListAll lists Runtime Contexts of all Runtimes in the tenant
+func (s *service) ListAll(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimeContextPage, error) {
+	rtmCtxTenant, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	if pageSize < 1 || pageSize > 100 {
+		return nil, apperrors.NewInvalidDataError("page size must be between 1 and 100")
+	}
+
+	return s.repo.ListAll(ctx, rtmCtxTenant, filter, pageSize, cursor)
+}
+
 func (s *service) Get(ctx context.Context, id string) (*model.RuntimeContext, error) {
 	rtmCtxTenant, err := tenant.LoadFromContext(ctx)
 	if err != nil {
@@ -117,7 +149,7 @@ func (s *service) Create(ctx context.Context, in model.RuntimeContextInput) (str
 		return "", errors.Wrapf(err, "while creating Runtime Context")
 	}
 
-	/*err = s.scenariosService.EnsureScenariosLabelDefinitionExists(ctx, rtmCtxTenant) TODO: Revisit when scenarios for runtime contexts are introduced
+	err = s.scenariosService.EnsureScenariosLabelDefinitionExists(ctx, rtmCtxTenant)
 	if err != nil {
 		return "", errors.Wrapf(err, "while ensuring Label Definition with key %s exists", model.ScenariosKey)
 	}
@@ -130,8 +162,8 @@ func (s *service) Create(ctx context.Context, in model.RuntimeContextInput) (str
 	if len(scenarios) > 0 {
 		in.Labels[model.ScenariosKey] = scenarios
 	} else {
-		s.scenariosService.AddDefaultScenarioIfEnabled(&in.Labels)
-	}*/
+		s.scenariosService.AddDefaultScenarioIfEnabled(ctx, &in.Labels)
+	}
 
 	err = s.labelUpsertService.UpsertMultipleLabels(ctx, rtmCtxTenant, model.RuntimeContextLabelableObject, id, in.Labels)
 	if err != nil {
@@ -168,14 +200,14 @@ func (s *service) Update(ctx context.Context, id string, in model.RuntimeContext
 		return nil
 	}
 
-	/*scenarios, err := s.scenarioAssignmentEngine.MergeScenariosFromInputLabelsAndAssignments(ctx, in.Labels) TODO: Revisit when scenarios for runtime contexts are introduced
+	scenarios, err := s.scenarioAssignmentEngine.MergeScenariosFromInputLabelsAndAssignments(ctx, in.Labels)
 	if err != nil {
 		return errors.Wrap(err, "while merging scenarios from input and assignments")
 	}
 
 	if len(scenarios) > 0 {
 		in.Labels[model.ScenariosKey] = scenarios
-	}*/
+	}
 
 	err = s.labelUpsertService.UpsertMultipleLabels(ctx, rtmCtxTenant, model.RuntimeContextLabelableObject, id, in.Labels)
 	if err != nil {
@@ -26,8 +26,9 @@ func TestService_Create(t *testing.T) {
 	runtimeID := "runtime_id"
 	key := "key"
 	val := "val"
+	scenarios := []interface{}{"DEFAULT"}
 	labels := map[string]interface{}{
-		model.ScenariosKey: "DEFAULT",
+		model.ScenariosKey: scenarios,
 	}
 	modelInput := model.RuntimeContextInput{
 		Key:       key,
@@ -56,6 +57,8 @@ func TestService_Create(t *testing.T) {
 	testCases := []struct {
 		Name                       string
 		RuntimeContextRepositoryFn func() *automock.RuntimeContextRepository
+		ScenariosServiceFn         func() *automock.ScenariosService
+		ScenarioAssignmentEngineFn func() *automock.ScenarioAssignmentEngine
 		LabelUpsertServiceFn       func() *automock.LabelUpsertService
 		UIDServiceFn               func() *automock.UIDService
 		Input                      model.RuntimeContextInput
@@ -68,6 +71,16 @@ func TestService_Create(t *testing.T) {
 				repo.On("Create", ctx, runtimeCtxModel).Return(nil).Once()
 				return repo
 			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				svc := &automock.ScenariosService{}
+				svc.On("EnsureScenariosLabelDefinitionExists", ctx, tnt).Return(nil).Once()
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("MergeScenariosFromInputLabelsAndAssignments", ctx, labels).Return(scenarios, nil).Once()
+				return engine
+			},
 			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
 				repo := &automock.LabelUpsertService{}
 				repo.On("UpsertMultipleLabels", ctx, tnt, model.RuntimeContextLabelableObject, id, modelInput.Labels).Return(nil).Once()
@@ -88,6 +101,17 @@ func TestService_Create(t *testing.T) {
 				repo.On("Create", ctx, runtimeCtxModel).Return(nil).Once()
 				return repo
 			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				svc := &automock.ScenariosService{}
+				svc.On("EnsureScenariosLabelDefinitionExists", ctx, tnt).Return(nil).Once()
+				svc.On("AddDefaultScenarioIfEnabled", ctx, mock.Anything).Return().Once()
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("MergeScenariosFromInputLabelsAndAssignments", ctx, nilLabels).Return(nil, nil).Once()
+				return engine
+			},
 			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
 				repo := &automock.LabelUpsertService{}
 				repo.On("UpsertMultipleLabels", ctx, tnt, model.RuntimeContextLabelableObject, id, nilLabels).Return(nil).Once()
@@ -108,6 +132,12 @@ func TestService_Create(t *testing.T) {
 				repo.On("Create", ctx, runtimeCtxModel).Return(testErr).Once()
 				return repo
 			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				return &automock.ScenariosService{}
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
 			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
 				repo := &automock.LabelUpsertService{}
 				return repo
@@ -120,6 +150,60 @@ func TestService_Create(t *testing.T) {
 			Input:       modelInput,
 			ExpectedErr: testErr,
 		},
+		{
+			Name: "Returns error when ensuring scenarios label definition failed",
+			RuntimeContextRepositoryFn: func() *automock.RuntimeContextRepository {
+				repo := &automock.RuntimeContextRepository{}
+				repo.On("Create", ctx, runtimeCtxModel).Return(nil).Once()
+				return repo
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				svc := &automock.ScenariosService{}
+				svc.On("EnsureScenariosLabelDefinitionExists", ctx, tnt).Return(testErr).Once()
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(id)
+				return svc
+			},
+			Input:       modelInput,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when merging scenarios from assignments failed",
+			RuntimeContextRepositoryFn: func() *automock.RuntimeContextRepository {
+				repo := &automock.RuntimeContextRepository{}
+				repo.On("Create", ctx, runtimeCtxModel).Return(nil).Once()
+				return repo
+			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				svc := &automock.ScenariosService{}
+				svc.On("EnsureScenariosLabelDefinitionExists", ctx, tnt).Return(nil).Once()
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("MergeScenariosFromInputLabelsAndAssignments", ctx, labels).Return(nil, testErr).Once()
+				return engine
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(id)
+				return svc
+			},
+			Input:       modelInput,
+			ExpectedErr: testErr,
+		},
 		{
 			Name: "Returns error when label upserting failed",
 			RuntimeContextRepositoryFn: func() *automock.RuntimeContextRepository {
@@ -127,6 +211,16 @@ func TestService_Create(t *testing.T) {
 				repo.On("Create", ctx, runtimeCtxModel).Return(nil).Once()
 				return repo
 			},
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				svc := &automock.ScenariosService{}
+				svc.On("EnsureScenariosLabelDefinitionExists", ctx, tnt).Return(nil).Once()
+				return svc
+			},
+			ScenarioAssignmentEngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("MergeScenariosFromInputLabelsAndAssignments", ctx, labels).Return(scenarios, nil).Once()
+				return engine
+			},
 			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
 				repo := &automock.LabelUpsertService{}
 				repo.On("UpsertMultipleLabels", ctx, "tenant", model.RuntimeContextLabelableObject, id, modelInput.Labels).Return(testErr).Once()
@@ -147,7 +241,9 @@ func TestService_Create(t *testing.T) {
 			repo := testCase.RuntimeContextRepositoryFn()
 			idSvc := testCase.UIDServiceFn()
 			labelSvc := testCase.LabelUpsertServiceFn()
-			svc := runtime_context.NewService(repo, nil, labelSvc, idSvc)
+			scenariosSvc := testCase.ScenariosServiceFn()
+			engine := testCase.ScenarioAssignmentEngineFn()
+			svc := runtime_context.NewService(repo, nil, scenariosSvc, labelSvc, idSvc, engine)
 
 			// when
 			result, err := svc.Create(ctx, testCase.Input)
@@ -164,12 +260,14 @@ func TestService_Create(t *testing.T) {
 			repo.AssertExpectations(t)
 			idSvc.AssertExpectations(t)
 			labelSvc.AssertExpectations(t)
+			scenariosSvc.AssertExpectations(t)
+			engine.AssertExpectations(t)
 		})
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime_context.NewService(nil, nil, nil, nil)
+		svc := runtime_context.NewService(nil, nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.Create(context.TODO(), model.RuntimeContextInput{})
 		// then
@@ -217,6 +315,7 @@ func TestService_Update(t *testing.T) {
 		Name                 string
 		RepositoryFn         func() *automock.RuntimeContextRepository
 		LabelRepositoryFn    func() *automock.LabelRepository
+		EngineFn             func() *automock.ScenarioAssignmentEngine
 		LabelUpsertServiceFn func() *automock.LabelUpsertService
 		Input                model.RuntimeContextInput
 		InputID              string
@@ -235,6 +334,11 @@ func TestService_Update(t *testing.T) {
 				repo.On("DeleteAll", ctx, tnt, model.RuntimeContextLabelableObject, runtimeCtxModel.ID).Return(nil).Once()
 				return repo
 			},
+			EngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("MergeScenariosFromInputLabelsAndAssignments", ctx, labels).Return(nil, nil).Once()
+				return engine
+			},
 			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
 				repo := &automock.LabelUpsertService{}
 				repo.On("UpsertMultipleLabels", ctx, tnt, model.RuntimeContextLabelableObject, runtimeCtxModel.ID, modelInput.Labels).Return(nil).Once()
@@ -257,6 +361,9 @@ func TestService_Update(t *testing.T) {
 				repo.On("DeleteAll", ctx, tnt, model.RuntimeContextLabelableObject, runtimeCtxModel.ID).Return(nil).Once()
 				return repo
 			},
+			EngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
 			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
 				repo := &automock.LabelUpsertService{}
 				return repo
@@ -281,6 +388,9 @@ func TestService_Update(t *testing.T) {
 				repo := &automock.LabelRepository{}
 				return repo
 			},
+			EngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
 			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
 				repo := &automock.LabelUpsertService{}
 				return repo
@@ -300,6 +410,9 @@ func TestService_Update(t *testing.T) {
 				repo := &automock.LabelRepository{}
 				return repo
 			},
+			EngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
 			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
 				repo := &automock.LabelUpsertService{}
 				return repo
@@ -321,6 +434,9 @@ func TestService_Update(t *testing.T) {
 				repo.On("DeleteAll", ctx, tnt, model.RuntimeContextLabelableObject, runtimeCtxModel.ID).Return(testErr).Once()
 				return repo
 			},
+			EngineFn: func() *automock.ScenarioAssignmentEngine {
+				return &automock.ScenarioAssignmentEngine{}
+			},
 			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
 				repo := &automock.LabelUpsertService{}
 				return repo
@@ -329,6 +445,31 @@ func TestService_Update(t *testing.T) {
 			Input:              modelInput,
 			ExpectedErrMessage: testErr.Error(),
 		},
+		{
+			Name: "Returns error when merging scenarios from assignments failed",
+			RepositoryFn: func() *automock.RuntimeContextRepository {
+				repo := &automock.RuntimeContextRepository{}
+				repo.On("GetByID", ctx, tnt, "foo").Return(runtimeCtxModel, nil).Once()
+				repo.On("Update", ctx, inputRuntimeContextModel).Return(nil).Once()
+				return repo
+			},
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("DeleteAll", ctx, tnt, model.RuntimeContextLabelableObject, runtimeCtxModel.ID).Return(nil).Once()
+				return repo
+			},
+			EngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("MergeScenariosFromInputLabelsAndAssignments", ctx, labels).Return(nil, testErr).Once()
+				return engine
+			},
+			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
+				return &automock.LabelUpsertService{}
+			},
+			InputID:            id,
+			Input:              modelInput,
+			ExpectedErrMessage: testErr.Error(),
+		},
 		{
 			Name: "Returns error when upserting labels failed",
 			RepositoryFn: func() *automock.RuntimeContextRepository {
@@ -342,6 +483,11 @@ func TestService_Update(t *testing.T) {
 				repo.On("DeleteAll", ctx, tnt, model.RuntimeContextLabelableObject, runtimeCtxModel.ID).Return(nil).Once()
 				return repo
 			},
+			EngineFn: func() *automock.ScenarioAssignmentEngine {
+				engine := &automock.ScenarioAssignmentEngine{}
+				engine.On("MergeScenariosFromInputLabelsAndAssignments", ctx, labels).Return(nil, nil).Once()
+				return engine
+			},
 			LabelUpsertServiceFn: func() *automock.LabelUpsertService {
 				repo := &automock.LabelUpsertService{}
 				repo.On("UpsertMultipleLabels", ctx, tnt, model.RuntimeContextLabelableObject, runtimeCtxModel.ID, modelInput.Labels).Return(testErr).Once()
@@ -358,7 +504,8 @@ func TestService_Update(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
 			labelSvc := testCase.LabelUpsertServiceFn()
-			svc := runtime_context.NewService(repo, labelRepo, labelSvc, nil)
+			engine := testCase.EngineFn()
+			svc := runtime_context.NewService(repo, labelRepo, nil, labelSvc, nil, engine)
 
 			// when
 			err := svc.Update(ctx, testCase.InputID, testCase.Input)
@@ -373,12 +520,13 @@ func TestService_Update(t *testing.T) {
 			repo.AssertExpectations(t)
 			labelRepo.AssertExpectations(t)
 			labelSvc.AssertExpectations(t)
+			engine.AssertExpectations(t)
 		})
 	}
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime_context.NewService(nil, nil, nil, nil)
+		svc := runtime_context.NewService(nil, nil, nil, nil, nil, nil)
 		// when
 		err := svc.Update(context.TODO(), "id", model.RuntimeContextInput{})
 		// then
@@ -439,7 +587,7 @@ func TestService_Delete(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
-			svc := runtime_context.NewService(repo, nil, nil, nil)
+			svc := runtime_context.NewService(repo, nil, nil, nil, nil, nil)
 
 			// when
 			err := svc.Delete(ctx, testCase.InputID)
@@ -457,7 +605,7 @@ func TestService_Delete(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime_context.NewService(nil, nil, nil, nil)
+		svc := runtime_context.NewService(nil, nil, nil, nil, nil, nil)
 		// when
 		err := svc.Delete(context.TODO(), "id")
 		// then
@@ -523,7 +671,7 @@ func TestService_Get(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := runtime_context.NewService(repo, nil, nil, nil)
+			svc := runtime_context.NewService(repo, nil, nil, nil, nil, nil)
 
 			// when
 			rtmCtx, err := svc.Get(ctx, testCase.InputID)
@@ -542,7 +690,7 @@ func TestService_Get(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime_context.NewService(nil, nil, nil, nil)
+		svc := runtime_context.NewService(nil, nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.Get(context.TODO(), "id")
 		// then
@@ -606,7 +754,7 @@ func TestService_Exist(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			//GIVEN
 			rtmCtxRepo := testCase.RepositoryFn()
-			svc := runtime_context.NewService(rtmCtxRepo, nil, nil, nil)
+			svc := runtime_context.NewService(rtmCtxRepo, nil, nil, nil, nil, nil)
 
 			// WHEN
 			value, err := svc.Exist(ctx, testCase.InputRuntimeContextID)
@@ -625,7 +773,7 @@ func TestService_Exist(t *testing.T) {
 	}
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime_context.NewService(nil, nil, nil, nil)
+		svc := runtime_context.NewService(nil, nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.Exist(context.TODO(), "id")
 		// then
@@ -747,7 +895,7 @@ func TestService_List(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := runtime_context.NewService(repo, nil, nil, nil)
+			svc := runtime_context.NewService(repo, nil, nil, nil, nil, nil)
 
 			// when
 			rtmCtx, err := svc.List(ctx, runtimeID, testCase.InputLabelFilters, testCase.InputPageSize, testCase.InputCursor)
@@ -766,7 +914,7 @@ func TestService_List(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime_context.NewService(nil, nil, nil, nil)
+		svc := runtime_context.NewService(nil, nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.List(context.TODO(), "", nil, 1, "")
 		// then
@@ -775,6 +923,104 @@ func TestService_List(t *testing.T) {
 	})
 }
 
+func TestService_ListAll(t *testing.T) {
+	// given
+	testErr := errors.New("Test error")
+
+	modelRuntimeContexts := []*model.RuntimeContext{
+		{ID: "foo", Key: "key", Value: "value", RuntimeID: "runtime_id"},
+		{ID: "bar", Key: "key2", Value: "value2", RuntimeID: "other_runtime_id"},
+	}
+	runtimePage := &model.RuntimeContextPage{
+		Data:       modelRuntimeContexts,
+		TotalCount: len(modelRuntimeContexts),
+		PageInfo: &pagination.Page{
+			HasNextPage: false,
+			EndCursor:   "end",
+			StartCursor: "start",
+		},
+	}
+
+	first := 2
+	after := "test"
+	filter := []*labelfilter.LabelFilter{{Key: ""}}
+
+	tnt := "tenant"
+	externalTnt := "external-tnt"
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tnt, externalTnt)
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.RuntimeContextRepository
+		InputPageSize      int
+		ExpectedResult     *model.RuntimeContextPage
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.RuntimeContextRepository {
+				repo := &automock.RuntimeContextRepository{}
+				repo.On("ListAll", ctx, tnt, filter, first, after).Return(runtimePage, nil).Once()
+				return repo
+			},
+			InputPageSize:  first,
+			ExpectedResult: runtimePage,
+		},
+		{
+			Name: "Returns error when runtime context listing failed",
+			RepositoryFn: func() *automock.RuntimeContextRepository {
+				repo := &automock.RuntimeContextRepository{}
+				repo.On("ListAll", ctx, tnt, filter, first, after).Return(nil, testErr).Once()
+				return repo
+			},
+			InputPageSize:      first,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name: "Returns error when pageSize is out of range",
+			RepositoryFn: func() *automock.RuntimeContextRepository {
+				return &automock.RuntimeContextRepository{}
+			},
+			InputPageSize:      101,
+			ExpectedErrMessage: "page size must be between 1 and 100",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+
+			svc := runtime_context.NewService(repo, nil, nil, nil, nil, nil)
+
+			// when
+			rtmCtx, err := svc.ListAll(ctx, filter, testCase.InputPageSize, after)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, rtmCtx)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error on loading tenant", func(t *testing.T) {
+		// given
+		svc := runtime_context.NewService(nil, nil, nil, nil, nil, nil)
+		// when
+		_, err := svc.ListAll(context.TODO(), nil, 1, "")
+		// then
+		require.Error(t, err)
+		assert.EqualError(t, err, "while loading tenant from context: cannot read tenant from context")
+	})
+}
+
 func TestService_ListLabel(t *testing.T) {
 	// given
 	tnt := "tenant"
@@ -888,7 +1134,7 @@ func TestService_ListLabel(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			labelRepo := testCase.LabelRepositoryFn()
-			svc := runtime_context.NewService(repo, labelRepo, nil, nil)
+			svc := runtime_context.NewService(repo, labelRepo, nil, nil, nil, nil)
 
 			// when
 			l, err := svc.ListLabels(ctx, testCase.InputRuntimeContextID)
@@ -908,7 +1154,7 @@ func TestService_ListLabel(t *testing.T) {
 
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
 		// given
-		svc := runtime_context.NewService(nil, nil, nil, nil)
+		svc := runtime_context.NewService(nil, nil, nil, nil, nil, nil)
 		// when
 		_, err := svc.ListLabels(context.TODO(), "id")
 		// then
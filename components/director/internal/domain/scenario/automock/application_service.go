@@ -0,0 +1,40 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+// ApplicationService is an autogenerated mock type for the ApplicationService type
+type ApplicationService struct {
+	mock.Mock
+}
+
+// List provides a mock function with given fields: ctx, filter, pageSize, cursor
+func (_m *ApplicationService) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
+	ret := _m.Called(ctx, filter, pageSize, cursor)
+
+	var r0 *model.ApplicationPage
+	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, int, string) *model.ApplicationPage); ok {
+		r0 = rf(ctx, filter, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, int, string) error); ok {
+		r1 = rf(ctx, filter, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
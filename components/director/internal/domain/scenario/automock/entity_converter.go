@@ -0,0 +1,46 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	scenario "github.com/kyma-incubator/compass/components/director/internal/domain/scenario"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EntityConverter is an autogenerated mock type for the EntityConverter type
+type EntityConverter struct {
+	mock.Mock
+}
+
+// FromEntity provides a mock function with given fields: in
+func (_m *EntityConverter) FromEntity(in *scenario.Entity) *model.Scenario {
+	ret := _m.Called(in)
+
+	var r0 *model.Scenario
+	if rf, ok := ret.Get(0).(func(*scenario.Entity) *model.Scenario); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Scenario)
+		}
+	}
+
+	return r0
+}
+
+// ToEntity provides a mock function with given fields: in
+func (_m *EntityConverter) ToEntity(in *model.Scenario) *scenario.Entity {
+	ret := _m.Called(in)
+
+	var r0 *scenario.Entity
+	if rf, ok := ret.Get(0).(func(*model.Scenario) *scenario.Entity); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*scenario.Entity)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,52 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LabelDefinitionService is an autogenerated mock type for the LabelDefinitionService type
+type LabelDefinitionService struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx, tenant, key
+func (_m *LabelDefinitionService) Get(ctx context.Context, tenant string, key string) (*model.LabelDefinition, error) {
+	ret := _m.Called(ctx, tenant, key)
+
+	var r0 *model.LabelDefinition
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.LabelDefinition); ok {
+		r0 = rf(ctx, tenant, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.LabelDefinition)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, def
+func (_m *LabelDefinitionService) Update(ctx context.Context, def model.LabelDefinition) error {
+	ret := _m.Called(ctx, def)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.LabelDefinition) error); ok {
+		r0 = rf(ctx, def)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
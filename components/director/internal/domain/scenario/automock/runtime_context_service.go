@@ -0,0 +1,40 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	labelfilter "github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+// RuntimeContextService is an autogenerated mock type for the RuntimeContextService type
+type RuntimeContextService struct {
+	mock.Mock
+}
+
+// ListAll provides a mock function with given fields: ctx, filter, pageSize, cursor
+func (_m *RuntimeContextService) ListAll(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimeContextPage, error) {
+	ret := _m.Called(ctx, filter, pageSize, cursor)
+
+	var r0 *model.RuntimeContextPage
+	if rf, ok := ret.Get(0).(func(context.Context, []*labelfilter.LabelFilter, int, string) *model.RuntimeContextPage); ok {
+		r0 = rf(ctx, filter, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RuntimeContextPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []*labelfilter.LabelFilter, int, string) error); ok {
+		r1 = rf(ctx, filter, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,61 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+// ScenarioConverter is an autogenerated mock type for the ScenarioConverter type
+type ScenarioConverter struct {
+	mock.Mock
+}
+
+// InputFromGraphQL provides a mock function with given fields: in
+func (_m *ScenarioConverter) InputFromGraphQL(in graphql.ScenarioInput) model.ScenarioInput {
+	ret := _m.Called(in)
+
+	var r0 model.ScenarioInput
+	if rf, ok := ret.Get(0).(func(graphql.ScenarioInput) model.ScenarioInput); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.ScenarioInput)
+	}
+
+	return r0
+}
+
+// MultipleToGraphQL provides a mock function with given fields: in
+func (_m *ScenarioConverter) MultipleToGraphQL(in []*model.Scenario) []*graphql.Scenario {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.Scenario
+	if rf, ok := ret.Get(0).(func([]*model.Scenario) []*graphql.Scenario); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.Scenario)
+		}
+	}
+
+	return r0
+}
+
+// ToGraphQL provides a mock function with given fields: in
+func (_m *ScenarioConverter) ToGraphQL(in *model.Scenario) *graphql.Scenario {
+	ret := _m.Called(in)
+
+	var r0 *graphql.Scenario
+	if rf, ok := ret.Get(0).(func(*model.Scenario) *graphql.Scenario); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.Scenario)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,89 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ScenarioRepository is an autogenerated mock type for the ScenarioRepository type
+type ScenarioRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, item
+func (_m *ScenarioRepository) Create(ctx context.Context, item model.Scenario) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Scenario) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByName provides a mock function with given fields: ctx, tenant, name
+func (_m *ScenarioRepository) GetByName(ctx context.Context, tenant string, name string) (*model.Scenario, error) {
+	ret := _m.Called(ctx, tenant, name)
+
+	var r0 *model.Scenario
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Scenario); ok {
+		r0 = rf(ctx, tenant, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Scenario)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, tenant
+func (_m *ScenarioRepository) List(ctx context.Context, tenant string) ([]*model.Scenario, error) {
+	ret := _m.Called(ctx, tenant)
+
+	var r0 []*model.Scenario
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Scenario); ok {
+		r0 = rf(ctx, tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Scenario)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, item
+func (_m *ScenarioRepository) Update(ctx context.Context, item model.Scenario) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Scenario) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,172 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ScenarioService is an autogenerated mock type for the ScenarioService type
+type ScenarioService struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, in
+func (_m *ScenarioService) Create(ctx context.Context, in model.ScenarioInput) error {
+	ret := _m.Called(ctx, in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ScenarioInput) error); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, name
+func (_m *ScenarioService) Delete(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, name
+func (_m *ScenarioService) Get(ctx context.Context, name string) (*model.Scenario, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 *model.Scenario
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Scenario); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Scenario)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *ScenarioService) List(ctx context.Context) ([]*model.Scenario, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.Scenario
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.Scenario); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Scenario)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListApplications provides a mock function with given fields: ctx, name, pageSize, cursor
+func (_m *ScenarioService) ListApplications(ctx context.Context, name string, pageSize int, cursor string) (*model.ApplicationPage, error) {
+	ret := _m.Called(ctx, name, pageSize, cursor)
+
+	var r0 *model.ApplicationPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *model.ApplicationPage); ok {
+		r0 = rf(ctx, name, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ApplicationPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
+		r1 = rf(ctx, name, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRuntimeContexts provides a mock function with given fields: ctx, name, pageSize, cursor
+func (_m *ScenarioService) ListRuntimeContexts(ctx context.Context, name string, pageSize int, cursor string) (*model.RuntimeContextPage, error) {
+	ret := _m.Called(ctx, name, pageSize, cursor)
+
+	var r0 *model.RuntimeContextPage
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *model.RuntimeContextPage); ok {
+		r0 = rf(ctx, name, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RuntimeContextPage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
+		r1 = rf(ctx, name, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRuntimes provides a mock function with given fields: ctx, name, pageSize, cursor
+func (_m *ScenarioService) ListRuntimes(ctx context.Context, name string, pageSize int, cursor string) (*model.RuntimePage, error) {
+	ret := _m.Called(ctx, name, pageSize, cursor)
+
+	var r0 *model.RuntimePage
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *model.RuntimePage); ok {
+		r0 = rf(ctx, name, pageSize, cursor)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RuntimePage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
+		r1 = rf(ctx, name, pageSize, cursor)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, in
+func (_m *ScenarioService) Update(ctx context.Context, in model.ScenarioInput) error {
+	ret := _m.Called(ctx, in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.ScenarioInput) error); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,74 @@
+package scenario
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct{}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) ToGraphQL(in *model.Scenario) *graphql.Scenario {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.Scenario{
+		Name:        in.Name,
+		Description: in.Description,
+		Owner:       in.Owner,
+	}
+}
+
+func (c *converter) MultipleToGraphQL(in []*model.Scenario) []*graphql.Scenario {
+	scenarios := make([]*graphql.Scenario, 0, len(in))
+	for _, s := range in {
+		if s == nil {
+			continue
+		}
+
+		scenarios = append(scenarios, c.ToGraphQL(s))
+	}
+
+	return scenarios
+}
+
+func (c *converter) InputFromGraphQL(in graphql.ScenarioInput) model.ScenarioInput {
+	return model.ScenarioInput{
+		Name:        in.Name,
+		Description: in.Description,
+		Owner:       in.Owner,
+	}
+}
+
+func (c *converter) ToEntity(in *model.Scenario) *Entity {
+	if in == nil {
+		return nil
+	}
+
+	return &Entity{
+		ID:          in.ID,
+		TenantID:    in.Tenant,
+		Name:        in.Name,
+		Description: repo.NewNullableString(in.Description),
+		Owner:       repo.NewNullableString(in.Owner),
+	}
+}
+
+func (c *converter) FromEntity(in *Entity) *model.Scenario {
+	if in == nil {
+		return nil
+	}
+
+	return &model.Scenario{
+		ID:          in.ID,
+		Tenant:      in.TenantID,
+		Name:        in.Name,
+		Description: repo.StringPtrFromNullableString(in.Description),
+		Owner:       repo.StringPtrFromNullableString(in.Owner),
+	}
+}
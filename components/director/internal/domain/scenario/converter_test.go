@@ -0,0 +1,116 @@
+package scenario_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenario"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		conv := scenario.NewConverter()
+
+		// WHEN
+		result := conv.ToGraphQL(fixModelScenario(testID, testName))
+
+		// THEN
+		assert.Equal(t, fixGQLScenario(testName), result)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		// GIVEN
+		conv := scenario.NewConverter()
+
+		// WHEN
+		result := conv.ToGraphQL(nil)
+
+		// THEN
+		assert.Nil(t, result)
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	// GIVEN
+	conv := scenario.NewConverter()
+	in := []*model.Scenario{fixModelScenario(testID, testName), nil, {Name: model.DefaultScenario}}
+
+	// WHEN
+	result := conv.MultipleToGraphQL(in)
+
+	// THEN
+	assert.Equal(t, []*graphql.Scenario{fixGQLScenario(testName), {Name: model.DefaultScenario}}, result)
+}
+
+func TestConverter_InputFromGraphQL(t *testing.T) {
+	// GIVEN
+	conv := scenario.NewConverter()
+
+	// WHEN
+	result := conv.InputFromGraphQL(fixGQLScenarioInput(testName))
+
+	// THEN
+	assert.Equal(t, fixModelScenarioInput(testName), result)
+}
+
+func TestConverter_ToEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		conv := scenario.NewConverter()
+
+		// WHEN
+		result := conv.ToEntity(fixModelScenario(testID, testName))
+
+		// THEN
+		assert.Equal(t, fixEntityScenario(testID, testName), result)
+	})
+
+	t.Run("Without metadata", func(t *testing.T) {
+		// GIVEN
+		conv := scenario.NewConverter()
+
+		// WHEN
+		result := conv.ToEntity(&model.Scenario{ID: testID, Tenant: testTenant, Name: testName})
+
+		// THEN
+		assert.Equal(t, &scenario.Entity{ID: testID, TenantID: testTenant, Name: testName}, result)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		// GIVEN
+		conv := scenario.NewConverter()
+
+		// WHEN
+		result := conv.ToEntity(nil)
+
+		// THEN
+		assert.Nil(t, result)
+	})
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		conv := scenario.NewConverter()
+
+		// WHEN
+		result := conv.FromEntity(fixEntityScenario(testID, testName))
+
+		// THEN
+		assert.Equal(t, fixModelScenario(testID, testName), result)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		// GIVEN
+		conv := scenario.NewConverter()
+
+		// WHEN
+		result := conv.FromEntity(nil)
+
+		// THEN
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,17 @@
+package scenario
+
+import "database/sql"
+
+type Entity struct {
+	ID          string         `db:"id"`
+	TenantID    string         `db:"tenant_id"`
+	Name        string         `db:"name"`
+	Description sql.NullString `db:"description"`
+	Owner       sql.NullString `db:"owner"`
+}
+
+type EntityCollection []Entity
+
+func (c EntityCollection) Len() int {
+	return len(c)
+}
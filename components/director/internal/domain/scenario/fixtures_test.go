@@ -0,0 +1,100 @@
+package scenario_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenario"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenario/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	testID          = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	testTenant      = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	testExtTenant   = "external-tenant"
+	testName        = "MARKETING"
+	testDescription = "Systems of the marketing department"
+	testOwner       = "marketing-team"
+)
+
+var testError = errors.New("test error")
+
+func fixScenariosServiceThatEnsures(ctx context.Context) func() *automock.ScenariosService {
+	return func() *automock.ScenariosService {
+		svc := &automock.ScenariosService{}
+		svc.On("EnsureScenariosLabelDefinitionExists", ctx, testTenant).Return(nil).Once()
+		return svc
+	}
+}
+
+func fixModelScenario(id, name string) *model.Scenario {
+	return &model.Scenario{
+		ID:          id,
+		Tenant:      testTenant,
+		Name:        name,
+		Description: str.Ptr(testDescription),
+		Owner:       str.Ptr(testOwner),
+	}
+}
+
+func fixModelScenarioInput(name string) model.ScenarioInput {
+	return model.ScenarioInput{
+		Name:        name,
+		Description: str.Ptr(testDescription),
+		Owner:       str.Ptr(testOwner),
+	}
+}
+
+func fixGQLScenario(name string) *graphql.Scenario {
+	return &graphql.Scenario{
+		Name:        name,
+		Description: str.Ptr(testDescription),
+		Owner:       str.Ptr(testOwner),
+	}
+}
+
+func fixGQLScenarioInput(name string) graphql.ScenarioInput {
+	return graphql.ScenarioInput{
+		Name:        name,
+		Description: str.Ptr(testDescription),
+		Owner:       str.Ptr(testOwner),
+	}
+}
+
+func fixEntityScenario(id, name string) *scenario.Entity {
+	return &scenario.Entity{
+		ID:          id,
+		TenantID:    testTenant,
+		Name:        name,
+		Description: sql.NullString{String: testDescription, Valid: true},
+		Owner:       sql.NullString{String: testOwner, Valid: true},
+	}
+}
+
+func fixScenariosLabelDefinition(scenarios ...string) *model.LabelDefinition {
+	enum := make([]interface{}, 0, len(scenarios))
+	for _, s := range scenarios {
+		enum = append(enum, s)
+	}
+	var schema interface{} = map[string]interface{}{
+		"type":        "array",
+		"minItems":    float64(1),
+		"uniqueItems": true,
+		"items": map[string]interface{}{
+			"type":      "string",
+			"pattern":   model.ScenarioNamePattern,
+			"enum":      enum,
+			"maxLength": float64(128),
+		},
+	}
+	return &model.LabelDefinition{
+		ID:     testID,
+		Tenant: testTenant,
+		Key:    model.ScenariosKey,
+		Schema: &schema,
+	}
+}
@@ -0,0 +1,80 @@
+package scenario
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const (
+	scenariosTable string = `public.scenarios`
+	tenantColumn   string = `tenant_id`
+)
+
+var (
+	scenarioColumns  = []string{"id", "tenant_id", "name", "description", "owner"}
+	updatableColumns = []string{"description", "owner"}
+)
+
+//go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
+type EntityConverter interface {
+	ToEntity(in *model.Scenario) *Entity
+	FromEntity(in *Entity) *model.Scenario
+}
+
+type pgRepository struct {
+	creator      repo.Creator
+	singleGetter repo.SingleGetter
+	lister       repo.Lister
+	updater      repo.Updater
+	deleter      repo.Deleter
+	conv         EntityConverter
+}
+
+func NewRepository(conv EntityConverter) *pgRepository {
+	return &pgRepository{
+		creator:      repo.NewCreator(resource.Scenario, scenariosTable, scenarioColumns),
+		singleGetter: repo.NewSingleGetter(resource.Scenario, scenariosTable, tenantColumn, scenarioColumns),
+		lister:       repo.NewLister(resource.Scenario, scenariosTable, tenantColumn, scenarioColumns),
+		updater:      repo.NewUpdater(resource.Scenario, scenariosTable, updatableColumns, tenantColumn, []string{"id"}),
+		deleter:      repo.NewDeleter(resource.Scenario, scenariosTable, tenantColumn),
+		conv:         conv,
+	}
+}
+
+func (r *pgRepository) Create(ctx context.Context, item model.Scenario) error {
+	return r.creator.Create(ctx, r.conv.ToEntity(&item))
+}
+
+func (r *pgRepository) GetByName(ctx context.Context, tenant, name string) (*model.Scenario, error) {
+	var entity Entity
+	if err := r.singleGetter.Get(ctx, tenant, repo.Conditions{repo.NewEqualCondition("name", name)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity), nil
+}
+
+func (r *pgRepository) List(ctx context.Context, tenant string) ([]*model.Scenario, error) {
+	var entities EntityCollection
+	if err := r.lister.List(ctx, tenant, &entities); err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.Scenario, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, r.conv.FromEntity(&entity))
+	}
+	return items, nil
+}
+
+func (r *pgRepository) Update(ctx context.Context, item model.Scenario) error {
+	return r.updater.UpdateSingle(ctx, r.conv.ToEntity(&item))
+}
+
+// DeleteByName deletes the metadata of the scenario, if it exists
+func (r *pgRepository) DeleteByName(ctx context.Context, tenant, name string) error {
+	return r.deleter.DeleteMany(ctx, tenant, repo.Conditions{repo.NewEqualCondition("name", name)})
+}
@@ -0,0 +1,236 @@
+package scenario_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenario"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenario/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var scenarioColumns = []string{"id", "tenant_id", "name", "description", "owner"}
+
+func TestRepository_Create(t *testing.T) {
+	insertQuery := regexp.QuoteMeta(`INSERT INTO public.scenarios ( id, tenant_id, name, description, owner ) VALUES ( ?, ?, ?, ?, ? )`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		scenarioModel := fixModelScenario(testID, testName)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", scenarioModel).Return(fixEntityScenario(testID, testName)).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(insertQuery).
+			WithArgs(testID, testTenant, testName, testDescription, testOwner).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := scenario.NewRepository(mockConverter)
+
+		// WHEN
+		err := repo.Create(ctx, *scenarioModel)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when creating", func(t *testing.T) {
+		// GIVEN
+		scenarioModel := fixModelScenario(testID, testName)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", scenarioModel).Return(fixEntityScenario(testID, testName)).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(insertQuery).
+			WithArgs(testID, testTenant, testName, testDescription, testOwner).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := scenario.NewRepository(mockConverter)
+
+		// WHEN
+		err := repo.Create(ctx, *scenarioModel)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_GetByName(t *testing.T) {
+	selectQuery := regexp.QuoteMeta(`SELECT id, tenant_id, name, description, owner FROM public.scenarios WHERE tenant_id = $1 AND name = $2`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		scenarioModel := fixModelScenario(testID, testName)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", fixEntityScenario(testID, testName)).Return(scenarioModel).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		rows := sqlmock.NewRows(scenarioColumns).AddRow(testID, testTenant, testName, testDescription, testOwner)
+		dbMock.ExpectQuery(selectQuery).
+			WithArgs(testTenant, testName).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := scenario.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := repo.GetByName(ctx, testTenant, testName)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, scenarioModel, result)
+	})
+
+	t.Run("Error when scenario does not exist", func(t *testing.T) {
+		// GIVEN
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(selectQuery).
+			WithArgs(testTenant, testName).
+			WillReturnRows(sqlmock.NewRows(scenarioColumns))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := scenario.NewRepository(mockConverter)
+
+		// WHEN
+		_, err := repo.GetByName(ctx, testTenant, testName)
+
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+}
+
+func TestRepository_List(t *testing.T) {
+	selectQuery := regexp.QuoteMeta(`SELECT id, tenant_id, name, description, owner FROM public.scenarios WHERE tenant_id = $1`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		first := fixModelScenario(testID, testName)
+		second := &model.Scenario{ID: "cccccccc-cccc-cccc-cccc-cccccccccccc", Tenant: testTenant, Name: "SALES"}
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", fixEntityScenario(testID, testName)).Return(first).Once()
+		mockConverter.On("FromEntity", &scenario.Entity{ID: second.ID, TenantID: testTenant, Name: second.Name}).Return(second).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		rows := sqlmock.NewRows(scenarioColumns).
+			AddRow(testID, testTenant, testName, testDescription, testOwner).
+			AddRow(second.ID, testTenant, second.Name, nil, nil)
+		dbMock.ExpectQuery(selectQuery).
+			WithArgs(testTenant).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := scenario.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := repo.List(ctx, testTenant)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.Scenario{first, second}, result)
+	})
+
+	t.Run("Error when listing", func(t *testing.T) {
+		// GIVEN
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(selectQuery).
+			WithArgs(testTenant).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := scenario.NewRepository(mockConverter)
+
+		// WHEN
+		_, err := repo.List(ctx, testTenant)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_Update(t *testing.T) {
+	// GIVEN
+	scenarioModel := fixModelScenario(testID, testName)
+
+	mockConverter := &automock.EntityConverter{}
+	defer mockConverter.AssertExpectations(t)
+	mockConverter.On("ToEntity", scenarioModel).Return(fixEntityScenario(testID, testName)).Once()
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.scenarios SET description = ?, owner = ? WHERE tenant_id = ? AND id = ?`)).
+		WithArgs(testDescription, testOwner, testTenant, testID).
+		WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	repo := scenario.NewRepository(mockConverter)
+
+	// WHEN
+	err := repo.Update(ctx, *scenarioModel)
+
+	// THEN
+	require.NoError(t, err)
+}
+
+func TestRepository_DeleteByName(t *testing.T) {
+	deleteQuery := regexp.QuoteMeta(`DELETE FROM public.scenarios WHERE tenant_id = $1 AND name = $2`)
+
+	testCases := []struct {
+		Name   string
+		Result driver.Result
+	}{
+		{
+			Name:   "Success",
+			Result: sqlmock.NewResult(-1, 1),
+		},
+		{
+			Name:   "Success when scenario has no metadata",
+			Result: sqlmock.NewResult(-1, 0),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			db, dbMock := testdb.MockDatabase(t)
+			defer dbMock.AssertExpectations(t)
+			dbMock.ExpectExec(deleteQuery).
+				WithArgs(testTenant, testName).
+				WillReturnResult(testCase.Result)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			repo := scenario.NewRepository(nil)
+
+			// WHEN
+			err := repo.DeleteByName(ctx, testTenant, testName)
+
+			// THEN
+			require.NoError(t, err)
+		})
+	}
+}
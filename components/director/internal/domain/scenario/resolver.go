@@ -0,0 +1,299 @@
+package scenario
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+//go:generate mockery -name=ScenarioService -output=automock -outpkg=automock -case=underscore
+type ScenarioService interface {
+	List(ctx context.Context) ([]*model.Scenario, error)
+	Get(ctx context.Context, name string) (*model.Scenario, error)
+	Create(ctx context.Context, in model.ScenarioInput) error
+	Update(ctx context.Context, in model.ScenarioInput) error
+	Delete(ctx context.Context, name string) error
+	ListApplications(ctx context.Context, name string, pageSize int, cursor string) (*model.ApplicationPage, error)
+	ListRuntimes(ctx context.Context, name string, pageSize int, cursor string) (*model.RuntimePage, error)
+	ListRuntimeContexts(ctx context.Context, name string, pageSize int, cursor string) (*model.RuntimeContextPage, error)
+}
+
+//go:generate mockery -name=ScenarioConverter -output=automock -outpkg=automock -case=underscore
+type ScenarioConverter interface {
+	ToGraphQL(in *model.Scenario) *graphql.Scenario
+	MultipleToGraphQL(in []*model.Scenario) []*graphql.Scenario
+	InputFromGraphQL(in graphql.ScenarioInput) model.ScenarioInput
+}
+
+//go:generate mockery -name=ApplicationConverter -output=automock -outpkg=automock -case=underscore
+type ApplicationConverter interface {
+	MultipleToGraphQL(in []*model.Application) []*graphql.Application
+}
+
+//go:generate mockery -name=RuntimeConverter -output=automock -outpkg=automock -case=underscore
+type RuntimeConverter interface {
+	MultipleToGraphQL(in []*model.Runtime) []*graphql.Runtime
+}
+
+//go:generate mockery -name=RuntimeContextConverter -output=automock -outpkg=automock -case=underscore
+type RuntimeContextConverter interface {
+	MultipleToGraphQL(in []*model.RuntimeContext) []*graphql.RuntimeContext
+}
+
+type Resolver struct {
+	transact            persistence.Transactioner
+	svc                 ScenarioService
+	conv                ScenarioConverter
+	appConverter        ApplicationConverter
+	runtimeConverter    RuntimeConverter
+	runtimeCtxConverter RuntimeContextConverter
+}
+
+func NewResolver(transact persistence.Transactioner, svc ScenarioService, conv ScenarioConverter, appConverter ApplicationConverter, runtimeConverter RuntimeConverter, runtimeCtxConverter RuntimeContextConverter) *Resolver {
+	return &Resolver{
+		transact:            transact,
+		svc:                 svc,
+		conv:                conv,
+		appConverter:        appConverter,
+		runtimeConverter:    runtimeConverter,
+		runtimeCtxConverter: runtimeCtxConverter,
+	}
+}
+
+func (r *Resolver) Scenarios(ctx context.Context) ([]*graphql.Scenario, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	scenarios, err := r.svc.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.MultipleToGraphQL(scenarios), nil
+}
+
+func (r *Resolver) Scenario(ctx context.Context, name string) (*graphql.Scenario, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	scenario, err := r.svc.Get(ctx, name)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, tx.Commit()
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(scenario), nil
+}
+
+func (r *Resolver) CreateScenario(ctx context.Context, in graphql.ScenarioInput) (*graphql.Scenario, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := r.svc.Create(ctx, r.conv.InputFromGraphQL(in)); err != nil {
+		return nil, err
+	}
+
+	scenario, err := r.svc.Get(ctx, in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(scenario), nil
+}
+
+func (r *Resolver) UpdateScenario(ctx context.Context, in graphql.ScenarioInput) (*graphql.Scenario, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := r.svc.Update(ctx, r.conv.InputFromGraphQL(in)); err != nil {
+		return nil, err
+	}
+
+	scenario, err := r.svc.Get(ctx, in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(scenario), nil
+}
+
+func (r *Resolver) DeleteScenario(ctx context.Context, name string) (*graphql.Scenario, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	scenario, err := r.svc.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.svc.Delete(ctx, name); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(scenario), nil
+}
+
+func (r *Resolver) Applications(ctx context.Context, obj *graphql.Scenario, first *int, after *graphql.PageCursor) (*graphql.ApplicationPage, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Scenario cannot be empty")
+	}
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	appPage, err := r.svc.ListApplications(ctx, obj.Name, *first, cursorValue(after))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &graphql.ApplicationPage{
+		Data:       r.appConverter.MultipleToGraphQL(appPage.Data),
+		TotalCount: appPage.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor: graphql.PageCursor(appPage.PageInfo.StartCursor),
+			EndCursor:   graphql.PageCursor(appPage.PageInfo.EndCursor),
+			HasNextPage: appPage.PageInfo.HasNextPage,
+		},
+	}, nil
+}
+
+func (r *Resolver) Runtimes(ctx context.Context, obj *graphql.Scenario, first *int, after *graphql.PageCursor) (*graphql.RuntimePage, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Scenario cannot be empty")
+	}
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	runtimePage, err := r.svc.ListRuntimes(ctx, obj.Name, *first, cursorValue(after))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &graphql.RuntimePage{
+		Data:       r.runtimeConverter.MultipleToGraphQL(runtimePage.Data),
+		TotalCount: runtimePage.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor: graphql.PageCursor(runtimePage.PageInfo.StartCursor),
+			EndCursor:   graphql.PageCursor(runtimePage.PageInfo.EndCursor),
+			HasNextPage: runtimePage.PageInfo.HasNextPage,
+		},
+	}, nil
+}
+
+func (r *Resolver) RuntimeContexts(ctx context.Context, obj *graphql.Scenario, first *int, after *graphql.PageCursor) (*graphql.RuntimeContextPage, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Scenario cannot be empty")
+	}
+	if first == nil {
+		return nil, apperrors.NewInvalidDataError("missing required parameter 'first'")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	runtimeCtxPage, err := r.svc.ListRuntimeContexts(ctx, obj.Name, *first, cursorValue(after))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &graphql.RuntimeContextPage{
+		Data:       r.runtimeCtxConverter.MultipleToGraphQL(runtimeCtxPage.Data),
+		TotalCount: runtimeCtxPage.TotalCount,
+		PageInfo: &graphql.PageInfo{
+			StartCursor: graphql.PageCursor(runtimeCtxPage.PageInfo.StartCursor),
+			EndCursor:   graphql.PageCursor(runtimeCtxPage.PageInfo.EndCursor),
+			HasNextPage: runtimeCtxPage.PageInfo.HasNextPage,
+		},
+	}, nil
+}
+
+func cursorValue(after *graphql.PageCursor) string {
+	if after == nil {
+		return ""
+	}
+	return string(*after)
+}
@@ -0,0 +1,634 @@
+package scenario_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenario"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenario/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Scenarios(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	modelScenarios := []*model.Scenario{fixModelScenario(testID, testName)}
+	gqlScenarios := []*graphql.Scenario{fixGQLScenario(testName)}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcFn          func() *automock.ScenarioService
+		ConvFn         func() *automock.ScenarioConverter
+		ExpectedOutput []*graphql.Scenario
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.ScenarioService {
+				svc := &automock.ScenarioService{}
+				svc.On("List", txtest.CtxWithDBMatcher()).Return(modelScenarios, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ScenarioConverter {
+				conv := &automock.ScenarioConverter{}
+				conv.On("MultipleToGraphQL", modelScenarios).Return(gqlScenarios).Once()
+				return conv
+			},
+			ExpectedOutput: gqlScenarios,
+		},
+		{
+			Name: "Returns error when listing Scenarios failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.ScenarioService {
+				svc := &automock.ScenarioService{}
+				svc.On("List", txtest.CtxWithDBMatcher()).Return(nil, testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ScenarioConverter {
+				return &automock.ScenarioConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when beginning transaction",
+			TxFn: txGen.ThatFailsOnBegin,
+			SvcFn: func() *automock.ScenarioService {
+				return &automock.ScenarioService{}
+			},
+			ConvFn: func() *automock.ScenarioConverter {
+				return &automock.ScenarioConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			SvcFn: func() *automock.ScenarioService {
+				svc := &automock.ScenarioService{}
+				svc.On("List", txtest.CtxWithDBMatcher()).Return(modelScenarios, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ScenarioConverter {
+				return &automock.ScenarioConverter{}
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.SvcFn()
+			conv := testCase.ConvFn()
+
+			resolver := scenario.NewResolver(transact, svc, conv, nil, nil, nil)
+
+			// WHEN
+			result, err := resolver.Scenarios(ctx)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_Scenario(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	modelScenario := fixModelScenario(testID, testName)
+	gqlScenario := fixGQLScenario(testName)
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcFn          func() *automock.ScenarioService
+		ConvFn         func() *automock.ScenarioConverter
+		ExpectedOutput *graphql.Scenario
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.ScenarioService {
+				svc := &automock.ScenarioService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testName).Return(modelScenario, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ScenarioConverter {
+				conv := &automock.ScenarioConverter{}
+				conv.On("ToGraphQL", modelScenario).Return(gqlScenario).Once()
+				return conv
+			},
+			ExpectedOutput: gqlScenario,
+		},
+		{
+			Name: "Returns nil when Scenario does not exist",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.ScenarioService {
+				svc := &automock.ScenarioService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testName).Return(nil, apperrors.NewNotFoundError(resource.Scenario, testName)).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ScenarioConverter {
+				return &automock.ScenarioConverter{}
+			},
+		},
+		{
+			Name: "Returns error when getting Scenario failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.ScenarioService {
+				svc := &automock.ScenarioService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testName).Return(nil, testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ScenarioConverter {
+				return &automock.ScenarioConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			SvcFn: func() *automock.ScenarioService {
+				svc := &automock.ScenarioService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testName).Return(modelScenario, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ScenarioConverter {
+				return &automock.ScenarioConverter{}
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.SvcFn()
+			conv := testCase.ConvFn()
+
+			resolver := scenario.NewResolver(transact, svc, conv, nil, nil, nil)
+
+			// WHEN
+			result, err := resolver.Scenario(ctx, testName)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_CreateScenario(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	gqlInput := fixGQLScenarioInput(testName)
+	modelInput := fixModelScenarioInput(testName)
+	modelScenario := fixModelScenario(testID, testName)
+	gqlScenario := fixGQLScenario(testName)
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcFn          func() *automock.ScenarioService
+		ConvFn         func() *automock.ScenarioConverter
+		ExpectedOutput *graphql.Scenario
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.ScenarioService {
+				svc := &automock.ScenarioService{}
+				svc.On("Create", txtest.CtxWithDBMatcher(), modelInput).Return(nil).Once()
+				svc.On("Get", txtest.CtxWithDBMatcher(), testName).Return(modelScenario, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ScenarioConverter {
+				conv := &automock.ScenarioConverter{}
+				conv.On("InputFromGraphQL", gqlInput).Return(modelInput).Once()
+				conv.On("ToGraphQL", modelScenario).Return(gqlScenario).Once()
+				return conv
+			},
+			ExpectedOutput: gqlScenario,
+		},
+		{
+			Name: "Returns error when creating Scenario failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.ScenarioService {
+				svc := &automock.ScenarioService{}
+				svc.On("Create", txtest.CtxWithDBMatcher(), modelInput).Return(testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ScenarioConverter {
+				conv := &automock.ScenarioConverter{}
+				conv.On("InputFromGraphQL", gqlInput).Return(modelInput).Once()
+				return conv
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when getting created Scenario failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.ScenarioService {
+				svc := &automock.ScenarioService{}
+				svc.On("Create", txtest.CtxWithDBMatcher(), modelInput).Return(nil).Once()
+				svc.On("Get", txtest.CtxWithDBMatcher(), testName).Return(nil, testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ScenarioConverter {
+				conv := &automock.ScenarioConverter{}
+				conv.On("InputFromGraphQL", gqlInput).Return(modelInput).Once()
+				return conv
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when beginning transaction",
+			TxFn: txGen.ThatFailsOnBegin,
+			SvcFn: func() *automock.ScenarioService {
+				return &automock.ScenarioService{}
+			},
+			ConvFn: func() *automock.ScenarioConverter {
+				return &automock.ScenarioConverter{}
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.SvcFn()
+			conv := testCase.ConvFn()
+
+			resolver := scenario.NewResolver(transact, svc, conv, nil, nil, nil)
+
+			// WHEN
+			result, err := resolver.CreateScenario(ctx, gqlInput)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_UpdateScenario(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	gqlInput := fixGQLScenarioInput(testName)
+	modelInput := fixModelScenarioInput(testName)
+	modelScenario := fixModelScenario(testID, testName)
+	gqlScenario := fixGQLScenario(testName)
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcFn          func() *automock.ScenarioService
+		ExpectedOutput *graphql.Scenario
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.ScenarioService {
+				svc := &automock.ScenarioService{}
+				svc.On("Update", txtest.CtxWithDBMatcher(), modelInput).Return(nil).Once()
+				svc.On("Get", txtest.CtxWithDBMatcher(), testName).Return(modelScenario, nil).Once()
+				return svc
+			},
+			ExpectedOutput: gqlScenario,
+		},
+		{
+			Name: "Returns error when updating Scenario failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.ScenarioService {
+				svc := &automock.ScenarioService{}
+				svc.On("Update", txtest.CtxWithDBMatcher(), modelInput).Return(testError).Once()
+				return svc
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.SvcFn()
+			conv := &automock.ScenarioConverter{}
+			conv.On("InputFromGraphQL", gqlInput).Return(modelInput).Once()
+			if testCase.ExpectedOutput != nil {
+				conv.On("ToGraphQL", modelScenario).Return(testCase.ExpectedOutput).Once()
+			}
+
+			resolver := scenario.NewResolver(transact, svc, conv, nil, nil, nil)
+
+			// WHEN
+			result, err := resolver.UpdateScenario(ctx, gqlInput)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_DeleteScenario(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	modelScenario := fixModelScenario(testID, testName)
+	gqlScenario := fixGQLScenario(testName)
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcFn          func() *automock.ScenarioService
+		ConvFn         func() *automock.ScenarioConverter
+		ExpectedOutput *graphql.Scenario
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.ScenarioService {
+				svc := &automock.ScenarioService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testName).Return(modelScenario, nil).Once()
+				svc.On("Delete", txtest.CtxWithDBMatcher(), testName).Return(nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ScenarioConverter {
+				conv := &automock.ScenarioConverter{}
+				conv.On("ToGraphQL", modelScenario).Return(gqlScenario).Once()
+				return conv
+			},
+			ExpectedOutput: gqlScenario,
+		},
+		{
+			Name: "Returns error when getting Scenario failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.ScenarioService {
+				svc := &automock.ScenarioService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testName).Return(nil, testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ScenarioConverter {
+				return &automock.ScenarioConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when deleting Scenario failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.ScenarioService {
+				svc := &automock.ScenarioService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testName).Return(modelScenario, nil).Once()
+				svc.On("Delete", txtest.CtxWithDBMatcher(), testName).Return(testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ScenarioConverter {
+				return &automock.ScenarioConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			SvcFn: func() *automock.ScenarioService {
+				svc := &automock.ScenarioService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testName).Return(modelScenario, nil).Once()
+				svc.On("Delete", txtest.CtxWithDBMatcher(), testName).Return(nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.ScenarioConverter {
+				return &automock.ScenarioConverter{}
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.SvcFn()
+			conv := testCase.ConvFn()
+
+			resolver := scenario.NewResolver(transact, svc, conv, nil, nil, nil)
+
+			// WHEN
+			result, err := resolver.DeleteScenario(ctx, testName)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_Applications(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	first := 2
+	after := graphql.PageCursor("cursor")
+	obj := fixGQLScenario(testName)
+	modelApps := []*model.Application{{ID: testID, Name: "app"}}
+	gqlApps := []*graphql.Application{{ID: testID, Name: "app"}}
+	appPage := &model.ApplicationPage{
+		Data:       modelApps,
+		TotalCount: 1,
+		PageInfo:   &pagination.Page{StartCursor: "start", EndCursor: "end", HasNextPage: false},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		svc := &automock.ScenarioService{}
+		svc.On("ListApplications", txtest.CtxWithDBMatcher(), testName, first, string(after)).Return(appPage, nil).Once()
+		appConv := &automock.ApplicationConverter{}
+		appConv.On("MultipleToGraphQL", modelApps).Return(gqlApps).Once()
+
+		resolver := scenario.NewResolver(transact, svc, nil, appConv, nil, nil)
+
+		// WHEN
+		result, err := resolver.Applications(ctx, obj, &first, &after)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, &graphql.ApplicationPage{
+			Data:       gqlApps,
+			TotalCount: 1,
+			PageInfo:   &graphql.PageInfo{StartCursor: "start", EndCursor: "end", HasNextPage: false},
+		}, result)
+
+		mock.AssertExpectationsForObjects(t, persist, transact, svc, appConv)
+	})
+
+	t.Run("Returns error when listing Applications failed", func(t *testing.T) {
+		persist, transact := txGen.ThatDoesntExpectCommit()
+		svc := &automock.ScenarioService{}
+		svc.On("ListApplications", txtest.CtxWithDBMatcher(), testName, first, "").Return(nil, testError).Once()
+
+		resolver := scenario.NewResolver(transact, svc, nil, nil, nil, nil)
+
+		// WHEN
+		_, err := resolver.Applications(ctx, obj, &first, nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+
+		mock.AssertExpectationsForObjects(t, persist, transact, svc)
+	})
+
+	t.Run("Returns error when Scenario is nil", func(t *testing.T) {
+		resolver := scenario.NewResolver(nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		_, err := resolver.Applications(ctx, nil, &first, nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Scenario cannot be empty")
+	})
+
+	t.Run("Returns error when first is missing", func(t *testing.T) {
+		resolver := scenario.NewResolver(nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		_, err := resolver.Applications(ctx, obj, nil, nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing required parameter 'first'")
+	})
+}
+
+func TestResolver_Runtimes(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	first := 2
+	modelRuntimes := []*model.Runtime{{ID: testID, Name: "runtime"}}
+	gqlRuntimes := []*graphql.Runtime{{ID: testID, Name: "runtime"}}
+	runtimePage := &model.RuntimePage{
+		Data:       modelRuntimes,
+		TotalCount: 1,
+		PageInfo:   &pagination.Page{StartCursor: "start", EndCursor: "end", HasNextPage: true},
+	}
+
+	persist, transact := txGen.ThatSucceeds()
+	svc := &automock.ScenarioService{}
+	svc.On("ListRuntimes", txtest.CtxWithDBMatcher(), testName, first, "").Return(runtimePage, nil).Once()
+	runtimeConv := &automock.RuntimeConverter{}
+	runtimeConv.On("MultipleToGraphQL", modelRuntimes).Return(gqlRuntimes).Once()
+
+	resolver := scenario.NewResolver(transact, svc, nil, nil, runtimeConv, nil)
+
+	// WHEN
+	result, err := resolver.Runtimes(ctx, fixGQLScenario(testName), &first, nil)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, &graphql.RuntimePage{
+		Data:       gqlRuntimes,
+		TotalCount: 1,
+		PageInfo:   &graphql.PageInfo{StartCursor: "start", EndCursor: "end", HasNextPage: true},
+	}, result)
+
+	mock.AssertExpectationsForObjects(t, persist, transact, svc, runtimeConv)
+}
+
+func TestResolver_RuntimeContexts(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	first := 2
+	modelRuntimeContexts := []*model.RuntimeContext{{ID: testID, Key: "key", Value: "value"}}
+	gqlRuntimeContexts := []*graphql.RuntimeContext{{ID: testID, Key: "key", Value: "value"}}
+	runtimeCtxPage := &model.RuntimeContextPage{
+		Data:       modelRuntimeContexts,
+		TotalCount: 1,
+		PageInfo:   &pagination.Page{StartCursor: "start", EndCursor: "end", HasNextPage: false},
+	}
+
+	persist, transact := txGen.ThatSucceeds()
+	svc := &automock.ScenarioService{}
+	svc.On("ListRuntimeContexts", txtest.CtxWithDBMatcher(), testName, first, "").Return(runtimeCtxPage, nil).Once()
+	runtimeCtxConv := &automock.RuntimeContextConverter{}
+	runtimeCtxConv.On("MultipleToGraphQL", modelRuntimeContexts).Return(gqlRuntimeContexts).Once()
+
+	resolver := scenario.NewResolver(transact, svc, nil, nil, nil, runtimeCtxConv)
+
+	// WHEN
+	result, err := resolver.RuntimeContexts(ctx, fixGQLScenario(testName), &first, nil)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, &graphql.RuntimeContextPage{
+		Data:       gqlRuntimeContexts,
+		TotalCount: 1,
+		PageInfo:   &graphql.PageInfo{StartCursor: "start", EndCursor: "end", HasNextPage: false},
+	}, result)
+
+	mock.AssertExpectationsForObjects(t, persist, transact, svc, runtimeCtxConv)
+}
@@ -0,0 +1,352 @@
+package scenario
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=ScenarioRepository -output=automock -outpkg=automock -case=underscore
+type ScenarioRepository interface {
+	Create(ctx context.Context, item model.Scenario) error
+	GetByName(ctx context.Context, tenant, name string) (*model.Scenario, error)
+	List(ctx context.Context, tenant string) ([]*model.Scenario, error)
+	Update(ctx context.Context, item model.Scenario) error
+}
+
+//go:generate mockery -name=LabelDefinitionService -output=automock -outpkg=automock -case=underscore
+type LabelDefinitionService interface {
+	Get(ctx context.Context, tenant string, key string) (*model.LabelDefinition, error)
+	Update(ctx context.Context, def model.LabelDefinition) error
+}
+
+//go:generate mockery -name=ScenariosService -output=automock -outpkg=automock -case=underscore
+type ScenariosService interface {
+	EnsureScenariosLabelDefinitionExists(ctx context.Context, tenant string) error
+}
+
+//go:generate mockery -name=ApplicationService -output=automock -outpkg=automock -case=underscore
+type ApplicationService interface {
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error)
+}
+
+//go:generate mockery -name=RuntimeService -output=automock -outpkg=automock -case=underscore
+type RuntimeService interface {
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
+}
+
+//go:generate mockery -name=RuntimeContextService -output=automock -outpkg=automock -case=underscore
+type RuntimeContextService interface {
+	ListAll(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimeContextPage, error)
+}
+
+//go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	repo              ScenarioRepository
+	labelDefService   LabelDefinitionService
+	scenariosService  ScenariosService
+	appService        ApplicationService
+	runtimeService    RuntimeService
+	runtimeCtxService RuntimeContextService
+	uidService        UIDService
+}
+
+func NewService(repo ScenarioRepository, labelDefService LabelDefinitionService, scenariosService ScenariosService, appService ApplicationService, runtimeService RuntimeService, runtimeCtxService RuntimeContextService, uidService UIDService) *service {
+	return &service{
+		repo:              repo,
+		labelDefService:   labelDefService,
+		scenariosService:  scenariosService,
+		appService:        appService,
+		runtimeService:    runtimeService,
+		runtimeCtxService: runtimeCtxService,
+		uidService:        uidService,
+	}
+}
+
+// List returns all scenarios from the scenarios label definition together with their metadata
+func (s *service) List(ctx context.Context) ([]*model.Scenario, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ld, err := s.getScenariosLabelDefinition(ctx, tnt)
+	if err != nil {
+		return nil, err
+	}
+	names, err := scenariosFromSchema(ld.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := s.repo.List(ctx, tnt)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing scenarios")
+	}
+	metadataByName := make(map[string]*model.Scenario, len(metadata))
+	for _, m := range metadata {
+		metadataByName[m.Name] = m
+	}
+
+	scenarios := make([]*model.Scenario, 0, len(names))
+	for _, name := range names {
+		if m, ok := metadataByName[name]; ok {
+			scenarios = append(scenarios, m)
+			continue
+		}
+		scenarios = append(scenarios, &model.Scenario{Tenant: tnt, Name: name})
+	}
+
+	return scenarios, nil
+}
+
+// Get returns the scenario with the given name. Scenarios without metadata are returned only with the name.
+func (s *service) Get(ctx context.Context, name string) (*model.Scenario, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ld, err := s.getScenariosLabelDefinition(ctx, tnt)
+	if err != nil {
+		return nil, err
+	}
+	names, err := scenariosFromSchema(ld.Schema)
+	if err != nil {
+		return nil, err
+	}
+	if !contains(names, name) {
+		return nil, apperrors.NewNotFoundError(resource.Scenario, name)
+	}
+
+	return s.getMetadata(ctx, tnt, name)
+}
+
+// Create adds the scenario to the scenarios label definition and stores its metadata
+func (s *service) Create(ctx context.Context, in model.ScenarioInput) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	ld, err := s.getScenariosLabelDefinition(ctx, tnt)
+	if err != nil {
+		return err
+	}
+	names, err := scenariosFromSchema(ld.Schema)
+	if err != nil {
+		return err
+	}
+	if contains(names, in.Name) {
+		return apperrors.NewNotUniqueError(resource.Scenario)
+	}
+
+	if err := s.updateScenarios(ctx, *ld, append(names, in.Name)); err != nil {
+		return errors.Wrapf(err, "while adding scenario %s to label definition", in.Name)
+	}
+
+	return s.saveMetadata(ctx, tnt, in)
+}
+
+// Update updates the metadata of the scenario
+func (s *service) Update(ctx context.Context, in model.ScenarioInput) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	ld, err := s.getScenariosLabelDefinition(ctx, tnt)
+	if err != nil {
+		return err
+	}
+	names, err := scenariosFromSchema(ld.Schema)
+	if err != nil {
+		return err
+	}
+	if !contains(names, in.Name) {
+		return apperrors.NewNotFoundError(resource.Scenario, in.Name)
+	}
+
+	return s.saveMetadata(ctx, tnt, in)
+}
+
+// Delete removes the scenario from the scenarios label definition, which also deletes its metadata.
+// The label definition service rejects the removal of scenarios which are used in labels or Automatic Scenario Assignments.
+func (s *service) Delete(ctx context.Context, name string) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if name == model.DefaultScenario {
+		return apperrors.NewInvalidDataError("%s scenario cannot be deleted", model.DefaultScenario)
+	}
+
+	ld, err := s.getScenariosLabelDefinition(ctx, tnt)
+	if err != nil {
+		return err
+	}
+	names, err := scenariosFromSchema(ld.Schema)
+	if err != nil {
+		return err
+	}
+	if !contains(names, name) {
+		return apperrors.NewNotFoundError(resource.Scenario, name)
+	}
+
+	remaining := make([]string, 0, len(names)-1)
+	for _, n := range names {
+		if n != name {
+			remaining = append(remaining, n)
+		}
+	}
+
+	if err := s.updateScenarios(ctx, *ld, remaining); err != nil {
+		return errors.Wrapf(err, "while removing scenario %s from label definition", name)
+	}
+
+	return nil
+}
+
+func (s *service) ListApplications(ctx context.Context, name string, pageSize int, cursor string) (*model.ApplicationPage, error) {
+	return s.appService.List(ctx, scenarioFilter(name), pageSize, cursor)
+}
+
+// ListRuntimes lists the Runtimes labeled with the scenario, which includes the ones assigned by Automatic Scenario Assignments
+func (s *service) ListRuntimes(ctx context.Context, name string, pageSize int, cursor string) (*model.RuntimePage, error) {
+	return s.runtimeService.List(ctx, scenarioFilter(name), pageSize, cursor)
+}
+
+func (s *service) ListRuntimeContexts(ctx context.Context, name string, pageSize int, cursor string) (*model.RuntimeContextPage, error) {
+	return s.runtimeCtxService.ListAll(ctx, scenarioFilter(name), pageSize, cursor)
+}
+
+func (s *service) getScenariosLabelDefinition(ctx context.Context, tnt string) (*model.LabelDefinition, error) {
+	if err := s.scenariosService.EnsureScenariosLabelDefinitionExists(ctx, tnt); err != nil {
+		return nil, errors.Wrapf(err, "while ensuring that `%s` label definition exists", model.ScenariosKey)
+	}
+
+	ld, err := s.labelDefService.Get(ctx, tnt, model.ScenariosKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting `%s` label definition", model.ScenariosKey)
+	}
+	if ld == nil {
+		return nil, fmt.Errorf("missing `%s` label definition", model.ScenariosKey)
+	}
+	return ld, nil
+}
+
+func (s *service) updateScenarios(ctx context.Context, ld model.LabelDefinition, names []string) error {
+	schema, err := schemaWithScenarios(ld.Schema, names)
+	if err != nil {
+		return err
+	}
+	ld.Schema = schema
+
+	return s.labelDefService.Update(ctx, ld)
+}
+
+func (s *service) getMetadata(ctx context.Context, tnt, name string) (*model.Scenario, error) {
+	scenario, err := s.repo.GetByName(ctx, tnt, name)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return &model.Scenario{Tenant: tnt, Name: name}, nil
+		}
+		return nil, errors.Wrapf(err, "while getting scenario %s", name)
+	}
+	return scenario, nil
+}
+
+// saveMetadata creates the metadata of the scenario or updates it, if the scenario already has metadata
+func (s *service) saveMetadata(ctx context.Context, tnt string, in model.ScenarioInput) error {
+	existing, err := s.repo.GetByName(ctx, tnt, in.Name)
+	if err != nil && !apperrors.IsNotFoundError(err) {
+		return errors.Wrapf(err, "while getting scenario %s", in.Name)
+	}
+
+	if existing == nil {
+		if err := s.repo.Create(ctx, in.ToScenario(s.uidService.Generate(), tnt)); err != nil {
+			return errors.Wrapf(err, "while creating scenario %s", in.Name)
+		}
+		return nil
+	}
+
+	if err := s.repo.Update(ctx, in.ToScenario(existing.ID, tnt)); err != nil {
+		return errors.Wrapf(err, "while updating scenario %s", in.Name)
+	}
+	return nil
+}
+
+func scenarioFilter(name string) []*labelfilter.LabelFilter {
+	query := fmt.Sprintf(`$[*] ? (@ == "%s")`, name)
+	return []*labelfilter.LabelFilter{labelfilter.NewForKeyWithQuery(model.ScenariosKey, query)}
+}
+
+func scenariosFromSchema(schema *interface{}) ([]string, error) {
+	if schema == nil {
+		return nil, fmt.Errorf("missing schema for `%s` label definition", model.ScenariosKey)
+	}
+
+	b, err := json.Marshal(*schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshaling schema")
+	}
+	var sd struct {
+		Items struct {
+			Enum []string `json:"enum"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(b, &sd); err != nil {
+		return nil, errors.Wrapf(err, "while unmarshaling schema of `%s` label definition", model.ScenariosKey)
+	}
+	return sd.Items.Enum, nil
+}
+
+// schemaWithScenarios returns a copy of the schema with the given scenarios in the enum
+func schemaWithScenarios(schema *interface{}, names []string) (*interface{}, error) {
+	if schema == nil {
+		return nil, fmt.Errorf("missing schema for `%s` label definition", model.ScenariosKey)
+	}
+
+	b, err := json.Marshal(*schema)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshaling schema")
+	}
+	var schemaMap map[string]interface{}
+	if err := json.Unmarshal(b, &schemaMap); err != nil {
+		return nil, errors.Wrapf(err, "while unmarshaling schema of `%s` label definition", model.ScenariosKey)
+	}
+
+	items, ok := schemaMap["items"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("missing items in schema of `%s` label definition", model.ScenariosKey)
+	}
+	enum := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		enum = append(enum, name)
+	}
+	items["enum"] = enum
+
+	var out interface{} = schemaMap
+	return &out, nil
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,581 @@
+package scenario_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenario"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/scenario/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const otherName = "SALES"
+
+func TestService_List(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExtTenant)
+
+	testCases := []struct {
+		Name               string
+		ScenariosServiceFn func() *automock.ScenariosService
+		LabelDefServiceFn  func() *automock.LabelDefinitionService
+		RepositoryFn       func() *automock.ScenarioRepository
+		ExpectedResult     []*model.Scenario
+		ExpectedErrMessage string
+	}{
+		{
+			Name:               "Success",
+			ScenariosServiceFn: fixScenariosServiceThatEnsures(ctx),
+			LabelDefServiceFn: func() *automock.LabelDefinitionService {
+				svc := &automock.LabelDefinitionService{}
+				svc.On("Get", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition(model.DefaultScenario, testName), nil).Once()
+				return svc
+			},
+			RepositoryFn: func() *automock.ScenarioRepository {
+				repo := &automock.ScenarioRepository{}
+				repo.On("List", ctx, testTenant).Return([]*model.Scenario{
+					fixModelScenario(testID, testName),
+					fixModelScenario("cccccccc-cccc-cccc-cccc-cccccccccccc", "REMOVED"),
+				}, nil).Once()
+				return repo
+			},
+			ExpectedResult: []*model.Scenario{
+				{Tenant: testTenant, Name: model.DefaultScenario},
+				fixModelScenario(testID, testName),
+			},
+		},
+		{
+			Name:               "Error when getting label definition",
+			ScenariosServiceFn: fixScenariosServiceThatEnsures(ctx),
+			LabelDefServiceFn: func() *automock.LabelDefinitionService {
+				svc := &automock.LabelDefinitionService{}
+				svc.On("Get", ctx, testTenant, model.ScenariosKey).Return(nil, testError).Once()
+				return svc
+			},
+			RepositoryFn: func() *automock.ScenarioRepository {
+				return &automock.ScenarioRepository{}
+			},
+			ExpectedErrMessage: "while getting `scenarios` label definition",
+		},
+		{
+			Name:               "Error when label definition does not exist",
+			ScenariosServiceFn: fixScenariosServiceThatEnsures(ctx),
+			LabelDefServiceFn: func() *automock.LabelDefinitionService {
+				svc := &automock.LabelDefinitionService{}
+				svc.On("Get", ctx, testTenant, model.ScenariosKey).Return(nil, nil).Once()
+				return svc
+			},
+			RepositoryFn: func() *automock.ScenarioRepository {
+				return &automock.ScenarioRepository{}
+			},
+			ExpectedErrMessage: "missing `scenarios` label definition",
+		},
+		{
+			Name:               "Error when listing metadata",
+			ScenariosServiceFn: fixScenariosServiceThatEnsures(ctx),
+			LabelDefServiceFn: func() *automock.LabelDefinitionService {
+				svc := &automock.LabelDefinitionService{}
+				svc.On("Get", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition(model.DefaultScenario), nil).Once()
+				return svc
+			},
+			RepositoryFn: func() *automock.ScenarioRepository {
+				repo := &automock.ScenarioRepository{}
+				repo.On("List", ctx, testTenant).Return(nil, testError).Once()
+				return repo
+			},
+			ExpectedErrMessage: "while listing scenarios",
+		},
+		{
+			Name: "Error when ensuring label definition",
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				svc := &automock.ScenariosService{}
+				svc.On("EnsureScenariosLabelDefinitionExists", ctx, testTenant).Return(testError).Once()
+				return svc
+			},
+			LabelDefServiceFn: func() *automock.LabelDefinitionService {
+				return &automock.LabelDefinitionService{}
+			},
+			RepositoryFn: func() *automock.ScenarioRepository {
+				return &automock.ScenarioRepository{}
+			},
+			ExpectedErrMessage: "while ensuring that `scenarios` label definition exists",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			scenariosSvc := testCase.ScenariosServiceFn()
+			labelDefSvc := testCase.LabelDefServiceFn()
+			repo := testCase.RepositoryFn()
+			svc := scenario.NewService(repo, labelDefSvc, scenariosSvc, nil, nil, nil, nil)
+
+			// WHEN
+			result, err := svc.List(ctx)
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			mock.AssertExpectationsForObjects(t, scenariosSvc, labelDefSvc, repo)
+		})
+	}
+
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := scenario.NewService(nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		_, err := svc.List(context.TODO())
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_Get(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExtTenant)
+
+	testCases := []struct {
+		Name               string
+		ScenarioName       string
+		RepositoryFn       func() *automock.ScenarioRepository
+		ExpectedResult     *model.Scenario
+		ExpectedErr        error
+		ExpectedErrMessage string
+	}{
+		{
+			Name:         "Success",
+			ScenarioName: testName,
+			RepositoryFn: func() *automock.ScenarioRepository {
+				repo := &automock.ScenarioRepository{}
+				repo.On("GetByName", ctx, testTenant, testName).Return(fixModelScenario(testID, testName), nil).Once()
+				return repo
+			},
+			ExpectedResult: fixModelScenario(testID, testName),
+		},
+		{
+			Name:         "Success when scenario has no metadata",
+			ScenarioName: model.DefaultScenario,
+			RepositoryFn: func() *automock.ScenarioRepository {
+				repo := &automock.ScenarioRepository{}
+				repo.On("GetByName", ctx, testTenant, model.DefaultScenario).Return(nil, apperrors.NewNotFoundError(resource.Scenario, model.DefaultScenario)).Once()
+				return repo
+			},
+			ExpectedResult: &model.Scenario{Tenant: testTenant, Name: model.DefaultScenario},
+		},
+		{
+			Name:         "Error when scenario is not in label definition",
+			ScenarioName: otherName,
+			RepositoryFn: func() *automock.ScenarioRepository {
+				return &automock.ScenarioRepository{}
+			},
+			ExpectedErr: apperrors.NewNotFoundError(resource.Scenario, otherName),
+		},
+		{
+			Name:         "Error when getting metadata",
+			ScenarioName: testName,
+			RepositoryFn: func() *automock.ScenarioRepository {
+				repo := &automock.ScenarioRepository{}
+				repo.On("GetByName", ctx, testTenant, testName).Return(nil, testError).Once()
+				return repo
+			},
+			ExpectedErrMessage: "while getting scenario MARKETING",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			scenariosSvc := fixScenariosServiceThatEnsures(ctx)()
+			labelDefSvc := &automock.LabelDefinitionService{}
+			labelDefSvc.On("Get", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition(model.DefaultScenario, testName), nil).Once()
+			repo := testCase.RepositoryFn()
+			svc := scenario.NewService(repo, labelDefSvc, scenariosSvc, nil, nil, nil, nil)
+
+			// WHEN
+			result, err := svc.Get(ctx, testCase.ScenarioName)
+
+			// THEN
+			switch {
+			case testCase.ExpectedErr != nil:
+				require.Error(t, err)
+				assert.Equal(t, testCase.ExpectedErr, err)
+			case testCase.ExpectedErrMessage != "":
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			default:
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, scenariosSvc, labelDefSvc, repo)
+		})
+	}
+}
+
+func TestService_Create(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExtTenant)
+	in := fixModelScenarioInput(testName)
+
+	testCases := []struct {
+		Name               string
+		LabelDefServiceFn  func() *automock.LabelDefinitionService
+		RepositoryFn       func() *automock.ScenarioRepository
+		UIDServiceFn       func() *automock.UIDService
+		ExpectedErr        error
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			LabelDefServiceFn: func() *automock.LabelDefinitionService {
+				svc := &automock.LabelDefinitionService{}
+				svc.On("Get", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition(model.DefaultScenario), nil).Once()
+				svc.On("Update", ctx, *fixScenariosLabelDefinition(model.DefaultScenario, testName)).Return(nil).Once()
+				return svc
+			},
+			RepositoryFn: func() *automock.ScenarioRepository {
+				repo := &automock.ScenarioRepository{}
+				repo.On("GetByName", ctx, testTenant, testName).Return(nil, apperrors.NewNotFoundError(resource.Scenario, testName)).Once()
+				repo.On("Create", ctx, *fixModelScenario(testID, testName)).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(testID).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Success when stale metadata exists",
+			LabelDefServiceFn: func() *automock.LabelDefinitionService {
+				svc := &automock.LabelDefinitionService{}
+				svc.On("Get", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition(model.DefaultScenario), nil).Once()
+				svc.On("Update", ctx, *fixScenariosLabelDefinition(model.DefaultScenario, testName)).Return(nil).Once()
+				return svc
+			},
+			RepositoryFn: func() *automock.ScenarioRepository {
+				repo := &automock.ScenarioRepository{}
+				repo.On("GetByName", ctx, testTenant, testName).Return(&model.Scenario{ID: testID, Tenant: testTenant, Name: testName}, nil).Once()
+				repo.On("Update", ctx, *fixModelScenario(testID, testName)).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+		},
+		{
+			Name: "Error when scenario already exists",
+			LabelDefServiceFn: func() *automock.LabelDefinitionService {
+				svc := &automock.LabelDefinitionService{}
+				svc.On("Get", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition(model.DefaultScenario, testName), nil).Once()
+				return svc
+			},
+			RepositoryFn: func() *automock.ScenarioRepository {
+				return &automock.ScenarioRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErr: apperrors.NewNotUniqueError(resource.Scenario),
+		},
+		{
+			Name: "Error when updating label definition",
+			LabelDefServiceFn: func() *automock.LabelDefinitionService {
+				svc := &automock.LabelDefinitionService{}
+				svc.On("Get", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition(model.DefaultScenario), nil).Once()
+				svc.On("Update", ctx, *fixScenariosLabelDefinition(model.DefaultScenario, testName)).Return(testError).Once()
+				return svc
+			},
+			RepositoryFn: func() *automock.ScenarioRepository {
+				return &automock.ScenarioRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			ExpectedErrMessage: "while adding scenario MARKETING to label definition",
+		},
+		{
+			Name: "Error when creating metadata",
+			LabelDefServiceFn: func() *automock.LabelDefinitionService {
+				svc := &automock.LabelDefinitionService{}
+				svc.On("Get", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition(model.DefaultScenario), nil).Once()
+				svc.On("Update", ctx, *fixScenariosLabelDefinition(model.DefaultScenario, testName)).Return(nil).Once()
+				return svc
+			},
+			RepositoryFn: func() *automock.ScenarioRepository {
+				repo := &automock.ScenarioRepository{}
+				repo.On("GetByName", ctx, testTenant, testName).Return(nil, apperrors.NewNotFoundError(resource.Scenario, testName)).Once()
+				repo.On("Create", ctx, *fixModelScenario(testID, testName)).Return(testError).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(testID).Once()
+				return svc
+			},
+			ExpectedErrMessage: "while creating scenario MARKETING",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			scenariosSvc := fixScenariosServiceThatEnsures(ctx)()
+			labelDefSvc := testCase.LabelDefServiceFn()
+			repo := testCase.RepositoryFn()
+			uidSvc := testCase.UIDServiceFn()
+			svc := scenario.NewService(repo, labelDefSvc, scenariosSvc, nil, nil, nil, uidSvc)
+
+			// WHEN
+			err := svc.Create(ctx, in)
+
+			// THEN
+			switch {
+			case testCase.ExpectedErr != nil:
+				require.Error(t, err)
+				assert.Equal(t, testCase.ExpectedErr, err)
+			case testCase.ExpectedErrMessage != "":
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			default:
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, scenariosSvc, labelDefSvc, repo, uidSvc)
+		})
+	}
+}
+
+func TestService_Update(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExtTenant)
+
+	testCases := []struct {
+		Name               string
+		Input              model.ScenarioInput
+		RepositoryFn       func() *automock.ScenarioRepository
+		ExpectedErr        error
+		ExpectedErrMessage string
+	}{
+		{
+			Name:  "Success",
+			Input: fixModelScenarioInput(testName),
+			RepositoryFn: func() *automock.ScenarioRepository {
+				repo := &automock.ScenarioRepository{}
+				repo.On("GetByName", ctx, testTenant, testName).Return(&model.Scenario{ID: testID, Tenant: testTenant, Name: testName}, nil).Once()
+				repo.On("Update", ctx, *fixModelScenario(testID, testName)).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			Name:  "Error when scenario does not exist",
+			Input: fixModelScenarioInput(otherName),
+			RepositoryFn: func() *automock.ScenarioRepository {
+				return &automock.ScenarioRepository{}
+			},
+			ExpectedErr: apperrors.NewNotFoundError(resource.Scenario, otherName),
+		},
+		{
+			Name:  "Error when updating metadata",
+			Input: fixModelScenarioInput(testName),
+			RepositoryFn: func() *automock.ScenarioRepository {
+				repo := &automock.ScenarioRepository{}
+				repo.On("GetByName", ctx, testTenant, testName).Return(&model.Scenario{ID: testID, Tenant: testTenant, Name: testName}, nil).Once()
+				repo.On("Update", ctx, *fixModelScenario(testID, testName)).Return(testError).Once()
+				return repo
+			},
+			ExpectedErrMessage: "while updating scenario MARKETING",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			scenariosSvc := fixScenariosServiceThatEnsures(ctx)()
+			labelDefSvc := &automock.LabelDefinitionService{}
+			labelDefSvc.On("Get", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition(model.DefaultScenario, testName), nil).Once()
+			repo := testCase.RepositoryFn()
+			svc := scenario.NewService(repo, labelDefSvc, scenariosSvc, nil, nil, nil, nil)
+
+			// WHEN
+			err := svc.Update(ctx, testCase.Input)
+
+			// THEN
+			switch {
+			case testCase.ExpectedErr != nil:
+				require.Error(t, err)
+				assert.Equal(t, testCase.ExpectedErr, err)
+			case testCase.ExpectedErrMessage != "":
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			default:
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, scenariosSvc, labelDefSvc, repo)
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExtTenant)
+
+	testCases := []struct {
+		Name               string
+		ScenarioName       string
+		ScenariosServiceFn func() *automock.ScenariosService
+		LabelDefServiceFn  func() *automock.LabelDefinitionService
+		RepositoryFn       func() *automock.ScenarioRepository
+		ExpectedErr        error
+		ExpectedErrMessage string
+	}{
+		{
+			Name:               "Success",
+			ScenarioName:       testName,
+			ScenariosServiceFn: fixScenariosServiceThatEnsures(ctx),
+			LabelDefServiceFn: func() *automock.LabelDefinitionService {
+				svc := &automock.LabelDefinitionService{}
+				svc.On("Get", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition(model.DefaultScenario, testName, otherName), nil).Once()
+				svc.On("Update", ctx, *fixScenariosLabelDefinition(model.DefaultScenario, otherName)).Return(nil).Once()
+				return svc
+			},
+			RepositoryFn: func() *automock.ScenarioRepository {
+				return &automock.ScenarioRepository{}
+			},
+		},
+		{
+			Name:         "Error when deleting default scenario",
+			ScenarioName: model.DefaultScenario,
+			ScenariosServiceFn: func() *automock.ScenariosService {
+				return &automock.ScenariosService{}
+			},
+			LabelDefServiceFn: func() *automock.LabelDefinitionService {
+				return &automock.LabelDefinitionService{}
+			},
+			RepositoryFn: func() *automock.ScenarioRepository {
+				return &automock.ScenarioRepository{}
+			},
+			ExpectedErrMessage: "DEFAULT scenario cannot be deleted",
+		},
+		{
+			Name:               "Error when scenario does not exist",
+			ScenarioName:       otherName,
+			ScenariosServiceFn: fixScenariosServiceThatEnsures(ctx),
+			LabelDefServiceFn: func() *automock.LabelDefinitionService {
+				svc := &automock.LabelDefinitionService{}
+				svc.On("Get", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition(model.DefaultScenario, testName), nil).Once()
+				return svc
+			},
+			RepositoryFn: func() *automock.ScenarioRepository {
+				return &automock.ScenarioRepository{}
+			},
+			ExpectedErr: apperrors.NewNotFoundError(resource.Scenario, otherName),
+		},
+		{
+			Name:               "Error when scenario is in use",
+			ScenarioName:       testName,
+			ScenariosServiceFn: fixScenariosServiceThatEnsures(ctx),
+			LabelDefServiceFn: func() *automock.LabelDefinitionService {
+				svc := &automock.LabelDefinitionService{}
+				svc.On("Get", ctx, testTenant, model.ScenariosKey).Return(fixScenariosLabelDefinition(model.DefaultScenario, testName), nil).Once()
+				svc.On("Update", ctx, *fixScenariosLabelDefinition(model.DefaultScenario)).Return(testError).Once()
+				return svc
+			},
+			RepositoryFn: func() *automock.ScenarioRepository {
+				return &automock.ScenarioRepository{}
+			},
+			ExpectedErrMessage: "while removing scenario MARKETING from label definition",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			scenariosSvc := testCase.ScenariosServiceFn()
+			labelDefSvc := testCase.LabelDefServiceFn()
+			repo := testCase.RepositoryFn()
+			svc := scenario.NewService(repo, labelDefSvc, scenariosSvc, nil, nil, nil, nil)
+
+			// WHEN
+			err := svc.Delete(ctx, testCase.ScenarioName)
+
+			// THEN
+			switch {
+			case testCase.ExpectedErr != nil:
+				require.Error(t, err)
+				assert.Equal(t, testCase.ExpectedErr, err)
+			case testCase.ExpectedErrMessage != "":
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			default:
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, scenariosSvc, labelDefSvc, repo)
+		})
+	}
+}
+
+func TestService_ListMembers(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExtTenant)
+	expectedFilter := []*labelfilter.LabelFilter{labelfilter.NewForKeyWithQuery(model.ScenariosKey, `$[*] ? (@ == "MARKETING")`)}
+	pageSize := 50
+	cursor := "cursor"
+
+	t.Run("Applications", func(t *testing.T) {
+		appPage := &model.ApplicationPage{Data: []*model.Application{{ID: testID}}, TotalCount: 1}
+		appSvc := &automock.ApplicationService{}
+		appSvc.On("List", ctx, expectedFilter, pageSize, cursor).Return(appPage, nil).Once()
+		svc := scenario.NewService(nil, nil, nil, appSvc, nil, nil, nil)
+
+		// WHEN
+		result, err := svc.ListApplications(ctx, testName, pageSize, cursor)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, appPage, result)
+		appSvc.AssertExpectations(t)
+	})
+
+	t.Run("Runtimes", func(t *testing.T) {
+		runtimePage := &model.RuntimePage{Data: []*model.Runtime{{ID: testID}}, TotalCount: 1}
+		runtimeSvc := &automock.RuntimeService{}
+		runtimeSvc.On("List", ctx, expectedFilter, pageSize, cursor).Return(runtimePage, nil).Once()
+		svc := scenario.NewService(nil, nil, nil, nil, runtimeSvc, nil, nil)
+
+		// WHEN
+		result, err := svc.ListRuntimes(ctx, testName, pageSize, cursor)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, runtimePage, result)
+		runtimeSvc.AssertExpectations(t)
+	})
+
+	t.Run("RuntimeContexts", func(t *testing.T) {
+		runtimeCtxPage := &model.RuntimeContextPage{Data: []*model.RuntimeContext{{ID: testID}}, TotalCount: 1}
+		runtimeCtxSvc := &automock.RuntimeContextService{}
+		runtimeCtxSvc.On("ListAll", ctx, expectedFilter, pageSize, cursor).Return(runtimeCtxPage, nil).Once()
+		svc := scenario.NewService(nil, nil, nil, nil, nil, runtimeCtxSvc, nil)
+
+		// WHEN
+		result, err := svc.ListRuntimeContexts(ctx, testName, pageSize, cursor)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, runtimeCtxPage, result)
+		runtimeCtxSvc.AssertExpectations(t)
+	})
+}
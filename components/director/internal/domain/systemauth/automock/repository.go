@@ -28,6 +28,20 @@ func (_m *Repository) Create(ctx context.Context, item model.SystemAuth) error {
 	return r0
 }
 
+// DeleteAllForTenant provides a mock function with given fields: ctx, tenant
+func (_m *Repository) DeleteAllForTenant(ctx context.Context, tenant string) error {
+	ret := _m.Called(ctx, tenant)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, tenant)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteByIDForObject provides a mock function with given fields: ctx, tenant, id, objType
 func (_m *Repository) DeleteByIDForObject(ctx context.Context, tenant string, id string, objType model.SystemAuthReferenceObjectType) error {
 	ret := _m.Called(ctx, tenant, id, objType)
@@ -147,3 +161,49 @@ func (_m *Repository) ListForObjectGlobal(ctx context.Context, objectType model.
 
 	return r0, r1
 }
+
+// ListForObjectIDs provides a mock function with given fields: ctx, tenant, objectType, objectIDs
+func (_m *Repository) ListForObjectIDs(ctx context.Context, tenant string, objectType model.SystemAuthReferenceObjectType, objectIDs []string) ([]model.SystemAuth, error) {
+	ret := _m.Called(ctx, tenant, objectType, objectIDs)
+
+	var r0 []model.SystemAuth
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.SystemAuthReferenceObjectType, []string) []model.SystemAuth); ok {
+		r0 = rf(ctx, tenant, objectType, objectIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SystemAuth)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.SystemAuthReferenceObjectType, []string) error); ok {
+		r1 = rf(ctx, tenant, objectType, objectIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListForTenant provides a mock function with given fields: ctx, tenant
+func (_m *Repository) ListForTenant(ctx context.Context, tenant string) ([]model.SystemAuth, error) {
+	ret := _m.Called(ctx, tenant)
+
+	var r0 []model.SystemAuth
+	if rf, ok := ret.Get(0).(func(context.Context, string) []model.SystemAuth); ok {
+		r0 = rf(ctx, tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SystemAuth)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -59,6 +59,7 @@ func (c *converter) ToEntity(in model.SystemAuth) (Entity, error) {
 		TenantID:            repo.NewNullableString(in.TenantID),
 		AppID:               repo.NewNullableString(in.AppID),
 		RuntimeID:           repo.NewNullableString(in.RuntimeID),
+		RuntimeContextID:    repo.NewNullableString(in.RuntimeContextID),
 		IntegrationSystemID: repo.NewNullableString(in.IntegrationSystemID),
 		Value:               value,
 	}, nil
@@ -80,6 +81,7 @@ func (c *converter) FromEntity(in Entity) (model.SystemAuth, error) {
 		TenantID:            repo.StringPtrFromNullableString(in.TenantID),
 		AppID:               repo.StringPtrFromNullableString(in.AppID),
 		RuntimeID:           repo.StringPtrFromNullableString(in.RuntimeID),
+		RuntimeContextID:    repo.StringPtrFromNullableString(in.RuntimeContextID),
 		IntegrationSystemID: repo.StringPtrFromNullableString(in.IntegrationSystemID),
 		Value:               value,
 	}, nil
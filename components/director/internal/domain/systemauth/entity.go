@@ -7,6 +7,7 @@ type Entity struct {
 	TenantID            sql.NullString `db:"tenant_id"`
 	AppID               sql.NullString `db:"app_id"`
 	RuntimeID           sql.NullString `db:"runtime_id"`
+	RuntimeContextID    sql.NullString `db:"runtime_context_id"`
 	IntegrationSystemID sql.NullString `db:"integration_system_id"`
 	Value               sql.NullString `db:"value"`
 }
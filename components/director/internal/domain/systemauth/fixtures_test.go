@@ -20,7 +20,7 @@ var (
 	testErr              = errors.New("test error")
 )
 
-var testTableColumns = []string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value"}
+var testTableColumns = []string{"id", "tenant_id", "app_id", "runtime_id", "runtime_context_id", "integration_system_id", "value"}
 
 func fixGQLSystemAuth(id string, auth *graphql.Auth) *graphql.SystemAuth {
 	return &graphql.SystemAuth{
@@ -42,6 +42,9 @@ func fixModelSystemAuth(id string, objectType model.SystemAuthReferenceObjectTyp
 	case model.RuntimeReference:
 		systemAuth.RuntimeID = &objectID
 		systemAuth.TenantID = &testTenant
+	case model.RuntimeContextReference:
+		systemAuth.RuntimeContextID = &objectID
+		systemAuth.TenantID = &testTenant
 	case model.IntegrationSystemReference:
 		systemAuth.IntegrationSystemID = &objectID
 		systemAuth.TenantID = nil
@@ -136,6 +139,9 @@ func fixEntity(id string, objectType model.SystemAuthReferenceObjectType, object
 	case model.RuntimeReference:
 		out.RuntimeID = repo.NewNullableString(&objectID)
 		out.TenantID = repo.NewNullableString(&testTenant)
+	case model.RuntimeContextReference:
+		out.RuntimeContextID = repo.NewNullableString(&objectID)
+		out.TenantID = repo.NewNullableString(&testTenant)
 	case model.IntegrationSystemReference:
 		out.IntegrationSystemID = repo.NewNullableString(&objectID)
 		out.TenantID = repo.NewNullableString(nil)
@@ -153,17 +159,18 @@ type sqlRow struct {
 	tenant   *string
 	appID    *string
 	rtmID    *string
+	rtmCtxID *string
 	intSysID *string
 }
 
 func fixSQLRows(rows []sqlRow) *sqlmock.Rows {
 	out := sqlmock.NewRows(testTableColumns)
 	for _, row := range rows {
-		out.AddRow(row.id, row.tenant, row.appID, row.rtmID, row.intSysID, testMarshalledSchema)
+		out.AddRow(row.id, row.tenant, row.appID, row.rtmID, row.rtmCtxID, row.intSysID, testMarshalledSchema)
 	}
 	return out
 }
 
 func fixSystemAuthCreateArgs(ent systemauth.Entity) []driver.Value {
-	return []driver.Value{ent.ID, ent.TenantID, ent.AppID, ent.RuntimeID, ent.IntegrationSystemID, ent.Value}
+	return []driver.Value{ent.ID, ent.TenantID, ent.AppID, ent.RuntimeID, ent.RuntimeContextID, ent.IntegrationSystemID, ent.Value}
 }
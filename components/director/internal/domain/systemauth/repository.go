@@ -17,7 +17,7 @@ import (
 const tableName string = `public.system_auths`
 
 var (
-	tableColumns = []string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value"}
+	tableColumns = []string{"id", "tenant_id", "app_id", "runtime_id", "runtime_context_id", "integration_system_id", "value"}
 	tenantColumn = "tenant_id"
 )
 
@@ -111,6 +111,30 @@ func (r *repository) ListForObject(ctx context.Context, tenant string, objectTyp
 	return r.multipleFromEntities(entities)
 }
 
+func (r *repository) ListForObjectIDs(ctx context.Context, tenant string, objectType model.SystemAuthReferenceObjectType, objectIDs []string) ([]model.SystemAuth, error) {
+	objTypeFieldName, err := referenceObjectField(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(objectIDs) == 0 {
+		return nil, nil
+	}
+
+	var entities Collection
+
+	conditions := repo.Conditions{
+		repo.NewInConditionForStringValues(objTypeFieldName, objectIDs),
+	}
+
+	err = r.lister.List(ctx, tenant, &entities, conditions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
 func (r *repository) ListForObjectGlobal(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error) {
 	objTypeFieldName, err := referenceObjectField(objectType)
 	if err != nil {
@@ -131,6 +155,16 @@ func (r *repository) ListForObjectGlobal(ctx context.Context, objectType model.S
 	return r.multipleFromEntities(entities)
 }
 
+func (r *repository) ListForTenant(ctx context.Context, tenant string) ([]model.SystemAuth, error) {
+	var entities Collection
+
+	if err := r.lister.List(ctx, tenant, &entities); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
 func (r *repository) multipleFromEntities(entities Collection) ([]model.SystemAuth, error) {
 
 	var items []model.SystemAuth
@@ -158,6 +192,10 @@ func (r *repository) DeleteAllForObject(ctx context.Context, tenant string, obje
 	return r.deleter.DeleteMany(ctx, tenant, repo.Conditions{repo.NewEqualCondition(objTypeFieldName, objectID)})
 }
 
+func (r *repository) DeleteAllForTenant(ctx context.Context, tenant string) error {
+	return r.deleter.DeleteMany(ctx, tenant, repo.Conditions{})
+}
+
 func (r *repository) DeleteByIDForObject(ctx context.Context, tenant, id string, objType model.SystemAuthReferenceObjectType) error {
 	var objTypeCond repo.Condition
 
@@ -188,6 +226,8 @@ func referenceObjectField(objectType model.SystemAuthReferenceObjectType) (strin
 		return "app_id", nil
 	case model.RuntimeReference:
 		return "runtime_id", nil
+	case model.RuntimeContextReference:
+		return "runtime_context_id", nil
 	case model.IntegrationSystemReference:
 		return "integration_system_id", nil
 	}
@@ -161,10 +161,10 @@ func TestRepository_GetByID(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value"}).
-			AddRow(saID, testTenant, saEntity.AppID, saEntity.RuntimeID, saEntity.IntegrationSystemID, saEntity.Value)
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "runtime_id", "runtime_context_id", "integration_system_id", "value"}).
+			AddRow(saID, testTenant, saEntity.AppID, saEntity.RuntimeID, saEntity.RuntimeContextID, saEntity.IntegrationSystemID, saEntity.Value)
 
-		query := "SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE tenant_id = $1 AND id = $2"
+		query := "SELECT id, tenant_id, app_id, runtime_id, runtime_context_id, integration_system_id, value FROM public.system_auths WHERE tenant_id = $1 AND id = $2"
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(testTenant, saID).WillReturnRows(rows)
 
@@ -190,8 +190,8 @@ func TestRepository_GetByID(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value"}).
-			AddRow(saID, testTenant, saEntity.AppID, saEntity.RuntimeID, saEntity.IntegrationSystemID, saEntity.Value)
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "runtime_id", "runtime_context_id", "integration_system_id", "value"}).
+			AddRow(saID, testTenant, saEntity.AppID, saEntity.RuntimeID, saEntity.RuntimeContextID, saEntity.IntegrationSystemID, saEntity.Value)
 
 		dbMock.ExpectQuery("SELECT .*").
 			WithArgs(testTenant, saID).WillReturnRows(rows)
@@ -237,10 +237,10 @@ func TestRepository_GetByIDGlobal(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value"}).
-			AddRow(saID, testTenant, saEntity.AppID, saEntity.RuntimeID, saEntity.IntegrationSystemID, saEntity.Value)
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "runtime_id", "runtime_context_id", "integration_system_id", "value"}).
+			AddRow(saID, testTenant, saEntity.AppID, saEntity.RuntimeID, saEntity.RuntimeContextID, saEntity.IntegrationSystemID, saEntity.Value)
 
-		query := "SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE id = $1"
+		query := "SELECT id, tenant_id, app_id, runtime_id, runtime_context_id, integration_system_id, value FROM public.system_auths WHERE id = $1"
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(saID).WillReturnRows(rows)
 
@@ -266,8 +266,8 @@ func TestRepository_GetByIDGlobal(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "runtime_id", "integration_system_id", "value"}).
-			AddRow(saID, testTenant, saEntity.AppID, saEntity.RuntimeID, saEntity.IntegrationSystemID, saEntity.Value)
+		rows := sqlmock.NewRows([]string{"id", "tenant_id", "app_id", "runtime_id", "runtime_context_id", "integration_system_id", "value"}).
+			AddRow(saID, testTenant, saEntity.AppID, saEntity.RuntimeID, saEntity.RuntimeContextID, saEntity.IntegrationSystemID, saEntity.Value)
 
 		dbMock.ExpectQuery("SELECT .*").
 			WithArgs(saID).WillReturnRows(rows)
@@ -315,7 +315,7 @@ func TestRepository_ListForObject(t *testing.T) {
 			fixEntity("bar", model.RuntimeReference, objID, true),
 		}
 
-		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE tenant_id = $1 AND runtime_id = $2`
+		query := `SELECT id, tenant_id, app_id, runtime_id, runtime_context_id, integration_system_id, value FROM public.system_auths WHERE tenant_id = $1 AND runtime_id = $2`
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(testTenant, objID).
 			WillReturnRows(fixSQLRows([]sqlRow{
@@ -350,6 +350,38 @@ func TestRepository_ListForObject(t *testing.T) {
 		convMock.AssertExpectations(t)
 	})
 
+	t.Run("Success listing auths for Runtime Context", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		modelSysAuth := fixModelSystemAuth("foo", model.RuntimeContextReference, objID, modelAuth)
+		entSysAuth := fixEntity("foo", model.RuntimeContextReference, objID, true)
+
+		query := `SELECT id, tenant_id, app_id, runtime_id, runtime_context_id, integration_system_id, value FROM public.system_auths WHERE tenant_id = $1 AND runtime_context_id = $2`
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(testTenant, objID).
+			WillReturnRows(fixSQLRows([]sqlRow{
+				{
+					id:       modelSysAuth.ID,
+					tenant:   &testTenant,
+					rtmCtxID: modelSysAuth.RuntimeContextID,
+				},
+			}))
+
+		convMock := automock.Converter{}
+		convMock.On("FromEntity", entSysAuth).Return(*modelSysAuth, nil).Once()
+		pgRepository := systemauth.NewRepository(&convMock)
+
+		//WHEN
+		result, err := pgRepository.ListForObject(ctx, testTenant, model.RuntimeContextReference, objID)
+
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		dbMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
 	t.Run("Success listing auths for Application", func(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		ctx := persistence.SaveToContext(context.TODO(), db)
@@ -363,7 +395,7 @@ func TestRepository_ListForObject(t *testing.T) {
 			fixEntity("bar", model.ApplicationReference, objID, true),
 		}
 
-		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE tenant_id = $1 AND app_id = $2`
+		query := `SELECT id, tenant_id, app_id, runtime_id, runtime_context_id, integration_system_id, value FROM public.system_auths WHERE tenant_id = $1 AND app_id = $2`
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(testTenant, objID).
 			WillReturnRows(fixSQLRows([]sqlRow{
@@ -411,7 +443,7 @@ func TestRepository_ListForObject(t *testing.T) {
 			fixEntity("bar", model.IntegrationSystemReference, objID, true),
 		}
 
-		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE integration_system_id = $1`
+		query := `SELECT id, tenant_id, app_id, runtime_id, runtime_context_id, integration_system_id, value FROM public.system_auths WHERE integration_system_id = $1`
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(objID).
 			WillReturnRows(fixSQLRows([]sqlRow{
@@ -463,7 +495,7 @@ func TestRepository_ListForObject(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		ctx := persistence.SaveToContext(context.TODO(), db)
 
-		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE integration_system_id = $1`
+		query := `SELECT id, tenant_id, app_id, runtime_id, runtime_context_id, integration_system_id, value FROM public.system_auths WHERE integration_system_id = $1`
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(objID).
 			WillReturnError(testErr)
@@ -493,7 +525,7 @@ func TestRepository_ListForObject(t *testing.T) {
 			fixEntity("bar", model.IntegrationSystemReference, objID, true),
 		}
 
-		query := `SELECT id, tenant_id, app_id, runtime_id, integration_system_id, value FROM public.system_auths WHERE integration_system_id = $1`
+		query := `SELECT id, tenant_id, app_id, runtime_id, runtime_context_id, integration_system_id, value FROM public.system_auths WHERE integration_system_id = $1`
 		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
 			WithArgs(objID).
 			WillReturnRows(fixSQLRows([]sqlRow{
@@ -529,6 +561,93 @@ func TestRepository_ListForObject(t *testing.T) {
 	})
 }
 
+func TestRepository_ListForObjectIDs(t *testing.T) {
+	//GIVEN
+	firstObjID := "foo"
+	secondObjID := "bar"
+	modelAuth := fixModelAuth()
+	query := `SELECT id, tenant_id, app_id, runtime_id, runtime_context_id, integration_system_id, value FROM public.system_auths WHERE tenant_id = $1 AND runtime_id IN ($2, $3)`
+
+	t.Run("Success listing auths for Runtimes", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		modelSysAuths := []*model.SystemAuth{
+			fixModelSystemAuth("foo", model.RuntimeReference, firstObjID, modelAuth),
+			fixModelSystemAuth("bar", model.RuntimeReference, secondObjID, modelAuth),
+		}
+		entSysAuths := []systemauth.Entity{
+			fixEntity("foo", model.RuntimeReference, firstObjID, true),
+			fixEntity("bar", model.RuntimeReference, secondObjID, true),
+		}
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(testTenant, firstObjID, secondObjID).
+			WillReturnRows(fixSQLRows([]sqlRow{
+				{
+					id:       modelSysAuths[0].ID,
+					tenant:   &testTenant,
+					appID:    modelSysAuths[0].AppID,
+					rtmID:    modelSysAuths[0].RuntimeID,
+					intSysID: modelSysAuths[0].IntegrationSystemID,
+				},
+				{
+					id:       modelSysAuths[1].ID,
+					tenant:   &testTenant,
+					appID:    modelSysAuths[1].AppID,
+					rtmID:    modelSysAuths[1].RuntimeID,
+					intSysID: modelSysAuths[1].IntegrationSystemID,
+				},
+			}))
+
+		convMock := automock.Converter{}
+		convMock.On("FromEntity", entSysAuths[0]).Return(*modelSysAuths[0], nil).Once()
+		convMock.On("FromEntity", entSysAuths[1]).Return(*modelSysAuths[1], nil).Once()
+		pgRepository := systemauth.NewRepository(&convMock)
+
+		//WHEN
+		result, err := pgRepository.ListForObjectIDs(ctx, testTenant, model.RuntimeReference, []string{firstObjID, secondObjID})
+
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, *modelSysAuths[0], result[0])
+		assert.Equal(t, *modelSysAuths[1], result[1])
+		dbMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("Error when listing", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(testTenant, firstObjID, secondObjID).
+			WillReturnError(testErr)
+
+		pgRepository := systemauth.NewRepository(nil)
+
+		//WHEN
+		result, err := pgRepository.ListForObjectIDs(ctx, testTenant, model.RuntimeReference, []string{firstObjID, secondObjID})
+
+		//THEN
+		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+		require.Nil(t, result)
+		dbMock.AssertExpectations(t)
+	})
+
+	t.Run("Error when unsupported object type", func(t *testing.T) {
+		pgRepository := systemauth.NewRepository(nil)
+
+		//WHEN
+		result, err := pgRepository.ListForObjectIDs(context.TODO(), testTenant, "unsupported", []string{firstObjID})
+
+		//THEN
+		require.EqualError(t, err, "Internal Server Error: unsupported reference object type")
+		require.Nil(t, result)
+	})
+}
+
 func TestRepository_DeleteAllForObject(t *testing.T) {
 	// GIVEN
 	sysAuthID := "foo"
@@ -611,6 +730,116 @@ func TestRepository_DeleteAllForObject(t *testing.T) {
 	})
 }
 
+func TestRepository_ListForTenant(t *testing.T) {
+	//GIVEN
+	modelAuth := fixModelAuth()
+	query := `SELECT id, tenant_id, app_id, runtime_id, runtime_context_id, integration_system_id, value FROM public.system_auths WHERE tenant_id = $1`
+
+	t.Run("Success", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		modelSysAuths := []*model.SystemAuth{
+			fixModelSystemAuth("foo", model.RuntimeReference, "bar", modelAuth),
+			fixModelSystemAuth("foo2", model.ApplicationReference, "bar2", modelAuth),
+		}
+		entSysAuths := []systemauth.Entity{
+			fixEntity("foo", model.RuntimeReference, "bar", true),
+			fixEntity("foo2", model.ApplicationReference, "bar2", true),
+		}
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(testTenant).
+			WillReturnRows(fixSQLRows([]sqlRow{
+				{
+					id:       modelSysAuths[0].ID,
+					tenant:   &testTenant,
+					appID:    modelSysAuths[0].AppID,
+					rtmID:    modelSysAuths[0].RuntimeID,
+					intSysID: modelSysAuths[0].IntegrationSystemID,
+				},
+				{
+					id:       modelSysAuths[1].ID,
+					tenant:   &testTenant,
+					appID:    modelSysAuths[1].AppID,
+					rtmID:    modelSysAuths[1].RuntimeID,
+					intSysID: modelSysAuths[1].IntegrationSystemID,
+				},
+			}))
+
+		convMock := automock.Converter{}
+		convMock.On("FromEntity", entSysAuths[0]).Return(*modelSysAuths[0], nil).Once()
+		convMock.On("FromEntity", entSysAuths[1]).Return(*modelSysAuths[1], nil).Once()
+		pgRepository := systemauth.NewRepository(&convMock)
+
+		//WHEN
+		result, err := pgRepository.ListForTenant(ctx, testTenant)
+
+		//THEN
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, *modelSysAuths[0], result[0])
+		assert.Equal(t, *modelSysAuths[1], result[1])
+		dbMock.AssertExpectations(t)
+		convMock.AssertExpectations(t)
+	})
+
+	t.Run("Error when listing", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(query)).
+			WithArgs(testTenant).
+			WillReturnError(testErr)
+
+		pgRepository := systemauth.NewRepository(nil)
+
+		//WHEN
+		result, err := pgRepository.ListForTenant(ctx, testTenant)
+
+		//THEN
+		require.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+		require.Nil(t, result)
+		dbMock.AssertExpectations(t)
+	})
+}
+
+func TestRepository_DeleteAllForTenant(t *testing.T) {
+	query := `DELETE FROM public.system_auths WHERE tenant_id = $1`
+
+	t.Run("Success", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectExec(regexp.QuoteMeta(query)).
+			WithArgs(testTenant).
+			WillReturnResult(sqlmock.NewResult(-1, 2))
+
+		repo := systemauth.NewRepository(nil)
+		// WHEN
+		err := repo.DeleteAllForTenant(ctx, testTenant)
+		// THEN
+		require.NoError(t, err)
+		dbMock.AssertExpectations(t)
+	})
+
+	t.Run("Error when deleting", func(t *testing.T) {
+		db, dbMock := testdb.MockDatabase(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		dbMock.ExpectExec(regexp.QuoteMeta(query)).
+			WithArgs(testTenant).
+			WillReturnError(testErr)
+
+		repo := systemauth.NewRepository(nil)
+		// WHEN
+		err := repo.DeleteAllForTenant(ctx, testTenant)
+		// THEN
+		require.Error(t, err)
+		assert.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
 func TestRepository_DeleteByIDForObject(t *testing.T) {
 	// GIVEN
 	sysAuthID := "foo"
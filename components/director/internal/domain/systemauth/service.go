@@ -19,9 +19,12 @@ type Repository interface {
 	GetByID(ctx context.Context, tenant, id string) (*model.SystemAuth, error)
 	GetByIDGlobal(ctx context.Context, id string) (*model.SystemAuth, error)
 	ListForObject(ctx context.Context, tenant string, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error)
+	ListForObjectIDs(ctx context.Context, tenant string, objectType model.SystemAuthReferenceObjectType, objectIDs []string) ([]model.SystemAuth, error)
 	ListForObjectGlobal(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error)
+	ListForTenant(ctx context.Context, tenant string) ([]model.SystemAuth, error)
 	DeleteByIDForObject(ctx context.Context, tenant, id string, objType model.SystemAuthReferenceObjectType) error
 	DeleteByIDForObjectGlobal(ctx context.Context, id string, objType model.SystemAuthReferenceObjectType) error
+	DeleteAllForTenant(ctx context.Context, tenant string) error
 }
 
 //go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
@@ -71,6 +74,9 @@ func (s *service) create(ctx context.Context, id string, objectType model.System
 	case model.RuntimeReference:
 		systemAuth.RuntimeID = &objectID
 		systemAuth.TenantID = &tnt
+	case model.RuntimeContextReference:
+		systemAuth.RuntimeContextID = &objectID
+		systemAuth.TenantID = &tnt
 	case model.IntegrationSystemReference:
 		systemAuth.IntegrationSystemID = &objectID
 		systemAuth.TenantID = nil
@@ -139,6 +145,47 @@ func (s *service) ListForObject(ctx context.Context, objectType model.SystemAuth
 	return systemAuths, nil
 }
 
+func (s *service) ListForObjectIDs(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectIDs []string) (map[string][]model.SystemAuth, error) {
+	if objectType == model.IntegrationSystemReference {
+		return nil, apperrors.NewInvalidOperationError("listing System Auths for multiple Integration Systems is not supported")
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	systemAuths, err := s.repo.ListForObjectIDs(ctx, tnt, objectType, objectIDs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing System Auths for multiple objects of type %s", objectType)
+	}
+
+	systemAuthsByObjectID := make(map[string][]model.SystemAuth, len(objectIDs))
+	for _, sysAuth := range systemAuths {
+		objectID, err := sysAuth.GetReferenceObjectID()
+		if err != nil {
+			return nil, err
+		}
+		systemAuthsByObjectID[objectID] = append(systemAuthsByObjectID[objectID], sysAuth)
+	}
+
+	return systemAuthsByObjectID, nil
+}
+
+func (s *service) ListForTenant(ctx context.Context) ([]model.SystemAuth, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	systemAuths, err := s.repo.ListForTenant(ctx, tnt)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing System Auths for tenant %s", tnt)
+	}
+
+	return systemAuths, nil
+}
+
 func (s *service) DeleteByIDForObject(ctx context.Context, objectType model.SystemAuthReferenceObjectType, authID string) error {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
@@ -158,3 +205,16 @@ func (s *service) DeleteByIDForObject(ctx context.Context, objectType model.Syst
 
 	return nil
 }
+
+func (s *service) DeleteAllForTenant(ctx context.Context) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteAllForTenant(ctx, tnt); err != nil {
+		return errors.Wrapf(err, "while deleting System Auths for tenant %s", tnt)
+	}
+
+	return nil
+}
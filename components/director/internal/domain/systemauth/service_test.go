@@ -54,6 +54,18 @@ func TestService_Create(t *testing.T) {
 			ExpectedOutput:  sysAuthID,
 			ExpectedError:   nil,
 		},
+		{
+			Name: "Success creating auth for Runtime Context",
+			sysAuthRepoFn: func() *automock.Repository {
+				sysAuthRepo := &automock.Repository{}
+				sysAuthRepo.On("Create", contextThatHasTenant(testTenant), *fixModelSystemAuth(sysAuthID, model.RuntimeContextReference, objID, modelAuth)).Return(nil)
+				return sysAuthRepo
+			},
+			InputObjectType: model.RuntimeContextReference,
+			InputAuth:       &modelAuthInput,
+			ExpectedOutput:  sysAuthID,
+			ExpectedError:   nil,
+		},
 		{
 			Name: "Success creating auth for Application",
 			sysAuthRepoFn: func() *automock.Repository {
@@ -314,6 +326,179 @@ func TestService_ListForObject(t *testing.T) {
 	})
 }
 
+func TestService_ListForObjectIDs(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+
+	objIDs := []string{"bar", "bar2"}
+
+	modelAuth := fixModelAuth()
+
+	firstRtmSysAuth := model.SystemAuth{
+		ID:        "foo",
+		TenantID:  &testTenant,
+		RuntimeID: str.Ptr("bar"),
+		Value:     modelAuth,
+	}
+	secondRtmSysAuth := model.SystemAuth{
+		ID:        "foo2",
+		TenantID:  &testTenant,
+		RuntimeID: str.Ptr("bar"),
+		Value:     modelAuth,
+	}
+	thirdRtmSysAuth := model.SystemAuth{
+		ID:        "foo3",
+		TenantID:  &testTenant,
+		RuntimeID: str.Ptr("bar2"),
+		Value:     modelAuth,
+	}
+
+	testCases := []struct {
+		Name            string
+		sysAuthRepoFn   func() *automock.Repository
+		InputObjectType model.SystemAuthReferenceObjectType
+		ExpectedOutput  map[string][]model.SystemAuth
+		ExpectedError   error
+	}{
+		{
+			Name: "Success listing Auths for Runtimes",
+			sysAuthRepoFn: func() *automock.Repository {
+				sysAuthRepo := &automock.Repository{}
+				sysAuthRepo.On("ListForObjectIDs", contextThatHasTenant(testTenant), testTenant, model.RuntimeReference, objIDs).Return([]model.SystemAuth{firstRtmSysAuth, secondRtmSysAuth, thirdRtmSysAuth}, nil)
+				return sysAuthRepo
+			},
+			InputObjectType: model.RuntimeReference,
+			ExpectedOutput: map[string][]model.SystemAuth{
+				"bar":  {firstRtmSysAuth, secondRtmSysAuth},
+				"bar2": {thirdRtmSysAuth},
+			},
+			ExpectedError: nil,
+		},
+		{
+			Name: "Error when listing Auths for Integration Systems",
+			sysAuthRepoFn: func() *automock.Repository {
+				return &automock.Repository{}
+			},
+			InputObjectType: model.IntegrationSystemReference,
+			ExpectedOutput:  nil,
+			ExpectedError:   errors.New("listing System Auths for multiple Integration Systems is not supported"),
+		},
+		{
+			Name: "Error listing System Auths",
+			sysAuthRepoFn: func() *automock.Repository {
+				sysAuthRepo := &automock.Repository{}
+				sysAuthRepo.On("ListForObjectIDs", contextThatHasTenant(testTenant), testTenant, model.RuntimeReference, objIDs).Return(nil, testErr)
+				return sysAuthRepo
+			},
+			InputObjectType: model.RuntimeReference,
+			ExpectedOutput:  nil,
+			ExpectedError:   testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			sysAuthRepo := testCase.sysAuthRepoFn()
+			svc := systemauth.NewService(sysAuthRepo, nil)
+
+			// WHEN
+			result, err := svc.ListForObjectIDs(ctx, testCase.InputObjectType, objIDs)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			sysAuthRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := systemauth.NewService(nil, nil)
+
+		// WHEN
+		_, err := svc.ListForObjectIDs(context.TODO(), model.RuntimeReference, objIDs)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_ListForTenant(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+
+	sysAuths := []model.SystemAuth{
+		{ID: "foo", TenantID: &testTenant, RuntimeID: str.Ptr("bar"), Value: fixModelAuth()},
+		{ID: "foo2", TenantID: &testTenant, AppID: str.Ptr("bar2"), Value: fixModelAuth()},
+	}
+
+	testCases := []struct {
+		Name           string
+		sysAuthRepoFn  func() *automock.Repository
+		ExpectedOutput []model.SystemAuth
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			sysAuthRepoFn: func() *automock.Repository {
+				sysAuthRepo := &automock.Repository{}
+				sysAuthRepo.On("ListForTenant", contextThatHasTenant(testTenant), testTenant).Return(sysAuths, nil).Once()
+				return sysAuthRepo
+			},
+			ExpectedOutput: sysAuths,
+			ExpectedError:  nil,
+		},
+		{
+			Name: "Error listing System Auths",
+			sysAuthRepoFn: func() *automock.Repository {
+				sysAuthRepo := &automock.Repository{}
+				sysAuthRepo.On("ListForTenant", contextThatHasTenant(testTenant), testTenant).Return(nil, testErr).Once()
+				return sysAuthRepo
+			},
+			ExpectedOutput: nil,
+			ExpectedError:  testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			sysAuthRepo := testCase.sysAuthRepoFn()
+			svc := systemauth.NewService(sysAuthRepo, nil)
+
+			// WHEN
+			result, err := svc.ListForTenant(ctx)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			sysAuthRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := systemauth.NewService(nil, nil)
+
+		// WHEN
+		_, err := svc.ListForTenant(context.TODO())
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
 func TestService_GetByIDForObject(t *testing.T) {
 	// GIVEN
 	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
@@ -500,3 +685,64 @@ func contextThatHasTenant(expectedTenant string) interface{} {
 		return actualTenant == expectedTenant
 	})
 }
+
+func TestService_DeleteAllForTenant(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
+
+	testCases := []struct {
+		Name          string
+		sysAuthRepoFn func() *automock.Repository
+		ExpectedError error
+	}{
+		{
+			Name: "Success",
+			sysAuthRepoFn: func() *automock.Repository {
+				sysAuthRepo := &automock.Repository{}
+				sysAuthRepo.On("DeleteAllForTenant", contextThatHasTenant(testTenant), testTenant).Return(nil).Once()
+				return sysAuthRepo
+			},
+			ExpectedError: nil,
+		},
+		{
+			Name: "Error deleting System Auths",
+			sysAuthRepoFn: func() *automock.Repository {
+				sysAuthRepo := &automock.Repository{}
+				sysAuthRepo.On("DeleteAllForTenant", contextThatHasTenant(testTenant), testTenant).Return(testErr).Once()
+				return sysAuthRepo
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			sysAuthRepo := testCase.sysAuthRepoFn()
+			svc := systemauth.NewService(sysAuthRepo, nil)
+
+			// WHEN
+			err := svc.DeleteAllForTenant(ctx)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			sysAuthRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := systemauth.NewService(nil, nil)
+
+		// WHEN
+		err := svc.DeleteAllForTenant(context.TODO())
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
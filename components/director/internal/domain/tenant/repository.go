@@ -141,3 +141,11 @@ func (r *pgRepository) DeleteByExternalTenant(ctx context.Context, externalTenan
 
 	return r.deleterGlobal.DeleteManyGlobal(ctx, conditions)
 }
+
+func (r *pgRepository) Delete(ctx context.Context, id string) error {
+	conditions := repo.Conditions{
+		repo.NewEqualCondition(idColumn, id),
+	}
+
+	return r.deleterGlobal.DeleteManyGlobal(ctx, conditions)
+}
@@ -439,3 +439,45 @@ func TestPgRepository_DeleteByExternalTenant(t *testing.T) {
 		assert.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
 	})
 }
+
+func TestPgRepository_Delete(t *testing.T) {
+	deleteStatement := regexp.QuoteMeta(`DELETE FROM public.business_tenant_mappings WHERE id = $1`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectExec(deleteStatement).
+			WithArgs(testID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := tenant.NewRepository(nil)
+
+		// WHEN
+		err := repo.Delete(ctx, testID)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Database error", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(deleteStatement).
+			WithArgs(testID).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := tenant.NewRepository(nil)
+
+		// WHEN
+		err := repo.Delete(ctx, testID)
+
+		// THEN
+		require.Error(t, err)
+		assert.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
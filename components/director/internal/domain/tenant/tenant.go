@@ -15,23 +15,67 @@ const (
 type TenantCtx struct {
 	InternalID string
 	ExternalID string
+	// SharedWithID is the internal ID of the tenant which reads the resources of an Application shared with it by the tenant from the context
+	SharedWithID string
 }
 
 func LoadFromContext(ctx context.Context) (string, error) {
+	tenant, err := LoadTenantPairFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return tenant.InternalID, nil
+}
+
+// LoadTenantPairFromContext returns both the internal and the external ID of the tenant
+func LoadTenantPairFromContext(ctx context.Context) (TenantCtx, error) {
 	tenant, ok := ctx.Value(TenantContextKey).(TenantCtx)
 
 	if !ok {
-		return "", apperrors.NewCannotReadTenantError()
+		return TenantCtx{}, apperrors.NewCannotReadTenantError()
 	}
 
 	if tenant.InternalID == "" {
-		return "", apperrors.NewTenantRequiredError()
+		return TenantCtx{}, apperrors.NewTenantRequiredError()
 	}
 
-	return tenant.InternalID, nil
+	return tenant, nil
 }
 
 func SaveToContext(ctx context.Context, internalID, externalID string) context.Context {
 	tenantCtx := TenantCtx{InternalID: internalID, ExternalID: externalID}
 	return context.WithValue(ctx, TenantContextKey, tenantCtx)
 }
+
+// SaveOwnerToContext switches the tenant in the context to the tenant owning a shared Application, so that the resources of the Application can be read.
+// The tenant which the Application is shared with is kept in the context. The external ID of the owner is not set.
+func SaveOwnerToContext(ctx context.Context, ownerID string) (context.Context, error) {
+	current, err := LoadTenantPairFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.InternalID == ownerID {
+		return ctx, nil
+	}
+
+	sharedWithID := current.InternalID
+	if current.SharedWithID != "" {
+		sharedWithID = current.SharedWithID
+	}
+
+	tenantCtx := TenantCtx{InternalID: ownerID, SharedWithID: sharedWithID}
+	return context.WithValue(ctx, TenantContextKey, tenantCtx), nil
+}
+
+// LoadSharedWithFromContext returns the internal ID of the tenant which reads the resources of a shared Application,
+// if the tenant in the context was switched to the owner of the Application
+func LoadSharedWithFromContext(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(TenantContextKey).(TenantCtx)
+	if !ok || tenant.SharedWithID == "" {
+		return "", false
+	}
+
+	return tenant.SharedWithID, true
+}
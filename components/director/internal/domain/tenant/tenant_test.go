@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -52,6 +53,50 @@ func TestLoadFromContext(t *testing.T) {
 	}
 }
 
+func TestLoadTenantPairFromContext(t *testing.T) {
+	internalID := "foo"
+	externalID := "bar"
+
+	testCases := []struct {
+		Name    string
+		Context context.Context
+
+		ExpectedResult     tenant.TenantCtx
+		ExpectedErrMessage string
+	}{
+		{
+			Name:           "Success",
+			Context:        context.WithValue(context.TODO(), tenant.TenantContextKey, tenant.TenantCtx{InternalID: internalID, ExternalID: externalID}),
+			ExpectedResult: tenant.TenantCtx{InternalID: internalID, ExternalID: externalID},
+		},
+		{
+			Name:               "Error when tenant is not in the context",
+			Context:            context.TODO(),
+			ExpectedErrMessage: "cannot read tenant from context",
+		},
+		{
+			Name:               "Error when internal tenant is empty",
+			Context:            context.WithValue(context.TODO(), tenant.TenantContextKey, tenant.TenantCtx{ExternalID: externalID}),
+			ExpectedErrMessage: apperrors.NewTenantRequiredError().Error(),
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+			// when
+			result, err := tenant.LoadTenantPairFromContext(testCase.Context)
+
+			// then
+			if testCase.ExpectedErrMessage != "" {
+				require.Equal(t, testCase.ExpectedErrMessage, err.Error())
+				return
+			}
+
+			assert.Equal(t, testCase.ExpectedResult, result)
+		})
+	}
+}
+
 func TestSaveToLoadFromContext(t *testing.T) {
 	// given
 	value := "foo"
@@ -65,3 +110,57 @@ func TestSaveToLoadFromContext(t *testing.T) {
 	// then
 	assert.Equal(t, tenants, result.Value(tenant.TenantContextKey))
 }
+
+func TestSaveOwnerToContext(t *testing.T) {
+	// given
+	ownerID := "owner"
+	targetID := "target"
+	externalTargetID := "external-target"
+
+	t.Run("Success", func(t *testing.T) {
+		ctx := tenant.SaveToContext(context.TODO(), targetID, externalTargetID)
+
+		// when
+		result, err := tenant.SaveOwnerToContext(ctx, ownerID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, tenant.TenantCtx{InternalID: ownerID, SharedWithID: targetID}, result.Value(tenant.TenantContextKey))
+		sharedWithID, ok := tenant.LoadSharedWithFromContext(result)
+		assert.True(t, ok)
+		assert.Equal(t, targetID, sharedWithID)
+	})
+
+	t.Run("Success when the context is already switched", func(t *testing.T) {
+		ctx := context.WithValue(context.TODO(), tenant.TenantContextKey, tenant.TenantCtx{InternalID: "other-owner", SharedWithID: targetID})
+
+		// when
+		result, err := tenant.SaveOwnerToContext(ctx, ownerID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, tenant.TenantCtx{InternalID: ownerID, SharedWithID: targetID}, result.Value(tenant.TenantContextKey))
+	})
+
+	t.Run("Returns the same context when the tenant owns the Application", func(t *testing.T) {
+		ctx := tenant.SaveToContext(context.TODO(), ownerID, "external-owner")
+
+		// when
+		result, err := tenant.SaveOwnerToContext(ctx, ownerID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ctx, result)
+		_, ok := tenant.LoadSharedWithFromContext(result)
+		assert.False(t, ok)
+	})
+
+	t.Run("Error when tenant is not in the context", func(t *testing.T) {
+		// when
+		_, err := tenant.SaveOwnerToContext(context.TODO(), ownerID)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
@@ -0,0 +1,28 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CertificateRevoker is an autogenerated mock type for the CertificateRevoker type
+type CertificateRevoker struct {
+	mock.Mock
+}
+
+// RevokeCertificates provides a mock function with given fields: ctx, authIDs
+func (_m *CertificateRevoker) RevokeCertificates(ctx context.Context, authIDs []string) error {
+	ret := _m.Called(ctx, authIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, authIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,46 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	tenantoffboarding "github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EntityConverter is an autogenerated mock type for the EntityConverter type
+type EntityConverter struct {
+	mock.Mock
+}
+
+// FromEntity provides a mock function with given fields: in
+func (_m *EntityConverter) FromEntity(in *tenantoffboarding.Entity) *model.TenantOffboarding {
+	ret := _m.Called(in)
+
+	var r0 *model.TenantOffboarding
+	if rf, ok := ret.Get(0).(func(*tenantoffboarding.Entity) *model.TenantOffboarding); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TenantOffboarding)
+		}
+	}
+
+	return r0
+}
+
+// ToEntity provides a mock function with given fields: in
+func (_m *EntityConverter) ToEntity(in *model.TenantOffboarding) *tenantoffboarding.Entity {
+	ret := _m.Called(in)
+
+	var r0 *tenantoffboarding.Entity
+	if rf, ok := ret.Get(0).(func(*model.TenantOffboarding) *tenantoffboarding.Entity); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tenantoffboarding.Entity)
+		}
+	}
+
+	return r0
+}
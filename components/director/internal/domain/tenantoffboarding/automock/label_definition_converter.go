@@ -0,0 +1,36 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+// LabelDefinitionConverter is an autogenerated mock type for the LabelDefinitionConverter type
+type LabelDefinitionConverter struct {
+	mock.Mock
+}
+
+// ToGraphQL provides a mock function with given fields: in
+func (_m *LabelDefinitionConverter) ToGraphQL(in model.LabelDefinition) (graphql.LabelDefinition, error) {
+	ret := _m.Called(in)
+
+	var r0 graphql.LabelDefinition
+	if rf, ok := ret.Get(0).(func(model.LabelDefinition) graphql.LabelDefinition); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(graphql.LabelDefinition)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(model.LabelDefinition) error); ok {
+		r1 = rf(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,29 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RuntimeNotifier is an autogenerated mock type for the RuntimeNotifier type
+type RuntimeNotifier struct {
+	mock.Mock
+}
+
+// NotifyTenantOffboarded provides a mock function with given fields: ctx, offboarding, runtimeIDs
+func (_m *RuntimeNotifier) NotifyTenantOffboarded(ctx context.Context, offboarding *model.TenantOffboarding, runtimeIDs []string) error {
+	ret := _m.Called(ctx, offboarding, runtimeIDs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TenantOffboarding, []string) error); ok {
+		r0 = rf(ctx, offboarding, runtimeIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
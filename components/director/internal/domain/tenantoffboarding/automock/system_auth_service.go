@@ -0,0 +1,52 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SystemAuthService is an autogenerated mock type for the SystemAuthService type
+type SystemAuthService struct {
+	mock.Mock
+}
+
+// DeleteAllForTenant provides a mock function with given fields: ctx
+func (_m *SystemAuthService) DeleteAllForTenant(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListForTenant provides a mock function with given fields: ctx
+func (_m *SystemAuthService) ListForTenant(ctx context.Context) ([]model.SystemAuth, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []model.SystemAuth
+	if rf, ok := ret.Get(0).(func(context.Context) []model.SystemAuth); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SystemAuth)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
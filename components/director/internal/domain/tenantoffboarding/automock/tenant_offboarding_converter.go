@@ -0,0 +1,31 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+// TenantOffboardingConverter is an autogenerated mock type for the TenantOffboardingConverter type
+type TenantOffboardingConverter struct {
+	mock.Mock
+}
+
+// ToGraphQL provides a mock function with given fields: in
+func (_m *TenantOffboardingConverter) ToGraphQL(in *model.TenantOffboarding) *graphql.TenantOffboarding {
+	ret := _m.Called(in)
+
+	var r0 *graphql.TenantOffboarding
+	if rf, ok := ret.Get(0).(func(*model.TenantOffboarding) *graphql.TenantOffboarding); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.TenantOffboarding)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,89 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TenantOffboardingRepository is an autogenerated mock type for the TenantOffboardingRepository type
+type TenantOffboardingRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, item
+func (_m *TenantOffboardingRepository) Create(ctx context.Context, item model.TenantOffboarding) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TenantOffboarding) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByExternalTenant provides a mock function with given fields: ctx, externalTenant
+func (_m *TenantOffboardingRepository) GetByExternalTenant(ctx context.Context, externalTenant string) (*model.TenantOffboarding, error) {
+	ret := _m.Called(ctx, externalTenant)
+
+	var r0 *model.TenantOffboarding
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.TenantOffboarding); ok {
+		r0 = rf(ctx, externalTenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TenantOffboarding)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, externalTenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUnfinished provides a mock function with given fields: ctx
+func (_m *TenantOffboardingRepository) ListUnfinished(ctx context.Context) ([]*model.TenantOffboarding, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.TenantOffboarding
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.TenantOffboarding); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TenantOffboarding)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, item
+func (_m *TenantOffboardingRepository) Update(ctx context.Context, item model.TenantOffboarding) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.TenantOffboarding) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
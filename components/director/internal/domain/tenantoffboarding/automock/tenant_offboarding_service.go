@@ -0,0 +1,84 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TenantOffboardingService is an autogenerated mock type for the TenantOffboardingService type
+type TenantOffboardingService struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx, externalTenant
+func (_m *TenantOffboardingService) Get(ctx context.Context, externalTenant string) (*model.TenantOffboarding, error) {
+	ret := _m.Called(ctx, externalTenant)
+
+	var r0 *model.TenantOffboarding
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.TenantOffboarding); ok {
+		r0 = rf(ctx, externalTenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TenantOffboarding)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, externalTenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestExport provides a mock function with given fields: ctx, externalTenant
+func (_m *TenantOffboardingService) RequestExport(ctx context.Context, externalTenant string) (*model.TenantOffboarding, error) {
+	ret := _m.Called(ctx, externalTenant)
+
+	var r0 *model.TenantOffboarding
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.TenantOffboarding); ok {
+		r0 = rf(ctx, externalTenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TenantOffboarding)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, externalTenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Start provides a mock function with given fields: ctx, externalTenant, exportData
+func (_m *TenantOffboardingService) Start(ctx context.Context, externalTenant string, exportData bool) (*model.TenantOffboarding, error) {
+	ret := _m.Called(ctx, externalTenant, exportData)
+
+	var r0 *model.TenantOffboarding
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) *model.TenantOffboarding); ok {
+		r0 = rf(ctx, externalTenant, exportData)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TenantOffboarding)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, externalTenant, exportData)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,45 @@
+package tenantoffboarding
+
+import (
+	"context"
+
+	gcli "github.com/machinebox/graphql"
+	"github.com/pkg/errors"
+)
+
+const revokeCertificatesRequest = `
+		mutation ($authIDs: [ID!]!) {
+		  result: revokeCertificates(authIDs: $authIDs)
+		}`
+
+//go:generate mockery -name=GraphQLClient -output=automock -outpkg=automock -case=underscore
+type GraphQLClient interface {
+	Run(ctx context.Context, req *gcli.Request, resp interface{}) error
+}
+
+// NewConnectorCertificateRevoker returns a CertificateRevoker which revokes the client certificates issued by the Connector for System Auths
+func NewConnectorCertificateRevoker(cli GraphQLClient) *connectorCertificateRevoker {
+	return &connectorCertificateRevoker{cli: cli}
+}
+
+type connectorCertificateRevoker struct {
+	cli GraphQLClient
+}
+
+func (r *connectorCertificateRevoker) RevokeCertificates(ctx context.Context, authIDs []string) error {
+	if len(authIDs) == 0 {
+		return nil
+	}
+
+	req := gcli.NewRequest(revokeCertificatesRequest)
+	req.Var("authIDs", authIDs)
+
+	var resp struct {
+		Result bool `json:"result"`
+	}
+	if err := r.cli.Run(ctx, req, &resp); err != nil {
+		return errors.Wrap(err, "while calling connector to revoke client certificates")
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package tenantoffboarding_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding/automock"
+	gcli "github.com/machinebox/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectorCertificateRevoker_RevokeCertificates(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	authIDs := []string{"auth-1", "auth-2"}
+	requestMatcher := mock.MatchedBy(func(req *gcli.Request) bool {
+		return assert.ObjectsAreEqual(authIDs, req.Vars()["authIDs"])
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		cli := &automock.GraphQLClient{}
+		cli.On("Run", ctx, requestMatcher, mock.Anything).Return(nil).Once()
+
+		revoker := tenantoffboarding.NewConnectorCertificateRevoker(cli)
+
+		// WHEN
+		err := revoker.RevokeCertificates(ctx, authIDs)
+
+		// THEN
+		require.NoError(t, err)
+		cli.AssertExpectations(t)
+	})
+
+	t.Run("Success without calling Connector when there are no System Auths", func(t *testing.T) {
+		cli := &automock.GraphQLClient{}
+
+		revoker := tenantoffboarding.NewConnectorCertificateRevoker(cli)
+
+		// WHEN
+		err := revoker.RevokeCertificates(ctx, []string{})
+
+		// THEN
+		require.NoError(t, err)
+		cli.AssertNotCalled(t, "Run", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Returns error when calling Connector failed", func(t *testing.T) {
+		cli := &automock.GraphQLClient{}
+		cli.On("Run", ctx, requestMatcher, mock.Anything).Return(testError).Once()
+
+		revoker := tenantoffboarding.NewConnectorCertificateRevoker(cli)
+
+		// WHEN
+		err := revoker.RevokeCertificates(ctx, authIDs)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+		cli.AssertExpectations(t)
+	})
+}
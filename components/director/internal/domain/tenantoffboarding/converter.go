@@ -0,0 +1,83 @@
+package tenantoffboarding
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct{}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) ToGraphQL(in *model.TenantOffboarding) *graphql.TenantOffboarding {
+	if in == nil {
+		return nil
+	}
+
+	var exportedData *graphql.JSON
+	if in.ExportedData != nil {
+		data := graphql.JSON(*in.ExportedData)
+		exportedData = &data
+	}
+
+	var purgeAfter *graphql.Timestamp
+	if in.PurgeAfter != nil {
+		timestamp := graphql.Timestamp(*in.PurgeAfter)
+		purgeAfter = &timestamp
+	}
+
+	return &graphql.TenantOffboarding{
+		ID:              in.ID,
+		Tenant:          in.ExternalTenant,
+		State:           graphql.TenantOffboardingState(in.State),
+		ExportRequested: in.ExportRequested,
+		ExportedData:    exportedData,
+		Error:           in.Error,
+		CreatedAt:       graphql.Timestamp(in.CreatedAt),
+		UpdatedAt:       graphql.Timestamp(in.UpdatedAt),
+		PurgeAfter:      purgeAfter,
+	}
+}
+
+func (c *converter) ToEntity(in *model.TenantOffboarding) *Entity {
+	if in == nil {
+		return nil
+	}
+
+	return &Entity{
+		ID:               in.ID,
+		TenantID:         in.TenantID,
+		ExternalTenant:   in.ExternalTenant,
+		State:            string(in.State),
+		ExportRequested:  in.ExportRequested,
+		ExportedData:     repo.NewNullableString(in.ExportedData),
+		Error:            repo.NewNullableString(in.Error),
+		CreatedAt:        in.CreatedAt,
+		UpdatedAt:        in.UpdatedAt,
+		PurgeAfter:       in.PurgeAfter,
+		RuntimesToNotify: in.RuntimesToNotify,
+	}
+}
+
+func (c *converter) FromEntity(in *Entity) *model.TenantOffboarding {
+	if in == nil {
+		return nil
+	}
+
+	return &model.TenantOffboarding{
+		ID:               in.ID,
+		TenantID:         in.TenantID,
+		ExternalTenant:   in.ExternalTenant,
+		State:            model.TenantOffboardingState(in.State),
+		ExportRequested:  in.ExportRequested,
+		ExportedData:     repo.StringPtrFromNullableString(in.ExportedData),
+		Error:            repo.StringPtrFromNullableString(in.Error),
+		CreatedAt:        in.CreatedAt,
+		UpdatedAt:        in.UpdatedAt,
+		PurgeAfter:       in.PurgeAfter,
+		RuntimesToNotify: in.RuntimesToNotify,
+	}
+}
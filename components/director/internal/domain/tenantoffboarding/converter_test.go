@@ -0,0 +1,86 @@
+package tenantoffboarding_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *model.TenantOffboarding
+		Expected *graphql.TenantOffboarding
+	}{
+		{
+			Name:     "All properties given",
+			Input:    fixModelTenantOffboardingWithAllProperties(),
+			Expected: fixGQLTenantOffboardingWithAllProperties(),
+		},
+		{
+			Name:  "Without optional properties",
+			Input: fixModelTenantOffboarding(model.TenantOffboardingStateDeactivated),
+			Expected: &graphql.TenantOffboarding{
+				ID:        testID,
+				Tenant:    testExtTenant,
+				State:     graphql.TenantOffboardingStateDeactivated,
+				CreatedAt: graphql.Timestamp(testTime),
+				UpdatedAt: graphql.Timestamp(testTime),
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			result := tenantoffboarding.NewConverter().ToGraphQL(testCase.Input)
+
+			// THEN
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
+func TestConverter_ToEntity(t *testing.T) {
+	t.Run("All properties given", func(t *testing.T) {
+		// WHEN
+		result := tenantoffboarding.NewConverter().ToEntity(fixModelTenantOffboardingWithAllProperties())
+
+		// THEN
+		assert.Equal(t, fixEntityTenantOffboardingWithAllProperties(), result)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		// WHEN
+		result := tenantoffboarding.NewConverter().ToEntity(nil)
+
+		// THEN
+		assert.Nil(t, result)
+	})
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	t.Run("All properties given", func(t *testing.T) {
+		// WHEN
+		result := tenantoffboarding.NewConverter().FromEntity(fixEntityTenantOffboardingWithAllProperties())
+
+		// THEN
+		assert.Equal(t, fixModelTenantOffboardingWithAllProperties(), result)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		// WHEN
+		result := tenantoffboarding.NewConverter().FromEntity(nil)
+
+		// THEN
+		assert.Nil(t, result)
+	})
+}
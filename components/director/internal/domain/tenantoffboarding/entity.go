@@ -0,0 +1,28 @@
+package tenantoffboarding
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type Entity struct {
+	ID               string         `db:"id"`
+	TenantID         string         `db:"tenant_id"`
+	ExternalTenant   string         `db:"external_tenant"`
+	State            string         `db:"state"`
+	ExportRequested  bool           `db:"export_requested"`
+	ExportedData     sql.NullString `db:"exported_data"`
+	Error            sql.NullString `db:"error"`
+	CreatedAt        time.Time      `db:"created_at"`
+	UpdatedAt        time.Time      `db:"updated_at"`
+	PurgeAfter       *time.Time     `db:"purge_after"`
+	RuntimesToNotify pq.StringArray `db:"runtimes_to_notify"`
+}
+
+type EntityCollection []Entity
+
+func (c EntityCollection) Len() int {
+	return len(c)
+}
@@ -0,0 +1,11 @@
+package tenantoffboarding
+
+import "time"
+
+func (s *service) SetTimestampGen(timestampGen func() time.Time) {
+	s.timestampGen = timestampGen
+}
+
+func (p *Processor) SetTimestampGen(timestampGen func() time.Time) {
+	p.timestampGen = timestampGen
+}
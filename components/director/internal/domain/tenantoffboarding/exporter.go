@@ -0,0 +1,121 @@
+package tenantoffboarding
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+const exportPageSize = 100
+
+//go:generate mockery -name=ApplicationService -output=automock -outpkg=automock -case=underscore
+type ApplicationService interface {
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error)
+}
+
+//go:generate mockery -name=ApplicationConverter -output=automock -outpkg=automock -case=underscore
+type ApplicationConverter interface {
+	MultipleToGraphQL(in []*model.Application) []*graphql.Application
+}
+
+//go:generate mockery -name=RuntimeService -output=automock -outpkg=automock -case=underscore
+type RuntimeService interface {
+	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
+}
+
+//go:generate mockery -name=RuntimeConverter -output=automock -outpkg=automock -case=underscore
+type RuntimeConverter interface {
+	MultipleToGraphQL(in []*model.Runtime) []*graphql.Runtime
+}
+
+//go:generate mockery -name=LabelDefinitionService -output=automock -outpkg=automock -case=underscore
+type LabelDefinitionService interface {
+	List(ctx context.Context, tenant string) ([]model.LabelDefinition, error)
+}
+
+//go:generate mockery -name=LabelDefinitionConverter -output=automock -outpkg=automock -case=underscore
+type LabelDefinitionConverter interface {
+	ToGraphQL(in model.LabelDefinition) (graphql.LabelDefinition, error)
+}
+
+type tenantData struct {
+	Applications     []*graphql.Application    `json:"applications"`
+	Runtimes         []*graphql.Runtime        `json:"runtimes"`
+	LabelDefinitions []graphql.LabelDefinition `json:"labelDefinitions"`
+}
+
+type exporter struct {
+	appSvc       ApplicationService
+	appConv      ApplicationConverter
+	runtimeSvc   RuntimeService
+	runtimeConv  RuntimeConverter
+	labelDefSvc  LabelDefinitionService
+	labelDefConv LabelDefinitionConverter
+}
+
+func NewExporter(appSvc ApplicationService, appConv ApplicationConverter, runtimeSvc RuntimeService, runtimeConv RuntimeConverter, labelDefSvc LabelDefinitionService, labelDefConv LabelDefinitionConverter) *exporter {
+	return &exporter{
+		appSvc:       appSvc,
+		appConv:      appConv,
+		runtimeSvc:   runtimeSvc,
+		runtimeConv:  runtimeConv,
+		labelDefSvc:  labelDefSvc,
+		labelDefConv: labelDefConv,
+	}
+}
+
+// Export returns the Applications, Runtimes and Label Definitions of the tenant from the context as a JSON document
+func (e *exporter) Export(ctx context.Context) (string, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	data := tenantData{
+		Applications:     []*graphql.Application{},
+		Runtimes:         []*graphql.Runtime{},
+		LabelDefinitions: []graphql.LabelDefinition{},
+	}
+
+	for cursor, hasNextPage := "", true; hasNextPage; {
+		page, err := e.appSvc.List(ctx, nil, exportPageSize, cursor)
+		if err != nil {
+			return "", errors.Wrap(err, "while listing Applications")
+		}
+		data.Applications = append(data.Applications, e.appConv.MultipleToGraphQL(page.Data)...)
+		cursor, hasNextPage = page.PageInfo.EndCursor, page.PageInfo.HasNextPage
+	}
+
+	for cursor, hasNextPage := "", true; hasNextPage; {
+		page, err := e.runtimeSvc.List(ctx, nil, exportPageSize, cursor)
+		if err != nil {
+			return "", errors.Wrap(err, "while listing Runtimes")
+		}
+		data.Runtimes = append(data.Runtimes, e.runtimeConv.MultipleToGraphQL(page.Data)...)
+		cursor, hasNextPage = page.PageInfo.EndCursor, page.PageInfo.HasNextPage
+	}
+
+	labelDefs, err := e.labelDefSvc.List(ctx, tnt)
+	if err != nil {
+		return "", errors.Wrap(err, "while listing Label Definitions")
+	}
+	for _, labelDef := range labelDefs {
+		gqlLabelDef, err := e.labelDefConv.ToGraphQL(labelDef)
+		if err != nil {
+			return "", errors.Wrapf(err, "while converting Label Definition %s", labelDef.Key)
+		}
+		data.LabelDefinitions = append(data.LabelDefinitions, gqlLabelDef)
+	}
+
+	marshalled, err := json.Marshal(data)
+	if err != nil {
+		return "", errors.Wrap(err, "while marshalling data of tenant")
+	}
+
+	return string(marshalled), nil
+}
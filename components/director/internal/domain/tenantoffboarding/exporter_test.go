@@ -0,0 +1,151 @@
+package tenantoffboarding_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExporter_Export(t *testing.T) {
+	// GIVEN
+	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExtTenant)
+	var noFilter []*labelfilter.LabelFilter
+
+	firstApps := []*model.Application{{ID: "app-1", Name: "foo"}}
+	secondApps := []*model.Application{{ID: "app-2", Name: "bar"}}
+	runtimes := []*model.Runtime{{ID: "runtime-1", Name: "baz"}}
+	labelDef := model.LabelDefinition{Key: "scenarios"}
+
+	firstAppsPage := &model.ApplicationPage{Data: firstApps, PageInfo: &pagination.Page{EndCursor: "next", HasNextPage: true}}
+	secondAppsPage := &model.ApplicationPage{Data: secondApps, PageInfo: &pagination.Page{}}
+	runtimesPage := &model.RuntimePage{Data: runtimes, PageInfo: &pagination.Page{}}
+
+	t.Run("Success", func(t *testing.T) {
+		appSvc := &automock.ApplicationService{}
+		appSvc.On("List", ctx, noFilter, 100, "").Return(firstAppsPage, nil).Once()
+		appSvc.On("List", ctx, noFilter, 100, "next").Return(secondAppsPage, nil).Once()
+
+		appConv := &automock.ApplicationConverter{}
+		appConv.On("MultipleToGraphQL", firstApps).Return([]*graphql.Application{{ID: "app-1", Name: "foo"}}).Once()
+		appConv.On("MultipleToGraphQL", secondApps).Return([]*graphql.Application{{ID: "app-2", Name: "bar"}}).Once()
+
+		runtimeSvc := &automock.RuntimeService{}
+		runtimeSvc.On("List", ctx, noFilter, 100, "").Return(runtimesPage, nil).Once()
+
+		runtimeConv := &automock.RuntimeConverter{}
+		runtimeConv.On("MultipleToGraphQL", runtimes).Return([]*graphql.Runtime{{ID: "runtime-1", Name: "baz"}}).Once()
+
+		labelDefSvc := &automock.LabelDefinitionService{}
+		labelDefSvc.On("List", ctx, testTenant).Return([]model.LabelDefinition{labelDef}, nil).Once()
+
+		labelDefConv := &automock.LabelDefinitionConverter{}
+		labelDefConv.On("ToGraphQL", labelDef).Return(graphql.LabelDefinition{Key: "scenarios"}, nil).Once()
+
+		exporter := tenantoffboarding.NewExporter(appSvc, appConv, runtimeSvc, runtimeConv, labelDefSvc, labelDefConv)
+
+		// WHEN
+		result, err := exporter.Export(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"applications": [
+				{"id": "app-1", "name": "foo", "providerName": null, "description": null, "integrationSystemID": null, "status": null, "healthCheckURL": null},
+				{"id": "app-2", "name": "bar", "providerName": null, "description": null, "integrationSystemID": null, "status": null, "healthCheckURL": null}
+			],
+			"runtimes": [
+				{"id": "runtime-1", "name": "baz", "description": null, "status": null, "metadata": null, "eventingConfiguration": null}
+			],
+			"labelDefinitions": [
+				{"key": "scenarios", "schema": null}
+			]
+		}`, result)
+		mock.AssertExpectationsForObjects(t, appSvc, appConv, runtimeSvc, runtimeConv, labelDefSvc, labelDefConv)
+	})
+
+	t.Run("Returns error when listing Applications failed", func(t *testing.T) {
+		appSvc := &automock.ApplicationService{}
+		appSvc.On("List", ctx, noFilter, 100, "").Return(nil, testError).Once()
+
+		exporter := tenantoffboarding.NewExporter(appSvc, nil, nil, nil, nil, nil)
+
+		// WHEN
+		_, err := exporter.Export(ctx)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+		mock.AssertExpectationsForObjects(t, appSvc)
+	})
+
+	t.Run("Returns error when listing Runtimes failed", func(t *testing.T) {
+		appSvc := &automock.ApplicationService{}
+		appSvc.On("List", ctx, noFilter, 100, "").Return(secondAppsPage, nil).Once()
+
+		appConv := &automock.ApplicationConverter{}
+		appConv.On("MultipleToGraphQL", secondApps).Return(nil).Once()
+
+		runtimeSvc := &automock.RuntimeService{}
+		runtimeSvc.On("List", ctx, noFilter, 100, "").Return(nil, testError).Once()
+
+		exporter := tenantoffboarding.NewExporter(appSvc, appConv, runtimeSvc, nil, nil, nil)
+
+		// WHEN
+		_, err := exporter.Export(ctx)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+		mock.AssertExpectationsForObjects(t, appSvc, appConv, runtimeSvc)
+	})
+
+	t.Run("Returns error when converting Label Definition failed", func(t *testing.T) {
+		appSvc := &automock.ApplicationService{}
+		appSvc.On("List", ctx, noFilter, 100, "").Return(secondAppsPage, nil).Once()
+
+		appConv := &automock.ApplicationConverter{}
+		appConv.On("MultipleToGraphQL", secondApps).Return(nil).Once()
+
+		runtimeSvc := &automock.RuntimeService{}
+		runtimeSvc.On("List", ctx, noFilter, 100, "").Return(runtimesPage, nil).Once()
+
+		runtimeConv := &automock.RuntimeConverter{}
+		runtimeConv.On("MultipleToGraphQL", runtimes).Return(nil).Once()
+
+		labelDefSvc := &automock.LabelDefinitionService{}
+		labelDefSvc.On("List", ctx, testTenant).Return([]model.LabelDefinition{labelDef}, nil).Once()
+
+		labelDefConv := &automock.LabelDefinitionConverter{}
+		labelDefConv.On("ToGraphQL", labelDef).Return(graphql.LabelDefinition{}, testError).Once()
+
+		exporter := tenantoffboarding.NewExporter(appSvc, appConv, runtimeSvc, runtimeConv, labelDefSvc, labelDefConv)
+
+		// WHEN
+		_, err := exporter.Export(ctx)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+		mock.AssertExpectationsForObjects(t, appSvc, appConv, runtimeSvc, runtimeConv, labelDefSvc, labelDefConv)
+	})
+
+	t.Run("Returns error when tenant is missing in context", func(t *testing.T) {
+		exporter := tenantoffboarding.NewExporter(nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		_, err := exporter.Export(context.TODO())
+
+		// THEN
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,91 @@
+package tenantoffboarding_test
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/lib/pq"
+)
+
+const (
+	testID           = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	testTenant       = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	testExtTenant    = "external-tenant"
+	testExportedData = `{"applications":[],"runtimes":[],"labelDefinitions":[]}`
+	testErrorMessage = "previous error"
+	testRuntimeID    = "cccccccc-cccc-cccc-cccc-cccccccccccc"
+)
+
+var (
+	testError      = errors.New("test error")
+	testTime       = time.Date(2020, 12, 8, 12, 0, 0, 0, time.UTC)
+	testPurgeAfter = testTime.Add(time.Hour)
+)
+
+func fixModelTenantOffboarding(state model.TenantOffboardingState) *model.TenantOffboarding {
+	return &model.TenantOffboarding{
+		ID:             testID,
+		TenantID:       testTenant,
+		ExternalTenant: testExtTenant,
+		State:          state,
+		CreatedAt:      testTime,
+		UpdatedAt:      testTime,
+	}
+}
+
+func fixModelTenantOffboardingWithAllProperties() *model.TenantOffboarding {
+	offboarding := fixModelTenantOffboarding(model.TenantOffboardingStateExported)
+	offboarding.ExportRequested = true
+	offboarding.ExportedData = str.Ptr(testExportedData)
+	offboarding.Error = str.Ptr(testErrorMessage)
+	offboarding.PurgeAfter = &testPurgeAfter
+	offboarding.RuntimesToNotify = []string{testRuntimeID}
+	return offboarding
+}
+
+func fixGQLTenantOffboardingWithAllProperties() *graphql.TenantOffboarding {
+	exportedData := graphql.JSON(testExportedData)
+	purgeAfter := graphql.Timestamp(testPurgeAfter)
+	return &graphql.TenantOffboarding{
+		ID:              testID,
+		Tenant:          testExtTenant,
+		State:           graphql.TenantOffboardingStateExported,
+		ExportRequested: true,
+		ExportedData:    &exportedData,
+		Error:           str.Ptr(testErrorMessage),
+		CreatedAt:       graphql.Timestamp(testTime),
+		UpdatedAt:       graphql.Timestamp(testTime),
+		PurgeAfter:      &purgeAfter,
+	}
+}
+
+func fixEntityTenantOffboardingWithAllProperties() *tenantoffboarding.Entity {
+	return &tenantoffboarding.Entity{
+		ID:               testID,
+		TenantID:         testTenant,
+		ExternalTenant:   testExtTenant,
+		State:            string(model.TenantOffboardingStateExported),
+		ExportRequested:  true,
+		ExportedData:     sql.NullString{String: testExportedData, Valid: true},
+		Error:            sql.NullString{String: testErrorMessage, Valid: true},
+		CreatedAt:        testTime,
+		UpdatedAt:        testTime,
+		PurgeAfter:       &testPurgeAfter,
+		RuntimesToNotify: pq.StringArray{testRuntimeID},
+	}
+}
+
+func fixModelTenant() *model.BusinessTenantMapping {
+	return &model.BusinessTenantMapping{
+		ID:             testTenant,
+		Name:           "tenant",
+		ExternalTenant: testExtTenant,
+		Provider:       "Compass",
+		Status:         model.Active,
+	}
+}
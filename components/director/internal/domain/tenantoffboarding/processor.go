@@ -0,0 +1,299 @@
+package tenantoffboarding
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+)
+
+// Config configures the offboarding of tenants. The retention period starts when the credentials of the tenant are revoked.
+// When RetentionPeriod is zero, the data of an offboarded tenant is purged as soon as its credentials are revoked and the requested export is completed.
+type Config struct {
+	RetentionPeriod    time.Duration `envconfig:"default=0s"`
+	ProcessingInterval time.Duration `envconfig:"default=1m"`
+	// RuntimeNotificationURL receives the Runtimes which lost their credentials. When it is empty, the Runtimes are only logged.
+	RuntimeNotificationURL string `envconfig:"optional"`
+}
+
+//go:generate mockery -name=SystemAuthService -output=automock -outpkg=automock -case=underscore
+type SystemAuthService interface {
+	ListForTenant(ctx context.Context) ([]model.SystemAuth, error)
+	DeleteAllForTenant(ctx context.Context) error
+}
+
+//go:generate mockery -name=OAuth20Service -output=automock -outpkg=automock -case=underscore
+type OAuth20Service interface {
+	DeleteMultipleClientCredentials(ctx context.Context, auths []model.SystemAuth) error
+}
+
+//go:generate mockery -name=CertificateRevoker -output=automock -outpkg=automock -case=underscore
+type CertificateRevoker interface {
+	RevokeCertificates(ctx context.Context, authIDs []string) error
+}
+
+//go:generate mockery -name=DataExporter -output=automock -outpkg=automock -case=underscore
+type DataExporter interface {
+	Export(ctx context.Context) (string, error)
+}
+
+//go:generate mockery -name=RuntimeNotifier -output=automock -outpkg=automock -case=underscore
+type RuntimeNotifier interface {
+	NotifyTenantOffboarded(ctx context.Context, offboarding *model.TenantOffboarding, runtimeIDs []string) error
+}
+
+type Processor struct {
+	transact persistence.Transactioner
+
+	repo        TenantOffboardingRepository
+	tenantRepo  TenantRepository
+	sysAuthSvc  SystemAuthService
+	oAuth20Svc  OAuth20Service
+	certRevoker CertificateRevoker
+	exporter    DataExporter
+	notifier    RuntimeNotifier
+
+	cfg          Config
+	timestampGen timestamp.Generator
+}
+
+func NewProcessor(transact persistence.Transactioner, repo TenantOffboardingRepository, tenantRepo TenantRepository, sysAuthSvc SystemAuthService, oAuth20Svc OAuth20Service, certRevoker CertificateRevoker, exporter DataExporter, notifier RuntimeNotifier, cfg Config) *Processor {
+	return &Processor{
+		transact:     transact,
+		repo:         repo,
+		tenantRepo:   tenantRepo,
+		sysAuthSvc:   sysAuthSvc,
+		oAuth20Svc:   oAuth20Svc,
+		certRevoker:  certRevoker,
+		exporter:     exporter,
+		notifier:     notifier,
+		cfg:          cfg,
+		timestampGen: timestamp.DefaultGenerator(),
+	}
+}
+
+// Start completes the pending steps of tenant offboardings, until the context is cancelled
+func (p *Processor) Start(ctx context.Context) {
+	ticker := time.NewTicker(p.cfg.ProcessingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("Stopping tenant offboarding processor")
+			return
+		case <-ticker.C:
+			if err := p.ProcessPending(ctx); err != nil {
+				log.C(ctx).WithError(err).Error("Failed to process tenant offboardings")
+			}
+		}
+	}
+}
+
+// ProcessPending completes the pending steps of all unfinished tenant offboardings. Every step is completed in a separate transaction.
+// When a step fails, the error is stored in the offboarding and the step is retried on the next run.
+func (p *Processor) ProcessPending(ctx context.Context) error {
+	offboardings, err := p.listUnfinished(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, offboarding := range offboardings {
+		offboardingCtx := tenant.SaveToContext(ctx, offboarding.TenantID, offboarding.ExternalTenant)
+
+		if err := p.process(offboardingCtx, offboarding); err != nil {
+			log.C(ctx).WithError(err).Errorf("Failed to process offboarding of tenant %s", offboarding.ExternalTenant)
+			if err := p.recordError(offboardingCtx, offboarding, err); err != nil {
+				log.C(ctx).WithError(err).Errorf("Failed to record error of offboarding of tenant %s", offboarding.ExternalTenant)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *Processor) listUnfinished(ctx context.Context) ([]*model.TenantOffboarding, error) {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening transaction")
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	offboardings, err := p.repo.ListUnfinished(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing unfinished tenant offboardings")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing transaction")
+	}
+
+	return offboardings, nil
+}
+
+func (p *Processor) process(ctx context.Context, offboarding *model.TenantOffboarding) error {
+	if offboarding.State == model.TenantOffboardingStateDeactivated {
+		if err := p.completeStep(ctx, offboarding, p.revokeCredentials); err != nil {
+			return errors.Wrap(err, "while revoking credentials")
+		}
+	}
+
+	if len(offboarding.RuntimesToNotify) > 0 {
+		if err := p.completeStep(ctx, offboarding, p.notifyRuntimes); err != nil {
+			return errors.Wrap(err, "while notifying Runtimes")
+		}
+	}
+
+	if offboarding.IsExportPending() {
+		if err := p.completeStep(ctx, offboarding, p.exportData); err != nil {
+			return errors.Wrap(err, "while exporting data")
+		}
+	}
+
+	if offboarding.IsPurgeDue(p.timestampGen()) {
+		if err := p.completeStep(ctx, offboarding, p.purgeData); err != nil {
+			return errors.Wrap(err, "while purging data")
+		}
+	}
+
+	return nil
+}
+
+// completeStep applies the step to a copy of the offboarding and stores the result. The offboarding is updated only if the transaction is committed.
+func (p *Processor) completeStep(ctx context.Context, offboarding *model.TenantOffboarding, step func(ctx context.Context, offboarding *model.TenantOffboarding) error) error {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening transaction")
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	updated := *offboarding
+	if err := step(ctx, &updated); err != nil {
+		return err
+	}
+
+	updated.Error = nil
+	updated.UpdatedAt = p.timestampGen()
+	if err := p.repo.Update(ctx, updated); err != nil {
+		return errors.Wrapf(err, "while updating offboarding of tenant %s", offboarding.ExternalTenant)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "while committing transaction")
+	}
+
+	log.C(ctx).Infof("Offboarding of tenant %s reached state %s", updated.ExternalTenant, updated.State)
+	*offboarding = updated
+	return nil
+}
+
+// revokeCredentials deletes the System Auths of the tenant together with their OAuth 2.0 clients and stores the Runtimes which lost their credentials to notify them.
+// Client certificates issued by the Connector stay valid after their System Auths are deleted, so they are added to the revocation list of the Connector first.
+func (p *Processor) revokeCredentials(ctx context.Context, offboarding *model.TenantOffboarding) error {
+	auths, err := p.sysAuthSvc.ListForTenant(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := p.certRevoker.RevokeCertificates(ctx, authIDsFromSystemAuths(auths)); err != nil {
+		return err
+	}
+
+	if err := p.oAuth20Svc.DeleteMultipleClientCredentials(ctx, auths); err != nil {
+		return err
+	}
+
+	if err := p.sysAuthSvc.DeleteAllForTenant(ctx); err != nil {
+		return err
+	}
+
+	purgeAfter := p.timestampGen().Add(p.cfg.RetentionPeriod)
+	offboarding.PurgeAfter = &purgeAfter
+	offboarding.RuntimesToNotify = runtimeIDsFromSystemAuths(auths)
+	offboarding.State = model.TenantOffboardingStateCredentialsRevoked
+	return nil
+}
+
+// notifyRuntimes notifies the Runtimes which lost their credentials. The Runtimes are kept in the offboarding until the notification succeeds.
+func (p *Processor) notifyRuntimes(ctx context.Context, offboarding *model.TenantOffboarding) error {
+	if err := p.notifier.NotifyTenantOffboarded(ctx, offboarding, offboarding.RuntimesToNotify); err != nil {
+		return err
+	}
+
+	offboarding.RuntimesToNotify = nil
+	return nil
+}
+
+func (p *Processor) exportData(ctx context.Context, offboarding *model.TenantOffboarding) error {
+	data, err := p.exporter.Export(ctx)
+	if err != nil {
+		return err
+	}
+
+	offboarding.ExportedData = &data
+	offboarding.State = model.TenantOffboardingStateExported
+	return nil
+}
+
+// purgeData deletes the tenant, which deletes all of its data. The offboarding is kept without the exported data.
+func (p *Processor) purgeData(ctx context.Context, offboarding *model.TenantOffboarding) error {
+	if err := p.tenantRepo.Delete(ctx, offboarding.TenantID); err != nil {
+		return errors.Wrapf(err, "while deleting tenant %s", offboarding.ExternalTenant)
+	}
+
+	offboarding.ExportedData = nil
+	offboarding.State = model.TenantOffboardingStatePurged
+	return nil
+}
+
+func (p *Processor) recordError(ctx context.Context, offboarding *model.TenantOffboarding, processingErr error) error {
+	tx, err := p.transact.Begin()
+	if err != nil {
+		return errors.Wrap(err, "while opening transaction")
+	}
+	defer p.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	offboarding.Error = str.Ptr(processingErr.Error())
+	offboarding.UpdatedAt = p.timestampGen()
+	if err := p.repo.Update(ctx, *offboarding); err != nil {
+		return errors.Wrapf(err, "while updating offboarding of tenant %s", offboarding.ExternalTenant)
+	}
+
+	return tx.Commit()
+}
+
+func authIDsFromSystemAuths(auths []model.SystemAuth) []string {
+	authIDs := make([]string, 0, len(auths))
+	for _, auth := range auths {
+		authIDs = append(authIDs, auth.ID)
+	}
+	return authIDs
+}
+
+func runtimeIDsFromSystemAuths(auths []model.SystemAuth) []string {
+	var runtimeIDs []string
+	seen := make(map[string]struct{})
+	for _, auth := range auths {
+		if auth.RuntimeID == nil {
+			continue
+		}
+		if _, ok := seen[*auth.RuntimeID]; ok {
+			continue
+		}
+		seen[*auth.RuntimeID] = struct{}{}
+		runtimeIDs = append(runtimeIDs, *auth.RuntimeID)
+	}
+	return runtimeIDs
+}
@@ -0,0 +1,291 @@
+package tenantoffboarding_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessor_ProcessPending(t *testing.T) {
+	// GIVEN
+	now := testTime.Add(time.Minute)
+	retentionPeriod := time.Hour
+	purgeAfter := now.Add(retentionPeriod)
+
+	runtimeID := "runtime-1"
+	auths := []model.SystemAuth{
+		{ID: "auth-1", RuntimeID: str.Ptr(runtimeID)},
+		{ID: "auth-2", RuntimeID: str.Ptr(runtimeID)},
+		{ID: "auth-3", AppID: str.Ptr("app-1")},
+	}
+	authIDs := []string{"auth-1", "auth-2", "auth-3"}
+
+	revoked := fixModelTenantOffboarding(model.TenantOffboardingStateCredentialsRevoked)
+	revoked.UpdatedAt = now
+	revoked.PurgeAfter = &now
+	revoked.RuntimesToNotify = []string{runtimeID}
+
+	notified := fixModelTenantOffboarding(model.TenantOffboardingStateCredentialsRevoked)
+	notified.UpdatedAt = now
+	notified.PurgeAfter = &now
+
+	purged := fixModelTenantOffboarding(model.TenantOffboardingStatePurged)
+	purged.UpdatedAt = now
+	purged.PurgeAfter = &now
+
+	t.Run("Success when credentials are revoked, Runtimes are notified and data is purged", func(t *testing.T) {
+		persistTx, transact := fixTransactioner(4, 4)
+
+		repo := &automock.TenantOffboardingRepository{}
+		repo.On("ListUnfinished", txtest.CtxWithDBMatcher()).Return([]*model.TenantOffboarding{fixModelTenantOffboarding(model.TenantOffboardingStateDeactivated)}, nil).Once()
+		repo.On("Update", txtest.CtxWithDBMatcher(), *revoked).Return(nil).Once()
+		repo.On("Update", txtest.CtxWithDBMatcher(), *notified).Return(nil).Once()
+		repo.On("Update", txtest.CtxWithDBMatcher(), *purged).Return(nil).Once()
+
+		tenantRepo := &automock.TenantRepository{}
+		tenantRepo.On("Delete", txtest.CtxWithDBMatcher(), testTenant).Return(nil).Once()
+
+		sysAuthSvc := &automock.SystemAuthService{}
+		sysAuthSvc.On("ListForTenant", txtest.CtxWithDBMatcher()).Return(auths, nil).Once()
+		sysAuthSvc.On("DeleteAllForTenant", txtest.CtxWithDBMatcher()).Return(nil).Once()
+
+		oAuth20Svc := &automock.OAuth20Service{}
+		oAuth20Svc.On("DeleteMultipleClientCredentials", txtest.CtxWithDBMatcher(), auths).Return(nil).Once()
+
+		certRevoker := &automock.CertificateRevoker{}
+		certRevoker.On("RevokeCertificates", txtest.CtxWithDBMatcher(), authIDs).Return(nil).Once()
+
+		notifier := &automock.RuntimeNotifier{}
+		notifier.On("NotifyTenantOffboarded", txtest.CtxWithDBMatcher(), revoked, []string{runtimeID}).Return(nil).Once()
+
+		processor := tenantoffboarding.NewProcessor(transact, repo, tenantRepo, sysAuthSvc, oAuth20Svc, certRevoker, nil, notifier, tenantoffboarding.Config{})
+		processor.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		err := processor.ProcessPending(context.TODO())
+
+		// THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, repo, tenantRepo, sysAuthSvc, oAuth20Svc, certRevoker, notifier)
+	})
+
+	t.Run("Success when data is exported and retention period has not expired", func(t *testing.T) {
+		persistTx, transact := fixTransactioner(3, 3)
+
+		offboarding := fixModelTenantOffboarding(model.TenantOffboardingStateDeactivated)
+		offboarding.ExportRequested = true
+
+		revokedWithExport := fixModelTenantOffboarding(model.TenantOffboardingStateCredentialsRevoked)
+		revokedWithExport.ExportRequested = true
+		revokedWithExport.UpdatedAt = now
+		revokedWithExport.PurgeAfter = &purgeAfter
+
+		exported := fixModelTenantOffboarding(model.TenantOffboardingStateExported)
+		exported.ExportRequested = true
+		exported.ExportedData = str.Ptr(testExportedData)
+		exported.UpdatedAt = now
+		exported.PurgeAfter = &purgeAfter
+
+		repo := &automock.TenantOffboardingRepository{}
+		repo.On("ListUnfinished", txtest.CtxWithDBMatcher()).Return([]*model.TenantOffboarding{offboarding}, nil).Once()
+		repo.On("Update", txtest.CtxWithDBMatcher(), *revokedWithExport).Return(nil).Once()
+		repo.On("Update", txtest.CtxWithDBMatcher(), *exported).Return(nil).Once()
+
+		sysAuthSvc := &automock.SystemAuthService{}
+		sysAuthSvc.On("ListForTenant", txtest.CtxWithDBMatcher()).Return(nil, nil).Once()
+		sysAuthSvc.On("DeleteAllForTenant", txtest.CtxWithDBMatcher()).Return(nil).Once()
+
+		oAuth20Svc := &automock.OAuth20Service{}
+		oAuth20Svc.On("DeleteMultipleClientCredentials", txtest.CtxWithDBMatcher(), []model.SystemAuth(nil)).Return(nil).Once()
+
+		certRevoker := &automock.CertificateRevoker{}
+		certRevoker.On("RevokeCertificates", txtest.CtxWithDBMatcher(), []string{}).Return(nil).Once()
+
+		exporter := &automock.DataExporter{}
+		exporter.On("Export", txtest.CtxWithDBMatcher()).Return(testExportedData, nil).Once()
+
+		processor := tenantoffboarding.NewProcessor(transact, repo, nil, sysAuthSvc, oAuth20Svc, certRevoker, exporter, nil, tenantoffboarding.Config{RetentionPeriod: retentionPeriod})
+		processor.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		err := processor.ProcessPending(context.TODO())
+
+		// THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, repo, sysAuthSvc, oAuth20Svc, certRevoker, exporter)
+	})
+
+	t.Run("Success when retention period of exported data has expired", func(t *testing.T) {
+		persistTx, transact := fixTransactioner(2, 2)
+
+		offboarding := fixModelTenantOffboardingWithAllProperties()
+		offboarding.Error = nil
+		offboarding.RuntimesToNotify = nil
+		offboarding.PurgeAfter = &testTime
+
+		purgedAfterExport := fixModelTenantOffboarding(model.TenantOffboardingStatePurged)
+		purgedAfterExport.ExportRequested = true
+		purgedAfterExport.UpdatedAt = now
+		purgedAfterExport.PurgeAfter = &testTime
+
+		repo := &automock.TenantOffboardingRepository{}
+		repo.On("ListUnfinished", txtest.CtxWithDBMatcher()).Return([]*model.TenantOffboarding{offboarding}, nil).Once()
+		repo.On("Update", txtest.CtxWithDBMatcher(), *purgedAfterExport).Return(nil).Once()
+
+		tenantRepo := &automock.TenantRepository{}
+		tenantRepo.On("Delete", txtest.CtxWithDBMatcher(), testTenant).Return(nil).Once()
+
+		processor := tenantoffboarding.NewProcessor(transact, repo, tenantRepo, nil, nil, nil, nil, nil, tenantoffboarding.Config{})
+		processor.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		err := processor.ProcessPending(context.TODO())
+
+		// THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, repo, tenantRepo)
+	})
+
+	t.Run("Records error when revoking credentials failed", func(t *testing.T) {
+		persistTx, transact := fixTransactioner(3, 2)
+
+		failed := fixModelTenantOffboarding(model.TenantOffboardingStateDeactivated)
+		failed.UpdatedAt = now
+		failed.Error = str.Ptr("while revoking credentials: while deleting OAuth 2.0 credentials: test error")
+
+		repo := &automock.TenantOffboardingRepository{}
+		repo.On("ListUnfinished", txtest.CtxWithDBMatcher()).Return([]*model.TenantOffboarding{fixModelTenantOffboarding(model.TenantOffboardingStateDeactivated)}, nil).Once()
+		repo.On("Update", txtest.CtxWithDBMatcher(), *failed).Return(nil).Once()
+
+		sysAuthSvc := &automock.SystemAuthService{}
+		sysAuthSvc.On("ListForTenant", txtest.CtxWithDBMatcher()).Return(auths, nil).Once()
+
+		oAuth20Svc := &automock.OAuth20Service{}
+		oAuth20Svc.On("DeleteMultipleClientCredentials", txtest.CtxWithDBMatcher(), auths).Return(errors.Wrap(testError, "while deleting OAuth 2.0 credentials")).Once()
+
+		certRevoker := &automock.CertificateRevoker{}
+		certRevoker.On("RevokeCertificates", txtest.CtxWithDBMatcher(), authIDs).Return(nil).Once()
+
+		processor := tenantoffboarding.NewProcessor(transact, repo, nil, sysAuthSvc, oAuth20Svc, certRevoker, nil, nil, tenantoffboarding.Config{})
+		processor.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		err := processor.ProcessPending(context.TODO())
+
+		// THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, repo, sysAuthSvc, oAuth20Svc, certRevoker)
+	})
+
+	t.Run("Records error and keeps Runtimes to notify when notifying Runtimes failed", func(t *testing.T) {
+		persistTx, transact := fixTransactioner(3, 2)
+
+		offboarding := fixModelTenantOffboarding(model.TenantOffboardingStateCredentialsRevoked)
+		offboarding.PurgeAfter = &testTime
+		offboarding.RuntimesToNotify = []string{runtimeID}
+
+		failed := fixModelTenantOffboarding(model.TenantOffboardingStateCredentialsRevoked)
+		failed.UpdatedAt = now
+		failed.PurgeAfter = &testTime
+		failed.RuntimesToNotify = []string{runtimeID}
+		failed.Error = str.Ptr("while notifying Runtimes: test error")
+
+		repo := &automock.TenantOffboardingRepository{}
+		repo.On("ListUnfinished", txtest.CtxWithDBMatcher()).Return([]*model.TenantOffboarding{offboarding}, nil).Once()
+		repo.On("Update", txtest.CtxWithDBMatcher(), *failed).Return(nil).Once()
+
+		notifier := &automock.RuntimeNotifier{}
+		notifier.On("NotifyTenantOffboarded", txtest.CtxWithDBMatcher(), mock.Anything, []string{runtimeID}).Return(testError).Once()
+
+		processor := tenantoffboarding.NewProcessor(transact, repo, nil, nil, nil, nil, nil, notifier, tenantoffboarding.Config{})
+		processor.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		err := processor.ProcessPending(context.TODO())
+
+		// THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, repo, notifier)
+	})
+
+	t.Run("Records error when revoking client certificates failed", func(t *testing.T) {
+		persistTx, transact := fixTransactioner(3, 2)
+
+		failed := fixModelTenantOffboarding(model.TenantOffboardingStateDeactivated)
+		failed.UpdatedAt = now
+		failed.Error = str.Ptr("while revoking credentials: while calling connector to revoke client certificates: test error")
+
+		repo := &automock.TenantOffboardingRepository{}
+		repo.On("ListUnfinished", txtest.CtxWithDBMatcher()).Return([]*model.TenantOffboarding{fixModelTenantOffboarding(model.TenantOffboardingStateDeactivated)}, nil).Once()
+		repo.On("Update", txtest.CtxWithDBMatcher(), *failed).Return(nil).Once()
+
+		sysAuthSvc := &automock.SystemAuthService{}
+		sysAuthSvc.On("ListForTenant", txtest.CtxWithDBMatcher()).Return(auths, nil).Once()
+
+		certRevoker := &automock.CertificateRevoker{}
+		certRevoker.On("RevokeCertificates", txtest.CtxWithDBMatcher(), authIDs).Return(errors.Wrap(testError, "while calling connector to revoke client certificates")).Once()
+
+		processor := tenantoffboarding.NewProcessor(transact, repo, nil, sysAuthSvc, nil, certRevoker, nil, nil, tenantoffboarding.Config{})
+		processor.SetTimestampGen(func() time.Time { return now })
+
+		// WHEN
+		err := processor.ProcessPending(context.TODO())
+
+		// THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, persistTx, transact, repo, sysAuthSvc, certRevoker)
+	})
+
+	t.Run("Returns error when listing unfinished offboardings failed", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(testError).ThatDoesntExpectCommit()
+
+		repo := &automock.TenantOffboardingRepository{}
+		repo.On("ListUnfinished", txtest.CtxWithDBMatcher()).Return(nil, testError).Once()
+
+		processor := tenantoffboarding.NewProcessor(transact, repo, nil, nil, nil, nil, nil, nil, tenantoffboarding.Config{})
+
+		// WHEN
+		err := processor.ProcessPending(context.TODO())
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+		mock.AssertExpectationsForObjects(t, persistTx, transact, repo)
+	})
+
+	t.Run("Returns error when transaction begin failed", func(t *testing.T) {
+		persistTx, transact := txtest.NewTransactionContextGenerator(testError).ThatFailsOnBegin()
+
+		processor := tenantoffboarding.NewProcessor(transact, nil, nil, nil, nil, nil, nil, nil, tenantoffboarding.Config{})
+
+		// WHEN
+		err := processor.ProcessPending(context.TODO())
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+		mock.AssertExpectationsForObjects(t, persistTx, transact)
+	})
+}
+
+func fixTransactioner(begins, commits int) (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+	persistTx := &persistenceautomock.PersistenceTx{}
+	persistTx.On("Commit").Return(nil).Times(commits)
+
+	transact := &persistenceautomock.Transactioner{}
+	transact.On("Begin").Return(persistTx, nil).Times(begins)
+	transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return().Times(begins)
+
+	return persistTx, transact
+}
@@ -0,0 +1,78 @@
+package tenantoffboarding
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const (
+	tenantOffboardingsTable string = `public.tenant_offboardings`
+	externalTenantColumn    string = `external_tenant`
+	stateColumn             string = `state`
+	createdAtColumn         string = `created_at`
+)
+
+var (
+	tenantOffboardingColumns = []string{"id", "tenant_id", externalTenantColumn, stateColumn, "export_requested", "exported_data", "error", createdAtColumn, "updated_at", "purge_after", "runtimes_to_notify"}
+	updatableColumns         = []string{stateColumn, "export_requested", "exported_data", "error", "updated_at", "purge_after", "runtimes_to_notify"}
+)
+
+//go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
+type EntityConverter interface {
+	ToEntity(in *model.TenantOffboarding) *Entity
+	FromEntity(in *Entity) *model.TenantOffboarding
+}
+
+type pgRepository struct {
+	creator            repo.Creator
+	singleGetterGlobal repo.SingleGetterGlobal
+	listerGlobal       repo.ListerGlobal
+	updaterGlobal      repo.UpdaterGlobal
+	conv               EntityConverter
+}
+
+func NewRepository(conv EntityConverter) *pgRepository {
+	return &pgRepository{
+		creator:            repo.NewCreator(resource.TenantOffboarding, tenantOffboardingsTable, tenantOffboardingColumns),
+		singleGetterGlobal: repo.NewSingleGetterGlobal(resource.TenantOffboarding, tenantOffboardingsTable, tenantOffboardingColumns),
+		listerGlobal:       repo.NewListerGlobal(resource.TenantOffboarding, tenantOffboardingsTable, tenantOffboardingColumns),
+		updaterGlobal:      repo.NewUpdaterGlobal(resource.TenantOffboarding, tenantOffboardingsTable, updatableColumns, []string{"id"}),
+		conv:               conv,
+	}
+}
+
+func (r *pgRepository) Create(ctx context.Context, item model.TenantOffboarding) error {
+	return r.creator.Create(ctx, r.conv.ToEntity(&item))
+}
+
+// GetByExternalTenant returns the latest offboarding of the tenant. The same external tenant can be offboarded again after it was loaded anew.
+func (r *pgRepository) GetByExternalTenant(ctx context.Context, externalTenant string) (*model.TenantOffboarding, error) {
+	var entity Entity
+	conditions := repo.Conditions{repo.NewEqualCondition(externalTenantColumn, externalTenant)}
+	orderBy := repo.OrderByParams{repo.NewDescOrderBy(createdAtColumn)}
+	if err := r.singleGetterGlobal.GetGlobal(ctx, conditions, orderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity), nil
+}
+
+func (r *pgRepository) ListUnfinished(ctx context.Context) ([]*model.TenantOffboarding, error) {
+	var entities EntityCollection
+	if err := r.listerGlobal.ListGlobal(ctx, &entities, repo.NewNotEqualCondition(stateColumn, string(model.TenantOffboardingStatePurged))); err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.TenantOffboarding, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, r.conv.FromEntity(&entity))
+	}
+	return items, nil
+}
+
+func (r *pgRepository) Update(ctx context.Context, item model.TenantOffboarding) error {
+	return r.updaterGlobal.UpdateSingleGlobal(ctx, r.conv.ToEntity(&item))
+}
@@ -0,0 +1,235 @@
+package tenantoffboarding_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	tenantOffboardingColumns = []string{"id", "tenant_id", "external_tenant", "state", "export_requested", "exported_data", "error", "created_at", "updated_at", "purge_after", "runtimes_to_notify"}
+	testRuntimesToNotify     = fmt.Sprintf(`{"%s"}`, testRuntimeID)
+)
+
+func fixTenantOffboardingRow() []driver.Value {
+	return []driver.Value{testID, testTenant, testExtTenant, string(model.TenantOffboardingStateExported), true, testExportedData, testErrorMessage, testTime, testTime, testPurgeAfter, testRuntimesToNotify}
+}
+
+func TestRepository_Create(t *testing.T) {
+	insertQuery := regexp.QuoteMeta(`INSERT INTO public.tenant_offboardings ( id, tenant_id, external_tenant, state, export_requested, exported_data, error, created_at, updated_at, purge_after, runtimes_to_notify ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		offboardingModel := fixModelTenantOffboardingWithAllProperties()
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", offboardingModel).Return(fixEntityTenantOffboardingWithAllProperties()).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(insertQuery).
+			WithArgs(fixTenantOffboardingRow()...).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := tenantoffboarding.NewRepository(mockConverter)
+
+		// WHEN
+		err := repo.Create(ctx, *offboardingModel)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when creating", func(t *testing.T) {
+		// GIVEN
+		offboardingModel := fixModelTenantOffboardingWithAllProperties()
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", offboardingModel).Return(fixEntityTenantOffboardingWithAllProperties()).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(insertQuery).
+			WithArgs(fixTenantOffboardingRow()...).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := tenantoffboarding.NewRepository(mockConverter)
+
+		// WHEN
+		err := repo.Create(ctx, *offboardingModel)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_GetByExternalTenant(t *testing.T) {
+	selectQuery := regexp.QuoteMeta(`SELECT id, tenant_id, external_tenant, state, export_requested, exported_data, error, created_at, updated_at, purge_after, runtimes_to_notify FROM public.tenant_offboardings WHERE external_tenant = $1 ORDER BY created_at DESC`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		offboardingModel := fixModelTenantOffboardingWithAllProperties()
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", fixEntityTenantOffboardingWithAllProperties()).Return(offboardingModel).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		rows := sqlmock.NewRows(tenantOffboardingColumns).AddRow(fixTenantOffboardingRow()...)
+		dbMock.ExpectQuery(selectQuery).WithArgs(testExtTenant).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := tenantoffboarding.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := repo.GetByExternalTenant(ctx, testExtTenant)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, offboardingModel, result)
+	})
+
+	t.Run("Returns not found error when tenant was not offboarded", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(selectQuery).WithArgs(testExtTenant).WillReturnRows(sqlmock.NewRows(tenantOffboardingColumns))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := tenantoffboarding.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.GetByExternalTenant(ctx, testExtTenant)
+
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+
+	t.Run("Error when getting", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(selectQuery).WithArgs(testExtTenant).WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := tenantoffboarding.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.GetByExternalTenant(ctx, testExtTenant)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_ListUnfinished(t *testing.T) {
+	selectQuery := regexp.QuoteMeta(`SELECT id, tenant_id, external_tenant, state, export_requested, exported_data, error, created_at, updated_at, purge_after, runtimes_to_notify FROM public.tenant_offboardings WHERE state != $1`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		offboardingModel := fixModelTenantOffboardingWithAllProperties()
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("FromEntity", fixEntityTenantOffboardingWithAllProperties()).Return(offboardingModel).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		rows := sqlmock.NewRows(tenantOffboardingColumns).AddRow(fixTenantOffboardingRow()...)
+		dbMock.ExpectQuery(selectQuery).WithArgs(string(model.TenantOffboardingStatePurged)).WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := tenantoffboarding.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := repo.ListUnfinished(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.TenantOffboarding{offboardingModel}, result)
+	})
+
+	t.Run("Error when listing", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(selectQuery).WithArgs(string(model.TenantOffboardingStatePurged)).WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := tenantoffboarding.NewRepository(nil)
+
+		// WHEN
+		_, err := repo.ListUnfinished(ctx)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_Update(t *testing.T) {
+	updateQuery := regexp.QuoteMeta(`UPDATE public.tenant_offboardings SET state = ?, export_requested = ?, exported_data = ?, error = ?, updated_at = ?, purge_after = ?, runtimes_to_notify = ? WHERE id = ?`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		offboardingModel := fixModelTenantOffboardingWithAllProperties()
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", offboardingModel).Return(fixEntityTenantOffboardingWithAllProperties()).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(updateQuery).
+			WithArgs(string(model.TenantOffboardingStateExported), true, testExportedData, testErrorMessage, testTime, testPurgeAfter, testRuntimesToNotify, testID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := tenantoffboarding.NewRepository(mockConverter)
+
+		// WHEN
+		err := repo.Update(ctx, *offboardingModel)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when updating", func(t *testing.T) {
+		// GIVEN
+		offboardingModel := fixModelTenantOffboardingWithAllProperties()
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("ToEntity", offboardingModel).Return(fixEntityTenantOffboardingWithAllProperties()).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(updateQuery).
+			WithArgs(string(model.TenantOffboardingStateExported), true, testExportedData, testErrorMessage, testTime, testPurgeAfter, testRuntimesToNotify, testID).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := tenantoffboarding.NewRepository(mockConverter)
+
+		// WHEN
+		err := repo.Update(ctx, *offboardingModel)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
@@ -0,0 +1,102 @@
+package tenantoffboarding
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+//go:generate mockery -name=TenantOffboardingService -output=automock -outpkg=automock -case=underscore
+type TenantOffboardingService interface {
+	Start(ctx context.Context, externalTenant string, exportData bool) (*model.TenantOffboarding, error)
+	Get(ctx context.Context, externalTenant string) (*model.TenantOffboarding, error)
+	RequestExport(ctx context.Context, externalTenant string) (*model.TenantOffboarding, error)
+}
+
+//go:generate mockery -name=TenantOffboardingConverter -output=automock -outpkg=automock -case=underscore
+type TenantOffboardingConverter interface {
+	ToGraphQL(in *model.TenantOffboarding) *graphql.TenantOffboarding
+}
+
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      TenantOffboardingService
+	conv     TenantOffboardingConverter
+}
+
+func NewResolver(transact persistence.Transactioner, svc TenantOffboardingService, conv TenantOffboardingConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+func (r *Resolver) TenantOffboarding(ctx context.Context, tenant string) (*graphql.TenantOffboarding, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	offboarding, err := r.svc.Get(ctx, tenant)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, tx.Commit()
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(offboarding), nil
+}
+
+func (r *Resolver) OffboardTenant(ctx context.Context, tenant string, exportData *bool) (*graphql.TenantOffboarding, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	offboarding, err := r.svc.Start(ctx, tenant, exportData != nil && *exportData)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(offboarding), nil
+}
+
+func (r *Resolver) RequestTenantDataExport(ctx context.Context, tenant string) (*graphql.TenantOffboarding, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	offboarding, err := r.svc.RequestExport(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.ToGraphQL(offboarding), nil
+}
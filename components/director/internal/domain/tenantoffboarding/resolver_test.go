@@ -0,0 +1,334 @@
+package tenantoffboarding_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_TenantOffboarding(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	modelOffboarding := fixModelTenantOffboardingWithAllProperties()
+	gqlOffboarding := fixGQLTenantOffboardingWithAllProperties()
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcFn          func() *automock.TenantOffboardingService
+		ConvFn         func() *automock.TenantOffboardingConverter
+		ExpectedOutput *graphql.TenantOffboarding
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testExtTenant).Return(modelOffboarding, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.TenantOffboardingConverter {
+				conv := &automock.TenantOffboardingConverter{}
+				conv.On("ToGraphQL", modelOffboarding).Return(gqlOffboarding).Once()
+				return conv
+			},
+			ExpectedOutput: gqlOffboarding,
+		},
+		{
+			Name: "Returns nil when tenant is not offboarded",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testExtTenant).Return(nil, apperrors.NewNotFoundError(resource.TenantOffboarding, testExtTenant)).Once()
+				return svc
+			},
+			ConvFn: func() *automock.TenantOffboardingConverter {
+				return &automock.TenantOffboardingConverter{}
+			},
+		},
+		{
+			Name: "Returns error when getting Tenant Offboarding failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testExtTenant).Return(nil, testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.TenantOffboardingConverter {
+				return &automock.TenantOffboardingConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when beginning transaction",
+			TxFn: txGen.ThatFailsOnBegin,
+			SvcFn: func() *automock.TenantOffboardingService {
+				return &automock.TenantOffboardingService{}
+			},
+			ConvFn: func() *automock.TenantOffboardingConverter {
+				return &automock.TenantOffboardingConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			SvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("Get", txtest.CtxWithDBMatcher(), testExtTenant).Return(modelOffboarding, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.TenantOffboardingConverter {
+				return &automock.TenantOffboardingConverter{}
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.SvcFn()
+			conv := testCase.ConvFn()
+
+			resolver := tenantoffboarding.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.TenantOffboarding(ctx, testExtTenant)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_OffboardTenant(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	exportData := true
+	modelOffboarding := fixModelTenantOffboarding(model.TenantOffboardingStateDeactivated)
+	gqlOffboarding := fixGQLTenantOffboardingWithAllProperties()
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcFn          func() *automock.TenantOffboardingService
+		ConvFn         func() *automock.TenantOffboardingConverter
+		ExportData     *bool
+		ExpectedOutput *graphql.TenantOffboarding
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("Start", txtest.CtxWithDBMatcher(), testExtTenant, true).Return(modelOffboarding, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.TenantOffboardingConverter {
+				conv := &automock.TenantOffboardingConverter{}
+				conv.On("ToGraphQL", modelOffboarding).Return(gqlOffboarding).Once()
+				return conv
+			},
+			ExportData:     &exportData,
+			ExpectedOutput: gqlOffboarding,
+		},
+		{
+			Name: "Success when export is not specified",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("Start", txtest.CtxWithDBMatcher(), testExtTenant, false).Return(modelOffboarding, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.TenantOffboardingConverter {
+				conv := &automock.TenantOffboardingConverter{}
+				conv.On("ToGraphQL", modelOffboarding).Return(gqlOffboarding).Once()
+				return conv
+			},
+			ExpectedOutput: gqlOffboarding,
+		},
+		{
+			Name: "Returns error when starting offboarding failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("Start", txtest.CtxWithDBMatcher(), testExtTenant, false).Return(nil, testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.TenantOffboardingConverter {
+				return &automock.TenantOffboardingConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when beginning transaction",
+			TxFn: txGen.ThatFailsOnBegin,
+			SvcFn: func() *automock.TenantOffboardingService {
+				return &automock.TenantOffboardingService{}
+			},
+			ConvFn: func() *automock.TenantOffboardingConverter {
+				return &automock.TenantOffboardingConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			SvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("Start", txtest.CtxWithDBMatcher(), testExtTenant, false).Return(modelOffboarding, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.TenantOffboardingConverter {
+				return &automock.TenantOffboardingConverter{}
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.SvcFn()
+			conv := testCase.ConvFn()
+
+			resolver := tenantoffboarding.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.OffboardTenant(ctx, testExtTenant, testCase.ExportData)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_RequestTenantDataExport(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	modelOffboarding := fixModelTenantOffboarding(model.TenantOffboardingStateCredentialsRevoked)
+	modelOffboarding.ExportRequested = true
+	gqlOffboarding := fixGQLTenantOffboardingWithAllProperties()
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcFn          func() *automock.TenantOffboardingService
+		ConvFn         func() *automock.TenantOffboardingConverter
+		ExpectedOutput *graphql.TenantOffboarding
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("RequestExport", txtest.CtxWithDBMatcher(), testExtTenant).Return(modelOffboarding, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.TenantOffboardingConverter {
+				conv := &automock.TenantOffboardingConverter{}
+				conv.On("ToGraphQL", modelOffboarding).Return(gqlOffboarding).Once()
+				return conv
+			},
+			ExpectedOutput: gqlOffboarding,
+		},
+		{
+			Name: "Returns error when requesting export failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("RequestExport", txtest.CtxWithDBMatcher(), testExtTenant).Return(nil, testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.TenantOffboardingConverter {
+				return &automock.TenantOffboardingConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when beginning transaction",
+			TxFn: txGen.ThatFailsOnBegin,
+			SvcFn: func() *automock.TenantOffboardingService {
+				return &automock.TenantOffboardingService{}
+			},
+			ConvFn: func() *automock.TenantOffboardingConverter {
+				return &automock.TenantOffboardingConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			SvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("RequestExport", txtest.CtxWithDBMatcher(), testExtTenant).Return(modelOffboarding, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.TenantOffboardingConverter {
+				return &automock.TenantOffboardingConverter{}
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.SvcFn()
+			conv := testCase.ConvFn()
+
+			resolver := tenantoffboarding.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.RequestTenantDataExport(ctx, testExtTenant)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package tenantoffboarding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+type runtimeNotification struct {
+	Tenant     string   `json:"tenant"`
+	RuntimeIDs []string `json:"runtimeIDs"`
+}
+
+// NewWebhookRuntimeNotifier returns a RuntimeNotifier which sends the Runtimes affected by offboarded tenants to the given URL
+func NewWebhookRuntimeNotifier(httpClient *http.Client, url string) *webhookRuntimeNotifier {
+	return &webhookRuntimeNotifier{httpClient: httpClient, url: url}
+}
+
+type webhookRuntimeNotifier struct {
+	httpClient *http.Client
+	url        string
+}
+
+func (n *webhookRuntimeNotifier) NotifyTenantOffboarded(ctx context.Context, offboarding *model.TenantOffboarding, runtimeIDs []string) error {
+	if len(runtimeIDs) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(runtimeNotification{Tenant: offboarding.ExternalTenant, RuntimeIDs: runtimeIDs})
+	if err != nil {
+		return errors.Wrap(err, "while marshalling notification")
+	}
+
+	req, err := http.NewRequest(http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "while creating notification request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.Wrapf(err, "while sending notification to %s", n.url)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.C(ctx).WithError(err).Warn("Failed to close notification response body")
+		}
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("notification endpoint %s responded with status code %d", n.url, resp.StatusCode)
+	}
+
+	log.C(ctx).Infof("Notified about Runtimes %v which lost their credentials because tenant %s is offboarded", runtimeIDs, offboarding.ExternalTenant)
+	return nil
+}
+
+// NewLogRuntimeNotifier returns a RuntimeNotifier which reports Runtimes affected by offboarded tenants in the logs
+func NewLogRuntimeNotifier() *logRuntimeNotifier {
+	return &logRuntimeNotifier{}
+}
+
+type logRuntimeNotifier struct{}
+
+func (n *logRuntimeNotifier) NotifyTenantOffboarded(ctx context.Context, offboarding *model.TenantOffboarding, runtimeIDs []string) error {
+	if len(runtimeIDs) == 0 {
+		return nil
+	}
+
+	log.C(ctx).Infof("Runtimes %v lost their credentials because tenant %s is offboarded", runtimeIDs, offboarding.ExternalTenant)
+	return nil
+}
@@ -0,0 +1,93 @@
+package tenantoffboarding_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookRuntimeNotifier_NotifyTenantOffboarded(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	offboarding := fixModelTenantOffboarding(model.TenantOffboardingStateCredentialsRevoked)
+	runtimeIDs := []string{testRuntimeID}
+
+	t.Run("Success", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{"tenant": testExtTenant, "runtimeIDs": []interface{}{testRuntimeID}}, body)
+
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		notifier := tenantoffboarding.NewWebhookRuntimeNotifier(server.Client(), server.URL)
+
+		// WHEN
+		err := notifier.NotifyTenantOffboarded(ctx, offboarding, runtimeIDs)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Success without calling the endpoint when there are no Runtimes", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+		}))
+		defer server.Close()
+
+		notifier := tenantoffboarding.NewWebhookRuntimeNotifier(server.Client(), server.URL)
+
+		// WHEN
+		err := notifier.NotifyTenantOffboarded(ctx, offboarding, nil)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, 0, calls)
+	})
+
+	t.Run("Returns error when the endpoint responded with error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		notifier := tenantoffboarding.NewWebhookRuntimeNotifier(server.Client(), server.URL)
+
+		// WHEN
+		err := notifier.NotifyTenantOffboarded(ctx, offboarding, runtimeIDs)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "responded with status code 503")
+	})
+
+	t.Run("Returns error when the endpoint is not reachable", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close()
+
+		notifier := tenantoffboarding.NewWebhookRuntimeNotifier(server.Client(), server.URL)
+
+		// WHEN
+		err := notifier.NotifyTenantOffboarded(ctx, offboarding, runtimeIDs)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while sending notification")
+	})
+}
@@ -0,0 +1,140 @@
+package tenantoffboarding
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=TenantOffboardingRepository -output=automock -outpkg=automock -case=underscore
+type TenantOffboardingRepository interface {
+	Create(ctx context.Context, item model.TenantOffboarding) error
+	GetByExternalTenant(ctx context.Context, externalTenant string) (*model.TenantOffboarding, error)
+	ListUnfinished(ctx context.Context) ([]*model.TenantOffboarding, error)
+	Update(ctx context.Context, item model.TenantOffboarding) error
+}
+
+//go:generate mockery -name=TenantRepository -output=automock -outpkg=automock -case=underscore
+type TenantRepository interface {
+	GetByExternalTenant(ctx context.Context, externalTenant string) (*model.BusinessTenantMapping, error)
+	Update(ctx context.Context, model *model.BusinessTenantMapping) error
+	Delete(ctx context.Context, id string) error
+}
+
+//go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	repo         TenantOffboardingRepository
+	tenantRepo   TenantRepository
+	uidService   UIDService
+	timestampGen timestamp.Generator
+}
+
+func NewService(repo TenantOffboardingRepository, tenantRepo TenantRepository, uidService UIDService) *service {
+	return &service{
+		repo:         repo,
+		tenantRepo:   tenantRepo,
+		uidService:   uidService,
+		timestampGen: timestamp.DefaultGenerator(),
+	}
+}
+
+// Start deactivates the tenant, which blocks the API access for it, and starts its offboarding.
+// The remaining steps are completed by the Processor. If the tenant is already being offboarded, the existing offboarding is returned.
+func (s *service) Start(ctx context.Context, externalTenant string, exportData bool) (*model.TenantOffboarding, error) {
+	offboarding, err := s.repo.GetByExternalTenant(ctx, externalTenant)
+	if err != nil && !apperrors.IsNotFoundError(err) {
+		return nil, errors.Wrapf(err, "while getting offboarding of tenant %s", externalTenant)
+	}
+	if err == nil && offboarding.State != model.TenantOffboardingStatePurged {
+		if exportData {
+			return s.requestExport(ctx, offboarding)
+		}
+		return offboarding, nil
+	}
+
+	tnt, err := s.tenantRepo.GetByExternalTenant(ctx, externalTenant)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting tenant %s", externalTenant)
+	}
+
+	tnt.Status = model.Inactive
+	if err := s.tenantRepo.Update(ctx, tnt); err != nil {
+		return nil, errors.Wrapf(err, "while deactivating tenant %s", externalTenant)
+	}
+
+	now := s.timestampGen()
+	offboarding = &model.TenantOffboarding{
+		ID:              s.uidService.Generate(),
+		TenantID:        tnt.ID,
+		ExternalTenant:  externalTenant,
+		State:           model.TenantOffboardingStateDeactivated,
+		ExportRequested: exportData,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if err := s.repo.Create(ctx, *offboarding); err != nil {
+		return nil, errors.Wrapf(err, "while creating offboarding of tenant %s", externalTenant)
+	}
+
+	log.C(ctx).Infof("Deactivated tenant %s and started its offboarding with id %s", externalTenant, offboarding.ID)
+	return offboarding, nil
+}
+
+// StartMany starts the offboarding of the tenants which were deleted in the external tenant provider.
+// Tenants which do not exist or are already deactivated are skipped.
+func (s *service) StartMany(ctx context.Context, tenantInputs []model.BusinessTenantMappingInput) error {
+	for _, tenantInput := range tenantInputs {
+		if _, err := s.Start(ctx, tenantInput.ExternalTenant, false); err != nil {
+			if apperrors.IsNotFoundError(err) {
+				continue
+			}
+			return errors.Wrap(err, "while offboarding tenant")
+		}
+	}
+
+	return nil
+}
+
+func (s *service) Get(ctx context.Context, externalTenant string) (*model.TenantOffboarding, error) {
+	offboarding, err := s.repo.GetByExternalTenant(ctx, externalTenant)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting offboarding of tenant %s", externalTenant)
+	}
+
+	return offboarding, nil
+}
+
+// RequestExport requests the export of the data of the offboarded tenant. The data cannot be exported after it is purged.
+func (s *service) RequestExport(ctx context.Context, externalTenant string) (*model.TenantOffboarding, error) {
+	offboarding, err := s.Get(ctx, externalTenant)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.requestExport(ctx, offboarding)
+}
+
+func (s *service) requestExport(ctx context.Context, offboarding *model.TenantOffboarding) (*model.TenantOffboarding, error) {
+	if offboarding.State == model.TenantOffboardingStatePurged {
+		return nil, apperrors.NewInvalidOperationError("data of the tenant is already purged")
+	}
+	if offboarding.ExportRequested {
+		return offboarding, nil
+	}
+
+	offboarding.ExportRequested = true
+	offboarding.UpdatedAt = s.timestampGen()
+	if err := s.repo.Update(ctx, *offboarding); err != nil {
+		return nil, errors.Wrapf(err, "while requesting export of data of tenant %s", offboarding.ExternalTenant)
+	}
+
+	return offboarding, nil
+}
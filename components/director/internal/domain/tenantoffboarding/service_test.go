@@ -0,0 +1,379 @@
+package tenantoffboarding_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenantoffboarding/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Start(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	now := testTime.Add(time.Minute)
+	notFoundErr := apperrors.NewNotFoundError(resource.TenantOffboarding, testExtTenant)
+
+	deactivatedTenant := fixModelTenant()
+	deactivatedTenant.Status = model.Inactive
+
+	newOffboarding := fixModelTenantOffboarding(model.TenantOffboardingStateDeactivated)
+	newOffboarding.CreatedAt = now
+	newOffboarding.UpdatedAt = now
+
+	newOffboardingWithExport := fixModelTenantOffboarding(model.TenantOffboardingStateDeactivated)
+	newOffboardingWithExport.ExportRequested = true
+	newOffboardingWithExport.CreatedAt = now
+	newOffboardingWithExport.UpdatedAt = now
+
+	unfinishedOffboardingWithExport := fixModelTenantOffboarding(model.TenantOffboardingStateCredentialsRevoked)
+	unfinishedOffboardingWithExport.ExportRequested = true
+	unfinishedOffboardingWithExport.UpdatedAt = now
+
+	testCases := []struct {
+		Name           string
+		ExportData     bool
+		RepoFn         func() *automock.TenantOffboardingRepository
+		TenantRepoFn   func() *automock.TenantRepository
+		UIDSvcFn       func() *automock.UIDService
+		ExpectedOutput *model.TenantOffboarding
+		ExpectedError  error
+	}{
+		{
+			Name:       "Success",
+			ExportData: true,
+			RepoFn: func() *automock.TenantOffboardingRepository {
+				repo := &automock.TenantOffboardingRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(nil, notFoundErr).Once()
+				repo.On("Create", ctx, *newOffboardingWithExport).Return(nil).Once()
+				return repo
+			},
+			TenantRepoFn: func() *automock.TenantRepository {
+				repo := &automock.TenantRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(fixModelTenant(), nil).Once()
+				repo.On("Update", ctx, deactivatedTenant).Return(nil).Once()
+				return repo
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(testID).Once()
+				return svc
+			},
+			ExpectedOutput: newOffboardingWithExport,
+		},
+		{
+			Name: "Success when previous offboarding of the tenant is finished",
+			RepoFn: func() *automock.TenantOffboardingRepository {
+				repo := &automock.TenantOffboardingRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(fixModelTenantOffboarding(model.TenantOffboardingStatePurged), nil).Once()
+				repo.On("Create", ctx, *newOffboarding).Return(nil).Once()
+				return repo
+			},
+			TenantRepoFn: func() *automock.TenantRepository {
+				repo := &automock.TenantRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(fixModelTenant(), nil).Once()
+				repo.On("Update", ctx, deactivatedTenant).Return(nil).Once()
+				return repo
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(testID).Once()
+				return svc
+			},
+			ExpectedOutput: newOffboarding,
+		},
+		{
+			Name: "Returns unfinished offboarding of the tenant",
+			RepoFn: func() *automock.TenantOffboardingRepository {
+				repo := &automock.TenantOffboardingRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(fixModelTenantOffboarding(model.TenantOffboardingStateCredentialsRevoked), nil).Once()
+				return repo
+			},
+			TenantRepoFn:   unusedTenantRepository,
+			UIDSvcFn:       unusedUIDService,
+			ExpectedOutput: fixModelTenantOffboarding(model.TenantOffboardingStateCredentialsRevoked),
+		},
+		{
+			Name:       "Requests export in unfinished offboarding of the tenant",
+			ExportData: true,
+			RepoFn: func() *automock.TenantOffboardingRepository {
+				repo := &automock.TenantOffboardingRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(fixModelTenantOffboarding(model.TenantOffboardingStateCredentialsRevoked), nil).Once()
+				repo.On("Update", ctx, *unfinishedOffboardingWithExport).Return(nil).Once()
+				return repo
+			},
+			TenantRepoFn:   unusedTenantRepository,
+			UIDSvcFn:       unusedUIDService,
+			ExpectedOutput: unfinishedOffboardingWithExport,
+		},
+		{
+			Name: "Returns error when getting offboarding failed",
+			RepoFn: func() *automock.TenantOffboardingRepository {
+				repo := &automock.TenantOffboardingRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(nil, testError).Once()
+				return repo
+			},
+			TenantRepoFn:  unusedTenantRepository,
+			UIDSvcFn:      unusedUIDService,
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns not found error when tenant does not exist",
+			RepoFn: func() *automock.TenantOffboardingRepository {
+				repo := &automock.TenantOffboardingRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(nil, notFoundErr).Once()
+				return repo
+			},
+			TenantRepoFn: func() *automock.TenantRepository {
+				repo := &automock.TenantRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(nil, apperrors.NewNotFoundError(resource.Tenant, testExtTenant)).Once()
+				return repo
+			},
+			UIDSvcFn:      unusedUIDService,
+			ExpectedError: apperrors.NewNotFoundError(resource.Tenant, testExtTenant),
+		},
+		{
+			Name: "Returns error when deactivating tenant failed",
+			RepoFn: func() *automock.TenantOffboardingRepository {
+				repo := &automock.TenantOffboardingRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(nil, notFoundErr).Once()
+				return repo
+			},
+			TenantRepoFn: func() *automock.TenantRepository {
+				repo := &automock.TenantRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(fixModelTenant(), nil).Once()
+				repo.On("Update", ctx, deactivatedTenant).Return(testError).Once()
+				return repo
+			},
+			UIDSvcFn:      unusedUIDService,
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when creating offboarding failed",
+			RepoFn: func() *automock.TenantOffboardingRepository {
+				repo := &automock.TenantOffboardingRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(nil, notFoundErr).Once()
+				repo.On("Create", ctx, *newOffboarding).Return(testError).Once()
+				return repo
+			},
+			TenantRepoFn: func() *automock.TenantRepository {
+				repo := &automock.TenantRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(fixModelTenant(), nil).Once()
+				repo.On("Update", ctx, deactivatedTenant).Return(nil).Once()
+				return repo
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(testID).Once()
+				return svc
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			tenantRepo := testCase.TenantRepoFn()
+			uidSvc := testCase.UIDSvcFn()
+
+			svc := tenantoffboarding.NewService(repo, tenantRepo, uidSvc)
+			svc.SetTimestampGen(func() time.Time { return now })
+
+			// WHEN
+			result, err := svc.Start(ctx, testExtTenant, testCase.ExportData)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, repo, tenantRepo, uidSvc)
+		})
+	}
+}
+
+func TestService_StartMany(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	otherExtTenant := "other-external-tenant"
+	tenantInputs := []model.BusinessTenantMappingInput{
+		{ExternalTenant: testExtTenant},
+		{ExternalTenant: otherExtTenant},
+	}
+
+	t.Run("Success when some of the tenants do not exist", func(t *testing.T) {
+		repo := &automock.TenantOffboardingRepository{}
+		repo.On("GetByExternalTenant", ctx, testExtTenant).Return(fixModelTenantOffboarding(model.TenantOffboardingStateDeactivated), nil).Once()
+		repo.On("GetByExternalTenant", ctx, otherExtTenant).Return(nil, apperrors.NewNotFoundError(resource.TenantOffboarding, otherExtTenant)).Once()
+
+		tenantRepo := &automock.TenantRepository{}
+		tenantRepo.On("GetByExternalTenant", ctx, otherExtTenant).Return(nil, apperrors.NewNotFoundError(resource.Tenant, otherExtTenant)).Once()
+
+		svc := tenantoffboarding.NewService(repo, tenantRepo, nil)
+
+		// WHEN
+		err := svc.StartMany(ctx, tenantInputs)
+
+		// THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, repo, tenantRepo)
+	})
+
+	t.Run("Returns error when offboarding tenant failed", func(t *testing.T) {
+		repo := &automock.TenantOffboardingRepository{}
+		repo.On("GetByExternalTenant", ctx, testExtTenant).Return(nil, testError).Once()
+
+		svc := tenantoffboarding.NewService(repo, nil, nil)
+
+		// WHEN
+		err := svc.StartMany(ctx, tenantInputs)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+}
+
+func TestService_Get(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &automock.TenantOffboardingRepository{}
+		repo.On("GetByExternalTenant", ctx, testExtTenant).Return(fixModelTenantOffboardingWithAllProperties(), nil).Once()
+
+		svc := tenantoffboarding.NewService(repo, nil, nil)
+
+		// WHEN
+		result, err := svc.Get(ctx, testExtTenant)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixModelTenantOffboardingWithAllProperties(), result)
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+
+	t.Run("Returns error when getting offboarding failed", func(t *testing.T) {
+		repo := &automock.TenantOffboardingRepository{}
+		repo.On("GetByExternalTenant", ctx, testExtTenant).Return(nil, testError).Once()
+
+		svc := tenantoffboarding.NewService(repo, nil, nil)
+
+		// WHEN
+		_, err := svc.Get(ctx, testExtTenant)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+}
+
+func TestService_RequestExport(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	now := testTime.Add(time.Minute)
+
+	offboardingWithExport := fixModelTenantOffboarding(model.TenantOffboardingStateCredentialsRevoked)
+	offboardingWithExport.ExportRequested = true
+	offboardingWithExport.UpdatedAt = now
+
+	testCases := []struct {
+		Name           string
+		RepoFn         func() *automock.TenantOffboardingRepository
+		ExpectedOutput *model.TenantOffboarding
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			RepoFn: func() *automock.TenantOffboardingRepository {
+				repo := &automock.TenantOffboardingRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(fixModelTenantOffboarding(model.TenantOffboardingStateCredentialsRevoked), nil).Once()
+				repo.On("Update", ctx, *offboardingWithExport).Return(nil).Once()
+				return repo
+			},
+			ExpectedOutput: offboardingWithExport,
+		},
+		{
+			Name: "Success when export is already requested",
+			RepoFn: func() *automock.TenantOffboardingRepository {
+				repo := &automock.TenantOffboardingRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(fixModelTenantOffboardingWithAllProperties(), nil).Once()
+				return repo
+			},
+			ExpectedOutput: fixModelTenantOffboardingWithAllProperties(),
+		},
+		{
+			Name: "Returns error when data is already purged",
+			RepoFn: func() *automock.TenantOffboardingRepository {
+				repo := &automock.TenantOffboardingRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(fixModelTenantOffboarding(model.TenantOffboardingStatePurged), nil).Once()
+				return repo
+			},
+			ExpectedError: apperrors.NewInvalidOperationError("data of the tenant is already purged"),
+		},
+		{
+			Name: "Returns error when getting offboarding failed",
+			RepoFn: func() *automock.TenantOffboardingRepository {
+				repo := &automock.TenantOffboardingRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(nil, testError).Once()
+				return repo
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when updating offboarding failed",
+			RepoFn: func() *automock.TenantOffboardingRepository {
+				repo := &automock.TenantOffboardingRepository{}
+				repo.On("GetByExternalTenant", ctx, testExtTenant).Return(fixModelTenantOffboarding(model.TenantOffboardingStateCredentialsRevoked), nil).Once()
+				repo.On("Update", ctx, *offboardingWithExport).Return(testError).Once()
+				return repo
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+
+			svc := tenantoffboarding.NewService(repo, nil, nil)
+			svc.SetTimestampGen(func() time.Time { return now })
+
+			// WHEN
+			result, err := svc.RequestExport(ctx, testExtTenant)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, repo)
+		})
+	}
+}
+
+func unusedTenantRepository() *automock.TenantRepository {
+	return &automock.TenantRepository{}
+}
+
+func unusedUIDService() *automock.UIDService {
+	return &automock.UIDService{}
+}
@@ -0,0 +1,156 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	user "github.com/kyma-incubator/compass/components/director/internal/domain/user"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EntityConverter is an autogenerated mock type for the EntityConverter type
+type EntityConverter struct {
+	mock.Mock
+}
+
+// RoleBindingFromEntity provides a mock function with given fields: in
+func (_m *EntityConverter) RoleBindingFromEntity(in *user.RoleBindingEntity) *model.RoleBinding {
+	ret := _m.Called(in)
+
+	var r0 *model.RoleBinding
+	if rf, ok := ret.Get(0).(func(*user.RoleBindingEntity) *model.RoleBinding); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RoleBinding)
+		}
+	}
+
+	return r0
+}
+
+// RoleBindingToEntity provides a mock function with given fields: in
+func (_m *EntityConverter) RoleBindingToEntity(in *model.RoleBinding) *user.RoleBindingEntity {
+	ret := _m.Called(in)
+
+	var r0 *user.RoleBindingEntity
+	if rf, ok := ret.Get(0).(func(*model.RoleBinding) *user.RoleBindingEntity); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.RoleBindingEntity)
+		}
+	}
+
+	return r0
+}
+
+// RoleFromEntity provides a mock function with given fields: in
+func (_m *EntityConverter) RoleFromEntity(in *user.RoleEntity) (*model.Role, error) {
+	ret := _m.Called(in)
+
+	var r0 *model.Role
+	if rf, ok := ret.Get(0).(func(*user.RoleEntity) *model.Role); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Role)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*user.RoleEntity) error); ok {
+		r1 = rf(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RoleToEntity provides a mock function with given fields: in
+func (_m *EntityConverter) RoleToEntity(in *model.Role) (*user.RoleEntity, error) {
+	ret := _m.Called(in)
+
+	var r0 *user.RoleEntity
+	if rf, ok := ret.Get(0).(func(*model.Role) *user.RoleEntity); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.RoleEntity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*model.Role) error); ok {
+		r1 = rf(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserFromEntity provides a mock function with given fields: in
+func (_m *EntityConverter) UserFromEntity(in *user.UserEntity) *model.User {
+	ret := _m.Called(in)
+
+	var r0 *model.User
+	if rf, ok := ret.Get(0).(func(*user.UserEntity) *model.User); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+
+	return r0
+}
+
+// UserGroupFromEntity provides a mock function with given fields: in
+func (_m *EntityConverter) UserGroupFromEntity(in *user.UserGroupEntity) *model.UserGroup {
+	ret := _m.Called(in)
+
+	var r0 *model.UserGroup
+	if rf, ok := ret.Get(0).(func(*user.UserGroupEntity) *model.UserGroup); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserGroup)
+		}
+	}
+
+	return r0
+}
+
+// UserGroupToEntity provides a mock function with given fields: in
+func (_m *EntityConverter) UserGroupToEntity(in *model.UserGroup) *user.UserGroupEntity {
+	ret := _m.Called(in)
+
+	var r0 *user.UserGroupEntity
+	if rf, ok := ret.Get(0).(func(*model.UserGroup) *user.UserGroupEntity); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.UserGroupEntity)
+		}
+	}
+
+	return r0
+}
+
+// UserToEntity provides a mock function with given fields: in
+func (_m *EntityConverter) UserToEntity(in *model.User) *user.UserEntity {
+	ret := _m.Called(in)
+
+	var r0 *user.UserEntity
+	if rf, ok := ret.Get(0).(func(*model.User) *user.UserEntity); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*user.UserEntity)
+		}
+	}
+
+	return r0
+}
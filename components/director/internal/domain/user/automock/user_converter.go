@@ -0,0 +1,199 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+// UserConverter is an autogenerated mock type for the UserConverter type
+type UserConverter struct {
+	mock.Mock
+}
+
+// MultipleRoleBindingsToGraphQL provides a mock function with given fields: in
+func (_m *UserConverter) MultipleRoleBindingsToGraphQL(in []*model.RoleBinding) []*graphql.RoleBinding {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.RoleBinding
+	if rf, ok := ret.Get(0).(func([]*model.RoleBinding) []*graphql.RoleBinding); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.RoleBinding)
+		}
+	}
+
+	return r0
+}
+
+// MultipleRolesToGraphQL provides a mock function with given fields: in
+func (_m *UserConverter) MultipleRolesToGraphQL(in []*model.Role) []*graphql.Role {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.Role
+	if rf, ok := ret.Get(0).(func([]*model.Role) []*graphql.Role); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.Role)
+		}
+	}
+
+	return r0
+}
+
+// MultipleUserGroupsToGraphQL provides a mock function with given fields: in
+func (_m *UserConverter) MultipleUserGroupsToGraphQL(in []*model.UserGroup) []*graphql.UserGroup {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.UserGroup
+	if rf, ok := ret.Get(0).(func([]*model.UserGroup) []*graphql.UserGroup); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.UserGroup)
+		}
+	}
+
+	return r0
+}
+
+// MultipleUsersToGraphQL provides a mock function with given fields: in
+func (_m *UserConverter) MultipleUsersToGraphQL(in []*model.User) []*graphql.User {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.User
+	if rf, ok := ret.Get(0).(func([]*model.User) []*graphql.User); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.User)
+		}
+	}
+
+	return r0
+}
+
+// RoleBindingInputFromGraphQL provides a mock function with given fields: in
+func (_m *UserConverter) RoleBindingInputFromGraphQL(in graphql.RoleBindingInput) model.RoleBindingInput {
+	ret := _m.Called(in)
+
+	var r0 model.RoleBindingInput
+	if rf, ok := ret.Get(0).(func(graphql.RoleBindingInput) model.RoleBindingInput); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.RoleBindingInput)
+	}
+
+	return r0
+}
+
+// RoleBindingToGraphQL provides a mock function with given fields: in
+func (_m *UserConverter) RoleBindingToGraphQL(in *model.RoleBinding) *graphql.RoleBinding {
+	ret := _m.Called(in)
+
+	var r0 *graphql.RoleBinding
+	if rf, ok := ret.Get(0).(func(*model.RoleBinding) *graphql.RoleBinding); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.RoleBinding)
+		}
+	}
+
+	return r0
+}
+
+// RoleInputFromGraphQL provides a mock function with given fields: in
+func (_m *UserConverter) RoleInputFromGraphQL(in graphql.RoleInput) model.RoleInput {
+	ret := _m.Called(in)
+
+	var r0 model.RoleInput
+	if rf, ok := ret.Get(0).(func(graphql.RoleInput) model.RoleInput); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.RoleInput)
+	}
+
+	return r0
+}
+
+// RoleToGraphQL provides a mock function with given fields: in
+func (_m *UserConverter) RoleToGraphQL(in *model.Role) *graphql.Role {
+	ret := _m.Called(in)
+
+	var r0 *graphql.Role
+	if rf, ok := ret.Get(0).(func(*model.Role) *graphql.Role); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.Role)
+		}
+	}
+
+	return r0
+}
+
+// UserGroupInputFromGraphQL provides a mock function with given fields: in
+func (_m *UserConverter) UserGroupInputFromGraphQL(in graphql.UserGroupInput) model.UserGroupInput {
+	ret := _m.Called(in)
+
+	var r0 model.UserGroupInput
+	if rf, ok := ret.Get(0).(func(graphql.UserGroupInput) model.UserGroupInput); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.UserGroupInput)
+	}
+
+	return r0
+}
+
+// UserGroupToGraphQL provides a mock function with given fields: in
+func (_m *UserConverter) UserGroupToGraphQL(in *model.UserGroup) *graphql.UserGroup {
+	ret := _m.Called(in)
+
+	var r0 *graphql.UserGroup
+	if rf, ok := ret.Get(0).(func(*model.UserGroup) *graphql.UserGroup); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.UserGroup)
+		}
+	}
+
+	return r0
+}
+
+// UserInputFromGraphQL provides a mock function with given fields: in
+func (_m *UserConverter) UserInputFromGraphQL(in graphql.UserInput) model.UserInput {
+	ret := _m.Called(in)
+
+	var r0 model.UserInput
+	if rf, ok := ret.Get(0).(func(graphql.UserInput) model.UserInput); ok {
+		r0 = rf(in)
+	} else {
+		r0 = ret.Get(0).(model.UserInput)
+	}
+
+	return r0
+}
+
+// UserToGraphQL provides a mock function with given fields: in
+func (_m *UserConverter) UserToGraphQL(in *model.User) *graphql.User {
+	ret := _m.Called(in)
+
+	var r0 *graphql.User
+	if rf, ok := ret.Get(0).(func(*model.User) *graphql.User); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.User)
+		}
+	}
+
+	return r0
+}
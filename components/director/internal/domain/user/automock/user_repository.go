@@ -0,0 +1,463 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserRepository is an autogenerated mock type for the UserRepository type
+type UserRepository struct {
+	mock.Mock
+}
+
+// CreateRole provides a mock function with given fields: ctx, item
+func (_m *UserRepository) CreateRole(ctx context.Context, item model.Role) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Role) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateRoleBinding provides a mock function with given fields: ctx, item
+func (_m *UserRepository) CreateRoleBinding(ctx context.Context, item model.RoleBinding) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.RoleBinding) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateUser provides a mock function with given fields: ctx, item
+func (_m *UserRepository) CreateUser(ctx context.Context, item model.User) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.User) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateUserGroup provides a mock function with given fields: ctx, item
+func (_m *UserRepository) CreateUserGroup(ctx context.Context, item model.UserGroup) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserGroup) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRole provides a mock function with given fields: ctx, id
+func (_m *UserRepository) DeleteRole(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRoleBinding provides a mock function with given fields: ctx, id
+func (_m *UserRepository) DeleteRoleBinding(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteUser provides a mock function with given fields: ctx, id
+func (_m *UserRepository) DeleteUser(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteUserGroup provides a mock function with given fields: ctx, id
+func (_m *UserRepository) DeleteUserGroup(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetRole provides a mock function with given fields: ctx, id
+func (_m *UserRepository) GetRole(ctx context.Context, id string) (*model.Role, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Role
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Role); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Role)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRoleBinding provides a mock function with given fields: ctx, id
+func (_m *UserRepository) GetRoleBinding(ctx context.Context, id string) (*model.RoleBinding, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.RoleBinding
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.RoleBinding); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RoleBinding)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRoleByName provides a mock function with given fields: ctx, name
+func (_m *UserRepository) GetRoleByName(ctx context.Context, name string) (*model.Role, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 *model.Role
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Role); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Role)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUser provides a mock function with given fields: ctx, id
+func (_m *UserRepository) GetUser(ctx context.Context, id string) (*model.User, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.User
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserByUsername provides a mock function with given fields: ctx, username
+func (_m *UserRepository) GetUserByUsername(ctx context.Context, username string) (*model.User, error) {
+	ret := _m.Called(ctx, username)
+
+	var r0 *model.User
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.User); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserGroup provides a mock function with given fields: ctx, id
+func (_m *UserRepository) GetUserGroup(ctx context.Context, id string) (*model.UserGroup, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.UserGroup
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.UserGroup); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserGroupByName provides a mock function with given fields: ctx, name
+func (_m *UserRepository) GetUserGroupByName(ctx context.Context, name string) (*model.UserGroup, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 *model.UserGroup
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.UserGroup); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoleBindings provides a mock function with given fields: ctx
+func (_m *UserRepository) ListRoleBindings(ctx context.Context) ([]*model.RoleBinding, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.RoleBinding
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.RoleBinding); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RoleBinding)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoleBindingsForSubjects provides a mock function with given fields: ctx, subjectType, subjectIDs
+func (_m *UserRepository) ListRoleBindingsForSubjects(ctx context.Context, subjectType model.RoleBindingSubjectType, subjectIDs []string) ([]*model.RoleBinding, error) {
+	ret := _m.Called(ctx, subjectType, subjectIDs)
+
+	var r0 []*model.RoleBinding
+	if rf, ok := ret.Get(0).(func(context.Context, model.RoleBindingSubjectType, []string) []*model.RoleBinding); ok {
+		r0 = rf(ctx, subjectType, subjectIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RoleBinding)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.RoleBindingSubjectType, []string) error); ok {
+		r1 = rf(ctx, subjectType, subjectIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoles provides a mock function with given fields: ctx
+func (_m *UserRepository) ListRoles(ctx context.Context) ([]*model.Role, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.Role
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.Role); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Role)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRolesByIDs provides a mock function with given fields: ctx, ids
+func (_m *UserRepository) ListRolesByIDs(ctx context.Context, ids []string) ([]*model.Role, error) {
+	ret := _m.Called(ctx, ids)
+
+	var r0 []*model.Role
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*model.Role); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Role)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUserGroups provides a mock function with given fields: ctx
+func (_m *UserRepository) ListUserGroups(ctx context.Context) ([]*model.UserGroup, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.UserGroup
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.UserGroup); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUserGroupsByNames provides a mock function with given fields: ctx, names
+func (_m *UserRepository) ListUserGroupsByNames(ctx context.Context, names []string) ([]*model.UserGroup, error) {
+	ret := _m.Called(ctx, names)
+
+	var r0 []*model.UserGroup
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*model.UserGroup); ok {
+		r0 = rf(ctx, names)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, names)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUsers provides a mock function with given fields: ctx
+func (_m *UserRepository) ListUsers(ctx context.Context) ([]*model.User, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.User
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.User); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateRole provides a mock function with given fields: ctx, item
+func (_m *UserRepository) UpdateRole(ctx context.Context, item model.Role) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Role) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,353 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserService is an autogenerated mock type for the UserService type
+type UserService struct {
+	mock.Mock
+}
+
+// CreateRole provides a mock function with given fields: ctx, in
+func (_m *UserService) CreateRole(ctx context.Context, in model.RoleInput) (string, error) {
+	ret := _m.Called(ctx, in)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, model.RoleInput) string); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.RoleInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRoleBinding provides a mock function with given fields: ctx, in
+func (_m *UserService) CreateRoleBinding(ctx context.Context, in model.RoleBindingInput) (string, error) {
+	ret := _m.Called(ctx, in)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, model.RoleBindingInput) string); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.RoleBindingInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateUser provides a mock function with given fields: ctx, in
+func (_m *UserService) CreateUser(ctx context.Context, in model.UserInput) (string, error) {
+	ret := _m.Called(ctx, in)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserInput) string); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.UserInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateUserGroup provides a mock function with given fields: ctx, in
+func (_m *UserService) CreateUserGroup(ctx context.Context, in model.UserGroupInput) (string, error) {
+	ret := _m.Called(ctx, in)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserGroupInput) string); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.UserGroupInput) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRole provides a mock function with given fields: ctx, id
+func (_m *UserService) DeleteRole(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRoleBinding provides a mock function with given fields: ctx, id
+func (_m *UserService) DeleteRoleBinding(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteUser provides a mock function with given fields: ctx, id
+func (_m *UserService) DeleteUser(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteUserGroup provides a mock function with given fields: ctx, id
+func (_m *UserService) DeleteUserGroup(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetRole provides a mock function with given fields: ctx, id
+func (_m *UserService) GetRole(ctx context.Context, id string) (*model.Role, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.Role
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Role); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Role)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRoleBinding provides a mock function with given fields: ctx, id
+func (_m *UserService) GetRoleBinding(ctx context.Context, id string) (*model.RoleBinding, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.RoleBinding
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.RoleBinding); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RoleBinding)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUser provides a mock function with given fields: ctx, id
+func (_m *UserService) GetUser(ctx context.Context, id string) (*model.User, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.User
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserGroup provides a mock function with given fields: ctx, id
+func (_m *UserService) GetUserGroup(ctx context.Context, id string) (*model.UserGroup, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.UserGroup
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.UserGroup); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoleBindings provides a mock function with given fields: ctx
+func (_m *UserService) ListRoleBindings(ctx context.Context) ([]*model.RoleBinding, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.RoleBinding
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.RoleBinding); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RoleBinding)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoles provides a mock function with given fields: ctx
+func (_m *UserService) ListRoles(ctx context.Context) ([]*model.Role, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.Role
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.Role); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Role)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUserGroups provides a mock function with given fields: ctx
+func (_m *UserService) ListUserGroups(ctx context.Context) ([]*model.UserGroup, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.UserGroup
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.UserGroup); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserGroup)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUsers provides a mock function with given fields: ctx
+func (_m *UserService) ListUsers(ctx context.Context) ([]*model.User, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.User
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.User); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateRole provides a mock function with given fields: ctx, id, in
+func (_m *UserService) UpdateRole(ctx context.Context, id string, in model.RoleInput) error {
+	ret := _m.Called(ctx, id, in)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.RoleInput) error); ok {
+		r0 = rf(ctx, id, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,194 @@
+package user
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+const (
+	StaticUserRolePrefix  = "static-user-"
+	StaticGroupRolePrefix = "static-group-"
+)
+
+// Bootstrap creates the users and the user groups defined in the static files, if they do not exist yet.
+// Scopes of every static entry are granted with a dedicated role, named after the entry with StaticUserRolePrefix or StaticGroupRolePrefix.
+// Scopes and role bindings of these roles are kept in sync with the static files: roles of entries removed from the files are deleted together with their role bindings,
+// and role bindings of users removed from a tenant are deleted. Other roles and role bindings created through the API are left untouched.
+func (s *service) Bootstrap(ctx context.Context, users []StaticUser, groups []StaticGroup) error {
+	staticRoleIDs := make(map[string]bool)
+	staticRoleBindingIDs := make(map[string]bool)
+
+	for _, group := range groups {
+		groupID, err := s.ensureUserGroup(ctx, group.GroupName)
+		if err != nil {
+			return errors.Wrapf(err, "while bootstrapping User Group with name %s", group.GroupName)
+		}
+
+		roleID, err := s.ensureRole(ctx, StaticGroupRolePrefix+group.GroupName, group.Scopes)
+		if err != nil {
+			return errors.Wrapf(err, "while bootstrapping Role of User Group with name %s", group.GroupName)
+		}
+
+		staticRoleIDs[roleID] = true
+
+		roleBindingID, err := s.ensureRoleBinding(ctx, roleID, model.RoleBindingSubjectTypeUserGroup, groupID, nil)
+		if err != nil {
+			return errors.Wrapf(err, "while bootstrapping Role Binding of User Group with name %s", group.GroupName)
+		}
+		staticRoleBindingIDs[roleBindingID] = true
+	}
+
+	for _, user := range users {
+		userID, err := s.ensureUser(ctx, user.Username)
+		if err != nil {
+			return errors.Wrapf(err, "while bootstrapping User with username %s", user.Username)
+		}
+
+		roleID, err := s.ensureRole(ctx, StaticUserRolePrefix+user.Username, user.Scopes)
+		if err != nil {
+			return errors.Wrapf(err, "while bootstrapping Role of User with username %s", user.Username)
+		}
+
+		staticRoleIDs[roleID] = true
+
+		for i := range user.Tenants {
+			roleBindingID, err := s.ensureRoleBinding(ctx, roleID, model.RoleBindingSubjectTypeUser, userID, &user.Tenants[i])
+			if err != nil {
+				return errors.Wrapf(err, "while bootstrapping Role Binding of User with username %s in tenant %s", user.Username, user.Tenants[i])
+			}
+			staticRoleBindingIDs[roleBindingID] = true
+		}
+	}
+
+	if err := s.deleteStaleStaticRoles(ctx, staticRoleIDs, staticRoleBindingIDs); err != nil {
+		return errors.Wrap(err, "while deleting stale static Roles")
+	}
+
+	return nil
+}
+
+// deleteStaleStaticRoles deletes static roles which are not defined in the static files anymore, and role bindings of the defined ones which are not defined in the static files anymore.
+// Role bindings of deleted roles are deleted by the database.
+func (s *service) deleteStaleStaticRoles(ctx context.Context, staticRoleIDs, staticRoleBindingIDs map[string]bool) error {
+	roles, err := s.repo.ListRoles(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, role := range roles {
+		if staticRoleIDs[role.ID] || !isStaticRole(role.Name) {
+			continue
+		}
+
+		log.C(ctx).Infof("Deleting stale Role with name %s", role.Name)
+		if err := s.repo.DeleteRole(ctx, role.ID); err != nil {
+			return errors.Wrapf(err, "while deleting Role with name %s", role.Name)
+		}
+	}
+
+	bindings, err := s.repo.ListRoleBindings(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, rb := range bindings {
+		if !staticRoleIDs[rb.RoleID] || staticRoleBindingIDs[rb.ID] {
+			continue
+		}
+
+		log.C(ctx).Infof("Deleting stale Role Binding with id %s", rb.ID)
+		if err := s.repo.DeleteRoleBinding(ctx, rb.ID); err != nil {
+			return errors.Wrapf(err, "while deleting Role Binding with id %s", rb.ID)
+		}
+	}
+
+	return nil
+}
+
+func isStaticRole(name string) bool {
+	return strings.HasPrefix(name, StaticUserRolePrefix) || strings.HasPrefix(name, StaticGroupRolePrefix)
+}
+
+func (s *service) ensureUser(ctx context.Context, username string) (string, error) {
+	user, err := s.repo.GetUserByUsername(ctx, username)
+	if err == nil {
+		return user.ID, nil
+	}
+	if !apperrors.IsNotFoundError(err) {
+		return "", err
+	}
+
+	log.C(ctx).Infof("Creating User with username %s", username)
+	return s.CreateUser(ctx, model.UserInput{Username: username})
+}
+
+func (s *service) ensureUserGroup(ctx context.Context, name string) (string, error) {
+	group, err := s.repo.GetUserGroupByName(ctx, name)
+	if err == nil {
+		return group.ID, nil
+	}
+	if !apperrors.IsNotFoundError(err) {
+		return "", err
+	}
+
+	log.C(ctx).Infof("Creating User Group with name %s", name)
+	return s.CreateUserGroup(ctx, model.UserGroupInput{Name: name})
+}
+
+func (s *service) ensureRole(ctx context.Context, name string, scopes []string) (string, error) {
+	in := model.RoleInput{Name: name, Scopes: scopes}
+
+	role, err := s.repo.GetRoleByName(ctx, name)
+	if err != nil {
+		if !apperrors.IsNotFoundError(err) {
+			return "", err
+		}
+
+		log.C(ctx).Infof("Creating Role with name %s", name)
+		return s.CreateRole(ctx, in)
+	}
+
+	if (len(role.Scopes) == 0 && len(scopes) == 0) || reflect.DeepEqual(role.Scopes, scopes) {
+		return role.ID, nil
+	}
+
+	log.C(ctx).Infof("Updating scopes of Role with name %s", name)
+	if err := s.UpdateRole(ctx, role.ID, in); err != nil {
+		return "", err
+	}
+
+	return role.ID, nil
+}
+
+func (s *service) ensureRoleBinding(ctx context.Context, roleID string, subjectType model.RoleBindingSubjectType, subjectID string, tenant *string) (string, error) {
+	bindings, err := s.repo.ListRoleBindingsForSubjects(ctx, subjectType, []string{subjectID})
+	if err != nil {
+		return "", err
+	}
+
+	for _, rb := range bindings {
+		if rb.RoleID == roleID && reflect.DeepEqual(rb.Tenant, tenant) {
+			return rb.ID, nil
+		}
+	}
+
+	id := s.uidService.Generate()
+
+	rb := model.RoleBindingInput{
+		RoleID:      roleID,
+		SubjectType: subjectType,
+		SubjectID:   subjectID,
+		Tenant:      tenant,
+	}
+	if err := s.repo.CreateRoleBinding(ctx, rb.ToRoleBinding(id)); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
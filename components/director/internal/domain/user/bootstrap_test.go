@@ -0,0 +1,235 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/user"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/user/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Bootstrap(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	staticUsers := []user.StaticUser{
+		{
+			Username: testUsername,
+			Tenants:  []string{testExternalTenant},
+			Scopes:   testScopes,
+		},
+	}
+	staticGroups := []user.StaticGroup{
+		{
+			GroupName: testGroupName,
+			Scopes:    testScopes,
+		},
+	}
+	userRoleName := user.StaticUserRolePrefix + testUsername
+	groupRoleName := user.StaticGroupRolePrefix + testGroupName
+	userNotFoundErr := apperrors.NewNotFoundError(resource.User, testUsername)
+	groupNotFoundErr := apperrors.NewNotFoundError(resource.UserGroup, testGroupName)
+	roleNotFoundErr := apperrors.NewNotFoundError(resource.Role, "")
+	staleRoleID := "eeeeeeee-eeee-eeee-eeee-eeeeeeeeeeee"
+	staleRoleBindingID := "ffffffff-ffff-ffff-ffff-ffffffffffff"
+	apiRoleID := "11111111-1111-1111-1111-111111111111"
+	apiRoleBindingID := "22222222-2222-2222-2222-222222222222"
+
+	testCases := []struct {
+		Name          string
+		Users         []user.StaticUser
+		Groups        []user.StaticGroup
+		RepoFn        func() *automock.UserRepository
+		UIDSvcFn      func() *automock.UIDService
+		ExpectedError error
+	}{
+		{
+			Name:  "Success creates missing User, Role and Role Binding",
+			Users: staticUsers,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetUserByUsername", ctx, testUsername).Return(nil, userNotFoundErr).Once()
+				repo.On("CreateUser", ctx, *fixModelUser(testUserID, testUsername)).Return(nil).Once()
+				repo.On("GetRoleByName", ctx, userRoleName).Return(nil, roleNotFoundErr).Once()
+				repo.On("CreateRole", ctx, *fixModelRole(testRoleID, userRoleName, testScopes)).Return(nil).Once()
+				repo.On("ListRoleBindingsForSubjects", ctx, model.RoleBindingSubjectTypeUser, []string{testUserID}).Return(nil, nil).Once()
+				repo.On("CreateRoleBinding", ctx, *fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, str.Ptr(testExternalTenant))).Return(nil).Once()
+				repo.On("ListRoles", ctx).Return([]*model.Role{fixModelRole(testRoleID, userRoleName, testScopes)}, nil).Once()
+				repo.On("ListRoleBindings", ctx).Return([]*model.RoleBinding{fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, str.Ptr(testExternalTenant))}, nil).Once()
+				return repo
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				uidSvc := &automock.UIDService{}
+				uidSvc.On("Generate").Return(testUserID).Once()
+				uidSvc.On("Generate").Return(testRoleID).Once()
+				uidSvc.On("Generate").Return(testRoleBindingID).Once()
+				return uidSvc
+			},
+		},
+		{
+			Name:   "Success creates missing User Group, Role and global Role Binding",
+			Groups: staticGroups,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetUserGroupByName", ctx, testGroupName).Return(nil, groupNotFoundErr).Once()
+				repo.On("CreateUserGroup", ctx, *fixModelUserGroup(testGroupID, testGroupName)).Return(nil).Once()
+				repo.On("GetRoleByName", ctx, groupRoleName).Return(nil, roleNotFoundErr).Once()
+				repo.On("CreateRole", ctx, *fixModelRole(testRoleID, groupRoleName, testScopes)).Return(nil).Once()
+				repo.On("ListRoleBindingsForSubjects", ctx, model.RoleBindingSubjectTypeUserGroup, []string{testGroupID}).Return(nil, nil).Once()
+				repo.On("CreateRoleBinding", ctx, *fixModelGroupRoleBinding(testRoleBindingID, testRoleID, testGroupID, nil)).Return(nil).Once()
+				repo.On("ListRoles", ctx).Return([]*model.Role{fixModelRole(testRoleID, groupRoleName, testScopes)}, nil).Once()
+				repo.On("ListRoleBindings", ctx).Return([]*model.RoleBinding{fixModelGroupRoleBinding(testRoleBindingID, testRoleID, testGroupID, nil)}, nil).Once()
+				return repo
+			},
+			UIDSvcFn: func() *automock.UIDService {
+				uidSvc := &automock.UIDService{}
+				uidSvc.On("Generate").Return(testGroupID).Once()
+				uidSvc.On("Generate").Return(testRoleID).Once()
+				uidSvc.On("Generate").Return(testRoleBindingID).Once()
+				return uidSvc
+			},
+		},
+		{
+			Name:  "Success updates scopes of existing Role and keeps existing Role Binding",
+			Users: staticUsers,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetUserByUsername", ctx, testUsername).Return(fixModelUser(testUserID, testUsername), nil).Once()
+				repo.On("GetRoleByName", ctx, userRoleName).Return(fixModelRole(testRoleID, userRoleName, []string{"application:read"}), nil).Once()
+				repo.On("UpdateRole", ctx, *fixModelRole(testRoleID, userRoleName, testScopes)).Return(nil).Once()
+				repo.On("ListRoleBindingsForSubjects", ctx, model.RoleBindingSubjectTypeUser, []string{testUserID}).
+					Return([]*model.RoleBinding{fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, str.Ptr(testExternalTenant))}, nil).Once()
+				repo.On("ListRoles", ctx).Return([]*model.Role{fixModelRole(testRoleID, userRoleName, testScopes)}, nil).Once()
+				repo.On("ListRoleBindings", ctx).Return([]*model.RoleBinding{fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, str.Ptr(testExternalTenant))}, nil).Once()
+				return repo
+			},
+			UIDSvcFn: fixUIDSvcThatGeneratesNothing,
+		},
+		{
+			Name:  "Success does nothing when everything exists",
+			Users: staticUsers,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetUserByUsername", ctx, testUsername).Return(fixModelUser(testUserID, testUsername), nil).Once()
+				repo.On("GetRoleByName", ctx, userRoleName).Return(fixModelRole(testRoleID, userRoleName, testScopes), nil).Once()
+				repo.On("ListRoleBindingsForSubjects", ctx, model.RoleBindingSubjectTypeUser, []string{testUserID}).
+					Return([]*model.RoleBinding{fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, str.Ptr(testExternalTenant))}, nil).Once()
+				repo.On("ListRoles", ctx).Return([]*model.Role{fixModelRole(testRoleID, userRoleName, testScopes)}, nil).Once()
+				repo.On("ListRoleBindings", ctx).Return([]*model.RoleBinding{fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, str.Ptr(testExternalTenant))}, nil).Once()
+				return repo
+			},
+			UIDSvcFn: fixUIDSvcThatGeneratesNothing,
+		},
+		{
+			Name:  "Success deletes stale static Roles and Role Bindings",
+			Users: staticUsers,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetUserByUsername", ctx, testUsername).Return(fixModelUser(testUserID, testUsername), nil).Once()
+				repo.On("GetRoleByName", ctx, userRoleName).Return(fixModelRole(testRoleID, userRoleName, testScopes), nil).Once()
+				repo.On("ListRoleBindingsForSubjects", ctx, model.RoleBindingSubjectTypeUser, []string{testUserID}).
+					Return([]*model.RoleBinding{fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, str.Ptr(testExternalTenant))}, nil).Once()
+				repo.On("ListRoles", ctx).Return([]*model.Role{
+					fixModelRole(testRoleID, userRoleName, testScopes),
+					fixModelRole(staleRoleID, groupRoleName, testScopes),
+					fixModelRole(apiRoleID, testRoleName, testScopes),
+				}, nil).Once()
+				repo.On("DeleteRole", ctx, staleRoleID).Return(nil).Once()
+				repo.On("ListRoleBindings", ctx).Return([]*model.RoleBinding{
+					fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, str.Ptr(testExternalTenant)),
+					fixModelUserRoleBinding(staleRoleBindingID, testRoleID, testUserID, str.Ptr("removed-tenant")),
+					fixModelUserRoleBinding(apiRoleBindingID, apiRoleID, testUserID, str.Ptr("removed-tenant")),
+				}, nil).Once()
+				repo.On("DeleteRoleBinding", ctx, staleRoleBindingID).Return(nil).Once()
+				return repo
+			},
+			UIDSvcFn: fixUIDSvcThatGeneratesNothing,
+		},
+		{
+			Name:  "Error when listing Roles",
+			Users: staticUsers,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetUserByUsername", ctx, testUsername).Return(fixModelUser(testUserID, testUsername), nil).Once()
+				repo.On("GetRoleByName", ctx, userRoleName).Return(fixModelRole(testRoleID, userRoleName, testScopes), nil).Once()
+				repo.On("ListRoleBindingsForSubjects", ctx, model.RoleBindingSubjectTypeUser, []string{testUserID}).
+					Return([]*model.RoleBinding{fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, str.Ptr(testExternalTenant))}, nil).Once()
+				repo.On("ListRoles", ctx).Return(nil, testError).Once()
+				return repo
+			},
+			UIDSvcFn:      fixUIDSvcThatGeneratesNothing,
+			ExpectedError: testError,
+		},
+		{
+			Name:  "Error when deleting stale Role Binding",
+			Users: staticUsers,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetUserByUsername", ctx, testUsername).Return(fixModelUser(testUserID, testUsername), nil).Once()
+				repo.On("GetRoleByName", ctx, userRoleName).Return(fixModelRole(testRoleID, userRoleName, testScopes), nil).Once()
+				repo.On("ListRoleBindingsForSubjects", ctx, model.RoleBindingSubjectTypeUser, []string{testUserID}).
+					Return([]*model.RoleBinding{fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, str.Ptr(testExternalTenant))}, nil).Once()
+				repo.On("ListRoles", ctx).Return([]*model.Role{fixModelRole(testRoleID, userRoleName, testScopes)}, nil).Once()
+				repo.On("ListRoleBindings", ctx).Return([]*model.RoleBinding{
+					fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, str.Ptr(testExternalTenant)),
+					fixModelUserRoleBinding(staleRoleBindingID, testRoleID, testUserID, str.Ptr("removed-tenant")),
+				}, nil).Once()
+				repo.On("DeleteRoleBinding", ctx, staleRoleBindingID).Return(testError).Once()
+				return repo
+			},
+			UIDSvcFn:      fixUIDSvcThatGeneratesNothing,
+			ExpectedError: testError,
+		},
+		{
+			Name:  "Error when getting User",
+			Users: staticUsers,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetUserByUsername", ctx, testUsername).Return(nil, testError).Once()
+				return repo
+			},
+			UIDSvcFn:      fixUIDSvcThatGeneratesNothing,
+			ExpectedError: testError,
+		},
+		{
+			Name:   "Error when getting Role",
+			Groups: staticGroups,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetUserGroupByName", ctx, testGroupName).Return(fixModelUserGroup(testGroupID, testGroupName), nil).Once()
+				repo.On("GetRoleByName", ctx, groupRoleName).Return(nil, testError).Once()
+				return repo
+			},
+			UIDSvcFn:      fixUIDSvcThatGeneratesNothing,
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			uidSvc := testCase.UIDSvcFn()
+
+			svc := user.NewService(repo, uidSvc)
+
+			// WHEN
+			err := svc.Bootstrap(ctx, testCase.Users, testCase.Groups)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			repo.AssertExpectations(t)
+			uidSvc.AssertExpectations(t)
+		})
+	}
+}
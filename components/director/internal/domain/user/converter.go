@@ -0,0 +1,275 @@
+package user
+
+import (
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+)
+
+type converter struct{}
+
+func NewConverter() *converter {
+	return &converter{}
+}
+
+func (c *converter) UserToGraphQL(in *model.User) *graphql.User {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.User{
+		ID:       in.ID,
+		Username: in.Username,
+	}
+}
+
+func (c *converter) MultipleUsersToGraphQL(in []*model.User) []*graphql.User {
+	users := make([]*graphql.User, 0, len(in))
+	for _, u := range in {
+		if u == nil {
+			continue
+		}
+
+		users = append(users, c.UserToGraphQL(u))
+	}
+
+	return users
+}
+
+func (c *converter) UserInputFromGraphQL(in graphql.UserInput) model.UserInput {
+	return model.UserInput{
+		Username: in.Username,
+	}
+}
+
+func (c *converter) UserGroupToGraphQL(in *model.UserGroup) *graphql.UserGroup {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.UserGroup{
+		ID:   in.ID,
+		Name: in.Name,
+	}
+}
+
+func (c *converter) MultipleUserGroupsToGraphQL(in []*model.UserGroup) []*graphql.UserGroup {
+	groups := make([]*graphql.UserGroup, 0, len(in))
+	for _, g := range in {
+		if g == nil {
+			continue
+		}
+
+		groups = append(groups, c.UserGroupToGraphQL(g))
+	}
+
+	return groups
+}
+
+func (c *converter) UserGroupInputFromGraphQL(in graphql.UserGroupInput) model.UserGroupInput {
+	return model.UserGroupInput{
+		Name: in.Name,
+	}
+}
+
+func (c *converter) RoleToGraphQL(in *model.Role) *graphql.Role {
+	if in == nil {
+		return nil
+	}
+
+	scopes := in.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	return &graphql.Role{
+		ID:     in.ID,
+		Name:   in.Name,
+		Scopes: scopes,
+	}
+}
+
+func (c *converter) MultipleRolesToGraphQL(in []*model.Role) []*graphql.Role {
+	roles := make([]*graphql.Role, 0, len(in))
+	for _, r := range in {
+		if r == nil {
+			continue
+		}
+
+		roles = append(roles, c.RoleToGraphQL(r))
+	}
+
+	return roles
+}
+
+func (c *converter) RoleInputFromGraphQL(in graphql.RoleInput) model.RoleInput {
+	return model.RoleInput{
+		Name:   in.Name,
+		Scopes: in.Scopes,
+	}
+}
+
+func (c *converter) RoleBindingToGraphQL(in *model.RoleBinding) *graphql.RoleBinding {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.RoleBinding{
+		ID:          in.ID,
+		RoleID:      in.RoleID,
+		SubjectType: graphql.RoleBindingSubjectType(in.SubjectType),
+		SubjectID:   in.SubjectID,
+		Tenant:      in.Tenant,
+	}
+}
+
+func (c *converter) MultipleRoleBindingsToGraphQL(in []*model.RoleBinding) []*graphql.RoleBinding {
+	bindings := make([]*graphql.RoleBinding, 0, len(in))
+	for _, rb := range in {
+		if rb == nil {
+			continue
+		}
+
+		bindings = append(bindings, c.RoleBindingToGraphQL(rb))
+	}
+
+	return bindings
+}
+
+func (c *converter) RoleBindingInputFromGraphQL(in graphql.RoleBindingInput) model.RoleBindingInput {
+	return model.RoleBindingInput{
+		RoleID:      in.RoleID,
+		SubjectType: model.RoleBindingSubjectType(in.SubjectType),
+		SubjectID:   in.SubjectID,
+		Tenant:      in.Tenant,
+	}
+}
+
+func (c *converter) UserToEntity(in *model.User) *UserEntity {
+	if in == nil {
+		return nil
+	}
+
+	return &UserEntity{
+		ID:       in.ID,
+		Username: in.Username,
+	}
+}
+
+func (c *converter) UserFromEntity(in *UserEntity) *model.User {
+	if in == nil {
+		return nil
+	}
+
+	return &model.User{
+		ID:       in.ID,
+		Username: in.Username,
+	}
+}
+
+func (c *converter) UserGroupToEntity(in *model.UserGroup) *UserGroupEntity {
+	if in == nil {
+		return nil
+	}
+
+	return &UserGroupEntity{
+		ID:   in.ID,
+		Name: in.Name,
+	}
+}
+
+func (c *converter) UserGroupFromEntity(in *UserGroupEntity) *model.UserGroup {
+	if in == nil {
+		return nil
+	}
+
+	return &model.UserGroup{
+		ID:   in.ID,
+		Name: in.Name,
+	}
+}
+
+func (c *converter) RoleToEntity(in *model.Role) (*RoleEntity, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	scopes := in.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	scopesMarshalled, err := json.Marshal(scopes)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshalling scopes")
+	}
+
+	return &RoleEntity{
+		ID:     in.ID,
+		Name:   in.Name,
+		Scopes: string(scopesMarshalled),
+	}, nil
+}
+
+func (c *converter) RoleFromEntity(in *RoleEntity) (*model.Role, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var scopes []string
+	if err := json.Unmarshal([]byte(in.Scopes), &scopes); err != nil {
+		return nil, errors.Wrap(err, "while unmarshalling scopes")
+	}
+
+	return &model.Role{
+		ID:     in.ID,
+		Name:   in.Name,
+		Scopes: scopes,
+	}, nil
+}
+
+func (c *converter) RoleBindingToEntity(in *model.RoleBinding) *RoleBindingEntity {
+	if in == nil {
+		return nil
+	}
+
+	entity := &RoleBindingEntity{
+		ID:     in.ID,
+		RoleID: in.RoleID,
+		Tenant: repo.NewNullableString(in.Tenant),
+	}
+
+	switch in.SubjectType {
+	case model.RoleBindingSubjectTypeUser:
+		entity.UserID = repo.NewValidNullableString(in.SubjectID)
+	case model.RoleBindingSubjectTypeUserGroup:
+		entity.GroupID = repo.NewValidNullableString(in.SubjectID)
+	}
+
+	return entity
+}
+
+func (c *converter) RoleBindingFromEntity(in *RoleBindingEntity) *model.RoleBinding {
+	if in == nil {
+		return nil
+	}
+
+	rb := &model.RoleBinding{
+		ID:     in.ID,
+		RoleID: in.RoleID,
+		Tenant: repo.StringPtrFromNullableString(in.Tenant),
+	}
+
+	if in.UserID.Valid {
+		rb.SubjectType = model.RoleBindingSubjectTypeUser
+		rb.SubjectID = in.UserID.String
+	} else {
+		rb.SubjectType = model.RoleBindingSubjectTypeUserGroup
+		rb.SubjectID = in.GroupID.String
+	}
+
+	return rb
+}
@@ -0,0 +1,198 @@
+package user_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/user"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_UserToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		conv := user.NewConverter()
+
+		// WHEN
+		result := conv.UserToGraphQL(fixModelUser(testUserID, testUsername))
+
+		// THEN
+		assert.Equal(t, fixGQLUser(testUserID, testUsername), result)
+	})
+
+	t.Run("Returns nil when input is nil", func(t *testing.T) {
+		// GIVEN
+		conv := user.NewConverter()
+
+		// WHEN
+		result := conv.UserToGraphQL(nil)
+
+		// THEN
+		assert.Nil(t, result)
+	})
+}
+
+func TestConverter_MultipleUsersToGraphQL(t *testing.T) {
+	// GIVEN
+	conv := user.NewConverter()
+	input := []*model.User{
+		fixModelUser(testUserID, testUsername),
+		nil,
+		fixModelUser("foo", "bar"),
+	}
+	expected := []*graphql.User{
+		fixGQLUser(testUserID, testUsername),
+		fixGQLUser("foo", "bar"),
+	}
+
+	// WHEN
+	result := conv.MultipleUsersToGraphQL(input)
+
+	// THEN
+	assert.Equal(t, expected, result)
+}
+
+func TestConverter_UserGroupToGraphQL(t *testing.T) {
+	// GIVEN
+	conv := user.NewConverter()
+
+	// WHEN
+	result := conv.UserGroupToGraphQL(fixModelUserGroup(testGroupID, testGroupName))
+
+	// THEN
+	assert.Equal(t, fixGQLUserGroup(testGroupID, testGroupName), result)
+}
+
+func TestConverter_RoleToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		conv := user.NewConverter()
+
+		// WHEN
+		result := conv.RoleToGraphQL(fixModelRole(testRoleID, testRoleName, testScopes))
+
+		// THEN
+		assert.Equal(t, fixGQLRole(testRoleID, testRoleName, testScopes), result)
+	})
+
+	t.Run("Returns empty scopes when role has none", func(t *testing.T) {
+		// GIVEN
+		conv := user.NewConverter()
+
+		// WHEN
+		result := conv.RoleToGraphQL(fixModelRole(testRoleID, testRoleName, nil))
+
+		// THEN
+		assert.Equal(t, fixGQLRole(testRoleID, testRoleName, []string{}), result)
+	})
+}
+
+func TestConverter_RoleBindingToGraphQL(t *testing.T) {
+	// GIVEN
+	conv := user.NewConverter()
+	tenant := str.Ptr(testExternalTenant)
+
+	// WHEN
+	result := conv.RoleBindingToGraphQL(fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, tenant))
+
+	// THEN
+	assert.Equal(t, fixGQLUserRoleBinding(testRoleBindingID, testRoleID, testUserID, tenant), result)
+}
+
+func TestConverter_RoleBindingInputFromGraphQL(t *testing.T) {
+	// GIVEN
+	conv := user.NewConverter()
+	tenant := str.Ptr(testExternalTenant)
+
+	// WHEN
+	result := conv.RoleBindingInputFromGraphQL(fixGQLUserRoleBindingInput(testRoleID, testUserID, tenant))
+
+	// THEN
+	assert.Equal(t, fixModelUserRoleBindingInput(testRoleID, testUserID, tenant), result)
+}
+
+func TestConverter_RoleEntity(t *testing.T) {
+	t.Run("Success to entity", func(t *testing.T) {
+		// GIVEN
+		conv := user.NewConverter()
+
+		// WHEN
+		result, err := conv.RoleToEntity(fixModelRole(testRoleID, testRoleName, testScopes))
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixEntityRole(testRoleID, testRoleName, testScopesJSON), result)
+	})
+
+	t.Run("Success to entity with empty scopes", func(t *testing.T) {
+		// GIVEN
+		conv := user.NewConverter()
+
+		// WHEN
+		result, err := conv.RoleToEntity(fixModelRole(testRoleID, testRoleName, nil))
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixEntityRole(testRoleID, testRoleName, "[]"), result)
+	})
+
+	t.Run("Success from entity", func(t *testing.T) {
+		// GIVEN
+		conv := user.NewConverter()
+
+		// WHEN
+		result, err := conv.RoleFromEntity(fixEntityRole(testRoleID, testRoleName, testScopesJSON))
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixModelRole(testRoleID, testRoleName, testScopes), result)
+	})
+
+	t.Run("Error from entity when scopes are not valid JSON", func(t *testing.T) {
+		// GIVEN
+		conv := user.NewConverter()
+
+		// WHEN
+		_, err := conv.RoleFromEntity(fixEntityRole(testRoleID, testRoleName, "{"))
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while unmarshalling scopes")
+	})
+}
+
+func TestConverter_RoleBindingEntity(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Model  *model.RoleBinding
+		Entity *user.RoleBindingEntity
+	}{
+		{
+			Name:   "User bound in tenant",
+			Model:  fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, str.Ptr(testExternalTenant)),
+			Entity: fixEntityUserRoleBinding(testRoleBindingID, testRoleID, testUserID, str.Ptr(testExternalTenant)),
+		},
+		{
+			Name:   "User bound in every tenant",
+			Model:  fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, nil),
+			Entity: fixEntityUserRoleBinding(testRoleBindingID, testRoleID, testUserID, nil),
+		},
+		{
+			Name:   "User Group bound in every tenant",
+			Model:  fixModelGroupRoleBinding(testRoleBindingID, testRoleID, testGroupID, nil),
+			Entity: fixEntityGroupRoleBinding(testRoleBindingID, testRoleID, testGroupID),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			conv := user.NewConverter()
+
+			assert.Equal(t, testCase.Entity, conv.RoleBindingToEntity(testCase.Model))
+			assert.Equal(t, testCase.Model, conv.RoleBindingFromEntity(testCase.Entity))
+		})
+	}
+}
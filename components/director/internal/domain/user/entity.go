@@ -0,0 +1,52 @@
+package user
+
+import "database/sql"
+
+type UserEntity struct {
+	ID       string `db:"id"`
+	Username string `db:"username"`
+}
+
+type UserCollection []UserEntity
+
+func (c UserCollection) Len() int {
+	return len(c)
+}
+
+type UserGroupEntity struct {
+	ID   string `db:"id"`
+	Name string `db:"name"`
+}
+
+type UserGroupCollection []UserGroupEntity
+
+func (c UserGroupCollection) Len() int {
+	return len(c)
+}
+
+type RoleEntity struct {
+	ID     string `db:"id"`
+	Name   string `db:"name"`
+	Scopes string `db:"scopes"`
+}
+
+type RoleCollection []RoleEntity
+
+func (c RoleCollection) Len() int {
+	return len(c)
+}
+
+// RoleBindingEntity references either a user or a user group, which is enforced by the database constraint
+type RoleBindingEntity struct {
+	ID      string         `db:"id"`
+	RoleID  string         `db:"role_id"`
+	UserID  sql.NullString `db:"user_id"`
+	GroupID sql.NullString `db:"group_id"`
+	Tenant  sql.NullString `db:"tenant"`
+}
+
+type RoleBindingCollection []RoleBindingEntity
+
+func (c RoleBindingCollection) Len() int {
+	return len(c)
+}
@@ -0,0 +1,162 @@
+package user_test
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/user"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const (
+	testUserID         = "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+	testGroupID        = "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+	testRoleID         = "cccccccc-cccc-cccc-cccc-cccccccccccc"
+	testRoleBindingID  = "dddddddd-dddd-dddd-dddd-dddddddddddd"
+	testUsername       = "admin"
+	testGroupName      = "mps-superadmin"
+	testRoleName       = "admin-role"
+	testExternalTenant = "3e64ebae-38b5-46a0-b1ed-9ccee153a0ae"
+	testScopesJSON     = `["application:read","application:write"]`
+)
+
+var (
+	testScopes = []string{"application:read", "application:write"}
+	testError  = errors.New("test error")
+)
+
+func fixModelUser(id, username string) *model.User {
+	return &model.User{
+		ID:       id,
+		Username: username,
+	}
+}
+
+func fixGQLUser(id, username string) *graphql.User {
+	return &graphql.User{
+		ID:       id,
+		Username: username,
+	}
+}
+
+func fixEntityUser(id, username string) *user.UserEntity {
+	return &user.UserEntity{
+		ID:       id,
+		Username: username,
+	}
+}
+
+func fixModelUserGroup(id, name string) *model.UserGroup {
+	return &model.UserGroup{
+		ID:   id,
+		Name: name,
+	}
+}
+
+func fixGQLUserGroup(id, name string) *graphql.UserGroup {
+	return &graphql.UserGroup{
+		ID:   id,
+		Name: name,
+	}
+}
+
+func fixEntityUserGroup(id, name string) *user.UserGroupEntity {
+	return &user.UserGroupEntity{
+		ID:   id,
+		Name: name,
+	}
+}
+
+func fixModelRole(id, name string, scopes []string) *model.Role {
+	return &model.Role{
+		ID:     id,
+		Name:   name,
+		Scopes: scopes,
+	}
+}
+
+func fixGQLRole(id, name string, scopes []string) *graphql.Role {
+	return &graphql.Role{
+		ID:     id,
+		Name:   name,
+		Scopes: scopes,
+	}
+}
+
+func fixEntityRole(id, name, scopes string) *user.RoleEntity {
+	return &user.RoleEntity{
+		ID:     id,
+		Name:   name,
+		Scopes: scopes,
+	}
+}
+
+func fixModelUserRoleBinding(id, roleID, userID string, tenant *string) *model.RoleBinding {
+	return &model.RoleBinding{
+		ID:          id,
+		RoleID:      roleID,
+		SubjectType: model.RoleBindingSubjectTypeUser,
+		SubjectID:   userID,
+		Tenant:      tenant,
+	}
+}
+
+func fixModelGroupRoleBinding(id, roleID, groupID string, tenant *string) *model.RoleBinding {
+	return &model.RoleBinding{
+		ID:          id,
+		RoleID:      roleID,
+		SubjectType: model.RoleBindingSubjectTypeUserGroup,
+		SubjectID:   groupID,
+		Tenant:      tenant,
+	}
+}
+
+func fixGQLUserRoleBinding(id, roleID, userID string, tenant *string) *graphql.RoleBinding {
+	return &graphql.RoleBinding{
+		ID:          id,
+		RoleID:      roleID,
+		SubjectType: graphql.RoleBindingSubjectTypeUser,
+		SubjectID:   userID,
+		Tenant:      tenant,
+	}
+}
+
+func fixEntityUserRoleBinding(id, roleID, userID string, tenant *string) *user.RoleBindingEntity {
+	entity := &user.RoleBindingEntity{
+		ID:     id,
+		RoleID: roleID,
+		UserID: sql.NullString{String: userID, Valid: true},
+	}
+	if tenant != nil {
+		entity.Tenant = sql.NullString{String: *tenant, Valid: true}
+	}
+
+	return entity
+}
+
+func fixEntityGroupRoleBinding(id, roleID, groupID string) *user.RoleBindingEntity {
+	return &user.RoleBindingEntity{
+		ID:      id,
+		RoleID:  roleID,
+		GroupID: sql.NullString{String: groupID, Valid: true},
+	}
+}
+
+func fixModelUserRoleBindingInput(roleID, userID string, tenant *string) model.RoleBindingInput {
+	return model.RoleBindingInput{
+		RoleID:      roleID,
+		SubjectType: model.RoleBindingSubjectTypeUser,
+		SubjectID:   userID,
+		Tenant:      tenant,
+	}
+}
+
+func fixGQLUserRoleBindingInput(roleID, userID string, tenant *string) graphql.RoleBindingInput {
+	return graphql.RoleBindingInput{
+		RoleID:      roleID,
+		SubjectType: graphql.RoleBindingSubjectTypeUser,
+		SubjectID:   userID,
+		Tenant:      tenant,
+	}
+}
@@ -0,0 +1,289 @@
+package user
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	usersTable        string = `public.users`
+	userGroupsTable   string = `public.user_groups`
+	rolesTable        string = `public.roles`
+	roleBindingsTable string = `public.role_bindings`
+)
+
+var (
+	userColumns        = []string{"id", "username"}
+	userGroupColumns   = []string{"id", "name"}
+	roleColumns        = []string{"id", "name", "scopes"}
+	roleBindingColumns = []string{"id", "role_id", "user_id", "group_id", "tenant"}
+)
+
+//go:generate mockery -name=EntityConverter -output=automock -outpkg=automock -case=underscore
+type EntityConverter interface {
+	UserToEntity(in *model.User) *UserEntity
+	UserFromEntity(in *UserEntity) *model.User
+	UserGroupToEntity(in *model.UserGroup) *UserGroupEntity
+	UserGroupFromEntity(in *UserGroupEntity) *model.UserGroup
+	RoleToEntity(in *model.Role) (*RoleEntity, error)
+	RoleFromEntity(in *RoleEntity) (*model.Role, error)
+	RoleBindingToEntity(in *model.RoleBinding) *RoleBindingEntity
+	RoleBindingFromEntity(in *RoleBindingEntity) *model.RoleBinding
+}
+
+type pgRepository struct {
+	userCreator    repo.Creator
+	userGetter     repo.SingleGetterGlobal
+	userLister     repo.ListerGlobal
+	userDeleter    repo.DeleterGlobal
+	groupCreator   repo.Creator
+	groupGetter    repo.SingleGetterGlobal
+	groupLister    repo.ListerGlobal
+	groupDeleter   repo.DeleterGlobal
+	roleCreator    repo.Creator
+	roleGetter     repo.SingleGetterGlobal
+	roleLister     repo.ListerGlobal
+	roleUpdater    repo.UpdaterGlobal
+	roleDeleter    repo.DeleterGlobal
+	bindingCreator repo.Creator
+	bindingGetter  repo.SingleGetterGlobal
+	bindingLister  repo.ListerGlobal
+	bindingDeleter repo.DeleterGlobal
+	conv           EntityConverter
+}
+
+func NewRepository(conv EntityConverter) *pgRepository {
+	return &pgRepository{
+		userCreator:    repo.NewCreator(resource.User, usersTable, userColumns),
+		userGetter:     repo.NewSingleGetterGlobal(resource.User, usersTable, userColumns),
+		userLister:     repo.NewListerGlobal(resource.User, usersTable, userColumns),
+		userDeleter:    repo.NewDeleterGlobal(resource.User, usersTable),
+		groupCreator:   repo.NewCreator(resource.UserGroup, userGroupsTable, userGroupColumns),
+		groupGetter:    repo.NewSingleGetterGlobal(resource.UserGroup, userGroupsTable, userGroupColumns),
+		groupLister:    repo.NewListerGlobal(resource.UserGroup, userGroupsTable, userGroupColumns),
+		groupDeleter:   repo.NewDeleterGlobal(resource.UserGroup, userGroupsTable),
+		roleCreator:    repo.NewCreator(resource.Role, rolesTable, roleColumns),
+		roleGetter:     repo.NewSingleGetterGlobal(resource.Role, rolesTable, roleColumns),
+		roleLister:     repo.NewListerGlobal(resource.Role, rolesTable, roleColumns),
+		roleUpdater:    repo.NewUpdaterGlobal(resource.Role, rolesTable, []string{"name", "scopes"}, []string{"id"}),
+		roleDeleter:    repo.NewDeleterGlobal(resource.Role, rolesTable),
+		bindingCreator: repo.NewCreator(resource.RoleBinding, roleBindingsTable, roleBindingColumns),
+		bindingGetter:  repo.NewSingleGetterGlobal(resource.RoleBinding, roleBindingsTable, roleBindingColumns),
+		bindingLister:  repo.NewListerGlobal(resource.RoleBinding, roleBindingsTable, roleBindingColumns),
+		bindingDeleter: repo.NewDeleterGlobal(resource.RoleBinding, roleBindingsTable),
+		conv:           conv,
+	}
+}
+
+func (r *pgRepository) CreateUser(ctx context.Context, item model.User) error {
+	return r.userCreator.Create(ctx, r.conv.UserToEntity(&item))
+}
+
+func (r *pgRepository) GetUser(ctx context.Context, id string) (*model.User, error) {
+	return r.getUser(ctx, repo.NewEqualCondition("id", id))
+}
+
+func (r *pgRepository) GetUserByUsername(ctx context.Context, username string) (*model.User, error) {
+	return r.getUser(ctx, repo.NewEqualCondition("username", username))
+}
+
+func (r *pgRepository) ListUsers(ctx context.Context) ([]*model.User, error) {
+	var entities UserCollection
+	if err := r.userLister.ListGlobal(ctx, &entities); err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.User, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, r.conv.UserFromEntity(&entity))
+	}
+	return items, nil
+}
+
+func (r *pgRepository) DeleteUser(ctx context.Context, id string) error {
+	return r.userDeleter.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
+func (r *pgRepository) CreateUserGroup(ctx context.Context, item model.UserGroup) error {
+	return r.groupCreator.Create(ctx, r.conv.UserGroupToEntity(&item))
+}
+
+func (r *pgRepository) GetUserGroup(ctx context.Context, id string) (*model.UserGroup, error) {
+	return r.getUserGroup(ctx, repo.NewEqualCondition("id", id))
+}
+
+func (r *pgRepository) GetUserGroupByName(ctx context.Context, name string) (*model.UserGroup, error) {
+	return r.getUserGroup(ctx, repo.NewEqualCondition("name", name))
+}
+
+func (r *pgRepository) ListUserGroups(ctx context.Context) ([]*model.UserGroup, error) {
+	return r.listUserGroups(ctx)
+}
+
+func (r *pgRepository) ListUserGroupsByNames(ctx context.Context, names []string) ([]*model.UserGroup, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	return r.listUserGroups(ctx, repo.NewInConditionForStringValues("name", names))
+}
+
+func (r *pgRepository) DeleteUserGroup(ctx context.Context, id string) error {
+	return r.groupDeleter.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
+func (r *pgRepository) CreateRole(ctx context.Context, item model.Role) error {
+	entity, err := r.conv.RoleToEntity(&item)
+	if err != nil {
+		return errors.Wrap(err, "while converting Role to entity")
+	}
+
+	return r.roleCreator.Create(ctx, entity)
+}
+
+func (r *pgRepository) GetRole(ctx context.Context, id string) (*model.Role, error) {
+	return r.getRole(ctx, repo.NewEqualCondition("id", id))
+}
+
+func (r *pgRepository) GetRoleByName(ctx context.Context, name string) (*model.Role, error) {
+	return r.getRole(ctx, repo.NewEqualCondition("name", name))
+}
+
+func (r *pgRepository) ListRoles(ctx context.Context) ([]*model.Role, error) {
+	return r.listRoles(ctx)
+}
+
+func (r *pgRepository) ListRolesByIDs(ctx context.Context, ids []string) ([]*model.Role, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return r.listRoles(ctx, repo.NewInConditionForStringValues("id", ids))
+}
+
+func (r *pgRepository) UpdateRole(ctx context.Context, item model.Role) error {
+	entity, err := r.conv.RoleToEntity(&item)
+	if err != nil {
+		return errors.Wrap(err, "while converting Role to entity")
+	}
+
+	return r.roleUpdater.UpdateSingleGlobal(ctx, entity)
+}
+
+func (r *pgRepository) DeleteRole(ctx context.Context, id string) error {
+	return r.roleDeleter.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
+func (r *pgRepository) CreateRoleBinding(ctx context.Context, item model.RoleBinding) error {
+	return r.bindingCreator.Create(ctx, r.conv.RoleBindingToEntity(&item))
+}
+
+func (r *pgRepository) GetRoleBinding(ctx context.Context, id string) (*model.RoleBinding, error) {
+	var entity RoleBindingEntity
+	if err := r.bindingGetter.GetGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.RoleBindingFromEntity(&entity), nil
+}
+
+func (r *pgRepository) ListRoleBindings(ctx context.Context) ([]*model.RoleBinding, error) {
+	return r.listRoleBindings(ctx)
+}
+
+// ListRoleBindingsForSubjects lists role bindings of the users or the user groups with the given IDs
+func (r *pgRepository) ListRoleBindingsForSubjects(ctx context.Context, subjectType model.RoleBindingSubjectType, subjectIDs []string) ([]*model.RoleBinding, error) {
+	if len(subjectIDs) == 0 {
+		return nil, nil
+	}
+
+	column := "user_id"
+	if subjectType == model.RoleBindingSubjectTypeUserGroup {
+		column = "group_id"
+	}
+
+	return r.listRoleBindings(ctx, repo.NewInConditionForStringValues(column, subjectIDs))
+}
+
+func (r *pgRepository) DeleteRoleBinding(ctx context.Context, id string) error {
+	return r.bindingDeleter.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
+func (r *pgRepository) getUser(ctx context.Context, condition repo.Condition) (*model.User, error) {
+	var entity UserEntity
+	if err := r.userGetter.GetGlobal(ctx, repo.Conditions{condition}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.UserFromEntity(&entity), nil
+}
+
+func (r *pgRepository) getUserGroup(ctx context.Context, condition repo.Condition) (*model.UserGroup, error) {
+	var entity UserGroupEntity
+	if err := r.groupGetter.GetGlobal(ctx, repo.Conditions{condition}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.UserGroupFromEntity(&entity), nil
+}
+
+func (r *pgRepository) listUserGroups(ctx context.Context, conditions ...repo.Condition) ([]*model.UserGroup, error) {
+	var entities UserGroupCollection
+	if err := r.groupLister.ListGlobal(ctx, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.UserGroup, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, r.conv.UserGroupFromEntity(&entity))
+	}
+	return items, nil
+}
+
+func (r *pgRepository) getRole(ctx context.Context, condition repo.Condition) (*model.Role, error) {
+	var entity RoleEntity
+	if err := r.roleGetter.GetGlobal(ctx, repo.Conditions{condition}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	role, err := r.conv.RoleFromEntity(&entity)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting Role from entity")
+	}
+
+	return role, nil
+}
+
+func (r *pgRepository) listRoles(ctx context.Context, conditions ...repo.Condition) ([]*model.Role, error) {
+	var entities RoleCollection
+	if err := r.roleLister.ListGlobal(ctx, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.Role, 0, len(entities))
+	for _, entity := range entities {
+		role, err := r.conv.RoleFromEntity(&entity)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting Role from entity")
+		}
+		items = append(items, role)
+	}
+	return items, nil
+}
+
+func (r *pgRepository) listRoleBindings(ctx context.Context, conditions ...repo.Condition) ([]*model.RoleBinding, error) {
+	var entities RoleBindingCollection
+	if err := r.bindingLister.ListGlobal(ctx, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	items := make([]*model.RoleBinding, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, r.conv.RoleBindingFromEntity(&entity))
+	}
+	return items, nil
+}
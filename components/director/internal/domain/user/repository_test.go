@@ -0,0 +1,386 @@
+package user_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/user"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/user/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateUser(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		userModel := fixModelUser(testUserID, testUsername)
+		userEntity := fixEntityUser(testUserID, testUsername)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("UserToEntity", userModel).Return(userEntity).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.users ( id, username ) VALUES ( ?, ? )`)).
+			WithArgs(testUserID, testUsername).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		userRepo := user.NewRepository(mockConverter)
+
+		// WHEN
+		err := userRepo.CreateUser(ctx, *userModel)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when creating", func(t *testing.T) {
+		// GIVEN
+		userModel := fixModelUser(testUserID, testUsername)
+		userEntity := fixEntityUser(testUserID, testUsername)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("UserToEntity", userModel).Return(userEntity).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.users ( id, username ) VALUES ( ?, ? )`)).
+			WithArgs(testUserID, testUsername).
+			WillReturnError(testError)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		userRepo := user.NewRepository(mockConverter)
+
+		// WHEN
+		err := userRepo.CreateUser(ctx, *userModel)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestRepository_GetUserByUsername(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		userModel := fixModelUser(testUserID, testUsername)
+		userEntity := fixEntityUser(testUserID, testUsername)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("UserFromEntity", userEntity).Return(userModel).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		rows := sqlmock.NewRows([]string{"id", "username"}).AddRow(testUserID, testUsername)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, username FROM public.users WHERE username = $1`)).
+			WithArgs(testUsername).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		userRepo := user.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := userRepo.GetUserByUsername(ctx, testUsername)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, userModel, result)
+	})
+
+	t.Run("Error when user does not exist", func(t *testing.T) {
+		// GIVEN
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, username FROM public.users WHERE username = $1`)).
+			WithArgs(testUsername).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "username"}))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		userRepo := user.NewRepository(mockConverter)
+
+		// WHEN
+		_, err := userRepo.GetUserByUsername(ctx, testUsername)
+
+		// THEN
+		require.Error(t, err)
+		assert.True(t, apperrors.IsNotFoundError(err))
+	})
+}
+
+func TestRepository_ListUserGroupsByNames(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		groupModel := fixModelUserGroup(testGroupID, testGroupName)
+		groupEntity := fixEntityUserGroup(testGroupID, testGroupName)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("UserGroupFromEntity", groupEntity).Return(groupModel).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		rows := sqlmock.NewRows([]string{"id", "name"}).AddRow(testGroupID, testGroupName)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name FROM public.user_groups WHERE name IN ($1, $2)`)).
+			WithArgs(testGroupName, "non-existing").
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		userRepo := user.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := userRepo.ListUserGroupsByNames(ctx, []string{testGroupName, "non-existing"})
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.UserGroup{groupModel}, result)
+	})
+
+	t.Run("Returns nil without querying when no names are given", func(t *testing.T) {
+		// GIVEN
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		userRepo := user.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := userRepo.ListUserGroupsByNames(ctx, nil)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestRepository_CreateRole(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		roleModel := fixModelRole(testRoleID, testRoleName, testScopes)
+		roleEntity := fixEntityRole(testRoleID, testRoleName, testScopesJSON)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("RoleToEntity", roleModel).Return(roleEntity, nil).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.roles ( id, name, scopes ) VALUES ( ?, ?, ? )`)).
+			WithArgs(testRoleID, testRoleName, testScopesJSON).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		userRepo := user.NewRepository(mockConverter)
+
+		// WHEN
+		err := userRepo.CreateRole(ctx, *roleModel)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when converting", func(t *testing.T) {
+		// GIVEN
+		roleModel := fixModelRole(testRoleID, testRoleName, testScopes)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("RoleToEntity", roleModel).Return(nil, testError).Once()
+
+		userRepo := user.NewRepository(mockConverter)
+
+		// WHEN
+		err := userRepo.CreateRole(context.TODO(), *roleModel)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testError.Error())
+	})
+}
+
+func TestRepository_UpdateRole(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		roleModel := fixModelRole(testRoleID, testRoleName, testScopes)
+		roleEntity := fixEntityRole(testRoleID, testRoleName, testScopesJSON)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("RoleToEntity", roleModel).Return(roleEntity, nil).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.roles SET name = ?, scopes = ? WHERE id = ?`)).
+			WithArgs(testRoleName, testScopesJSON, testRoleID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		userRepo := user.NewRepository(mockConverter)
+
+		// WHEN
+		err := userRepo.UpdateRole(ctx, *roleModel)
+
+		// THEN
+		require.NoError(t, err)
+	})
+}
+
+func TestRepository_ListRolesByIDs(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		roleModel := fixModelRole(testRoleID, testRoleName, testScopes)
+		roleEntity := fixEntityRole(testRoleID, testRoleName, testScopesJSON)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("RoleFromEntity", roleEntity).Return(roleModel, nil).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		rows := sqlmock.NewRows([]string{"id", "name", "scopes"}).AddRow(testRoleID, testRoleName, testScopesJSON)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, scopes FROM public.roles WHERE id IN ($1)`)).
+			WithArgs(testRoleID).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		userRepo := user.NewRepository(mockConverter)
+
+		// WHEN
+		result, err := userRepo.ListRolesByIDs(ctx, []string{testRoleID})
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.Role{roleModel}, result)
+	})
+
+	t.Run("Error when converting", func(t *testing.T) {
+		// GIVEN
+		roleEntity := fixEntityRole(testRoleID, testRoleName, testScopesJSON)
+
+		mockConverter := &automock.EntityConverter{}
+		defer mockConverter.AssertExpectations(t)
+		mockConverter.On("RoleFromEntity", roleEntity).Return(nil, testError).Once()
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		rows := sqlmock.NewRows([]string{"id", "name", "scopes"}).AddRow(testRoleID, testRoleName, testScopesJSON)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, scopes FROM public.roles WHERE id IN ($1)`)).
+			WithArgs(testRoleID).
+			WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		userRepo := user.NewRepository(mockConverter)
+
+		// WHEN
+		_, err := userRepo.ListRolesByIDs(ctx, []string{testRoleID})
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while converting Role from entity")
+	})
+}
+
+func TestRepository_CreateRoleBinding(t *testing.T) {
+	// GIVEN
+	tenant := str.Ptr(testExternalTenant)
+	rbModel := fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, tenant)
+	rbEntity := fixEntityUserRoleBinding(testRoleBindingID, testRoleID, testUserID, tenant)
+
+	mockConverter := &automock.EntityConverter{}
+	defer mockConverter.AssertExpectations(t)
+	mockConverter.On("RoleBindingToEntity", rbModel).Return(rbEntity).Once()
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.role_bindings ( id, role_id, user_id, group_id, tenant ) VALUES ( ?, ?, ?, ?, ? )`)).
+		WithArgs(testRoleBindingID, testRoleID, rbEntity.UserID, rbEntity.GroupID, rbEntity.Tenant).
+		WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	userRepo := user.NewRepository(mockConverter)
+
+	// WHEN
+	err := userRepo.CreateRoleBinding(ctx, *rbModel)
+
+	// THEN
+	require.NoError(t, err)
+}
+
+func TestRepository_ListRoleBindingsForSubjects(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		SubjectType   model.RoleBindingSubjectType
+		SubjectID     string
+		ExpectedQuery string
+		Entity        *user.RoleBindingEntity
+		Model         *model.RoleBinding
+	}{
+		{
+			Name:          "Success for User",
+			SubjectType:   model.RoleBindingSubjectTypeUser,
+			SubjectID:     testUserID,
+			ExpectedQuery: `SELECT id, role_id, user_id, group_id, tenant FROM public.role_bindings WHERE user_id IN ($1)`,
+			Entity:        fixEntityUserRoleBinding(testRoleBindingID, testRoleID, testUserID, nil),
+			Model:         fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, nil),
+		},
+		{
+			Name:          "Success for User Group",
+			SubjectType:   model.RoleBindingSubjectTypeUserGroup,
+			SubjectID:     testGroupID,
+			ExpectedQuery: `SELECT id, role_id, user_id, group_id, tenant FROM public.role_bindings WHERE group_id IN ($1)`,
+			Entity:        fixEntityGroupRoleBinding(testRoleBindingID, testRoleID, testGroupID),
+			Model:         fixModelGroupRoleBinding(testRoleBindingID, testRoleID, testGroupID, nil),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			mockConverter := &automock.EntityConverter{}
+			defer mockConverter.AssertExpectations(t)
+			mockConverter.On("RoleBindingFromEntity", testCase.Entity).Return(testCase.Model).Once()
+			db, dbMock := testdb.MockDatabase(t)
+			defer dbMock.AssertExpectations(t)
+			rows := sqlmock.NewRows([]string{"id", "role_id", "user_id", "group_id", "tenant"}).
+				AddRow(testCase.Entity.ID, testCase.Entity.RoleID, testCase.Entity.UserID, testCase.Entity.GroupID, testCase.Entity.Tenant)
+			dbMock.ExpectQuery(regexp.QuoteMeta(testCase.ExpectedQuery)).
+				WithArgs(testCase.SubjectID).
+				WillReturnRows(rows)
+
+			ctx := persistence.SaveToContext(context.TODO(), db)
+			userRepo := user.NewRepository(mockConverter)
+
+			// WHEN
+			result, err := userRepo.ListRoleBindingsForSubjects(ctx, testCase.SubjectType, []string{testCase.SubjectID})
+
+			// THEN
+			require.NoError(t, err)
+			assert.Equal(t, []*model.RoleBinding{testCase.Model}, result)
+		})
+	}
+}
+
+func TestRepository_DeleteRoleBinding(t *testing.T) {
+	// GIVEN
+	mockConverter := &automock.EntityConverter{}
+	defer mockConverter.AssertExpectations(t)
+	db, dbMock := testdb.MockDatabase(t)
+	defer dbMock.AssertExpectations(t)
+	dbMock.ExpectExec(regexp.QuoteMeta(`DELETE FROM public.role_bindings WHERE id = $1`)).
+		WithArgs(testRoleBindingID).
+		WillReturnResult(sqlmock.NewResult(-1, 1))
+
+	ctx := persistence.SaveToContext(context.TODO(), db)
+	userRepo := user.NewRepository(mockConverter)
+
+	// WHEN
+	err := userRepo.DeleteRoleBinding(ctx, testRoleBindingID)
+
+	// THEN
+	require.NoError(t, err)
+}
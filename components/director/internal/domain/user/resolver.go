@@ -0,0 +1,373 @@
+package user
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+//go:generate mockery -name=UserService -output=automock -outpkg=automock -case=underscore
+type UserService interface {
+	CreateUser(ctx context.Context, in model.UserInput) (string, error)
+	GetUser(ctx context.Context, id string) (*model.User, error)
+	ListUsers(ctx context.Context) ([]*model.User, error)
+	DeleteUser(ctx context.Context, id string) error
+	CreateUserGroup(ctx context.Context, in model.UserGroupInput) (string, error)
+	GetUserGroup(ctx context.Context, id string) (*model.UserGroup, error)
+	ListUserGroups(ctx context.Context) ([]*model.UserGroup, error)
+	DeleteUserGroup(ctx context.Context, id string) error
+	CreateRole(ctx context.Context, in model.RoleInput) (string, error)
+	GetRole(ctx context.Context, id string) (*model.Role, error)
+	ListRoles(ctx context.Context) ([]*model.Role, error)
+	UpdateRole(ctx context.Context, id string, in model.RoleInput) error
+	DeleteRole(ctx context.Context, id string) error
+	CreateRoleBinding(ctx context.Context, in model.RoleBindingInput) (string, error)
+	GetRoleBinding(ctx context.Context, id string) (*model.RoleBinding, error)
+	ListRoleBindings(ctx context.Context) ([]*model.RoleBinding, error)
+	DeleteRoleBinding(ctx context.Context, id string) error
+}
+
+//go:generate mockery -name=UserConverter -output=automock -outpkg=automock -case=underscore
+type UserConverter interface {
+	UserToGraphQL(in *model.User) *graphql.User
+	MultipleUsersToGraphQL(in []*model.User) []*graphql.User
+	UserInputFromGraphQL(in graphql.UserInput) model.UserInput
+	UserGroupToGraphQL(in *model.UserGroup) *graphql.UserGroup
+	MultipleUserGroupsToGraphQL(in []*model.UserGroup) []*graphql.UserGroup
+	UserGroupInputFromGraphQL(in graphql.UserGroupInput) model.UserGroupInput
+	RoleToGraphQL(in *model.Role) *graphql.Role
+	MultipleRolesToGraphQL(in []*model.Role) []*graphql.Role
+	RoleInputFromGraphQL(in graphql.RoleInput) model.RoleInput
+	RoleBindingToGraphQL(in *model.RoleBinding) *graphql.RoleBinding
+	MultipleRoleBindingsToGraphQL(in []*model.RoleBinding) []*graphql.RoleBinding
+	RoleBindingInputFromGraphQL(in graphql.RoleBindingInput) model.RoleBindingInput
+}
+
+type Resolver struct {
+	transact persistence.Transactioner
+	svc      UserService
+	conv     UserConverter
+}
+
+func NewResolver(transact persistence.Transactioner, svc UserService, conv UserConverter) *Resolver {
+	return &Resolver{
+		transact: transact,
+		svc:      svc,
+		conv:     conv,
+	}
+}
+
+func (r *Resolver) Users(ctx context.Context) ([]*graphql.User, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	users, err := r.svc.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.MultipleUsersToGraphQL(users), nil
+}
+
+func (r *Resolver) CreateUser(ctx context.Context, in graphql.UserInput) (*graphql.User, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	id, err := r.svc.CreateUser(ctx, r.conv.UserInputFromGraphQL(in))
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := r.svc.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.UserToGraphQL(user), nil
+}
+
+func (r *Resolver) DeleteUser(ctx context.Context, id string) (*graphql.User, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	user, err := r.svc.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.svc.DeleteUser(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.UserToGraphQL(user), nil
+}
+
+func (r *Resolver) UserGroups(ctx context.Context) ([]*graphql.UserGroup, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	groups, err := r.svc.ListUserGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.MultipleUserGroupsToGraphQL(groups), nil
+}
+
+func (r *Resolver) CreateUserGroup(ctx context.Context, in graphql.UserGroupInput) (*graphql.UserGroup, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	id, err := r.svc.CreateUserGroup(ctx, r.conv.UserGroupInputFromGraphQL(in))
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := r.svc.GetUserGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.UserGroupToGraphQL(group), nil
+}
+
+func (r *Resolver) DeleteUserGroup(ctx context.Context, id string) (*graphql.UserGroup, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	group, err := r.svc.GetUserGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.svc.DeleteUserGroup(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.UserGroupToGraphQL(group), nil
+}
+
+func (r *Resolver) Roles(ctx context.Context) ([]*graphql.Role, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	roles, err := r.svc.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.MultipleRolesToGraphQL(roles), nil
+}
+
+func (r *Resolver) CreateRole(ctx context.Context, in graphql.RoleInput) (*graphql.Role, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	id, err := r.svc.CreateRole(ctx, r.conv.RoleInputFromGraphQL(in))
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := r.svc.GetRole(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.RoleToGraphQL(role), nil
+}
+
+func (r *Resolver) UpdateRole(ctx context.Context, id string, in graphql.RoleInput) (*graphql.Role, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := r.svc.UpdateRole(ctx, id, r.conv.RoleInputFromGraphQL(in)); err != nil {
+		return nil, err
+	}
+
+	role, err := r.svc.GetRole(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.RoleToGraphQL(role), nil
+}
+
+func (r *Resolver) DeleteRole(ctx context.Context, id string) (*graphql.Role, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	role, err := r.svc.GetRole(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.svc.DeleteRole(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.RoleToGraphQL(role), nil
+}
+
+func (r *Resolver) RoleBindings(ctx context.Context) ([]*graphql.RoleBinding, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	bindings, err := r.svc.ListRoleBindings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.MultipleRoleBindingsToGraphQL(bindings), nil
+}
+
+func (r *Resolver) CreateRoleBinding(ctx context.Context, in graphql.RoleBindingInput) (*graphql.RoleBinding, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	id, err := r.svc.CreateRoleBinding(ctx, r.conv.RoleBindingInputFromGraphQL(in))
+	if err != nil {
+		return nil, err
+	}
+
+	rb, err := r.svc.GetRoleBinding(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.RoleBindingToGraphQL(rb), nil
+}
+
+func (r *Resolver) DeleteRoleBinding(ctx context.Context, id string) (*graphql.RoleBinding, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	rb, err := r.svc.GetRoleBinding(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.svc.DeleteRoleBinding(ctx, id); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return r.conv.RoleBindingToGraphQL(rb), nil
+}
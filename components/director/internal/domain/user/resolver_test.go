@@ -0,0 +1,471 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/user"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/user/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Users(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	modelUsers := []*model.User{fixModelUser(testUserID, testUsername)}
+	gqlUsers := []*graphql.User{fixGQLUser(testUserID, testUsername)}
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcFn          func() *automock.UserService
+		ConvFn         func() *automock.UserConverter
+		ExpectedOutput []*graphql.User
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.UserService {
+				svc := &automock.UserService{}
+				svc.On("ListUsers", txtest.CtxWithDBMatcher()).Return(modelUsers, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.UserConverter {
+				conv := &automock.UserConverter{}
+				conv.On("MultipleUsersToGraphQL", modelUsers).Return(gqlUsers).Once()
+				return conv
+			},
+			ExpectedOutput: gqlUsers,
+		},
+		{
+			Name: "Returns error when listing Users failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.UserService {
+				svc := &automock.UserService{}
+				svc.On("ListUsers", txtest.CtxWithDBMatcher()).Return(nil, testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.UserConverter {
+				return &automock.UserConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when beginning transaction",
+			TxFn: txGen.ThatFailsOnBegin,
+			SvcFn: func() *automock.UserService {
+				return &automock.UserService{}
+			},
+			ConvFn: func() *automock.UserConverter {
+				return &automock.UserConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			SvcFn: func() *automock.UserService {
+				svc := &automock.UserService{}
+				svc.On("ListUsers", txtest.CtxWithDBMatcher()).Return(modelUsers, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.UserConverter {
+				return &automock.UserConverter{}
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.SvcFn()
+			conv := testCase.ConvFn()
+
+			resolver := user.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.Users(ctx)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_CreateUser(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	gqlInput := graphql.UserInput{Username: testUsername}
+	modelInput := model.UserInput{Username: testUsername}
+	modelUser := fixModelUser(testUserID, testUsername)
+	gqlUser := fixGQLUser(testUserID, testUsername)
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcFn          func() *automock.UserService
+		ConvFn         func() *automock.UserConverter
+		ExpectedOutput *graphql.User
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.UserService {
+				svc := &automock.UserService{}
+				svc.On("CreateUser", txtest.CtxWithDBMatcher(), modelInput).Return(testUserID, nil).Once()
+				svc.On("GetUser", txtest.CtxWithDBMatcher(), testUserID).Return(modelUser, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.UserConverter {
+				conv := &automock.UserConverter{}
+				conv.On("UserInputFromGraphQL", gqlInput).Return(modelInput).Once()
+				conv.On("UserToGraphQL", modelUser).Return(gqlUser).Once()
+				return conv
+			},
+			ExpectedOutput: gqlUser,
+		},
+		{
+			Name: "Returns error when creating User failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.UserService {
+				svc := &automock.UserService{}
+				svc.On("CreateUser", txtest.CtxWithDBMatcher(), modelInput).Return("", testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.UserConverter {
+				conv := &automock.UserConverter{}
+				conv.On("UserInputFromGraphQL", gqlInput).Return(modelInput).Once()
+				return conv
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when getting User failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.UserService {
+				svc := &automock.UserService{}
+				svc.On("CreateUser", txtest.CtxWithDBMatcher(), modelInput).Return(testUserID, nil).Once()
+				svc.On("GetUser", txtest.CtxWithDBMatcher(), testUserID).Return(nil, testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.UserConverter {
+				conv := &automock.UserConverter{}
+				conv.On("UserInputFromGraphQL", gqlInput).Return(modelInput).Once()
+				return conv
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when beginning transaction",
+			TxFn: txGen.ThatFailsOnBegin,
+			SvcFn: func() *automock.UserService {
+				return &automock.UserService{}
+			},
+			ConvFn: func() *automock.UserConverter {
+				return &automock.UserConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when committing transaction",
+			TxFn: txGen.ThatFailsOnCommit,
+			SvcFn: func() *automock.UserService {
+				svc := &automock.UserService{}
+				svc.On("CreateUser", txtest.CtxWithDBMatcher(), modelInput).Return(testUserID, nil).Once()
+				svc.On("GetUser", txtest.CtxWithDBMatcher(), testUserID).Return(modelUser, nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.UserConverter {
+				conv := &automock.UserConverter{}
+				conv.On("UserInputFromGraphQL", gqlInput).Return(modelInput).Once()
+				return conv
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.SvcFn()
+			conv := testCase.ConvFn()
+
+			resolver := user.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.CreateUser(ctx, gqlInput)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_UpdateRole(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	gqlInput := graphql.RoleInput{Name: testRoleName, Scopes: testScopes}
+	modelInput := model.RoleInput{Name: testRoleName, Scopes: testScopes}
+	modelRole := fixModelRole(testRoleID, testRoleName, testScopes)
+	gqlRole := fixGQLRole(testRoleID, testRoleName, testScopes)
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcFn          func() *automock.UserService
+		ExpectedOutput *graphql.Role
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.UserService {
+				svc := &automock.UserService{}
+				svc.On("UpdateRole", txtest.CtxWithDBMatcher(), testRoleID, modelInput).Return(nil).Once()
+				svc.On("GetRole", txtest.CtxWithDBMatcher(), testRoleID).Return(modelRole, nil).Once()
+				return svc
+			},
+			ExpectedOutput: gqlRole,
+		},
+		{
+			Name: "Returns error when updating Role failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.UserService {
+				svc := &automock.UserService{}
+				svc.On("UpdateRole", txtest.CtxWithDBMatcher(), testRoleID, modelInput).Return(testError).Once()
+				return svc
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.SvcFn()
+			conv := &automock.UserConverter{}
+			conv.On("RoleInputFromGraphQL", gqlInput).Return(modelInput).Once()
+			if testCase.ExpectedOutput != nil {
+				conv.On("RoleToGraphQL", modelRole).Return(gqlRole).Once()
+			}
+
+			resolver := user.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.UpdateRole(ctx, testRoleID, gqlInput)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_CreateRoleBinding(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	tenant := str.Ptr(testExternalTenant)
+	gqlInput := fixGQLUserRoleBindingInput(testRoleID, testUserID, tenant)
+	modelInput := fixModelUserRoleBindingInput(testRoleID, testUserID, tenant)
+	modelRoleBinding := fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, tenant)
+	gqlRoleBinding := fixGQLUserRoleBinding(testRoleBindingID, testRoleID, testUserID, tenant)
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcFn          func() *automock.UserService
+		ExpectedOutput *graphql.RoleBinding
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.UserService {
+				svc := &automock.UserService{}
+				svc.On("CreateRoleBinding", txtest.CtxWithDBMatcher(), modelInput).Return(testRoleBindingID, nil).Once()
+				svc.On("GetRoleBinding", txtest.CtxWithDBMatcher(), testRoleBindingID).Return(modelRoleBinding, nil).Once()
+				return svc
+			},
+			ExpectedOutput: gqlRoleBinding,
+		},
+		{
+			Name: "Returns error when creating Role Binding failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.UserService {
+				svc := &automock.UserService{}
+				svc.On("CreateRoleBinding", txtest.CtxWithDBMatcher(), modelInput).Return("", testError).Once()
+				return svc
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.SvcFn()
+			conv := &automock.UserConverter{}
+			conv.On("RoleBindingInputFromGraphQL", gqlInput).Return(modelInput).Once()
+			if testCase.ExpectedOutput != nil {
+				conv.On("RoleBindingToGraphQL", modelRoleBinding).Return(gqlRoleBinding).Once()
+			}
+
+			resolver := user.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.CreateRoleBinding(ctx, gqlInput)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+}
+
+func TestResolver_DeleteUserGroup(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	txGen := txtest.NewTransactionContextGenerator(testError)
+
+	modelGroup := fixModelUserGroup(testGroupID, testGroupName)
+	gqlGroup := fixGQLUserGroup(testGroupID, testGroupName)
+
+	testCases := []struct {
+		Name           string
+		TxFn           func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		SvcFn          func() *automock.UserService
+		ConvFn         func() *automock.UserConverter
+		ExpectedOutput *graphql.UserGroup
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			TxFn: txGen.ThatSucceeds,
+			SvcFn: func() *automock.UserService {
+				svc := &automock.UserService{}
+				svc.On("GetUserGroup", txtest.CtxWithDBMatcher(), testGroupID).Return(modelGroup, nil).Once()
+				svc.On("DeleteUserGroup", txtest.CtxWithDBMatcher(), testGroupID).Return(nil).Once()
+				return svc
+			},
+			ConvFn: func() *automock.UserConverter {
+				conv := &automock.UserConverter{}
+				conv.On("UserGroupToGraphQL", modelGroup).Return(gqlGroup).Once()
+				return conv
+			},
+			ExpectedOutput: gqlGroup,
+		},
+		{
+			Name: "Returns error when getting User Group failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.UserService {
+				svc := &automock.UserService{}
+				svc.On("GetUserGroup", txtest.CtxWithDBMatcher(), testGroupID).Return(nil, testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.UserConverter {
+				return &automock.UserConverter{}
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name: "Returns error when deleting User Group failed",
+			TxFn: txGen.ThatDoesntExpectCommit,
+			SvcFn: func() *automock.UserService {
+				svc := &automock.UserService{}
+				svc.On("GetUserGroup", txtest.CtxWithDBMatcher(), testGroupID).Return(modelGroup, nil).Once()
+				svc.On("DeleteUserGroup", txtest.CtxWithDBMatcher(), testGroupID).Return(testError).Once()
+				return svc
+			},
+			ConvFn: func() *automock.UserConverter {
+				return &automock.UserConverter{}
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TxFn()
+			svc := testCase.SvcFn()
+			conv := testCase.ConvFn()
+
+			resolver := user.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.DeleteUserGroup(ctx, testGroupID)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			persist.AssertExpectations(t)
+			transact.AssertExpectations(t)
+			svc.AssertExpectations(t)
+			conv.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package user
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=UserRepository -output=automock -outpkg=automock -case=underscore
+type UserRepository interface {
+	CreateUser(ctx context.Context, item model.User) error
+	GetUser(ctx context.Context, id string) (*model.User, error)
+	GetUserByUsername(ctx context.Context, username string) (*model.User, error)
+	ListUsers(ctx context.Context) ([]*model.User, error)
+	DeleteUser(ctx context.Context, id string) error
+	CreateUserGroup(ctx context.Context, item model.UserGroup) error
+	GetUserGroup(ctx context.Context, id string) (*model.UserGroup, error)
+	GetUserGroupByName(ctx context.Context, name string) (*model.UserGroup, error)
+	ListUserGroups(ctx context.Context) ([]*model.UserGroup, error)
+	ListUserGroupsByNames(ctx context.Context, names []string) ([]*model.UserGroup, error)
+	DeleteUserGroup(ctx context.Context, id string) error
+	CreateRole(ctx context.Context, item model.Role) error
+	GetRole(ctx context.Context, id string) (*model.Role, error)
+	GetRoleByName(ctx context.Context, name string) (*model.Role, error)
+	ListRoles(ctx context.Context) ([]*model.Role, error)
+	ListRolesByIDs(ctx context.Context, ids []string) ([]*model.Role, error)
+	UpdateRole(ctx context.Context, item model.Role) error
+	DeleteRole(ctx context.Context, id string) error
+	CreateRoleBinding(ctx context.Context, item model.RoleBinding) error
+	GetRoleBinding(ctx context.Context, id string) (*model.RoleBinding, error)
+	ListRoleBindings(ctx context.Context) ([]*model.RoleBinding, error)
+	ListRoleBindingsForSubjects(ctx context.Context, subjectType model.RoleBindingSubjectType, subjectIDs []string) ([]*model.RoleBinding, error)
+	DeleteRoleBinding(ctx context.Context, id string) error
+}
+
+//go:generate mockery -name=UIDService -output=automock -outpkg=automock -case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	repo       UserRepository
+	uidService UIDService
+}
+
+func NewService(repo UserRepository, uidService UIDService) *service {
+	return &service{
+		repo:       repo,
+		uidService: uidService,
+	}
+}
+
+func (s *service) CreateUser(ctx context.Context, in model.UserInput) (string, error) {
+	id := s.uidService.Generate()
+	if err := s.repo.CreateUser(ctx, in.ToUser(id)); err != nil {
+		return "", errors.Wrapf(err, "while creating User with username %s", in.Username)
+	}
+
+	return id, nil
+}
+
+func (s *service) GetUser(ctx context.Context, id string) (*model.User, error) {
+	user, err := s.repo.GetUser(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting User with id %s", id)
+	}
+
+	return user, nil
+}
+
+func (s *service) ListUsers(ctx context.Context) ([]*model.User, error) {
+	users, err := s.repo.ListUsers(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Users")
+	}
+
+	return users, nil
+}
+
+func (s *service) DeleteUser(ctx context.Context, id string) error {
+	if err := s.repo.DeleteUser(ctx, id); err != nil {
+		return errors.Wrapf(err, "while deleting User with id %s", id)
+	}
+
+	return nil
+}
+
+func (s *service) CreateUserGroup(ctx context.Context, in model.UserGroupInput) (string, error) {
+	id := s.uidService.Generate()
+	if err := s.repo.CreateUserGroup(ctx, in.ToUserGroup(id)); err != nil {
+		return "", errors.Wrapf(err, "while creating User Group with name %s", in.Name)
+	}
+
+	return id, nil
+}
+
+func (s *service) GetUserGroup(ctx context.Context, id string) (*model.UserGroup, error) {
+	group, err := s.repo.GetUserGroup(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting User Group with id %s", id)
+	}
+
+	return group, nil
+}
+
+func (s *service) ListUserGroups(ctx context.Context) ([]*model.UserGroup, error) {
+	groups, err := s.repo.ListUserGroups(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing User Groups")
+	}
+
+	return groups, nil
+}
+
+func (s *service) DeleteUserGroup(ctx context.Context, id string) error {
+	if err := s.repo.DeleteUserGroup(ctx, id); err != nil {
+		return errors.Wrapf(err, "while deleting User Group with id %s", id)
+	}
+
+	return nil
+}
+
+func (s *service) CreateRole(ctx context.Context, in model.RoleInput) (string, error) {
+	id := s.uidService.Generate()
+	if err := s.repo.CreateRole(ctx, in.ToRole(id)); err != nil {
+		return "", errors.Wrapf(err, "while creating Role with name %s", in.Name)
+	}
+
+	return id, nil
+}
+
+func (s *service) GetRole(ctx context.Context, id string) (*model.Role, error) {
+	role, err := s.repo.GetRole(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Role with id %s", id)
+	}
+
+	return role, nil
+}
+
+func (s *service) ListRoles(ctx context.Context) ([]*model.Role, error) {
+	roles, err := s.repo.ListRoles(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Roles")
+	}
+
+	return roles, nil
+}
+
+func (s *service) UpdateRole(ctx context.Context, id string, in model.RoleInput) error {
+	if err := s.repo.UpdateRole(ctx, in.ToRole(id)); err != nil {
+		return errors.Wrapf(err, "while updating Role with id %s", id)
+	}
+
+	return nil
+}
+
+func (s *service) DeleteRole(ctx context.Context, id string) error {
+	if err := s.repo.DeleteRole(ctx, id); err != nil {
+		return errors.Wrapf(err, "while deleting Role with id %s", id)
+	}
+
+	return nil
+}
+
+func (s *service) CreateRoleBinding(ctx context.Context, in model.RoleBindingInput) (string, error) {
+	if _, err := s.repo.GetRole(ctx, in.RoleID); err != nil {
+		return "", errors.Wrapf(err, "while getting Role with id %s", in.RoleID)
+	}
+
+	switch in.SubjectType {
+	case model.RoleBindingSubjectTypeUser:
+		if _, err := s.repo.GetUser(ctx, in.SubjectID); err != nil {
+			return "", errors.Wrapf(err, "while getting User with id %s", in.SubjectID)
+		}
+	case model.RoleBindingSubjectTypeUserGroup:
+		if _, err := s.repo.GetUserGroup(ctx, in.SubjectID); err != nil {
+			return "", errors.Wrapf(err, "while getting User Group with id %s", in.SubjectID)
+		}
+	default:
+		return "", apperrors.NewInvalidDataError("unknown subject type %s", in.SubjectType)
+	}
+
+	id := s.uidService.Generate()
+	if err := s.repo.CreateRoleBinding(ctx, in.ToRoleBinding(id)); err != nil {
+		return "", errors.Wrap(err, "while creating Role Binding")
+	}
+
+	return id, nil
+}
+
+func (s *service) GetRoleBinding(ctx context.Context, id string) (*model.RoleBinding, error) {
+	rb, err := s.repo.GetRoleBinding(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Role Binding with id %s", id)
+	}
+
+	return rb, nil
+}
+
+func (s *service) ListRoleBindings(ctx context.Context) ([]*model.RoleBinding, error) {
+	bindings, err := s.repo.ListRoleBindings(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Role Bindings")
+	}
+
+	return bindings, nil
+}
+
+func (s *service) DeleteRoleBinding(ctx context.Context, id string) error {
+	if err := s.repo.DeleteRoleBinding(ctx, id); err != nil {
+		return errors.Wrapf(err, "while deleting Role Binding with id %s", id)
+	}
+
+	return nil
+}
+
+// GetUserScopes returns scopes of the roles bound to the user in the tenant with the given external ID.
+// Scopes of all the roles bound to the user are returned if no tenant is given.
+func (s *service) GetUserScopes(ctx context.Context, username, externalTenant string) ([]string, error) {
+	user, err := s.repo.GetUserByUsername(ctx, username)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting User with username %s", username)
+	}
+
+	bindings, err := s.repo.ListRoleBindingsForSubjects(ctx, model.RoleBindingSubjectTypeUser, []string{user.ID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing Role Bindings of User with username %s", username)
+	}
+
+	return s.getScopesForRoleBindings(ctx, bindings, externalTenant)
+}
+
+// GetUserGroupScopes returns scopes of the roles bound to any of the user groups in the tenant with the given external ID.
+// User groups which do not exist are ignored.
+func (s *service) GetUserGroupScopes(ctx context.Context, groupNames []string, externalTenant string) ([]string, error) {
+	groups, err := s.repo.ListUserGroupsByNames(ctx, groupNames)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing User Groups")
+	}
+
+	groupIDs := make([]string, 0, len(groups))
+	for _, group := range groups {
+		groupIDs = append(groupIDs, group.ID)
+	}
+
+	bindings, err := s.repo.ListRoleBindingsForSubjects(ctx, model.RoleBindingSubjectTypeUserGroup, groupIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Role Bindings of User Groups")
+	}
+
+	return s.getScopesForRoleBindings(ctx, bindings, externalTenant)
+}
+
+// getScopesForRoleBindings returns scopes of the roles bound in the given tenant, without duplicates
+func (s *service) getScopesForRoleBindings(ctx context.Context, bindings []*model.RoleBinding, externalTenant string) ([]string, error) {
+	var roleIDs []string
+	for _, rb := range bindings {
+		if rb.AppliesToTenant(externalTenant) && !contains(roleIDs, rb.RoleID) {
+			roleIDs = append(roleIDs, rb.RoleID)
+		}
+	}
+
+	if len(roleIDs) == 0 {
+		return nil, nil
+	}
+
+	roles, err := s.repo.ListRolesByIDs(ctx, roleIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Roles")
+	}
+
+	rolesByID := make(map[string]*model.Role, len(roles))
+	for _, role := range roles {
+		rolesByID[role.ID] = role
+	}
+
+	var scopes []string
+	for _, roleID := range roleIDs {
+		role, ok := rolesByID[roleID]
+		if !ok {
+			continue
+		}
+
+		for _, scope := range role.Scopes {
+			if !contains(scopes, scope) {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+
+	return scopes, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,385 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/user"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/user/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_CreateUser(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	userModel := fixModelUser(testUserID, testUsername)
+
+	testCases := []struct {
+		Name           string
+		RepoFn         func() *automock.UserRepository
+		ExpectedOutput string
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("CreateUser", ctx, *userModel).Return(nil).Once()
+				return repo
+			},
+			ExpectedOutput: testUserID,
+		},
+		{
+			Name: "Error when creating User",
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("CreateUser", ctx, *userModel).Return(testError).Once()
+				return repo
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			uidSvc := &automock.UIDService{}
+			uidSvc.On("Generate").Return(testUserID).Once()
+
+			svc := user.NewService(repo, uidSvc)
+
+			// WHEN
+			result, err := svc.CreateUser(ctx, model.UserInput{Username: testUsername})
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			repo.AssertExpectations(t)
+			uidSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_CreateRoleBinding(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	tenant := str.Ptr(testExternalTenant)
+	userInput := fixModelUserRoleBindingInput(testRoleID, testUserID, tenant)
+	groupInput := model.RoleBindingInput{
+		RoleID:      testRoleID,
+		SubjectType: model.RoleBindingSubjectTypeUserGroup,
+		SubjectID:   testGroupID,
+	}
+	notFoundErr := apperrors.NewNotFoundError("", "")
+
+	testCases := []struct {
+		Name           string
+		Input          model.RoleBindingInput
+		RepoFn         func() *automock.UserRepository
+		UIDSvcFn       func() *automock.UIDService
+		ExpectedOutput string
+		ExpectedError  error
+	}{
+		{
+			Name:  "Success for User",
+			Input: userInput,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetRole", ctx, testRoleID).Return(fixModelRole(testRoleID, testRoleName, testScopes), nil).Once()
+				repo.On("GetUser", ctx, testUserID).Return(fixModelUser(testUserID, testUsername), nil).Once()
+				repo.On("CreateRoleBinding", ctx, *fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, tenant)).Return(nil).Once()
+				return repo
+			},
+			UIDSvcFn:       fixUIDSvcThatGenerates(testRoleBindingID),
+			ExpectedOutput: testRoleBindingID,
+		},
+		{
+			Name:  "Success for User Group",
+			Input: groupInput,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetRole", ctx, testRoleID).Return(fixModelRole(testRoleID, testRoleName, testScopes), nil).Once()
+				repo.On("GetUserGroup", ctx, testGroupID).Return(fixModelUserGroup(testGroupID, testGroupName), nil).Once()
+				repo.On("CreateRoleBinding", ctx, *fixModelGroupRoleBinding(testRoleBindingID, testRoleID, testGroupID, nil)).Return(nil).Once()
+				return repo
+			},
+			UIDSvcFn:       fixUIDSvcThatGenerates(testRoleBindingID),
+			ExpectedOutput: testRoleBindingID,
+		},
+		{
+			Name:  "Error when Role does not exist",
+			Input: userInput,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetRole", ctx, testRoleID).Return(nil, notFoundErr).Once()
+				return repo
+			},
+			UIDSvcFn:      fixUIDSvcThatGeneratesNothing,
+			ExpectedError: notFoundErr,
+		},
+		{
+			Name:  "Error when User does not exist",
+			Input: userInput,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetRole", ctx, testRoleID).Return(fixModelRole(testRoleID, testRoleName, testScopes), nil).Once()
+				repo.On("GetUser", ctx, testUserID).Return(nil, notFoundErr).Once()
+				return repo
+			},
+			UIDSvcFn:      fixUIDSvcThatGeneratesNothing,
+			ExpectedError: notFoundErr,
+		},
+		{
+			Name: "Error when subject type is unknown",
+			Input: model.RoleBindingInput{
+				RoleID:      testRoleID,
+				SubjectType: "UNKNOWN",
+				SubjectID:   testUserID,
+			},
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetRole", ctx, testRoleID).Return(fixModelRole(testRoleID, testRoleName, testScopes), nil).Once()
+				return repo
+			},
+			UIDSvcFn:      fixUIDSvcThatGeneratesNothing,
+			ExpectedError: apperrors.NewInvalidDataError("unknown subject type UNKNOWN"),
+		},
+		{
+			Name:  "Error when creating Role Binding",
+			Input: userInput,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetRole", ctx, testRoleID).Return(fixModelRole(testRoleID, testRoleName, testScopes), nil).Once()
+				repo.On("GetUser", ctx, testUserID).Return(fixModelUser(testUserID, testUsername), nil).Once()
+				repo.On("CreateRoleBinding", ctx, *fixModelUserRoleBinding(testRoleBindingID, testRoleID, testUserID, tenant)).Return(testError).Once()
+				return repo
+			},
+			UIDSvcFn:      fixUIDSvcThatGenerates(testRoleBindingID),
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			uidSvc := testCase.UIDSvcFn()
+
+			svc := user.NewService(repo, uidSvc)
+
+			// WHEN
+			result, err := svc.CreateRoleBinding(ctx, testCase.Input)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			repo.AssertExpectations(t)
+			uidSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_GetUserScopes(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	otherTenant := "other-tenant"
+	otherRoleID := "other-role"
+	bindings := []*model.RoleBinding{
+		fixModelUserRoleBinding("rb1", testRoleID, testUserID, str.Ptr(testExternalTenant)),
+		fixModelUserRoleBinding("rb2", otherRoleID, testUserID, str.Ptr(otherTenant)),
+	}
+
+	testCases := []struct {
+		Name           string
+		ExternalTenant string
+		RepoFn         func() *automock.UserRepository
+		ExpectedOutput []string
+		ExpectedError  error
+	}{
+		{
+			Name:           "Success returns scopes of the roles bound in the tenant",
+			ExternalTenant: testExternalTenant,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetUserByUsername", ctx, testUsername).Return(fixModelUser(testUserID, testUsername), nil).Once()
+				repo.On("ListRoleBindingsForSubjects", ctx, model.RoleBindingSubjectTypeUser, []string{testUserID}).Return(bindings, nil).Once()
+				repo.On("ListRolesByIDs", ctx, []string{testRoleID}).Return([]*model.Role{fixModelRole(testRoleID, testRoleName, testScopes)}, nil).Once()
+				return repo
+			},
+			ExpectedOutput: testScopes,
+		},
+		{
+			Name:           "Success returns unique scopes of all roles when tenant is empty",
+			ExternalTenant: "",
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetUserByUsername", ctx, testUsername).Return(fixModelUser(testUserID, testUsername), nil).Once()
+				repo.On("ListRoleBindingsForSubjects", ctx, model.RoleBindingSubjectTypeUser, []string{testUserID}).Return(bindings, nil).Once()
+				repo.On("ListRolesByIDs", ctx, []string{testRoleID, otherRoleID}).Return([]*model.Role{
+					fixModelRole(otherRoleID, "other", []string{"application:read", "runtime:read"}),
+					fixModelRole(testRoleID, testRoleName, testScopes),
+				}, nil).Once()
+				return repo
+			},
+			ExpectedOutput: []string{"application:read", "application:write", "runtime:read"},
+		},
+		{
+			Name:           "Success returns no scopes when no roles are bound in the tenant",
+			ExternalTenant: "non-existing",
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetUserByUsername", ctx, testUsername).Return(fixModelUser(testUserID, testUsername), nil).Once()
+				repo.On("ListRoleBindingsForSubjects", ctx, model.RoleBindingSubjectTypeUser, []string{testUserID}).Return(bindings, nil).Once()
+				return repo
+			},
+			ExpectedOutput: nil,
+		},
+		{
+			Name:           "Error when getting User",
+			ExternalTenant: testExternalTenant,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetUserByUsername", ctx, testUsername).Return(nil, testError).Once()
+				return repo
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name:           "Error when listing Role Bindings",
+			ExternalTenant: testExternalTenant,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetUserByUsername", ctx, testUsername).Return(fixModelUser(testUserID, testUsername), nil).Once()
+				repo.On("ListRoleBindingsForSubjects", ctx, model.RoleBindingSubjectTypeUser, []string{testUserID}).Return(nil, testError).Once()
+				return repo
+			},
+			ExpectedError: testError,
+		},
+		{
+			Name:           "Error when listing Roles",
+			ExternalTenant: testExternalTenant,
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("GetUserByUsername", ctx, testUsername).Return(fixModelUser(testUserID, testUsername), nil).Once()
+				repo.On("ListRoleBindingsForSubjects", ctx, model.RoleBindingSubjectTypeUser, []string{testUserID}).Return(bindings, nil).Once()
+				repo.On("ListRolesByIDs", ctx, []string{testRoleID}).Return(nil, testError).Once()
+				return repo
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			svc := user.NewService(repo, nil)
+
+			// WHEN
+			result, err := svc.GetUserScopes(ctx, testUsername, testCase.ExternalTenant)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_GetUserGroupScopes(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	groupNames := []string{testGroupName, "non-existing"}
+
+	testCases := []struct {
+		Name           string
+		RepoFn         func() *automock.UserRepository
+		ExpectedOutput []string
+		ExpectedError  error
+	}{
+		{
+			Name: "Success",
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("ListUserGroupsByNames", ctx, groupNames).Return([]*model.UserGroup{fixModelUserGroup(testGroupID, testGroupName)}, nil).Once()
+				repo.On("ListRoleBindingsForSubjects", ctx, model.RoleBindingSubjectTypeUserGroup, []string{testGroupID}).
+					Return([]*model.RoleBinding{fixModelGroupRoleBinding(testRoleBindingID, testRoleID, testGroupID, nil)}, nil).Once()
+				repo.On("ListRolesByIDs", ctx, []string{testRoleID}).Return([]*model.Role{fixModelRole(testRoleID, testRoleName, testScopes)}, nil).Once()
+				return repo
+			},
+			ExpectedOutput: testScopes,
+		},
+		{
+			Name: "Success returns no scopes when none of the User Groups exist",
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("ListUserGroupsByNames", ctx, groupNames).Return([]*model.UserGroup{}, nil).Once()
+				repo.On("ListRoleBindingsForSubjects", ctx, model.RoleBindingSubjectTypeUserGroup, []string{}).Return(nil, nil).Once()
+				return repo
+			},
+			ExpectedOutput: nil,
+		},
+		{
+			Name: "Error when listing User Groups",
+			RepoFn: func() *automock.UserRepository {
+				repo := &automock.UserRepository{}
+				repo.On("ListUserGroupsByNames", ctx, groupNames).Return(nil, testError).Once()
+				return repo
+			},
+			ExpectedError: testError,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepoFn()
+			svc := user.NewService(repo, nil)
+
+			// WHEN
+			result, err := svc.GetUserGroupScopes(ctx, groupNames, testExternalTenant)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func fixUIDSvcThatGenerates(id string) func() *automock.UIDService {
+	return func() *automock.UIDService {
+		uidSvc := &automock.UIDService{}
+		uidSvc.On("Generate").Return(id).Once()
+		return uidSvc
+	}
+}
+
+func fixUIDSvcThatGeneratesNothing() *automock.UIDService {
+	return &automock.UIDService{}
+}
@@ -0,0 +1,49 @@
+package user
+
+import (
+	"io/ioutil"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+)
+
+// StaticUser is a user defined in the static users file, granted the scopes in the listed tenants
+type StaticUser struct {
+	Username string   `json:"username"`
+	Tenants  []string `json:"tenants"`
+	Scopes   []string `json:"scopes"`
+}
+
+// StaticGroup is a user group defined in the static groups file, granted the scopes in every tenant
+type StaticGroup struct {
+	GroupName string   `json:"groupname"`
+	Scopes    []string `json:"scopes"`
+}
+
+func LoadStaticUsers(srcPath string) ([]StaticUser, error) {
+	staticUsersBytes, err := ioutil.ReadFile(srcPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading static users file")
+	}
+
+	var staticUsers []StaticUser
+	if err := yaml.UnmarshalStrict(staticUsersBytes, &staticUsers, yaml.DisallowUnknownFields); err != nil {
+		return nil, errors.Wrap(err, "while unmarshalling static users YAML")
+	}
+
+	return staticUsers, nil
+}
+
+func LoadStaticGroups(srcPath string) ([]StaticGroup, error) {
+	staticGroupsBytes, err := ioutil.ReadFile(srcPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading static groups file")
+	}
+
+	var staticGroups []StaticGroup
+	if err := yaml.UnmarshalStrict(staticGroupsBytes, &staticGroups, yaml.DisallowUnknownFields); err != nil {
+		return nil, errors.Wrap(err, "while unmarshalling static groups YAML")
+	}
+
+	return staticGroups, nil
+}
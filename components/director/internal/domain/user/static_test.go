@@ -0,0 +1,155 @@
+package user_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/user"
+	"github.com/stretchr/testify/require"
+)
+
+var validUserNameFileContent string = `
+- username: "admin"
+  scopes:
+  - "application:write"
+  tenants: 
+  - "3f2d9157-a0ba-4ff3-9d50-f5d6f9730eed"
+- username: "developer"
+  scopes:
+  - "application:read"
+  tenants: 
+  - "555d9157-a1bf-4aa2-9a22-f5d6f9730aaf"
+`
+
+var unknownUserNameFieldsFileContent string = `
+- username: "admin"
+  scope:
+  - "application:write"
+  tenants: 
+  - "3f2d9157-a0ba-4ff3-9d50-f5d6f9730eed"
+`
+
+var validGroupsNameFileContent string = `
+- groupname: "admin"
+  scopes:
+  - "application:write"
+  - "application:read"
+- groupname: "developer"
+  scopes:
+  - "application:read"
+`
+
+var unknownGroupsNameFieldsFileContent string = `
+- groupname: "admin"
+  notascope:
+  - "application:write"
+`
+
+func TestLoadStaticUsers(t *testing.T) {
+	t.Run("returns users from valid file", func(t *testing.T) {
+		filePath := "static-users.tmp.json"
+		writeTempFile(t, filePath, validUserNameFileContent)
+		defer removeTempFile(t, filePath)
+
+		users, err := user.LoadStaticUsers(filePath)
+
+		require.NoError(t, err)
+		require.Equal(t, []user.StaticUser{
+			{
+				Username: "admin",
+				Scopes:   []string{"application:write"},
+				Tenants:  []string{"3f2d9157-a0ba-4ff3-9d50-f5d6f9730eed"},
+			},
+			{
+				Username: "developer",
+				Scopes:   []string{"application:read"},
+				Tenants:  []string{"555d9157-a1bf-4aa2-9a22-f5d6f9730aaf"},
+			},
+		}, users)
+	})
+
+	t.Run("fails when file does not exist", func(t *testing.T) {
+		_, err := user.LoadStaticUsers("not-existing.json")
+
+		require.EqualError(t, err, "while reading static users file: open not-existing.json: no such file or directory")
+	})
+
+	t.Run("fails when file is not valid", func(t *testing.T) {
+		filePath := "static-users.tmp.json"
+		writeTempFile(t, filePath, `some-not-valid-content`)
+		defer removeTempFile(t, filePath)
+
+		_, err := user.LoadStaticUsers(filePath)
+
+		require.EqualError(t, err, "while unmarshalling static users YAML: error unmarshaling JSON: while decoding JSON: json: cannot unmarshal string into Go value of type []user.StaticUser")
+	})
+
+	t.Run("fails when file content contains unknown fields", func(t *testing.T) {
+		filePath := "static-users.tmp.json"
+		writeTempFile(t, filePath, unknownUserNameFieldsFileContent)
+		defer removeTempFile(t, filePath)
+
+		_, err := user.LoadStaticUsers(filePath)
+
+		require.EqualError(t, err, "while unmarshalling static users YAML: error unmarshaling JSON: while decoding JSON: json: unknown field \"scope\"")
+	})
+}
+
+func TestLoadStaticGroups(t *testing.T) {
+	t.Run("returns groups from valid file", func(t *testing.T) {
+		filePath := "static-groups.tmp.json"
+		writeTempFile(t, filePath, validGroupsNameFileContent)
+		defer removeTempFile(t, filePath)
+
+		groups, err := user.LoadStaticGroups(filePath)
+
+		require.NoError(t, err)
+		require.Equal(t, []user.StaticGroup{
+			{
+				GroupName: "admin",
+				Scopes:    []string{"application:write", "application:read"},
+			},
+			{
+				GroupName: "developer",
+				Scopes:    []string{"application:read"},
+			},
+		}, groups)
+	})
+
+	t.Run("fails when file does not exist", func(t *testing.T) {
+		_, err := user.LoadStaticGroups("not-existing.json")
+
+		require.EqualError(t, err, "while reading static groups file: open not-existing.json: no such file or directory")
+	})
+
+	t.Run("fails when file is not valid", func(t *testing.T) {
+		filePath := "static-groups.tmp.json"
+		writeTempFile(t, filePath, `some-not-valid-content`)
+		defer removeTempFile(t, filePath)
+
+		_, err := user.LoadStaticGroups(filePath)
+
+		require.EqualError(t, err, "while unmarshalling static groups YAML: error unmarshaling JSON: while decoding JSON: json: cannot unmarshal string into Go value of type []user.StaticGroup")
+	})
+
+	t.Run("fails when file content contains unknown fields", func(t *testing.T) {
+		filePath := "static-groups.tmp.json"
+		writeTempFile(t, filePath, unknownGroupsNameFieldsFileContent)
+		defer removeTempFile(t, filePath)
+
+		_, err := user.LoadStaticGroups(filePath)
+
+		require.EqualError(t, err, "while unmarshalling static groups YAML: error unmarshaling JSON: while decoding JSON: json: unknown field \"notascope\"")
+	})
+}
+
+func writeTempFile(t *testing.T, filePath, content string) {
+	err := ioutil.WriteFile(filePath, []byte(content), 0644)
+	require.NoError(t, err)
+}
+
+func removeTempFile(t *testing.T, filePath string) {
+	err := os.Remove(filePath)
+	require.NoError(t, err)
+}
@@ -10,6 +10,8 @@ func ToViewer(cons consumer.Consumer) (*graphql.Viewer, error) {
 	switch cons.ConsumerType {
 	case consumer.Runtime:
 		return &graphql.Viewer{ID: cons.ConsumerID, Type: graphql.ViewerTypeRuntime}, nil
+	case consumer.RuntimeContext:
+		return &graphql.Viewer{ID: cons.ConsumerID, Type: graphql.ViewerTypeRuntimeContext}, nil
 	case consumer.Application:
 		return &graphql.Viewer{ID: cons.ConsumerID, Type: graphql.ViewerTypeApplication}, nil
 	case consumer.IntegrationSystem:
@@ -25,6 +25,11 @@ func TestToViewer(t *testing.T) {
 			Input:    consumer.Consumer{ConsumerID: id, ConsumerType: consumer.Runtime},
 			Expected: graphql.Viewer{ID: id, Type: graphql.ViewerTypeRuntime},
 		},
+		{
+			Name:     "Convert To Runtime Context",
+			Input:    consumer.Consumer{ConsumerID: id, ConsumerType: consumer.RuntimeContext},
+			Expected: graphql.Viewer{ID: id, Type: graphql.ViewerTypeRuntimeContext},
+		},
 		{
 			Name:     "Convert To Application",
 			Input:    consumer.Consumer{ConsumerID: id, ConsumerType: consumer.Application},
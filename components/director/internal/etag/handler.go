@@ -0,0 +1,116 @@
+package etag
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/ast"
+)
+
+const (
+	ETagHeader        = "ETag"
+	IfNoneMatchHeader = "If-None-Match"
+)
+
+type contextKey string
+
+const responseCtxKey contextKey = "ETagResponse"
+
+type response struct {
+	cacheable bool
+}
+
+// Handler computes the ETag of GraphQL query responses. When the ETag matches one of the values
+// sent by the client in the If-None-Match header, the response body is dropped and 304 is returned.
+func Handler() func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			resp := &response{}
+			ctx := context.WithValue(r.Context(), responseCtxKey, resp)
+
+			writer := &bufferedResponseWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(writer, r.WithContext(ctx))
+
+			if !resp.cacheable || writer.status != http.StatusOK {
+				writer.flush()
+				return
+			}
+
+			etag := computeETag(writer.body.Bytes())
+			w.Header().Set(ETagHeader, etag)
+
+			if matches(r.Header.Get(IfNoneMatchHeader), etag) {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+
+			writer.flush()
+		})
+	}
+}
+
+// RequestMiddleware marks the response as cacheable when the request contains only queries
+// and no errors occurred during the execution
+func RequestMiddleware(ctx context.Context, next func(ctx context.Context) []byte) []byte {
+	res := next(ctx)
+
+	resp, ok := ctx.Value(responseCtxKey).(*response)
+	if !ok {
+		return res
+	}
+
+	reqCtx := graphql.GetRequestContext(ctx)
+	if reqCtx == nil || reqCtx.Doc == nil || len(reqCtx.Errors) > 0 {
+		return res
+	}
+
+	for _, op := range reqCtx.Doc.Operations {
+		if op.Operation != ast.Query {
+			return res
+		}
+	}
+
+	resp.cacheable = true
+	return res
+}
+
+func computeETag(body []byte) string {
+	hash := sha256.Sum256(body)
+	return fmt.Sprintf(`"%s"`, hex.EncodeToString(hash[:]))
+}
+
+func matches(ifNoneMatch, etag string) bool {
+	for _, value := range strings.Split(ifNoneMatch, ",") {
+		value = strings.TrimPrefix(strings.TrimSpace(value), "W/")
+		if value == etag || value == "*" {
+			return true
+		}
+	}
+
+	return false
+}
+
+type bufferedResponseWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *bufferedResponseWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func (w *bufferedResponseWriter) flush() {
+	w.ResponseWriter.WriteHeader(w.status)
+	_, _ = w.ResponseWriter.Write(w.body.Bytes())
+}
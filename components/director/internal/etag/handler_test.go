@@ -0,0 +1,117 @@
+package etag_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-incubator/compass/components/director/internal/etag"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/gqlerror"
+)
+
+const testResponse = `{"data":{"applicationsForRuntime":{"totalCount":0}}}`
+
+func TestHandler(t *testing.T) {
+	queryDoc := &ast.QueryDocument{Operations: ast.OperationList{{Operation: ast.Query}}}
+	mutationDoc := &ast.QueryDocument{Operations: ast.OperationList{{Operation: ast.Mutation}}}
+
+	hash := sha256.Sum256([]byte(testResponse))
+	expectedETag := fmt.Sprintf(`"%s"`, hex.EncodeToString(hash[:]))
+
+	testCases := []struct {
+		Name             string
+		IfNoneMatch      string
+		Next             http.Handler
+		ExpectedStatus   int
+		ExpectedETag     string
+		ExpectedResponse string
+	}{
+		{
+			Name:             "Returns response with ETag for query",
+			Next:             fixNextHandler(&graphql.RequestContext{Doc: queryDoc}, http.StatusOK),
+			ExpectedStatus:   http.StatusOK,
+			ExpectedETag:     expectedETag,
+			ExpectedResponse: testResponse,
+		},
+		{
+			Name:             "Returns not modified when ETag matches",
+			IfNoneMatch:      `"other", ` + expectedETag,
+			Next:             fixNextHandler(&graphql.RequestContext{Doc: queryDoc}, http.StatusOK),
+			ExpectedStatus:   http.StatusNotModified,
+			ExpectedETag:     expectedETag,
+			ExpectedResponse: "",
+		},
+		{
+			Name:             "Returns response when ETag does not match",
+			IfNoneMatch:      `"other"`,
+			Next:             fixNextHandler(&graphql.RequestContext{Doc: queryDoc}, http.StatusOK),
+			ExpectedStatus:   http.StatusOK,
+			ExpectedETag:     expectedETag,
+			ExpectedResponse: testResponse,
+		},
+		{
+			Name:             "Returns response without ETag for mutation",
+			IfNoneMatch:      expectedETag,
+			Next:             fixNextHandler(&graphql.RequestContext{Doc: mutationDoc}, http.StatusOK),
+			ExpectedStatus:   http.StatusOK,
+			ExpectedResponse: testResponse,
+		},
+		{
+			Name:        "Returns response without ETag when errors occurred",
+			IfNoneMatch: expectedETag,
+			Next: fixNextHandler(&graphql.RequestContext{
+				Doc:    queryDoc,
+				Errors: gqlerror.List{gqlerror.Errorf("test error")},
+			}, http.StatusOK),
+			ExpectedStatus:   http.StatusOK,
+			ExpectedResponse: testResponse,
+		},
+		{
+			Name:             "Returns response without ETag when status is not OK",
+			IfNoneMatch:      expectedETag,
+			Next:             fixNextHandler(&graphql.RequestContext{Doc: queryDoc}, http.StatusUnprocessableEntity),
+			ExpectedStatus:   http.StatusUnprocessableEntity,
+			ExpectedResponse: testResponse,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+			if testCase.IfNoneMatch != "" {
+				req.Header.Set(etag.IfNoneMatchHeader, testCase.IfNoneMatch)
+			}
+			rr := httptest.NewRecorder()
+
+			// WHEN
+			etag.Handler()(testCase.Next).ServeHTTP(rr, req)
+
+			// THEN
+			assert.Equal(t, testCase.ExpectedStatus, rr.Code)
+			assert.Equal(t, testCase.ExpectedETag, rr.Header().Get(etag.ETagHeader))
+			assert.Equal(t, testCase.ExpectedResponse, rr.Body.String())
+		})
+	}
+}
+
+func fixNextHandler(reqCtx *graphql.RequestContext, status int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := graphql.WithRequestContext(r.Context(), reqCtx)
+		res := etag.RequestMiddleware(ctx, func(ctx context.Context) []byte {
+			return []byte(testResponse)
+		})
+
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+		}
+		_, _ = w.Write(res)
+	})
+}
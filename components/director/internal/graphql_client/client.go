@@ -19,7 +19,7 @@ func newAuthorizedHTTPClient(timeout time.Duration) *http.Client {
 	}
 
 	return &http.Client{
-		Transport: httputil.NewCorrelationIDTransport(transport),
+		Transport: httputil.NewCorrelationIDTransport(httputil.NewTracingTransport(transport)),
 		Timeout:   timeout,
 	}
 }
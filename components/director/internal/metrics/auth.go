@@ -0,0 +1,30 @@
+package metrics
+
+const (
+	successResult = "success"
+	failureResult = "failure"
+	hitResult     = "hit"
+	missResult    = "miss"
+)
+
+// RecordTokenValidation counts the tokens validated with the given method by the issuer
+func (c *Collector) RecordTokenValidation(issuer, method string, valid bool) {
+	c.authTokenValidationTotal.WithLabelValues(issuer, method, result(valid, successResult, failureResult)).Inc()
+}
+
+// RecordIntrospectionCache counts the lookups in the introspection cache of the issuer
+func (c *Collector) RecordIntrospectionCache(issuer string, hit bool) {
+	c.authIntrospectionCacheTotal.WithLabelValues(issuer, result(hit, hitResult, missResult)).Inc()
+}
+
+// RecordJWKSSynchronization counts the synchronizations of the keys of the issuer
+func (c *Collector) RecordJWKSSynchronization(issuer string, success bool) {
+	c.authJWKSSyncTotal.WithLabelValues(issuer, result(success, successResult, failureResult)).Inc()
+}
+
+func result(condition bool, ifTrue, ifFalse string) string {
+	if condition {
+		return ifTrue
+	}
+	return ifFalse
+}
@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollector_Auth(t *testing.T) {
+	t.Run("counts token validations per issuer", func(t *testing.T) {
+		// GIVEN
+		collector := NewCollector()
+
+		// WHEN
+		collector.RecordTokenValidation("idp", "jwt", true)
+		collector.RecordTokenValidation("idp", "jwt", false)
+		collector.RecordTokenValidation("idp", "jwt", false)
+
+		// THEN
+		assert.Equal(t, float64(1), testutil.ToFloat64(collector.authTokenValidationTotal.WithLabelValues("idp", "jwt", successResult)))
+		assert.Equal(t, float64(2), testutil.ToFloat64(collector.authTokenValidationTotal.WithLabelValues("idp", "jwt", failureResult)))
+	})
+
+	t.Run("counts introspection cache lookups per issuer", func(t *testing.T) {
+		// GIVEN
+		collector := NewCollector()
+
+		// WHEN
+		collector.RecordIntrospectionCache("idp", true)
+		collector.RecordIntrospectionCache("idp", false)
+
+		// THEN
+		assert.Equal(t, float64(1), testutil.ToFloat64(collector.authIntrospectionCacheTotal.WithLabelValues("idp", hitResult)))
+		assert.Equal(t, float64(1), testutil.ToFloat64(collector.authIntrospectionCacheTotal.WithLabelValues("idp", missResult)))
+	})
+
+	t.Run("counts JWKS synchronizations per issuer", func(t *testing.T) {
+		// GIVEN
+		collector := NewCollector()
+
+		// WHEN
+		collector.RecordJWKSSynchronization("idp", false)
+
+		// THEN
+		assert.Equal(t, float64(1), testutil.ToFloat64(collector.authJWKSSyncTotal.WithLabelValues("idp", failureResult)))
+	})
+}
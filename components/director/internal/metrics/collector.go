@@ -12,6 +12,19 @@ type Collector struct {
 	graphQLRequestDuration *prometheus.HistogramVec
 	hydraRequestTotal      *prometheus.CounterVec
 	hydraRequestDuration   *prometheus.HistogramVec
+
+	graphQLOperationTotal    *prometheus.CounterVec
+	graphQLOperationDuration *prometheus.HistogramVec
+	graphQLResolverTotal     *prometheus.CounterVec
+	graphQLResolverDuration  *prometheus.HistogramVec
+
+	dbQueryDuration    *prometheus.HistogramVec
+	dbTransactionTotal prometheus.Counter
+	dbRollbackTotal    prometheus.Counter
+
+	authTokenValidationTotal    *prometheus.CounterVec
+	authIntrospectionCacheTotal *prometheus.CounterVec
+	authJWKSSyncTotal           *prometheus.CounterVec
 }
 
 func NewCollector() *Collector {
@@ -40,6 +53,66 @@ func NewCollector() *Collector {
 			Name:      "hydra_request_total",
 			Help:      "Total HTTP Requests to Hydra",
 		}, []string{"code", "method"}),
+		graphQLOperationTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: DirectorSubsystem,
+			Name:      "graphql_operation_total",
+			Help:      "Total executed GraphQL operations",
+		}, []string{"type", "name", "error"}),
+		graphQLOperationDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: DirectorSubsystem,
+			Name:      "graphql_operation_duration_seconds",
+			Help:      "Duration of executing GraphQL operations",
+		}, []string{"type", "name"}),
+		graphQLResolverTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: DirectorSubsystem,
+			Name:      "graphql_resolver_total",
+			Help:      "Total GraphQL fields resolved by resolver methods",
+		}, []string{"field", "error"}),
+		graphQLResolverDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: DirectorSubsystem,
+			Name:      "graphql_resolver_duration_seconds",
+			Help:      "Duration of resolving GraphQL fields by resolver methods",
+		}, []string{"field"}),
+		dbQueryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: DirectorSubsystem,
+			Name:      "db_query_duration_seconds",
+			Help:      "Duration of SQL queries executed by repositories",
+		}, []string{"repository", "method"}),
+		dbTransactionTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: DirectorSubsystem,
+			Name:      "db_transaction_total",
+			Help:      "Total started database transactions",
+		}),
+		dbRollbackTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: DirectorSubsystem,
+			Name:      "db_rollback_total",
+			Help:      "Total rolled back database transactions",
+		}),
+		authTokenValidationTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: DirectorSubsystem,
+			Name:      "auth_token_validation_total",
+			Help:      "Total validated tokens per issuer",
+		}, []string{"issuer", "method", "result"}),
+		authIntrospectionCacheTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: DirectorSubsystem,
+			Name:      "auth_introspection_cache_total",
+			Help:      "Total lookups in the token introspection cache per issuer",
+		}, []string{"issuer", "result"}),
+		authJWKSSyncTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: DirectorSubsystem,
+			Name:      "auth_jwks_sync_total",
+			Help:      "Total JWKS synchronizations per issuer",
+		}, []string{"issuer", "result"}),
 	}
 }
 
@@ -48,6 +121,16 @@ func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
 	c.graphQLRequestDuration.Describe(ch)
 	c.hydraRequestTotal.Describe(ch)
 	c.hydraRequestDuration.Describe(ch)
+	c.graphQLOperationTotal.Describe(ch)
+	c.graphQLOperationDuration.Describe(ch)
+	c.graphQLResolverTotal.Describe(ch)
+	c.graphQLResolverDuration.Describe(ch)
+	c.dbQueryDuration.Describe(ch)
+	c.dbTransactionTotal.Describe(ch)
+	c.dbRollbackTotal.Describe(ch)
+	c.authTokenValidationTotal.Describe(ch)
+	c.authIntrospectionCacheTotal.Describe(ch)
+	c.authJWKSSyncTotal.Describe(ch)
 }
 
 func (c *Collector) Collect(ch chan<- prometheus.Metric) {
@@ -55,6 +138,16 @@ func (c *Collector) Collect(ch chan<- prometheus.Metric) {
 	c.graphQLRequestDuration.Collect(ch)
 	c.hydraRequestTotal.Collect(ch)
 	c.hydraRequestDuration.Collect(ch)
+	c.graphQLOperationTotal.Collect(ch)
+	c.graphQLOperationDuration.Collect(ch)
+	c.graphQLResolverTotal.Collect(ch)
+	c.graphQLResolverDuration.Collect(ch)
+	c.dbQueryDuration.Collect(ch)
+	c.dbTransactionTotal.Collect(ch)
+	c.dbRollbackTotal.Collect(ch)
+	c.authTokenValidationTotal.Collect(ch)
+	c.authIntrospectionCacheTotal.Collect(ch)
+	c.authJWKSSyncTotal.Collect(ch)
 }
 
 func (c *Collector) GraphQLHandlerWithInstrumentation(handler http.Handler) http.HandlerFunc {
@@ -64,7 +157,12 @@ func (c *Collector) GraphQLHandlerWithInstrumentation(handler http.Handler) http
 }
 
 func (c *Collector) InstrumentOAuth20HTTPClient(client *http.Client) {
+	transport := client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
 	client.Transport = promhttp.InstrumentRoundTripperCounter(c.hydraRequestTotal,
-		promhttp.InstrumentRoundTripperDuration(c.hydraRequestDuration, http.DefaultTransport),
+		promhttp.InstrumentRoundTripperDuration(c.hydraRequestDuration, transport),
 	)
 }
@@ -0,0 +1,86 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/vektah/gqlparser/ast"
+)
+
+const (
+	noError            = "None"
+	anonymousOperation = "anonymous"
+	unknownOperation   = "unknown"
+)
+
+// GraphQLRequestMiddleware counts executed GraphQL operations by their type, name and the class of the first returned error
+func (c *Collector) GraphQLRequestMiddleware(ctx context.Context, next func(ctx context.Context) []byte) []byte {
+	start := time.Now()
+	res := next(ctx)
+
+	reqCtx := graphql.GetRequestContext(ctx)
+	if reqCtx == nil {
+		return res
+	}
+
+	opType, opName := operationLabels(reqCtx.Doc)
+	c.graphQLOperationDuration.WithLabelValues(opType, opName).Observe(time.Since(start).Seconds())
+	c.graphQLOperationTotal.WithLabelValues(opType, opName, operationErrorClass(reqCtx)).Inc()
+
+	return res
+}
+
+// GraphQLResolverMiddleware counts GraphQL fields resolved by resolver methods by the class of the returned error
+func (c *Collector) GraphQLResolverMiddleware(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	resolverCtx := graphql.GetResolverContext(ctx)
+	if resolverCtx == nil || !resolverCtx.IsMethod {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	res, err := next(ctx)
+
+	field := fmt.Sprintf("%s.%s", resolverCtx.Object, resolverCtx.Field.Name)
+	c.graphQLResolverDuration.WithLabelValues(field).Observe(time.Since(start).Seconds())
+	c.graphQLResolverTotal.WithLabelValues(field, errorClass(err)).Inc()
+
+	return res, err
+}
+
+// operationLabels returns the type and name of the executed operation.
+// The request context does not hold the requested operation name, so documents with multiple operations are reported as unknown.
+func operationLabels(doc *ast.QueryDocument) (string, string) {
+	if doc == nil || len(doc.Operations) != 1 {
+		return unknownOperation, unknownOperation
+	}
+
+	op := doc.Operations[0]
+	if op.Name == "" {
+		return string(op.Operation), anonymousOperation
+	}
+
+	return string(op.Operation), op.Name
+}
+
+func operationErrorClass(reqCtx *graphql.RequestContext) string {
+	if len(reqCtx.Errors) == 0 {
+		return noError
+	}
+
+	if class, ok := reqCtx.Errors[0].Extensions["error"].(string); ok {
+		return class
+	}
+
+	return apperrors.UnknownError.String()
+}
+
+func errorClass(err error) string {
+	if err == nil {
+		return noError
+	}
+
+	return apperrors.ErrorCode(err).String()
+}
@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/ast"
+	"github.com/vektah/gqlparser/gqlerror"
+)
+
+func TestCollector_GraphQLRequestMiddleware(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Doc            *ast.QueryDocument
+		Errors         gqlerror.List
+		ExpectedLabels []string
+	}{
+		{
+			Name:           "Named operation without errors",
+			Doc:            fixQueryDocument(ast.Mutation, "registerApp"),
+			ExpectedLabels: []string{"mutation", "registerApp", noError},
+		},
+		{
+			Name:           "Anonymous operation with error",
+			Doc:            fixQueryDocument(ast.Query, ""),
+			Errors:         gqlerror.List{{Message: "not found", Extensions: map[string]interface{}{"error": apperrors.NotFound.String()}}},
+			ExpectedLabels: []string{"query", anonymousOperation, apperrors.NotFound.String()},
+		},
+		{
+			Name:           "Multiple operations with error without class",
+			Doc:            fixQueryDocument(ast.Query, "first", "second"),
+			Errors:         gqlerror.List{{Message: "test"}},
+			ExpectedLabels: []string{unknownOperation, unknownOperation, apperrors.UnknownError.String()},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			collector := NewCollector()
+			reqCtx := graphql.NewRequestContext(testCase.Doc, "", nil)
+			reqCtx.Errors = testCase.Errors
+			ctx := graphql.WithRequestContext(context.TODO(), reqCtx)
+
+			// WHEN
+			res := collector.GraphQLRequestMiddleware(ctx, func(ctx context.Context) []byte {
+				return []byte("response")
+			})
+
+			// THEN
+			assert.Equal(t, []byte("response"), res)
+			assert.Equal(t, float64(1), testutil.ToFloat64(collector.graphQLOperationTotal.WithLabelValues(testCase.ExpectedLabels...)))
+			assert.Equal(t, 1, testutil.CollectAndCount(collector.graphQLOperationDuration))
+		})
+	}
+}
+
+func TestCollector_GraphQLResolverMiddleware(t *testing.T) {
+	t.Run("counts resolver method by error class", func(t *testing.T) {
+		// GIVEN
+		collector := NewCollector()
+		testErr := apperrors.NewNotUniqueError(resource.Application)
+		ctx := graphql.WithResolverContext(context.TODO(), &graphql.ResolverContext{
+			Object:   "Mutation",
+			Field:    graphql.CollectedField{Field: &ast.Field{Name: "registerApplication"}},
+			IsMethod: true,
+		})
+
+		// WHEN
+		_, err := collector.GraphQLResolverMiddleware(ctx, func(ctx context.Context) (interface{}, error) {
+			return nil, testErr
+		})
+
+		// THEN
+		require.Equal(t, testErr, err)
+		assert.Equal(t, float64(1), testutil.ToFloat64(collector.graphQLResolverTotal.WithLabelValues("Mutation.registerApplication", apperrors.NotUnique.String())))
+		assert.Equal(t, 1, testutil.CollectAndCount(collector.graphQLResolverDuration))
+	})
+
+	t.Run("skips fields which are not resolved by a method", func(t *testing.T) {
+		// GIVEN
+		collector := NewCollector()
+		ctx := graphql.WithResolverContext(context.TODO(), &graphql.ResolverContext{
+			Object: "Application",
+			Field:  graphql.CollectedField{Field: &ast.Field{Name: "name"}},
+		})
+
+		// WHEN
+		res, err := collector.GraphQLResolverMiddleware(ctx, func(ctx context.Context) (interface{}, error) {
+			return "foo", nil
+		})
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, "foo", res)
+		assert.Equal(t, 0, testutil.CollectAndCount(collector.graphQLResolverTotal))
+	})
+}
+
+func fixQueryDocument(operation ast.Operation, names ...string) *ast.QueryDocument {
+	doc := &ast.QueryDocument{}
+	for _, name := range names {
+		doc.Operations = append(doc.Operations, &ast.OperationDefinition{Operation: operation, Name: name})
+	}
+
+	return doc
+}
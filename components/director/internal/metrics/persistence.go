@@ -0,0 +1,149 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+)
+
+const (
+	modulePath       = "github.com/kyma-incubator/compass/components/director/"
+	unknownCallLabel = "unknown"
+	maxCallerDepth   = 16
+)
+
+var (
+	// packages which execute queries on behalf of the repositories
+	queryHelperPackages = []string{
+		modulePath + "pkg/persistence.",
+		modulePath + "internal/metrics.",
+		modulePath + "internal/repo.",
+	}
+
+	closureSuffix = regexp.MustCompile(`(\.func\d+(\.\d+)*)+$`)
+)
+
+// InstrumentTransactioner counts started and rolled back transactions and measures the duration of the queries executed within them
+func (c *Collector) InstrumentTransactioner(transact persistence.Transactioner) persistence.Transactioner {
+	return &instrumentedTransactioner{Transactioner: transact, collector: c}
+}
+
+type instrumentedTransactioner struct {
+	persistence.Transactioner
+	collector *Collector
+}
+
+func (t *instrumentedTransactioner) Begin() (persistence.PersistenceTx, error) {
+	tx, err := t.Transactioner.Begin()
+	if err != nil {
+		return tx, err
+	}
+
+	t.collector.dbTransactionTotal.Inc()
+	return &instrumentedTx{PersistenceTx: tx, collector: t.collector}, nil
+}
+
+func (t *instrumentedTransactioner) RollbackUnlessCommitted(ctx context.Context, tx persistence.PersistenceTx) {
+	instrumented, ok := tx.(*instrumentedTx)
+	if !ok {
+		t.Transactioner.RollbackUnlessCommitted(ctx, tx)
+		return
+	}
+
+	if !instrumented.committed {
+		t.collector.dbRollbackTotal.Inc()
+	}
+	t.Transactioner.RollbackUnlessCommitted(ctx, instrumented.PersistenceTx)
+}
+
+type instrumentedTx struct {
+	persistence.PersistenceTx
+	collector *Collector
+	committed bool
+}
+
+func (tx *instrumentedTx) Commit() error {
+	if err := tx.PersistenceTx.Commit(); err != nil {
+		return err
+	}
+
+	tx.committed = true
+	return nil
+}
+
+func (tx *instrumentedTx) Get(dest interface{}, query string, args ...interface{}) error {
+	defer tx.observeQuery(time.Now())
+	return tx.PersistenceTx.Get(dest, query, args...)
+}
+
+func (tx *instrumentedTx) Select(dest interface{}, query string, args ...interface{}) error {
+	defer tx.observeQuery(time.Now())
+	return tx.PersistenceTx.Select(dest, query, args...)
+}
+
+func (tx *instrumentedTx) NamedExec(query string, arg interface{}) (sql.Result, error) {
+	defer tx.observeQuery(time.Now())
+	return tx.PersistenceTx.NamedExec(query, arg)
+}
+
+func (tx *instrumentedTx) Exec(query string, args ...interface{}) (sql.Result, error) {
+	defer tx.observeQuery(time.Now())
+	return tx.PersistenceTx.Exec(query, args...)
+}
+
+func (tx *instrumentedTx) observeQuery(start time.Time) {
+	repository, method := repositoryCaller()
+	tx.collector.dbQueryDuration.WithLabelValues(repository, method).Observe(time.Since(start).Seconds())
+}
+
+// repositoryCaller returns the package and method name of the first caller outside of the generic query helpers,
+// e.g. "application" and "GetByID" for the application repository.
+func repositoryCaller() (string, string) {
+	pcs := make([]uintptr, maxCallerDepth)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	for {
+		frame, more := frames.Next()
+		if !isQueryHelper(frame.Function) {
+			return parseFunctionName(frame.Function)
+		}
+		if !more {
+			return unknownCallLabel, unknownCallLabel
+		}
+	}
+}
+
+func isQueryHelper(function string) bool {
+	for _, prefix := range queryHelperPackages {
+		if strings.HasPrefix(function, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseFunctionName(function string) (string, string) {
+	function = closureSuffix.ReplaceAllString(function, "")
+	if idx := strings.LastIndex(function, "/"); idx >= 0 {
+		function = function[idx+1:]
+	}
+
+	idx := strings.Index(function, ".")
+	if idx < 0 {
+		return unknownCallLabel, unknownCallLabel
+	}
+
+	pkg, rest := function[:idx], function[idx+1:]
+	if dot := strings.LastIndex(rest, "."); dot >= 0 {
+		rest = rest[dot+1:]
+	}
+
+	return pkg, rest
+}
@@ -0,0 +1,126 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollector_InstrumentTransactioner(t *testing.T) {
+	testErr := errors.New("test error")
+
+	t.Run("counts committed transaction without rollback", func(t *testing.T) {
+		// GIVEN
+		collector := NewCollector()
+		tx := &automock.PersistenceTx{}
+		tx.On("Get", mock.Anything, "SELECT 1").Return(nil).Once()
+		tx.On("Commit").Return(nil).Once()
+		transact := &automock.Transactioner{}
+		transact.On("Begin").Return(tx, nil).Once()
+		transact.On("RollbackUnlessCommitted", mock.Anything, tx).Once()
+		instrumented := collector.InstrumentTransactioner(transact)
+
+		// WHEN
+		persistTx, err := instrumented.Begin()
+		require.NoError(t, err)
+		err = persistTx.Get(nil, "SELECT 1")
+		require.NoError(t, err)
+		err = persistTx.Commit()
+		require.NoError(t, err)
+		instrumented.RollbackUnlessCommitted(context.TODO(), persistTx)
+
+		// THEN
+		assert.Equal(t, float64(1), testutil.ToFloat64(collector.dbTransactionTotal))
+		assert.Equal(t, float64(0), testutil.ToFloat64(collector.dbRollbackTotal))
+		assert.Equal(t, 1, testutil.CollectAndCount(collector.dbQueryDuration))
+		mock.AssertExpectationsForObjects(t, tx, transact)
+	})
+
+	t.Run("counts rollback when transaction was not committed", func(t *testing.T) {
+		// GIVEN
+		collector := NewCollector()
+		tx := &automock.PersistenceTx{}
+		tx.On("Commit").Return(testErr).Once()
+		transact := &automock.Transactioner{}
+		transact.On("Begin").Return(tx, nil).Once()
+		transact.On("RollbackUnlessCommitted", mock.Anything, tx).Once()
+		instrumented := collector.InstrumentTransactioner(transact)
+
+		// WHEN
+		persistTx, err := instrumented.Begin()
+		require.NoError(t, err)
+		err = persistTx.Commit()
+		require.Equal(t, testErr, err)
+		instrumented.RollbackUnlessCommitted(context.TODO(), persistTx)
+
+		// THEN
+		assert.Equal(t, float64(1), testutil.ToFloat64(collector.dbTransactionTotal))
+		assert.Equal(t, float64(1), testutil.ToFloat64(collector.dbRollbackTotal))
+		mock.AssertExpectationsForObjects(t, tx, transact)
+	})
+
+	t.Run("does not count transaction which failed to begin", func(t *testing.T) {
+		// GIVEN
+		collector := NewCollector()
+		transact := &automock.Transactioner{}
+		transact.On("Begin").Return(nil, testErr).Once()
+		instrumented := collector.InstrumentTransactioner(transact)
+
+		// WHEN
+		_, err := instrumented.Begin()
+
+		// THEN
+		require.Equal(t, testErr, err)
+		assert.Equal(t, float64(0), testutil.ToFloat64(collector.dbTransactionTotal))
+		transact.AssertExpectations(t)
+	})
+}
+
+func TestParseFunctionName(t *testing.T) {
+	testCases := []struct {
+		Name               string
+		Function           string
+		ExpectedRepository string
+		ExpectedMethod     string
+	}{
+		{
+			Name:               "Pointer receiver method",
+			Function:           modulePath + "internal/domain/application.(*pgRepository).GetByID",
+			ExpectedRepository: "application",
+			ExpectedMethod:     "GetByID",
+		},
+		{
+			Name:               "Closure within method",
+			Function:           modulePath + "internal/domain/label.(*repository).Upsert.func1.1",
+			ExpectedRepository: "label",
+			ExpectedMethod:     "Upsert",
+		},
+		{
+			Name:               "Package function",
+			Function:           modulePath + "internal/domain/tenant.ListAll",
+			ExpectedRepository: "tenant",
+			ExpectedMethod:     "ListAll",
+		},
+		{
+			Name:               "Unknown function",
+			Function:           "",
+			ExpectedRepository: unknownCallLabel,
+			ExpectedMethod:     unknownCallLabel,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repository, method := parseFunctionName(testCase.Function)
+
+			assert.Equal(t, testCase.ExpectedRepository, repository)
+			assert.Equal(t, testCase.ExpectedMethod, method)
+		})
+	}
+}
@@ -13,6 +13,8 @@ type ApplicationTemplate struct {
 	ApplicationInputJSON string
 	Placeholders         []ApplicationTemplatePlaceholder
 	AccessLevel          ApplicationTemplateAccessLevel
+	TenantID             *string
+	Version              int
 }
 
 type ApplicationTemplatePage struct {
@@ -33,8 +35,15 @@ type ApplicationTemplateAccessLevel string
 
 const (
 	GlobalApplicationTemplateAccessLevel ApplicationTemplateAccessLevel = "GLOBAL"
+	TenantApplicationTemplateAccessLevel ApplicationTemplateAccessLevel = "TENANT"
 )
 
+type ApplicationFromTemplateUpgrade struct {
+	FromVersion int
+	ToVersion   int
+	Changes     []*ApplicationChange
+}
+
 type ApplicationFromTemplateInput struct {
 	TemplateName string
 	Values       ApplicationFromTemplateInputValues
@@ -52,10 +61,33 @@ func (in ApplicationFromTemplateInputValues) FindPlaceholderValue(name string) (
 }
 
 type ApplicationTemplatePlaceholder struct {
-	Name        string
-	Description *string
+	Name          string
+	Description   *string
+	Type          ApplicationTemplatePlaceholderType `json:",omitempty"`
+	Optional      bool                               `json:",omitempty"`
+	DefaultValue  *string                            `json:",omitempty"`
+	AllowedValues []string                           `json:",omitempty"`
+	JSONSchema    *string                            `json:",omitempty"`
+}
+
+// ValueType returns the type of the placeholder value. Placeholders stored without type are treated as strings.
+func (p ApplicationTemplatePlaceholder) ValueType() ApplicationTemplatePlaceholderType {
+	if p.Type == "" {
+		return StringApplicationTemplatePlaceholderType
+	}
+	return p.Type
 }
 
+type ApplicationTemplatePlaceholderType string
+
+const (
+	StringApplicationTemplatePlaceholderType  ApplicationTemplatePlaceholderType = "STRING"
+	URLApplicationTemplatePlaceholderType     ApplicationTemplatePlaceholderType = "URL"
+	EnumApplicationTemplatePlaceholderType    ApplicationTemplatePlaceholderType = "ENUM"
+	BooleanApplicationTemplatePlaceholderType ApplicationTemplatePlaceholderType = "BOOLEAN"
+	NumberApplicationTemplatePlaceholderType  ApplicationTemplatePlaceholderType = "NUMBER"
+)
+
 type ApplicationTemplateValueInput struct {
 	Placeholder string
 	Value       string
@@ -75,3 +107,16 @@ func (a *ApplicationTemplateInput) ToApplicationTemplate(id string) ApplicationT
 		AccessLevel:          a.AccessLevel,
 	}
 }
+
+// MergeValues returns the values overridden by the given ones
+func (in ApplicationFromTemplateInputValues) MergeValues(overrides ApplicationFromTemplateInputValues) ApplicationFromTemplateInputValues {
+	merged := make(ApplicationFromTemplateInputValues, 0, len(in)+len(overrides))
+	for _, value := range in {
+		if _, err := overrides.FindPlaceholderValue(value.Placeholder); err == nil {
+			continue
+		}
+		merged = append(merged, value)
+	}
+
+	return append(merged, overrides...)
+}
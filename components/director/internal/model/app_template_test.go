@@ -109,3 +109,39 @@ func TestApplicationFromTemplateInputValues_FindPlaceholderValue(t *testing.T) {
 		})
 	}
 }
+
+func TestApplicationTemplatePlaceholder_ValueType(t *testing.T) {
+	t.Run("Returns string type when type is not set", func(t *testing.T) {
+		placeholder := model.ApplicationTemplatePlaceholder{Name: "a"}
+
+		assert.Equal(t, model.StringApplicationTemplatePlaceholderType, placeholder.ValueType())
+	})
+
+	t.Run("Returns type when set", func(t *testing.T) {
+		placeholder := model.ApplicationTemplatePlaceholder{Name: "a", Type: model.NumberApplicationTemplatePlaceholderType}
+
+		assert.Equal(t, model.NumberApplicationTemplatePlaceholderType, placeholder.ValueType())
+	})
+}
+
+func TestApplicationFromTemplateInputValues_MergeValues(t *testing.T) {
+	// given
+	var values model.ApplicationFromTemplateInputValues = []*model.ApplicationTemplateValueInput{
+		{Placeholder: "a", Value: "foo"},
+		{Placeholder: "b", Value: "bar"},
+	}
+	var overrides model.ApplicationFromTemplateInputValues = []*model.ApplicationTemplateValueInput{
+		{Placeholder: "b", Value: "baz"},
+		{Placeholder: "c", Value: "qux"},
+	}
+
+	// when
+	result := values.MergeValues(overrides)
+
+	// then
+	assert.Equal(t, model.ApplicationFromTemplateInputValues{
+		{Placeholder: "a", Value: "foo"},
+		{Placeholder: "b", Value: "baz"},
+		{Placeholder: "c", Value: "qux"},
+	}, result)
+}
@@ -15,6 +15,8 @@ type Application struct {
 	Status              *ApplicationStatus
 	HealthCheckURL      *string
 	IntegrationSystemID *string
+	// DeletedAt is set only for Applications marked as deleted
+	DeletedAt *time.Time
 }
 
 func (app *Application) SetFromUpdateInput(update ApplicationUpdateInput, timestamp time.Time) {
@@ -104,3 +106,37 @@ type ApplicationUpdateInput struct {
 	IntegrationSystemID *string
 	StatusCondition     *ApplicationStatusCondition
 }
+
+// ApplicationTemplateReference describes the Application Template and the placeholder values an Application was registered or upgraded from
+type ApplicationTemplateReference struct {
+	ApplicationTemplateID *string
+	Version               int
+	Values                ApplicationFromTemplateInputValues
+}
+
+// ApplicationChange describes a change of a resource of an Application applied while reconciling it with an input
+type ApplicationChange struct {
+	Resource  ApplicationChangeResource
+	Operation ApplicationChangeOperation
+	Key       string
+}
+
+type ApplicationChangeResource string
+
+const (
+	ApplicationChangeResourcePackage         ApplicationChangeResource = "PACKAGE"
+	ApplicationChangeResourceAPIDefinition   ApplicationChangeResource = "API_DEFINITION"
+	ApplicationChangeResourceEventDefinition ApplicationChangeResource = "EVENT_DEFINITION"
+	ApplicationChangeResourceDocument        ApplicationChangeResource = "DOCUMENT"
+	ApplicationChangeResourceWebhook         ApplicationChangeResource = "WEBHOOK"
+	ApplicationChangeResourceLabel           ApplicationChangeResource = "LABEL"
+)
+
+type ApplicationChangeOperation string
+
+const (
+	ApplicationChangeOperationCreate ApplicationChangeOperation = "CREATE"
+	ApplicationChangeOperationUpdate ApplicationChangeOperation = "UPDATE"
+	// ApplicationChangeOperationRemoved marks resources which are no longer defined in the input. They are kept in the Application.
+	ApplicationChangeOperationRemoved ApplicationChangeOperation = "REMOVED"
+)
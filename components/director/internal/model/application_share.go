@@ -0,0 +1,30 @@
+package model
+
+// ApplicationShare grants the Runtimes and Runtime Contexts of the target tenant read-only access to the Application of the tenant.
+// If Scenario is defined, only the Runtimes and Runtime Contexts in the scenario have access to the Application.
+type ApplicationShare struct {
+	ID            string
+	Tenant        string
+	ApplicationID string
+	TargetTenant  string
+	// TargetExternalTenant is not persisted, it is resolved from the TargetTenant
+	TargetExternalTenant string
+	Scenario             *string
+}
+
+type ApplicationShareInput struct {
+	// TargetExternalTenant is the external ID of the tenant which the Application is shared with
+	TargetExternalTenant string
+	Scenario             *string
+}
+
+func (i ApplicationShareInput) ToApplicationShare(id, tenant, applicationID, targetTenant string) ApplicationShare {
+	return ApplicationShare{
+		ID:                   id,
+		Tenant:               tenant,
+		ApplicationID:        applicationID,
+		TargetTenant:         targetTenant,
+		TargetExternalTenant: i.TargetExternalTenant,
+		Scenario:             i.Scenario,
+	}
+}
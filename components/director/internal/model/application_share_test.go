@@ -0,0 +1,62 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplicationShareInput_ToApplicationShare(t *testing.T) {
+	// given
+	id := "foo"
+	tenant := "tenant"
+	appID := "app"
+	targetTenant := "target"
+
+	testCases := []struct {
+		Name     string
+		Input    model.ApplicationShareInput
+		Expected model.ApplicationShare
+	}{
+		{
+			Name: "All properties given",
+			Input: model.ApplicationShareInput{
+				TargetExternalTenant: "external-target",
+				Scenario:             str.Ptr("HR"),
+			},
+			Expected: model.ApplicationShare{
+				ID:                   id,
+				Tenant:               tenant,
+				ApplicationID:        appID,
+				TargetTenant:         targetTenant,
+				TargetExternalTenant: "external-target",
+				Scenario:             str.Ptr("HR"),
+			},
+		},
+		{
+			Name: "Without scenario",
+			Input: model.ApplicationShareInput{
+				TargetExternalTenant: "external-target",
+			},
+			Expected: model.ApplicationShare{
+				ID:                   id,
+				Tenant:               tenant,
+				ApplicationID:        appID,
+				TargetTenant:         targetTenant,
+				TargetExternalTenant: "external-target",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			result := testCase.Input.ToApplicationShare(id, tenant, appID, targetTenant)
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
@@ -19,8 +19,9 @@ type CSRFTokenCredentialRequestAuth struct {
 }
 
 type CredentialData struct {
-	Basic *BasicCredentialData
-	Oauth *OAuthCredentialData
+	Basic          *BasicCredentialData
+	Oauth          *OAuthCredentialData
+	CertificateGen *CertificateGenCredentialData `json:",omitempty"`
 }
 
 type BasicCredentialData struct {
@@ -28,9 +29,17 @@ type BasicCredentialData struct {
 	Password string
 }
 type OAuthCredentialData struct {
-	ClientID     string
-	ClientSecret string
-	URL          string
+	ClientID              string
+	ClientSecret          string
+	URL                   string
+	AdditionalHeaders     map[string][]string
+	AdditionalQueryParams map[string][]string
+}
+
+type CertificateGenCredentialData struct {
+	CommonName  string
+	Certificate string
+	PrivateKey  string
 }
 
 type AuthInput struct {
@@ -64,8 +73,9 @@ func (i *AuthInput) ToAuth() *Auth {
 }
 
 type CredentialDataInput struct {
-	Basic *BasicCredentialDataInput
-	Oauth *OAuthCredentialDataInput
+	Basic          *BasicCredentialDataInput
+	Oauth          *OAuthCredentialDataInput
+	CertificateGen *CertificateGenCredentialDataInput
 }
 
 func (i *CredentialDataInput) ToCredentialData() *CredentialData {
@@ -75,6 +85,7 @@ func (i *CredentialDataInput) ToCredentialData() *CredentialData {
 
 	var basic *BasicCredentialData
 	var oauth *OAuthCredentialData
+	var certificateGen *CertificateGenCredentialData
 
 	if i.Basic != nil {
 		basic = i.Basic.ToBasicCredentialData()
@@ -84,9 +95,14 @@ func (i *CredentialDataInput) ToCredentialData() *CredentialData {
 		oauth = i.Oauth.ToOAuthCredentialData()
 	}
 
+	if i.CertificateGen != nil {
+		certificateGen = i.CertificateGen.ToCertificateGenCredentialData()
+	}
+
 	return &CredentialData{
-		Basic: basic,
-		Oauth: oauth,
+		Basic:          basic,
+		Oauth:          oauth,
+		CertificateGen: certificateGen,
 	}
 }
 
@@ -107,9 +123,11 @@ func (i *BasicCredentialDataInput) ToBasicCredentialData() *BasicCredentialData
 }
 
 type OAuthCredentialDataInput struct {
-	ClientID     string
-	ClientSecret string
-	URL          string
+	ClientID              string
+	ClientSecret          string
+	URL                   string
+	AdditionalHeaders     map[string][]string
+	AdditionalQueryParams map[string][]string
 }
 
 func (i *OAuthCredentialDataInput) ToOAuthCredentialData() *OAuthCredentialData {
@@ -118,9 +136,29 @@ func (i *OAuthCredentialDataInput) ToOAuthCredentialData() *OAuthCredentialData
 	}
 
 	return &OAuthCredentialData{
-		ClientID:     i.ClientID,
-		ClientSecret: i.ClientSecret,
-		URL:          i.URL,
+		ClientID:              i.ClientID,
+		ClientSecret:          i.ClientSecret,
+		URL:                   i.URL,
+		AdditionalHeaders:     i.AdditionalHeaders,
+		AdditionalQueryParams: i.AdditionalQueryParams,
+	}
+}
+
+type CertificateGenCredentialDataInput struct {
+	CommonName  string
+	Certificate string
+	PrivateKey  string
+}
+
+func (i *CertificateGenCredentialDataInput) ToCertificateGenCredentialData() *CertificateGenCredentialData {
+	if i == nil {
+		return nil
+	}
+
+	return &CertificateGenCredentialData{
+		CommonName:  i.CommonName,
+		Certificate: i.Certificate,
+		PrivateKey:  i.PrivateKey,
 	}
 }
 
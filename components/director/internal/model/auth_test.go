@@ -94,6 +94,9 @@ func TestCredentialDataInput_ToCredentialData(t *testing.T) {
 				Oauth: &model.OAuthCredentialDataInput{
 					URL: "test",
 				},
+				CertificateGen: &model.CertificateGenCredentialDataInput{
+					CommonName: "cn",
+				},
 			},
 			Expected: &model.CredentialData{
 				Basic: &model.BasicCredentialData{
@@ -102,6 +105,9 @@ func TestCredentialDataInput_ToCredentialData(t *testing.T) {
 				Oauth: &model.OAuthCredentialData{
 					URL: "test",
 				},
+				CertificateGen: &model.CertificateGenCredentialData{
+					CommonName: "cn",
+				},
 			},
 		},
 		{
@@ -180,14 +186,18 @@ func TestOAuthCredentialDataInput_ToOAuthCredentialData(t *testing.T) {
 		{
 			Name: "All properties given",
 			Input: &model.OAuthCredentialDataInput{
-				URL:          "test",
-				ClientID:     "id",
-				ClientSecret: "secret",
+				URL:                   "test",
+				ClientID:              "id",
+				ClientSecret:          "secret",
+				AdditionalHeaders:     map[string][]string{"header": {"value"}},
+				AdditionalQueryParams: map[string][]string{"param": {"value"}},
 			},
 			Expected: &model.OAuthCredentialData{
-				URL:          "test",
-				ClientID:     "id",
-				ClientSecret: "secret",
+				URL:                   "test",
+				ClientID:              "id",
+				ClientSecret:          "secret",
+				AdditionalHeaders:     map[string][]string{"header": {"value"}},
+				AdditionalQueryParams: map[string][]string{"param": {"value"}},
 			},
 		},
 		{
@@ -214,6 +224,50 @@ func TestOAuthCredentialDataInput_ToOAuthCredentialData(t *testing.T) {
 	}
 }
 
+func TestCertificateGenCredentialDataInput_ToCertificateGenCredentialData(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Input    *model.CertificateGenCredentialDataInput
+		Expected *model.CertificateGenCredentialData
+	}{
+		{
+			Name: "All properties given",
+			Input: &model.CertificateGenCredentialDataInput{
+				CommonName:  "cn",
+				Certificate: "cert",
+				PrivateKey:  "key",
+			},
+			Expected: &model.CertificateGenCredentialData{
+				CommonName:  "cn",
+				Certificate: "cert",
+				PrivateKey:  "key",
+			},
+		},
+		{
+			Name:     "Empty",
+			Input:    &model.CertificateGenCredentialDataInput{},
+			Expected: &model.CertificateGenCredentialData{},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+
+			// when
+			result := testCase.Input.ToCertificateGenCredentialData()
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
 func TestCredentialRequestAuthInput_ToCredentialRequestAuth(t *testing.T) {
 	// given
 	testCases := []struct {
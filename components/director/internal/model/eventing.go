@@ -52,3 +52,31 @@ func NewEmptyApplicationEventingConfig() (*ApplicationEventingConfiguration, err
 		},
 	}, nil
 }
+
+type EventingPolicyType string
+
+const (
+	// EventingPolicyPinned keeps the default Runtime chosen explicitly, no other Runtime is chosen when it leaves the Application scenarios
+	EventingPolicyPinned EventingPolicyType = "PINNED"
+	// EventingPolicyOldest chooses the oldest Runtime assigned to the Application scenarios
+	EventingPolicyOldest EventingPolicyType = "OLDEST"
+	// EventingPolicyLabelPreferred chooses the oldest Runtime with the preferred label, or the oldest Runtime if none has it
+	EventingPolicyLabelPreferred EventingPolicyType = "LABEL_PREFERRED"
+)
+
+type EventingPolicy struct {
+	Type           EventingPolicyType
+	PreferredLabel *LabelSelector
+}
+
+func NewDefaultEventingPolicy() EventingPolicy {
+	return EventingPolicy{
+		Type: EventingPolicyOldest,
+	}
+}
+
+type ApplicationEventingBinding struct {
+	ApplicationID string
+	RuntimeID     string
+	Policy        EventingPolicy
+}
@@ -35,6 +35,7 @@ const (
 	RuntimeLabelableObject        LabelableObject = "Runtime"
 	RuntimeContextLabelableObject LabelableObject = "Runtime Context"
 	ApplicationLabelableObject    LabelableObject = "Application"
+	PackageLabelableObject        LabelableObject = "Package"
 )
 
 func NewLabelForRuntimeContext(runtimeCtx RuntimeContext, key string, value interface{}) *Label {
@@ -6,3 +6,52 @@ type LabelDefinition struct {
 	Key    string
 	Schema *interface{}
 }
+
+type LabelMigrationAction string
+
+const (
+	// LabelMigrationActionFail rejects the migration if any label does not match the new schema
+	LabelMigrationActionFail LabelMigrationAction = "FAIL"
+	// LabelMigrationActionSetDefault replaces the labels which do not match the new schema with the default value
+	LabelMigrationActionSetDefault LabelMigrationAction = "SET_DEFAULT"
+	// LabelMigrationActionDelete deletes the labels which do not match the new schema
+	LabelMigrationActionDelete LabelMigrationAction = "DELETE"
+)
+
+type LabelChangeType string
+
+const (
+	LabelChangeTypeMapped    LabelChangeType = "MAPPED"
+	LabelChangeTypeDefaulted LabelChangeType = "DEFAULTED"
+	LabelChangeTypeDeleted   LabelChangeType = "DELETED"
+	LabelChangeTypeInvalid   LabelChangeType = "INVALID"
+)
+
+// LabelValueMapping replaces the label value, or the element of the label value if it is an array, equal to From with To
+type LabelValueMapping struct {
+	From interface{}
+	To   interface{}
+}
+
+// LabelDefinitionMigration describes how the existing labels are migrated to the new schema of the label definition.
+// The value mappings are applied first, and then OnInvalid is applied to the labels which still do not match the schema.
+type LabelDefinitionMigration struct {
+	ValueMappings []LabelValueMapping
+	OnInvalid     LabelMigrationAction
+	DefaultValue  interface{}
+	DryRun        bool
+}
+
+type LabelChange struct {
+	ObjectType LabelableObject
+	ObjectID   string
+	Type       LabelChangeType
+	OldValue   interface{}
+	NewValue   interface{}
+}
+
+type LabelDefinitionMigrationResult struct {
+	LabelDefinition LabelDefinition
+	DryRun          bool
+	Changes         []LabelChange
+}
@@ -7,13 +7,16 @@ import (
 )
 
 type PackageInstanceAuth struct {
-	ID          string
-	PackageID   string
-	Tenant      string
-	Context     *string
-	InputParams *string
-	Auth        *Auth
-	Status      *PackageInstanceAuthStatus
+	ID               string
+	PackageID        string
+	RuntimeContextID *string
+	Tenant           string
+	Context          *string
+	InputParams      *string
+	Auth             *Auth
+	Status           *PackageInstanceAuthStatus
+	// RequesterTenant is set when the Package Instance Auth is requested by a tenant which the Application is shared with
+	RequesterTenant *string
 }
 
 func (a *PackageInstanceAuth) SetDefaultStatus(condition PackageInstanceAuthStatusCondition, timestamp time.Time) error {
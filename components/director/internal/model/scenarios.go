@@ -2,6 +2,10 @@ package model
 
 const (
 	ScenariosKey = "scenarios"
+	// DefaultScenario is required by the scenarios label definition, so it cannot be deleted
+	DefaultScenario = "DEFAULT"
+	// ScenarioNamePattern is the pattern of the values in the enum of the scenarios label definition
+	ScenarioNamePattern = "^[A-Za-z0-9]([-_A-Za-z0-9\\s]*[A-Za-z0-9])$"
 )
 
 var (
@@ -12,7 +16,7 @@ var (
 		"uniqueItems": true,
 		"items": map[string]interface{}{
 			"type":      "string",
-			"pattern":   "^[A-Za-z0-9]([-_A-Za-z0-9\\s]*[A-Za-z0-9])$",
+			"pattern":   ScenarioNamePattern,
 			"enum":      []string{"DEFAULT"},
 			"maxLength": 128,
 		},
@@ -41,7 +45,7 @@ var (
 						"const": "string",
 					},
 					"pattern": map[string]interface{}{
-						"const": "^[A-Za-z0-9]([-_A-Za-z0-9\\s]*[A-Za-z0-9])$",
+						"const": ScenarioNamePattern,
 					},
 					"maxLength": map[string]interface{}{
 						"const": 128,
@@ -50,7 +54,7 @@ var (
 						"type": "array",
 						"items": map[string]interface{}{
 							"type":      "string",
-							"pattern":   "^[A-Za-z0-9]([-_A-Za-z0-9\\s]*[A-Za-z0-9])$",
+							"pattern":   ScenarioNamePattern,
 							"maxLength": 128,
 						},
 						"contains": map[string]interface{}{
@@ -62,3 +66,33 @@ var (
 		},
 	}
 )
+
+// Scenario holds the metadata of a scenario.
+// The scenarios themselves are the values of the enum in the scenarios label definition.
+type Scenario struct {
+	ID          string
+	Tenant      string
+	Name        string
+	Description *string
+	Owner       *string
+}
+
+type ScenarioInput struct {
+	Name        string
+	Description *string
+	Owner       *string
+}
+
+func (i *ScenarioInput) ToScenario(id, tenant string) Scenario {
+	if i == nil {
+		return Scenario{}
+	}
+
+	return Scenario{
+		ID:          id,
+		Tenant:      tenant,
+		Name:        i.Name,
+		Description: i.Description,
+		Owner:       i.Owner,
+	}
+}
@@ -1,10 +1,13 @@
 package model_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/jsonschema"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,3 +21,54 @@ func Test_SchemaForSchema(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, result.Valid)
 }
+
+func TestScenarioInput_ToScenario(t *testing.T) {
+	// given
+	id := "foo"
+	tenant := "tenant"
+
+	testCases := []struct {
+		Name     string
+		Input    *model.ScenarioInput
+		Expected model.Scenario
+	}{
+		{
+			Name: "All properties given",
+			Input: &model.ScenarioInput{
+				Name:        "marketing",
+				Description: str.Ptr("Marketing systems"),
+				Owner:       str.Ptr("marketing-team"),
+			},
+			Expected: model.Scenario{
+				ID:          id,
+				Tenant:      tenant,
+				Name:        "marketing",
+				Description: str.Ptr("Marketing systems"),
+				Owner:       str.Ptr("marketing-team"),
+			},
+		},
+		{
+			Name:  "Empty",
+			Input: &model.ScenarioInput{},
+			Expected: model.Scenario{
+				ID:     id,
+				Tenant: tenant,
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: model.Scenario{},
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+			// when
+			result := testCase.Input.ToScenario(id, tenant)
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
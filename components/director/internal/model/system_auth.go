@@ -9,6 +9,7 @@ type SystemAuth struct {
 	TenantID            *string
 	AppID               *string
 	RuntimeID           *string
+	RuntimeContextID    *string
 	IntegrationSystemID *string
 	Value               *Auth
 }
@@ -22,6 +23,10 @@ func (sa SystemAuth) GetReferenceObjectType() (SystemAuthReferenceObjectType, er
 		return RuntimeReference, nil
 	}
 
+	if sa.RuntimeContextID != nil {
+		return RuntimeContextReference, nil
+	}
+
 	if sa.IntegrationSystemID != nil {
 		return IntegrationSystemReference, nil
 	}
@@ -38,6 +43,10 @@ func (sa SystemAuth) GetReferenceObjectID() (string, error) {
 		return *sa.RuntimeID, nil
 	}
 
+	if sa.RuntimeContextID != nil {
+		return *sa.RuntimeContextID, nil
+	}
+
 	if sa.IntegrationSystemID != nil {
 		return *sa.IntegrationSystemID, nil
 	}
@@ -49,6 +58,7 @@ type SystemAuthReferenceObjectType string
 
 const (
 	RuntimeReference           SystemAuthReferenceObjectType = "Runtime"
+	RuntimeContextReference    SystemAuthReferenceObjectType = "Runtime Context"
 	ApplicationReference       SystemAuthReferenceObjectType = "Application"
 	IntegrationSystemReference SystemAuthReferenceObjectType = "Integration System"
 )
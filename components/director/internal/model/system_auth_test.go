@@ -35,6 +35,18 @@ func TestSystemAuthGetReferenceObjectType(t *testing.T) {
 		require.Equal(t, RuntimeReference, refObjType)
 	})
 
+	t.Run("GetReferenceObjectType returns RuntimeContextReference for SystemAuth referenced by the Runtime Context", func(t *testing.T) {
+		runtimeContextID := uuid.New()
+		sysAuth := SystemAuth{
+			RuntimeContextID: str.Ptr(runtimeContextID.String()),
+		}
+
+		refObjType, err := sysAuth.GetReferenceObjectType()
+
+		require.NoError(t, err)
+		require.Equal(t, RuntimeContextReference, refObjType)
+	})
+
 	t.Run("GetReferenceObjectType returns IntegrationSystemReference for SystemAuth referenced by the Integration System", func(t *testing.T) {
 		intSysID := uuid.New()
 		sysAuth := SystemAuth{
@@ -81,6 +93,18 @@ func TestSystemAuthGetReferenceObjectID(t *testing.T) {
 		require.Equal(t, runtimeID.String(), refObjID)
 	})
 
+	t.Run("GetReferenceObjectID returns RuntimeContextID for SystemAuth referenced by the Runtime Context", func(t *testing.T) {
+		runtimeContextID := uuid.New()
+		sysAuth := SystemAuth{
+			RuntimeContextID: str.Ptr(runtimeContextID.String()),
+		}
+
+		refObjID, err := sysAuth.GetReferenceObjectID()
+
+		require.NoError(t, err)
+		require.Equal(t, runtimeContextID.String(), refObjID)
+	})
+
 	t.Run("GetReferenceObjectID returns IntegrationSystemID and IntegrationSystemReference for SystemAuth referenced by the Integration System", func(t *testing.T) {
 		intSysID := uuid.New()
 		sysAuth := SystemAuth{
@@ -0,0 +1,48 @@
+package model
+
+import "time"
+
+// TenantOffboardingState is the last completed step of the tenant offboarding
+type TenantOffboardingState string
+
+const (
+	TenantOffboardingStateDeactivated        TenantOffboardingState = "DEACTIVATED"
+	TenantOffboardingStateCredentialsRevoked TenantOffboardingState = "CREDENTIALS_REVOKED"
+	TenantOffboardingStateExported           TenantOffboardingState = "EXPORTED"
+	TenantOffboardingStatePurged             TenantOffboardingState = "PURGED"
+)
+
+// TenantOffboarding tracks the offboarding of a tenant. The tenant is deactivated when the offboarding starts,
+// then its credentials are revoked, its data is exported if requested, and the data is purged after the retention period.
+type TenantOffboarding struct {
+	ID              string
+	TenantID        string
+	ExternalTenant  string
+	State           TenantOffboardingState
+	ExportRequested bool
+	// ExportedData is kept until the data of the tenant is purged
+	ExportedData *string
+	// Error is the reason why the last attempt to complete the next step failed
+	Error     *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// PurgeAfter is set when the credentials of the tenant are revoked
+	PurgeAfter *time.Time
+	// RuntimesToNotify are the Runtimes which lost their credentials and are not notified yet
+	RuntimesToNotify []string
+}
+
+// IsExportPending returns true if the data of the tenant was requested, but it is not exported yet
+func (o *TenantOffboarding) IsExportPending() bool {
+	return o.ExportRequested && (o.State == TenantOffboardingStateDeactivated || o.State == TenantOffboardingStateCredentialsRevoked)
+}
+
+// IsPurgeDue returns true if the credentials of the tenant are revoked, the requested export is completed
+// and the retention period has expired at the given time
+func (o *TenantOffboarding) IsPurgeDue(now time.Time) bool {
+	if o.State != TenantOffboardingStateCredentialsRevoked && o.State != TenantOffboardingStateExported {
+		return false
+	}
+
+	return !o.IsExportPending() && o.PurgeAfter != nil && !now.Before(*o.PurgeAfter)
+}
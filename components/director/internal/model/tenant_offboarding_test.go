@@ -0,0 +1,70 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTenantOffboarding_IsPurgeDue(t *testing.T) {
+	// given
+	now := time.Now()
+
+	testCases := []struct {
+		Name     string
+		Input    model.TenantOffboarding
+		Expected bool
+	}{
+		{
+			Name:     "Credentials revoked and retention period expired",
+			Input:    model.TenantOffboarding{State: model.TenantOffboardingStateCredentialsRevoked, PurgeAfter: &now},
+			Expected: true,
+		},
+		{
+			Name:     "Data exported and retention period expired",
+			Input:    model.TenantOffboarding{State: model.TenantOffboardingStateExported, ExportRequested: true, PurgeAfter: timePtr(now.Add(-time.Hour))},
+			Expected: true,
+		},
+		{
+			Name:     "Retention period not expired",
+			Input:    model.TenantOffboarding{State: model.TenantOffboardingStateCredentialsRevoked, PurgeAfter: timePtr(now.Add(time.Hour))},
+			Expected: false,
+		},
+		{
+			Name:     "Credentials not revoked",
+			Input:    model.TenantOffboarding{State: model.TenantOffboardingStateDeactivated, PurgeAfter: timePtr(now.Add(-time.Hour))},
+			Expected: false,
+		},
+		{
+			Name:     "Export pending",
+			Input:    model.TenantOffboarding{State: model.TenantOffboardingStateCredentialsRevoked, ExportRequested: true, PurgeAfter: timePtr(now.Add(-time.Hour))},
+			Expected: false,
+		},
+		{
+			Name:     "Retention period not started",
+			Input:    model.TenantOffboarding{State: model.TenantOffboardingStateCredentialsRevoked},
+			Expected: false,
+		},
+		{
+			Name:     "Already purged",
+			Input:    model.TenantOffboarding{State: model.TenantOffboardingStatePurged, PurgeAfter: timePtr(now.Add(-time.Hour))},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			result := testCase.Input.IsPurgeDue(now)
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,109 @@
+package model
+
+type User struct {
+	ID       string
+	Username string
+}
+
+type UserInput struct {
+	Username string
+}
+
+func (i *UserInput) ToUser(id string) User {
+	if i == nil {
+		return User{}
+	}
+
+	return User{
+		ID:       id,
+		Username: i.Username,
+	}
+}
+
+type UserGroup struct {
+	ID   string
+	Name string
+}
+
+type UserGroupInput struct {
+	Name string
+}
+
+func (i *UserGroupInput) ToUserGroup(id string) UserGroup {
+	if i == nil {
+		return UserGroup{}
+	}
+
+	return UserGroup{
+		ID:   id,
+		Name: i.Name,
+	}
+}
+
+// Role is a named set of scopes which can be granted to users and user groups with role bindings
+type Role struct {
+	ID     string
+	Name   string
+	Scopes []string
+}
+
+type RoleInput struct {
+	Name   string
+	Scopes []string
+}
+
+func (i *RoleInput) ToRole(id string) Role {
+	if i == nil {
+		return Role{}
+	}
+
+	return Role{
+		ID:     id,
+		Name:   i.Name,
+		Scopes: i.Scopes,
+	}
+}
+
+type RoleBindingSubjectType string
+
+const (
+	RoleBindingSubjectTypeUser      RoleBindingSubjectType = "USER"
+	RoleBindingSubjectTypeUserGroup RoleBindingSubjectType = "GROUP"
+)
+
+// RoleBinding grants the role to the user or the user group in the tenant with the given external ID.
+// A role binding without a tenant grants the role in every tenant.
+type RoleBinding struct {
+	ID          string
+	RoleID      string
+	SubjectType RoleBindingSubjectType
+	SubjectID   string
+	Tenant      *string
+}
+
+type RoleBindingInput struct {
+	RoleID      string
+	SubjectType RoleBindingSubjectType
+	SubjectID   string
+	Tenant      *string
+}
+
+func (i *RoleBindingInput) ToRoleBinding(id string) RoleBinding {
+	if i == nil {
+		return RoleBinding{}
+	}
+
+	return RoleBinding{
+		ID:          id,
+		RoleID:      i.RoleID,
+		SubjectType: i.SubjectType,
+		SubjectID:   i.SubjectID,
+		Tenant:      i.Tenant,
+	}
+}
+
+// AppliesToTenant returns true if the role binding grants the role in the tenant with the given external ID.
+// Every role binding applies if no tenant is given.
+func (rb RoleBinding) AppliesToTenant(externalTenant string) bool {
+	return externalTenant == "" || rb.Tenant == nil || *rb.Tenant == externalTenant
+}
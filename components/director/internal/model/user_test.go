@@ -0,0 +1,154 @@
+package model_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+func TestRoleInput_ToRole(t *testing.T) {
+	// given
+	id := "foo"
+
+	testCases := []struct {
+		Name     string
+		Input    *model.RoleInput
+		Expected model.Role
+	}{
+		{
+			Name: "All properties given",
+			Input: &model.RoleInput{
+				Name:   "operator",
+				Scopes: []string{"application:read", "runtime:read"},
+			},
+			Expected: model.Role{
+				ID:     id,
+				Name:   "operator",
+				Scopes: []string{"application:read", "runtime:read"},
+			},
+		},
+		{
+			Name:  "Empty",
+			Input: &model.RoleInput{},
+			Expected: model.Role{
+				ID: id,
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: model.Role{},
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+			// when
+			result := testCase.Input.ToRole(id)
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
+func TestRoleBindingInput_ToRoleBinding(t *testing.T) {
+	// given
+	id := "foo"
+
+	testCases := []struct {
+		Name     string
+		Input    *model.RoleBindingInput
+		Expected model.RoleBinding
+	}{
+		{
+			Name: "All properties given",
+			Input: &model.RoleBindingInput{
+				RoleID:      "role",
+				SubjectType: model.RoleBindingSubjectTypeUser,
+				SubjectID:   "user",
+				Tenant:      str.Ptr("tenant"),
+			},
+			Expected: model.RoleBinding{
+				ID:          id,
+				RoleID:      "role",
+				SubjectType: model.RoleBindingSubjectTypeUser,
+				SubjectID:   "user",
+				Tenant:      str.Ptr("tenant"),
+			},
+		},
+		{
+			Name:  "Empty",
+			Input: &model.RoleBindingInput{},
+			Expected: model.RoleBinding{
+				ID: id,
+			},
+		},
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: model.RoleBinding{},
+		},
+	}
+
+	for i, testCase := range testCases {
+		t.Run(fmt.Sprintf("%d: %s", i, testCase.Name), func(t *testing.T) {
+			// when
+			result := testCase.Input.ToRoleBinding(id)
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
+
+func TestRoleBinding_AppliesToTenant(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		BindingTenant  *string
+		ExternalTenant string
+		Expected       bool
+	}{
+		{
+			Name:           "Binding in the given tenant",
+			BindingTenant:  str.Ptr("tenant"),
+			ExternalTenant: "tenant",
+			Expected:       true,
+		},
+		{
+			Name:           "Binding in other tenant",
+			BindingTenant:  str.Ptr("other"),
+			ExternalTenant: "tenant",
+			Expected:       false,
+		},
+		{
+			Name:           "Binding in every tenant",
+			BindingTenant:  nil,
+			ExternalTenant: "tenant",
+			Expected:       true,
+		},
+		{
+			Name:           "No tenant given",
+			BindingTenant:  str.Ptr("other"),
+			ExternalTenant: "",
+			Expected:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			rb := model.RoleBinding{Tenant: testCase.BindingTenant}
+
+			// when
+			result := rb.AppliesToTenant(testCase.ExternalTenant)
+
+			// then
+			assert.Equal(t, testCase.Expected, result)
+		})
+	}
+}
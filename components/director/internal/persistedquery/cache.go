@@ -0,0 +1,41 @@
+package persistedquery
+
+import (
+	"context"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+)
+
+type Config struct {
+	CacheSize int `envconfig:"default=1000"`
+}
+
+// cache keeps the queries registered by clients using Automatic Persisted Queries,
+// so that subsequent requests can send only the SHA-256 hash of the query
+type cache struct {
+	queries *lru.Cache
+}
+
+func NewCache(cfg Config) (*cache, error) {
+	queries, err := lru.New(cfg.CacheSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating persisted queries cache")
+	}
+
+	return &cache{queries: queries}, nil
+}
+
+func (c *cache) Add(ctx context.Context, hash string, query string) {
+	c.queries.Add(hash, query)
+}
+
+func (c *cache) Get(ctx context.Context, hash string) (string, bool) {
+	query, ok := c.queries.Get(hash)
+	if !ok {
+		return "", false
+	}
+
+	queryStr, ok := query.(string)
+	return queryStr, ok
+}
@@ -0,0 +1,49 @@
+package persistedquery_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/persistedquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		cache, err := persistedquery.NewCache(persistedquery.Config{CacheSize: 1})
+		require.NoError(t, err)
+
+		// WHEN
+		cache.Add(context.TODO(), "hash", "query")
+		query, ok := cache.Get(context.TODO(), "hash")
+
+		// THEN
+		assert.True(t, ok)
+		assert.Equal(t, "query", query)
+	})
+
+	t.Run("Evicts the least recently used query", func(t *testing.T) {
+		// GIVEN
+		cache, err := persistedquery.NewCache(persistedquery.Config{CacheSize: 1})
+		require.NoError(t, err)
+
+		// WHEN
+		cache.Add(context.TODO(), "hash", "query")
+		cache.Add(context.TODO(), "other-hash", "other-query")
+		_, ok := cache.Get(context.TODO(), "hash")
+
+		// THEN
+		assert.False(t, ok)
+	})
+
+	t.Run("Returns error when cache size is invalid", func(t *testing.T) {
+		// WHEN
+		_, err := persistedquery.NewCache(persistedquery.Config{CacheSize: 0})
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while creating persisted queries cache")
+	})
+}
@@ -76,6 +76,44 @@ func (c *notNullCondition) GetQueryArgs() ([]interface{}, bool) {
 	return nil, false
 }
 
+func NewNullCondition(field string) Condition {
+	return &nullCondition{
+		field: field,
+	}
+}
+
+type nullCondition struct {
+	field string
+}
+
+func (c *nullCondition) GetQueryPart() string {
+	return fmt.Sprintf("%s IS NULL", c.field)
+}
+
+func (c *nullCondition) GetQueryArgs() ([]interface{}, bool) {
+	return nil, false
+}
+
+func NewLessThanCondition(field string, val interface{}) Condition {
+	return &lessThanCondition{
+		field: field,
+		val:   val,
+	}
+}
+
+type lessThanCondition struct {
+	field string
+	val   interface{}
+}
+
+func (c *lessThanCondition) GetQueryPart() string {
+	return fmt.Sprintf("%s < ?", c.field)
+}
+
+func (c *lessThanCondition) GetQueryArgs() ([]interface{}, bool) {
+	return []interface{}{c.val}, true
+}
+
 func NewInConditionForSubQuery(field, subQuery string, args []interface{}) Condition {
 	return &inCondition{
 		field:       field,
@@ -113,6 +151,26 @@ func NewInConditionForStringValues(field string, values []string) Condition {
 	}
 }
 
+type regexCondition struct {
+	field string
+	value string
+}
+
+func (c *regexCondition) GetQueryPart() string {
+	return fmt.Sprintf("%s ~ ?", c.field)
+}
+
+func (c *regexCondition) GetQueryArgs() ([]interface{}, bool) {
+	return []interface{}{c.value}, true
+}
+
+func NewRegexConditionString(field string, value string) Condition {
+	return &regexCondition{
+		field: field,
+		value: value,
+	}
+}
+
 type notRegexCondition struct {
 	field string
 	value string
@@ -132,3 +190,57 @@ func NewNotRegexConditionString(field string, value string) Condition {
 		value: value,
 	}
 }
+
+type nullOrEqualCondition struct {
+	field string
+	val   interface{}
+}
+
+func (c *nullOrEqualCondition) GetQueryPart() string {
+	return fmt.Sprintf("(%s IS NULL OR %s = ?)", c.field, c.field)
+}
+
+func (c *nullOrEqualCondition) GetQueryArgs() ([]interface{}, bool) {
+	return []interface{}{c.val}, true
+}
+
+func NewNullOrEqualCondition(field string, val interface{}) Condition {
+	return &nullOrEqualCondition{
+		field: field,
+		val:   val,
+	}
+}
+
+// NewOrCondition joins the given conditions with OR
+func NewOrCondition(conditions ...Condition) Condition {
+	return &compoundCondition{
+		operator:   "OR",
+		conditions: conditions,
+	}
+}
+
+// NewAndCondition joins the given conditions with AND. It is used to group conditions inside of an OR condition.
+func NewAndCondition(conditions ...Condition) Condition {
+	return &compoundCondition{
+		operator:   "AND",
+		conditions: conditions,
+	}
+}
+
+type compoundCondition struct {
+	operator   string
+	conditions Conditions
+}
+
+func (c *compoundCondition) GetQueryPart() string {
+	parts := make([]string, 0, len(c.conditions))
+	for _, cond := range c.conditions {
+		parts = append(parts, cond.GetQueryPart())
+	}
+	return fmt.Sprintf("(%s)", strings.Join(parts, fmt.Sprintf(" %s ", c.operator)))
+}
+
+func (c *compoundCondition) GetQueryArgs() ([]interface{}, bool) {
+	args := getAllArgs(c.conditions)
+	return args, len(args) > 0
+}
@@ -68,6 +68,30 @@ func TestList(t *testing.T) {
 		assert.Len(t, dest, 1)
 	})
 
+	t.Run("lists all items successfully with compound conditions", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id_col", "tenant_id", "first_name", "last_name", "age"}).
+			AddRow(peterRow...).
+			AddRow(homerRow...)
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT id_col, tenant_id, first_name, last_name, age FROM users WHERE tenant_id = $1 AND ((first_name = $2 AND age != $3) OR last_name IS NOT NULL)`)).
+			WithArgs(givenTenant, "Peter", 18).WillReturnRows(rows)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		conditions := repo.Conditions{
+			repo.NewOrCondition(
+				repo.NewAndCondition(repo.NewEqualCondition("first_name", "Peter"), repo.NewNotEqualCondition("age", 18)),
+				repo.NewNotNullCondition("last_name"),
+			),
+		}
+
+		err := sut.List(ctx, givenTenant, &dest, conditions...)
+		require.NoError(t, err)
+		assert.Len(t, dest, 2)
+	})
+
 	t.Run("returns error if missing persistence context", func(t *testing.T) {
 		ctx := context.TODO()
 		err := sut.List(ctx, givenTenant, nil)
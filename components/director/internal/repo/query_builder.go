@@ -61,6 +61,32 @@ func buildSelectQuery(tableName string, selectedColumns string, conditions Condi
 	return getQueryFromBuilder(stmtBuilder), allArgs, nil
 }
 
+// buildUnionQuery builds a single statement which unions a separately paginated select query
+// for every given value of the idsColumn. Placeholders are enumerated across all subqueries.
+func buildUnionQuery(tableName string, selectedColumns string, idsColumn string, ids []string, conditions Conditions, paginationSQL string) (string, []interface{}, error) {
+	var stmtBuilder strings.Builder
+	var allArgs []interface{}
+
+	for idx, id := range ids {
+		if idx > 0 {
+			stmtBuilder.WriteString(" UNION ALL ")
+		}
+
+		idConditions := append(Conditions{NewEqualCondition(idsColumn, id)}, conditions...)
+
+		stmtBuilder.WriteString(fmt.Sprintf("(SELECT %s FROM %s WHERE", selectedColumns, tableName))
+		err := writeEnumeratedConditions(&stmtBuilder, idConditions)
+		if err != nil {
+			return "", nil, errors.Wrap(err, "while writing enumerated conditions.")
+		}
+		stmtBuilder.WriteString(fmt.Sprintf(" %s)", paginationSQL))
+
+		allArgs = append(allArgs, getAllArgs(idConditions)...)
+	}
+
+	return getQueryFromBuilder(stmtBuilder), allArgs, nil
+}
+
 const anyKeyExistsOp = "?|"
 const anyKeyExistsOpPlaceholder = "{{anyKeyExistsOp}}"
 
@@ -0,0 +1,108 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+// UnionLister lists a separate page of objects for each of the given parent IDs using a fixed number of queries
+type UnionLister interface {
+	// List fills dest with the requested page of objects for every ID and returns the total count of objects per ID
+	List(ctx context.Context, tenant string, ids []string, idsColumn string, pageSize int, cursor string, orderByColumn string, dest Collection, additionalConditions ...Condition) (map[string]int, error)
+}
+
+type universalUnionLister struct {
+	tableName       string
+	selectedColumns string
+	tenantColumn    string
+	resourceType    resource.Type
+}
+
+func NewUnionLister(resourceType resource.Type, tableName string, tenantColumn string, selectedColumns []string) UnionLister {
+	return &universalUnionLister{
+		tableName:       tableName,
+		selectedColumns: strings.Join(selectedColumns, ", "),
+		tenantColumn:    tenantColumn,
+		resourceType:    resourceType,
+	}
+}
+
+type idTotalCount struct {
+	ID         string `db:"id"`
+	TotalCount int    `db:"total_count"`
+}
+
+func (l *universalUnionLister) List(ctx context.Context, tenant string, ids []string, idsColumn string, pageSize int, cursor string, orderByColumn string, dest Collection, additionalConditions ...Condition) (map[string]int, error) {
+	if tenant == "" {
+		return nil, apperrors.NewTenantRequiredError()
+	}
+
+	if len(ids) == 0 {
+		return map[string]int{}, nil
+	}
+
+	persist, err := persistence.FromCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := pagination.DecodeOffsetCursor(cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding page cursor")
+	}
+
+	paginationSQL, err := pagination.ConvertOffsetLimitAndOrderedColumnToSQL(pageSize, offset, orderByColumn)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting offset and limit to cursor")
+	}
+
+	conditions := append(Conditions{NewEqualCondition(l.tenantColumn, tenant)}, additionalConditions...)
+
+	query, args, err := buildUnionQuery(l.tableName, l.selectedColumns, idsColumn, ids, conditions, paginationSQL)
+	if err != nil {
+		return nil, errors.Wrap(err, "while building union list query")
+	}
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	err = persist.Select(dest, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "while fetching list of objects from DB")
+	}
+
+	return l.getTotalCounts(ctx, persist, idsColumn, ids, conditions)
+}
+
+func (l *universalUnionLister) getTotalCounts(ctx context.Context, persist persistence.PersistenceOp, idsColumn string, ids []string, conditions Conditions) (map[string]int, error) {
+	conditions = append(conditions, NewInConditionForStringValues(idsColumn, ids))
+
+	query, args, err := buildSelectQuery(l.tableName, fmt.Sprintf("%s AS id, COUNT(*) AS total_count", idsColumn), conditions, NoOrderBy)
+	if err != nil {
+		return nil, errors.Wrap(err, "while building count query")
+	}
+	query = fmt.Sprintf("%s GROUP BY %s", query, idsColumn)
+
+	log.C(ctx).Debugf("Executing DB query: %s", query)
+	var counts []idTotalCount
+	err = persist.Select(&counts, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "while counting objects")
+	}
+
+	totalCounts := make(map[string]int, len(ids))
+	for _, id := range ids {
+		totalCounts[id] = 0
+	}
+	for _, c := range counts {
+		totalCounts[c.ID] = c.TotalCount
+	}
+
+	return totalCounts, nil
+}
@@ -0,0 +1,134 @@
+package repo_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnionList(t *testing.T) {
+	givenTenant := uuidB()
+	peterID := uuidA()
+	homerID := uuidC()
+	peter := User{FirstName: "Peter", LastName: "Griffin", Age: 40, Tenant: givenTenant, ID: peterID}
+	peterRow := []driver.Value{peterID, givenTenant, "Peter", "Griffin", 40}
+	homer := User{FirstName: "Homer", LastName: "Simpson", Age: 55, Tenant: givenTenant, ID: homerID}
+	homerRow := []driver.Value{homerID, givenTenant, "Homer", "Simpson", 55}
+
+	sut := repo.NewUnionLister("UserType", "users", "tenant_id",
+		[]string{"id_col", "tenant_id", "first_name", "last_name", "age"})
+
+	t.Run("returns page for every ID and total counts", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id_col", "tenant_id", "first_name", "last_name", "age"}).
+			AddRow(peterRow...).
+			AddRow(homerRow...)
+		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id_col, tenant_id, first_name, last_name, age FROM users WHERE last_name = $1 AND tenant_id = $2 ORDER BY id_col LIMIT 10 OFFSET 0) UNION ALL (SELECT id_col, tenant_id, first_name, last_name, age FROM users WHERE last_name = $3 AND tenant_id = $4 ORDER BY id_col LIMIT 10 OFFSET 0)")).
+			WithArgs("Griffin", givenTenant, "Simpson", givenTenant).
+			WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT last_name AS id, COUNT(*) AS total_count FROM users WHERE tenant_id = $1 AND last_name IN ($2, $3) GROUP BY last_name")).
+			WithArgs(givenTenant, "Griffin", "Simpson").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow("Griffin", 1).AddRow("Simpson", 12))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		totalCounts, err := sut.List(ctx, givenTenant, []string{"Griffin", "Simpson"}, "last_name", 10, "", "id_col", &dest)
+		require.NoError(t, err)
+		assert.Len(t, dest, 2)
+		assert.Equal(t, peter, dest[0])
+		assert.Equal(t, homer, dest[1])
+		assert.Equal(t, map[string]int{"Griffin": 1, "Simpson": 12}, totalCounts)
+	})
+
+	t.Run("returns page with additional conditions and zero count for IDs without objects", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id_col", "tenant_id", "first_name", "last_name", "age"}).
+			AddRow(peterRow...)
+		mock.ExpectQuery(regexp.QuoteMeta("(SELECT id_col, tenant_id, first_name, last_name, age FROM users WHERE last_name = $1 AND tenant_id = $2 AND age != $3 ORDER BY id_col LIMIT 2 OFFSET 2) UNION ALL (SELECT id_col, tenant_id, first_name, last_name, age FROM users WHERE last_name = $4 AND tenant_id = $5 AND age != $6 ORDER BY id_col LIMIT 2 OFFSET 2)")).
+			WithArgs("Griffin", givenTenant, 18, "Simpson", givenTenant, 18).
+			WillReturnRows(rows)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT last_name AS id, COUNT(*) AS total_count FROM users WHERE tenant_id = $1 AND age != $2 AND last_name IN ($3, $4) GROUP BY last_name")).
+			WithArgs(givenTenant, 18, "Griffin", "Simpson").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "total_count"}).AddRow("Griffin", 3))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		totalCounts, err := sut.List(ctx, givenTenant, []string{"Griffin", "Simpson"}, "last_name", 2, "RHBLdEo0ajlqRHEy", "id_col", &dest, repo.NewNotEqualCondition("age", 18))
+		require.NoError(t, err)
+		assert.Len(t, dest, 1)
+		assert.Equal(t, map[string]int{"Griffin": 3, "Simpson": 0}, totalCounts)
+	})
+
+	t.Run("returns no counts and does not query DB when no IDs given", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		totalCounts, err := sut.List(ctx, givenTenant, []string{}, "last_name", 2, "", "id_col", &dest)
+		require.NoError(t, err)
+		assert.Empty(t, totalCounts)
+		assert.Empty(t, dest)
+	})
+
+	t.Run("returns error if tenant is empty", func(t *testing.T) {
+		_, err := sut.List(context.TODO(), "", []string{"Griffin"}, "last_name", 2, "", "id_col", nil)
+		require.EqualError(t, err, apperrors.NewTenantRequiredError().Error())
+	})
+
+	t.Run("returns error if missing persistence context", func(t *testing.T) {
+		_, err := sut.List(context.TODO(), givenTenant, []string{"Griffin"}, "last_name", 2, "", "id_col", nil)
+		require.EqualError(t, err, apperrors.NewInternalError("unable to fetch database from context").Error())
+	})
+
+	t.Run("returns error if wrong cursor", func(t *testing.T) {
+		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
+		_, err := sut.List(ctx, givenTenant, []string{"Griffin"}, "last_name", 2, "zzz", "id_col", nil)
+		require.EqualError(t, err, "while decoding page cursor: cursor is not correct: illegal base64 data at input byte 0")
+	})
+
+	t.Run("returns error if wrong pagination attributes", func(t *testing.T) {
+		ctx := persistence.SaveToContext(context.TODO(), &sqlx.Tx{})
+		_, err := sut.List(ctx, givenTenant, []string{"Griffin"}, "last_name", -3, "", "id_col", nil)
+		require.EqualError(t, err, "while converting offset and limit to cursor: Invalid data [reason=page size cannot be smaller than 1]")
+	})
+
+	t.Run("returns error on db operation", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		mock.ExpectQuery(`\(SELECT .*`).WillReturnError(someError())
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		_, err := sut.List(ctx, givenTenant, []string{"Griffin"}, "last_name", 2, "", "id_col", &dest)
+		require.EqualError(t, err, "while fetching list of objects from DB: some error")
+	})
+
+	t.Run("returns error on counting objects", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		mock.ExpectQuery(`\(SELECT .*`).WillReturnRows(sqlmock.NewRows([]string{"id_col", "tenant_id", "first_name", "last_name", "age"}))
+		mock.ExpectQuery(`SELECT last_name AS id, COUNT\(\*\) .*`).WillReturnError(someError())
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		_, err := sut.List(ctx, givenTenant, []string{"Griffin"}, "last_name", 2, "", "id_col", &dest)
+		require.EqualError(t, err, "while counting objects: some error")
+	})
+}
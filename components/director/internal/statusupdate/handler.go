@@ -25,8 +25,9 @@ type StatusUpdateRepository interface {
 type WithStatusObject string
 
 const (
-	Applications WithStatusObject = "applications"
-	Runtimes     WithStatusObject = "runtimes"
+	Applications    WithStatusObject = "applications"
+	Runtimes        WithStatusObject = "runtimes"
+	RuntimeContexts WithStatusObject = "runtime_contexts"
 )
 
 func New(transact persistence.Transactioner, repo StatusUpdateRepository) *update {
@@ -53,6 +54,8 @@ func (u *update) Handler() func(next http.Handler) http.Handler {
 				object = Applications
 			case consumer.Runtime:
 				object = Runtimes
+			case consumer.RuntimeContext:
+				object = RuntimeContexts
 			default:
 				next.ServeHTTP(w, r)
 				return
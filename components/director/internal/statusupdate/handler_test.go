@@ -97,6 +97,20 @@ func TestUpdate_Handler(t *testing.T) {
 			ExpectedResponse: "OK",
 			MockNextHandler:  fixNextHandler(t),
 		},
+		{
+			Name: "In case of Runtime Context update status and execute next handler",
+			TxFn: txGen.ThatSucceeds,
+			RepoFn: func() *automock.StatusUpdateRepository {
+				repo := automock.StatusUpdateRepository{}
+				repo.On("IsConnected", txtest.CtxWithDBMatcher(), testID, statusupdate.RuntimeContexts).Return(false, nil)
+				repo.On("UpdateStatus", txtest.CtxWithDBMatcher(), testID, statusupdate.RuntimeContexts).Return(nil)
+				return &repo
+			},
+			Request:          createRequestWithClaims(testID, consumer.RuntimeContext),
+			ExpectedStatus:   http.StatusOK,
+			ExpectedResponse: "OK",
+			MockNextHandler:  fixNextHandler(t),
+		},
 		{
 			Name: "In case of application already connected do nothing and execute next handler",
 			TxFn: txGen.ThatSucceeds,
@@ -13,8 +13,9 @@ import (
 )
 
 const (
-	updateQuery = "UPDATE public.%s SET status_condition = 'CONNECTED', status_timestamp = $1 WHERE id = $2"
-	existsQuery = "SELECT 1 FROM public.%s WHERE id = $1 AND status_condition = 'CONNECTED'"
+	updateQuery                  = "UPDATE public.%s SET status_condition = 'CONNECTED', status_timestamp = $1 WHERE id = %s"
+	existsQuery                  = "SELECT 1 FROM public.%s WHERE id = %s AND status_condition = 'CONNECTED'"
+	runtimeOfRuntimeContextQuery = "(SELECT runtime_id FROM public.runtime_contexts WHERE id = %s)"
 )
 
 type repository struct {
@@ -32,7 +33,8 @@ func (r *repository) UpdateStatus(ctx context.Context, id string, object WithSta
 		return errors.Wrap(err, "while loading persistence from context")
 	}
 
-	stmt := fmt.Sprintf(updateQuery, object)
+	table, idCondition := statusTarget(object, "$2")
+	stmt := fmt.Sprintf(updateQuery, table, idCondition)
 
 	_, err = persist.Exec(stmt, r.timestampGen(), id)
 
@@ -50,7 +52,8 @@ func (r *repository) IsConnected(ctx context.Context, id string, object WithStat
 		return false, errors.Wrap(err, "while loading persistence from context")
 	}
 
-	stmt := fmt.Sprintf(existsQuery, object)
+	table, idCondition := statusTarget(object, "$1")
+	stmt := fmt.Sprintf(existsQuery, table, idCondition)
 
 	var count int
 	err = persist.Get(&count, stmt, id)
@@ -64,3 +67,12 @@ func (r *repository) IsConnected(ctx context.Context, id string, object WithStat
 	return true, nil
 
 }
+
+// statusTarget returns the table holding the status of the object and the condition matching its row.
+// Runtime Contexts do not have a status of their own, so the status of their Runtime is used instead.
+func statusTarget(object WithStatusObject, idPlaceholder string) (WithStatusObject, string) {
+	if object == RuntimeContexts {
+		return Runtimes, fmt.Sprintf(runtimeOfRuntimeContextQuery, idPlaceholder)
+	}
+	return object, idPlaceholder
+}
@@ -61,6 +61,25 @@ func TestRepository_IsConnected(t *testing.T) {
 		assert.True(t, res)
 	})
 
+	t.Run("Success for runtime contexts", func(t *testing.T) {
+
+		//GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT 1 FROM public.runtimes WHERE id = (SELECT runtime_id FROM public.runtime_contexts WHERE id = $1) AND status_condition = 'CONNECTED'`)).
+			WithArgs(testID).
+			WillReturnRows(testdb.RowWhenObjectExist())
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := statusupdate.NewRepository()
+
+		//WHEN
+		res, err := repo.IsConnected(ctx, testID, "runtime_contexts")
+
+		//THEN
+		require.NoError(t, err)
+		assert.True(t, res)
+	})
+
 	t.Run("Error for applications", func(t *testing.T) {
 
 		//GIVEN
@@ -123,6 +142,22 @@ func TestRepository_UpdateStatus(t *testing.T) {
 		//THEN
 		require.NoError(t, err)
 	})
+	t.Run("Success for runtime contexts", func(t *testing.T) {
+
+		//GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET status_condition = 'CONNECTED', status_timestamp = $1 WHERE id = (SELECT runtime_id FROM public.runtime_contexts WHERE id = $2)`)).
+			WithArgs(timestamp, testID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+		ctx := persistence.SaveToContext(context.TODO(), db)
+
+		//WHEN
+		err := repo.UpdateStatus(ctx, testID, "runtime_contexts")
+
+		//THEN
+		require.NoError(t, err)
+	})
 	t.Run("Success for runtimes", func(t *testing.T) {
 
 		//GIVEN
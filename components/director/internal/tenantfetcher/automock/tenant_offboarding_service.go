@@ -0,0 +1,29 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TenantOffboardingService is an autogenerated mock type for the TenantOffboardingService type
+type TenantOffboardingService struct {
+	mock.Mock
+}
+
+// StartMany provides a mock function with given fields: ctx, tenantInputs
+func (_m *TenantOffboardingService) StartMany(ctx context.Context, tenantInputs []model.BusinessTenantMappingInput) error {
+	ret := _m.Called(ctx, tenantInputs)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.BusinessTenantMappingInput) error); ok {
+		r0 = rf(ctx, tenantInputs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
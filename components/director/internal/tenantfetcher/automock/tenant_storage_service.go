@@ -28,20 +28,6 @@ func (_m *TenantStorageService) CreateManyIfNotExists(ctx context.Context, tenan
 	return r0
 }
 
-// DeleteMany provides a mock function with given fields: ctx, tenantInputs
-func (_m *TenantStorageService) DeleteMany(ctx context.Context, tenantInputs []model.BusinessTenantMappingInput) error {
-	ret := _m.Called(ctx, tenantInputs)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []model.BusinessTenantMappingInput) error); ok {
-		r0 = rf(ctx, tenantInputs)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
 // List provides a mock function with given fields: ctx
 func (_m *TenantStorageService) List(ctx context.Context) ([]*model.BusinessTenantMapping, error) {
 	ret := _m.Called(ctx)
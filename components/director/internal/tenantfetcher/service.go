@@ -39,7 +39,11 @@ type QueryConfig struct {
 type TenantStorageService interface {
 	List(ctx context.Context) ([]*model.BusinessTenantMapping, error)
 	CreateManyIfNotExists(ctx context.Context, tenantInputs []model.BusinessTenantMappingInput) error
-	DeleteMany(ctx context.Context, tenantInputs []model.BusinessTenantMappingInput) error
+}
+
+//go:generate mockery -name=TenantOffboardingService -output=automock -outpkg=automock -case=underscore
+type TenantOffboardingService interface {
+	StartMany(ctx context.Context, tenantInputs []model.BusinessTenantMappingInput) error
 }
 
 //go:generate mockery -name=EventAPIClient -output=automock -outpkg=automock -case=underscore
@@ -53,26 +57,28 @@ const (
 )
 
 type Service struct {
-	queryConfig          QueryConfig
-	transact             persistence.Transactioner
-	kubeClient           KubeClient
-	eventAPIClient       EventAPIClient
-	tenantStorageService TenantStorageService
-	providerName         string
-	fieldMapping         TenantFieldMapping
+	queryConfig              QueryConfig
+	transact                 persistence.Transactioner
+	kubeClient               KubeClient
+	eventAPIClient           EventAPIClient
+	tenantStorageService     TenantStorageService
+	tenantOffboardingService TenantOffboardingService
+	providerName             string
+	fieldMapping             TenantFieldMapping
 
 	retryAttempts uint
 }
 
-func NewService(queryConfig QueryConfig, transact persistence.Transactioner, kubeClient KubeClient, fieldMapping TenantFieldMapping, providerName string, client EventAPIClient, tenantStorageService TenantStorageService) *Service {
+func NewService(queryConfig QueryConfig, transact persistence.Transactioner, kubeClient KubeClient, fieldMapping TenantFieldMapping, providerName string, client EventAPIClient, tenantStorageService TenantStorageService, tenantOffboardingService TenantOffboardingService) *Service {
 	return &Service{
-		transact:             transact,
-		kubeClient:           kubeClient,
-		fieldMapping:         fieldMapping,
-		providerName:         providerName,
-		eventAPIClient:       client,
-		tenantStorageService: tenantStorageService,
-		queryConfig:          queryConfig,
+		transact:                 transact,
+		kubeClient:               kubeClient,
+		fieldMapping:             fieldMapping,
+		providerName:             providerName,
+		eventAPIClient:           client,
+		tenantStorageService:     tenantStorageService,
+		tenantOffboardingService: tenantOffboardingService,
+		queryConfig:              queryConfig,
 
 		retryAttempts: retryAttempts,
 	}
@@ -151,9 +157,9 @@ func (s Service) SyncTenants() error {
 	if err != nil {
 		return errors.Wrap(err, "while storing new tenants")
 	}
-	err = s.tenantStorageService.DeleteMany(ctx, tenantsToDelete)
+	err = s.tenantOffboardingService.StartMany(ctx, tenantsToDelete)
 	if err != nil {
-		return errors.Wrap(err, "while removing tenants")
+		return errors.Wrap(err, "while offboarding tenants")
 	}
 
 	err = tx.Commit()
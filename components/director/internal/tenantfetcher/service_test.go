@@ -69,12 +69,13 @@ func TestService_SyncTenants(t *testing.T) {
 	txGen := txtest.NewTransactionContextGenerator(testErr)
 
 	testCases := []struct {
-		Name               string
-		TransactionerFn    func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
-		APIClientFn        func() *automock.EventAPIClient
-		TenantStorageSvcFn func() *automock.TenantStorageService
-		KubeClientFn       func() *automock.KubeClient
-		ExpectedError      error
+		Name                   string
+		TransactionerFn        func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		APIClientFn            func() *automock.EventAPIClient
+		TenantStorageSvcFn     func() *automock.TenantStorageService
+		TenantOffboardingSvcFn func() *automock.TenantOffboardingService
+		KubeClientFn           func() *automock.KubeClient
+		ExpectedError          error
 	}{
 		{
 			Name:            "Success when empty db and single page",
@@ -90,7 +91,11 @@ func TestService_SyncTenants(t *testing.T) {
 				svc := &automock.TenantStorageService{}
 				svc.On("List", txtest.CtxWithDBMatcher()).Return(nil, nil).Once()
 				svc.On("CreateManyIfNotExists", txtest.CtxWithDBMatcher(), emptySlice).Return(nil).Once()
-				svc.On("DeleteMany", txtest.CtxWithDBMatcher(), emptySlice).Return(nil).Once()
+				return svc
+			},
+			TenantOffboardingSvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("StartMany", txtest.CtxWithDBMatcher(), emptySlice).Return(nil).Once()
 				return svc
 			},
 			KubeClientFn: func() *automock.KubeClient {
@@ -117,7 +122,11 @@ func TestService_SyncTenants(t *testing.T) {
 					businessTenants[0].ToBusinessTenantMapping(fixID()),
 				}, nil).Once()
 				svc.On("CreateManyIfNotExists", txtest.CtxWithDBMatcher(), matchArrayWithoutOrderArgument(t, businessTenants[1:])).Return(nil).Once()
-				svc.On("DeleteMany", txtest.CtxWithDBMatcher(), emptySlice).Return(nil).Once()
+				return svc
+			},
+			TenantOffboardingSvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("StartMany", txtest.CtxWithDBMatcher(), emptySlice).Return(nil).Once()
 				return svc
 			},
 			KubeClientFn: func() *automock.KubeClient {
@@ -142,7 +151,11 @@ func TestService_SyncTenants(t *testing.T) {
 				svc := &automock.TenantStorageService{}
 				svc.On("List", txtest.CtxWithDBMatcher()).Return(nil, nil).Once()
 				svc.On("CreateManyIfNotExists", txtest.CtxWithDBMatcher(), matchArrayWithoutOrderArgument(t, businessTenants)).Return(nil).Once()
-				svc.On("DeleteMany", txtest.CtxWithDBMatcher(), emptySlice).Return(nil).Once()
+				return svc
+			},
+			TenantOffboardingSvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("StartMany", txtest.CtxWithDBMatcher(), emptySlice).Return(nil).Once()
 				return svc
 			},
 			KubeClientFn: func() *automock.KubeClient {
@@ -172,7 +185,11 @@ func TestService_SyncTenants(t *testing.T) {
 					businessTenants[0].ToBusinessTenantMapping(fixID()),
 				}, nil).Once()
 				svc.On("CreateManyIfNotExists", txtest.CtxWithDBMatcher(), matchArrayWithoutOrderArgument(t, businessTenants[1:])).Return(nil).Once()
-				svc.On("DeleteMany", txtest.CtxWithDBMatcher(), businessTenants[0:1]).Return(nil).Once()
+				return svc
+			},
+			TenantOffboardingSvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("StartMany", txtest.CtxWithDBMatcher(), businessTenants[0:1]).Return(nil).Once()
 				return svc
 			},
 			KubeClientFn: func() *automock.KubeClient {
@@ -197,7 +214,11 @@ func TestService_SyncTenants(t *testing.T) {
 				svc := &automock.TenantStorageService{}
 				svc.AssertNotCalled(t, "List", txtest.CtxWithDBMatcher())
 				svc.AssertNotCalled(t, "CreateManyIfNotExists", txtest.CtxWithDBMatcher(), mock.Anything)
-				svc.AssertNotCalled(t, "DeleteMany", txtest.CtxWithDBMatcher(), emptySlice)
+				return svc
+			},
+			TenantOffboardingSvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.AssertNotCalled(t, "StartMany", txtest.CtxWithDBMatcher(), emptySlice)
 				return svc
 			},
 			KubeClientFn: func() *automock.KubeClient {
@@ -223,6 +244,10 @@ func TestService_SyncTenants(t *testing.T) {
 				svc := &automock.TenantStorageService{}
 				return svc
 			},
+			TenantOffboardingSvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				return svc
+			},
 			KubeClientFn: func() *automock.KubeClient {
 				client := &automock.KubeClient{}
 				client.On("GetTenantFetcherConfigMapData").Return("1", nil).Once()
@@ -243,6 +268,10 @@ func TestService_SyncTenants(t *testing.T) {
 				svc := &automock.TenantStorageService{}
 				return svc
 			},
+			TenantOffboardingSvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				return svc
+			},
 			KubeClientFn: func() *automock.KubeClient {
 				client := &automock.KubeClient{}
 				client.On("GetTenantFetcherConfigMapData").Return("1", nil).Once()
@@ -264,6 +293,10 @@ func TestService_SyncTenants(t *testing.T) {
 				svc := &automock.TenantStorageService{}
 				return svc
 			},
+			TenantOffboardingSvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				return svc
+			},
 			KubeClientFn: func() *automock.KubeClient {
 				client := &automock.KubeClient{}
 				client.On("GetTenantFetcherConfigMapData").Return("1", nil).Once()
@@ -286,6 +319,10 @@ func TestService_SyncTenants(t *testing.T) {
 				svc := &automock.TenantStorageService{}
 				return svc
 			},
+			TenantOffboardingSvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				return svc
+			},
 			KubeClientFn: func() *automock.KubeClient {
 				client := &automock.KubeClient{}
 				client.On("GetTenantFetcherConfigMapData").Return("1", nil).Once()
@@ -307,6 +344,10 @@ func TestService_SyncTenants(t *testing.T) {
 				svc := &automock.TenantStorageService{}
 				return svc
 			},
+			TenantOffboardingSvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				return svc
+			},
 			KubeClientFn: func() *automock.KubeClient {
 				client := &automock.KubeClient{}
 				client.On("GetTenantFetcherConfigMapData").Return("1", nil).Once()
@@ -328,6 +369,10 @@ func TestService_SyncTenants(t *testing.T) {
 				svc := &automock.TenantStorageService{}
 				return svc
 			},
+			TenantOffboardingSvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				return svc
+			},
 			KubeClientFn: func() *automock.KubeClient {
 				client := &automock.KubeClient{}
 				client.On("GetTenantFetcherConfigMapData").Return("1", nil).Once()
@@ -350,6 +395,10 @@ func TestService_SyncTenants(t *testing.T) {
 				svc := &automock.TenantStorageService{}
 				return svc
 			},
+			TenantOffboardingSvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				return svc
+			},
 			KubeClientFn: func() *automock.KubeClient {
 				client := &automock.KubeClient{}
 				client.On("GetTenantFetcherConfigMapData").Return("1", nil).Once()
@@ -372,7 +421,11 @@ func TestService_SyncTenants(t *testing.T) {
 				svc := &automock.TenantStorageService{}
 				svc.On("List", txtest.CtxWithDBMatcher()).Return(nil, nil).Once()
 				svc.On("CreateManyIfNotExists", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil).Once()
-				svc.On("DeleteMany", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil).Once()
+				return svc
+			},
+			TenantOffboardingSvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("StartMany", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil).Once()
 				return svc
 			},
 			KubeClientFn: func() *automock.KubeClient {
@@ -397,7 +450,11 @@ func TestService_SyncTenants(t *testing.T) {
 				svc := &automock.TenantStorageService{}
 				svc.On("List", txtest.CtxWithDBMatcher()).Return(nil, nil).Once()
 				svc.On("CreateManyIfNotExists", txtest.CtxWithDBMatcher(), mock.Anything).Return(testErr).Once()
-				svc.AssertNotCalled(t, "DeleteMany")
+				return svc
+			},
+			TenantOffboardingSvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.AssertNotCalled(t, "StartMany")
 				return svc
 			},
 			KubeClientFn: func() *automock.KubeClient {
@@ -409,7 +466,7 @@ func TestService_SyncTenants(t *testing.T) {
 			ExpectedError: testErr,
 		},
 		{
-			Name:            "Error when couldn't delete",
+			Name:            "Error when couldn't offboard",
 			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			APIClientFn: func() *automock.EventAPIClient {
 				client := &automock.EventAPIClient{}
@@ -422,7 +479,11 @@ func TestService_SyncTenants(t *testing.T) {
 				svc := &automock.TenantStorageService{}
 				svc.On("List", txtest.CtxWithDBMatcher()).Return(nil, nil).Once()
 				svc.On("CreateManyIfNotExists", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil).Once()
-				svc.On("DeleteMany", txtest.CtxWithDBMatcher(), mock.Anything).Return(testErr).Once()
+				return svc
+			},
+			TenantOffboardingSvcFn: func() *automock.TenantOffboardingService {
+				svc := &automock.TenantOffboardingService{}
+				svc.On("StartMany", txtest.CtxWithDBMatcher(), mock.Anything).Return(testErr).Once()
 				return svc
 			},
 			KubeClientFn: func() *automock.KubeClient {
@@ -441,6 +502,7 @@ func TestService_SyncTenants(t *testing.T) {
 			persist, transact := testCase.TransactionerFn()
 			apiClient := testCase.APIClientFn()
 			tenantStorageSvc := testCase.TenantStorageSvcFn()
+			tenantOffboardingSvc := testCase.TenantOffboardingSvcFn()
 			kubeClient := testCase.KubeClientFn()
 			svc := tenantfetcher.NewService(tenantfetcher.QueryConfig{
 				PageNumField:   "pageNum",
@@ -457,7 +519,7 @@ func TestService_SyncTenants(t *testing.T) {
 				NameField:          "name",
 				TotalPagesField:    "pages",
 				TotalResultsField:  "total",
-			}, provider, apiClient, tenantStorageSvc)
+			}, provider, apiClient, tenantStorageSvc, tenantOffboardingSvc)
 			svc.SetRetryAttempts(1)
 
 			// WHEN
@@ -475,6 +537,7 @@ func TestService_SyncTenants(t *testing.T) {
 			transact.AssertExpectations(t)
 			apiClient.AssertExpectations(t)
 			tenantStorageSvc.AssertExpectations(t)
+			tenantOffboardingSvc.AssertExpectations(t)
 			kubeClient.AssertExpectations(t)
 		})
 	}
@@ -491,7 +554,8 @@ func TestService_SyncTenants(t *testing.T) {
 		tenantStorageSvc := &automock.TenantStorageService{}
 		tenantStorageSvc.On("List", txtest.CtxWithDBMatcher()).Return(nil, nil).Once()
 		tenantStorageSvc.On("CreateManyIfNotExists", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil).Once()
-		tenantStorageSvc.On("DeleteMany", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil).Once()
+		tenantOffboardingSvc := &automock.TenantOffboardingService{}
+		tenantOffboardingSvc.On("StartMany", txtest.CtxWithDBMatcher(), mock.Anything).Return(nil).Once()
 		kubeClient := &automock.KubeClient{}
 		kubeClient.On("GetTenantFetcherConfigMapData").Return("1", nil).Once()
 		kubeClient.On("UpdateTenantFetcherConfigMapData", mock.Anything).Return(nil).Once()
@@ -511,7 +575,7 @@ func TestService_SyncTenants(t *testing.T) {
 			NameField:          "name",
 			TotalPagesField:    "pages",
 			TotalResultsField:  "total",
-		}, provider, apiClient, tenantStorageSvc)
+		}, provider, apiClient, tenantStorageSvc, tenantOffboardingSvc)
 
 		// WHEN
 		err := svc.SyncTenants()
@@ -523,6 +587,7 @@ func TestService_SyncTenants(t *testing.T) {
 		transact.AssertExpectations(t)
 		apiClient.AssertExpectations(t)
 		tenantStorageSvc.AssertExpectations(t)
+		tenantOffboardingSvc.AssertExpectations(t)
 		kubeClient.AssertExpectations(t)
 	})
 }
@@ -14,6 +14,29 @@ type TenantRepository struct {
 	mock.Mock
 }
 
+// Get provides a mock function with given fields: ctx, id
+func (_m *TenantRepository) Get(ctx context.Context, id string) (*model.BusinessTenantMapping, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *model.BusinessTenantMapping
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.BusinessTenantMapping); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BusinessTenantMapping)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByExternalTenant provides a mock function with given fields: ctx, externalTenant
 func (_m *TenantRepository) GetByExternalTenant(ctx context.Context, externalTenant string) (*model.BusinessTenantMapping, error) {
 	ret := _m.Called(ctx, externalTenant)
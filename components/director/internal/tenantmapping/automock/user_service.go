@@ -0,0 +1,60 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserService is an autogenerated mock type for the UserService type
+type UserService struct {
+	mock.Mock
+}
+
+// GetUserGroupScopes provides a mock function with given fields: ctx, groupNames, externalTenant
+func (_m *UserService) GetUserGroupScopes(ctx context.Context, groupNames []string, externalTenant string) ([]string, error) {
+	ret := _m.Called(ctx, groupNames, externalTenant)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string) []string); ok {
+		r0 = rf(ctx, groupNames, externalTenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string) error); ok {
+		r1 = rf(ctx, groupNames, externalTenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserScopes provides a mock function with given fields: ctx, username, externalTenant
+func (_m *UserService) GetUserScopes(ctx context.Context, username string, externalTenant string) ([]string, error) {
+	ret := _m.Called(ctx, username, externalTenant)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []string); ok {
+		r0 = rf(ctx, username, externalTenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, username, externalTenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -40,9 +40,16 @@ type ObjectContextProvider interface {
 
 //go:generate mockery -name=TenantRepository -output=automock -outpkg=automock -case=underscore
 type TenantRepository interface {
+	Get(ctx context.Context, id string) (*model.BusinessTenantMapping, error)
 	GetByExternalTenant(ctx context.Context, externalTenant string) (*model.BusinessTenantMapping, error)
 }
 
+//go:generate mockery -name=UserService -output=automock -outpkg=automock -case=underscore
+type UserService interface {
+	GetUserScopes(ctx context.Context, username, externalTenant string) ([]string, error)
+	GetUserGroupScopes(ctx context.Context, groupNames []string, externalTenant string) ([]string, error)
+}
+
 type Handler struct {
 	authenticators         []authenticator.Config
 	reqDataParser          ReqDataParser
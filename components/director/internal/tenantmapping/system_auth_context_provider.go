@@ -49,7 +49,7 @@ func (m *systemAuthContextProvider) GetObjectContext(ctx context.Context, reqDat
 	switch refObjType {
 	case model.IntegrationSystemReference:
 		tenantCtx, scopes, err = m.getTenantAndScopesForIntegrationSystem(ctx, reqData)
-	case model.RuntimeReference, model.ApplicationReference:
+	case model.RuntimeReference, model.RuntimeContextReference, model.ApplicationReference:
 		tenantCtx, scopes, err = m.getTenantAndScopesForApplicationOrRuntime(ctx, sysAuth, refObjType, reqData, authDetails.AuthFlow)
 	default:
 		return ObjectContext{}, errors.Errorf("unsupported reference object type (%s)", refObjType)
@@ -145,6 +145,16 @@ func (m *systemAuthContextProvider) getTenantAndScopesForApplicationOrRuntime(ct
 		}
 		log.C(ctx).Warningf("Could not get tenant external id, error: %s", err.Error())
 
+		if _, err := m.tenantRepo.Get(ctx, *sysAuth.TenantID); err != nil {
+			if apperrors.IsNotFoundError(err) {
+				log.C(ctx).Warningf("Tenant with ID %s does not exist or is deactivated", *sysAuth.TenantID)
+
+				log.C(ctx).Info("Returning tenant context with empty internal tenant ID...")
+				return NewTenantContext("", ""), scopes, nil
+			}
+			return TenantContext{}, scopes, errors.Wrapf(err, "while getting tenant mapping [TenantId=%s]", *sysAuth.TenantID)
+		}
+
 		log.C(ctx).Infof("Returning context with empty external tenant ID and internal tenant id: %s", *sysAuth.TenantID)
 		return NewTenantContext("", *sysAuth.TenantID), scopes, nil
 	}
@@ -16,6 +16,8 @@ import (
 	systemauthmock "github.com/kyma-incubator/compass/components/director/internal/domain/systemauth/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	tenantmappingmock "github.com/kyma-incubator/compass/components/director/internal/tenantmapping/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/stretchr/testify/mock"
 )
@@ -39,7 +41,10 @@ func TestSystemAuthContextProvider(t *testing.T) {
 		scopesGetterMock := getScopesGetterMock()
 		scopesGetterMock.On("GetRequiredScopes", "clientCredentialsRegistrationScopes.application").Return(expectedScopes, nil).Once()
 
-		provider := tenantmapping.NewSystemAuthContextProvider(systemAuthSvcMock, scopesGetterMock, nil)
+		tenantRepoMock := getTenantRepositoryMock()
+		tenantRepoMock.On("Get", mock.Anything, expectedTenantID.String()).Return(&model.BusinessTenantMapping{ID: expectedTenantID.String()}, nil).Once()
+
+		provider := tenantmapping.NewSystemAuthContextProvider(systemAuthSvcMock, scopesGetterMock, tenantRepoMock)
 		authDetails := oathkeeper.AuthDetails{AuthID: authID.String(), AuthFlow: oathkeeper.CertificateFlow}
 
 		objCtx, err := provider.GetObjectContext(context.TODO(), reqData, authDetails)
@@ -51,7 +56,70 @@ func TestSystemAuthContextProvider(t *testing.T) {
 		require.Equal(t, refObjID.String(), objCtx.ConsumerID)
 		require.Equal(t, "Application", string(objCtx.ConsumerType))
 
-		mock.AssertExpectationsForObjects(t, systemAuthSvcMock, scopesGetterMock)
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock, scopesGetterMock, tenantRepoMock)
+	})
+
+	t.Run("returns empty tenant when the tenant defined in SystemAuth is deactivated in the Application or Runtime SystemAuth case", func(t *testing.T) {
+		authID := uuid.New()
+		refObjID := uuid.New()
+		tenantID := uuid.New()
+		expectedScopes := []string{"application:read"}
+		sysAuth := &model.SystemAuth{
+			ID:       authID.String(),
+			TenantID: str.Ptr(tenantID.String()),
+			AppID:    str.Ptr(refObjID.String()),
+		}
+		reqData := oathkeeper.ReqData{}
+
+		systemAuthSvcMock := getSystemAuthSvcMock()
+		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
+
+		scopesGetterMock := getScopesGetterMock()
+		scopesGetterMock.On("GetRequiredScopes", "clientCredentialsRegistrationScopes.application").Return(expectedScopes, nil).Once()
+
+		tenantRepoMock := getTenantRepositoryMock()
+		tenantRepoMock.On("Get", mock.Anything, tenantID.String()).Return(nil, apperrors.NewNotFoundError(resource.Tenant, tenantID.String())).Once()
+
+		provider := tenantmapping.NewSystemAuthContextProvider(systemAuthSvcMock, scopesGetterMock, tenantRepoMock)
+		authDetails := oathkeeper.AuthDetails{AuthID: authID.String(), AuthFlow: oathkeeper.CertificateFlow}
+
+		objCtx, err := provider.GetObjectContext(context.TODO(), reqData, authDetails)
+
+		require.NoError(t, err)
+		require.Equal(t, "", objCtx.TenantID)
+		require.Equal(t, "", objCtx.ExternalTenantID)
+
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock, scopesGetterMock, tenantRepoMock)
+	})
+
+	t.Run("returns error when unable to get the tenant defined in SystemAuth in the Application or Runtime SystemAuth case", func(t *testing.T) {
+		authID := uuid.New()
+		refObjID := uuid.New()
+		tenantID := uuid.New()
+		sysAuth := &model.SystemAuth{
+			ID:       authID.String(),
+			TenantID: str.Ptr(tenantID.String()),
+			AppID:    str.Ptr(refObjID.String()),
+		}
+		reqData := oathkeeper.ReqData{}
+
+		systemAuthSvcMock := getSystemAuthSvcMock()
+		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
+
+		scopesGetterMock := getScopesGetterMock()
+		scopesGetterMock.On("GetRequiredScopes", "clientCredentialsRegistrationScopes.application").Return([]string{"application:read"}, nil).Once()
+
+		tenantRepoMock := getTenantRepositoryMock()
+		tenantRepoMock.On("Get", mock.Anything, tenantID.String()).Return(nil, errors.New("some-error")).Once()
+
+		provider := tenantmapping.NewSystemAuthContextProvider(systemAuthSvcMock, scopesGetterMock, tenantRepoMock)
+		authDetails := oathkeeper.AuthDetails{AuthID: authID.String(), AuthFlow: oathkeeper.CertificateFlow}
+
+		_, err := provider.GetObjectContext(context.TODO(), reqData, authDetails)
+
+		require.EqualError(t, err, fmt.Sprintf("while fetching the tenant and scopes for system auth with id: %s, object type: Application, using auth flow: Certificate: while getting tenant mapping [TenantId=%s]: some-error", sysAuth.ID, tenantID))
+
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock, scopesGetterMock, tenantRepoMock)
 	})
 
 	t.Run("returns tenant and scopes from the ReqData in the Integration System SystemAuth case", func(t *testing.T) {
@@ -119,7 +187,10 @@ func TestSystemAuthContextProvider(t *testing.T) {
 		systemAuthSvcMock := getSystemAuthSvcMock()
 		systemAuthSvcMock.On("GetGlobal", mock.Anything, authID.String()).Return(sysAuth, nil).Once()
 
-		provider := tenantmapping.NewSystemAuthContextProvider(systemAuthSvcMock, nil, nil)
+		tenantRepoMock := getTenantRepositoryMock()
+		tenantRepoMock.On("Get", mock.Anything, expectedTenantID.String()).Return(&model.BusinessTenantMapping{ID: expectedTenantID.String()}, nil).Once()
+
+		provider := tenantmapping.NewSystemAuthContextProvider(systemAuthSvcMock, nil, tenantRepoMock)
 		authDetails := oathkeeper.AuthDetails{AuthID: authID.String(), AuthFlow: oathkeeper.OAuth2Flow}
 
 		objCtx, err := provider.GetObjectContext(context.TODO(), reqData, authDetails)
@@ -131,7 +202,7 @@ func TestSystemAuthContextProvider(t *testing.T) {
 		require.Equal(t, refObjID.String(), objCtx.ConsumerID)
 		require.Equal(t, "Application", string(objCtx.ConsumerType))
 
-		mock.AssertExpectationsForObjects(t, systemAuthSvcMock)
+		mock.AssertExpectationsForObjects(t, systemAuthSvcMock, tenantRepoMock)
 	})
 
 	t.Run("returns error when unable to get SystemAuth from the service", func(t *testing.T) {
@@ -15,49 +15,50 @@ import (
 	"github.com/pkg/errors"
 )
 
-func NewUserContextProvider(staticUserRepo StaticUserRepository, staticGroupRepo StaticGroupRepository, tenantRepo TenantRepository) *userContextProvider {
+func NewUserContextProvider(userSvc UserService, tenantRepo TenantRepository) *userContextProvider {
 	return &userContextProvider{
-		staticUserRepo:  staticUserRepo,
-		staticGroupRepo: staticGroupRepo,
-		tenantRepo:      tenantRepo,
+		userSvc:    userSvc,
+		tenantRepo: tenantRepo,
 	}
 }
 
 type userContextProvider struct {
-	staticUserRepo  StaticUserRepository
-	staticGroupRepo StaticGroupRepository
-	tenantRepo      TenantRepository
+	userSvc    UserService
+	tenantRepo TenantRepository
 }
 
 func (m *userContextProvider) GetObjectContext(ctx context.Context, reqData oathkeeper.ReqData, authDetails oathkeeper.AuthDetails) (ObjectContext, error) {
-	var externalTenantID, scopes string
-	var staticUser *StaticUser
-	var err error
-
 	logger := log.C(ctx).WithFields(logrus.Fields{
 		"consumer_type": consumer.User,
 	})
 
 	ctx = log.ContextWithLogger(ctx, logger)
 
+	externalTenantID, err := reqData.GetExternalTenantID()
+	if err != nil {
+		if !apperrors.IsKeyDoesNotExist(err) {
+			return ObjectContext{}, errors.Wrapf(err, "could not parse external ID for user: %s", authDetails.AuthID)
+		}
+		log.C(ctx).Warningf("Could not get tenant external id, error: %s", err.Error())
+		externalTenantID = ""
+	}
+
 	log.C(ctx).Info("Getting scopes from groups")
-	scopes = m.getScopesForUserGroups(ctx, reqData)
+	scopes, err := m.getScopesForUserGroups(ctx, reqData, externalTenantID)
+	if err != nil {
+		return ObjectContext{}, err
+	}
+
 	if !hasScopes(scopes) {
 		log.C(ctx).Info("No scopes found from groups, getting user data")
 
-		staticUser, scopes, err = m.getUserData(ctx, reqData, authDetails.AuthID)
+		scopes, err = m.getScopesForUser(ctx, reqData, authDetails.AuthID, externalTenantID)
 		if err != nil {
 			return ObjectContext{}, errors.Wrapf(err, "while getting user data for user: %s", authDetails.AuthID)
 		}
 	}
 
-	externalTenantID, err = reqData.GetExternalTenantID()
-	if err != nil {
-		if !apperrors.IsKeyDoesNotExist(err) {
-			return ObjectContext{}, errors.Wrapf(err, "could not parse external ID for user: %s", authDetails.AuthID)
-		}
-		log.C(ctx).Warningf("Could not get tenant external id, error: %s", err.Error())
-
+	if externalTenantID == "" {
 		log.C(ctx).Info("Could not create tenant context, returning empty context...")
 		return NewObjectContext(TenantContext{}, scopes, authDetails.AuthID, consumer.User), nil
 	}
@@ -74,61 +75,54 @@ func (m *userContextProvider) GetObjectContext(ctx context.Context, reqData oath
 		return ObjectContext{}, errors.Wrapf(err, "while getting external tenant mapping [ExternalTenantId=%s]", externalTenantID)
 	}
 
-	if staticUser != nil && !hasValidTenant(staticUser.Tenants, tenantMapping.ExternalTenant) {
-		return ObjectContext{}, apperrors.NewInternalError(fmt.Sprintf("Static tenant with username: %s missmatch external tenant: %s", staticUser.Username, tenantMapping.ExternalTenant))
-	}
-
 	objCtx := NewObjectContext(NewTenantContext(externalTenantID, tenantMapping.ID), scopes, authDetails.AuthID, consumer.User)
 	log.C(ctx).Infof("Successfully got object context: %+v", objCtx)
 
 	return objCtx, nil
 }
 
-func (m *userContextProvider) getScopesForUserGroups(ctx context.Context, reqData oathkeeper.ReqData) string {
+// getScopesForUserGroups returns scopes of the roles bound to the user groups from the request in the given tenant
+func (m *userContextProvider) getScopesForUserGroups(ctx context.Context, reqData oathkeeper.ReqData, externalTenantID string) (string, error) {
 	userGroups := reqData.GetUserGroups()
 	if len(userGroups) == 0 {
-		return ""
+		return "", nil
 	}
 	log.C(ctx).Debugf("Found user groups: %s", strings.Join(userGroups, " "))
 
-	staticGroups := m.staticGroupRepo.Get(ctx, userGroups)
-	if len(staticGroups) == 0 {
-		return ""
+	groupScopes, err := m.userSvc.GetUserGroupScopes(ctx, userGroups, externalTenantID)
+	if err != nil {
+		return "", errors.Wrap(err, "while getting scopes of user groups")
 	}
 
-	scopes := staticGroups.GetGroupScopes()
+	scopes := strings.Join(groupScopes, " ")
 	log.C(ctx).Debugf("Found scopes: %s", scopes)
 
-	return scopes
+	return scopes, nil
 }
 
-func (m *userContextProvider) getUserData(ctx context.Context, reqData oathkeeper.ReqData, username string) (*StaticUser, string, error) {
-	staticUser, err := m.staticUserRepo.Get(username)
+// getScopesForUser returns scopes from the request, or scopes of the roles bound to the user in the given tenant if the request has none.
+// The user must have at least one role bound in the given tenant in both cases.
+func (m *userContextProvider) getScopesForUser(ctx context.Context, reqData oathkeeper.ReqData, username, externalTenantID string) (string, error) {
+	userScopes, err := m.userSvc.GetUserScopes(ctx, username, externalTenantID)
 	if err != nil {
-		return nil, "", errors.Wrapf(err, "while searching for a static user with username %s", username)
+		return "", errors.Wrapf(err, "while getting scopes of user with username %s", username)
+	}
+	log.C(ctx).Debugf("Found user with name %s and scopes: %s", username, userScopes)
+
+	if externalTenantID != "" && len(userScopes) == 0 {
+		return "", apperrors.NewInternalError(fmt.Sprintf("User with username: %s has no roles bound in external tenant: %s", username, externalTenantID))
 	}
-	log.C(ctx).Debugf("Found static user with name %s and tenants: %s", staticUser.Username, staticUser.Tenants)
 
 	scopes, err := reqData.GetScopes()
 	if err != nil {
 		if !apperrors.IsKeyDoesNotExist(err) {
-			return nil, "", errors.Wrap(err, "while fetching scopes")
+			return "", errors.Wrap(err, "while fetching scopes")
 		}
-		scopes = strings.Join(staticUser.Scopes, " ")
+		scopes = strings.Join(userScopes, " ")
 	}
 	log.C(ctx).Debugf("Found scopes: %s", scopes)
 
-	return &staticUser, scopes, nil
-}
-
-func hasValidTenant(assignedTenants []string, tenant string) bool {
-	for _, assignedTenant := range assignedTenants {
-		if assignedTenant == tenant {
-			return true
-		}
-	}
-
-	return false
+	return scopes, nil
 }
 
 func hasScopes(scopes string) bool {
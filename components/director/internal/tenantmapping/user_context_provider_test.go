@@ -39,24 +39,18 @@ func TestUserContextProvider(t *testing.T) {
 				},
 			},
 		}
-		staticUser := tenantmapping.StaticUser{
-			Username: username,
-			Tenants:  []string{expectedExternalTenantID.String()},
-			Scopes:   expectedScopes,
-		}
-
 		tenantMappingModel := &model.BusinessTenantMapping{
 			ID:             expectedTenantID.String(),
 			ExternalTenant: expectedExternalTenantID.String(),
 		}
 
-		staticUserRepoMock := getStaticUserRepoMock()
-		staticUserRepoMock.On("Get", username).Return(staticUser, nil).Once()
+		userSvcMock := getUserServiceMock()
+		userSvcMock.On("GetUserScopes", mock.Anything, username, expectedExternalTenantID.String()).Return(expectedScopes, nil).Once()
 
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
 
-		provider := tenantmapping.NewUserContextProvider(staticUserRepoMock, nil, tenantRepoMock)
+		provider := tenantmapping.NewUserContextProvider(userSvcMock, tenantRepoMock)
 
 		objCtx, err := provider.GetObjectContext(context.TODO(), reqData, jwtAuthDetails)
 
@@ -66,7 +60,7 @@ func TestUserContextProvider(t *testing.T) {
 		require.Equal(t, username, objCtx.ConsumerID)
 		require.Equal(t, userObjCtxType, string(objCtx.ConsumerType))
 
-		mock.AssertExpectationsForObjects(t, staticUserRepoMock, tenantRepoMock)
+		mock.AssertExpectationsForObjects(t, userSvcMock, tenantRepoMock)
 	})
 
 	t.Run("returns tenant and scopes that are defined in the Header map of ReqData", func(t *testing.T) {
@@ -78,23 +72,18 @@ func TestUserContextProvider(t *testing.T) {
 				},
 			},
 		}
-		staticUser := tenantmapping.StaticUser{
-			Username: username,
-			Tenants:  []string{expectedExternalTenantID.String()},
-			Scopes:   expectedScopes,
-		}
 		tenantMappingModel := &model.BusinessTenantMapping{
 			ID:             expectedTenantID.String(),
 			ExternalTenant: expectedExternalTenantID.String(),
 		}
 
-		staticUserRepoMock := getStaticUserRepoMock()
-		staticUserRepoMock.On("Get", username).Return(staticUser, nil).Once()
+		userSvcMock := getUserServiceMock()
+		userSvcMock.On("GetUserScopes", mock.Anything, username, expectedExternalTenantID.String()).Return(expectedScopes, nil).Once()
 
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
 
-		provider := tenantmapping.NewUserContextProvider(staticUserRepoMock, nil, tenantRepoMock)
+		provider := tenantmapping.NewUserContextProvider(userSvcMock, tenantRepoMock)
 
 		objCtx, err := provider.GetObjectContext(context.TODO(), reqData, jwtAuthDetails)
 
@@ -104,7 +93,7 @@ func TestUserContextProvider(t *testing.T) {
 		require.Equal(t, username, objCtx.ConsumerID)
 		require.Equal(t, userObjCtxType, string(objCtx.ConsumerType))
 
-		mock.AssertExpectationsForObjects(t, staticUserRepoMock, tenantRepoMock)
+		mock.AssertExpectationsForObjects(t, userSvcMock, tenantRepoMock)
 	})
 
 	t.Run("returns tenant which is defined in the Extra map and scopes which is defined in the Header map of ReqData", func(t *testing.T) {
@@ -118,23 +107,18 @@ func TestUserContextProvider(t *testing.T) {
 				},
 			},
 		}
-		staticUser := tenantmapping.StaticUser{
-			Username: username,
-			Tenants:  []string{expectedExternalTenantID.String()},
-			Scopes:   expectedScopes,
-		}
 		tenantMappingModel := &model.BusinessTenantMapping{
 			ID:             expectedTenantID.String(),
 			ExternalTenant: expectedExternalTenantID.String(),
 		}
 
-		staticUserRepoMock := getStaticUserRepoMock()
-		staticUserRepoMock.On("Get", username).Return(staticUser, nil).Once()
+		userSvcMock := getUserServiceMock()
+		userSvcMock.On("GetUserScopes", mock.Anything, username, expectedExternalTenantID.String()).Return(expectedScopes, nil).Once()
 
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
 
-		provider := tenantmapping.NewUserContextProvider(staticUserRepoMock, nil, tenantRepoMock)
+		provider := tenantmapping.NewUserContextProvider(userSvcMock, tenantRepoMock)
 
 		objCtx, err := provider.GetObjectContext(context.TODO(), reqData, jwtAuthDetails)
 
@@ -144,7 +128,7 @@ func TestUserContextProvider(t *testing.T) {
 		require.Equal(t, username, objCtx.ConsumerID)
 		require.Equal(t, userObjCtxType, string(objCtx.ConsumerType))
 
-		mock.AssertExpectationsForObjects(t, staticUserRepoMock, tenantRepoMock)
+		mock.AssertExpectationsForObjects(t, userSvcMock, tenantRepoMock)
 	})
 
 	t.Run("returns tenant which is defined in the Header map and scopes which is defined in the Extra map of ReqData", func(t *testing.T) {
@@ -158,23 +142,18 @@ func TestUserContextProvider(t *testing.T) {
 				},
 			},
 		}
-		staticUser := tenantmapping.StaticUser{
-			Username: username,
-			Tenants:  []string{expectedExternalTenantID.String()},
-			Scopes:   expectedScopes,
-		}
 		tenantMappingModel := &model.BusinessTenantMapping{
 			ID:             expectedTenantID.String(),
 			ExternalTenant: expectedExternalTenantID.String(),
 		}
 
-		staticUserRepoMock := getStaticUserRepoMock()
-		staticUserRepoMock.On("Get", username).Return(staticUser, nil).Once()
+		userSvcMock := getUserServiceMock()
+		userSvcMock.On("GetUserScopes", mock.Anything, username, expectedExternalTenantID.String()).Return(expectedScopes, nil).Once()
 
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
 
-		provider := tenantmapping.NewUserContextProvider(staticUserRepoMock, nil, tenantRepoMock)
+		provider := tenantmapping.NewUserContextProvider(userSvcMock, tenantRepoMock)
 
 		objCtx, err := provider.GetObjectContext(context.TODO(), reqData, jwtAuthDetails)
 
@@ -184,10 +163,10 @@ func TestUserContextProvider(t *testing.T) {
 		require.Equal(t, username, objCtx.ConsumerID)
 		require.Equal(t, userObjCtxType, string(objCtx.ConsumerType))
 
-		mock.AssertExpectationsForObjects(t, staticUserRepoMock, tenantRepoMock)
+		mock.AssertExpectationsForObjects(t, userSvcMock, tenantRepoMock)
 	})
 
-	t.Run("returns scopes defined on the StaticUser and tenant from the request", func(t *testing.T) {
+	t.Run("returns scopes of the roles bound to the user and tenant from the request", func(t *testing.T) {
 		reqData := oathkeeper.ReqData{
 			Body: oathkeeper.ReqBody{
 				Extra: map[string]interface{}{
@@ -195,23 +174,18 @@ func TestUserContextProvider(t *testing.T) {
 				},
 			},
 		}
-		staticUser := tenantmapping.StaticUser{
-			Username: username,
-			Tenants:  []string{expectedExternalTenantID.String()},
-			Scopes:   expectedScopes,
-		}
 		tenantMappingModel := &model.BusinessTenantMapping{
 			ID:             expectedTenantID.String(),
 			ExternalTenant: expectedExternalTenantID.String(),
 		}
 
-		staticUserRepoMock := getStaticUserRepoMock()
-		staticUserRepoMock.On("Get", username).Return(staticUser, nil).Once()
+		userSvcMock := getUserServiceMock()
+		userSvcMock.On("GetUserScopes", mock.Anything, username, expectedExternalTenantID.String()).Return(expectedScopes, nil).Once()
 
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
 
-		provider := tenantmapping.NewUserContextProvider(staticUserRepoMock, nil, tenantRepoMock)
+		provider := tenantmapping.NewUserContextProvider(userSvcMock, tenantRepoMock)
 
 		objCtx, err := provider.GetObjectContext(context.TODO(), reqData, jwtAuthDetails)
 
@@ -221,10 +195,9 @@ func TestUserContextProvider(t *testing.T) {
 		require.Equal(t, username, objCtx.ConsumerID)
 		require.Equal(t, userObjCtxType, string(objCtx.ConsumerType))
 
-		mock.AssertExpectationsForObjects(t, staticUserRepoMock, tenantRepoMock)
+		mock.AssertExpectationsForObjects(t, userSvcMock, tenantRepoMock)
 	})
-
-	t.Run("returns scopes defined on the StaticGroup from the request", func(t *testing.T) {
+	t.Run("returns scopes of the roles bound to the user group from the request", func(t *testing.T) {
 		groupName := "test"
 		expectedGroupScopes := []string{"tennants:read", "application:read"}
 
@@ -236,34 +209,18 @@ func TestUserContextProvider(t *testing.T) {
 				},
 			},
 		}
-		staticGroups := tenantmapping.StaticGroups{
-			{
-				GroupName: groupName,
-				Scopes:    expectedGroupScopes,
-			},
-		}
-
-		staticUser := tenantmapping.StaticUser{
-			Username: username,
-			Tenants:  []string{expectedExternalTenantID.String()},
-			Scopes:   expectedScopes,
-		}
-
 		tenantMappingModel := &model.BusinessTenantMapping{
 			ID:             expectedTenantID.String(),
 			ExternalTenant: expectedExternalTenantID.String(),
 		}
 
-		staticGroupRepoMock := getStaticGroupRepoMock()
-		staticGroupRepoMock.On("Get", mock.Anything, []string{groupName}).Return(staticGroups, nil).Once()
-
-		staticUserRepoMock := getStaticUserRepoMock()
-		staticUserRepoMock.On("Get", username).Return(staticUser, nil).Once()
+		userSvcMock := getUserServiceMock()
+		userSvcMock.On("GetUserGroupScopes", mock.Anything, []string{groupName}, expectedExternalTenantID.String()).Return(expectedGroupScopes, nil).Once()
 
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
 
-		provider := tenantmapping.NewUserContextProvider(staticUserRepoMock, staticGroupRepoMock, tenantRepoMock)
+		provider := tenantmapping.NewUserContextProvider(userSvcMock, tenantRepoMock)
 
 		objCtx, err := provider.GetObjectContext(context.TODO(), reqData, jwtAuthDetails)
 
@@ -273,14 +230,12 @@ func TestUserContextProvider(t *testing.T) {
 		require.Equal(t, username, objCtx.ConsumerID)
 		require.Equal(t, userObjCtxType, string(objCtx.ConsumerType))
 
-		mock.AssertExpectationsForObjects(t, staticGroupRepoMock, tenantRepoMock)
+		mock.AssertExpectationsForObjects(t, userSvcMock, tenantRepoMock)
 	})
 
-	t.Run("returns all unique scopes defined on the StaticGroups from the request", func(t *testing.T) {
+	t.Run("returns scopes of the roles bound to all user groups from the request", func(t *testing.T) {
 		groupName1 := "test"
 		groupName2 := "test2"
-		expectedGroupScopes := []string{"tennants:read", "application:read"}
-		expectedGroupScopes2 := []string{"application:read", "applications:edit"}
 		allExpectedGroupScopes := []string{"tennants:read", "application:read", "applications:edit"}
 
 		reqData := oathkeeper.ReqData{
@@ -291,28 +246,18 @@ func TestUserContextProvider(t *testing.T) {
 				},
 			},
 		}
-		staticGroups := tenantmapping.StaticGroups{
-			{
-				GroupName: groupName1,
-				Scopes:    expectedGroupScopes,
-			}, {
-				GroupName: groupName2,
-				Scopes:    expectedGroupScopes2,
-			},
-		}
-
 		tenantMappingModel := &model.BusinessTenantMapping{
 			ID:             expectedTenantID.String(),
 			ExternalTenant: expectedExternalTenantID.String(),
 		}
 
-		staticGroupRepoMock := getStaticGroupRepoMock()
-		staticGroupRepoMock.On("Get", mock.Anything, []string{groupName1, groupName2}).Return(staticGroups, nil).Once()
+		userSvcMock := getUserServiceMock()
+		userSvcMock.On("GetUserGroupScopes", mock.Anything, []string{groupName1, groupName2}, expectedExternalTenantID.String()).Return(allExpectedGroupScopes, nil).Once()
 
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
 
-		provider := tenantmapping.NewUserContextProvider(nil, staticGroupRepoMock, tenantRepoMock)
+		provider := tenantmapping.NewUserContextProvider(userSvcMock, tenantRepoMock)
 
 		objCtx, err := provider.GetObjectContext(context.TODO(), reqData, jwtAuthDetails)
 
@@ -322,10 +267,10 @@ func TestUserContextProvider(t *testing.T) {
 		require.Equal(t, username, objCtx.ConsumerID)
 		require.Equal(t, userObjCtxType, string(objCtx.ConsumerType))
 
-		mock.AssertExpectationsForObjects(t, staticGroupRepoMock, tenantRepoMock)
+		mock.AssertExpectationsForObjects(t, userSvcMock, tenantRepoMock)
 	})
 
-	t.Run("returns scopes defined on the StaticUser when group not present from the request", func(t *testing.T) {
+	t.Run("returns scopes of the roles bound to the user when user groups from the request have none", func(t *testing.T) {
 		groupName := "test"
 		reqData := oathkeeper.ReqData{
 			Body: oathkeeper.ReqBody{
@@ -335,29 +280,19 @@ func TestUserContextProvider(t *testing.T) {
 				},
 			},
 		}
-		var staticGroups tenantmapping.StaticGroups
-
-		staticUser := tenantmapping.StaticUser{
-			Username: username,
-			Tenants:  []string{expectedExternalTenantID.String()},
-			Scopes:   expectedScopes,
-		}
-
 		tenantMappingModel := &model.BusinessTenantMapping{
 			ID:             expectedTenantID.String(),
 			ExternalTenant: expectedExternalTenantID.String(),
 		}
 
-		staticGroupRepoMock := getStaticGroupRepoMock()
-		staticGroupRepoMock.On("Get", mock.Anything, []string{groupName}).Return(staticGroups, nil).Once()
-
-		staticUserRepoMock := getStaticUserRepoMock()
-		staticUserRepoMock.On("Get", username).Return(staticUser, nil).Once()
+		userSvcMock := getUserServiceMock()
+		userSvcMock.On("GetUserGroupScopes", mock.Anything, []string{groupName}, expectedExternalTenantID.String()).Return(nil, nil).Once()
+		userSvcMock.On("GetUserScopes", mock.Anything, username, expectedExternalTenantID.String()).Return(expectedScopes, nil).Once()
 
 		tenantRepoMock := getTenantRepositoryMock()
 		tenantRepoMock.On("GetByExternalTenant", mock.Anything, expectedExternalTenantID.String()).Return(tenantMappingModel, nil).Once()
 
-		provider := tenantmapping.NewUserContextProvider(staticUserRepoMock, staticGroupRepoMock, tenantRepoMock)
+		provider := tenantmapping.NewUserContextProvider(userSvcMock, tenantRepoMock)
 
 		objCtx, err := provider.GetObjectContext(context.TODO(), reqData, jwtAuthDetails)
 
@@ -367,10 +302,32 @@ func TestUserContextProvider(t *testing.T) {
 		require.Equal(t, username, objCtx.ConsumerID)
 		require.Equal(t, userObjCtxType, string(objCtx.ConsumerType))
 
-		mock.AssertExpectationsForObjects(t, staticGroupRepoMock, tenantRepoMock)
+		mock.AssertExpectationsForObjects(t, userSvcMock, tenantRepoMock)
 	})
 
-	t.Run("returns error when tenant from the request does not match any tenants assigned to the static user", func(t *testing.T) {
+	t.Run("returns error when user service fails to get scopes of the user groups", func(t *testing.T) {
+		groupName := "test"
+		reqData := oathkeeper.ReqData{
+			Body: oathkeeper.ReqBody{
+				Extra: map[string]interface{}{
+					oathkeeper.GroupsKey: []interface{}{groupName},
+				},
+			},
+		}
+
+		userSvcMock := getUserServiceMock()
+		userSvcMock.On("GetUserGroupScopes", mock.Anything, []string{groupName}, "").Return(nil, errors.New("some-error")).Once()
+
+		provider := tenantmapping.NewUserContextProvider(userSvcMock, nil)
+
+		_, err := provider.GetObjectContext(context.TODO(), reqData, jwtAuthDetails)
+
+		require.EqualError(t, err, "while getting scopes of user groups: some-error")
+
+		mock.AssertExpectationsForObjects(t, userSvcMock)
+	})
+
+	t.Run("returns error when user has no roles bound in the tenant from the request", func(t *testing.T) {
 		nonExistingExternalTenantID := uuid.New().String()
 		reqData := oathkeeper.ReqData{
 			Body: oathkeeper.ReqBody{
@@ -379,29 +336,19 @@ func TestUserContextProvider(t *testing.T) {
 				},
 			},
 		}
-		staticUser := tenantmapping.StaticUser{
-			Username: username,
-			Tenants:  []string{expectedExternalTenantID.String()},
-			Scopes:   expectedScopes,
-		}
-		tenantMappingModel := &model.BusinessTenantMapping{
-			ID:             uuid.New().String(),
-			ExternalTenant: nonExistingExternalTenantID,
-		}
 
-		staticUserRepoMock := getStaticUserRepoMock()
-		staticUserRepoMock.On("Get", username).Return(staticUser, nil).Once()
+		userSvcMock := getUserServiceMock()
+		userSvcMock.On("GetUserScopes", mock.Anything, username, nonExistingExternalTenantID).Return(nil, nil).Once()
 
 		tenantRepoMock := getTenantRepositoryMock()
-		tenantRepoMock.On("GetByExternalTenant", mock.Anything, nonExistingExternalTenantID).Return(tenantMappingModel, nil).Once()
 
-		provider := tenantmapping.NewUserContextProvider(staticUserRepoMock, nil, tenantRepoMock)
+		provider := tenantmapping.NewUserContextProvider(userSvcMock, tenantRepoMock)
 
 		_, err := provider.GetObjectContext(context.TODO(), reqData, jwtAuthDetails)
 
-		require.EqualError(t, err, apperrors.NewInternalError(fmt.Sprintf("Static tenant with username: some-user missmatch external tenant: %s", nonExistingExternalTenantID)).Error())
+		require.EqualError(t, err, "while getting user data for user: some-user: "+apperrors.NewInternalError(fmt.Sprintf("User with username: some-user has no roles bound in external tenant: %s", nonExistingExternalTenantID)).Error())
 
-		mock.AssertExpectationsForObjects(t, staticUserRepoMock, tenantRepoMock)
+		mock.AssertExpectationsForObjects(t, userSvcMock, tenantRepoMock)
 	})
 
 	t.Run("returns error when tenant is specified in Extra map in a non-string format", func(t *testing.T) {
@@ -412,22 +359,15 @@ func TestUserContextProvider(t *testing.T) {
 				},
 			},
 		}
-		staticUser := tenantmapping.StaticUser{
-			Username: username,
-			Tenants:  []string{expectedExternalTenantID.String()},
-			Scopes:   expectedScopes,
-		}
+		userSvcMock := getUserServiceMock()
 
-		staticUserRepoMock := getStaticUserRepoMock()
-		staticUserRepoMock.On("Get", username).Return(staticUser, nil).Once()
-
-		provider := tenantmapping.NewUserContextProvider(staticUserRepoMock, nil, nil)
+		provider := tenantmapping.NewUserContextProvider(userSvcMock, nil)
 
 		_, err := provider.GetObjectContext(context.TODO(), reqData, jwtAuthDetails)
 
 		require.EqualError(t, err, "could not parse external ID for user: some-user: while parsing the value for key=tenant: Internal Server Error: unable to cast the value to a string type")
 
-		mock.AssertExpectationsForObjects(t, staticUserRepoMock)
+		mock.AssertExpectationsForObjects(t, userSvcMock)
 	})
 
 	t.Run("returns error when scopes is specified in Extra map in a non-string format", func(t *testing.T) {
@@ -438,49 +378,38 @@ func TestUserContextProvider(t *testing.T) {
 				},
 			},
 		}
-		staticUser := tenantmapping.StaticUser{
-			Username: username,
-			Tenants:  []string{expectedExternalTenantID.String()},
-			Scopes:   expectedScopes,
-		}
-
-		staticUserRepoMock := getStaticUserRepoMock()
-		staticUserRepoMock.On("Get", username).Return(staticUser, nil).Once()
+		userSvcMock := getUserServiceMock()
+		userSvcMock.On("GetUserScopes", mock.Anything, username, "").Return(expectedScopes, nil).Once()
 
-		provider := tenantmapping.NewUserContextProvider(staticUserRepoMock, nil, nil)
+		provider := tenantmapping.NewUserContextProvider(userSvcMock, nil)
 
 		_, err := provider.GetObjectContext(context.TODO(), reqData, jwtAuthDetails)
 
 		require.EqualError(t, err, "while getting user data for user: some-user: while fetching scopes: while parsing the value for scope: Internal Server Error: unable to cast the value to a string type")
 
-		mock.AssertExpectationsForObjects(t, staticUserRepoMock)
+		mock.AssertExpectationsForObjects(t, userSvcMock)
 	})
 
-	t.Run("returns error when user repository returns error", func(t *testing.T) {
+	t.Run("returns error when user service returns error", func(t *testing.T) {
 		reqData := oathkeeper.ReqData{}
 		username := "non-existing"
 
-		staticUserRepoMock := getStaticUserRepoMock()
-		staticUserRepoMock.On("Get", username).Return(tenantmapping.StaticUser{}, errors.New("some-error")).Once()
+		userSvcMock := getUserServiceMock()
+		userSvcMock.On("GetUserScopes", mock.Anything, username, "").Return(nil, errors.New("some-error")).Once()
 
-		provider := tenantmapping.NewUserContextProvider(staticUserRepoMock, nil, nil)
+		provider := tenantmapping.NewUserContextProvider(userSvcMock, nil)
 
 		jwtAuthDetailsWithMissingUser := jwtAuthDetails
 		jwtAuthDetailsWithMissingUser.AuthID = username
 		_, err := provider.GetObjectContext(context.TODO(), reqData, jwtAuthDetailsWithMissingUser)
 
-		require.EqualError(t, err, "while getting user data for user: non-existing: while searching for a static user with username non-existing: some-error")
+		require.EqualError(t, err, "while getting user data for user: non-existing: while getting scopes of user with username non-existing: some-error")
 
-		mock.AssertExpectationsForObjects(t, staticUserRepoMock)
+		mock.AssertExpectationsForObjects(t, userSvcMock)
 	})
 }
 
-func getStaticUserRepoMock() *automock.StaticUserRepository {
-	repo := &automock.StaticUserRepository{}
-	return repo
-}
-
-func getStaticGroupRepoMock() *automock.StaticGroupRepository {
-	repo := &automock.StaticGroupRepository{}
-	return repo
+func getUserServiceMock() *automock.UserService {
+	svc := &automock.UserService{}
+	return svc
 }
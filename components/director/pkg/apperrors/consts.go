@@ -18,6 +18,7 @@ const (
 	EmptyData          ErrorType = 29
 	InconsistentData   ErrorType = 30
 	NotUniqueName      ErrorType = 31
+	Forbidden          ErrorType = 32
 )
 
 const (
@@ -39,4 +40,6 @@ const (
 	emptyDataMsg                 = "Some required data was left out"
 	inconsistentDataMsg          = "Inconsistent or out-of-range data"
 	notUniqueNameMsg             = "Object name is not unique"
+	forbiddenMsg                 = "Access to the object is forbidden"
+	deniedByPolicyMsg            = "Operation is denied by policy"
 )
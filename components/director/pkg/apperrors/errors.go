@@ -266,6 +266,27 @@ func NewUnauthorizedError(msg string) error {
 	}
 }
 
+func NewForbiddenError(consumerType, consumerID string, objectType resource.Type, objectID string) error {
+	return Error{
+		errorCode: Forbidden,
+		Message:   forbiddenMsg,
+		arguments: map[string]string{
+			"consumerType": consumerType,
+			"consumerID":   consumerID,
+			"objectType":   string(objectType),
+			"objectID":     objectID,
+		},
+	}
+}
+
+func NewDeniedByPolicyError(policyName string) error {
+	return Error{
+		errorCode: Forbidden,
+		Message:   deniedByPolicyMsg,
+		arguments: map[string]string{"policy": policyName},
+	}
+}
+
 func IsValueNotFoundInConfiguration(err error) bool {
 	if customErr, ok := err.(Error); ok {
 		return customErr.errorCode == NotFound && customErr.Message == valueNotFoundInConfigMsg
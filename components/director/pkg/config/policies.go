@@ -0,0 +1,53 @@
+package config
+
+import (
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/policy"
+	"github.com/pkg/errors"
+)
+
+const policiesPath = "policies"
+
+// GetPolicies returns the authorization policies. Policies are optional, so no policies are returned if they are not defined.
+// The policies are parsed and compiled when the configuration is loaded, not on every call.
+func (p *Provider) GetPolicies() ([]policy.Policy, error) {
+	if p.cachedConfig == nil {
+		return nil, apperrors.NewInternalError("required configuration not loaded")
+	}
+
+	return p.cachedPolicies, p.policiesErr
+}
+
+func (p *Provider) loadPolicies() ([]policy.Policy, error) {
+	if _, ok := p.cachedConfig[policiesPath]; !ok {
+		return nil, nil
+	}
+
+	val, err := p.getValueForJSONPath(policiesPath)
+	if err != nil {
+		if apperrors.IsValueNotFoundInConfiguration(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	valMarshalled, err := json.Marshal(val)
+	if err != nil {
+		return nil, errors.Wrap(err, "while marshalling policies definition")
+	}
+
+	var policies []policy.Policy
+	if err := json.Unmarshal(valMarshalled, &policies); err != nil {
+		return nil, errors.Wrap(err, "unexpected policies definition, should be a list of policies")
+	}
+
+	for i := range policies {
+		if err := policies[i].Compile(); err != nil {
+			return nil, err
+		}
+	}
+
+	return policies, nil
+}
@@ -0,0 +1,124 @@
+package config_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/config"
+	"github.com/kyma-incubator/compass/components/director/pkg/policy"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider_GetPolicies(t *testing.T) {
+	t.Run("requires Load", func(t *testing.T) {
+		sut := config.NewProvider("anything")
+		_, err := sut.GetPolicies()
+		require.Error(t, err)
+	})
+
+	// GIVEN
+	sut := config.NewProvider("testdata/valid.yaml")
+	require.NoError(t, sut.Load())
+
+	t.Run("returns policies", func(t *testing.T) {
+		expected := []policy.Policy{
+			{
+				Name:       "deny-pii-for-region-x",
+				Effect:     policy.Deny,
+				Operations: []string{"requestPackageInstanceAuthCreation"},
+				Condition: map[string]interface{}{
+					"properties": map[string]interface{}{
+						"consumer": map[string]interface{}{
+							"properties": map[string]interface{}{
+								"labels": map[string]interface{}{
+									"properties": map[string]interface{}{
+										"region": map[string]interface{}{"const": "x"},
+									},
+									"required": []interface{}{"region"},
+								},
+							},
+						},
+						"object": map[string]interface{}{
+							"properties": map[string]interface{}{
+								"labels": map[string]interface{}{
+									"required": []interface{}{"pii"},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Name:   "allow-all",
+				Effect: policy.Allow,
+			},
+		}
+		// WHEN
+		actual, err := sut.GetPolicies()
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, expected, withoutCompiledConditions(actual))
+	})
+
+	t.Run("returns policies with compiled conditions", func(t *testing.T) {
+		// GIVEN
+		engine := policy.NewEngine(sut)
+		in := policy.Input{
+			Consumer:  policy.ConsumerInput{Labels: map[string]interface{}{"region": "x"}},
+			Operation: policy.OperationInput{Name: "requestPackageInstanceAuthCreation"},
+			Object:    &policy.ObjectInput{Labels: map[string]interface{}{"pii": true}},
+		}
+		// WHEN
+		decision, err := engine.Evaluate(context.TODO(), in)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, policy.Decision{Allowed: false, Policy: "deny-pii-for-region-x"}, decision)
+	})
+
+	sut = config.NewProvider("testdata/valid-hide-selectors-empty.yaml")
+	require.NoError(t, sut.Load())
+
+	t.Run("returns no policies when none specified", func(t *testing.T) {
+		// WHEN
+		actual, err := sut.GetPolicies()
+		// THEN
+		require.NoError(t, err)
+		assert.Nil(t, actual)
+	})
+
+	sut = config.NewProvider("testdata/invalid-policies-invalid-format.yaml")
+	require.NoError(t, sut.Load())
+
+	t.Run("returns error when policies in invalid format", func(t *testing.T) {
+		// WHEN
+		_, err := sut.GetPolicies()
+		// THEN
+		require.Error(t, err)
+	})
+
+	sut = config.NewProvider("testdata/invalid-policies-invalid-condition.yaml")
+	require.NoError(t, sut.Load())
+
+	t.Run("returns error when policy condition is invalid", func(t *testing.T) {
+		// WHEN
+		_, err := sut.GetPolicies()
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while compiling condition of policy invalid-condition")
+	})
+}
+
+func withoutCompiledConditions(policies []policy.Policy) []policy.Policy {
+	result := make([]policy.Policy, 0, len(policies))
+	for _, p := range policies {
+		result = append(result, policy.Policy{
+			Name:       p.Name,
+			Effect:     p.Effect,
+			Operations: p.Operations,
+			Condition:  p.Condition,
+		})
+	}
+	return result
+}
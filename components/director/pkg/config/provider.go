@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/policy"
 
 	"github.com/ghodss/yaml"
 	"github.com/oliveagle/jsonpath"
@@ -18,8 +19,10 @@ func NewProvider(fileName string) *Provider {
 }
 
 type Provider struct {
-	fileName     string
-	cachedConfig map[string]interface{}
+	fileName       string
+	cachedConfig   map[string]interface{}
+	cachedPolicies []policy.Policy
+	policiesErr    error
 }
 
 func (p *Provider) Load() error {
@@ -32,6 +35,7 @@ func (p *Provider) Load() error {
 		return errors.Wrap(err, "while unmarshalling YAML")
 	}
 	p.cachedConfig = out
+	p.cachedPolicies, p.policiesErr = p.loadPolicies()
 
 	return nil
 
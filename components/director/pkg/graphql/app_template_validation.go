@@ -18,7 +18,19 @@ func (i ApplicationTemplateInput) Validate() error {
 		"name":                   validation.Validate(i.Name, validation.Required, is.PrintableASCII, validation.Length(1, 100)),
 		"description":            validation.Validate(i.Description, validation.RuneLength(0, descriptionStringLengthLimit)),
 		"placeholders":           validation.Validate(i.Placeholders, validation.Each(validation.Required)),
-		"accessLevel":            validation.Validate(i.AccessLevel, validation.Required, validation.In(ApplicationTemplateAccessLevelGlobal)),
+		"accessLevel":            validation.Validate(i.AccessLevel, validation.Required, validation.In(ApplicationTemplateAccessLevelGlobal, ApplicationTemplateAccessLevelTenant)),
+		"applicationInput":       i.validApplicationInputLabels(),
+	}.Filter()
+}
+
+// validApplicationInputLabels validates only the label keys, as the other fields of the Application input may contain placeholders
+func (i ApplicationTemplateInput) validApplicationInputLabels() error {
+	if i.ApplicationInput == nil {
+		return nil
+	}
+
+	return validation.Errors{
+		"labels": validation.Validate(i.ApplicationInput.Labels, inputvalidation.EachKey(notReservedApplicationLabelKey())),
 	}.Filter()
 }
 
@@ -69,10 +81,43 @@ func (i ApplicationTemplateInput) ensurePlaceholdersUsed() error {
 }
 
 func (i PlaceholderDefinitionInput) Validate() error {
-	return validation.ValidateStruct(&i,
-		validation.Field(&i.Name, validation.Required, inputvalidation.DNSName),
-		validation.Field(&i.Description, validation.RuneLength(0, descriptionStringLengthLimit)),
-	)
+	return validation.Errors{
+		"Rule.ValidAllowedValues": i.validAllowedValues(),
+		"name":                    validation.Validate(i.Name, validation.Required, inputvalidation.DNSName),
+		"description":             validation.Validate(i.Description, validation.RuneLength(0, descriptionStringLengthLimit)),
+		"type":                    validation.Validate(i.Type, validation.NilOrNotEmpty, validation.In(PlaceholderTypeString, PlaceholderTypeURL, PlaceholderTypeEnum, PlaceholderTypeBoolean, PlaceholderTypeNumber)),
+		"defaultValue":            validation.Validate(i.DefaultValue, validation.RuneLength(0, longStringLengthLimit)),
+		"allowedValues":           validation.Validate(i.AllowedValues, validation.Each(validation.Required, validation.RuneLength(1, longStringLengthLimit))),
+	}.Filter()
+}
+
+func (i PlaceholderDefinitionInput) validAllowedValues() error {
+	if i.Type == nil || *i.Type != PlaceholderTypeEnum {
+		if len(i.AllowedValues) > 0 {
+			return errors.Errorf("allowed values can be provided only for placeholder of type %s", PlaceholderTypeEnum)
+		}
+		return nil
+	}
+
+	if len(i.AllowedValues) == 0 {
+		return errors.Errorf("allowed values are required for placeholder of type %s", PlaceholderTypeEnum)
+	}
+
+	keys := make(map[string]interface{})
+	for _, value := range i.AllowedValues {
+		if _, exist := keys[value]; exist {
+			return errors.Errorf("allowed value [%s] not unique", value)
+		}
+		keys[value] = struct{}{}
+	}
+
+	if i.DefaultValue != nil {
+		if _, exist := keys[*i.DefaultValue]; !exist {
+			return errors.Errorf("default value [%s] is not one of allowed values", *i.DefaultValue)
+		}
+	}
+
+	return nil
 }
 
 func (i ApplicationFromTemplateInput) Validate() error {
@@ -98,6 +143,13 @@ func (i ApplicationFromTemplateInput) ensureUniquePlaceholders() error {
 	return nil
 }
 
+func (i ApplicationFromTemplateUpgradeInput) Validate() error {
+	return validation.Errors{
+		"Rule.UniquePlaceholders": ApplicationFromTemplateInput{Values: i.Values}.ensureUniquePlaceholders(),
+		"values":                  validation.Validate(i.Values, validation.Each(validation.Required)),
+	}.Filter()
+}
+
 func (i TemplateValueInput) Validate() error {
 	return validation.ValidateStruct(&i,
 		validation.Field(&i.Placeholder, validation.Required, inputvalidation.DNSName),
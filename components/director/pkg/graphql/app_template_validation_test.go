@@ -233,6 +233,11 @@ func TestApplicationTemplateInput_Validate_AccessLevel(t *testing.T) {
 			Value: graphql.ApplicationTemplateAccessLevelGlobal,
 			Valid: true,
 		},
+		{
+			Name:  "Valid - Tenant",
+			Value: graphql.ApplicationTemplateAccessLevelTenant,
+			Valid: true,
+		},
 		{
 			Name:  "Invalid - Empty",
 			Value: inputvalidationtest.EmptyString,
@@ -262,6 +267,46 @@ func TestApplicationTemplateInput_Validate_AccessLevel(t *testing.T) {
 	}
 }
 
+func TestApplicationTemplateInput_Validate_ApplicationInputLabels(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Value *graphql.Labels
+		Valid bool
+	}{
+		{
+			Name:  "Valid",
+			Value: &graphql.Labels{"key": "value"},
+			Valid: true,
+		},
+		{
+			Name:  "Valid - Nil",
+			Value: nil,
+			Valid: true,
+		},
+		{
+			Name:  "Invalid - Reserved key",
+			Value: &graphql.Labels{"eventingPolicy": "value"},
+			Valid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidApplicationTemplateInput()
+			sut.ApplicationInput.Labels = testCase.Value
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.Valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 // PlaceholderDefinitionInput
 
 func TestPlaceholderDefinitionInput_Validate_Name(t *testing.T) {
@@ -304,6 +349,174 @@ func TestPlaceholderDefinitionInput_Validate_Name(t *testing.T) {
 	}
 }
 
+func TestPlaceholderDefinitionInput_Validate_Type(t *testing.T) {
+	invalidType := graphql.PlaceholderType("INVALID")
+	numberType := graphql.PlaceholderTypeNumber
+
+	testCases := []struct {
+		Name  string
+		Value *graphql.PlaceholderType
+		Valid bool
+	}{
+		{
+			Name:  "Valid",
+			Value: &numberType,
+			Valid: true,
+		},
+		{
+			Name:  "Valid - Nil",
+			Value: nil,
+			Valid: true,
+		},
+		{
+			Name:  "Invalid - Unknown type",
+			Value: &invalidType,
+			Valid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidPlaceholderDefintionInput()
+			sut.Type = testCase.Value
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.Valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPlaceholderDefinitionInput_Validate_AllowedValues(t *testing.T) {
+	enumType := graphql.PlaceholderTypeEnum
+	stringType := graphql.PlaceholderTypeString
+
+	testCases := []struct {
+		Name          string
+		Type          *graphql.PlaceholderType
+		AllowedValues []string
+		DefaultValue  *string
+		Valid         bool
+	}{
+		{
+			Name:          "Valid - Enum",
+			Type:          &enumType,
+			AllowedValues: []string{"a", "b"},
+			Valid:         true,
+		},
+		{
+			Name:          "Valid - Enum with default value",
+			Type:          &enumType,
+			AllowedValues: []string{"a", "b"},
+			DefaultValue:  str.Ptr("b"),
+			Valid:         true,
+		},
+		{
+			Name:  "Valid - String without allowed values",
+			Type:  &stringType,
+			Valid: true,
+		},
+		{
+			Name:  "Invalid - Enum without allowed values",
+			Type:  &enumType,
+			Valid: false,
+		},
+		{
+			Name:          "Invalid - Enum with duplicated allowed values",
+			Type:          &enumType,
+			AllowedValues: []string{"a", "a"},
+			Valid:         false,
+		},
+		{
+			Name:          "Invalid - Enum with empty allowed value",
+			Type:          &enumType,
+			AllowedValues: []string{""},
+			Valid:         false,
+		},
+		{
+			Name:          "Invalid - Enum with default value not allowed",
+			Type:          &enumType,
+			AllowedValues: []string{"a", "b"},
+			DefaultValue:  str.Ptr("c"),
+			Valid:         false,
+		},
+		{
+			Name:          "Invalid - Allowed values for type other than enum",
+			Type:          &stringType,
+			AllowedValues: []string{"a"},
+			Valid:         false,
+		},
+		{
+			Name:          "Invalid - Allowed values without type",
+			AllowedValues: []string{"a"},
+			Valid:         false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidPlaceholderDefintionInput()
+			sut.Type = testCase.Type
+			sut.AllowedValues = testCase.AllowedValues
+			sut.DefaultValue = testCase.DefaultValue
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.Valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPlaceholderDefinitionInput_Validate_DefaultValue(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Value *string
+		Valid bool
+	}{
+		{
+			Name:  "Valid",
+			Value: str.Ptr("default"),
+			Valid: true,
+		},
+		{
+			Name:  "Valid - Nil",
+			Value: nil,
+			Valid: true,
+		},
+		{
+			Name:  "Invalid - Too long",
+			Value: str.Ptr(inputvalidationtest.String257Long),
+			Valid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidPlaceholderDefintionInput()
+			sut.DefaultValue = testCase.Value
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.Valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestPlaceholderDefinitionInput_Validate_Description(t *testing.T) {
 	testCases := []struct {
 		Name  string
@@ -398,6 +611,59 @@ func TestApplicationFromTemplateInput_Validate_Rule_UniquePlaceholders(t *testin
 	}
 }
 
+func TestApplicationFromTemplateUpgradeInput_Validate(t *testing.T) {
+	testPlaceholderName := "test"
+
+	testCases := []struct {
+		Name  string
+		Value []*graphql.TemplateValueInput
+		Valid bool
+	}{
+		{
+			Name: "Valid",
+			Value: []*graphql.TemplateValueInput{
+				{Placeholder: testPlaceholderName, Value: "one"},
+			},
+			Valid: true,
+		},
+		{
+			Name:  "Valid - no values",
+			Value: nil,
+			Valid: true,
+		},
+		{
+			Name: "Invalid - not unique",
+			Value: []*graphql.TemplateValueInput{
+				{Placeholder: testPlaceholderName, Value: "one"},
+				{Placeholder: testPlaceholderName, Value: "two"},
+			},
+			Valid: false,
+		},
+		{
+			Name: "Invalid - invalid placeholder name",
+			Value: []*graphql.TemplateValueInput{
+				{Placeholder: inputvalidationtest.InvalidName, Value: "one"},
+			},
+			Valid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := graphql.ApplicationFromTemplateUpgradeInput{Values: testCase.Value}
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.Valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestApplicationFromTemplateInput_Validate_TemplateName(t *testing.T) {
 	testCases := []struct {
 		Name          string
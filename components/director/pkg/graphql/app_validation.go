@@ -10,11 +10,16 @@ func (i ApplicationRegisterInput) Validate() error {
 		validation.Field(&i.Name, validation.Required, validation.RuneLength(1, appNameLengthLimit)),
 		validation.Field(&i.ProviderName, validation.RuneLength(0, longStringLengthLimit)),
 		validation.Field(&i.Description, validation.RuneLength(0, descriptionStringLengthLimit)),
-		validation.Field(&i.Labels, inputvalidation.EachKey(validation.Required, validation.Match(alphanumericUnderscoreRegexp))),
+		validation.Field(&i.Labels, inputvalidation.EachKey(validation.Required, validation.Match(alphanumericUnderscoreRegexp), notReservedApplicationLabelKey())),
 		validation.Field(&i.HealthCheckURL, inputvalidation.IsURL, validation.RuneLength(0, longStringLengthLimit)),
 		validation.Field(&i.Webhooks, validation.Each(validation.Required)))
 }
 
+// notReservedApplicationLabelKey rejects the label keys which can be set only with dedicated mutations
+func notReservedApplicationLabelKey() validation.Rule {
+	return validation.NotIn(reservedApplicationLabelKeys...).Error("label key is reserved")
+}
+
 func (i ApplicationUpdateInput) Validate() error {
 	return validation.ValidateStruct(&i,
 		validation.Field(&i.ProviderName, validation.RuneLength(0, longStringLengthLimit)),
@@ -171,6 +171,11 @@ func TestApplicationRegisterInput_Validate_Labels(t *testing.T) {
 			Value:         &graphql.Labels{"not/valid": "value"},
 			ExpectedValid: false,
 		},
+		{
+			Name:          "Reserved key",
+			Value:         &graphql.Labels{"eventingPolicy": "value"},
+			ExpectedValid: false,
+		},
 	}
 
 	for _, testCase := range testCases {
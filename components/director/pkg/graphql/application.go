@@ -8,6 +8,8 @@ type Application struct {
 	Description         *string            `json:"description"`
 	Status              *ApplicationStatus `json:"status"`
 	HealthCheckURL      *string            `json:"healthCheckURL"`
+	// OwnerTenant is set only for Applications shared from another tenant. It is not exposed in the API.
+	OwnerTenant string `json:"-"`
 }
 
 // Extended types used by external API
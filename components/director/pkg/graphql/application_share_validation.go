@@ -0,0 +1,12 @@
+package graphql
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+func (i ApplicationShareInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.TargetTenant, validation.Required, validation.RuneLength(0, longStringLengthLimit)),
+		validation.Field(&i.Scenario, validation.NilOrNotEmpty, validation.RuneLength(0, shortStringLengthLimit), validation.Match(scenarioNameRegexp)),
+	)
+}
@@ -0,0 +1,107 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation/inputvalidationtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicationShareInput_Validate_TargetTenant(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         "2a1502ba-aded-11e9-a2a3-2a2ae2dbcce4",
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - Empty",
+			Value:         inputvalidationtest.EmptyString,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Too long",
+			Value:         inputvalidationtest.String257Long,
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidApplicationShareInput()
+			sut.TargetTenant = testCase.Value
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestApplicationShareInput_Validate_Scenario(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         *string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         str.Ptr("MARKETING"),
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - Nil",
+			Value:         nil,
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - Empty",
+			Value:         str.Ptr(inputvalidationtest.EmptyString),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Too long",
+			Value:         str.Ptr(inputvalidationtest.String129Long),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Invalid characters",
+			Value:         str.Ptr("marketing/eu"),
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidApplicationShareInput()
+			sut.Scenario = testCase.Value
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func fixValidApplicationShareInput() graphql.ApplicationShareInput {
+	return graphql.ApplicationShareInput{
+		TargetTenant: "2a1502ba-aded-11e9-a2a3-2a2ae2dbcce4",
+		Scenario:     str.Ptr("MARKETING"),
+	}
+}
@@ -7,6 +7,7 @@ import (
 type credential struct {
 	*BasicCredentialData
 	*OAuthCredentialData
+	*CertificateGenCredentialData
 }
 
 // UnmarshalJSON is used only by integration tests, we have to help graphql client to deal with Credential field
@@ -51,5 +52,8 @@ func retrieveCredential(umarshaledCredential credential) CredentialData {
 	if umarshaledCredential.BasicCredentialData != nil {
 		return umarshaledCredential.BasicCredentialData
 	}
+	if umarshaledCredential.CertificateGenCredentialData != nil {
+		return umarshaledCredential.CertificateGenCredentialData
+	}
 	return umarshaledCredential.OAuthCredentialData
 }
@@ -63,6 +63,26 @@ func TestUnmarshalOAuth(t *testing.T) {
 	assert.Equal(t, "client-secret", oauth.ClientSecret)
 }
 
+func TestUnmarshalCertificateGen(t *testing.T) {
+	// GIVEN
+	a := &graphql.Auth{}
+	// WHEN
+	err := a.UnmarshalJSON([]byte(`{
+		"credential": {
+			"commonName": "app-name",
+			"certificate": "cert",
+			"privateKey": "key"
+		}
+	}`))
+	// THEN
+	require.NoError(t, err)
+	certificateGen, ok := a.Credential.(*graphql.CertificateGenCredentialData)
+	require.True(t, ok)
+	assert.Equal(t, "app-name", certificateGen.CommonName)
+	assert.Equal(t, "cert", certificateGen.Certificate)
+	assert.Equal(t, "key", certificateGen.PrivateKey)
+}
+
 func TestUnmarshalCSRFBasicAuth(t *testing.T) {
 	// GIVEN
 	a := &graphql.CSRFTokenCredentialRequestAuth{}
@@ -25,10 +25,11 @@ func (i CredentialDataInput) Validate() error {
 	return validation.Errors{
 		"Rule.ExactlyOneNotNil": inputvalidation.ValidateExactlyOneNotNil(
 			"exactly one credential input has to be specified",
-			i.Basic, i.Oauth,
+			i.Basic, i.Oauth, i.CertificateGen,
 		),
-		"Basic": validation.Validate(i.Basic),
-		"Oauth": validation.Validate(i.Oauth),
+		"Basic":          validation.Validate(i.Basic),
+		"Oauth":          validation.Validate(i.Oauth),
+		"CertificateGen": validation.Validate(i.CertificateGen),
 	}.Filter()
 }
 
@@ -47,6 +48,12 @@ func (i OAuthCredentialDataInput) Validate() error {
 	)
 }
 
+func (i CertificateGenCredentialDataInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.CommonName, validation.Required, validation.RuneLength(0, 64)),
+	)
+}
+
 func (i CredentialRequestAuthInput) Validate() error {
 	return validation.ValidateStruct(&i,
 		validation.Field(&i.Csrf, validation.Required),
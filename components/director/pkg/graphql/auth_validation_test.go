@@ -1,6 +1,7 @@
 package graphql_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
@@ -222,6 +223,7 @@ func TestCredentialDataInput_Validate(t *testing.T) {
 	credential := fixValidCredentialDataInput()
 	basic := fixValidBasicCredentialDataInput()
 	oauth := fixValidOAuthCredentialDataInput()
+	certificateGen := fixValidCertificateGenCredentialDataInput()
 
 	testCases := []struct {
 		Name          string
@@ -263,6 +265,28 @@ func TestCredentialDataInput_Validate(t *testing.T) {
 			},
 			ExpectedValid: false,
 		},
+		{
+			Name: "ExpectedValid - certificate generation",
+			Value: &graphql.CredentialDataInput{
+				CertificateGen: &certificateGen,
+			},
+			ExpectedValid: true,
+		},
+		{
+			Name: "Invalid - basic and certificate generation provided",
+			Value: &graphql.CredentialDataInput{
+				Basic:          &basic,
+				CertificateGen: &certificateGen,
+			},
+			ExpectedValid: false,
+		},
+		{
+			Name: "Invalid - nested validation error in CertificateGen",
+			Value: &graphql.CredentialDataInput{
+				CertificateGen: &graphql.CertificateGenCredentialDataInput{},
+			},
+			ExpectedValid: false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -462,6 +486,46 @@ func TestOAuthCredentialDataInput_Validate_URL(t *testing.T) {
 	}
 }
 
+func TestCertificateGenCredentialDataInput_Validate_CommonName(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         "app-name.kyma.local",
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - Empty string",
+			Value:         inputvalidationtest.EmptyString,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Too long",
+			Value:         strings.Repeat("a", 65),
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidCertificateGenCredentialDataInput()
+			sut.CommonName = testCase.Value
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestCredentialRequestAuthInput_Validate(t *testing.T) {
 	csrf := fixValidCSRFTokenCredentialRequestAuthInput()
 	testCases := []struct {
@@ -741,6 +805,14 @@ func fixValidOAuthCredentialDataInput() graphql.OAuthCredentialDataInput {
 	}
 }
 
+func fixValidCertificateGenCredentialDataInput() graphql.CertificateGenCredentialDataInput {
+	return graphql.CertificateGenCredentialDataInput{
+		CommonName:  "app-name.kyma.local",
+		Certificate: "cert",
+		PrivateKey:  "key",
+	}
+}
+
 func fixValidCredentialRequestAuthInput() graphql.CredentialRequestAuthInput {
 	csrf := fixValidCSRFTokenCredentialRequestAuthInput()
 	return graphql.CredentialRequestAuthInput{
@@ -0,0 +1,228 @@
+package directorclient
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql/graphqlizer"
+	gcli "github.com/machinebox/graphql"
+	"github.com/pkg/errors"
+)
+
+// DefaultPageSize is the maximum page size accepted by the Director API
+const DefaultPageSize = 100
+
+const nameKey = "name"
+
+//go:generate mockery -name=GraphQLClient -output=automock -outpkg=automock -case=underscore
+type GraphQLClient interface {
+	Run(ctx context.Context, req *gcli.Request, resp interface{}) error
+}
+
+// Client reads Director objects into the extended graphql types.
+// Paginated fields are followed by their pageInfo.endCursor, so the returned objects contain all items instead of the first page only.
+type Client struct {
+	gqlClient GraphQLClient
+	fields    *graphqlizer.GqlFieldsProvider
+	pageSize  int
+}
+
+func NewClient(gqlClient GraphQLClient, pageSize int) *Client {
+	if pageSize <= 0 || pageSize > DefaultPageSize {
+		pageSize = DefaultPageSize
+	}
+
+	return &Client{
+		gqlClient: gqlClient,
+		fields:    &graphqlizer.GqlFieldsProvider{},
+		pageSize:  pageSize,
+	}
+}
+
+type ApplicationPageResult struct {
+	Result graphql.ApplicationPageExt `json:"result"`
+}
+
+type ApplicationResult struct {
+	Result graphql.ApplicationExt `json:"result"`
+}
+
+type ViewerResult struct {
+	Result graphql.Viewer `json:"result"`
+}
+
+// GetViewer returns the object which the caller is authenticated as
+func (c *Client) GetViewer(ctx context.Context) (graphql.Viewer, error) {
+	req := gcli.NewRequest(fmt.Sprintf(`query {
+			result: viewer {
+				%s
+			}
+		}`, c.fields.ForViewer()))
+
+	var resp ViewerResult
+	if err := c.gqlClient.Run(ctx, req, &resp); err != nil {
+		return graphql.Viewer{}, errors.Wrap(err, "while getting viewer")
+	}
+
+	return resp.Result, nil
+}
+
+// GetApplication returns the Application without its Packages, Webhooks and System Auths. Use ListPackages to get the Packages.
+func (c *Client) GetApplication(ctx context.Context, appID string) (graphql.ApplicationExt, error) {
+	req := gcli.NewRequest(fmt.Sprintf(`query {
+			result: application(id: "%s") {
+				%s
+			}
+		}`, appID, c.fields.OmitForApplication([]string{"packages", "webhooks", "auths"})))
+
+	var resp ApplicationResult
+	if err := c.gqlClient.Run(ctx, req, &resp); err != nil {
+		return graphql.ApplicationExt{}, errors.Wrapf(err, "while getting application with ID %s", appID)
+	}
+
+	return resp.Result, nil
+}
+
+// ListApplicationsByName returns all Applications with the given name. Packages of the Applications are not fetched, use ListPackages instead.
+func (c *Client) ListApplicationsByName(ctx context.Context, name string) ([]*graphql.ApplicationExt, error) {
+	var apps []*graphql.ApplicationExt
+	var cursor graphql.PageCursor
+	for {
+		req := gcli.NewRequest(fmt.Sprintf(`query {
+			result: applications(filter: {key: "%s", query: %s}, %s) {
+				%s
+			}
+		}`, nameKey, strconv.Quote(strconv.Quote(name)), c.pageArgs(cursor), c.fields.Page(c.fields.OmitForApplication([]string{"packages"}))))
+
+		var resp ApplicationPageResult
+		if err := c.gqlClient.Run(ctx, req, &resp); err != nil {
+			return nil, errors.Wrap(err, "while listing applications")
+		}
+
+		apps = append(apps, resp.Result.Data...)
+		if !hasNextPage(resp.Result.PageInfo) {
+			return apps, nil
+		}
+		cursor = resp.Result.PageInfo.EndCursor
+	}
+}
+
+// ListPackages returns all Packages of the Application together with all their API Definitions, Event Definitions and Documents
+func (c *Client) ListPackages(ctx context.Context, appID string) ([]*graphql.PackageExt, error) {
+	var packages []*graphql.PackageExt
+	var cursor graphql.PageCursor
+	for {
+		req := gcli.NewRequest(fmt.Sprintf(`query {
+			result: application(id: "%s") {
+				packages(%s) {
+					%s
+				}
+			}
+		}`, appID, c.pageArgs(cursor), c.fields.Page(c.fields.ForPackage())))
+
+		var resp ApplicationResult
+		if err := c.gqlClient.Run(ctx, req, &resp); err != nil {
+			return nil, errors.Wrapf(err, "while listing packages of application with ID %s", appID)
+		}
+
+		packages = append(packages, resp.Result.Packages.Data...)
+		if !hasNextPage(resp.Result.Packages.PageInfo) {
+			break
+		}
+		cursor = resp.Result.Packages.PageInfo.EndCursor
+	}
+
+	for _, pkg := range packages {
+		if err := c.completePackage(ctx, appID, pkg); err != nil {
+			return nil, err
+		}
+	}
+
+	return packages, nil
+}
+
+// GetPackage returns the Package of the Application together with all its API Definitions, Event Definitions and Documents
+func (c *Client) GetPackage(ctx context.Context, appID, packageID string) (graphql.PackageExt, error) {
+	req := gcli.NewRequest(fmt.Sprintf(`query {
+			result: application(id: "%s") {
+				package(id: "%s") {
+					%s
+				}
+			}
+		}`, appID, packageID, c.fields.ForPackage()))
+
+	var resp ApplicationResult
+	if err := c.gqlClient.Run(ctx, req, &resp); err != nil {
+		return graphql.PackageExt{}, errors.Wrapf(err, "while getting package with ID %s", packageID)
+	}
+
+	pkg := resp.Result.Package
+	if err := c.completePackage(ctx, appID, &pkg); err != nil {
+		return graphql.PackageExt{}, err
+	}
+
+	return pkg, nil
+}
+
+func (c *Client) completePackage(ctx context.Context, appID string, pkg *graphql.PackageExt) error {
+	for hasNextPage(pkg.APIDefinitions.PageInfo) {
+		page, err := c.packageField(ctx, appID, pkg.ID, "apiDefinitions", pkg.APIDefinitions.PageInfo.EndCursor, c.fields.ForAPIDefinition())
+		if err != nil {
+			return err
+		}
+		pkg.APIDefinitions.Data = append(pkg.APIDefinitions.Data, page.APIDefinitions.Data...)
+		pkg.APIDefinitions.PageInfo = page.APIDefinitions.PageInfo
+	}
+
+	for hasNextPage(pkg.EventDefinitions.PageInfo) {
+		page, err := c.packageField(ctx, appID, pkg.ID, "eventDefinitions", pkg.EventDefinitions.PageInfo.EndCursor, c.fields.ForEventDefinition())
+		if err != nil {
+			return err
+		}
+		pkg.EventDefinitions.Data = append(pkg.EventDefinitions.Data, page.EventDefinitions.Data...)
+		pkg.EventDefinitions.PageInfo = page.EventDefinitions.PageInfo
+	}
+
+	for hasNextPage(pkg.Documents.PageInfo) {
+		page, err := c.packageField(ctx, appID, pkg.ID, "documents", pkg.Documents.PageInfo.EndCursor, c.fields.ForDocument())
+		if err != nil {
+			return err
+		}
+		pkg.Documents.Data = append(pkg.Documents.Data, page.Documents.Data...)
+		pkg.Documents.PageInfo = page.Documents.PageInfo
+	}
+
+	return nil
+}
+
+func (c *Client) packageField(ctx context.Context, appID, packageID, field string, cursor graphql.PageCursor, itemFields string) (graphql.PackageExt, error) {
+	req := gcli.NewRequest(fmt.Sprintf(`query {
+			result: application(id: "%s") {
+				package(id: "%s") {
+					%s(%s) {
+						%s
+					}
+				}
+			}
+		}`, appID, packageID, field, c.pageArgs(cursor), c.fields.Page(itemFields)))
+
+	var resp ApplicationResult
+	if err := c.gqlClient.Run(ctx, req, &resp); err != nil {
+		return graphql.PackageExt{}, errors.Wrapf(err, "while getting %s of package with ID %s", field, packageID)
+	}
+
+	return resp.Result.Package, nil
+}
+
+func (c *Client) pageArgs(cursor graphql.PageCursor) string {
+	if cursor == "" {
+		return fmt.Sprintf("first: %d", c.pageSize)
+	}
+	return fmt.Sprintf(`first: %d, after: "%s"`, c.pageSize, cursor)
+}
+
+func hasNextPage(pageInfo *graphql.PageInfo) bool {
+	return pageInfo != nil && pageInfo.HasNextPage && pageInfo.EndCursor != ""
+}
@@ -0,0 +1,320 @@
+package directorclient_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql/directorclient"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql/directorclient/automock"
+	gcli "github.com/machinebox/graphql"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	appID     = "app-id"
+	packageID = "pkg-id"
+)
+
+var testErr = errors.New("test error")
+
+func TestClient_GetViewer(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// GIVEN
+		gqlClient := &automock.GraphQLClient{}
+		gqlClient.On("Run", mock.Anything, queryWith("result: viewer"), mock.Anything).
+			Run(respond(func(resp interface{}) {
+				resp.(*directorclient.ViewerResult).Result = graphql.Viewer{ID: appID, Type: graphql.ViewerTypeApplication}
+			})).Return(nil).Once()
+		defer gqlClient.AssertExpectations(t)
+
+		client := directorclient.NewClient(gqlClient, directorclient.DefaultPageSize)
+
+		// WHEN
+		viewer, err := client.GetViewer(context.TODO())
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, graphql.Viewer{ID: appID, Type: graphql.ViewerTypeApplication}, viewer)
+	})
+
+	t.Run("error when request fails", func(t *testing.T) {
+		// GIVEN
+		gqlClient := &automock.GraphQLClient{}
+		gqlClient.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(testErr).Once()
+		defer gqlClient.AssertExpectations(t)
+
+		client := directorclient.NewClient(gqlClient, directorclient.DefaultPageSize)
+
+		// WHEN
+		_, err := client.GetViewer(context.TODO())
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while getting viewer")
+	})
+}
+
+func TestClient_GetApplication(t *testing.T) {
+	t.Run("success - does not fetch packages", func(t *testing.T) {
+		// GIVEN
+		gqlClient := &automock.GraphQLClient{}
+		gqlClient.On("Run", mock.Anything, mock.MatchedBy(func(req *gcli.Request) bool {
+			return strings.Contains(req.Query(), `application(id: "app-id")`) && !strings.Contains(req.Query(), "packages")
+		}), mock.Anything).
+			Run(respondWithApplication(func(app *graphql.ApplicationExt) {
+				app.ID = appID
+				app.Name = "foo"
+			})).Return(nil).Once()
+		defer gqlClient.AssertExpectations(t)
+
+		client := directorclient.NewClient(gqlClient, directorclient.DefaultPageSize)
+
+		// WHEN
+		app, err := client.GetApplication(context.TODO(), appID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, "foo", app.Name)
+	})
+
+	t.Run("error when request fails", func(t *testing.T) {
+		// GIVEN
+		gqlClient := &automock.GraphQLClient{}
+		gqlClient.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(testErr).Once()
+		defer gqlClient.AssertExpectations(t)
+
+		client := directorclient.NewClient(gqlClient, directorclient.DefaultPageSize)
+
+		// WHEN
+		_, err := client.GetApplication(context.TODO(), appID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while getting application with ID app-id")
+	})
+}
+
+func TestClient_ListApplicationsByName(t *testing.T) {
+	t.Run("success - follows end cursor", func(t *testing.T) {
+		// GIVEN
+		gqlClient := &automock.GraphQLClient{}
+		gqlClient.On("Run", mock.Anything, queryWith(`applications(filter: {key: "name", query: "\"foo\""}, first: 2)`), mock.Anything).
+			Run(respond(func(resp interface{}) {
+				page := &resp.(*directorclient.ApplicationPageResult).Result
+				*page = fixApplicationPage("cursor", "app-1", "app-2")
+			})).Return(nil).Once()
+		gqlClient.On("Run", mock.Anything, queryWith(`first: 2, after: "cursor"`), mock.Anything).
+			Run(respond(func(resp interface{}) {
+				page := &resp.(*directorclient.ApplicationPageResult).Result
+				*page = fixApplicationPage("", "app-3")
+			})).Return(nil).Once()
+		defer gqlClient.AssertExpectations(t)
+
+		client := directorclient.NewClient(gqlClient, 2)
+
+		// WHEN
+		apps, err := client.ListApplicationsByName(context.TODO(), "foo")
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, apps, 3)
+		assert.Equal(t, "app-1", apps[0].ID)
+		assert.Equal(t, "app-3", apps[2].ID)
+	})
+
+	t.Run("error when request fails", func(t *testing.T) {
+		// GIVEN
+		gqlClient := &automock.GraphQLClient{}
+		gqlClient.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(testErr).Once()
+		defer gqlClient.AssertExpectations(t)
+
+		client := directorclient.NewClient(gqlClient, 2)
+
+		// WHEN
+		_, err := client.ListApplicationsByName(context.TODO(), "foo")
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+func TestClient_ListPackages(t *testing.T) {
+	t.Run("success - follows end cursor of packages and their APIs", func(t *testing.T) {
+		// GIVEN
+		gqlClient := &automock.GraphQLClient{}
+		gqlClient.On("Run", mock.Anything, queryWith("packages(first: 1)"), mock.Anything).
+			Run(respondWithApplication(func(app *graphql.ApplicationExt) {
+				app.Packages = fixPackagePage("pkg-cursor", fixPackage(packageID, "api-cursor", "api-1"))
+			})).Return(nil).Once()
+		gqlClient.On("Run", mock.Anything, queryWith(`packages(first: 1, after: "pkg-cursor")`), mock.Anything).
+			Run(respondWithApplication(func(app *graphql.ApplicationExt) {
+				app.Packages = fixPackagePage("", fixPackage("pkg-id-2", ""))
+			})).Return(nil).Once()
+		gqlClient.On("Run", mock.Anything, queryWith(`apiDefinitions(first: 1, after: "api-cursor")`), mock.Anything).
+			Run(respondWithApplication(func(app *graphql.ApplicationExt) {
+				app.Package = fixPackage(packageID, "", "api-2")
+			})).Return(nil).Once()
+		defer gqlClient.AssertExpectations(t)
+
+		client := directorclient.NewClient(gqlClient, 1)
+
+		// WHEN
+		packages, err := client.ListPackages(context.TODO(), appID)
+
+		// THEN
+		require.NoError(t, err)
+		require.Len(t, packages, 2)
+		assert.Equal(t, packageID, packages[0].ID)
+		require.Len(t, packages[0].APIDefinitions.Data, 2)
+		assert.Equal(t, "api-1", packages[0].APIDefinitions.Data[0].ID)
+		assert.Equal(t, "api-2", packages[0].APIDefinitions.Data[1].ID)
+		assert.Equal(t, "pkg-id-2", packages[1].ID)
+	})
+
+	t.Run("error when fetching next page of APIs fails", func(t *testing.T) {
+		// GIVEN
+		gqlClient := &automock.GraphQLClient{}
+		gqlClient.On("Run", mock.Anything, queryWith("packages(first: 1)"), mock.Anything).
+			Run(respondWithApplication(func(app *graphql.ApplicationExt) {
+				app.Packages = fixPackagePage("", fixPackage(packageID, "api-cursor", "api-1"))
+			})).Return(nil).Once()
+		gqlClient.On("Run", mock.Anything, queryWith("apiDefinitions"), mock.Anything).Return(testErr).Once()
+		defer gqlClient.AssertExpectations(t)
+
+		client := directorclient.NewClient(gqlClient, 1)
+
+		// WHEN
+		_, err := client.ListPackages(context.TODO(), appID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while getting apiDefinitions of package with ID pkg-id")
+	})
+}
+
+func TestClient_GetPackage(t *testing.T) {
+	t.Run("success - follows end cursor of events and documents", func(t *testing.T) {
+		// GIVEN
+		gqlClient := &automock.GraphQLClient{}
+		gqlClient.On("Run", mock.Anything, queryWith(`package(id: "pkg-id")`), mock.Anything).
+			Run(respondWithApplication(func(app *graphql.ApplicationExt) {
+				pkg := fixPackage(packageID, "")
+				pkg.EventDefinitions = graphql.EventAPIDefinitionPageExt{
+					EventDefinitionPage: graphql.EventDefinitionPage{PageInfo: fixPageInfo("event-cursor")},
+					Data:                []*graphql.EventAPIDefinitionExt{{EventDefinition: graphql.EventDefinition{ID: "event-1"}}},
+				}
+				pkg.Documents = graphql.DocumentPageExt{
+					DocumentPage: graphql.DocumentPage{PageInfo: fixPageInfo("doc-cursor")},
+					Data:         []*graphql.DocumentExt{{Document: graphql.Document{ID: "doc-1"}}},
+				}
+				app.Package = pkg
+			})).Return(nil).Once()
+		gqlClient.On("Run", mock.Anything, queryWith(`eventDefinitions(first: 100, after: "event-cursor")`), mock.Anything).
+			Run(respondWithApplication(func(app *graphql.ApplicationExt) {
+				app.Package.EventDefinitions = graphql.EventAPIDefinitionPageExt{
+					EventDefinitionPage: graphql.EventDefinitionPage{PageInfo: fixPageInfo("")},
+					Data:                []*graphql.EventAPIDefinitionExt{{EventDefinition: graphql.EventDefinition{ID: "event-2"}}},
+				}
+			})).Return(nil).Once()
+		gqlClient.On("Run", mock.Anything, queryWith(`documents(first: 100, after: "doc-cursor")`), mock.Anything).
+			Run(respondWithApplication(func(app *graphql.ApplicationExt) {
+				app.Package.Documents = graphql.DocumentPageExt{
+					DocumentPage: graphql.DocumentPage{PageInfo: fixPageInfo("")},
+					Data:         []*graphql.DocumentExt{{Document: graphql.Document{ID: "doc-2"}}},
+				}
+			})).Return(nil).Once()
+		defer gqlClient.AssertExpectations(t)
+
+		client := directorclient.NewClient(gqlClient, directorclient.DefaultPageSize)
+
+		// WHEN
+		pkg, err := client.GetPackage(context.TODO(), appID, packageID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, packageID, pkg.ID)
+		assert.Len(t, pkg.EventDefinitions.Data, 2)
+		assert.Len(t, pkg.Documents.Data, 2)
+	})
+
+	t.Run("error when request fails", func(t *testing.T) {
+		// GIVEN
+		gqlClient := &automock.GraphQLClient{}
+		gqlClient.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(testErr).Once()
+		defer gqlClient.AssertExpectations(t)
+
+		client := directorclient.NewClient(gqlClient, directorclient.DefaultPageSize)
+
+		// WHEN
+		_, err := client.GetPackage(context.TODO(), appID, packageID)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while getting package with ID pkg-id")
+	})
+}
+
+func queryWith(fragment string) interface{} {
+	return mock.MatchedBy(func(req *gcli.Request) bool {
+		return strings.Contains(req.Query(), fragment)
+	})
+}
+
+func respond(fill func(resp interface{})) func(args mock.Arguments) {
+	return func(args mock.Arguments) {
+		fill(args.Get(2))
+	}
+}
+
+func respondWithApplication(fill func(app *graphql.ApplicationExt)) func(args mock.Arguments) {
+	return func(args mock.Arguments) {
+		resp := args.Get(2).(*directorclient.ApplicationResult)
+		fill(&resp.Result)
+	}
+}
+
+func fixApplicationPage(endCursor string, ids ...string) graphql.ApplicationPageExt {
+	page := graphql.ApplicationPageExt{
+		ApplicationPage: graphql.ApplicationPage{PageInfo: fixPageInfo(endCursor)},
+	}
+	for _, id := range ids {
+		page.Data = append(page.Data, &graphql.ApplicationExt{Application: graphql.Application{ID: id}})
+	}
+	return page
+}
+
+func fixPackagePage(endCursor string, packages ...graphql.PackageExt) graphql.PackagePageExt {
+	page := graphql.PackagePageExt{
+		PackagePage: graphql.PackagePage{PageInfo: fixPageInfo(endCursor)},
+	}
+	for i := range packages {
+		page.Data = append(page.Data, &packages[i])
+	}
+	return page
+}
+
+func fixPackage(id, apiEndCursor string, apiIDs ...string) graphql.PackageExt {
+	pkg := graphql.PackageExt{
+		Package: graphql.Package{ID: id},
+		APIDefinitions: graphql.APIDefinitionPageExt{
+			APIDefinitionPage: graphql.APIDefinitionPage{PageInfo: fixPageInfo(apiEndCursor)},
+		},
+	}
+	for _, apiID := range apiIDs {
+		pkg.APIDefinitions.Data = append(pkg.APIDefinitions.Data, &graphql.APIDefinitionExt{APIDefinition: graphql.APIDefinition{ID: apiID}})
+	}
+	return pkg
+}
+
+func fixPageInfo(endCursor string) *graphql.PageInfo {
+	return &graphql.PageInfo{
+		EndCursor:   graphql.PageCursor(endCursor),
+		HasNextPage: endCursor != "",
+	}
+}
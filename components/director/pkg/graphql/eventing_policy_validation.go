@@ -0,0 +1,26 @@
+package graphql
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/pkg/errors"
+)
+
+func (i EventingPolicyInput) Validate() error {
+	return validation.Errors{
+		"Rule.PreferredLabel": i.validPreferredLabel(),
+		"type":                validation.Validate(i.Type, validation.Required, validation.In(EventingPolicyTypePinned, EventingPolicyTypeOldest, EventingPolicyTypeLabelPreferred)),
+		"preferredLabel":      validation.Validate(i.PreferredLabel),
+	}.Filter()
+}
+
+func (i EventingPolicyInput) validPreferredLabel() error {
+	if i.Type == EventingPolicyTypeLabelPreferred && i.PreferredLabel == nil {
+		return errors.Errorf("preferredLabel is required for %s policy", EventingPolicyTypeLabelPreferred)
+	}
+
+	if i.Type != EventingPolicyTypeLabelPreferred && i.PreferredLabel != nil {
+		return errors.Errorf("preferredLabel is allowed only for %s policy", EventingPolicyTypeLabelPreferred)
+	}
+
+	return nil
+}
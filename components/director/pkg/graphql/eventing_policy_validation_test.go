@@ -0,0 +1,88 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation/inputvalidationtest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventingPolicyInput_Validate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         graphql.EventingPolicyInput
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid - Oldest",
+			Value:         graphql.EventingPolicyInput{Type: graphql.EventingPolicyTypeOldest},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - Pinned",
+			Value:         graphql.EventingPolicyInput{Type: graphql.EventingPolicyTypePinned},
+			ExpectedValid: true,
+		},
+		{
+			Name: "ExpectedValid - Label preferred",
+			Value: graphql.EventingPolicyInput{
+				Type:           graphql.EventingPolicyTypeLabelPreferred,
+				PreferredLabel: &graphql.LabelSelectorInput{Key: "region", Value: "eu"},
+			},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - Empty type",
+			Value:         graphql.EventingPolicyInput{},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Unknown type",
+			Value:         graphql.EventingPolicyInput{Type: "NEWEST"},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Label preferred without preferred label",
+			Value:         graphql.EventingPolicyInput{Type: graphql.EventingPolicyTypeLabelPreferred},
+			ExpectedValid: false,
+		},
+		{
+			Name: "Invalid - Oldest with preferred label",
+			Value: graphql.EventingPolicyInput{
+				Type:           graphql.EventingPolicyTypeOldest,
+				PreferredLabel: &graphql.LabelSelectorInput{Key: "region", Value: "eu"},
+			},
+			ExpectedValid: false,
+		},
+		{
+			Name: "Invalid - Preferred label with unsupported key characters",
+			Value: graphql.EventingPolicyInput{
+				Type:           graphql.EventingPolicyTypeLabelPreferred,
+				PreferredLabel: &graphql.LabelSelectorInput{Key: "not/valid", Value: "eu"},
+			},
+			ExpectedValid: false,
+		},
+		{
+			Name: "Invalid - Preferred label with empty value",
+			Value: graphql.EventingPolicyInput{
+				Type:           graphql.EventingPolicyTypeLabelPreferred,
+				PreferredLabel: &graphql.LabelSelectorInput{Key: "region", Value: inputvalidationtest.EmptyString},
+			},
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//WHEN
+			err := testCase.Value.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
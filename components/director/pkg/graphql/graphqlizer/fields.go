@@ -112,6 +112,7 @@ func (fp *GqlFieldsProvider) ForApplicationTemplate(ctx ...FieldCtx) string {
 		applicationInput
 		placeholders {%s}
 		accessLevel
+		version
 	`, fp.ForPlaceholders())
 }
 
@@ -316,7 +317,13 @@ func (fp *GqlFieldsProvider) ForAuth() string {
 					clientId
 					clientSecret
 					url
-					
+					additionalHeaders
+					additionalQueryParams
+				}
+				... on CertificateGenCredentialData {
+					commonName
+					certificate
+					privateKey
 				}
 			}
 			additionalHeaders
@@ -333,7 +340,13 @@ func (fp *GqlFieldsProvider) ForAuth() string {
 					clientId
 					clientSecret
 					url
-					
+					additionalHeaders
+					additionalQueryParams
+				  }
+				  ... on CertificateGenCredentialData {
+					commonName
+					certificate
+					privateKey
 				  }
 			    }
 				additionalHeaders
@@ -400,7 +413,12 @@ func (fp *GqlFieldsProvider) ForIntegrationSystem() string {
 func (fp *GqlFieldsProvider) ForPlaceholders() string {
 	return `
 		name
-		description`
+		description
+		type
+		optional
+		defaultValue
+		allowedValues
+		jsonSchema`
 }
 
 func (fp *GqlFieldsProvider) ForEventingConfiguration() string {
@@ -498,3 +516,31 @@ func (fp *GqlFieldsProvider) ForAutomaticScenarioAssignment() string {
 		scenarioName
 		selector {%s}`, fp.ForLabel())
 }
+
+func (fp *GqlFieldsProvider) ForUser() string {
+	return `
+		id
+		username`
+}
+
+func (fp *GqlFieldsProvider) ForUserGroup() string {
+	return `
+		id
+		name`
+}
+
+func (fp *GqlFieldsProvider) ForRole() string {
+	return `
+		id
+		name
+		scopes`
+}
+
+func (fp *GqlFieldsProvider) ForRoleBinding() string {
+	return `
+		id
+		roleID
+		subjectType
+		subjectID
+		tenant`
+}
@@ -130,6 +130,10 @@ func (g *Graphqlizer) CredentialRequestAuthInputToGQL(in *graphql.CredentialRequ
 }
 
 func (g *Graphqlizer) CredentialDataInputToGQL(in *graphql.CredentialDataInput) (string, error) {
+	if in != nil && in.Oauth != nil {
+		in.Oauth.AdditionalHeadersSerialized = quoteHTTPHeadersSerialized(in.Oauth.AdditionalHeadersSerialized)
+		in.Oauth.AdditionalQueryParamsSerialized = quoteQueryParamsSerialized(in.Oauth.AdditionalQueryParamsSerialized)
+	}
 	return g.genericToGQL(in, ` {
 			{{- if .Basic }}
 			basic: {
@@ -142,6 +146,19 @@ func (g *Graphqlizer) CredentialDataInputToGQL(in *graphql.CredentialDataInput)
 				clientId: "{{ .Oauth.ClientID }}",
 				clientSecret: "{{ .Oauth.ClientSecret }}",
 				url: "{{ .Oauth.URL }}",
+				{{- if .Oauth.AdditionalHeadersSerialized }}
+				additionalHeadersSerialized: {{ .Oauth.AdditionalHeadersSerialized }},
+				{{- end }}
+				{{- if .Oauth.AdditionalQueryParamsSerialized }}
+				additionalQueryParamsSerialized: {{ .Oauth.AdditionalQueryParamsSerialized }},
+				{{- end }}
+			},
+			{{- end }}
+			{{- if .CertificateGen }}
+			certificateGen: {
+				commonName: "{{ .CertificateGen.CommonName }}",
+				certificate: {{ marshal .CertificateGen.Certificate }},
+				privateKey: {{ marshal .CertificateGen.PrivateKey }},
 			},
 			{{- end }}
 	}`)
@@ -362,6 +379,24 @@ func (g *Graphqlizer) PlaceholderDefinitionInputToGQL(in graphql.PlaceholderDefi
 		{{- if .Description }}
 		description: "{{.Description}}",
 		{{- end }}
+		{{- if .Type }}
+		type: {{.Type}},
+		{{- end }}
+		{{- if .Optional }}
+		optional: {{.Optional}},
+		{{- end }}
+		{{- if .DefaultValue }}
+		defaultValue: "{{.DefaultValue}}",
+		{{- end }}
+		{{- if .AllowedValues }}
+		allowedValues: [
+			{{- range $i, $e := .AllowedValues }}
+				{{- if $i}}, {{- end}} "{{ $e }}"
+			{{- end }} ],
+		{{- end }}
+		{{- if .JSONSchema }}
+		jsonSchema: {{.JSONSchema}},
+		{{- end }}
 	}`)
 }
 
@@ -384,6 +419,17 @@ func (g *Graphqlizer) ApplicationFromTemplateInputToGQL(in graphql.ApplicationFr
 	}`)
 }
 
+func (g *Graphqlizer) ApplicationFromTemplateUpgradeInputToGQL(in graphql.ApplicationFromTemplateUpgradeInput) (string, error) {
+	return g.genericToGQL(in, `{
+		{{- if .Values }}
+		values: [
+			{{- range $i, $e := .Values }}
+				{{- if $i}}, {{- end}} {{ TemplateValueInput $e }}
+			{{- end }} ],
+		{{- end }}
+	}`)
+}
+
 func (g *Graphqlizer) PackageCreateInputToGQL(in graphql.PackageCreateInput) (string, error) {
 	return g.genericToGQL(in, `{
 		name: "{{ .Name }}"
@@ -480,6 +526,39 @@ func (g *Graphqlizer) AutomaticScenarioAssignmentSetInputToGQL(in graphql.Automa
 	}`)
 }
 
+func (g *Graphqlizer) UserInputToGQL(in graphql.UserInput) (string, error) {
+	return g.genericToGQL(in, `{
+		username: "{{ .Username }}"
+	}`)
+}
+
+func (g *Graphqlizer) UserGroupInputToGQL(in graphql.UserGroupInput) (string, error) {
+	return g.genericToGQL(in, `{
+		name: "{{ .Name }}"
+	}`)
+}
+
+func (g *Graphqlizer) RoleInputToGQL(in graphql.RoleInput) (string, error) {
+	return g.genericToGQL(in, `{
+		name: "{{ .Name }}"
+		scopes: [
+			{{- range $i, $e := .Scopes }}
+				{{- if $i}}, {{- end}} "{{ $e }}"
+			{{- end }} ]
+	}`)
+}
+
+func (g *Graphqlizer) RoleBindingInputToGQL(in graphql.RoleBindingInput) (string, error) {
+	return g.genericToGQL(in, `{
+		roleID: "{{ .RoleID }}"
+		subjectType: {{ .SubjectType }}
+		subjectID: "{{ .SubjectID }}"
+		{{- if .Tenant }}
+		tenant: "{{ .Tenant }}"
+		{{- end }}
+	}`)
+}
+
 func (g *Graphqlizer) marshal(obj interface{}) string {
 	var out string
 
@@ -50,3 +50,28 @@ func (i LabelDefinitionInput) validateScenariosSchema() error {
 
 	return nil
 }
+
+func (i LabelDefinitionMigrationInput) Validate() error {
+	return validation.Errors{
+		"rule.defaultValue": i.validateDefaultValue(),
+		"onInvalid":         validation.Validate(i.OnInvalid, validation.NilOrNotEmpty, validation.In(LabelMigrationActionFail, LabelMigrationActionSetDefault, LabelMigrationActionDelete)),
+		"valueMappings":     validation.Validate(i.ValueMappings),
+	}.Filter()
+}
+
+func (i LabelDefinitionMigrationInput) validateDefaultValue() error {
+	if i.OnInvalid == nil || *i.OnInvalid != LabelMigrationActionSetDefault {
+		return nil
+	}
+	if i.DefaultValue == nil || *i.DefaultValue == nil {
+		return errors.Errorf("defaultValue is required for %s action", LabelMigrationActionSetDefault)
+	}
+	return nil
+}
+
+func (i LabelValueMappingInput) Validate() error {
+	return validation.Errors{
+		"from": validation.Validate(i.From, validation.NotNil),
+		"to":   validation.Validate(i.To, validation.NotNil),
+	}.Filter()
+}
@@ -237,3 +237,72 @@ func fixScenariosSchema(t *testing.T) *graphql.JSONSchema {
 	require.NoError(t, err)
 	return jsonSchemaPtr(string(marshalled))
 }
+
+func TestLabelDefinitionMigrationInput_Validate(t *testing.T) {
+	fail := graphql.LabelMigrationActionFail
+	setDefault := graphql.LabelMigrationActionSetDefault
+	deleteAction := graphql.LabelMigrationActionDelete
+	unknown := graphql.LabelMigrationAction("UNKNOWN")
+	var defaultValue interface{} = "default"
+	var nilValue interface{}
+
+	testCases := []struct {
+		Name          string
+		Value         graphql.LabelDefinitionMigrationInput
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid - Empty",
+			Value:         graphql.LabelDefinitionMigrationInput{},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - Fail",
+			Value:         graphql.LabelDefinitionMigrationInput{OnInvalid: &fail},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - Delete with value mappings",
+			Value:         graphql.LabelDefinitionMigrationInput{OnInvalid: &deleteAction, ValueMappings: []*graphql.LabelValueMappingInput{{From: "a", To: "b"}}},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - Set default with default value",
+			Value:         graphql.LabelDefinitionMigrationInput{OnInvalid: &setDefault, DefaultValue: &defaultValue},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - Set default without default value",
+			Value:         graphql.LabelDefinitionMigrationInput{OnInvalid: &setDefault},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Set default with null default value",
+			Value:         graphql.LabelDefinitionMigrationInput{OnInvalid: &setDefault, DefaultValue: &nilValue},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Unknown action",
+			Value:         graphql.LabelDefinitionMigrationInput{OnInvalid: &unknown},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Value mapping without target value",
+			Value:         graphql.LabelDefinitionMigrationInput{ValueMappings: []*graphql.LabelValueMappingInput{{From: "a"}}},
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//WHEN
+			err := testCase.Value.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
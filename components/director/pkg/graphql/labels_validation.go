@@ -10,3 +10,10 @@ func (i LabelInput) Validate() error {
 		validation.Field(&i.Value, validation.Required),
 	)
 }
+
+func (i LabelSelectorInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.Key, validation.Required, validation.RuneLength(0, longStringLengthLimit), validation.Match(alphanumericUnderscoreRegexp)),
+		validation.Field(&i.Value, validation.Required, validation.RuneLength(0, longStringLengthLimit)),
+	)
+}
@@ -55,6 +55,19 @@ type APISpecInput struct {
 	FetchRequest *FetchRequestInput `json:"fetchRequest"`
 }
 
+type ApplicationChange struct {
+	Resource  ApplicationChangeResource  `json:"resource"`
+	Operation ApplicationChangeOperation `json:"operation"`
+	// Package name, webhook type or label key. API Definitions, Event Definitions and Documents are identified by the Package name and their name or title, separated by a slash
+	Key string `json:"key"`
+}
+
+type ApplicationEventingBinding struct {
+	ApplicationID string          `json:"applicationID"`
+	RuntimeID     string          `json:"runtimeID"`
+	Policy        *EventingPolicy `json:"policy"`
+}
+
 type ApplicationEventingConfiguration struct {
 	DefaultURL string `json:"defaultURL"`
 }
@@ -66,6 +79,18 @@ type ApplicationFromTemplateInput struct {
 	Values       []*TemplateValueInput `json:"values"`
 }
 
+type ApplicationFromTemplateUpgrade struct {
+	FromVersion int                  `json:"fromVersion"`
+	ToVersion   int                  `json:"toVersion"`
+	Changes     []*ApplicationChange `json:"changes"`
+}
+
+// **Validation:** provided placeholders' names are unique
+type ApplicationFromTemplateUpgradeInput struct {
+	// Values overriding the placeholder values stored for the Application. Values of other placeholders are taken from the Application
+	Values []*TemplateValueInput `json:"values"`
+}
+
 type ApplicationPage struct {
 	Data       []*Application `json:"data"`
 	PageInfo   *PageInfo      `json:"pageInfo"`
@@ -81,7 +106,7 @@ type ApplicationRegisterInput struct {
 	ProviderName *string `json:"providerName"`
 	// **Validation:** max=2000
 	Description *string `json:"description"`
-	// **Validation:** label key is alphanumeric with underscore
+	// **Validation:** label key is alphanumeric with underscore, eventingPolicy key is reserved
 	Labels   *Labels         `json:"labels"`
 	Webhooks []*WebhookInput `json:"webhooks"`
 	// **Validation:** valid URL, max=256
@@ -91,6 +116,24 @@ type ApplicationRegisterInput struct {
 	StatusCondition     *ApplicationStatusCondition `json:"statusCondition"`
 }
 
+// Grant of read-only access to the Application for the Runtimes and Runtime Contexts of another tenant
+type ApplicationShare struct {
+	ID            string `json:"id"`
+	ApplicationID string `json:"applicationID"`
+	// External ID of the tenant which the Application is shared with
+	TargetTenant string  `json:"targetTenant"`
+	Scenario     *string `json:"scenario"`
+}
+
+type ApplicationShareInput struct {
+	// External ID of the tenant which the Application is shared with
+	TargetTenant string `json:"targetTenant"`
+	// If provided, the Application is shared only with the Runtimes and Runtime Contexts in the scenario of the target tenant
+	//
+	// **Validation:** max=128, alphanumeric characters, hyphens, underscores and spaces, starting and ending with an alphanumeric character
+	Scenario *string `json:"scenario"`
+}
+
 type ApplicationStatus struct {
 	Condition ApplicationStatusCondition `json:"condition"`
 	Timestamp Timestamp                  `json:"timestamp"`
@@ -103,6 +146,8 @@ type ApplicationTemplate struct {
 	ApplicationInput string                         `json:"applicationInput"`
 	Placeholders     []*PlaceholderDefinition       `json:"placeholders"`
 	AccessLevel      ApplicationTemplateAccessLevel `json:"accessLevel"`
+	// Incremented on every update of the Application Template
+	Version int `json:"version"`
 }
 
 // **Validation:** provided placeholders' names are unique and used in applicationInput
@@ -124,6 +169,14 @@ type ApplicationTemplatePage struct {
 
 func (ApplicationTemplatePage) IsPageable() {}
 
+type ApplicationTemplateReference struct {
+	// Null if the Application Template was deleted
+	ApplicationTemplateID *string `json:"applicationTemplateID"`
+	// Version of the Application Template the Application was last registered or upgraded from
+	Version int              `json:"version"`
+	Values  []*TemplateValue `json:"values"`
+}
+
 type ApplicationUpdateInput struct {
 	// **Validation:** max=256
 	ProviderName *string `json:"providerName"`
@@ -207,10 +260,28 @@ type CSRFTokenCredentialRequestAuthInput struct {
 	AdditionalQueryParamsSerialized *QueryParamsSerialized `json:"additionalQueryParamsSerialized"`
 }
 
-// **Validation:** basic or oauth field required
+type CertificateGenCredentialData struct {
+	CommonName  string `json:"commonName"`
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"privateKey"`
+}
+
+func (CertificateGenCredentialData) IsCredentialData() {}
+
+type CertificateGenCredentialDataInput struct {
+	// **Validation:** max=64
+	CommonName string `json:"commonName"`
+	// PEM encoded certificate generated for the common name
+	Certificate string `json:"certificate"`
+	// PEM encoded private key of the certificate
+	PrivateKey string `json:"privateKey"`
+}
+
+// **Validation:** exactly one of basic, oauth or certificateGen fields required
 type CredentialDataInput struct {
-	Basic *BasicCredentialDataInput `json:"basic"`
-	Oauth *OAuthCredentialDataInput `json:"oauth"`
+	Basic          *BasicCredentialDataInput          `json:"basic"`
+	Oauth          *OAuthCredentialDataInput          `json:"oauth"`
+	CertificateGen *CertificateGenCredentialDataInput `json:"certificateGen"`
 }
 
 type CredentialRequestAuth struct {
@@ -273,6 +344,17 @@ type EventSpecInput struct {
 	FetchRequest *FetchRequestInput `json:"fetchRequest"`
 }
 
+type EventingPolicy struct {
+	Type           EventingPolicyType `json:"type"`
+	PreferredLabel *Label             `json:"preferredLabel"`
+}
+
+// - preferredLabel is required for LABEL_PREFERRED type and not allowed for other types
+type EventingPolicyInput struct {
+	Type           EventingPolicyType  `json:"type"`
+	PreferredLabel *LabelSelectorInput `json:"preferredLabel"`
+}
+
 // Compass performs fetch to validate if request is correct and stores a copy
 type FetchRequest struct {
 	URL    string              `json:"url"`
@@ -285,7 +367,7 @@ type FetchRequest struct {
 type FetchRequestInput struct {
 	// **Validation:** valid URL, max=256
 	URL string `json:"url"`
-	// Currently unsupported, providing it will result in a failure
+	// Credentials and additional headers and query parameters used when fetching the specification. Request auth is currently unsupported, providing it will result in a failure
 	Auth *AuthInput `json:"auth"`
 	// Currently unsupported, providing it will result in a failure
 	Mode *FetchMode `json:"mode"`
@@ -336,6 +418,14 @@ type Label struct {
 	Value interface{} `json:"value"`
 }
 
+type LabelChange struct {
+	ObjectType string          `json:"objectType"`
+	ObjectID   string          `json:"objectID"`
+	Type       LabelChangeType `json:"type"`
+	OldValue   *interface{}    `json:"oldValue"`
+	NewValue   *interface{}    `json:"newValue"`
+}
+
 type LabelDefinition struct {
 	Key    string      `json:"key"`
 	Schema *JSONSchema `json:"schema"`
@@ -347,6 +437,22 @@ type LabelDefinitionInput struct {
 	Schema *JSONSchema `json:"schema"`
 }
 
+// Describes how the existing labels are migrated to the new schema of the label definition. The value mappings are applied first, and then the onInvalid action is applied to the labels which still do not match the schema.
+type LabelDefinitionMigrationInput struct {
+	ValueMappings []*LabelValueMappingInput `json:"valueMappings"`
+	OnInvalid     *LabelMigrationAction     `json:"onInvalid"`
+	// **Validation:** required if onInvalid is SET_DEFAULT, must match the new schema
+	DefaultValue *interface{} `json:"defaultValue"`
+	// If true, the changes are only reported, without being applied
+	DryRun *bool `json:"dryRun"`
+}
+
+type LabelDefinitionMigrationResult struct {
+	LabelDefinition *LabelDefinition `json:"labelDefinition"`
+	DryRun          bool             `json:"dryRun"`
+	Changes         []*LabelChange   `json:"changes"`
+}
+
 type LabelFilter struct {
 	// Label key. If query for the filter is not provided, returns every object with given label key regardless of its value.
 	Key string `json:"key"`
@@ -366,11 +472,19 @@ type LabelSelectorInput struct {
 	Value string `json:"value"`
 }
 
+// Replaces the label value, or the element of the label value if it is an array, equal to from with to
+type LabelValueMappingInput struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
 type OAuthCredentialData struct {
 	ClientID     string `json:"clientId"`
 	ClientSecret string `json:"clientSecret"`
 	// URL for getting access token
-	URL string `json:"url"`
+	URL                   string       `json:"url"`
+	AdditionalHeaders     *HttpHeaders `json:"additionalHeaders"`
+	AdditionalQueryParams *QueryParams `json:"additionalQueryParams"`
 }
 
 func (OAuthCredentialData) IsCredentialData() {}
@@ -380,6 +494,10 @@ type OAuthCredentialDataInput struct {
 	ClientSecret string `json:"clientSecret"`
 	// **Validation:** valid URL
 	URL string `json:"url"`
+	// Headers sent with the access token request
+	AdditionalHeadersSerialized *HttpHeadersSerialized `json:"additionalHeadersSerialized"`
+	// Query parameters sent with the access token request
+	AdditionalQueryParamsSerialized *QueryParamsSerialized `json:"additionalQueryParamsSerialized"`
 }
 
 type PackageCreateInput struct {
@@ -396,6 +514,8 @@ type PackageCreateInput struct {
 
 type PackageInstanceAuth struct {
 	ID string `json:"id"`
+	// ID of the Runtime Context which requested the PackageInstanceAuth. Empty if it was requested by a Runtime.
+	RuntimeContextID *string `json:"runtimeContextID"`
 	// Context of PackageInstanceAuth - such as Runtime ID, namespace
 	Context *JSON `json:"context"`
 	// User input while requesting Package Instance Auth
@@ -474,8 +594,13 @@ type PageInfo struct {
 }
 
 type PlaceholderDefinition struct {
-	Name        string  `json:"name"`
-	Description *string `json:"description"`
+	Name          string          `json:"name"`
+	Description   *string         `json:"description"`
+	Type          PlaceholderType `json:"type"`
+	Optional      bool            `json:"optional"`
+	DefaultValue  *string         `json:"defaultValue"`
+	AllowedValues []string        `json:"allowedValues"`
+	JSONSchema    *JSONSchema     `json:"jsonSchema"`
 }
 
 type PlaceholderDefinitionInput struct {
@@ -483,6 +608,44 @@ type PlaceholderDefinitionInput struct {
 	Name string `json:"name"`
 	// **Validation:**  max=2000
 	Description *string `json:"description"`
+	// Defaults to STRING
+	Type *PlaceholderType `json:"type"`
+	// Optional placeholders without provided value and default value are rendered as null (if the placeholder is the whole JSON value) or as an empty string
+	Optional *bool `json:"optional"`
+	// **Validation:**  max=256, must be a valid value for the placeholder type
+	DefaultValue *string `json:"defaultValue"`
+	// **Validation:**  required for ENUM type, not allowed for other types
+	AllowedValues []string `json:"allowedValues"`
+	// Schema which the typed placeholder value is validated against
+	JSONSchema *JSONSchema `json:"jsonSchema"`
+}
+
+type Role struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
+
+type RoleBinding struct {
+	ID          string                 `json:"id"`
+	RoleID      string                 `json:"roleID"`
+	SubjectType RoleBindingSubjectType `json:"subjectType"`
+	SubjectID   string                 `json:"subjectID"`
+	Tenant      *string                `json:"tenant"`
+}
+
+type RoleBindingInput struct {
+	RoleID      string                 `json:"roleID"`
+	SubjectType RoleBindingSubjectType `json:"subjectType"`
+	// ID of the User or the UserGroup, depending on the subjectType
+	SubjectID string `json:"subjectID"`
+	// External ID of the tenant in which the Role is granted. If not provided, the Role is granted in every tenant
+	Tenant *string `json:"tenant"`
+}
+
+type RoleInput struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
 }
 
 type RuntimeContextInput struct {
@@ -532,11 +695,25 @@ type RuntimeStatus struct {
 	Timestamp Timestamp              `json:"timestamp"`
 }
 
+type ScenarioInput struct {
+	// **Validation:** required max=128, alphanumeric characters, hyphens, underscores and spaces, starting and ending with an alphanumeric character
+	Name string `json:"name"`
+	// **Validation:**  max=2000
+	Description *string `json:"description"`
+	// **Validation:**  max=256
+	Owner *string `json:"owner"`
+}
+
 type SystemAuth struct {
 	ID   string `json:"id"`
 	Auth *Auth  `json:"auth"`
 }
 
+type TemplateValue struct {
+	Placeholder string `json:"placeholder"`
+	Value       string `json:"value"`
+}
+
 type TemplateValueInput struct {
 	// **Validation:**  Up to 36 characters long. Cannot start with a digit. The characters allowed in names are: digits (0-9), lower case letters (a-z),-, and .
 	Placeholder string `json:"placeholder"`
@@ -550,6 +727,40 @@ type Tenant struct {
 	Initialized *bool   `json:"initialized"`
 }
 
+type TenantOffboarding struct {
+	ID string `json:"id"`
+	// External ID of the offboarded tenant
+	Tenant          string                 `json:"tenant"`
+	State           TenantOffboardingState `json:"state"`
+	ExportRequested bool                   `json:"exportRequested"`
+	// Data of the tenant. Available after the export is completed and until the data is purged
+	ExportedData *JSON `json:"exportedData"`
+	// Reason why the last attempt to complete the next step failed
+	Error     *string   `json:"error"`
+	CreatedAt Timestamp `json:"createdAt"`
+	UpdatedAt Timestamp `json:"updatedAt"`
+	// Time after which the data of the tenant is purged. Set when the credentials of the tenant are revoked
+	PurgeAfter *Timestamp `json:"purgeAfter"`
+}
+
+type User struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+}
+
+type UserGroup struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type UserGroupInput struct {
+	Name string `json:"name"`
+}
+
+type UserInput struct {
+	Username string `json:"username"`
+}
+
 type Version struct {
 	// for example 4.6
 	Value      string `json:"value"`
@@ -630,6 +841,99 @@ func (e APISpecType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ApplicationChangeOperation string
+
+const (
+	ApplicationChangeOperationCreate ApplicationChangeOperation = "CREATE"
+	ApplicationChangeOperationUpdate ApplicationChangeOperation = "UPDATE"
+	// The resource is no longer defined in the template. It is kept in the Application and has to be deleted manually if it is not needed
+	ApplicationChangeOperationRemoved ApplicationChangeOperation = "REMOVED"
+)
+
+var AllApplicationChangeOperation = []ApplicationChangeOperation{
+	ApplicationChangeOperationCreate,
+	ApplicationChangeOperationUpdate,
+	ApplicationChangeOperationRemoved,
+}
+
+func (e ApplicationChangeOperation) IsValid() bool {
+	switch e {
+	case ApplicationChangeOperationCreate, ApplicationChangeOperationUpdate, ApplicationChangeOperationRemoved:
+		return true
+	}
+	return false
+}
+
+func (e ApplicationChangeOperation) String() string {
+	return string(e)
+}
+
+func (e *ApplicationChangeOperation) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ApplicationChangeOperation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ApplicationChangeOperation", str)
+	}
+	return nil
+}
+
+func (e ApplicationChangeOperation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ApplicationChangeResource string
+
+const (
+	ApplicationChangeResourcePackage         ApplicationChangeResource = "PACKAGE"
+	ApplicationChangeResourceAPIDefinition   ApplicationChangeResource = "API_DEFINITION"
+	ApplicationChangeResourceEventDefinition ApplicationChangeResource = "EVENT_DEFINITION"
+	ApplicationChangeResourceDocument        ApplicationChangeResource = "DOCUMENT"
+	ApplicationChangeResourceWebhook         ApplicationChangeResource = "WEBHOOK"
+	ApplicationChangeResourceLabel           ApplicationChangeResource = "LABEL"
+)
+
+var AllApplicationChangeResource = []ApplicationChangeResource{
+	ApplicationChangeResourcePackage,
+	ApplicationChangeResourceAPIDefinition,
+	ApplicationChangeResourceEventDefinition,
+	ApplicationChangeResourceDocument,
+	ApplicationChangeResourceWebhook,
+	ApplicationChangeResourceLabel,
+}
+
+func (e ApplicationChangeResource) IsValid() bool {
+	switch e {
+	case ApplicationChangeResourcePackage, ApplicationChangeResourceAPIDefinition, ApplicationChangeResourceEventDefinition, ApplicationChangeResourceDocument, ApplicationChangeResourceWebhook, ApplicationChangeResourceLabel:
+		return true
+	}
+	return false
+}
+
+func (e ApplicationChangeResource) String() string {
+	return string(e)
+}
+
+func (e *ApplicationChangeResource) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ApplicationChangeResource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ApplicationChangeResource", str)
+	}
+	return nil
+}
+
+func (e ApplicationChangeResource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ApplicationStatusCondition string
 
 const (
@@ -677,15 +981,17 @@ type ApplicationTemplateAccessLevel string
 
 const (
 	ApplicationTemplateAccessLevelGlobal ApplicationTemplateAccessLevel = "GLOBAL"
+	ApplicationTemplateAccessLevelTenant ApplicationTemplateAccessLevel = "TENANT"
 )
 
 var AllApplicationTemplateAccessLevel = []ApplicationTemplateAccessLevel{
 	ApplicationTemplateAccessLevelGlobal,
+	ApplicationTemplateAccessLevelTenant,
 }
 
 func (e ApplicationTemplateAccessLevel) IsValid() bool {
 	switch e {
-	case ApplicationTemplateAccessLevelGlobal:
+	case ApplicationTemplateAccessLevelGlobal, ApplicationTemplateAccessLevelTenant:
 		return true
 	}
 	return false
@@ -829,6 +1135,49 @@ func (e EventSpecType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type EventingPolicyType string
+
+const (
+	EventingPolicyTypePinned         EventingPolicyType = "PINNED"
+	EventingPolicyTypeOldest         EventingPolicyType = "OLDEST"
+	EventingPolicyTypeLabelPreferred EventingPolicyType = "LABEL_PREFERRED"
+)
+
+var AllEventingPolicyType = []EventingPolicyType{
+	EventingPolicyTypePinned,
+	EventingPolicyTypeOldest,
+	EventingPolicyTypeLabelPreferred,
+}
+
+func (e EventingPolicyType) IsValid() bool {
+	switch e {
+	case EventingPolicyTypePinned, EventingPolicyTypeOldest, EventingPolicyTypeLabelPreferred:
+		return true
+	}
+	return false
+}
+
+func (e EventingPolicyType) String() string {
+	return string(e)
+}
+
+func (e *EventingPolicyType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EventingPolicyType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EventingPolicyType", str)
+	}
+	return nil
+}
+
+func (e EventingPolicyType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type FetchMode string
 
 const (
@@ -995,6 +1344,97 @@ func (e HealthCheckType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type LabelChangeType string
+
+const (
+	LabelChangeTypeMapped    LabelChangeType = "MAPPED"
+	LabelChangeTypeDefaulted LabelChangeType = "DEFAULTED"
+	LabelChangeTypeDeleted   LabelChangeType = "DELETED"
+	LabelChangeTypeInvalid   LabelChangeType = "INVALID"
+)
+
+var AllLabelChangeType = []LabelChangeType{
+	LabelChangeTypeMapped,
+	LabelChangeTypeDefaulted,
+	LabelChangeTypeDeleted,
+	LabelChangeTypeInvalid,
+}
+
+func (e LabelChangeType) IsValid() bool {
+	switch e {
+	case LabelChangeTypeMapped, LabelChangeTypeDefaulted, LabelChangeTypeDeleted, LabelChangeTypeInvalid:
+		return true
+	}
+	return false
+}
+
+func (e LabelChangeType) String() string {
+	return string(e)
+}
+
+func (e *LabelChangeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LabelChangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LabelChangeType", str)
+	}
+	return nil
+}
+
+func (e LabelChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type LabelMigrationAction string
+
+const (
+	// Rejects the migration if any label does not match the new schema
+	LabelMigrationActionFail LabelMigrationAction = "FAIL"
+	// Replaces the labels which do not match the new schema with the default value
+	LabelMigrationActionSetDefault LabelMigrationAction = "SET_DEFAULT"
+	// Deletes the labels which do not match the new schema
+	LabelMigrationActionDelete LabelMigrationAction = "DELETE"
+)
+
+var AllLabelMigrationAction = []LabelMigrationAction{
+	LabelMigrationActionFail,
+	LabelMigrationActionSetDefault,
+	LabelMigrationActionDelete,
+}
+
+func (e LabelMigrationAction) IsValid() bool {
+	switch e {
+	case LabelMigrationActionFail, LabelMigrationActionSetDefault, LabelMigrationActionDelete:
+		return true
+	}
+	return false
+}
+
+func (e LabelMigrationAction) String() string {
+	return string(e)
+}
+
+func (e *LabelMigrationAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LabelMigrationAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LabelMigrationAction", str)
+	}
+	return nil
+}
+
+func (e LabelMigrationAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type PackageInstanceAuthSetStatusConditionInput string
 
 const (
@@ -1083,6 +1523,94 @@ func (e PackageInstanceAuthStatusCondition) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type PlaceholderType string
+
+const (
+	PlaceholderTypeString  PlaceholderType = "STRING"
+	PlaceholderTypeURL     PlaceholderType = "URL"
+	PlaceholderTypeEnum    PlaceholderType = "ENUM"
+	PlaceholderTypeBoolean PlaceholderType = "BOOLEAN"
+	PlaceholderTypeNumber  PlaceholderType = "NUMBER"
+)
+
+var AllPlaceholderType = []PlaceholderType{
+	PlaceholderTypeString,
+	PlaceholderTypeURL,
+	PlaceholderTypeEnum,
+	PlaceholderTypeBoolean,
+	PlaceholderTypeNumber,
+}
+
+func (e PlaceholderType) IsValid() bool {
+	switch e {
+	case PlaceholderTypeString, PlaceholderTypeURL, PlaceholderTypeEnum, PlaceholderTypeBoolean, PlaceholderTypeNumber:
+		return true
+	}
+	return false
+}
+
+func (e PlaceholderType) String() string {
+	return string(e)
+}
+
+func (e *PlaceholderType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PlaceholderType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PlaceholderType", str)
+	}
+	return nil
+}
+
+func (e PlaceholderType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RoleBindingSubjectType string
+
+const (
+	RoleBindingSubjectTypeUser  RoleBindingSubjectType = "USER"
+	RoleBindingSubjectTypeGroup RoleBindingSubjectType = "GROUP"
+)
+
+var AllRoleBindingSubjectType = []RoleBindingSubjectType{
+	RoleBindingSubjectTypeUser,
+	RoleBindingSubjectTypeGroup,
+}
+
+func (e RoleBindingSubjectType) IsValid() bool {
+	switch e {
+	case RoleBindingSubjectTypeUser, RoleBindingSubjectTypeGroup:
+		return true
+	}
+	return false
+}
+
+func (e RoleBindingSubjectType) String() string {
+	return string(e)
+}
+
+func (e *RoleBindingSubjectType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RoleBindingSubjectType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RoleBindingSubjectType", str)
+	}
+	return nil
+}
+
+func (e RoleBindingSubjectType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type RuntimeStatusCondition string
 
 const (
@@ -1171,10 +1699,56 @@ func (e SpecFormat) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type TenantOffboardingState string
+
+const (
+	TenantOffboardingStateDeactivated        TenantOffboardingState = "DEACTIVATED"
+	TenantOffboardingStateCredentialsRevoked TenantOffboardingState = "CREDENTIALS_REVOKED"
+	TenantOffboardingStateExported           TenantOffboardingState = "EXPORTED"
+	TenantOffboardingStatePurged             TenantOffboardingState = "PURGED"
+)
+
+var AllTenantOffboardingState = []TenantOffboardingState{
+	TenantOffboardingStateDeactivated,
+	TenantOffboardingStateCredentialsRevoked,
+	TenantOffboardingStateExported,
+	TenantOffboardingStatePurged,
+}
+
+func (e TenantOffboardingState) IsValid() bool {
+	switch e {
+	case TenantOffboardingStateDeactivated, TenantOffboardingStateCredentialsRevoked, TenantOffboardingStateExported, TenantOffboardingStatePurged:
+		return true
+	}
+	return false
+}
+
+func (e TenantOffboardingState) String() string {
+	return string(e)
+}
+
+func (e *TenantOffboardingState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TenantOffboardingState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TenantOffboardingState", str)
+	}
+	return nil
+}
+
+func (e TenantOffboardingState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ViewerType string
 
 const (
 	ViewerTypeRuntime           ViewerType = "RUNTIME"
+	ViewerTypeRuntimeContext    ViewerType = "RUNTIME_CONTEXT"
 	ViewerTypeApplication       ViewerType = "APPLICATION"
 	ViewerTypeIntegrationSystem ViewerType = "INTEGRATION_SYSTEM"
 	ViewerTypeUser              ViewerType = "USER"
@@ -1182,6 +1756,7 @@ const (
 
 var AllViewerType = []ViewerType{
 	ViewerTypeRuntime,
+	ViewerTypeRuntimeContext,
 	ViewerTypeApplication,
 	ViewerTypeIntegrationSystem,
 	ViewerTypeUser,
@@ -1189,7 +1764,7 @@ var AllViewerType = []ViewerType{
 
 func (e ViewerType) IsValid() bool {
 	switch e {
-	case ViewerTypeRuntime, ViewerTypeApplication, ViewerTypeIntegrationSystem, ViewerTypeUser:
+	case ViewerTypeRuntime, ViewerTypeRuntimeContext, ViewerTypeApplication, ViewerTypeIntegrationSystem, ViewerTypeUser:
 		return true
 	}
 	return false
@@ -7,6 +7,8 @@ type Package struct {
 	InstanceAuthRequestInputSchema *JSONSchema `json:"InstanceAuthRequestInputSchema"`
 	// When defined, all Auth requests fallback to defaultAuth.
 	DefaultInstanceAuth *Auth `json:"defaultInstanceAuth"`
+	// OwnerTenant is set only for Packages of Applications shared from another tenant. It is not exposed in the API.
+	OwnerTenant string `json:"-"`
 }
 
 type PackageExt struct {
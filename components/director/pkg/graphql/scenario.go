@@ -0,0 +1,7 @@
+package graphql
+
+type Scenario struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	Owner       *string `json:"owner"`
+}
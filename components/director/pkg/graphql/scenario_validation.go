@@ -0,0 +1,18 @@
+package graphql
+
+import (
+	"regexp"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+var scenarioNameRegexp = regexp.MustCompile(model.ScenarioNamePattern)
+
+func (i ScenarioInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.Name, validation.Required, validation.RuneLength(0, shortStringLengthLimit), validation.Match(scenarioNameRegexp)),
+		validation.Field(&i.Description, validation.RuneLength(0, descriptionStringLengthLimit)),
+		validation.Field(&i.Owner, validation.RuneLength(0, longStringLengthLimit)),
+	)
+}
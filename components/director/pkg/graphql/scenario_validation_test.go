@@ -0,0 +1,151 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation/inputvalidationtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScenarioInput_Validate_Name(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         "MARKETING",
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - With hyphens, underscores and spaces",
+			Value:         "Marketing team_1-eu",
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - Empty",
+			Value:         inputvalidationtest.EmptyString,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Too long",
+			Value:         inputvalidationtest.String129Long,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Ends with hyphen",
+			Value:         "MARKETING-",
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - Invalid characters",
+			Value:         "marketing/eu",
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidScenarioInput()
+			sut.Name = testCase.Value
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestScenarioInput_Validate_Description(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         *string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         str.Ptr("Systems of the marketing department"),
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - Nil",
+			Value:         nil,
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - Too long",
+			Value:         str.Ptr(inputvalidationtest.String2001Long),
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidScenarioInput()
+			sut.Description = testCase.Value
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestScenarioInput_Validate_Owner(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         *string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         str.Ptr("marketing-team"),
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - Nil",
+			Value:         nil,
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - Too long",
+			Value:         str.Ptr(inputvalidationtest.String257Long),
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidScenarioInput()
+			sut.Owner = testCase.Value
+			//WHEN
+			err := sut.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func fixValidScenarioInput() graphql.ScenarioInput {
+	return graphql.ScenarioInput{
+		Name: "MARKETING",
+	}
+}
@@ -0,0 +1,34 @@
+package graphql
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
+)
+
+func (i UserInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.Username, validation.Required, validation.RuneLength(0, longStringLengthLimit)),
+	)
+}
+
+func (i UserGroupInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.Name, validation.Required, validation.RuneLength(0, longStringLengthLimit)),
+	)
+}
+
+func (i RoleInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.Name, validation.Required, validation.RuneLength(0, longStringLengthLimit)),
+		validation.Field(&i.Scopes, validation.Required, inputvalidation.Each(validation.Required, validation.RuneLength(0, longStringLengthLimit))),
+	)
+}
+
+func (i RoleBindingInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.RoleID, validation.Required),
+		validation.Field(&i.SubjectType, validation.Required, validation.In(RoleBindingSubjectTypeUser, RoleBindingSubjectTypeGroup)),
+		validation.Field(&i.SubjectID, validation.Required),
+		validation.Field(&i.Tenant, validation.NilOrNotEmpty, validation.RuneLength(0, longStringLengthLimit)),
+	)
+}
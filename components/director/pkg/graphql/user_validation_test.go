@@ -0,0 +1,212 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation/inputvalidationtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserInput_Validate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         "admin@example.com",
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Empty string",
+			Value:         inputvalidationtest.EmptyString,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "String longer than 256 chars",
+			Value:         inputvalidationtest.String257Long,
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			in := graphql.UserInput{Username: testCase.Value}
+			//WHEN
+			err := in.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestUserGroupInput_Validate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         string
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         "operators",
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Empty string",
+			Value:         inputvalidationtest.EmptyString,
+			ExpectedValid: false,
+		},
+		{
+			Name:          "String longer than 256 chars",
+			Value:         inputvalidationtest.String257Long,
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			in := graphql.UserGroupInput{Name: testCase.Value}
+			//WHEN
+			err := in.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRoleInput_Validate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         graphql.RoleInput
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid",
+			Value:         graphql.RoleInput{Name: "operator", Scopes: []string{"application:read", "runtime:read"}},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Empty name",
+			Value:         graphql.RoleInput{Name: inputvalidationtest.EmptyString, Scopes: []string{"application:read"}},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Name longer than 256 chars",
+			Value:         graphql.RoleInput{Name: inputvalidationtest.String257Long, Scopes: []string{"application:read"}},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "No scopes",
+			Value:         graphql.RoleInput{Name: "operator", Scopes: []string{}},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Empty scope",
+			Value:         graphql.RoleInput{Name: "operator", Scopes: []string{"application:read", inputvalidationtest.EmptyString}},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Scope longer than 256 chars",
+			Value:         graphql.RoleInput{Name: "operator", Scopes: []string{inputvalidationtest.String257Long}},
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//WHEN
+			err := testCase.Value.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRoleBindingInput_Validate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         graphql.RoleBindingInput
+		ExpectedValid bool
+	}{
+		{
+			Name:          "ExpectedValid - User in tenant",
+			Value:         fixValidRoleBindingInput(graphql.RoleBindingSubjectTypeUser, str.Ptr("tenant")),
+			ExpectedValid: true,
+		},
+		{
+			Name:          "ExpectedValid - Group in every tenant",
+			Value:         fixValidRoleBindingInput(graphql.RoleBindingSubjectTypeGroup, nil),
+			ExpectedValid: true,
+		},
+		{
+			Name: "Empty role ID",
+			Value: graphql.RoleBindingInput{
+				SubjectType: graphql.RoleBindingSubjectTypeUser,
+				SubjectID:   "user",
+			},
+			ExpectedValid: false,
+		},
+		{
+			Name: "Empty subject ID",
+			Value: graphql.RoleBindingInput{
+				RoleID:      "role",
+				SubjectType: graphql.RoleBindingSubjectTypeUser,
+			},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid subject type",
+			Value:         fixValidRoleBindingInput("SYSTEM", nil),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Empty tenant",
+			Value:         fixValidRoleBindingInput(graphql.RoleBindingSubjectTypeUser, str.Ptr(inputvalidationtest.EmptyString)),
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Tenant longer than 256 chars",
+			Value:         fixValidRoleBindingInput(graphql.RoleBindingSubjectTypeUser, str.Ptr(inputvalidationtest.String257Long)),
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//WHEN
+			err := testCase.Value.Validate()
+			//THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func fixValidRoleBindingInput(subjectType graphql.RoleBindingSubjectType, tenant *string) graphql.RoleBindingInput {
+	return graphql.RoleBindingInput{
+		RoleID:      "role",
+		SubjectType: subjectType,
+		SubjectID:   "subject",
+		Tenant:      tenant,
+	}
+}
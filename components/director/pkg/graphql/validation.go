@@ -15,3 +15,6 @@ const (
 )
 
 var alphanumericUnderscoreRegexp = regexp.MustCompile(alphanumericUnderscoreRegexpString)
+
+// reservedApplicationLabelKeys contains the keys of Application labels managed by dedicated mutations
+var reservedApplicationLabelKeys = []interface{}{"eventingPolicy"}
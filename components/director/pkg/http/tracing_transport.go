@@ -0,0 +1,63 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewTracingTransport returns a transport that starts a client span for every outgoing request and propagates it in the W3C Trace Context headers.
+func NewTracingTransport(roundTripper HTTPRoundTripper) *TracingTransport {
+	return &TracingTransport{
+		roundTripper: roundTripper,
+	}
+}
+
+// TracingTransport is a transport that starts a client span for every outgoing request and propagates it in the W3C Trace Context headers.
+type TracingTransport struct {
+	roundTripper HTTPRoundTripper
+}
+
+// RoundTrip starts a client span and injects its context into the outgoing request headers.
+func (t *TracingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	ctx, span := tracing.StartSpan(r.Context(), fmt.Sprintf("HTTP %s %s", r.Method, r.URL.Host),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.HTTPClientAttributesFromHTTPRequest(r)...))
+	defer span.End()
+
+	r = r.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, r.Header)
+
+	resp, err := t.roundTripper.RoundTrip(r)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+
+	span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(resp.StatusCode)...)
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCode(resp.StatusCode))
+
+	return resp, nil
+}
@@ -0,0 +1,74 @@
+package http_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/kyma-incubator/compass/components/director/pkg/http/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracingTransport_RoundTrip(t *testing.T) {
+	const testUrl = "http://localhost:8080/graphql"
+
+	recorder := &oteltest.StandardSpanRecorder{}
+	otel.SetTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Run("propagates client span in traceparent header", func(t *testing.T) {
+		// GIVEN
+		var traceparent string
+		rt := &automock.HTTPRoundTripper{}
+		rt.On("RoundTrip", mock.Anything).Return(&http.Response{StatusCode: http.StatusOK}, nil).Run(func(args mock.Arguments) {
+			req, ok := args.Get(0).(*http.Request)
+			assert.True(t, ok)
+			traceparent = req.Header.Get("traceparent")
+		})
+
+		request, err := http.NewRequest(http.MethodPost, testUrl, nil)
+		require.NoError(t, err)
+
+		// WHEN
+		_, err = httputil.NewTracingTransport(rt).RoundTrip(request)
+
+		// THEN
+		require.NoError(t, err)
+		spans := recorder.Completed()
+		require.NotEmpty(t, spans)
+		span := spans[len(spans)-1]
+		assert.Equal(t, "HTTP POST localhost:8080", span.Name())
+		assert.Equal(t, trace.SpanKindClient, span.SpanKind())
+		assert.Contains(t, traceparent, span.SpanContext().SpanID.String())
+		assert.Empty(t, request.Header.Get("traceparent"))
+		rt.AssertExpectations(t)
+	})
+
+	t.Run("records round trip error", func(t *testing.T) {
+		// GIVEN
+		testErr := errors.New("test error")
+		rt := &automock.HTTPRoundTripper{}
+		rt.On("RoundTrip", mock.Anything).Return(nil, testErr)
+
+		request, err := http.NewRequest(http.MethodGet, testUrl, nil)
+		require.NoError(t, err)
+
+		// WHEN
+		_, err = httputil.NewTracingTransport(rt).RoundTrip(request)
+
+		// THEN
+		require.Equal(t, testErr, err)
+		spans := recorder.Completed()
+		require.NotEmpty(t, spans)
+		assert.Equal(t, codes.Error, spans[len(spans)-1].StatusCode())
+		rt.AssertExpectations(t)
+	})
+}
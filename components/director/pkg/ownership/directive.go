@@ -0,0 +1,141 @@
+package ownership
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=OwnerResolver -output=automock -outpkg=automock -case=underscore
+type OwnerResolver interface {
+	GetOwner(ctx context.Context, tenantID, ownerProvider, id string) (Owner, error)
+}
+
+type directive struct {
+	transact      persistence.Transactioner
+	appRepo       application.ApplicationRepository
+	labelRepo     runtime.LabelRepository
+	ownerResolver OwnerResolver
+}
+
+// NewDirective returns a new ownership directive
+func NewDirective(transact persistence.Transactioner, appRepo application.ApplicationRepository, labelRepo runtime.LabelRepository, ownerResolver OwnerResolver) *directive {
+	return &directive{
+		transact:      transact,
+		appRepo:       appRepo,
+		labelRepo:     labelRepo,
+		ownerResolver: ownerResolver,
+	}
+}
+
+// IsOwner ensures that the caller owns the object which is being modified:
+// applications may modify only themselves, integration systems only the applications and runtimes they manage,
+// and runtimes only themselves. Users are authorized with scopes only, so their requests are forwarded to the next resolver.
+func (d *directive) IsOwner(ctx context.Context, _ interface{}, next graphql.Resolver, ownerProvider string, idField string) (res interface{}, err error) {
+	consumerInfo, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if consumerInfo.ConsumerType == consumer.User {
+		log.C(ctx).Debugf("Consumer type is %v. Skipping ownership verification directive...", consumerInfo.ConsumerType)
+		return next(ctx)
+	}
+
+	tenantID, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	resCtx := graphql.GetResolverContext(ctx)
+	id, ok := resCtx.Args[idField].(string)
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("Could not get idField: %s from request context", idField))
+	}
+
+	tx, err := d.transact.Begin()
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while opening the db transaction.")
+		return nil, err
+	}
+	defer d.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	objOwner, err := d.ownerResolver.GetOwner(ctx, tenantID, ownerProvider, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Could not derive owner, an error occurred")
+	}
+	log.C(ctx).Debugf("Found owning %s ID based on the request parameter %s: %s", objOwner.ObjectType, idField, objOwner.ID)
+
+	isOwner, err := d.isOwner(ctx, tenantID, consumerInfo, objOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while committing transaction.")
+		return nil, err
+	}
+
+	if !isOwner {
+		log.C(ctx).Warnf("Denied access of %s with ID %s to %s with ID %s: the consumer is not the owner of the object", consumerInfo.ConsumerType, consumerInfo.ConsumerID, objOwner.ObjectType, objOwner.ID)
+		return nil, apperrors.NewForbiddenError(string(consumerInfo.ConsumerType), consumerInfo.ConsumerID, objOwner.ObjectType, objOwner.ID)
+	}
+
+	log.C(ctx).Infof("%s with ID %s is the owner of %s with ID %s", consumerInfo.ConsumerType, consumerInfo.ConsumerID, objOwner.ObjectType, objOwner.ID)
+	return next(ctx)
+}
+
+func (d *directive) isOwner(ctx context.Context, tenantID string, consumerInfo consumer.Consumer, objOwner Owner) (bool, error) {
+	switch objOwner.ObjectType {
+	case resource.Application:
+		switch consumerInfo.ConsumerType {
+		case consumer.Application:
+			return consumerInfo.ConsumerID == objOwner.ID, nil
+		case consumer.IntegrationSystem:
+			app, err := d.getApplication(ctx, tenantID, objOwner.ID)
+			if err != nil {
+				return false, errors.Wrapf(err, "while getting Application with id %s", objOwner.ID)
+			}
+			return app.IntegrationSystemID != nil && *app.IntegrationSystemID == consumerInfo.ConsumerID, nil
+		}
+	case resource.Runtime:
+		switch consumerInfo.ConsumerType {
+		case consumer.Runtime:
+			return consumerInfo.ConsumerID == objOwner.ID, nil
+		case consumer.IntegrationSystem:
+			intSysLabel, err := d.labelRepo.GetByKey(ctx, tenantID, model.RuntimeLabelableObject, objOwner.ID, runtime.IntegrationSystemLabel)
+			if err != nil {
+				if apperrors.IsNotFoundError(err) {
+					return false, nil
+				}
+				return false, errors.Wrapf(err, "while getting Integration System of Runtime with id %s", objOwner.ID)
+			}
+			return intSysLabel.Value == consumerInfo.ConsumerID, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getApplication returns the Application, including the one which is marked as deleted and can be restored
+func (d *directive) getApplication(ctx context.Context, tenantID, id string) (*model.Application, error) {
+	app, err := d.appRepo.GetByID(ctx, tenantID, id)
+	if err == nil || !apperrors.IsNotFoundError(err) {
+		return app, err
+	}
+
+	return d.appRepo.GetDeletedByID(ctx, tenantID, id)
+}
@@ -0,0 +1,368 @@
+package ownership_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	app_mock "github.com/kyma-incubator/compass/components/director/internal/domain/application/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
+	runtime_mock "github.com/kyma-incubator/compass/components/director/internal/domain/runtime/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/ownership"
+	"github.com/kyma-incubator/compass/components/director/pkg/ownership/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	tenantID         = "tenant"
+	appID            = "app"
+	otherAppID       = "other-app"
+	runtimeID        = "runtime"
+	runtimeContextID = "runtime-context"
+	intSysID         = "int-sys"
+	packageID        = "package"
+	objectID         = "object"
+	idField          = "id"
+	otherIntSys      = "other-int-sys"
+)
+
+func TestIsOwner(t *testing.T) {
+	testErr := errors.New("test error")
+
+	t.Run("could not extract consumer information, should return error", func(t *testing.T) {
+		// GIVEN
+		directive := ownership.NewDirective(nil, nil, nil, nil)
+		// WHEN
+		res, err := directive.IsOwner(context.TODO(), nil, nil, "", "")
+		// THEN
+		require.Error(t, err)
+		assert.EqualError(t, err, consumer.NoConsumerError.Error())
+		assert.Nil(t, res)
+	})
+
+	t.Run("consumer is of type user, should proceed with next resolver", func(t *testing.T) {
+		// GIVEN
+		directive := ownership.NewDirective(nil, nil, nil, nil)
+		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerType: consumer.User})
+		dummyResolver := &dummyResolver{}
+		// WHEN
+		res, err := directive.IsOwner(ctx, nil, dummyResolver.SuccessResolve, "", "")
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, mockedNextOutput(), res)
+		assert.True(t, dummyResolver.called)
+	})
+
+	t.Run("could not extract tenant from context, should return error", func(t *testing.T) {
+		// GIVEN
+		directive := ownership.NewDirective(nil, nil, nil, nil)
+		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerID: appID, ConsumerType: consumer.Application})
+		// WHEN
+		res, err := directive.IsOwner(ctx, nil, nil, ownership.GetApplicationID, idField)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), apperrors.NewCannotReadTenantError().Error())
+		assert.Nil(t, res)
+	})
+
+	t.Run("id field is missing in the request, should return error", func(t *testing.T) {
+		// GIVEN
+		directive := ownership.NewDirective(nil, nil, nil, nil)
+		ctx := fixContext(consumer.Consumer{ConsumerID: appID, ConsumerType: consumer.Application}, map[string]interface{}{})
+		// WHEN
+		res, err := directive.IsOwner(ctx, nil, nil, ownership.GetApplicationID, idField)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Could not get idField")
+		assert.Nil(t, res)
+	})
+
+	t.Run("transaction could not be started, should return error", func(t *testing.T) {
+		// GIVEN
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(testErr).ThatFailsOnBegin()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		directive := ownership.NewDirective(mockedTransactioner, nil, nil, fixOwnerResolver(ownership.GetApplicationID, ownership.Owner{ObjectType: resource.Application, ID: appID}))
+		ctx := fixContext(consumer.Consumer{ConsumerID: appID, ConsumerType: consumer.Application}, map[string]interface{}{idField: appID})
+		// WHEN
+		res, err := directive.IsOwner(ctx, nil, nil, ownership.GetApplicationID, idField)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		assert.Nil(t, res)
+	})
+
+	t.Run("transaction could not be committed, should return error", func(t *testing.T) {
+		// GIVEN
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(testErr).ThatFailsOnCommit()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		directive := ownership.NewDirective(mockedTransactioner, nil, nil, fixOwnerResolver(ownership.GetApplicationID, ownership.Owner{ObjectType: resource.Application, ID: appID}))
+		ctx := fixContext(consumer.Consumer{ConsumerID: appID, ConsumerType: consumer.Application}, map[string]interface{}{idField: appID})
+		// WHEN
+		res, err := directive.IsOwner(ctx, nil, nil, ownership.GetApplicationID, idField)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		assert.Nil(t, res)
+	})
+
+	testCases := []struct {
+		Name                 string
+		Consumer             consumer.Consumer
+		Owner                ownership.Owner
+		OwnerErr             error
+		AppRepoFn            func() *app_mock.ApplicationRepository
+		LabelRepoFn          func() *runtime_mock.LabelRepository
+		ExpectCommit         bool
+		ExpectedForbiddenErr error
+		ExpectedErrMessage   string
+	}{
+		{
+			Name:         "Application modifies itself",
+			Consumer:     consumer.Consumer{ConsumerID: appID, ConsumerType: consumer.Application},
+			Owner:        ownership.Owner{ObjectType: resource.Application, ID: appID},
+			ExpectCommit: true,
+		},
+		{
+			Name:                 "Application modifies other application",
+			Consumer:             consumer.Consumer{ConsumerID: otherAppID, ConsumerType: consumer.Application},
+			Owner:                ownership.Owner{ObjectType: resource.Application, ID: appID},
+			ExpectCommit:         true,
+			ExpectedForbiddenErr: apperrors.NewForbiddenError(string(consumer.Application), otherAppID, resource.Application, appID),
+		},
+		{
+			Name:               "Returns error when owner cannot be derived",
+			Consumer:           consumer.Consumer{ConsumerID: appID, ConsumerType: consumer.Application},
+			OwnerErr:           testErr,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:         "Integration System modifies application it manages",
+			Consumer:     consumer.Consumer{ConsumerID: intSysID, ConsumerType: consumer.IntegrationSystem},
+			Owner:        ownership.Owner{ObjectType: resource.Application, ID: appID},
+			AppRepoFn:    fixAppRepoWithIntegrationSystem(intSysID),
+			ExpectCommit: true,
+		},
+		{
+			Name:                 "Integration System modifies application it does not manage",
+			Consumer:             consumer.Consumer{ConsumerID: otherIntSys, ConsumerType: consumer.IntegrationSystem},
+			Owner:                ownership.Owner{ObjectType: resource.Application, ID: appID},
+			AppRepoFn:            fixAppRepoWithIntegrationSystem(intSysID),
+			ExpectCommit:         true,
+			ExpectedForbiddenErr: apperrors.NewForbiddenError(string(consumer.IntegrationSystem), otherIntSys, resource.Application, appID),
+		},
+		{
+			Name:     "Integration System modifies application which is not managed by any integration system",
+			Consumer: consumer.Consumer{ConsumerID: intSysID, ConsumerType: consumer.IntegrationSystem},
+			Owner:    ownership.Owner{ObjectType: resource.Application, ID: appID},
+			AppRepoFn: func() *app_mock.ApplicationRepository {
+				repo := &app_mock.ApplicationRepository{}
+				repo.On("GetByID", txtest.CtxWithDBMatcher(), tenantID, appID).Return(&model.Application{ID: appID}, nil).Once()
+				return repo
+			},
+			ExpectCommit:         true,
+			ExpectedForbiddenErr: apperrors.NewForbiddenError(string(consumer.IntegrationSystem), intSysID, resource.Application, appID),
+		},
+		{
+			Name:     "Integration System modifies deleted application it manages",
+			Consumer: consumer.Consumer{ConsumerID: intSysID, ConsumerType: consumer.IntegrationSystem},
+			Owner:    ownership.Owner{ObjectType: resource.Application, ID: appID},
+			AppRepoFn: func() *app_mock.ApplicationRepository {
+				integrationSystemID := intSysID
+				repo := &app_mock.ApplicationRepository{}
+				repo.On("GetByID", txtest.CtxWithDBMatcher(), tenantID, appID).Return(nil, apperrors.NewNotFoundError(resource.Application, appID)).Once()
+				repo.On("GetDeletedByID", txtest.CtxWithDBMatcher(), tenantID, appID).Return(&model.Application{ID: appID, IntegrationSystemID: &integrationSystemID}, nil).Once()
+				return repo
+			},
+			ExpectCommit: true,
+		},
+		{
+			Name:     "Returns error when application of Integration System cannot be fetched",
+			Consumer: consumer.Consumer{ConsumerID: intSysID, ConsumerType: consumer.IntegrationSystem},
+			Owner:    ownership.Owner{ObjectType: resource.Application, ID: appID},
+			AppRepoFn: func() *app_mock.ApplicationRepository {
+				repo := &app_mock.ApplicationRepository{}
+				repo.On("GetByID", txtest.CtxWithDBMatcher(), tenantID, appID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:                 "Runtime modifies application",
+			Consumer:             consumer.Consumer{ConsumerID: runtimeID, ConsumerType: consumer.Runtime},
+			Owner:                ownership.Owner{ObjectType: resource.Application, ID: appID},
+			ExpectCommit:         true,
+			ExpectedForbiddenErr: apperrors.NewForbiddenError(string(consumer.Runtime), runtimeID, resource.Application, appID),
+		},
+		{
+			Name:         "Runtime modifies itself",
+			Consumer:     consumer.Consumer{ConsumerID: runtimeID, ConsumerType: consumer.Runtime},
+			Owner:        ownership.Owner{ObjectType: resource.Runtime, ID: runtimeID},
+			ExpectCommit: true,
+		},
+		{
+			Name:                 "Runtime modifies other runtime",
+			Consumer:             consumer.Consumer{ConsumerID: runtimeID, ConsumerType: consumer.Runtime},
+			Owner:                ownership.Owner{ObjectType: resource.Runtime, ID: objectID},
+			ExpectCommit:         true,
+			ExpectedForbiddenErr: apperrors.NewForbiddenError(string(consumer.Runtime), runtimeID, resource.Runtime, objectID),
+		},
+		{
+			Name:         "Integration System modifies runtime it registered",
+			Consumer:     consumer.Consumer{ConsumerID: intSysID, ConsumerType: consumer.IntegrationSystem},
+			Owner:        ownership.Owner{ObjectType: resource.Runtime, ID: runtimeID},
+			LabelRepoFn:  fixLabelRepoWithIntegrationSystem(intSysID),
+			ExpectCommit: true,
+		},
+		{
+			Name:                 "Integration System modifies runtime registered by other integration system",
+			Consumer:             consumer.Consumer{ConsumerID: otherIntSys, ConsumerType: consumer.IntegrationSystem},
+			Owner:                ownership.Owner{ObjectType: resource.Runtime, ID: runtimeID},
+			LabelRepoFn:          fixLabelRepoWithIntegrationSystem(intSysID),
+			ExpectCommit:         true,
+			ExpectedForbiddenErr: apperrors.NewForbiddenError(string(consumer.IntegrationSystem), otherIntSys, resource.Runtime, runtimeID),
+		},
+		{
+			Name:     "Integration System modifies runtime which is not managed by any integration system",
+			Consumer: consumer.Consumer{ConsumerID: intSysID, ConsumerType: consumer.IntegrationSystem},
+			Owner:    ownership.Owner{ObjectType: resource.Runtime, ID: runtimeID},
+			LabelRepoFn: func() *runtime_mock.LabelRepository {
+				repo := &runtime_mock.LabelRepository{}
+				repo.On("GetByKey", txtest.CtxWithDBMatcher(), tenantID, model.RuntimeLabelableObject, runtimeID, runtime.IntegrationSystemLabel).
+					Return(nil, apperrors.NewNotFoundError(resource.Label, runtime.IntegrationSystemLabel)).Once()
+				return repo
+			},
+			ExpectCommit:         true,
+			ExpectedForbiddenErr: apperrors.NewForbiddenError(string(consumer.IntegrationSystem), intSysID, resource.Runtime, runtimeID),
+		},
+		{
+			Name:     "Returns error when integration system of runtime cannot be fetched",
+			Consumer: consumer.Consumer{ConsumerID: intSysID, ConsumerType: consumer.IntegrationSystem},
+			Owner:    ownership.Owner{ObjectType: resource.Runtime, ID: runtimeID},
+			LabelRepoFn: func() *runtime_mock.LabelRepository {
+				repo := &runtime_mock.LabelRepository{}
+				repo.On("GetByKey", txtest.CtxWithDBMatcher(), tenantID, model.RuntimeLabelableObject, runtimeID, runtime.IntegrationSystemLabel).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:                 "Application modifies runtime",
+			Consumer:             consumer.Consumer{ConsumerID: appID, ConsumerType: consumer.Application},
+			Owner:                ownership.Owner{ObjectType: resource.Runtime, ID: runtimeID},
+			ExpectCommit:         true,
+			ExpectedForbiddenErr: apperrors.NewForbiddenError(string(consumer.Application), appID, resource.Runtime, runtimeID),
+		},
+		{
+			Name:                 "Runtime Context modifies runtime",
+			Consumer:             consumer.Consumer{ConsumerID: objectID, ConsumerType: consumer.RuntimeContext},
+			Owner:                ownership.Owner{ObjectType: resource.Runtime, ID: runtimeID},
+			ExpectCommit:         true,
+			ExpectedForbiddenErr: apperrors.NewForbiddenError(string(consumer.RuntimeContext), objectID, resource.Runtime, runtimeID),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			appRepo := &app_mock.ApplicationRepository{}
+			if testCase.AppRepoFn != nil {
+				appRepo = testCase.AppRepoFn()
+			}
+			labelRepo := &runtime_mock.LabelRepository{}
+			if testCase.LabelRepoFn != nil {
+				labelRepo = testCase.LabelRepoFn()
+			}
+
+			ownerResolver := &automock.OwnerResolver{}
+			ownerResolver.On("GetOwner", txtest.CtxWithDBMatcher(), tenantID, ownership.GetApplicationIDByPackage, objectID).Return(testCase.Owner, testCase.OwnerErr).Once()
+
+			txGen := txtest.NewTransactionContextGenerator(nil)
+			mockedTx, mockedTransactioner := txGen.ThatDoesntExpectCommit()
+			if testCase.ExpectCommit {
+				mockedTx, mockedTransactioner = txGen.ThatSucceeds()
+			}
+
+			directive := ownership.NewDirective(mockedTransactioner, appRepo, labelRepo, ownerResolver)
+			ctx := fixContext(testCase.Consumer, map[string]interface{}{idField: objectID})
+			dummyResolver := &dummyResolver{}
+
+			// WHEN
+			res, err := directive.IsOwner(ctx, nil, dummyResolver.SuccessResolve, ownership.GetApplicationIDByPackage, idField)
+
+			// THEN
+			if testCase.ExpectedForbiddenErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, testCase.ExpectedForbiddenErr, err)
+				assert.Equal(t, apperrors.Forbidden, apperrors.ErrorCode(err))
+				assert.Nil(t, res)
+				assert.False(t, dummyResolver.called)
+			} else if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				assert.Nil(t, res)
+				assert.False(t, dummyResolver.called)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, mockedNextOutput(), res)
+				assert.True(t, dummyResolver.called)
+			}
+
+			mock.AssertExpectationsForObjects(t, mockedTx, mockedTransactioner, appRepo, labelRepo, ownerResolver)
+		})
+	}
+}
+
+func fixContext(consumerInfo consumer.Consumer, args map[string]interface{}) context.Context {
+	ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumerInfo)
+	ctx = context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID})
+	return graphql.WithResolverContext(ctx, &graphql.ResolverContext{Args: args})
+}
+
+func fixAppRepoWithIntegrationSystem(integrationSystemID string) func() *app_mock.ApplicationRepository {
+	return func() *app_mock.ApplicationRepository {
+		repo := &app_mock.ApplicationRepository{}
+		repo.On("GetByID", txtest.CtxWithDBMatcher(), tenantID, appID).Return(&model.Application{ID: appID, IntegrationSystemID: &integrationSystemID}, nil).Once()
+		return repo
+	}
+}
+
+func fixLabelRepoWithIntegrationSystem(integrationSystemID string) func() *runtime_mock.LabelRepository {
+	return func() *runtime_mock.LabelRepository {
+		repo := &runtime_mock.LabelRepository{}
+		repo.On("GetByKey", txtest.CtxWithDBMatcher(), tenantID, model.RuntimeLabelableObject, runtimeID, runtime.IntegrationSystemLabel).
+			Return(&model.Label{Key: runtime.IntegrationSystemLabel, Value: integrationSystemID}, nil).Once()
+		return repo
+	}
+}
+
+func fixOwnerResolver(ownerProvider string, owner ownership.Owner) *automock.OwnerResolver {
+	ownerResolver := &automock.OwnerResolver{}
+	ownerResolver.On("GetOwner", txtest.CtxWithDBMatcher(), tenantID, ownerProvider, appID).Return(owner, nil)
+	return ownerResolver
+}
+
+type dummyResolver struct {
+	called bool
+}
+
+func (d *dummyResolver) SuccessResolve(_ context.Context) (res interface{}, err error) {
+	d.called = true
+	return mockedNextOutput(), nil
+}
+
+func mockedNextOutput() string {
+	return "nextOutput"
+}
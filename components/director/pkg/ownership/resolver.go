@@ -0,0 +1,154 @@
+package ownership
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/appshare"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/eventdef"
+	mp_package "github.com/kyma-incubator/compass/components/director/internal/domain/package"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/packageinstanceauth"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime_context"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const (
+	GetApplicationID                      = "GetApplicationID"
+	GetApplicationIDByWebhook             = "GetApplicationIDByWebhook"
+	GetApplicationIDByPackage             = "GetApplicationIDByPackage"
+	GetApplicationIDByAPIDefinition       = "GetApplicationIDByAPIDefinition"
+	GetApplicationIDByEventDefinition     = "GetApplicationIDByEventDefinition"
+	GetApplicationIDByDocument            = "GetApplicationIDByDocument"
+	GetApplicationIDByPackageInstanceAuth = "GetApplicationIDByPackageInstanceAuth"
+	GetApplicationIDBySystemAuth          = "GetApplicationIDBySystemAuth"
+	GetApplicationIDByApplicationShare    = "GetApplicationIDByApplicationShare"
+	GetRuntimeID                          = "GetRuntimeID"
+	GetRuntimeIDByRuntimeContext          = "GetRuntimeIDByRuntimeContext"
+	GetRuntimeIDBySystemAuth              = "GetRuntimeIDBySystemAuth"
+)
+
+// Owner is the top-level object which owns the object being accessed
+type Owner struct {
+	ObjectType resource.Type
+	ID         string
+}
+
+type ownerResolver struct {
+	ownerProviders map[string]func(context.Context, string, string) (Owner, error)
+}
+
+// NewOwnerResolver returns a new resolver of the objects owners
+func NewOwnerResolver(webhookRepo webhook.WebhookRepository, packageRepo mp_package.PackageRepository, apiRepo api.APIRepository,
+	eventAPIRepo eventdef.EventAPIRepository, documentRepo document.DocumentRepository, packageInstanceAuthRepo packageinstanceauth.Repository,
+	runtimeContextRepo runtime_context.RuntimeContextRepository, systemAuthRepo systemauth.Repository, applicationShareRepo appshare.ApplicationShareRepository) *ownerResolver {
+	getApplicationIDByPackageFunc := func(ctx context.Context, tenantID, packageID string) (Owner, error) {
+		pkg, err := packageRepo.GetByID(ctx, tenantID, packageID)
+		if err != nil {
+			return Owner{}, errors.Wrapf(err, "while getting Package with id %s", packageID)
+		}
+		return Owner{ObjectType: resource.Application, ID: pkg.ApplicationID}, nil
+	}
+
+	getRuntimeIDByRuntimeContextFunc := func(ctx context.Context, tenantID, runtimeContextID string) (Owner, error) {
+		runtimeContext, err := runtimeContextRepo.GetByID(ctx, tenantID, runtimeContextID)
+		if err != nil {
+			return Owner{}, errors.Wrapf(err, "while getting Runtime Context with id %s", runtimeContextID)
+		}
+		return Owner{ObjectType: resource.Runtime, ID: runtimeContext.RuntimeID}, nil
+	}
+
+	return &ownerResolver{
+		ownerProviders: map[string]func(context.Context, string, string) (Owner, error){
+			GetApplicationID: func(ctx context.Context, tenantID, appID string) (Owner, error) {
+				return Owner{ObjectType: resource.Application, ID: appID}, nil
+			},
+			GetApplicationIDByWebhook: func(ctx context.Context, tenantID, webhookID string) (Owner, error) {
+				wh, err := webhookRepo.GetByID(ctx, tenantID, webhookID)
+				if err != nil {
+					return Owner{}, errors.Wrapf(err, "while getting Webhook with id %s", webhookID)
+				}
+				return Owner{ObjectType: resource.Application, ID: wh.ApplicationID}, nil
+			},
+			GetApplicationIDByPackage: getApplicationIDByPackageFunc,
+			GetApplicationIDByAPIDefinition: func(ctx context.Context, tenantID, apiID string) (Owner, error) {
+				apiDef, err := apiRepo.GetByID(ctx, tenantID, apiID)
+				if err != nil {
+					return Owner{}, errors.Wrapf(err, "while getting API Definition with id %s", apiID)
+				}
+				return getApplicationIDByPackageFunc(ctx, tenantID, apiDef.PackageID)
+			},
+			GetApplicationIDByEventDefinition: func(ctx context.Context, tenantID, eventID string) (Owner, error) {
+				eventDef, err := eventAPIRepo.GetByID(ctx, tenantID, eventID)
+				if err != nil {
+					return Owner{}, errors.Wrapf(err, "while getting Event Definition with id %s", eventID)
+				}
+				return getApplicationIDByPackageFunc(ctx, tenantID, eventDef.PackageID)
+			},
+			GetApplicationIDByDocument: func(ctx context.Context, tenantID, documentID string) (Owner, error) {
+				doc, err := documentRepo.GetByID(ctx, tenantID, documentID)
+				if err != nil {
+					return Owner{}, errors.Wrapf(err, "while getting Document with id %s", documentID)
+				}
+				return getApplicationIDByPackageFunc(ctx, tenantID, doc.PackageID)
+			},
+			GetApplicationIDByPackageInstanceAuth: func(ctx context.Context, tenantID, packageInstanceAuthID string) (Owner, error) {
+				packageInstanceAuth, err := packageInstanceAuthRepo.GetByID(ctx, tenantID, packageInstanceAuthID)
+				if err != nil {
+					return Owner{}, errors.Wrapf(err, "while getting Package instance auth with id %s", packageInstanceAuthID)
+				}
+				return getApplicationIDByPackageFunc(ctx, tenantID, packageInstanceAuth.PackageID)
+			},
+			GetApplicationIDBySystemAuth: func(ctx context.Context, tenantID, authID string) (Owner, error) {
+				auth, err := systemAuthRepo.GetByID(ctx, tenantID, authID)
+				if err != nil {
+					return Owner{}, errors.Wrapf(err, "while getting System Auth with id %s", authID)
+				}
+				if auth.AppID == nil {
+					return Owner{}, apperrors.NewInvalidDataError("System Auth with id %s does not belong to an Application", authID)
+				}
+				return Owner{ObjectType: resource.Application, ID: *auth.AppID}, nil
+			},
+			GetApplicationIDByApplicationShare: func(ctx context.Context, tenantID, shareID string) (Owner, error) {
+				share, err := applicationShareRepo.GetByID(ctx, tenantID, shareID)
+				if err != nil {
+					return Owner{}, errors.Wrapf(err, "while getting Application Share with id %s", shareID)
+				}
+				return Owner{ObjectType: resource.Application, ID: share.ApplicationID}, nil
+			},
+			GetRuntimeID: func(ctx context.Context, tenantID, runtimeID string) (Owner, error) {
+				return Owner{ObjectType: resource.Runtime, ID: runtimeID}, nil
+			},
+			GetRuntimeIDByRuntimeContext: getRuntimeIDByRuntimeContextFunc,
+			GetRuntimeIDBySystemAuth: func(ctx context.Context, tenantID, authID string) (Owner, error) {
+				auth, err := systemAuthRepo.GetByID(ctx, tenantID, authID)
+				if err != nil {
+					return Owner{}, errors.Wrapf(err, "while getting System Auth with id %s", authID)
+				}
+				switch {
+				case auth.RuntimeID != nil:
+					return Owner{ObjectType: resource.Runtime, ID: *auth.RuntimeID}, nil
+				case auth.RuntimeContextID != nil:
+					return getRuntimeIDByRuntimeContextFunc(ctx, tenantID, *auth.RuntimeContextID)
+				}
+				return Owner{}, apperrors.NewInvalidDataError("System Auth with id %s does not belong to a Runtime or a Runtime Context", authID)
+			},
+		},
+	}
+}
+
+// GetOwner returns the owner of the object with the given ID, found with the given owner provider.
+// The persistence transaction is expected to be in the context.
+func (r *ownerResolver) GetOwner(ctx context.Context, tenantID, ownerProvider, id string) (Owner, error) {
+	ownerProviderFunc, ok := r.ownerProviders[ownerProvider]
+	if !ok {
+		return Owner{}, errors.New(fmt.Sprintf("Could not get owner provider func: %s from provider list", ownerProvider))
+	}
+
+	return ownerProviderFunc(ctx, tenantID, id)
+}
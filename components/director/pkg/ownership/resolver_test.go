@@ -0,0 +1,310 @@
+package ownership_test
+
+import (
+	"context"
+	"testing"
+
+	api_mock "github.com/kyma-incubator/compass/components/director/internal/domain/api/automock"
+	appshare_mock "github.com/kyma-incubator/compass/components/director/internal/domain/appshare/automock"
+	doc_mock "github.com/kyma-incubator/compass/components/director/internal/domain/document/automock"
+	event_mock "github.com/kyma-incubator/compass/components/director/internal/domain/eventdef/automock"
+	pkg_mock "github.com/kyma-incubator/compass/components/director/internal/domain/package/automock"
+	pkg_auth_mock "github.com/kyma-incubator/compass/components/director/internal/domain/packageinstanceauth/automock"
+	rtm_ctx_mock "github.com/kyma-incubator/compass/components/director/internal/domain/runtime_context/automock"
+	sys_auth_mock "github.com/kyma-incubator/compass/components/director/internal/domain/systemauth/automock"
+	webhook_mock "github.com/kyma-incubator/compass/components/director/internal/domain/webhook/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/ownership"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwnerResolver_GetOwner(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		Name                 string
+		OwnerProvider        string
+		WebhookRepoFn        func() *webhook_mock.WebhookRepository
+		PackageRepoFn        func() *pkg_mock.PackageRepository
+		APIRepoFn            func() *api_mock.APIRepository
+		EventAPIRepoFn       func() *event_mock.EventAPIRepository
+		DocumentRepoFn       func() *doc_mock.DocumentRepository
+		PackageAuthRepoFn    func() *pkg_auth_mock.Repository
+		RuntimeContextRepoFn func() *rtm_ctx_mock.RuntimeContextRepository
+		SystemAuthRepoFn     func() *sys_auth_mock.Repository
+		AppShareRepoFn       func() *appshare_mock.ApplicationShareRepository
+		ExpectedOwner        ownership.Owner
+		ExpectedErrMessage   string
+	}{
+		{
+			Name:          "Success for Application",
+			OwnerProvider: ownership.GetApplicationID,
+			ExpectedOwner: ownership.Owner{ObjectType: resource.Application, ID: objectID},
+		},
+		{
+			Name:          "Success for Runtime",
+			OwnerProvider: ownership.GetRuntimeID,
+			ExpectedOwner: ownership.Owner{ObjectType: resource.Runtime, ID: objectID},
+		},
+		{
+			Name:          "Success for Webhook",
+			OwnerProvider: ownership.GetApplicationIDByWebhook,
+			WebhookRepoFn: func() *webhook_mock.WebhookRepository {
+				repo := &webhook_mock.WebhookRepository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(&model.Webhook{ID: objectID, ApplicationID: appID}, nil).Once()
+				return repo
+			},
+			ExpectedOwner: ownership.Owner{ObjectType: resource.Application, ID: appID},
+		},
+		{
+			Name:          "Success for Package",
+			OwnerProvider: ownership.GetApplicationIDByPackage,
+			PackageRepoFn: func() *pkg_mock.PackageRepository {
+				repo := &pkg_mock.PackageRepository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(&model.Package{ID: objectID, ApplicationID: appID}, nil).Once()
+				return repo
+			},
+			ExpectedOwner: ownership.Owner{ObjectType: resource.Application, ID: appID},
+		},
+		{
+			Name:          "Success for API Definition",
+			OwnerProvider: ownership.GetApplicationIDByAPIDefinition,
+			APIRepoFn: func() *api_mock.APIRepository {
+				repo := &api_mock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(&model.APIDefinition{ID: objectID, PackageID: packageID}, nil).Once()
+				return repo
+			},
+			PackageRepoFn: fixPackageRepo,
+			ExpectedOwner: ownership.Owner{ObjectType: resource.Application, ID: appID},
+		},
+		{
+			Name:          "Success for Event Definition",
+			OwnerProvider: ownership.GetApplicationIDByEventDefinition,
+			EventAPIRepoFn: func() *event_mock.EventAPIRepository {
+				repo := &event_mock.EventAPIRepository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(&model.EventDefinition{ID: objectID, PackageID: packageID}, nil).Once()
+				return repo
+			},
+			PackageRepoFn: fixPackageRepo,
+			ExpectedOwner: ownership.Owner{ObjectType: resource.Application, ID: appID},
+		},
+		{
+			Name:          "Success for Document",
+			OwnerProvider: ownership.GetApplicationIDByDocument,
+			DocumentRepoFn: func() *doc_mock.DocumentRepository {
+				repo := &doc_mock.DocumentRepository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(&model.Document{ID: objectID, PackageID: packageID}, nil).Once()
+				return repo
+			},
+			PackageRepoFn: fixPackageRepo,
+			ExpectedOwner: ownership.Owner{ObjectType: resource.Application, ID: appID},
+		},
+		{
+			Name:          "Success for Package Instance Auth",
+			OwnerProvider: ownership.GetApplicationIDByPackageInstanceAuth,
+			PackageAuthRepoFn: func() *pkg_auth_mock.Repository {
+				repo := &pkg_auth_mock.Repository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(&model.PackageInstanceAuth{ID: objectID, PackageID: packageID}, nil).Once()
+				return repo
+			},
+			PackageRepoFn: fixPackageRepo,
+			ExpectedOwner: ownership.Owner{ObjectType: resource.Application, ID: appID},
+		},
+		{
+			Name:          "Success for Runtime Context",
+			OwnerProvider: ownership.GetRuntimeIDByRuntimeContext,
+			RuntimeContextRepoFn: func() *rtm_ctx_mock.RuntimeContextRepository {
+				repo := &rtm_ctx_mock.RuntimeContextRepository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(&model.RuntimeContext{ID: objectID, RuntimeID: runtimeID}, nil).Once()
+				return repo
+			},
+			ExpectedOwner: ownership.Owner{ObjectType: resource.Runtime, ID: runtimeID},
+		},
+		{
+			Name:          "Success for System Auth of Application",
+			OwnerProvider: ownership.GetApplicationIDBySystemAuth,
+			SystemAuthRepoFn: func() *sys_auth_mock.Repository {
+				repo := &sys_auth_mock.Repository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(&model.SystemAuth{ID: objectID, AppID: str.Ptr(appID)}, nil).Once()
+				return repo
+			},
+			ExpectedOwner: ownership.Owner{ObjectType: resource.Application, ID: appID},
+		},
+		{
+			Name:          "Success for System Auth of Runtime",
+			OwnerProvider: ownership.GetRuntimeIDBySystemAuth,
+			SystemAuthRepoFn: func() *sys_auth_mock.Repository {
+				repo := &sys_auth_mock.Repository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(&model.SystemAuth{ID: objectID, RuntimeID: str.Ptr(runtimeID)}, nil).Once()
+				return repo
+			},
+			ExpectedOwner: ownership.Owner{ObjectType: resource.Runtime, ID: runtimeID},
+		},
+		{
+			Name:          "Success for System Auth of Runtime Context",
+			OwnerProvider: ownership.GetRuntimeIDBySystemAuth,
+			SystemAuthRepoFn: func() *sys_auth_mock.Repository {
+				repo := &sys_auth_mock.Repository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(&model.SystemAuth{ID: objectID, RuntimeContextID: str.Ptr(runtimeContextID)}, nil).Once()
+				return repo
+			},
+			RuntimeContextRepoFn: func() *rtm_ctx_mock.RuntimeContextRepository {
+				repo := &rtm_ctx_mock.RuntimeContextRepository{}
+				repo.On("GetByID", ctx, tenantID, runtimeContextID).Return(&model.RuntimeContext{ID: runtimeContextID, RuntimeID: runtimeID}, nil).Once()
+				return repo
+			},
+			ExpectedOwner: ownership.Owner{ObjectType: resource.Runtime, ID: runtimeID},
+		},
+		{
+			Name:          "Success for Application Share",
+			OwnerProvider: ownership.GetApplicationIDByApplicationShare,
+			AppShareRepoFn: func() *appshare_mock.ApplicationShareRepository {
+				repo := &appshare_mock.ApplicationShareRepository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(&model.ApplicationShare{ID: objectID, ApplicationID: appID}, nil).Once()
+				return repo
+			},
+			ExpectedOwner: ownership.Owner{ObjectType: resource.Application, ID: appID},
+		},
+		{
+			Name:          "Returns error when System Auth does not belong to an Application",
+			OwnerProvider: ownership.GetApplicationIDBySystemAuth,
+			SystemAuthRepoFn: func() *sys_auth_mock.Repository {
+				repo := &sys_auth_mock.Repository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(&model.SystemAuth{ID: objectID, RuntimeID: str.Ptr(runtimeID)}, nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: "does not belong to an Application",
+		},
+		{
+			Name:          "Returns error when System Auth does not belong to a Runtime",
+			OwnerProvider: ownership.GetRuntimeIDBySystemAuth,
+			SystemAuthRepoFn: func() *sys_auth_mock.Repository {
+				repo := &sys_auth_mock.Repository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(&model.SystemAuth{ID: objectID, AppID: str.Ptr(appID)}, nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: "does not belong to a Runtime or a Runtime Context",
+		},
+		{
+			Name:          "Returns error when System Auth cannot be fetched",
+			OwnerProvider: ownership.GetRuntimeIDBySystemAuth,
+			SystemAuthRepoFn: func() *sys_auth_mock.Repository {
+				repo := &sys_auth_mock.Repository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:          "Returns error when Application Share cannot be fetched",
+			OwnerProvider: ownership.GetApplicationIDByApplicationShare,
+			AppShareRepoFn: func() *appshare_mock.ApplicationShareRepository {
+				repo := &appshare_mock.ApplicationShareRepository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:          "Returns error when Webhook cannot be fetched",
+			OwnerProvider: ownership.GetApplicationIDByWebhook,
+			WebhookRepoFn: func() *webhook_mock.WebhookRepository {
+				repo := &webhook_mock.WebhookRepository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:          "Returns error when Package of API Definition cannot be fetched",
+			OwnerProvider: ownership.GetApplicationIDByAPIDefinition,
+			APIRepoFn: func() *api_mock.APIRepository {
+				repo := &api_mock.APIRepository{}
+				repo.On("GetByID", ctx, tenantID, objectID).Return(&model.APIDefinition{ID: objectID, PackageID: packageID}, nil).Once()
+				return repo
+			},
+			PackageRepoFn: func() *pkg_mock.PackageRepository {
+				repo := &pkg_mock.PackageRepository{}
+				repo.On("GetByID", ctx, tenantID, packageID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:               "Returns error when owner provider does not exist",
+			OwnerProvider:      "unknown",
+			ExpectedErrMessage: "Could not get owner provider func",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			webhookRepo := &webhook_mock.WebhookRepository{}
+			if testCase.WebhookRepoFn != nil {
+				webhookRepo = testCase.WebhookRepoFn()
+			}
+			packageRepo := &pkg_mock.PackageRepository{}
+			if testCase.PackageRepoFn != nil {
+				packageRepo = testCase.PackageRepoFn()
+			}
+			apiRepo := &api_mock.APIRepository{}
+			if testCase.APIRepoFn != nil {
+				apiRepo = testCase.APIRepoFn()
+			}
+			eventAPIRepo := &event_mock.EventAPIRepository{}
+			if testCase.EventAPIRepoFn != nil {
+				eventAPIRepo = testCase.EventAPIRepoFn()
+			}
+			documentRepo := &doc_mock.DocumentRepository{}
+			if testCase.DocumentRepoFn != nil {
+				documentRepo = testCase.DocumentRepoFn()
+			}
+			packageAuthRepo := &pkg_auth_mock.Repository{}
+			if testCase.PackageAuthRepoFn != nil {
+				packageAuthRepo = testCase.PackageAuthRepoFn()
+			}
+
+			runtimeContextRepo := &rtm_ctx_mock.RuntimeContextRepository{}
+			if testCase.RuntimeContextRepoFn != nil {
+				runtimeContextRepo = testCase.RuntimeContextRepoFn()
+			}
+			systemAuthRepo := &sys_auth_mock.Repository{}
+			if testCase.SystemAuthRepoFn != nil {
+				systemAuthRepo = testCase.SystemAuthRepoFn()
+			}
+			appShareRepo := &appshare_mock.ApplicationShareRepository{}
+			if testCase.AppShareRepoFn != nil {
+				appShareRepo = testCase.AppShareRepoFn()
+			}
+
+			resolver := ownership.NewOwnerResolver(webhookRepo, packageRepo, apiRepo, eventAPIRepo, documentRepo, packageAuthRepo, runtimeContextRepo, systemAuthRepo, appShareRepo)
+
+			// WHEN
+			owner, err := resolver.GetOwner(ctx, tenantID, testCase.OwnerProvider, objectID)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedOwner, owner)
+			}
+
+			mock.AssertExpectationsForObjects(t, webhookRepo, packageRepo, apiRepo, eventAPIRepo, documentRepo, packageAuthRepo, runtimeContextRepo, systemAuthRepo, appShareRepo)
+		})
+	}
+}
+
+func fixPackageRepo() *pkg_mock.PackageRepository {
+	repo := &pkg_mock.PackageRepository{}
+	repo.On("GetByID", context.TODO(), tenantID, packageID).Return(&model.Package{ID: packageID, ApplicationID: appID}, nil).Once()
+	return repo
+}
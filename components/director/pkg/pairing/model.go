@@ -6,6 +6,7 @@ type RequestData struct {
 	Application graphql.Application
 	Tenant      string
 	ClientUser  string
+	Labels      map[string]interface{}
 }
 
 type ResponseData struct {
@@ -29,7 +29,7 @@ func FromCtx(ctx context.Context) (PersistenceOp, error) {
 	dbCtx := ctx.Value(PersistenceCtxKey)
 
 	if db, ok := dbCtx.(PersistenceOp); ok {
-		return db, nil
+		return &tracedPersistenceOp{ctx: ctx, PersistenceOp: db}, nil
 	}
 
 	return nil, apperrors.NewInternalError("unable to fetch database from context")
@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedPersistenceOp starts a span for every SQL statement executed within the context it was created for
+type tracedPersistenceOp struct {
+	ctx context.Context
+	PersistenceOp
+}
+
+func (op *tracedPersistenceOp) Get(dest interface{}, query string, args ...interface{}) error {
+	span := op.startSpan(query)
+	defer span.End()
+
+	return endSpan(span, op.PersistenceOp.Get(dest, query, args...))
+}
+
+func (op *tracedPersistenceOp) Select(dest interface{}, query string, args ...interface{}) error {
+	span := op.startSpan(query)
+	defer span.End()
+
+	return endSpan(span, op.PersistenceOp.Select(dest, query, args...))
+}
+
+func (op *tracedPersistenceOp) NamedExec(query string, arg interface{}) (sql.Result, error) {
+	span := op.startSpan(query)
+	defer span.End()
+
+	res, err := op.PersistenceOp.NamedExec(query, arg)
+	return res, endSpan(span, err)
+}
+
+func (op *tracedPersistenceOp) Exec(query string, args ...interface{}) (sql.Result, error) {
+	span := op.startSpan(query)
+	defer span.End()
+
+	res, err := op.PersistenceOp.Exec(query, args...)
+	return res, endSpan(span, err)
+}
+
+func (op *tracedPersistenceOp) startSpan(query string) trace.Span {
+	_, span := tracing.StartSpan(op.ctx, fmt.Sprintf("SQL %s", statementType(query)),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgres, semconv.DBStatementKey.String(query)))
+
+	return span
+}
+
+func endSpan(span trace.Span, err error) error {
+	if err != nil && err != sql.ErrNoRows {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func statementType(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return strings.ToUpper(strings.TrimLeft(fields[0], "("))
+}
@@ -0,0 +1,96 @@
+package persistence_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/oteltest"
+)
+
+func TestFromCtx_Tracing(t *testing.T) {
+	const query = "SELECT id FROM public.applications WHERE id = $1"
+	testErr := errors.New("test error")
+
+	recorder := &oteltest.StandardSpanRecorder{}
+	otel.SetTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(recorder)))
+
+	t.Run("starts span for executed statement", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := fixSQLXMock(t)
+		dbMock.ExpectQuery("SELECT id FROM public.applications").WithArgs("foo").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("foo"))
+		persistOp, err := persistence.FromCtx(persistence.SaveToContext(context.TODO(), db))
+		require.NoError(t, err)
+
+		// WHEN
+		var id string
+		err = persistOp.Get(&id, query, "foo")
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, "foo", id)
+		span := lastCompletedSpan(t, recorder)
+		assert.Equal(t, "SQL SELECT", span.Name())
+		assert.Equal(t, label.StringValue(query), span.Attributes()["db.statement"])
+		assert.Equal(t, codes.Unset, span.StatusCode())
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+
+	t.Run("records statement error", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := fixSQLXMock(t)
+		dbMock.ExpectExec("DELETE FROM public.applications").WillReturnError(testErr)
+		persistOp, err := persistence.FromCtx(persistence.SaveToContext(context.TODO(), db))
+		require.NoError(t, err)
+
+		// WHEN
+		_, err = persistOp.Exec("DELETE FROM public.applications WHERE id = $1", "foo")
+
+		// THEN
+		require.Equal(t, testErr, err)
+		span := lastCompletedSpan(t, recorder)
+		assert.Equal(t, "SQL DELETE", span.Name())
+		assert.Equal(t, codes.Error, span.StatusCode())
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+
+	t.Run("does not record missing rows as error", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := fixSQLXMock(t)
+		dbMock.ExpectQuery("SELECT id FROM public.applications").WillReturnError(sql.ErrNoRows)
+		persistOp, err := persistence.FromCtx(persistence.SaveToContext(context.TODO(), db))
+		require.NoError(t, err)
+
+		// WHEN
+		var id string
+		err = persistOp.Get(&id, query, "foo")
+
+		// THEN
+		require.Equal(t, sql.ErrNoRows, err)
+		assert.Equal(t, codes.Unset, lastCompletedSpan(t, recorder).StatusCode())
+		assert.NoError(t, dbMock.ExpectationsWereMet())
+	})
+}
+
+func fixSQLXMock(t *testing.T) (*sqlx.DB, sqlmock.Sqlmock) {
+	db, dbMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	return sqlx.NewDb(db, "sqlmock"), dbMock
+}
+
+func lastCompletedSpan(t *testing.T, recorder *oteltest.StandardSpanRecorder) *oteltest.Span {
+	spans := recorder.Completed()
+	require.NotEmpty(t, spans)
+
+	return spans[len(spans)-1]
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	policy "github.com/kyma-incubator/compass/components/director/pkg/policy"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Engine is an autogenerated mock type for the Engine type
+type Engine struct {
+	mock.Mock
+}
+
+// Applies provides a mock function with given fields: operation
+func (_m *Engine) Applies(operation string) (bool, error) {
+	ret := _m.Called(operation)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(operation)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(operation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Evaluate provides a mock function with given fields: ctx, in
+func (_m *Engine) Evaluate(ctx context.Context, in policy.Input) (policy.Decision, error) {
+	ret := _m.Called(ctx, in)
+
+	var r0 policy.Decision
+	if rf, ok := ret.Get(0).(func(context.Context, policy.Input) policy.Decision); ok {
+		r0 = rf(ctx, in)
+	} else {
+		r0 = ret.Get(0).(policy.Decision)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, policy.Input) error); ok {
+		r1 = rf(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
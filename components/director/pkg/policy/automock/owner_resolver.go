@@ -0,0 +1,36 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	ownership "github.com/kyma-incubator/compass/components/director/pkg/ownership"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OwnerResolver is an autogenerated mock type for the OwnerResolver type
+type OwnerResolver struct {
+	mock.Mock
+}
+
+// GetOwner provides a mock function with given fields: ctx, tenantID, ownerProvider, id
+func (_m *OwnerResolver) GetOwner(ctx context.Context, tenantID string, ownerProvider string, id string) (ownership.Owner, error) {
+	ret := _m.Called(ctx, tenantID, ownerProvider, id)
+
+	var r0 ownership.Owner
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) ownership.Owner); ok {
+		r0 = rf(ctx, tenantID, ownerProvider, id)
+	} else {
+		r0 = ret.Get(0).(ownership.Owner)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, tenantID, ownerProvider, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
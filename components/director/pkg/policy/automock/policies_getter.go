@@ -0,0 +1,36 @@
+// Code generated by mockery v1.0.0. DO NOT EDIT.
+
+package automock
+
+import (
+	policy "github.com/kyma-incubator/compass/components/director/pkg/policy"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PoliciesGetter is an autogenerated mock type for the PoliciesGetter type
+type PoliciesGetter struct {
+	mock.Mock
+}
+
+// GetPolicies provides a mock function with given fields:
+func (_m *PoliciesGetter) GetPolicies() ([]policy.Policy, error) {
+	ret := _m.Called()
+
+	var r0 []policy.Policy
+	if rf, ok := ret.Get(0).(func() []policy.Policy); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]policy.Policy)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
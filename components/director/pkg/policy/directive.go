@@ -0,0 +1,231 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/ownership"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery -name=Engine -output=automock -outpkg=automock -case=underscore
+type Engine interface {
+	Applies(operation string) (bool, error)
+	Evaluate(ctx context.Context, in Input) (Decision, error)
+}
+
+//go:generate mockery -name=LabelRepository -output=automock -outpkg=automock -case=underscore
+type LabelRepository interface {
+	ListForObject(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) (map[string]*model.Label, error)
+}
+
+//go:generate mockery -name=OwnerResolver -output=automock -outpkg=automock -case=underscore
+type OwnerResolver interface {
+	GetOwner(ctx context.Context, tenantID, ownerProvider, id string) (ownership.Owner, error)
+}
+
+type directive struct {
+	transact      persistence.Transactioner
+	engine        Engine
+	labelRepo     LabelRepository
+	ownerResolver OwnerResolver
+}
+
+// NewDirective returns a new policy directive
+func NewDirective(transact persistence.Transactioner, engine Engine, labelRepo LabelRepository, ownerResolver OwnerResolver) *directive {
+	return &directive{
+		transact:      transact,
+		engine:        engine,
+		labelRepo:     labelRepo,
+		ownerResolver: ownerResolver,
+	}
+}
+
+// EnforcePolicies evaluates the policies against the consumer, the tenant, the operation with its arguments
+// and the object which owns the target of the operation, found with the optional targetProvider based on the idField argument.
+// The request is forwarded to the next resolver only if the policies allow it.
+func (d *directive) EnforcePolicies(ctx context.Context, _ interface{}, next graphql.Resolver, targetProvider *string, idField *string) (res interface{}, err error) {
+	consumerInfo, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resCtx := graphql.GetResolverContext(ctx)
+	applies, err := d.engine.Applies(resCtx.Field.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "while evaluating policies")
+	}
+	if !applies {
+		log.C(ctx).Debugf("No policy applies to operation %s. Skipping policies directive...", resCtx.Field.Name)
+		return next(ctx)
+	}
+
+	tenantPair, err := tenant.LoadTenantPairFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading tenant from context")
+	}
+
+	in := Input{
+		Consumer: ConsumerInput{
+			ID:   consumerInfo.ConsumerID,
+			Type: string(consumerInfo.ConsumerType),
+		},
+		Tenant: TenantInput{
+			ID:         tenantPair.InternalID,
+			ExternalID: tenantPair.ExternalID,
+		},
+		Operation: OperationInput{
+			Type: strings.ToLower(resCtx.Object),
+			Name: resCtx.Field.Name,
+		},
+		Arguments: resCtx.Args,
+	}
+
+	tx, err := d.transact.Begin()
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while opening the db transaction.")
+		return nil, err
+	}
+	defer d.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if objectType, ok := labelableObjectForConsumer(consumerInfo.ConsumerType); ok {
+		if in.Consumer.Labels, err = d.getLabels(ctx, tenantPair.InternalID, objectType, consumerInfo.ConsumerID); err != nil {
+			return nil, errors.Wrapf(err, "while getting labels of %s with ID %s", consumerInfo.ConsumerType, consumerInfo.ConsumerID)
+		}
+	}
+
+	if targetProvider != nil && idField != nil {
+		if in.Target, in.Object, err = d.getTarget(ctx, tenantPair.InternalID, *targetProvider, *idField); err != nil {
+			return nil, err
+		}
+	}
+
+	decision, err := d.engine.Evaluate(ctx, in)
+	if err != nil {
+		return nil, errors.Wrap(err, "while evaluating policies")
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while committing transaction.")
+		return nil, err
+	}
+
+	if !decision.Allowed {
+		return nil, apperrors.NewDeniedByPolicyError(decision.Policy)
+	}
+
+	return next(ctx)
+}
+
+// getTarget returns the object which owns the object addressed by the idField argument, and the addressed object itself
+func (d *directive) getTarget(ctx context.Context, tenantID, targetProvider, idField string) (*ObjectInput, *ObjectInput, error) {
+	resCtx := graphql.GetResolverContext(ctx)
+	id, ok := resCtx.Args[idField].(string)
+	if !ok {
+		return nil, nil, errors.New(fmt.Sprintf("Could not get idField: %s from request context", idField))
+	}
+
+	owner, err := d.ownerResolver.GetOwner(ctx, tenantID, targetProvider, id)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "Could not derive target, an error occurred")
+	}
+
+	target, err := d.getObject(ctx, tenantID, owner.ObjectType, owner.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	objectType, ok := addressedObjectTypes[targetProvider]
+	if !ok {
+		return nil, nil, errors.Errorf("unknown type of object addressed by target provider %s", targetProvider)
+	}
+	if objectType == owner.ObjectType {
+		return target, target, nil
+	}
+
+	object, err := d.getObject(ctx, tenantID, objectType, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return target, object, nil
+}
+
+func (d *directive) getObject(ctx context.Context, tenantID string, objectType resource.Type, id string) (*ObjectInput, error) {
+	object := &ObjectInput{
+		ID:     id,
+		Type:   string(objectType),
+		Labels: map[string]interface{}{},
+	}
+
+	labelableObject, ok := labelableObjects[objectType]
+	if !ok {
+		return object, nil
+	}
+
+	labels, err := d.getLabels(ctx, tenantID, labelableObject, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting labels of %s with ID %s", objectType, id)
+	}
+	object.Labels = labels
+
+	return object, nil
+}
+
+func (d *directive) getLabels(ctx context.Context, tenantID string, objectType model.LabelableObject, objectID string) (map[string]interface{}, error) {
+	labels, err := d.labelRepo.ListForObject(ctx, tenantID, objectType, objectID)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{}, len(labels))
+	for key, label := range labels {
+		values[key] = label.Value
+	}
+	return values, nil
+}
+
+var addressedObjectTypes = map[string]resource.Type{
+	ownership.GetApplicationID:                      resource.Application,
+	ownership.GetApplicationIDByWebhook:             resource.Webhook,
+	ownership.GetApplicationIDByPackage:             resource.Package,
+	ownership.GetApplicationIDByAPIDefinition:       resource.API,
+	ownership.GetApplicationIDByEventDefinition:     resource.EventDefinition,
+	ownership.GetApplicationIDByDocument:            resource.Document,
+	ownership.GetApplicationIDByPackageInstanceAuth: resource.PackageInstanceAuth,
+	ownership.GetApplicationIDBySystemAuth:          resource.SystemAuth,
+	ownership.GetApplicationIDByApplicationShare:    resource.ApplicationShare,
+	ownership.GetRuntimeID:                          resource.Runtime,
+	ownership.GetRuntimeIDByRuntimeContext:          resource.RuntimeContext,
+	ownership.GetRuntimeIDBySystemAuth:              resource.SystemAuth,
+}
+
+var labelableObjects = map[resource.Type]model.LabelableObject{
+	resource.Application:    model.ApplicationLabelableObject,
+	resource.Runtime:        model.RuntimeLabelableObject,
+	resource.RuntimeContext: model.RuntimeContextLabelableObject,
+	resource.Package:        model.PackageLabelableObject,
+}
+
+func labelableObjectForConsumer(consumerType consumer.ConsumerType) (model.LabelableObject, bool) {
+	switch consumerType {
+	case consumer.Runtime:
+		return model.RuntimeLabelableObject, true
+	case consumer.RuntimeContext:
+		return model.RuntimeContextLabelableObject, true
+	case consumer.Application:
+		return model.ApplicationLabelableObject, true
+	}
+	return "", false
+}
@@ -0,0 +1,355 @@
+package policy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/ownership"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/policy"
+	"github.com/kyma-incubator/compass/components/director/pkg/policy/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/ast"
+)
+
+func TestEnforcePolicies(t *testing.T) {
+	testErr := errors.New("test error")
+	provider := ownership.GetApplicationIDByPackage
+	field := idField
+	runtimeConsumer := consumer.Consumer{ConsumerID: runtimeID, ConsumerType: consumer.Runtime}
+	runtimeLabels := map[string]interface{}{"region": "x"}
+	appLabels := map[string]interface{}{"owner": "team-a"}
+	packageLabels := map[string]interface{}{"pii": true}
+	applicationProvider := ownership.GetApplicationID
+	applicationField := "applicationID"
+
+	t.Run("could not extract consumer information, should return error", func(t *testing.T) {
+		// GIVEN
+		directive := policy.NewDirective(nil, nil, nil, nil)
+		// WHEN
+		res, err := directive.EnforcePolicies(context.TODO(), nil, nil, &provider, &field)
+		// THEN
+		require.Error(t, err)
+		assert.EqualError(t, err, consumer.NoConsumerError.Error())
+		assert.Nil(t, res)
+	})
+
+	t.Run("no policy applies to the operation, should proceed with next resolver", func(t *testing.T) {
+		// GIVEN
+		engine := &automock.Engine{}
+		engine.On("Applies", operation).Return(false, nil).Once()
+		defer engine.AssertExpectations(t)
+
+		directive := policy.NewDirective(nil, engine, nil, nil)
+		ctx := fixContext(runtimeConsumer, map[string]interface{}{idField: packageID})
+		dummyResolver := &dummyResolver{}
+		// WHEN
+		res, err := directive.EnforcePolicies(ctx, nil, dummyResolver.SuccessResolve, &provider, &field)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, mockedNextOutput(), res)
+		assert.True(t, dummyResolver.called)
+	})
+
+	t.Run("could not check whether policies apply, should return error", func(t *testing.T) {
+		// GIVEN
+		engine := &automock.Engine{}
+		engine.On("Applies", operation).Return(false, testErr).Once()
+		defer engine.AssertExpectations(t)
+
+		directive := policy.NewDirective(nil, engine, nil, nil)
+		ctx := fixContext(runtimeConsumer, map[string]interface{}{idField: packageID})
+		// WHEN
+		res, err := directive.EnforcePolicies(ctx, nil, nil, &provider, &field)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		assert.Nil(t, res)
+	})
+
+	t.Run("could not extract tenant from context, should return error", func(t *testing.T) {
+		// GIVEN
+		engine := &automock.Engine{}
+		engine.On("Applies", operation).Return(true, nil).Once()
+		defer engine.AssertExpectations(t)
+
+		directive := policy.NewDirective(nil, engine, nil, nil)
+		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, runtimeConsumer)
+		ctx = graphql.WithResolverContext(ctx, &graphql.ResolverContext{Field: graphql.CollectedField{Field: &ast.Field{Name: operation}}})
+		// WHEN
+		res, err := directive.EnforcePolicies(ctx, nil, nil, &provider, &field)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), apperrors.NewCannotReadTenantError().Error())
+		assert.Nil(t, res)
+	})
+
+	t.Run("transaction could not be started, should return error", func(t *testing.T) {
+		// GIVEN
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(testErr).ThatFailsOnBegin()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		engine := &automock.Engine{}
+		engine.On("Applies", operation).Return(true, nil).Once()
+		defer engine.AssertExpectations(t)
+
+		directive := policy.NewDirective(mockedTransactioner, engine, nil, nil)
+		ctx := fixContext(runtimeConsumer, map[string]interface{}{idField: packageID})
+		// WHEN
+		res, err := directive.EnforcePolicies(ctx, nil, nil, &provider, &field)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		assert.Nil(t, res)
+	})
+
+	testCases := []struct {
+		Name               string
+		Consumer           consumer.Consumer
+		Args               map[string]interface{}
+		TargetProvider     *string
+		IDField            *string
+		LabelRepoFn        func() *automock.LabelRepository
+		OwnerResolverFn    func() *automock.OwnerResolver
+		EngineFn           func() *automock.Engine
+		TxFn               func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ExpectedErr        error
+		ExpectedErrMessage string
+	}{
+		{
+			Name:           "Allows request when policies allow it",
+			Consumer:       runtimeConsumer,
+			Args:           map[string]interface{}{idField: packageID},
+			TargetProvider: &provider,
+			IDField:        &field,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", txtest.CtxWithDBMatcher(), tenantID, model.RuntimeLabelableObject, runtimeID).Return(fixLabels(runtimeLabels), nil).Once()
+				repo.On("ListForObject", txtest.CtxWithDBMatcher(), tenantID, model.ApplicationLabelableObject, appID).Return(fixLabels(appLabels), nil).Once()
+				repo.On("ListForObject", txtest.CtxWithDBMatcher(), tenantID, model.PackageLabelableObject, packageID).Return(fixLabels(packageLabels), nil).Once()
+				return repo
+			},
+			OwnerResolverFn: fixOwnerResolver(ownership.Owner{ObjectType: resource.Application, ID: appID}, nil),
+			EngineFn:        fixEngine(fixInput("x", appLabels, packageLabels), policy.Decision{Allowed: true}, nil),
+			TxFn:            txtest.NewTransactionContextGenerator(nil).ThatSucceeds,
+		},
+		{
+			Name:           "Denies request when policies deny it",
+			Consumer:       runtimeConsumer,
+			Args:           map[string]interface{}{idField: packageID},
+			TargetProvider: &provider,
+			IDField:        &field,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", txtest.CtxWithDBMatcher(), tenantID, model.RuntimeLabelableObject, runtimeID).Return(fixLabels(runtimeLabels), nil).Once()
+				repo.On("ListForObject", txtest.CtxWithDBMatcher(), tenantID, model.ApplicationLabelableObject, appID).Return(fixLabels(appLabels), nil).Once()
+				repo.On("ListForObject", txtest.CtxWithDBMatcher(), tenantID, model.PackageLabelableObject, packageID).Return(fixLabels(packageLabels), nil).Once()
+				return repo
+			},
+			OwnerResolverFn: fixOwnerResolver(ownership.Owner{ObjectType: resource.Application, ID: appID}, nil),
+			EngineFn:        fixEngine(fixInput("x", appLabels, packageLabels), policy.Decision{Allowed: false, Policy: "pii"}, nil),
+			TxFn:            txtest.NewTransactionContextGenerator(nil).ThatSucceeds,
+			ExpectedErr:     apperrors.NewDeniedByPolicyError("pii"),
+		},
+		{
+			Name:           "Uses target as the addressed object when target provider addresses the owner",
+			Consumer:       consumer.Consumer{ConsumerID: "user", ConsumerType: consumer.User},
+			Args:           map[string]interface{}{applicationField: appID},
+			TargetProvider: &applicationProvider,
+			IDField:        &applicationField,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", txtest.CtxWithDBMatcher(), tenantID, model.ApplicationLabelableObject, appID).Return(fixLabels(appLabels), nil).Once()
+				return repo
+			},
+			OwnerResolverFn: func() *automock.OwnerResolver {
+				ownerResolver := &automock.OwnerResolver{}
+				ownerResolver.On("GetOwner", txtest.CtxWithDBMatcher(), tenantID, ownership.GetApplicationID, appID).Return(ownership.Owner{ObjectType: resource.Application, ID: appID}, nil).Once()
+				return ownerResolver
+			},
+			EngineFn: func() *automock.Engine {
+				target := &policy.ObjectInput{ID: appID, Type: string(resource.Application), Labels: appLabels}
+				return fixEngine(policy.Input{
+					Consumer:  policy.ConsumerInput{ID: "user", Type: string(consumer.User)},
+					Tenant:    policy.TenantInput{ID: tenantID, ExternalID: externalTenantID},
+					Operation: policy.OperationInput{Type: "mutation", Name: operation},
+					Arguments: map[string]interface{}{applicationField: appID},
+					Target:    target,
+					Object:    target,
+				}, policy.Decision{Allowed: true}, nil)()
+			},
+			TxFn: txtest.NewTransactionContextGenerator(nil).ThatSucceeds,
+		},
+		{
+			Name:     "Evaluates policies without target when target provider is not specified",
+			Consumer: consumer.Consumer{ConsumerID: "user", ConsumerType: consumer.User},
+			Args:     map[string]interface{}{},
+			EngineFn: fixEngine(policy.Input{
+				Consumer:  policy.ConsumerInput{ID: "user", Type: string(consumer.User)},
+				Tenant:    policy.TenantInput{ID: tenantID, ExternalID: externalTenantID},
+				Operation: policy.OperationInput{Type: "mutation", Name: operation},
+				Arguments: map[string]interface{}{},
+			}, policy.Decision{Allowed: true}, nil),
+			TxFn: txtest.NewTransactionContextGenerator(nil).ThatSucceeds,
+		},
+		{
+			Name:     "Returns error when consumer labels cannot be fetched",
+			Consumer: runtimeConsumer,
+			Args:     map[string]interface{}{idField: packageID},
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", txtest.CtxWithDBMatcher(), tenantID, model.RuntimeLabelableObject, runtimeID).Return(nil, testErr).Once()
+				return repo
+			},
+			TxFn:               txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:               "Returns error when id field is missing in the request",
+			Consumer:           consumer.Consumer{ConsumerID: "user", ConsumerType: consumer.User},
+			Args:               map[string]interface{}{},
+			TargetProvider:     &provider,
+			IDField:            &field,
+			TxFn:               txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit,
+			ExpectedErrMessage: "Could not get idField",
+		},
+		{
+			Name:               "Returns error when target cannot be derived",
+			Consumer:           consumer.Consumer{ConsumerID: "user", ConsumerType: consumer.User},
+			Args:               map[string]interface{}{idField: packageID},
+			TargetProvider:     &provider,
+			IDField:            &field,
+			OwnerResolverFn:    fixOwnerResolver(ownership.Owner{}, testErr),
+			TxFn:               txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:           "Returns error when target labels cannot be fetched",
+			Consumer:       consumer.Consumer{ConsumerID: "user", ConsumerType: consumer.User},
+			Args:           map[string]interface{}{idField: packageID},
+			TargetProvider: &provider,
+			IDField:        &field,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", txtest.CtxWithDBMatcher(), tenantID, model.ApplicationLabelableObject, appID).Return(nil, testErr).Once()
+				return repo
+			},
+			OwnerResolverFn:    fixOwnerResolver(ownership.Owner{ObjectType: resource.Application, ID: appID}, nil),
+			TxFn:               txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:           "Returns error when labels of the addressed object cannot be fetched",
+			Consumer:       consumer.Consumer{ConsumerID: "user", ConsumerType: consumer.User},
+			Args:           map[string]interface{}{idField: packageID},
+			TargetProvider: &provider,
+			IDField:        &field,
+			LabelRepoFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListForObject", txtest.CtxWithDBMatcher(), tenantID, model.ApplicationLabelableObject, appID).Return(fixLabels(appLabels), nil).Once()
+				repo.On("ListForObject", txtest.CtxWithDBMatcher(), tenantID, model.PackageLabelableObject, packageID).Return(nil, testErr).Once()
+				return repo
+			},
+			OwnerResolverFn:    fixOwnerResolver(ownership.Owner{ObjectType: resource.Application, ID: appID}, nil),
+			TxFn:               txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:     "Returns error when policies cannot be evaluated",
+			Consumer: consumer.Consumer{ConsumerID: "user", ConsumerType: consumer.User},
+			Args:     map[string]interface{}{},
+			EngineFn: func() *automock.Engine {
+				engine := &automock.Engine{}
+				engine.On("Evaluate", txtest.CtxWithDBMatcher(), mock.Anything).Return(policy.Decision{}, testErr).Once()
+				return engine
+			},
+			TxFn:               txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit,
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:     "Returns error when transaction cannot be committed",
+			Consumer: consumer.Consumer{ConsumerID: "user", ConsumerType: consumer.User},
+			Args:     map[string]interface{}{},
+			EngineFn: func() *automock.Engine {
+				engine := &automock.Engine{}
+				engine.On("Evaluate", txtest.CtxWithDBMatcher(), mock.Anything).Return(policy.Decision{Allowed: true}, nil).Once()
+				return engine
+			},
+			TxFn:               txtest.NewTransactionContextGenerator(testErr).ThatFailsOnCommit,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			labelRepo := &automock.LabelRepository{}
+			if testCase.LabelRepoFn != nil {
+				labelRepo = testCase.LabelRepoFn()
+			}
+			ownerResolver := &automock.OwnerResolver{}
+			if testCase.OwnerResolverFn != nil {
+				ownerResolver = testCase.OwnerResolverFn()
+			}
+			engine := &automock.Engine{}
+			if testCase.EngineFn != nil {
+				engine = testCase.EngineFn()
+			}
+			engine.On("Applies", operation).Return(true, nil).Once()
+			mockedTx, mockedTransactioner := testCase.TxFn()
+
+			directive := policy.NewDirective(mockedTransactioner, engine, labelRepo, ownerResolver)
+			ctx := fixContext(testCase.Consumer, testCase.Args)
+			dummyResolver := &dummyResolver{}
+
+			// WHEN
+			res, err := directive.EnforcePolicies(ctx, nil, dummyResolver.SuccessResolve, testCase.TargetProvider, testCase.IDField)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, testCase.ExpectedErr, err)
+				assert.Equal(t, apperrors.Forbidden, apperrors.ErrorCode(err))
+				assert.Nil(t, res)
+				assert.False(t, dummyResolver.called)
+			} else if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+				assert.Nil(t, res)
+				assert.False(t, dummyResolver.called)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, mockedNextOutput(), res)
+				assert.True(t, dummyResolver.called)
+			}
+
+			mock.AssertExpectationsForObjects(t, mockedTx, mockedTransactioner, labelRepo, ownerResolver, engine)
+		})
+	}
+}
+
+func fixOwnerResolver(owner ownership.Owner, err error) func() *automock.OwnerResolver {
+	return func() *automock.OwnerResolver {
+		ownerResolver := &automock.OwnerResolver{}
+		ownerResolver.On("GetOwner", txtest.CtxWithDBMatcher(), tenantID, ownership.GetApplicationIDByPackage, packageID).Return(owner, err).Once()
+		return ownerResolver
+	}
+}
+
+func fixEngine(in policy.Input, decision policy.Decision, err error) func() *automock.Engine {
+	return func() *automock.Engine {
+		engine := &automock.Engine{}
+		engine.On("Evaluate", txtest.CtxWithDBMatcher(), in).Return(decision, err).Once()
+		return engine
+	}
+}
@@ -0,0 +1,134 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+//go:generate mockery -name=PoliciesGetter -output=automock -outpkg=automock -case=underscore
+type PoliciesGetter interface {
+	// GetPolicies returns the policies with compiled conditions, see Policy.Compile
+	GetPolicies() ([]Policy, error)
+}
+
+type engine struct {
+	policiesGetter PoliciesGetter
+}
+
+// NewEngine returns a new policy engine, which evaluates the policies returned by the policies getter
+func NewEngine(policiesGetter PoliciesGetter) *engine {
+	return &engine{
+		policiesGetter: policiesGetter,
+	}
+}
+
+// Applies returns true if any policy applies to the operation
+func (e *engine) Applies(operation string) (bool, error) {
+	policies, err := e.policiesGetter.GetPolicies()
+	if err != nil {
+		return false, errors.Wrap(err, "while getting policies")
+	}
+
+	for _, p := range policies {
+		if p.AppliesTo(operation) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Evaluate decides whether the request described by the input is allowed.
+// The request is denied if it matches any deny policy, or if there are allow policies for the operation and it matches none of them.
+// Every decision is written to the decision log.
+func (e *engine) Evaluate(ctx context.Context, in Input) (Decision, error) {
+	policies, err := e.policiesGetter.GetPolicies()
+	if err != nil {
+		return Decision{}, errors.Wrap(err, "while getting policies")
+	}
+
+	inputMarshalled, err := json.Marshal(in)
+	if err != nil {
+		return Decision{}, errors.Wrap(err, "while marshalling policy input")
+	}
+
+	var allowPolicies []string
+	allowed := false
+	for _, p := range policies {
+		if !p.AppliesTo(in.Operation.Name) {
+			continue
+		}
+
+		matches, err := p.matches(string(inputMarshalled))
+		if err != nil {
+			return Decision{}, errors.Wrapf(err, "while evaluating condition of policy %s", p.Name)
+		}
+
+		switch p.Effect {
+		case Deny:
+			if matches {
+				decision := Decision{Allowed: false, Policy: p.Name}
+				logDecision(ctx, in, decision)
+				return decision, nil
+			}
+		case Allow:
+			allowPolicies = append(allowPolicies, p.Name)
+			allowed = allowed || matches
+		default:
+			return Decision{}, errors.Errorf("unknown effect %s of policy %s", p.Effect, p.Name)
+		}
+	}
+
+	decision := Decision{Allowed: true}
+	if len(allowPolicies) > 0 && !allowed {
+		decision = Decision{Allowed: false, Policy: strings.Join(allowPolicies, ",")}
+	}
+
+	logDecision(ctx, in, decision)
+	return decision, nil
+}
+
+func (p Policy) matches(in string) (bool, error) {
+	if len(p.Condition) == 0 {
+		return true, nil
+	}
+
+	if p.validator == nil {
+		return false, errors.New("condition is not compiled")
+	}
+
+	result, err := p.validator.ValidateString(in)
+	if err != nil {
+		return false, errors.Wrap(err, "while validating input against condition")
+	}
+
+	return result.Valid, nil
+}
+
+func logDecision(ctx context.Context, in Input, decision Decision) {
+	fields := logrus.Fields{
+		"decision":      decisionName(decision),
+		"policy":        decision.Policy,
+		"operation":     in.Operation.Name,
+		"consumer_type": in.Consumer.Type,
+		"consumer_id":   in.Consumer.ID,
+		"tenant":        in.Tenant.ExternalID,
+	}
+	if in.Target != nil {
+		fields["target_type"] = in.Target.Type
+		fields["target_id"] = in.Target.ID
+	}
+
+	log.C(ctx).WithFields(fields).Info("Policy decision")
+}
+
+func decisionName(decision Decision) string {
+	if decision.Allowed {
+		return string(Allow)
+	}
+	return string(Deny)
+}
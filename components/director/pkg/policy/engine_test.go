@@ -0,0 +1,260 @@
+package policy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/policy"
+	"github.com/kyma-incubator/compass/components/director/pkg/policy/automock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_Applies(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+
+	testCases := []struct {
+		Name               string
+		Policies           []policy.Policy
+		PoliciesErr        error
+		ExpectedResult     bool
+		ExpectedErrMessage string
+	}{
+		{
+			Name:           "Returns false when there are no policies",
+			ExpectedResult: false,
+		},
+		{
+			Name:           "Returns false when no policy applies to the operation",
+			Policies:       []policy.Policy{fixDenyPolicy("other", "otherOperation", nil)},
+			ExpectedResult: false,
+		},
+		{
+			Name:           "Returns true when policy applies to the operation",
+			Policies:       []policy.Policy{fixDenyPolicy("other", "otherOperation", nil), fixDenyPolicy("deny", operation, nil)},
+			ExpectedResult: true,
+		},
+		{
+			Name:           "Returns true when policy applies to every operation",
+			Policies:       []policy.Policy{{Name: "all", Effect: policy.Deny}},
+			ExpectedResult: true,
+		},
+		{
+			Name:               "Returns error when policies cannot be fetched",
+			PoliciesErr:        testErr,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			policiesGetter := &automock.PoliciesGetter{}
+			policiesGetter.On("GetPolicies").Return(testCase.Policies, testCase.PoliciesErr).Once()
+
+			engine := policy.NewEngine(policiesGetter)
+
+			// WHEN
+			result, err := engine.Applies(operation)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+
+			policiesGetter.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEngine_Evaluate(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+
+	regionXCondition := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"consumer": map[string]interface{}{
+				"properties": map[string]interface{}{
+					"type": map[string]interface{}{"const": "Runtime"},
+					"labels": map[string]interface{}{
+						"properties": map[string]interface{}{
+							"region": map[string]interface{}{"const": "x"},
+						},
+						"required": []interface{}{"region"},
+					},
+				},
+			},
+			"object": map[string]interface{}{
+				"properties": map[string]interface{}{
+					"type": map[string]interface{}{"const": "Package"},
+					"labels": map[string]interface{}{
+						"required": []interface{}{"pii"},
+					},
+				},
+				"required": []interface{}{"labels"},
+			},
+		},
+		"required": []interface{}{"object"},
+	}
+	tenantCondition := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"tenant": map[string]interface{}{
+				"properties": map[string]interface{}{
+					"externalID": map[string]interface{}{"const": externalTenantID},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name               string
+		Policies           []policy.Policy
+		PoliciesErr        error
+		NotCompiled        bool
+		Input              policy.Input
+		ExpectedDecision   policy.Decision
+		ExpectedErrMessage string
+	}{
+		{
+			Name:             "Allows when there are no policies",
+			Input:            fixInput("x", nil, map[string]interface{}{"pii": true}),
+			ExpectedDecision: policy.Decision{Allowed: true},
+		},
+		{
+			Name:             "Denies when deny policy matches",
+			Policies:         []policy.Policy{fixDenyPolicy("pii", operation, regionXCondition)},
+			Input:            fixInput("x", nil, map[string]interface{}{"pii": true}),
+			ExpectedDecision: policy.Decision{Allowed: false, Policy: "pii"},
+		},
+		{
+			Name:             "Denies when deny policy without condition applies",
+			Policies:         []policy.Policy{fixDenyPolicy("all", operation, nil)},
+			Input:            fixInput("x", nil, nil),
+			ExpectedDecision: policy.Decision{Allowed: false, Policy: "all"},
+		},
+		{
+			Name:             "Allows when deny policy condition does not match consumer labels",
+			Policies:         []policy.Policy{fixDenyPolicy("pii", operation, regionXCondition)},
+			Input:            fixInput("y", nil, map[string]interface{}{"pii": true}),
+			ExpectedDecision: policy.Decision{Allowed: true},
+		},
+		{
+			Name:             "Allows when deny policy condition does not match package labels",
+			Policies:         []policy.Policy{fixDenyPolicy("pii", operation, regionXCondition)},
+			Input:            fixInput("x", nil, map[string]interface{}{"other": true}),
+			ExpectedDecision: policy.Decision{Allowed: true},
+		},
+		{
+			Name:             "Allows when deny policy applies to other operation",
+			Policies:         []policy.Policy{fixDenyPolicy("pii", "otherOperation", regionXCondition)},
+			Input:            fixInput("x", nil, map[string]interface{}{"pii": true}),
+			ExpectedDecision: policy.Decision{Allowed: true},
+		},
+		{
+			Name:             "Allows when allow policy matches",
+			Policies:         []policy.Policy{{Name: "tenant", Effect: policy.Allow, Operations: []string{operation}, Condition: tenantCondition}},
+			Input:            fixInput("x", nil, nil),
+			ExpectedDecision: policy.Decision{Allowed: true},
+		},
+		{
+			Name: "Denies when no allow policy matches",
+			Policies: []policy.Policy{
+				{Name: "pii", Effect: policy.Allow, Operations: []string{operation}, Condition: regionXCondition},
+				{Name: "other-tenant", Effect: policy.Allow, Condition: map[string]interface{}{"properties": map[string]interface{}{"tenant": map[string]interface{}{"properties": map[string]interface{}{"externalID": map[string]interface{}{"const": "other"}}}}}},
+			},
+			Input:            fixInput("y", nil, nil),
+			ExpectedDecision: policy.Decision{Allowed: false, Policy: "pii,other-tenant"},
+		},
+		{
+			Name: "Denies when deny policy matches even if allow policy matches",
+			Policies: []policy.Policy{
+				{Name: "tenant", Effect: policy.Allow, Condition: tenantCondition},
+				fixDenyPolicy("pii", operation, regionXCondition),
+			},
+			Input:            fixInput("x", nil, map[string]interface{}{"pii": true}),
+			ExpectedDecision: policy.Decision{Allowed: false, Policy: "pii"},
+		},
+		{
+			Name:               "Returns error when policies cannot be fetched",
+			PoliciesErr:        testErr,
+			Input:              fixInput("x", nil, nil),
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:               "Returns error when condition is not compiled",
+			Policies:           []policy.Policy{fixDenyPolicy("not-compiled", operation, regionXCondition)},
+			NotCompiled:        true,
+			Input:              fixInput("x", nil, nil),
+			ExpectedErrMessage: "while evaluating condition of policy not-compiled",
+		},
+		{
+			Name:               "Returns error when effect is unknown",
+			Policies:           []policy.Policy{{Name: "unknown", Effect: "unknown"}},
+			Input:              fixInput("x", nil, nil),
+			ExpectedErrMessage: "unknown effect unknown of policy unknown",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			policies := testCase.Policies
+			if !testCase.NotCompiled {
+				policies = fixCompiledPolicies(t, policies)
+			}
+			policiesGetter := &automock.PoliciesGetter{}
+			policiesGetter.On("GetPolicies").Return(policies, testCase.PoliciesErr).Once()
+
+			engine := policy.NewEngine(policiesGetter)
+
+			// WHEN
+			decision, err := engine.Evaluate(ctx, testCase.Input)
+
+			// THEN
+			if testCase.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedDecision, decision)
+			}
+
+			policiesGetter.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPolicy_Compile(t *testing.T) {
+	t.Run("compiles condition", func(t *testing.T) {
+		// GIVEN
+		p := fixDenyPolicy("deny", operation, map[string]interface{}{"required": []interface{}{"object"}})
+		// WHEN
+		err := p.Compile()
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("compiles policy without condition", func(t *testing.T) {
+		// GIVEN
+		p := fixDenyPolicy("deny", operation, nil)
+		// WHEN
+		err := p.Compile()
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("returns error when condition is invalid", func(t *testing.T) {
+		// GIVEN
+		p := fixDenyPolicy("invalid", operation, map[string]interface{}{"type": "unknown"})
+		// WHEN
+		err := p.Compile()
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while compiling condition of policy invalid")
+	})
+}
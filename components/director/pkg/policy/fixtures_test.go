@@ -0,0 +1,103 @@
+package policy_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/policy"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/ast"
+)
+
+const (
+	tenantID         = "tenant"
+	externalTenantID = "external-tenant"
+	operation        = "requestPackageInstanceAuthCreation"
+	runtimeID        = "runtime"
+	appID            = "app"
+	packageID        = "package"
+	idField          = "packageID"
+)
+
+func fixDenyPolicy(name, operation string, condition map[string]interface{}) policy.Policy {
+	return policy.Policy{
+		Name:       name,
+		Effect:     policy.Deny,
+		Operations: []string{operation},
+		Condition:  condition,
+	}
+}
+
+func fixCompiledPolicies(t *testing.T, policies []policy.Policy) []policy.Policy {
+	compiled := make([]policy.Policy, 0, len(policies))
+	for _, p := range policies {
+		require.NoError(t, p.Compile())
+		compiled = append(compiled, p)
+	}
+	return compiled
+}
+
+func fixInput(region string, targetLabels, packageLabels map[string]interface{}) policy.Input {
+	return policy.Input{
+		Consumer: policy.ConsumerInput{
+			ID:     runtimeID,
+			Type:   string(consumer.Runtime),
+			Labels: map[string]interface{}{"region": region},
+		},
+		Tenant: policy.TenantInput{
+			ID:         tenantID,
+			ExternalID: externalTenantID,
+		},
+		Operation: policy.OperationInput{
+			Type: "mutation",
+			Name: operation,
+		},
+		Arguments: map[string]interface{}{idField: packageID},
+		Target: &policy.ObjectInput{
+			ID:     appID,
+			Type:   string(resource.Application),
+			Labels: targetLabels,
+		},
+		Object: &policy.ObjectInput{
+			ID:     packageID,
+			Type:   string(resource.Package),
+			Labels: packageLabels,
+		},
+	}
+}
+
+func fixContext(consumerInfo consumer.Consumer, args map[string]interface{}) context.Context {
+	ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumerInfo)
+	ctx = context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID, ExternalID: externalTenantID})
+	return graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+		Object: "Mutation",
+		Field:  graphql.CollectedField{Field: &ast.Field{Name: operation}},
+		Args:   args,
+	})
+}
+
+func fixLabels(labels map[string]interface{}) map[string]*model.Label {
+	result := make(map[string]*model.Label, len(labels))
+	for key, value := range labels {
+		result[key] = &model.Label{Key: key, Value: value}
+	}
+	return result
+}
+
+type dummyResolver struct {
+	called bool
+}
+
+func (d *dummyResolver) SuccessResolve(_ context.Context) (res interface{}, err error) {
+	d.called = true
+	return mockedNextOutput(), nil
+}
+
+func mockedNextOutput() string {
+	return "nextOutput"
+}
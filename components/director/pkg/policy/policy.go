@@ -0,0 +1,101 @@
+package policy
+
+import (
+	"github.com/kyma-incubator/compass/components/director/pkg/jsonschema"
+	"github.com/pkg/errors"
+)
+
+type Effect string
+
+const (
+	// Deny denies the matching requests
+	Deny Effect = "deny"
+	// Allow allows only the matching requests to the operations which it covers
+	Allow Effect = "allow"
+)
+
+// Policy is an authorization rule evaluated on top of the scopes.
+// Condition is a JSON Schema which is validated against the Input of the request.
+// A policy without operations applies to every operation, and a policy without condition matches every request.
+type Policy struct {
+	Name       string                 `json:"name"`
+	Effect     Effect                 `json:"effect"`
+	Operations []string               `json:"operations,omitempty"`
+	Condition  map[string]interface{} `json:"condition,omitempty"`
+
+	validator conditionValidator
+}
+
+type conditionValidator interface {
+	ValidateString(json string) (jsonschema.ValidationResult, error)
+}
+
+// Compile compiles the condition of the policy. Policies are compiled once when they are loaded, so that
+// the JSON Schema is not compiled again on every evaluation.
+func (p *Policy) Compile() error {
+	p.validator = nil
+	if len(p.Condition) == 0 {
+		return nil
+	}
+
+	validator, err := jsonschema.NewValidatorFromRawSchema(p.Condition)
+	if err != nil {
+		return errors.Wrapf(err, "while compiling condition of policy %s", p.Name)
+	}
+	p.validator = validator
+
+	return nil
+}
+
+func (p Policy) AppliesTo(operation string) bool {
+	if len(p.Operations) == 0 {
+		return true
+	}
+
+	for _, op := range p.Operations {
+		if op == operation {
+			return true
+		}
+	}
+	return false
+}
+
+// Input is the document against which the conditions of the policies are evaluated
+type Input struct {
+	Consumer  ConsumerInput          `json:"consumer"`
+	Tenant    TenantInput            `json:"tenant"`
+	Operation OperationInput         `json:"operation"`
+	Arguments map[string]interface{} `json:"arguments"`
+	Target    *ObjectInput           `json:"target,omitempty"`
+	Object    *ObjectInput           `json:"object,omitempty"`
+}
+
+type ConsumerInput struct {
+	ID     string                 `json:"id"`
+	Type   string                 `json:"type"`
+	Labels map[string]interface{} `json:"labels"`
+}
+
+type TenantInput struct {
+	ID         string `json:"id"`
+	ExternalID string `json:"externalID"`
+}
+
+type OperationInput struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+// ObjectInput describes an object with its labels, either the object being accessed or the top-level object,
+// an Application or a Runtime, which owns it. Objects which cannot be labeled have no labels.
+type ObjectInput struct {
+	ID     string                 `json:"id"`
+	Type   string                 `json:"type"`
+	Labels map[string]interface{} `json:"labels"`
+}
+
+// Decision is the result of the policies evaluation. Policy is the name of the policy which caused the denial.
+type Decision struct {
+	Allowed bool
+	Policy  string
+}
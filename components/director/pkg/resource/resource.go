@@ -20,6 +20,13 @@ const (
 	EventDefinition            Type = "EventDefinition"
 	AutomaticScenarioAssigment Type = "AutomaticScenarioAssigment"
 	Webhook                    Type = "Webhook"
+	User                       Type = "User"
+	UserGroup                  Type = "UserGroup"
+	Role                       Type = "Role"
+	RoleBinding                Type = "RoleBinding"
+	Scenario                   Type = "Scenario"
+	ApplicationShare           Type = "ApplicationShare"
+	TenantOffboarding          Type = "TenantOffboarding"
 )
 
 type SQLOperation string
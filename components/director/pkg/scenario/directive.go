@@ -10,6 +10,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/appshare"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/packageinstanceauth"
 
 	mp_package "github.com/kyma-incubator/compass/components/director/internal/domain/package"
@@ -38,10 +39,11 @@ type directive struct {
 	transact  persistence.Transactioner
 
 	applicationProviders map[string]func(context.Context, string, string) (string, error)
+	shareProviders       map[string]func(context.Context, string, string) ([]*model.ApplicationShare, error)
 }
 
 // NewDirective returns a new scenario directive
-func NewDirective(transact persistence.Transactioner, labelRepo label.LabelRepository, packageRepo mp_package.PackageRepository, packageInstanceAuthRepo packageinstanceauth.Repository) *directive {
+func NewDirective(transact persistence.Transactioner, labelRepo label.LabelRepository, packageRepo mp_package.PackageRepository, packageInstanceAuthRepo packageinstanceauth.Repository, shareRepo appshare.ApplicationShareRepository) *directive {
 	getApplicationIDByPackageFunc := func(ctx context.Context, tenantID, packageID string) (string, error) {
 		pkg, err := packageRepo.GetByID(ctx, tenantID, packageID)
 		if err != nil {
@@ -67,41 +69,55 @@ func NewDirective(transact persistence.Transactioner, labelRepo label.LabelRepos
 				return getApplicationIDByPackageFunc(ctx, tenantID, packageInstanceAuth.PackageID)
 			},
 		},
+		shareProviders: map[string]func(context.Context, string, string) ([]*model.ApplicationShare, error){
+			GetApplicationIDByPackage: func(ctx context.Context, tenantID, packageID string) ([]*model.ApplicationShare, error) {
+				return shareRepo.ListForPackage(ctx, tenantID, packageID)
+			},
+			GetApplicationIDByPackageInstanceAuth: func(ctx context.Context, tenantID, packageInstanceAuthID string) ([]*model.ApplicationShare, error) {
+				return shareRepo.ListForPackageInstanceAuth(ctx, tenantID, packageInstanceAuthID)
+			},
+		},
 	}
 }
 
-// HasScenario ensures that the runtime is in a scenario with the application which resources are being manipulated.
-// If the caller is not a Runtime, then request is forwarded to the next resolver.
+// HasScenario ensures that the runtime or runtime context is in a scenario with the application which resources are being manipulated.
+// If the caller is neither a Runtime nor a Runtime Context, then request is forwarded to the next resolver.
 func (d *directive) HasScenario(ctx context.Context, _ interface{}, next graphql.Resolver, applicationProvider string, idField string) (res interface{}, err error) {
 	consumerInfo, err := consumer.LoadFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if consumerInfo.ConsumerType != consumer.Runtime {
-		log.C(ctx).Debugf("Consumer type %v is not of type %v. Skipping verification directive...", consumerInfo.ConsumerType, consumer.Runtime)
+	var objectType model.LabelableObject
+	switch consumerInfo.ConsumerType {
+	case consumer.Runtime:
+		objectType = model.RuntimeLabelableObject
+	case consumer.RuntimeContext:
+		objectType = model.RuntimeContextLabelableObject
+	default:
+		log.C(ctx).Debugf("Consumer type %v is neither of type %v nor %v. Skipping verification directive...", consumerInfo.ConsumerType, consumer.Runtime, consumer.RuntimeContext)
 		return next(ctx)
 	}
-	log.C(ctx).Infof("Attempting to verify that the requesting runtime is in scenario with the owning application entity")
+	log.C(ctx).Infof("Attempting to verify that the requesting %s is in scenario with the owning application entity", objectType)
 
-	runtimeID := consumerInfo.ConsumerID
-	log.C(ctx).Debugf("Found Runtime ID for the requesting runtime: %v", runtimeID)
+	objectID := consumerInfo.ConsumerID
+	log.C(ctx).Debugf("Found %s ID for the requesting consumer: %v", objectType, objectID)
 
-	commonScenarios, err := d.extractCommonScenarios(ctx, runtimeID, applicationProvider, idField)
+	commonScenarios, err := d.extractCommonScenarios(ctx, objectType, objectID, applicationProvider, idField)
 	if err != nil {
 		return nil, err
 	}
 
 	if len(commonScenarios) == 0 {
-		return nil, apperrors.NewInvalidOperationError("requesting runtime should be in same scenario as the requested application resource")
+		return nil, apperrors.NewInvalidOperationError(fmt.Sprintf("requesting %s should be in same scenario as the requested application resource", objectType))
 	}
 	log.C(ctx).Debugf("Found the following common scenarios: %+v", commonScenarios)
 
-	log.C(ctx).Infof("Runtime with ID %s is in scenario with the owning application entity", runtimeID)
+	log.C(ctx).Infof("%s with ID %s is in scenario with the owning application entity", objectType, objectID)
 	return next(ctx)
 }
 
-func (d *directive) extractCommonScenarios(ctx context.Context, runtimeID, applicationProvider, idField string) ([]string, error) {
+func (d *directive) extractCommonScenarios(ctx context.Context, objectType model.LabelableObject, objectID, applicationProvider, idField string) ([]string, error) {
 	tenantID, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "while loading tenant from context")
@@ -129,7 +145,23 @@ func (d *directive) extractCommonScenarios(ctx context.Context, runtimeID, appli
 
 	appID, err := appProviderFunc(ctx, tenantID, id)
 	if err != nil {
-		return nil, errors.Wrapf(err, "Could not derive app id, an error occurred")
+		shares, shareErr := d.listShares(ctx, tenantID, applicationProvider, id, err)
+		if shareErr != nil {
+			return nil, errors.Wrapf(shareErr, "Could not derive app id, an error occurred")
+		}
+		log.C(ctx).Infof("Found %d Application Shares based on the request parameter %s", len(shares), idField)
+
+		commonScenarios, err := d.extractSharedScenarios(ctx, tenantID, objectType, objectID, shares)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := tx.Commit(); err != nil {
+			log.C(ctx).WithError(err).Errorf("An error occurred while committing transaction.")
+			return nil, err
+		}
+
+		return commonScenarios, nil
 	}
 	log.C(ctx).Infof("Found owning Application ID based on the request parameter %s: %s", idField, appID)
 
@@ -139,21 +171,60 @@ func (d *directive) extractCommonScenarios(ctx context.Context, runtimeID, appli
 	}
 	log.C(ctx).Debugf("Found the following application scenarios: %s", appScenarios)
 
-	runtimeScenarios, err := d.getObjectScenarios(ctx, tenantID, model.RuntimeLabelableObject, runtimeID)
+	objectScenarios, err := d.getObjectScenarios(ctx, tenantID, objectType, objectID)
 	if err != nil {
-		return nil, errors.Wrap(err, "while fetching scenarios for runtime")
+		return nil, errors.Wrapf(err, "while fetching scenarios for %s", objectType)
 	}
-	log.C(ctx).Debugf("Found the following runtime scenarios: %s", runtimeScenarios)
+	log.C(ctx).Debugf("Found the following %s scenarios: %s", objectType, objectScenarios)
 
 	if err := tx.Commit(); err != nil {
 		log.C(ctx).WithError(err).Errorf("An error occurred while committing transaction.")
 		return nil, err
 	}
 
-	commonScenarios := stringsIntersection(appScenarios, runtimeScenarios)
+	commonScenarios := stringsIntersection(appScenarios, objectScenarios)
 	return commonScenarios, nil
 }
 
+// listShares returns the shares of the Application owning the requested resource, if the resource is not found in the tenant
+// and belongs to an Application shared with it. Otherwise the original error is returned.
+func (d *directive) listShares(ctx context.Context, tenantID, applicationProvider, id string, appProviderErr error) ([]*model.ApplicationShare, error) {
+	shareProviderFunc, ok := d.shareProviders[applicationProvider]
+	if !ok || !apperrors.IsNotFoundError(appProviderErr) {
+		return nil, appProviderErr
+	}
+
+	shares, err := shareProviderFunc(ctx, tenantID, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing Application Shares")
+	}
+	if len(shares) == 0 {
+		return nil, appProviderErr
+	}
+
+	return shares, nil
+}
+
+// extractSharedScenarios returns the scenarios of the object which the Application is shared in.
+// A share without a scenario covers all scenarios of the object.
+func (d *directive) extractSharedScenarios(ctx context.Context, tenantID string, objectType model.LabelableObject, objectID string, shares []*model.ApplicationShare) ([]string, error) {
+	objectScenarios, err := d.getObjectScenarios(ctx, tenantID, objectType, objectID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while fetching scenarios for %s", objectType)
+	}
+	log.C(ctx).Debugf("Found the following %s scenarios: %s", objectType, objectScenarios)
+
+	sharedScenarios := make([]string, 0, len(shares))
+	for _, share := range shares {
+		if share.Scenario == nil {
+			return objectScenarios, nil
+		}
+		sharedScenarios = append(sharedScenarios, *share.Scenario)
+	}
+
+	return stringsIntersection(sharedScenarios, objectScenarios), nil
+}
+
 func (d *directive) getObjectScenarios(ctx context.Context, tenantID string, objectType model.LabelableObject, objectID string) ([]string, error) {
 	scenariosLabel, err := d.labelRepo.GetByKey(ctx, tenantID, objectType, objectID, model.ScenariosKey)
 	if err != nil {
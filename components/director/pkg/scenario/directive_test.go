@@ -13,6 +13,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/99designs/gqlgen/graphql"
+	share_mock "github.com/kyma-incubator/compass/components/director/internal/domain/appshare/automock"
 	lbl_mock "github.com/kyma-incubator/compass/components/director/internal/domain/label/automock"
 	pkg_mock "github.com/kyma-incubator/compass/components/director/internal/domain/package/automock"
 	pkg_auth_mock "github.com/kyma-incubator/compass/components/director/internal/domain/packageinstanceauth/automock"
@@ -28,7 +29,7 @@ import (
 func TestHasScenario(t *testing.T) {
 	t.Run("could not extract consumer information, should return error", func(t *testing.T) {
 		// GIVEN
-		directive := scenario.NewDirective(nil, nil, nil, nil)
+		directive := scenario.NewDirective(nil, nil, nil, nil, nil)
 		// WHEN
 		res, err := directive.HasScenario(context.TODO(), nil, nil, "", "")
 		// THEN
@@ -39,7 +40,7 @@ func TestHasScenario(t *testing.T) {
 
 	t.Run("consumer is of type user, should proceed with next resolver", func(t *testing.T) {
 		// GIVEN
-		directive := scenario.NewDirective(nil, nil, nil, nil)
+		directive := scenario.NewDirective(nil, nil, nil, nil, nil)
 		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerType: consumer.User})
 		dummyResolver := &dummyResolver{}
 		// WHEN
@@ -51,7 +52,7 @@ func TestHasScenario(t *testing.T) {
 
 	t.Run("consumer is of type application, should proceed with next resolver", func(t *testing.T) {
 		// GIVEN
-		directive := scenario.NewDirective(nil, nil, nil, nil)
+		directive := scenario.NewDirective(nil, nil, nil, nil, nil)
 		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerType: consumer.Application})
 		dummyResolver := &dummyResolver{}
 		// WHEN
@@ -63,7 +64,7 @@ func TestHasScenario(t *testing.T) {
 
 	t.Run("consumer is of type integration system, should proceed with next resolver", func(t *testing.T) {
 		// GIVEN
-		directive := scenario.NewDirective(nil, nil, nil, nil)
+		directive := scenario.NewDirective(nil, nil, nil, nil, nil)
 		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerType: consumer.IntegrationSystem})
 		dummyResolver := &dummyResolver{}
 		// WHEN
@@ -75,7 +76,7 @@ func TestHasScenario(t *testing.T) {
 
 	t.Run("could not extract tenant from context, should return error", func(t *testing.T) {
 		// GIVEN
-		directive := scenario.NewDirective(nil, nil, nil, nil)
+		directive := scenario.NewDirective(nil, nil, nil, nil, nil)
 		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerType: consumer.Runtime})
 		dummyResolver := &dummyResolver{}
 		// WHEN
@@ -101,7 +102,7 @@ func TestHasScenario(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := scenario.NewDirective(mockedTransactioner, lblRepo, nil, nil)
+		directive := scenario.NewDirective(mockedTransactioner, lblRepo, nil, nil, nil)
 		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerType: consumer.Runtime})
 		ctx = context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID})
 		rCtx := &graphql.ResolverContext{
@@ -138,7 +139,10 @@ func TestHasScenario(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := scenario.NewDirective(mockedTransactioner, nil, pkgRepo, nil)
+		shareRepo := &share_mock.ApplicationShareRepository{}
+		defer shareRepo.AssertExpectations(t)
+
+		directive := scenario.NewDirective(mockedTransactioner, nil, pkgRepo, nil, shareRepo)
 		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerType: consumer.Runtime})
 		ctx = context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID})
 		rCtx := &graphql.ResolverContext{
@@ -152,6 +156,7 @@ func TestHasScenario(t *testing.T) {
 
 		notFoundErr := apperrors.NewNotFoundErrorWithType(resource.Package)
 		pkgRepo.On("GetByID", ctxWithTx, tenantID, packageID).Return(nil, notFoundErr)
+		shareRepo.On("ListForPackage", ctxWithTx, tenantID, packageID).Return(nil, nil)
 		// WHEN
 		res, err := directive.HasScenario(ctx, nil, nil, scenario.GetApplicationIDByPackage, packageIDField)
 		// THEN
@@ -175,7 +180,10 @@ func TestHasScenario(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := scenario.NewDirective(mockedTransactioner, nil, nil, pkgAuthRepo)
+		shareRepo := &share_mock.ApplicationShareRepository{}
+		defer shareRepo.AssertExpectations(t)
+
+		directive := scenario.NewDirective(mockedTransactioner, nil, nil, pkgAuthRepo, shareRepo)
 		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerType: consumer.Runtime})
 		ctx = context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID})
 		rCtx := &graphql.ResolverContext{
@@ -189,6 +197,7 @@ func TestHasScenario(t *testing.T) {
 
 		notFoundErr := apperrors.NewNotFoundErrorWithType(resource.PackageInstanceAuth)
 		pkgAuthRepo.On("GetByID", ctxWithTx, tenantID, pkgAuthID).Return(nil, notFoundErr)
+		shareRepo.On("ListForPackageInstanceAuth", ctxWithTx, tenantID, pkgAuthID).Return(nil, nil)
 		// WHEN
 		res, err := directive.HasScenario(ctx, nil, nil, scenario.GetApplicationIDByPackageInstanceAuth, pkgAuthIDField)
 		// THEN
@@ -213,7 +222,7 @@ func TestHasScenario(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := scenario.NewDirective(mockedTransactioner, lblRepo, nil, nil)
+		directive := scenario.NewDirective(mockedTransactioner, lblRepo, nil, nil, nil)
 		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerID: runtimeID, ConsumerType: consumer.Runtime})
 		ctx = context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID})
 		rCtx := &graphql.ResolverContext{
@@ -253,7 +262,7 @@ func TestHasScenario(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := scenario.NewDirective(mockedTransactioner, lblRepo, nil, nil)
+		directive := scenario.NewDirective(mockedTransactioner, lblRepo, nil, nil, nil)
 		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerID: runtimeID, ConsumerType: consumer.Runtime})
 		ctx = context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID})
 		rCtx := &graphql.ResolverContext{
@@ -277,6 +286,86 @@ func TestHasScenario(t *testing.T) {
 		assert.Equal(t, res, nil)
 	})
 
+	t.Run("runtime context is in formation with application in application query", func(t *testing.T) {
+		// GIVEN
+		const (
+			idField          = "id"
+			tenantID         = "42"
+			runtimeContextID = "23"
+			applicationID    = "24"
+		)
+
+		lblRepo := &lbl_mock.LabelRepository{}
+		defer lblRepo.AssertExpectations(t)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		directive := scenario.NewDirective(mockedTransactioner, lblRepo, nil, nil, nil)
+		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerID: runtimeContextID, ConsumerType: consumer.RuntimeContext})
+		ctx = context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID})
+		rCtx := &graphql.ResolverContext{
+			Object:   "Application",
+			Field:    graphql.CollectedField{},
+			Args:     map[string]interface{}{idField: applicationID},
+			IsMethod: false,
+		}
+		ctx = graphql.WithResolverContext(ctx, rCtx)
+		ctxWithTx := persistence.SaveToContext(ctx, mockedTx)
+
+		mockedLabel := &model.Label{Value: []interface{}{"DEFAULT"}}
+		lblRepo.On("GetByKey", ctxWithTx, tenantID, model.ApplicationLabelableObject, applicationID, model.ScenariosKey).Return(mockedLabel, nil)
+		lblRepo.On("GetByKey", ctxWithTx, tenantID, model.RuntimeContextLabelableObject, runtimeContextID, model.ScenariosKey).Return(mockedLabel, nil)
+
+		dummyResolver := &dummyResolver{}
+		// WHEN
+		res, err := directive.HasScenario(ctx, nil, dummyResolver.SuccessResolve, scenario.GetApplicationID, idField)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, res, mockedNextOutput())
+	})
+
+	t.Run("runtime context is NOT in formation with application in application query", func(t *testing.T) {
+		// GIVEN
+		const (
+			idField          = "id"
+			tenantID         = "42"
+			runtimeContextID = "23"
+			applicationID    = "24"
+		)
+
+		lblRepo := &lbl_mock.LabelRepository{}
+		defer lblRepo.AssertExpectations(t)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		directive := scenario.NewDirective(mockedTransactioner, lblRepo, nil, nil, nil)
+		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerID: runtimeContextID, ConsumerType: consumer.RuntimeContext})
+		ctx = context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID})
+		rCtx := &graphql.ResolverContext{
+			Object:   "Application",
+			Field:    graphql.CollectedField{},
+			Args:     map[string]interface{}{idField: applicationID},
+			IsMethod: false,
+		}
+		ctx = graphql.WithResolverContext(ctx, rCtx)
+		ctxWithTx := persistence.SaveToContext(ctx, mockedTx)
+
+		mockedAppLabel := &model.Label{Value: []interface{}{"DEFAULT"}}
+		mockedRuntimeContextLabel := &model.Label{Value: []interface{}{"TEST"}}
+		lblRepo.On("GetByKey", ctxWithTx, tenantID, model.ApplicationLabelableObject, applicationID, model.ScenariosKey).Return(mockedAppLabel, nil)
+		lblRepo.On("GetByKey", ctxWithTx, tenantID, model.RuntimeContextLabelableObject, runtimeContextID, model.ScenariosKey).Return(mockedRuntimeContextLabel, nil)
+		// WHEN
+		res, err := directive.HasScenario(ctx, nil, nil, scenario.GetApplicationID, idField)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "requesting Runtime Context should be in same scenario")
+		assert.Equal(t, res, nil)
+	})
+
 	t.Run("runtime is in formation with owning application in request package instance auth flow ", func(t *testing.T) {
 		// GIVEN
 		const (
@@ -297,7 +386,7 @@ func TestHasScenario(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := scenario.NewDirective(mockedTransactioner, lblRepo, pkgRepo, nil)
+		directive := scenario.NewDirective(mockedTransactioner, lblRepo, pkgRepo, nil, nil)
 		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerID: runtimeID, ConsumerType: consumer.Runtime})
 		ctx = context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID})
 		rCtx := &graphql.ResolverContext{
@@ -343,7 +432,7 @@ func TestHasScenario(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := scenario.NewDirective(mockedTransactioner, lblRepo, pkgRepo, nil)
+		directive := scenario.NewDirective(mockedTransactioner, lblRepo, pkgRepo, nil, nil)
 		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerID: runtimeID, ConsumerType: consumer.Runtime})
 		ctx = context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID})
 		rCtx := &graphql.ResolverContext{
@@ -394,7 +483,7 @@ func TestHasScenario(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := scenario.NewDirective(mockedTransactioner, lblRepo, pkgRepo, pkgAuthRepo)
+		directive := scenario.NewDirective(mockedTransactioner, lblRepo, pkgRepo, pkgAuthRepo, nil)
 		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerID: runtimeID, ConsumerType: consumer.Runtime})
 		ctx = context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID})
 		rCtx := &graphql.ResolverContext{
@@ -447,7 +536,7 @@ func TestHasScenario(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := scenario.NewDirective(mockedTransactioner, lblRepo, pkgRepo, pkgAuthRepo)
+		directive := scenario.NewDirective(mockedTransactioner, lblRepo, pkgRepo, pkgAuthRepo, nil)
 		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerID: runtimeID, ConsumerType: consumer.Runtime})
 		ctx = context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID})
 		rCtx := &graphql.ResolverContext{
@@ -477,6 +566,162 @@ func TestHasScenario(t *testing.T) {
 		assert.Equal(t, res, nil)
 	})
 
+	t.Run("runtime is in formation with shared application in request package instance auth flow", func(t *testing.T) {
+		// GIVEN
+		const (
+			packageIDField = "packageID"
+			tenantID       = "42"
+			ownerTenantID  = "43"
+			packageID      = "24"
+			runtimeID      = "23"
+			applicationID  = "22"
+		)
+
+		pkgRepo := &pkg_mock.PackageRepository{}
+		defer pkgRepo.AssertExpectations(t)
+
+		shareRepo := &share_mock.ApplicationShareRepository{}
+		defer shareRepo.AssertExpectations(t)
+
+		lblRepo := &lbl_mock.LabelRepository{}
+		defer lblRepo.AssertExpectations(t)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		directive := scenario.NewDirective(mockedTransactioner, lblRepo, pkgRepo, nil, shareRepo)
+		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerID: runtimeID, ConsumerType: consumer.Runtime})
+		ctx = context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID})
+		rCtx := &graphql.ResolverContext{
+			Object:   "PackageInstanceAuth",
+			Field:    graphql.CollectedField{},
+			Args:     map[string]interface{}{packageIDField: packageID},
+			IsMethod: false,
+		}
+		ctx = graphql.WithResolverContext(ctx, rCtx)
+		ctxWithTx := persistence.SaveToContext(ctx, mockedTx)
+
+		pkgRepo.On("GetByID", ctxWithTx, tenantID, packageID).Return(nil, apperrors.NewNotFoundErrorWithType(resource.Package))
+
+		mockedShares := []*model.ApplicationShare{{Tenant: ownerTenantID, ApplicationID: applicationID, TargetTenant: tenantID}}
+		shareRepo.On("ListForPackage", ctxWithTx, tenantID, packageID).Return(mockedShares, nil)
+
+		mockedRuntimeLabel := &model.Label{Value: []interface{}{"TEST"}}
+		lblRepo.On("GetByKey", ctxWithTx, tenantID, model.RuntimeLabelableObject, runtimeID, model.ScenariosKey).Return(mockedRuntimeLabel, nil)
+
+		dummyResolver := &dummyResolver{}
+		// WHEN
+		res, err := directive.HasScenario(ctx, nil, dummyResolver.SuccessResolve, scenario.GetApplicationIDByPackage, packageIDField)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, res, mockedNextOutput())
+	})
+
+	t.Run("runtime is in formation with application shared in scenario in delete package instance auth flow", func(t *testing.T) {
+		// GIVEN
+		const (
+			pkgAuthIDField = "authID"
+			tenantID       = "42"
+			ownerTenantID  = "43"
+			pkgAuthID      = "24"
+			runtimeID      = "23"
+			applicationID  = "22"
+		)
+
+		pkgAuthRepo := &pkg_auth_mock.Repository{}
+		defer pkgAuthRepo.AssertExpectations(t)
+
+		shareRepo := &share_mock.ApplicationShareRepository{}
+		defer shareRepo.AssertExpectations(t)
+
+		lblRepo := &lbl_mock.LabelRepository{}
+		defer lblRepo.AssertExpectations(t)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		directive := scenario.NewDirective(mockedTransactioner, lblRepo, nil, pkgAuthRepo, shareRepo)
+		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerID: runtimeID, ConsumerType: consumer.Runtime})
+		ctx = context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID})
+		rCtx := &graphql.ResolverContext{
+			Object:   "PackageInstanceAuth",
+			Field:    graphql.CollectedField{},
+			Args:     map[string]interface{}{pkgAuthIDField: pkgAuthID},
+			IsMethod: false,
+		}
+		ctx = graphql.WithResolverContext(ctx, rCtx)
+		ctxWithTx := persistence.SaveToContext(ctx, mockedTx)
+
+		pkgAuthRepo.On("GetByID", ctxWithTx, tenantID, pkgAuthID).Return(nil, apperrors.NewNotFoundErrorWithType(resource.PackageInstanceAuth))
+
+		scenarioName := "TEST"
+		mockedShares := []*model.ApplicationShare{{Tenant: ownerTenantID, ApplicationID: applicationID, TargetTenant: tenantID, Scenario: &scenarioName}}
+		shareRepo.On("ListForPackageInstanceAuth", ctxWithTx, tenantID, pkgAuthID).Return(mockedShares, nil)
+
+		mockedRuntimeLabel := &model.Label{Value: []interface{}{"DEFAULT", "TEST"}}
+		lblRepo.On("GetByKey", ctxWithTx, tenantID, model.RuntimeLabelableObject, runtimeID, model.ScenariosKey).Return(mockedRuntimeLabel, nil)
+
+		dummyResolver := &dummyResolver{}
+		// WHEN
+		res, err := directive.HasScenario(ctx, nil, dummyResolver.SuccessResolve, scenario.GetApplicationIDByPackageInstanceAuth, pkgAuthIDField)
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, res, mockedNextOutput())
+	})
+
+	t.Run("runtime is NOT in formation with application shared in scenario in request package instance auth flow", func(t *testing.T) {
+		// GIVEN
+		const (
+			packageIDField = "packageID"
+			tenantID       = "42"
+			ownerTenantID  = "43"
+			packageID      = "24"
+			runtimeID      = "23"
+			applicationID  = "22"
+		)
+
+		pkgRepo := &pkg_mock.PackageRepository{}
+		defer pkgRepo.AssertExpectations(t)
+
+		shareRepo := &share_mock.ApplicationShareRepository{}
+		defer shareRepo.AssertExpectations(t)
+
+		lblRepo := &lbl_mock.LabelRepository{}
+		defer lblRepo.AssertExpectations(t)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		directive := scenario.NewDirective(mockedTransactioner, lblRepo, pkgRepo, nil, shareRepo)
+		ctx := context.WithValue(context.TODO(), consumer.ConsumerKey, consumer.Consumer{ConsumerID: runtimeID, ConsumerType: consumer.Runtime})
+		ctx = context.WithValue(ctx, tenant.TenantContextKey, tenant.TenantCtx{InternalID: tenantID})
+		rCtx := &graphql.ResolverContext{
+			Object:   "PackageInstanceAuth",
+			Field:    graphql.CollectedField{},
+			Args:     map[string]interface{}{packageIDField: packageID},
+			IsMethod: false,
+		}
+		ctx = graphql.WithResolverContext(ctx, rCtx)
+		ctxWithTx := persistence.SaveToContext(ctx, mockedTx)
+
+		pkgRepo.On("GetByID", ctxWithTx, tenantID, packageID).Return(nil, apperrors.NewNotFoundErrorWithType(resource.Package))
+
+		scenarioName := "HR"
+		mockedShares := []*model.ApplicationShare{{Tenant: ownerTenantID, ApplicationID: applicationID, TargetTenant: tenantID, Scenario: &scenarioName}}
+		shareRepo.On("ListForPackage", ctxWithTx, tenantID, packageID).Return(mockedShares, nil)
+
+		mockedRuntimeLabel := &model.Label{Value: []interface{}{"TEST"}}
+		lblRepo.On("GetByKey", ctxWithTx, tenantID, model.RuntimeLabelableObject, runtimeID, model.ScenariosKey).Return(mockedRuntimeLabel, nil)
+		// WHEN
+		res, err := directive.HasScenario(ctx, nil, nil, scenario.GetApplicationIDByPackage, packageIDField)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "requesting Runtime should be in same scenario as the requested application resource")
+		assert.Equal(t, res, nil)
+	})
 }
 
 type dummyResolver struct {
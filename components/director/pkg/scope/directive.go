@@ -2,6 +2,7 @@ package scope
 
 import (
 	"context"
+	"strings"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
@@ -9,6 +10,9 @@ import (
 	"github.com/pkg/errors"
 )
 
+// AlternativeScopesSeparator separates the scopes of a required scope entry which are accepted interchangeably
+const AlternativeScopesSeparator = "|"
+
 //go:generate mockery -name=ScopesGetter -output=automock -outpkg=automock -case=underscore
 type ScopesGetter interface {
 	GetRequiredScopes(scopesDefinition string) ([]string, error)
@@ -47,10 +51,18 @@ func (d *directive) matches(actual []string, required []string) bool {
 		actMap[a] = struct{}{}
 	}
 	for _, r := range required {
-		_, ex := actMap[r]
-		if !ex {
+		if !matchesAny(actMap, strings.Split(r, AlternativeScopesSeparator)) {
 			return false
 		}
 	}
 	return true
 }
+
+func matchesAny(actual map[string]interface{}, alternatives []string) bool {
+	for _, a := range alternatives {
+		if _, ex := actual[a]; ex {
+			return true
+		}
+	}
+	return false
+}
@@ -30,6 +30,37 @@ func TestHasScope(t *testing.T) {
 		assert.True(t, next.called)
 	})
 
+	t.Run("has one of alternative required scopes", func(t *testing.T) {
+		// GIVEN
+		mockRequiredScopesGetter := &automock.ScopesGetter{}
+		defer mockRequiredScopesGetter.AssertExpectations(t)
+		sut := scope.NewDirective(mockRequiredScopesGetter)
+		mockRequiredScopesGetter.On("GetRequiredScopes", fixScopesDefinition()).Return([]string{readScope, writeScope + scope.AlternativeScopesSeparator + deleteScope}, nil).Once()
+
+		next := dummyResolver{}
+		ctx := scope.SaveToContext(context.TODO(), []string{readScope, deleteScope})
+		// WHEN
+		act, err := sut.VerifyScopes(ctx, nil, next.SuccessResolve, fixScopesDefinition())
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, fixNextOutput(), act)
+		assert.True(t, next.called)
+	})
+
+	t.Run("has none of alternative required scopes", func(t *testing.T) {
+		// GIVEN
+		mockRequiredScopesGetter := &automock.ScopesGetter{}
+		defer mockRequiredScopesGetter.AssertExpectations(t)
+		sut := scope.NewDirective(mockRequiredScopesGetter)
+		mockRequiredScopesGetter.On("GetRequiredScopes", fixScopesDefinition()).Return([]string{writeScope + scope.AlternativeScopesSeparator + deleteScope}, nil).Once()
+		ctx := scope.SaveToContext(context.TODO(), []string{readScope})
+		// WHEN
+		_, err := sut.VerifyScopes(ctx, nil, nil, fixScopesDefinition())
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "required=write|delete")
+	})
+
 	t.Run("has insufficient scopes", func(t *testing.T) {
 		// GIVEN
 		mockRequiredScopesGetter := &automock.ScopesGetter{}
@@ -0,0 +1,13 @@
+package tracing
+
+const (
+	NoneExporter   = "none"
+	StdoutExporter = "stdout"
+	OTLPExporter   = "otlp"
+)
+
+type Config struct {
+	Exporter      string  `envconfig:"default=none"`
+	OTLPEndpoint  string  `envconfig:"default=localhost:55680"`
+	SamplingRatio float64 `envconfig:"default=1"`
+}
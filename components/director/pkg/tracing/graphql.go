@@ -0,0 +1,42 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ResolverMiddleware starts a span for every GraphQL field resolved by a resolver method.
+// Fields which are simply read from the parent object are skipped.
+func ResolverMiddleware(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	resolverCtx := graphql.GetResolverContext(ctx)
+	if resolverCtx == nil || !resolverCtx.IsMethod {
+		return next(ctx)
+	}
+
+	ctx, span := StartSpan(ctx, fmt.Sprintf("GraphQL %s.%s", resolverCtx.Object, resolverCtx.Field.Name),
+		trace.WithAttributes(label.String("graphql.path", formatPath(resolverCtx.Path()))))
+	defer span.End()
+
+	res, err := next(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return res, err
+}
+
+func formatPath(path []interface{}) string {
+	elements := make([]string, 0, len(path))
+	for _, element := range path {
+		elements = append(elements, fmt.Sprint(element))
+	}
+
+	return strings.Join(elements, ".")
+}
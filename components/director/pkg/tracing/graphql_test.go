@@ -0,0 +1,85 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/ast"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/label"
+)
+
+func TestResolverMiddleware(t *testing.T) {
+	testErr := errors.New("test error")
+
+	t.Run("starts span for resolver method", func(t *testing.T) {
+		// GIVEN
+		recorder := fixSpanRecorder()
+		ctx := fixResolverContext(true)
+
+		// WHEN
+		res, err := tracing.ResolverMiddleware(ctx, func(ctx context.Context) (interface{}, error) {
+			return "result", nil
+		})
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, "result", res)
+		spans := recorder.Completed()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "GraphQL Application.packages", spans[0].Name())
+		assert.Equal(t, label.StringValue("applications.packages"), spans[0].Attributes()["graphql.path"])
+		assert.Equal(t, codes.Unset, spans[0].StatusCode())
+	})
+
+	t.Run("records resolver error", func(t *testing.T) {
+		// GIVEN
+		recorder := fixSpanRecorder()
+		ctx := fixResolverContext(true)
+
+		// WHEN
+		_, err := tracing.ResolverMiddleware(ctx, func(ctx context.Context) (interface{}, error) {
+			return nil, testErr
+		})
+
+		// THEN
+		require.Equal(t, testErr, err)
+		spans := recorder.Completed()
+		require.Len(t, spans, 1)
+		assert.Equal(t, codes.Error, spans[0].StatusCode())
+		assert.Equal(t, testErr.Error(), spans[0].StatusMessage())
+	})
+
+	t.Run("skips fields which are not resolved by a method", func(t *testing.T) {
+		// GIVEN
+		recorder := fixSpanRecorder()
+		ctx := fixResolverContext(false)
+
+		// WHEN
+		_, err := tracing.ResolverMiddleware(ctx, func(ctx context.Context) (interface{}, error) {
+			return "result", nil
+		})
+
+		// THEN
+		require.NoError(t, err)
+		assert.Empty(t, recorder.Started())
+	})
+}
+
+func fixResolverContext(isMethod bool) context.Context {
+	ctx := graphql.WithResolverContext(context.TODO(), &graphql.ResolverContext{
+		Object: "Query",
+		Field:  graphql.CollectedField{Field: &ast.Field{Name: "applications", Alias: "applications"}},
+	})
+
+	return graphql.WithResolverContext(ctx, &graphql.ResolverContext{
+		Object:   "Application",
+		Field:    graphql.CollectedField{Field: &ast.Field{Name: "packages", Alias: "packages"}},
+		IsMethod: isMethod,
+	})
+}
@@ -0,0 +1,40 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Handler starts a server span for every incoming request, continuing the trace propagated by the caller in the W3C Trace Context headers
+func Handler(serviceName string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), r.Header)
+
+			ctx, span := StartSpan(ctx, fmt.Sprintf("HTTP %s %s", r.Method, r.URL.Path),
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(semconv.HTTPServerAttributesFromHTTPRequest(serviceName, r.URL.Path, r)...))
+			defer span.End()
+
+			writer := &statusResponseWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(writer, r.WithContext(ctx))
+
+			span.SetAttributes(semconv.HTTPAttributesFromHTTPStatusCode(writer.status)...)
+			span.SetStatus(semconv.SpanStatusFromHTTPStatusCode(writer.status))
+		})
+	}
+}
+
+type statusResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusResponseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
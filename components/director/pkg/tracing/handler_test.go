@@ -0,0 +1,65 @@
+package tracing_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestHandler(t *testing.T) {
+	const (
+		traceID  = "4bf92f3577b34da6a3ce929d0e0e4736"
+		parentID = "00f067aa0ba902b7"
+	)
+
+	t.Run("continues the propagated trace and records the response status", func(t *testing.T) {
+		// GIVEN
+		recorder := fixSpanRecorder()
+		var handlerSpanCtx trace.SpanContext
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handlerSpanCtx = trace.SpanContextFromContext(r.Context())
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		req := httptest.NewRequest(http.MethodGet, "/graphql", nil)
+		req.Header.Set("traceparent", "00-"+traceID+"-"+parentID+"-01")
+		w := httptest.NewRecorder()
+
+		// WHEN
+		tracing.Handler("test")(next).ServeHTTP(w, req)
+
+		// THEN
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		spans := recorder.Completed()
+		require.Len(t, spans, 1)
+		span := spans[0]
+		assert.Equal(t, "HTTP GET /graphql", span.Name())
+		assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+		assert.Equal(t, traceID, span.SpanContext().TraceID.String())
+		assert.Equal(t, parentID, span.ParentSpanID().String())
+		assert.Equal(t, codes.Error, span.StatusCode())
+		assert.Equal(t, span.SpanContext(), handlerSpanCtx)
+	})
+
+	t.Run("starts a new trace when none is propagated", func(t *testing.T) {
+		// GIVEN
+		recorder := fixSpanRecorder()
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+		// WHEN
+		tracing.Handler("test")(next).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/graphql", nil))
+
+		// THEN
+		spans := recorder.Completed()
+		require.Len(t, spans, 1)
+		assert.True(t, spans[0].SpanContext().TraceID.IsValid())
+		assert.False(t, spans[0].ParentSpanID().IsValid())
+		assert.Equal(t, codes.Unset, spans[0].StatusCode())
+	})
+}
@@ -0,0 +1,68 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/stdout"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/kyma-incubator/compass/components/director/pkg/tracing"
+
+// Configure registers the W3C Trace Context propagator and a tracer provider exporting spans with the configured exporter.
+// The returned function flushes the remaining spans and has to be called before the application exits.
+func Configure(ctx context.Context, serviceName string, cfg Config) (func(ctx context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	exporter, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if exporter == nil {
+		return func(ctx context.Context) error { return nil }, nil
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithConfig(sdktrace.Config{DefaultSampler: sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SamplingRatio))}),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.ServiceNameKey.String(serviceName))),
+		sdktrace.WithBatcher(exporter),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// StartSpan starts a new span as a child of the span stored in the context
+func StartSpan(ctx context.Context, spanName string, opts ...oteltrace.SpanOption) (context.Context, oteltrace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, spanName, opts...)
+}
+
+func newExporter(ctx context.Context, cfg Config) (trace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case NoneExporter:
+		return nil, nil
+	case StdoutExporter:
+		exporter, err := stdout.NewExporter(stdout.WithPrettyPrint(), stdout.WithoutMetricExport())
+		if err != nil {
+			return nil, errors.Wrap(err, "while creating stdout exporter")
+		}
+		return exporter, nil
+	case OTLPExporter:
+		exporter, err := otlp.NewExporter(ctx, otlp.WithInsecure(), otlp.WithAddress(cfg.OTLPEndpoint))
+		if err != nil {
+			return nil, errors.Wrap(err, "while creating OTLP exporter")
+		}
+		return exporter, nil
+	default:
+		return nil, errors.Errorf("unknown tracing exporter %q", cfg.Exporter)
+	}
+}
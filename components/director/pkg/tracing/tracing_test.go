@@ -0,0 +1,49 @@
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestConfigure(t *testing.T) {
+	t.Run("returns no-op shutdown for none exporter", func(t *testing.T) {
+		// WHEN
+		shutdown, err := tracing.Configure(context.TODO(), "test", tracing.Config{Exporter: tracing.NoneExporter, SamplingRatio: 1})
+
+		// THEN
+		require.NoError(t, err)
+		assert.NoError(t, shutdown(context.TODO()))
+	})
+
+	t.Run("configures stdout exporter", func(t *testing.T) {
+		// WHEN
+		shutdown, err := tracing.Configure(context.TODO(), "test", tracing.Config{Exporter: tracing.StdoutExporter, SamplingRatio: 1})
+
+		// THEN
+		require.NoError(t, err)
+		assert.NoError(t, shutdown(context.TODO()))
+	})
+
+	t.Run("returns error for unknown exporter", func(t *testing.T) {
+		// WHEN
+		_, err := tracing.Configure(context.TODO(), "test", tracing.Config{Exporter: "zipkin"})
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unknown tracing exporter "zipkin"`)
+	})
+}
+
+func fixSpanRecorder() *oteltest.StandardSpanRecorder {
+	recorder := &oteltest.StandardSpanRecorder{}
+	otel.SetTracerProvider(oteltest.NewTracerProvider(oteltest.WithSpanRecorder(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return recorder
+}
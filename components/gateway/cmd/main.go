@@ -16,6 +16,7 @@ import (
 	timeouthandler "github.com/kyma-incubator/compass/components/director/pkg/handler"
 	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/kyma-incubator/compass/components/director/pkg/signal"
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
 	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
 	timeservices "github.com/kyma-incubator/compass/components/gateway/internal/time"
 	"github.com/kyma-incubator/compass/components/gateway/internal/uuid"
@@ -29,6 +30,8 @@ import (
 	"golang.org/x/oauth2/clientcredentials"
 )
 
+const serviceName = "gateway"
+
 type config struct {
 	Address string `envconfig:"default=127.0.0.1:3000"`
 
@@ -38,6 +41,8 @@ type config struct {
 	ConnectorOrigin string `envconfig:"default=http://127.0.0.1:3002"`
 	MetricsAddress  string `envconfig:"default=127.0.0.1:3003"`
 	AuditlogEnabled bool   `envconfig:"default=false"`
+
+	Tracing tracing.Config
 }
 
 func main() {
@@ -51,8 +56,16 @@ func main() {
 	err := envconfig.InitWithPrefix(&cfg, "APP")
 	exitOnError(err, "Error while loading app config")
 
+	shutdownTracing, err := tracing.Configure(ctx, serviceName, cfg.Tracing)
+	exitOnError(err, "Error while configuring tracing")
+
+	defer func() {
+		err := shutdownTracing(context.Background())
+		exitOnError(err, "Error while shutting down tracing")
+	}()
+
 	router := mux.NewRouter()
-	router.Use(correlation.AttachCorrelationIDToContext())
+	router.Use(tracing.Handler(serviceName), correlation.AttachCorrelationIDToContext())
 
 	metricsCollector := metrics.NewAuditlogMetricCollector()
 	prometheus.MustRegister(metricsCollector)
@@ -70,7 +83,7 @@ func main() {
 		auditlogSvc = &auditlog.NoOpService{}
 	}
 
-	correlationTr := httputil.NewCorrelationIDTransport(http.DefaultTransport)
+	correlationTr := httputil.NewCorrelationIDTransport(httputil.NewTracingTransport(http.DefaultTransport))
 	tr := proxy.NewTransport(auditlogSink, auditlogSvc, correlationTr)
 
 	err = proxyRequestsForComponent(router, "/connector", cfg.ConnectorOrigin, tr)
@@ -179,7 +192,7 @@ func initAuditLogs(done chan bool, collector *metrics.AuditlogCollector) (proxy.
 	var httpClient auditlog.HttpClient
 	var msgFactory auditlog.AuditlogMessageFactory
 
-	tr := httputil.NewCorrelationIDTransport(http.DefaultTransport)
+	tr := httputil.NewCorrelationIDTransport(httputil.NewTracingTransport(http.DefaultTransport))
 
 	switch cfg.AuthMode {
 	case auditlog.Basic:
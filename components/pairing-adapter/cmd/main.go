@@ -9,38 +9,29 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
 	"github.com/kyma-incubator/compass/components/director/pkg/handler"
-	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
 	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/adapter"
 	"github.com/pkg/errors"
 	"github.com/vrischmann/envconfig"
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/clientcredentials"
 )
 
+const serviceName = "pairing-adapter"
+
 func main() {
 	conf := adapter.Configuration{}
 	err := envconfig.Init(&conf)
 	exitOnError(err, "while reading Pairing Adapter Configuration")
 
-	authStyle, err := getAuthStyle(conf.OAuth.AuthStyle)
-	exitOnError(err, "while getting Auth Style")
-
-	cc := clientcredentials.Config{
-		TokenURL:     conf.OAuth.URL,
-		ClientID:     conf.OAuth.ClientID,
-		ClientSecret: conf.OAuth.ClientSecret,
-		AuthStyle:    authStyle,
-	}
-
-	baseClient := &http.Client{
-		Transport: httputil.NewCorrelationIDTransport(http.DefaultTransport),
-	}
-	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, baseClient)
+	shutdownTracing, err := tracing.Configure(context.Background(), serviceName, conf.Tracing)
+	exitOnError(err, "while configuring tracing")
 
-	client := cc.Client(ctx)
-	client.Timeout = conf.ClientTimeout
+	defer func() {
+		err := shutdownTracing(context.Background())
+		exitOnError(err, "while shutting down tracing")
+	}()
 
-	cli := adapter.NewClient(client, conf.Mapping)
+	cli, err := getClient(conf)
+	exitOnError(err, "while creating client for External Token Service")
 
 	h := adapter.NewHandler(cli)
 	handlerWithTimeout, err := handler.WithTimeout(h, conf.ServerTimeout)
@@ -48,7 +39,7 @@ func main() {
 
 	router := mux.NewRouter()
 
-	router.Use(correlation.AttachCorrelationIDToContext())
+	router.Use(tracing.Handler(serviceName), correlation.AttachCorrelationIDToContext())
 	router.Handle("/adapter", handlerWithTimeout)
 	router.HandleFunc("/healthz", func(writer http.ResponseWriter, request *http.Request) {
 		writer.WriteHeader(http.StatusOK)
@@ -70,15 +61,22 @@ func exitOnError(err error, context string) {
 	}
 }
 
-func getAuthStyle(style adapter.AuthStyle) (oauth2.AuthStyle, error) {
-	switch style {
-	case adapter.AuthStyleInParams:
-		return oauth2.AuthStyleInParams, nil
-	case adapter.AuthStyleInHeader:
-		return oauth2.AuthStyleInHeader, nil
-	case adapter.AuthStyleAutoDetect:
-		return oauth2.AuthStyleAutoDetect, nil
-	default:
-		return -1, errors.New("unknown Auth style")
+func getClient(conf adapter.Configuration) (adapter.Client, error) {
+	if conf.TargetsConfigPath == "" {
+		log.Println("No targets configuration file provided, using single mapping from environment")
+
+		client, err := adapter.NewHTTPClient(adapter.Auth{Type: adapter.AuthTypeOAuth, OAuth: &conf.OAuth}, conf.ClientTimeout)
+		if err != nil {
+			return nil, err
+		}
+		return adapter.NewClient(client, conf.Mapping), nil
 	}
+
+	router, err := adapter.NewRouter(conf.TargetsConfigPath, conf.ClientTimeout)
+	if err != nil {
+		return nil, err
+	}
+	go router.WatchConfig(context.Background(), conf.TargetsReloadInterval)
+
+	return router, nil
 }
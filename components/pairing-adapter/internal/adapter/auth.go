@@ -0,0 +1,112 @@
+package adapter
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// NewHTTPClient returns an HTTP client which authenticates calls to the External Token Service as configured
+func NewHTTPClient(auth Auth, timeout time.Duration) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if auth.Type == AuthTypeMTLS {
+		tlsConfig, err := getTLSConfig(*auth.MTLS)
+		if err != nil {
+			return nil, errors.Wrap(err, "while preparing TLS configuration")
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	baseClient := &http.Client{
+		Transport: httputil.NewCorrelationIDTransport(httputil.NewTracingTransport(transport)),
+		Timeout:   timeout,
+	}
+
+	switch auth.Type {
+	case AuthTypeBasic:
+		baseClient.Transport = &basicAuthTransport{
+			username:  auth.Basic.Username,
+			password:  auth.Basic.Password,
+			transport: baseClient.Transport,
+		}
+	case AuthTypeOAuth:
+		authStyle, err := getAuthStyle(auth.OAuth.AuthStyle)
+		if err != nil {
+			return nil, errors.Wrap(err, "while getting Auth Style")
+		}
+
+		cc := clientcredentials.Config{
+			TokenURL:     auth.OAuth.URL,
+			ClientID:     auth.OAuth.ClientID,
+			ClientSecret: auth.OAuth.ClientSecret,
+			AuthStyle:    authStyle,
+		}
+
+		ctx := context.WithValue(context.Background(), oauth2.HTTPClient, baseClient)
+		client := cc.Client(ctx)
+		client.Timeout = timeout
+		return client, nil
+	}
+
+	return baseClient, nil
+}
+
+type basicAuthTransport struct {
+	username  string
+	password  string
+	transport http.RoundTripper
+}
+
+func (t *basicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	clone := req.Clone(req.Context())
+	clone.SetBasicAuth(t.username, t.password)
+	return t.transport.RoundTrip(clone)
+}
+
+func getTLSConfig(cfg MTLSAuth) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading client certificate")
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+	}
+
+	if cfg.CAFile != "" {
+		caCert, err := ioutil.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "while reading CA certificate")
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("CA certificate file does not contain valid PEM certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+func getAuthStyle(style AuthStyle) (oauth2.AuthStyle, error) {
+	switch style {
+	case AuthStyleInParams:
+		return oauth2.AuthStyleInParams, nil
+	case AuthStyleInHeader:
+		return oauth2.AuthStyleInHeader, nil
+	case "", AuthStyleAutoDetect:
+		return oauth2.AuthStyleAutoDetect, nil
+	default:
+		return -1, errors.New("unknown Auth style")
+	}
+}
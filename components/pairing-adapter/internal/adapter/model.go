@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/tracing"
 )
 
 const (
@@ -15,25 +16,28 @@ const (
 type AuthStyle string
 
 type Configuration struct {
-	Mapping       Mapping
-	OAuth         OAuth
-	Port          string        `envconfig:"default=8080"`
-	ClientTimeout time.Duration `envconfig:"default=30s"`
-	ServerTimeout time.Duration `envconfig:"default=30s"`
+	Mapping               Mapping       `envconfig:"optional"`
+	OAuth                 OAuth         `envconfig:"optional"`
+	TargetsConfigPath     string        `envconfig:"optional"`
+	TargetsReloadInterval time.Duration `envconfig:"default=30s"`
+	Port                  string        `envconfig:"default=8080"`
+	ClientTimeout         time.Duration `envconfig:"default=30s"`
+	ServerTimeout         time.Duration `envconfig:"default=30s"`
+	Tracing               tracing.Config
 }
 
 type Mapping struct {
-	TemplateExternalURL       string
-	TemplateHeaders           string
-	TemplateJSONBody          string
-	TemplateTokenFromResponse string
+	TemplateExternalURL       string `json:"templateExternalURL"`
+	TemplateHeaders           string `json:"templateHeaders"`
+	TemplateJSONBody          string `json:"templateJSONBody"`
+	TemplateTokenFromResponse string `json:"templateTokenFromResponse"`
 }
 
 type OAuth struct {
-	URL          string
-	ClientID     string
-	ClientSecret string
-	AuthStyle    AuthStyle `envconfig:"default=AuthDetect"`
+	URL          string    `json:"url"`
+	ClientID     string    `json:"clientID"`
+	ClientSecret string    `json:"clientSecret"`
+	AuthStyle    AuthStyle `json:"authStyle" envconfig:"default=AuthDetect"`
 }
 
 // swagger:response externalToken
@@ -51,6 +55,8 @@ type RequestData struct {
 	Tenant string
 	// in: body
 	ClientUser string
+	// in: body
+	Labels map[string]interface{}
 }
 
 type ResponseData struct {
@@ -0,0 +1,168 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Router forwards token requests to the External Token Service configured for the requesting Application.
+// The targets configuration is read from a file and can be reloaded at runtime.
+type Router struct {
+	configPath    string
+	clientTimeout time.Duration
+
+	mu           sync.RWMutex
+	targets      *targetSet
+	lastModified time.Time
+}
+
+type targetSet struct {
+	clients             map[string]Client
+	intSystemTargets    map[string]string
+	applicationLabelKey string
+	defaultTarget       string
+}
+
+func NewRouter(configPath string, clientTimeout time.Duration) (*Router, error) {
+	r := &Router{
+		configPath:    configPath,
+		clientTimeout: clientTimeout,
+	}
+
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *Router) Do(ctx context.Context, reqData RequestData) (*ExternalToken, error) {
+	r.mu.RLock()
+	targets := r.targets
+	r.mu.RUnlock()
+
+	name, err := targets.targetFor(reqData)
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Infof("Requesting token for Application with ID %s from target [%s]", reqData.Application.ID, name)
+	return targets.clients[name].Do(ctx, reqData)
+}
+
+// Reload reads the targets configuration file and replaces the current targets.
+// When the configuration is invalid, the current targets are kept.
+func (r *Router) Reload() error {
+	info, err := os.Stat(r.configPath)
+	if err != nil {
+		return errors.Wrapf(err, "while reading targets configuration file [%s]", r.configPath)
+	}
+
+	cfg, err := LoadTargetsConfig(r.configPath)
+	if err != nil {
+		return err
+	}
+
+	targets, err := newTargetSet(cfg, r.clientTimeout)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.targets = targets
+	r.lastModified = info.ModTime()
+
+	return nil
+}
+
+// WatchConfig reloads the targets configuration whenever the file changes, until the context is cancelled
+func (r *Router) WatchConfig(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logrus.Info("Stopping targets configuration watcher")
+			return
+		case <-ticker.C:
+			info, err := os.Stat(r.configPath)
+			if err != nil {
+				logrus.Errorf("Got error on checking targets configuration file: %v", err)
+				continue
+			}
+
+			r.mu.RLock()
+			changed := !info.ModTime().Equal(r.lastModified)
+			r.mu.RUnlock()
+			if !changed {
+				continue
+			}
+
+			if err := r.Reload(); err != nil {
+				logrus.Errorf("Got error on reloading targets configuration, keeping the previous one: %v", err)
+				continue
+			}
+			logrus.Info("Successfully reloaded targets configuration")
+		}
+	}
+}
+
+func newTargetSet(cfg TargetsConfig, clientTimeout time.Duration) (*targetSet, error) {
+	targets := &targetSet{
+		clients:             make(map[string]Client, len(cfg.Targets)),
+		intSystemTargets:    make(map[string]string),
+		applicationLabelKey: cfg.ApplicationLabelKey,
+		defaultTarget:       cfg.DefaultTarget,
+	}
+
+	for _, target := range cfg.Targets {
+		httpClient, err := NewHTTPClient(target.Auth, clientTimeout)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while creating HTTP client for target [%s]", target.Name)
+		}
+		targets.clients[target.Name] = NewClient(httpClient, target.Mapping)
+
+		for _, intSystemID := range target.IntegrationSystemIDs {
+			targets.intSystemTargets[intSystemID] = target.Name
+		}
+	}
+
+	return targets, nil
+}
+
+// targetFor prefers the target assigned to the Integration System of the Application by the operator,
+// so that the Application label, which tenant users can modify, cannot redirect managed Applications.
+func (s *targetSet) targetFor(reqData RequestData) (string, error) {
+	if reqData.Application.IntegrationSystemID != nil {
+		if name, ok := s.intSystemTargets[*reqData.Application.IntegrationSystemID]; ok {
+			return name, nil
+		}
+	}
+
+	if s.applicationLabelKey != "" {
+		if value, ok := reqData.Labels[s.applicationLabelKey]; ok {
+			name, ok := value.(string)
+			if !ok {
+				return "", fmt.Errorf("label [%s] of Application with ID %s is not a string", s.applicationLabelKey, reqData.Application.ID)
+			}
+			if _, ok := s.clients[name]; !ok {
+				return "", fmt.Errorf("target [%s] from label [%s] of Application with ID %s is not defined", name, s.applicationLabelKey, reqData.Application.ID)
+			}
+			return name, nil
+		}
+	}
+
+	if s.defaultTarget != "" {
+		return s.defaultTarget, nil
+	}
+
+	return "", fmt.Errorf("no target defined for Application with ID %s", reqData.Application.ID)
+}
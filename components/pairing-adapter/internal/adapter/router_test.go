@@ -0,0 +1,236 @@
+package adapter_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/adapter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRouter(t *testing.T) {
+	intSystemID := "int-system-id"
+
+	firstSrv := httptest.NewServer(tokenHandler("first-token"))
+	defer firstSrv.Close()
+	secondSrv := httptest.NewServer(tokenHandler("second-token"))
+	defer secondSrv.Close()
+
+	cfg := adapter.TargetsConfig{
+		ApplicationLabelKey: "pairingTarget",
+		DefaultTarget:       "first",
+		Targets: []adapter.Target{
+			fixTarget("first", firstSrv.URL),
+			fixTarget("second", secondSrv.URL, intSystemID),
+		},
+	}
+
+	t.Run("uses default target", func(t *testing.T) {
+		// GIVEN
+		router := newRouter(t, cfg)
+		// WHEN
+		actualToken, err := router.Do(context.TODO(), adapter.RequestData{})
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, "first-token", actualToken.Token)
+	})
+
+	t.Run("uses target assigned to integration system", func(t *testing.T) {
+		// GIVEN
+		router := newRouter(t, cfg)
+		// WHEN
+		actualToken, err := router.Do(context.TODO(), adapter.RequestData{
+			Application: graphql.Application{IntegrationSystemID: &intSystemID},
+		})
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, "second-token", actualToken.Token)
+	})
+
+	t.Run("uses target from application label", func(t *testing.T) {
+		// GIVEN
+		router := newRouter(t, cfg)
+		// WHEN
+		actualToken, err := router.Do(context.TODO(), adapter.RequestData{
+			Labels: map[string]interface{}{"pairingTarget": "second"},
+		})
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, "second-token", actualToken.Token)
+	})
+
+	t.Run("uses target assigned to integration system before application label", func(t *testing.T) {
+		// GIVEN
+		router := newRouter(t, cfg)
+		// WHEN
+		actualToken, err := router.Do(context.TODO(), adapter.RequestData{
+			Application: graphql.Application{IntegrationSystemID: &intSystemID},
+			Labels:      map[string]interface{}{"pairingTarget": "first"},
+		})
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, "second-token", actualToken.Token)
+	})
+
+	t.Run("fails when application label points to unknown target", func(t *testing.T) {
+		// GIVEN
+		router := newRouter(t, cfg)
+		// WHEN
+		_, err := router.Do(context.TODO(), adapter.RequestData{
+			Application: graphql.Application{ID: fixAppID()},
+			Labels:      map[string]interface{}{"pairingTarget": "unknown"},
+		})
+		// THEN
+		assert.EqualError(t, err, "target [unknown] from label [pairingTarget] of Application with ID applicationID is not defined")
+	})
+
+	t.Run("fails when no target matches", func(t *testing.T) {
+		// GIVEN
+		noDefaultCfg := cfg
+		noDefaultCfg.DefaultTarget = ""
+		router := newRouter(t, noDefaultCfg)
+		// WHEN
+		_, err := router.Do(context.TODO(), adapter.RequestData{Application: graphql.Application{ID: fixAppID()}})
+		// THEN
+		assert.EqualError(t, err, "no target defined for Application with ID applicationID")
+	})
+
+	t.Run("reloads changed configuration", func(t *testing.T) {
+		// GIVEN
+		path := writeTargetsConfig(t, cfg)
+		router, err := adapter.NewRouter(path, time.Second)
+		require.NoError(t, err)
+
+		changedCfg := cfg
+		changedCfg.DefaultTarget = "second"
+		writeTargetsConfigTo(t, path, changedCfg)
+		// WHEN
+		err = router.Reload()
+		// THEN
+		require.NoError(t, err)
+		actualToken, err := router.Do(context.TODO(), adapter.RequestData{})
+		require.NoError(t, err)
+		assert.Equal(t, "second-token", actualToken.Token)
+	})
+
+	t.Run("keeps previous configuration when the changed one is invalid", func(t *testing.T) {
+		// GIVEN
+		path := writeTargetsConfig(t, cfg)
+		router, err := adapter.NewRouter(path, time.Second)
+		require.NoError(t, err)
+
+		invalidCfg := cfg
+		invalidCfg.DefaultTarget = "unknown"
+		writeTargetsConfigTo(t, path, invalidCfg)
+		// WHEN
+		err = router.Reload()
+		// THEN
+		require.Error(t, err)
+		actualToken, err := router.Do(context.TODO(), adapter.RequestData{})
+		require.NoError(t, err)
+		assert.Equal(t, "first-token", actualToken.Token)
+	})
+
+	t.Run("fails when configuration file does not exist", func(t *testing.T) {
+		// WHEN
+		_, err := adapter.NewRouter(filepath.Join(os.TempDir(), "not-existing-targets.json"), time.Second)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while reading targets configuration file")
+	})
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	t.Run("sends basic auth credentials", func(t *testing.T) {
+		// GIVEN
+		srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			username, password, ok := req.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "user", username)
+			assert.Equal(t, "pass", password)
+			rw.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		client, err := adapter.NewHTTPClient(adapter.Auth{
+			Type:  adapter.AuthTypeBasic,
+			Basic: &adapter.BasicAuth{Username: "user", Password: "pass"},
+		}, time.Second)
+		require.NoError(t, err)
+		// WHEN
+		resp, err := client.Get(srv.URL)
+		// THEN
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("fails when client certificate cannot be loaded", func(t *testing.T) {
+		// WHEN
+		_, err := adapter.NewHTTPClient(adapter.Auth{
+			Type: adapter.AuthTypeMTLS,
+			MTLS: &adapter.MTLSAuth{CertFile: "not-existing.crt", KeyFile: "not-existing.key"},
+		}, time.Second)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while loading client certificate")
+	})
+
+	t.Run("fails on unknown OAuth auth style", func(t *testing.T) {
+		// WHEN
+		_, err := adapter.NewHTTPClient(adapter.Auth{
+			Type:  adapter.AuthTypeOAuth,
+			OAuth: &adapter.OAuth{URL: "http://oauth.local", ClientID: "id", AuthStyle: "unknown"},
+		}, time.Second)
+		// THEN
+		assert.EqualError(t, err, "while getting Auth Style: unknown Auth style")
+	})
+}
+
+func newRouter(t *testing.T, cfg adapter.TargetsConfig) *adapter.Router {
+	router, err := adapter.NewRouter(writeTargetsConfig(t, cfg), time.Second)
+	require.NoError(t, err)
+	return router
+}
+
+func writeTargetsConfig(t *testing.T, cfg adapter.TargetsConfig) string {
+	dir, err := ioutil.TempDir("", "targets")
+	require.NoError(t, err)
+	path := filepath.Join(dir, "targets.json")
+	writeTargetsConfigTo(t, path, cfg)
+	return path
+}
+
+func writeTargetsConfigTo(t *testing.T, path string, cfg adapter.TargetsConfig) {
+	b, err := json.Marshal(cfg)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, b, 0600))
+}
+
+func fixTarget(name, url string, intSystemIDs ...string) adapter.Target {
+	return adapter.Target{
+		Name:                 name,
+		IntegrationSystemIDs: intSystemIDs,
+		Mapping: adapter.Mapping{
+			TemplateExternalURL:       url,
+			TemplateTokenFromResponse: `{{ .token }}`,
+		},
+		Auth: adapter.Auth{Type: adapter.AuthTypeNone},
+	}
+}
+
+func tokenHandler(token string) http.HandlerFunc {
+	return func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{"token":"%s"}`, token)))
+	}
+}
@@ -0,0 +1,132 @@
+package adapter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	AuthTypeNone  AuthType = "None"
+	AuthTypeBasic AuthType = "Basic"
+	AuthTypeOAuth AuthType = "OAuth"
+	AuthTypeMTLS  AuthType = "MTLS"
+)
+
+type AuthType string
+
+// TargetsConfig describes External Token Services which the Pairing Adapter can request tokens from.
+// A target is chosen by the Integration System of the Application, then by the value of the ApplicationLabelKey label
+// of the Application, and finally falls back to DefaultTarget.
+type TargetsConfig struct {
+	ApplicationLabelKey string   `json:"applicationLabelKey"`
+	DefaultTarget       string   `json:"defaultTarget"`
+	Targets             []Target `json:"targets"`
+}
+
+type Target struct {
+	Name                 string   `json:"name"`
+	IntegrationSystemIDs []string `json:"integrationSystemIDs"`
+	Mapping              Mapping  `json:"mapping"`
+	Auth                 Auth     `json:"auth"`
+}
+
+type Auth struct {
+	Type  AuthType   `json:"type"`
+	Basic *BasicAuth `json:"basic,omitempty"`
+	OAuth *OAuth     `json:"oauth,omitempty"`
+	MTLS  *MTLSAuth  `json:"mtls,omitempty"`
+}
+
+type BasicAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type MTLSAuth struct {
+	CertFile string `json:"certFile"`
+	KeyFile  string `json:"keyFile"`
+	CAFile   string `json:"caFile"`
+}
+
+// LoadTargetsConfig reads and validates the targets configuration from a JSON file
+func LoadTargetsConfig(path string) (TargetsConfig, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return TargetsConfig{}, errors.Wrapf(err, "while reading targets configuration file [%s]", path)
+	}
+
+	cfg := TargetsConfig{}
+	if err := json.Unmarshal(b, &cfg); err != nil {
+		return TargetsConfig{}, errors.Wrapf(err, "while unmarshalling targets configuration file [%s]", path)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return TargetsConfig{}, errors.Wrapf(err, "while validating targets configuration file [%s]", path)
+	}
+
+	return cfg, nil
+}
+
+func (c TargetsConfig) Validate() error {
+	if len(c.Targets) == 0 {
+		return errors.New("at least one target has to be defined")
+	}
+
+	names := make(map[string]bool, len(c.Targets))
+	intSystems := make(map[string]string)
+	for _, target := range c.Targets {
+		if target.Name == "" {
+			return errors.New("target name cannot be empty")
+		}
+		if names[target.Name] {
+			return fmt.Errorf("target [%s] is defined more than once", target.Name)
+		}
+		names[target.Name] = true
+
+		for _, intSystemID := range target.IntegrationSystemIDs {
+			if other, ok := intSystems[intSystemID]; ok {
+				return fmt.Errorf("integration system [%s] is assigned to both target [%s] and [%s]", intSystemID, other, target.Name)
+			}
+			intSystems[intSystemID] = target.Name
+		}
+
+		if target.Mapping.TemplateExternalURL == "" {
+			return fmt.Errorf("target [%s] has no external URL template", target.Name)
+		}
+
+		if err := target.Auth.Validate(); err != nil {
+			return errors.Wrapf(err, "while validating auth of target [%s]", target.Name)
+		}
+	}
+
+	if c.DefaultTarget != "" && !names[c.DefaultTarget] {
+		return fmt.Errorf("default target [%s] is not defined", c.DefaultTarget)
+	}
+
+	return nil
+}
+
+func (a Auth) Validate() error {
+	switch a.Type {
+	case "", AuthTypeNone:
+		return nil
+	case AuthTypeBasic:
+		if a.Basic == nil || a.Basic.Username == "" {
+			return errors.New("basic auth requires username")
+		}
+	case AuthTypeOAuth:
+		if a.OAuth == nil || a.OAuth.URL == "" || a.OAuth.ClientID == "" {
+			return errors.New("oauth auth requires URL and client ID")
+		}
+	case AuthTypeMTLS:
+		if a.MTLS == nil || a.MTLS.CertFile == "" || a.MTLS.KeyFile == "" {
+			return errors.New("mtls auth requires certificate and key files")
+		}
+	default:
+		return fmt.Errorf("unknown auth type [%s]", a.Type)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package adapter_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/pairing-adapter/internal/adapter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTargetsConfig_Validate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Config        adapter.TargetsConfig
+		ExpectedError string
+	}{
+		{
+			Name: "Success",
+			Config: adapter.TargetsConfig{
+				DefaultTarget: "first",
+				Targets:       []adapter.Target{fixTarget("first", "http://first", "a"), fixTarget("second", "http://second", "b")},
+			},
+		},
+		{
+			Name:          "No targets",
+			Config:        adapter.TargetsConfig{},
+			ExpectedError: "at least one target has to be defined",
+		},
+		{
+			Name: "Empty target name",
+			Config: adapter.TargetsConfig{
+				Targets: []adapter.Target{fixTarget("", "http://first")},
+			},
+			ExpectedError: "target name cannot be empty",
+		},
+		{
+			Name: "Duplicated target name",
+			Config: adapter.TargetsConfig{
+				Targets: []adapter.Target{fixTarget("first", "http://first"), fixTarget("first", "http://second")},
+			},
+			ExpectedError: "target [first] is defined more than once",
+		},
+		{
+			Name: "Integration system assigned to many targets",
+			Config: adapter.TargetsConfig{
+				Targets: []adapter.Target{fixTarget("first", "http://first", "a"), fixTarget("second", "http://second", "a")},
+			},
+			ExpectedError: "integration system [a] is assigned to both target [first] and [second]",
+		},
+		{
+			Name: "Missing external URL",
+			Config: adapter.TargetsConfig{
+				Targets: []adapter.Target{fixTarget("first", "")},
+			},
+			ExpectedError: "target [first] has no external URL template",
+		},
+		{
+			Name: "Unknown default target",
+			Config: adapter.TargetsConfig{
+				DefaultTarget: "second",
+				Targets:       []adapter.Target{fixTarget("first", "http://first")},
+			},
+			ExpectedError: "default target [second] is not defined",
+		},
+		{
+			Name: "Invalid auth",
+			Config: adapter.TargetsConfig{
+				Targets: []adapter.Target{func() adapter.Target {
+					target := fixTarget("first", "http://first")
+					target.Auth = adapter.Auth{Type: adapter.AuthTypeBasic}
+					return target
+				}()},
+			},
+			ExpectedError: "while validating auth of target [first]: basic auth requires username",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			err := testCase.Config.Validate()
+			// THEN
+			if testCase.ExpectedError != "" {
+				assert.EqualError(t, err, testCase.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAuth_Validate(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Auth          adapter.Auth
+		ExpectedError string
+	}{
+		{Name: "No auth", Auth: adapter.Auth{}},
+		{Name: "Basic", Auth: adapter.Auth{Type: adapter.AuthTypeBasic, Basic: &adapter.BasicAuth{Username: "user"}}},
+		{Name: "OAuth", Auth: adapter.Auth{Type: adapter.AuthTypeOAuth, OAuth: &adapter.OAuth{URL: "http://oauth", ClientID: "id"}}},
+		{Name: "MTLS", Auth: adapter.Auth{Type: adapter.AuthTypeMTLS, MTLS: &adapter.MTLSAuth{CertFile: "tls.crt", KeyFile: "tls.key"}}},
+		{Name: "OAuth without details", Auth: adapter.Auth{Type: adapter.AuthTypeOAuth}, ExpectedError: "oauth auth requires URL and client ID"},
+		{Name: "MTLS without key", Auth: adapter.Auth{Type: adapter.AuthTypeMTLS, MTLS: &adapter.MTLSAuth{CertFile: "tls.crt"}}, ExpectedError: "mtls auth requires certificate and key files"},
+		{Name: "Unknown type", Auth: adapter.Auth{Type: "Kerberos"}, ExpectedError: "unknown auth type [Kerberos]"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// WHEN
+			err := testCase.Auth.Validate()
+			// THEN
+			if testCase.ExpectedError != "" {
+				assert.EqualError(t, err, testCase.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
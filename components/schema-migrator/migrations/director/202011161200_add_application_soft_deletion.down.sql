@@ -0,0 +1,10 @@
+BEGIN;
+
+DELETE FROM applications WHERE deleted_at IS NOT NULL;
+
+DROP INDEX applications_deleted_at_idx;
+
+ALTER TABLE applications
+    DROP COLUMN deleted_at;
+
+COMMIT;
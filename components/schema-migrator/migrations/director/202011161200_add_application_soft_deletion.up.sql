@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE applications
+    ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE INDEX applications_deleted_at_idx ON applications (deleted_at) WHERE deleted_at IS NOT NULL;
+
+COMMIT;
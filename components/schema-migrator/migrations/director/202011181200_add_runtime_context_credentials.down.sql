@@ -0,0 +1,32 @@
+BEGIN;
+
+ALTER TABLE package_instance_auths
+    DROP CONSTRAINT package_instance_auths_runtime_context_id_fk;
+
+ALTER TABLE package_instance_auths
+    DROP COLUMN runtime_context_id;
+
+DELETE FROM system_auths WHERE runtime_context_id IS NOT NULL;
+
+ALTER TABLE system_auths
+    DROP CONSTRAINT tenantCheck;
+
+ALTER TABLE system_auths
+    ADD CONSTRAINT tenantCheck CHECK(
+        ((app_id IS NOT NULL OR runtime_id IS NOT NULL) AND tenant_id IS NOT NULL) OR
+        (integration_system_id IS NOT NULL AND tenant_id IS NULL));
+
+ALTER TABLE system_auths
+    DROP CONSTRAINT valid_refs;
+
+ALTER TABLE system_auths
+    ADD CONSTRAINT valid_refs
+        CHECK (app_id IS NOT NULL OR runtime_id IS NOT NULL OR integration_system_id IS NOT NULL);
+
+ALTER TABLE system_auths
+    DROP CONSTRAINT system_auths_runtime_context_id_fk;
+
+ALTER TABLE system_auths
+    DROP COLUMN runtime_context_id;
+
+COMMIT;
@@ -0,0 +1,32 @@
+BEGIN;
+
+ALTER TABLE system_auths
+    ADD COLUMN runtime_context_id UUID;
+
+ALTER TABLE system_auths
+    ADD CONSTRAINT system_auths_runtime_context_id_fk FOREIGN KEY (runtime_context_id) REFERENCES runtime_contexts(id) ON DELETE CASCADE;
+
+ALTER TABLE system_auths
+    DROP CONSTRAINT valid_refs;
+
+ALTER TABLE system_auths
+    ADD CONSTRAINT valid_refs
+        CHECK (app_id IS NOT NULL OR runtime_id IS NOT NULL OR runtime_context_id IS NOT NULL OR integration_system_id IS NOT NULL);
+
+ALTER TABLE system_auths
+    DROP CONSTRAINT tenantCheck;
+
+ALTER TABLE system_auths
+    ADD CONSTRAINT tenantCheck CHECK(
+        ((app_id IS NOT NULL OR runtime_id IS NOT NULL OR runtime_context_id IS NOT NULL) AND tenant_id IS NOT NULL) OR
+        (integration_system_id IS NOT NULL AND tenant_id IS NULL));
+
+CREATE INDEX ON system_auths (runtime_context_id);
+
+ALTER TABLE package_instance_auths
+    ADD COLUMN runtime_context_id UUID;
+
+ALTER TABLE package_instance_auths
+    ADD CONSTRAINT package_instance_auths_runtime_context_id_fk FOREIGN KEY (runtime_context_id) REFERENCES runtime_contexts(id) ON DELETE CASCADE;
+
+COMMIT;
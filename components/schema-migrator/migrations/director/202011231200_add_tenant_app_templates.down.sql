@@ -0,0 +1,33 @@
+BEGIN;
+
+DELETE FROM app_templates WHERE tenant_id IS NOT NULL;
+
+DROP INDEX application_template_tenant_name_unique;
+DROP INDEX application_template_global_name_unique;
+
+ALTER TABLE app_templates
+    ADD CONSTRAINT application_template_name_unique UNIQUE (name);
+
+ALTER TABLE app_templates
+    DROP CONSTRAINT app_templates_tenant_access_level_check;
+
+ALTER TABLE app_templates
+    DROP COLUMN tenant_id;
+
+ALTER TABLE app_templates
+    ALTER COLUMN access_level TYPE VARCHAR(255);
+
+ALTER TYPE app_templates_access_level
+    RENAME TO app_templates_access_level_old;
+
+CREATE TYPE app_templates_access_level AS ENUM (
+    'GLOBAL'
+);
+
+ALTER TABLE app_templates
+    ALTER COLUMN access_level TYPE app_templates_access_level
+    USING access_level::app_templates_access_level;
+
+DROP TYPE app_templates_access_level_old;
+
+COMMIT;
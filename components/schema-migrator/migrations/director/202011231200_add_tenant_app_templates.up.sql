@@ -0,0 +1,37 @@
+BEGIN;
+
+ALTER TABLE app_templates
+    ALTER COLUMN access_level TYPE VARCHAR(255);
+
+ALTER TYPE app_templates_access_level
+    RENAME TO app_templates_access_level_old;
+
+CREATE TYPE app_templates_access_level AS ENUM (
+    'GLOBAL',
+    'TENANT'
+);
+
+ALTER TABLE app_templates
+    ALTER COLUMN access_level TYPE app_templates_access_level
+    USING access_level::app_templates_access_level;
+
+DROP TYPE app_templates_access_level_old;
+
+ALTER TABLE app_templates
+    ADD COLUMN tenant_id uuid;
+
+ALTER TABLE app_templates
+    ADD CONSTRAINT app_templates_tenant_id_fkey FOREIGN KEY (tenant_id) REFERENCES business_tenant_mappings(id) ON DELETE CASCADE;
+
+ALTER TABLE app_templates
+    ADD CONSTRAINT app_templates_tenant_access_level_check CHECK (
+        (access_level = 'GLOBAL' AND tenant_id IS NULL) OR
+        (access_level = 'TENANT' AND tenant_id IS NOT NULL));
+
+ALTER TABLE app_templates
+    DROP CONSTRAINT application_template_name_unique;
+
+CREATE UNIQUE INDEX application_template_global_name_unique ON app_templates (name) WHERE tenant_id IS NULL;
+CREATE UNIQUE INDEX application_template_tenant_name_unique ON app_templates (tenant_id, name) WHERE tenant_id IS NOT NULL;
+
+COMMIT;
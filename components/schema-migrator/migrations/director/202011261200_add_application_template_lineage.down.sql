@@ -0,0 +1,14 @@
+BEGIN;
+
+DROP INDEX applications_app_template_id_idx;
+
+ALTER TABLE applications
+    DROP CONSTRAINT applications_app_template_id_fkey,
+    DROP COLUMN app_template_values,
+    DROP COLUMN app_template_version,
+    DROP COLUMN app_template_id;
+
+ALTER TABLE app_templates
+    DROP COLUMN version;
+
+COMMIT;
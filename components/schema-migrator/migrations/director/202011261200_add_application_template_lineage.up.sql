@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE app_templates
+    ADD COLUMN version INTEGER NOT NULL DEFAULT 1 CHECK (version > 0);
+
+ALTER TABLE applications
+    ADD COLUMN app_template_id UUID,
+    ADD COLUMN app_template_version INTEGER,
+    ADD COLUMN app_template_values JSONB,
+    ADD CONSTRAINT applications_app_template_id_fkey FOREIGN KEY (app_template_id) REFERENCES app_templates (id) ON DELETE SET NULL;
+
+CREATE INDEX applications_app_template_id_idx ON applications (app_template_id) WHERE app_template_id IS NOT NULL;
+
+COMMIT;
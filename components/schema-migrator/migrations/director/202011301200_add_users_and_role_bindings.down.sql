@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TABLE role_bindings;
+DROP TABLE roles;
+DROP TABLE user_groups;
+DROP TABLE users;
+
+COMMIT;
@@ -0,0 +1,32 @@
+BEGIN;
+
+CREATE TABLE users (
+    id UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    username VARCHAR(256) NOT NULL UNIQUE
+);
+
+CREATE TABLE user_groups (
+    id UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    name VARCHAR(256) NOT NULL UNIQUE
+);
+
+CREATE TABLE roles (
+    id UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    name VARCHAR(256) NOT NULL UNIQUE,
+    scopes JSONB NOT NULL
+);
+
+CREATE TABLE role_bindings (
+    id UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    role_id UUID NOT NULL REFERENCES roles (id) ON DELETE CASCADE,
+    user_id UUID REFERENCES users (id) ON DELETE CASCADE,
+    group_id UUID REFERENCES user_groups (id) ON DELETE CASCADE,
+    tenant VARCHAR(256),
+    CONSTRAINT valid_subject CHECK ((user_id IS NULL) <> (group_id IS NULL))
+);
+
+CREATE UNIQUE INDEX ON role_bindings (role_id, coalesce(user_id, '00000000-0000-0000-0000-000000000000'), coalesce(group_id, '00000000-0000-0000-0000-000000000000'), coalesce(tenant, ''));
+CREATE INDEX ON role_bindings (user_id);
+CREATE INDEX ON role_bindings (group_id);
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+CREATE TABLE scenarios (
+    id UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    tenant_id UUID NOT NULL REFERENCES business_tenant_mappings (id) ON DELETE CASCADE,
+    name VARCHAR(128) NOT NULL,
+    description TEXT,
+    owner VARCHAR(256),
+    CONSTRAINT scenarios_tenant_name_unique UNIQUE (tenant_id, name)
+);
+
+COMMIT;
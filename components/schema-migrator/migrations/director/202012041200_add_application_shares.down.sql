@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE package_instance_auths
+    DROP COLUMN requester_tenant_id;
+
+DROP TABLE application_shares;
+
+COMMIT;
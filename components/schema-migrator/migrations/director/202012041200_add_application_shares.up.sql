@@ -0,0 +1,25 @@
+BEGIN;
+
+CREATE TABLE application_shares (
+    id UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    tenant_id UUID NOT NULL,
+    app_id UUID NOT NULL,
+    target_tenant_id UUID NOT NULL REFERENCES business_tenant_mappings (id) ON DELETE CASCADE,
+    scenario VARCHAR(128),
+    FOREIGN KEY (tenant_id, app_id) REFERENCES applications (tenant_id, id) ON DELETE CASCADE,
+    CONSTRAINT application_shares_target_tenant_check CHECK (tenant_id <> target_tenant_id)
+);
+
+CREATE INDEX ON application_shares (tenant_id);
+CREATE INDEX ON application_shares (target_tenant_id);
+CREATE UNIQUE INDEX ON application_shares (app_id, target_tenant_id, coalesce(scenario, ''));
+
+ALTER TABLE package_instance_auths
+    ADD COLUMN requester_tenant_id UUID;
+
+ALTER TABLE package_instance_auths
+    ADD CONSTRAINT package_instance_auths_requester_tenant_id_fk FOREIGN KEY (requester_tenant_id) REFERENCES business_tenant_mappings (id) ON DELETE CASCADE;
+
+CREATE INDEX ON package_instance_auths (requester_tenant_id);
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE tenant_offboardings;
+
+DROP TYPE tenant_offboarding_state;
+
+COMMIT;
@@ -0,0 +1,27 @@
+BEGIN;
+
+CREATE TYPE tenant_offboarding_state AS ENUM (
+    'DEACTIVATED',
+    'CREDENTIALS_REVOKED',
+    'EXPORTED',
+    'PURGED'
+);
+
+-- Offboardings are kept after the tenant is purged, so tenant_id does not reference business_tenant_mappings
+CREATE TABLE tenant_offboardings (
+    id UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    tenant_id UUID NOT NULL UNIQUE,
+    external_tenant VARCHAR(256) NOT NULL,
+    state tenant_offboarding_state NOT NULL,
+    export_requested BOOLEAN NOT NULL DEFAULT FALSE,
+    exported_data JSONB,
+    error TEXT,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    purge_after TIMESTAMP
+);
+
+CREATE INDEX ON tenant_offboardings (external_tenant);
+CREATE INDEX tenant_offboardings_state_idx ON tenant_offboardings (state) WHERE state <> 'PURGED';
+
+COMMIT;
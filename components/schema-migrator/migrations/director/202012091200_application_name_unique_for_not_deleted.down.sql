@@ -0,0 +1,12 @@
+BEGIN;
+
+DELETE FROM applications a
+WHERE a.deleted_at IS NOT NULL
+  AND EXISTS(SELECT 1 FROM applications b WHERE b.tenant_id = a.tenant_id AND b.name = a.name AND b.id <> a.id);
+
+DROP INDEX application_tenant_id_name_unique;
+
+ALTER TABLE applications
+    ADD CONSTRAINT application_tenant_id_name_unique UNIQUE (tenant_id, name);
+
+COMMIT;
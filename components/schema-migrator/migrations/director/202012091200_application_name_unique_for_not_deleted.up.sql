@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE applications
+    DROP CONSTRAINT application_tenant_id_name_unique;
+
+CREATE UNIQUE INDEX application_tenant_id_name_unique ON applications (tenant_id, name) WHERE deleted_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+DELETE FROM labels WHERE package_id IS NOT NULL;
+
+ALTER TABLE labels
+    DROP CONSTRAINT package_id_fk;
+
+ALTER TABLE labels
+    DROP CONSTRAINT valid_refs;
+
+ALTER TABLE labels
+    ADD CONSTRAINT valid_refs
+        CHECK (app_id IS NOT NULL OR runtime_id IS NOT NULL OR labels.runtime_context_id IS NOT NULL);
+
+DROP INDEX IF EXISTS labels_tenant_id_key_coalesce_coalesce1_coalesce2_coalesce3_idx;
+
+ALTER TABLE labels
+    DROP COLUMN package_id;
+
+CREATE UNIQUE INDEX ON labels (tenant_id, key, coalesce(app_id, '00000000-0000-0000-0000-000000000000'), coalesce(runtime_id, '00000000-0000-0000-0000-000000000000'), coalesce(labels.runtime_context_id, '00000000-0000-0000-0000-000000000000'));
+
+COMMIT;
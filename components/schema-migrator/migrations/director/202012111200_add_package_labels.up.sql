@@ -0,0 +1,19 @@
+BEGIN;
+
+ALTER TABLE labels
+    ADD COLUMN package_id UUID;
+
+ALTER TABLE labels
+    ADD CONSTRAINT package_id_fk FOREIGN KEY (package_id) REFERENCES packages(id) ON DELETE CASCADE;
+
+ALTER TABLE labels
+    DROP CONSTRAINT valid_refs;
+
+ALTER TABLE labels
+    ADD CONSTRAINT valid_refs
+        CHECK (app_id IS NOT NULL OR runtime_id IS NOT NULL OR labels.runtime_context_id IS NOT NULL OR labels.package_id IS NOT NULL);
+
+DROP INDEX IF EXISTS labels_tenant_id_key_coalesce_coalesce1_coalesce2_idx;
+CREATE UNIQUE INDEX ON labels (tenant_id, key, coalesce(app_id, '00000000-0000-0000-0000-000000000000'), coalesce(runtime_id, '00000000-0000-0000-0000-000000000000'), coalesce(labels.runtime_context_id, '00000000-0000-0000-0000-000000000000'), coalesce(labels.package_id, '00000000-0000-0000-0000-000000000000'));
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE tenant_offboardings DROP COLUMN runtimes_to_notify;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+-- Runtimes which lost their credentials are kept until they are notified, so that failed notifications are retried
+ALTER TABLE tenant_offboardings ADD COLUMN runtimes_to_notify UUID[];
+
+COMMIT;
@@ -42,6 +42,7 @@ data:
   gateway.gateway.auditlog.enabled: "true"
   gateway.gateway.auditlog.authMode: "oauth"
   director.deployment.allowJWTSigningNone: "true"
+  director.deployment.jwtStrictMode: "false"
 ---
 apiVersion: v1
 kind: ConfigMap
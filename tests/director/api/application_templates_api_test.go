@@ -194,3 +194,137 @@ func TestRegisterApplicationFromTemplate(t *testing.T) {
 	require.Equal(t, "test new-value", *outputApp.Application.Description)
 	saveExample(t, createAppFromTmplRequest.Query(), "register application from template")
 }
+
+func TestApplicationFromTemplatePreview(t *testing.T) {
+	//GIVEN
+	ctx := context.TODO()
+	tmplName := "preview-template"
+	placeholderKey := "new-placeholder"
+	appTmplInput := fixApplicationTemplate(tmplName)
+	appTmplInput.ApplicationInput.Description = ptr.String("test {{new-placeholder}}")
+	appTmplInput.Placeholders = []*graphql.PlaceholderDefinitionInput{
+		{
+			Name:        placeholderKey,
+			Description: ptr.String("description"),
+		}}
+
+	appTmpl := createApplicationTemplateFromInput(t, ctx, appTmplInput)
+	defer deleteApplicationTemplate(t, ctx, appTmpl.ID)
+
+	appFromTmpl := graphql.ApplicationFromTemplateInput{TemplateName: tmplName, Values: []*graphql.TemplateValueInput{
+		{
+			Placeholder: placeholderKey,
+			Value:       "{value}, 'quoted'",
+		}}}
+	appFromTmplGQL, err := tc.graphqlizer.ApplicationFromTemplateInputToGQL(appFromTmpl)
+	require.NoError(t, err)
+	previewRequest := fixApplicationFromTemplatePreview(appFromTmplGQL)
+	var output string
+
+	//WHEN
+	err = tc.RunOperation(ctx, previewRequest, &output)
+
+	//THEN
+	require.NoError(t, err)
+	assert.Contains(t, output, "test {value}, 'quoted'")
+
+	t.Log("Check if no application was registered")
+	appPage := graphql.ApplicationPage{}
+	err = tc.RunOperation(ctx, fixApplicationsRequest(), &appPage)
+	require.NoError(t, err)
+	for _, app := range appPage.Data {
+		assert.NotEqual(t, appTmplInput.ApplicationInput.Name, app.Name)
+	}
+	saveExample(t, previewRequest.Query(), "preview application from template")
+}
+
+func TestUpgradeApplicationFromTemplate(t *testing.T) {
+	//GIVEN
+	ctx := context.TODO()
+	tmplName := "upgrade-template"
+	placeholderKey := "new-placeholder"
+	appTmplInput := fixApplicationTemplate(tmplName)
+	appTmplInput.Placeholders = []*graphql.PlaceholderDefinitionInput{
+		{
+			Name:        placeholderKey,
+			Description: ptr.String("description"),
+		}}
+
+	appTmpl := createApplicationTemplateFromInput(t, ctx, appTmplInput)
+	defer deleteApplicationTemplate(t, ctx, appTmpl.ID)
+	require.Equal(t, 1, appTmpl.Version)
+
+	appFromTmpl := graphql.ApplicationFromTemplateInput{TemplateName: tmplName, Values: []*graphql.TemplateValueInput{
+		{
+			Placeholder: placeholderKey,
+			Value:       "v1",
+		}}}
+	appFromTmplGQL, err := tc.graphqlizer.ApplicationFromTemplateInputToGQL(appFromTmpl)
+	require.NoError(t, err)
+	app := graphql.ApplicationExt{}
+	err = tc.RunOperation(ctx, fixRegisterApplicationFromTemplate(appFromTmplGQL), &app)
+	require.NoError(t, err)
+	require.NotEmpty(t, app.ID)
+	defer unregisterApplication(t, app.ID)
+
+	t.Log("Check Application Template reference of registered Application")
+	appWithRef := graphql.Application{}
+	err = tc.RunOperation(ctx, fixApplicationTemplateReferenceRequest(app.ID), &appWithRef)
+	require.NoError(t, err)
+	require.NotNil(t, appWithRef.TemplateReference)
+	require.NotNil(t, appWithRef.TemplateReference.ApplicationTemplateID)
+	assert.Equal(t, appTmpl.ID, *appWithRef.TemplateReference.ApplicationTemplateID)
+	assert.Equal(t, 1, appWithRef.TemplateReference.Version)
+	assert.Equal(t, []*graphql.TemplateValue{{Placeholder: placeholderKey, Value: "v1"}}, appWithRef.TemplateReference.Values)
+
+	t.Log("Update Application Template")
+	appTmplInput.ApplicationInput.Labels = &graphql.Labels{
+		"a":        []string{"b", "c"},
+		"upgraded": "{{new-placeholder}}",
+	}
+	appTmplInputGQL, err := tc.graphqlizer.ApplicationTemplateInputToGQL(appTmplInput)
+	require.NoError(t, err)
+	updatedAppTmpl := graphql.ApplicationTemplate{}
+	err = tc.RunOperation(ctx, fixUpdateApplicationTemplateRequest(appTmpl.ID, appTmplInputGQL), &updatedAppTmpl)
+	require.NoError(t, err)
+	require.Equal(t, 2, updatedAppTmpl.Version)
+
+	upgradeInput := graphql.ApplicationFromTemplateUpgradeInput{Values: []*graphql.TemplateValueInput{
+		{
+			Placeholder: placeholderKey,
+			Value:       "v2",
+		}}}
+	upgradeInputGQL, err := tc.graphqlizer.ApplicationFromTemplateUpgradeInputToGQL(upgradeInput)
+	require.NoError(t, err)
+
+	t.Log("Check changes of upgrading Application")
+	diffRequest := fixApplicationFromTemplateUpgradeDiff(app.ID, upgradeInputGQL)
+	diff := graphql.ApplicationFromTemplateUpgrade{}
+	err = tc.RunOperation(ctx, diffRequest, &diff)
+	require.NoError(t, err)
+	assert.Equal(t, 1, diff.FromVersion)
+	assert.Equal(t, 2, diff.ToVersion)
+	assert.Equal(t, []*graphql.ApplicationChange{
+		{Resource: graphql.ApplicationChangeResourceLabel, Operation: graphql.ApplicationChangeOperationCreate, Key: "upgraded"},
+	}, diff.Changes)
+	saveExample(t, diffRequest.Query(), "query application from template upgrade diff")
+
+	unchangedApp := getApplication(t, ctx, app.ID)
+	assert.NotContains(t, unchangedApp.Labels, "upgraded")
+
+	//WHEN
+	upgradeRequest := fixUpgradeApplicationFromTemplate(app.ID, upgradeInputGQL)
+	upgradedApp := graphql.ApplicationExt{}
+	err = tc.RunOperation(ctx, upgradeRequest, &upgradedApp)
+
+	//THEN
+	require.NoError(t, err)
+	assert.Equal(t, "v2", upgradedApp.Labels["upgraded"])
+	saveExample(t, upgradeRequest.Query(), "upgrade application from template")
+
+	err = tc.RunOperation(ctx, fixApplicationTemplateReferenceRequest(app.ID), &appWithRef)
+	require.NoError(t, err)
+	require.NotNil(t, appWithRef.TemplateReference)
+	assert.Equal(t, 2, appWithRef.TemplateReference.Version)
+	assert.Equal(t, []*graphql.TemplateValue{{Placeholder: placeholderKey, Value: "v2"}}, appWithRef.TemplateReference.Values)
+}
@@ -613,6 +613,50 @@ func fixRegisterApplicationFromTemplate(applicationFromTemplateInputInGQL string
 			applicationFromTemplateInputInGQL, tc.gqlFieldsProvider.ForApplication()))
 }
 
+func fixApplicationFromTemplatePreview(applicationFromTemplateInputInGQL string) *gcli.Request {
+	return gcli.NewRequest(
+		fmt.Sprintf(`query {
+			result: applicationFromTemplatePreview(in: %s)
+			}`,
+			applicationFromTemplateInputInGQL))
+}
+
+func fixApplicationTemplateReferenceRequest(appID string) *gcli.Request {
+	return gcli.NewRequest(
+		fmt.Sprintf(`query {
+			result: application(id: "%s") {
+					templateReference {
+						applicationTemplateID
+						version
+						values { placeholder value }
+					}
+				}
+			}`,
+			appID))
+}
+
+func fixApplicationFromTemplateUpgradeDiff(appID, upgradeInputInGQL string) *gcli.Request {
+	return gcli.NewRequest(
+		fmt.Sprintf(`query {
+			result: applicationFromTemplateUpgradeDiff(appID: "%s", in: %s) {
+					fromVersion
+					toVersion
+					changes { resource operation key }
+				}
+			}`,
+			appID, upgradeInputInGQL))
+}
+
+func fixUpgradeApplicationFromTemplate(appID, upgradeInputInGQL string) *gcli.Request {
+	return gcli.NewRequest(
+		fmt.Sprintf(`mutation {
+			result: upgradeApplicationFromTemplate(appID: "%s", in: %s) {
+					%s
+				}
+			}`,
+			appID, upgradeInputInGQL, tc.gqlFieldsProvider.ForApplication()))
+}
+
 func fixSetDefaultEventingForApplication(appID string, runtimeID string) *gcli.Request {
 	return gcli.NewRequest(
 		fmt.Sprintf(`mutation {
@@ -902,3 +946,66 @@ func fixRefetchAPISpecRequest(id string) *gcli.Request {
 				}`,
 			id, tc.gqlFieldsProvider.ForApiSpec()))
 }
+
+func fixCreateUserRequest(userInputGQL string) *gcli.Request {
+	return gcli.NewRequest(
+		fmt.Sprintf(`mutation {
+			result: createUser(in: %s) {
+					%s
+				}
+			}`, userInputGQL, tc.gqlFieldsProvider.ForUser()))
+}
+
+func fixDeleteUserRequest(id string) *gcli.Request {
+	return gcli.NewRequest(
+		fmt.Sprintf(`mutation {
+			result: deleteUser(id: "%s") {
+					%s
+				}
+			}`, id, tc.gqlFieldsProvider.ForUser()))
+}
+
+func fixCreateRoleRequest(roleInputGQL string) *gcli.Request {
+	return gcli.NewRequest(
+		fmt.Sprintf(`mutation {
+			result: createRole(in: %s) {
+					%s
+				}
+			}`, roleInputGQL, tc.gqlFieldsProvider.ForRole()))
+}
+
+func fixDeleteRoleRequest(id string) *gcli.Request {
+	return gcli.NewRequest(
+		fmt.Sprintf(`mutation {
+			result: deleteRole(id: "%s") {
+					%s
+				}
+			}`, id, tc.gqlFieldsProvider.ForRole()))
+}
+
+func fixCreateRoleBindingRequest(roleBindingInputGQL string) *gcli.Request {
+	return gcli.NewRequest(
+		fmt.Sprintf(`mutation {
+			result: createRoleBinding(in: %s) {
+					%s
+				}
+			}`, roleBindingInputGQL, tc.gqlFieldsProvider.ForRoleBinding()))
+}
+
+func fixDeleteRoleBindingRequest(id string) *gcli.Request {
+	return gcli.NewRequest(
+		fmt.Sprintf(`mutation {
+			result: deleteRoleBinding(id: "%s") {
+					%s
+				}
+			}`, id, tc.gqlFieldsProvider.ForRoleBinding()))
+}
+
+func fixRoleBindingsRequest() *gcli.Request {
+	return gcli.NewRequest(
+		fmt.Sprintf(`query {
+				result: roleBindings {
+						%s
+					}
+				}`, tc.gqlFieldsProvider.ForRoleBinding()))
+}
@@ -27,7 +27,7 @@ type testContext struct {
 	currentScopes     []string
 }
 
-const defaultScopes = "runtime:write application:write tenant:read label_definition:write integration_system:write application:read runtime:read label_definition:read integration_system:read health_checks:read application_template:read application_template:write eventing:manage automatic_scenario_assignment:read automatic_scenario_assignment:write"
+const defaultScopes = "runtime:write application:write application:share tenant:read label_definition:write integration_system:write application:read runtime:read label_definition:read integration_system:read health_checks:read application_template:read application_template:write application_template:tenant_write eventing:manage automatic_scenario_assignment:read automatic_scenario_assignment:write user:read user:write"
 
 func newTestContext() (*testContext, error) {
 	scopesStr := os.Getenv("ALL_SCOPES")
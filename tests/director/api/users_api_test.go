@@ -0,0 +1,88 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserRoleBindings(t *testing.T) {
+	// GIVEN
+	ctx := context.Background()
+	tenantID := testTenants.GetDefaultTenantID()
+
+	userInGQL, err := tc.graphqlizer.UserInputToGQL(graphql.UserInput{Username: "e2e-user"})
+	require.NoError(t, err)
+	createUserReq := fixCreateUserRequest(userInGQL)
+	user := graphql.User{}
+	err = tc.RunOperationWithoutTenant(ctx, createUserReq, &user)
+	require.NoError(t, err)
+	require.NotEmpty(t, user.ID)
+	defer deleteUser(t, ctx, user.ID)
+	saveExample(t, createUserReq.Query(), "create user")
+
+	roleInGQL, err := tc.graphqlizer.RoleInputToGQL(graphql.RoleInput{
+		Name:   "e2e-role",
+		Scopes: []string{"application:read", "runtime:read"},
+	})
+	require.NoError(t, err)
+	createRoleReq := fixCreateRoleRequest(roleInGQL)
+	role := graphql.Role{}
+	err = tc.RunOperationWithoutTenant(ctx, createRoleReq, &role)
+	require.NoError(t, err)
+	require.NotEmpty(t, role.ID)
+	defer deleteRole(t, ctx, role.ID)
+	saveExample(t, createRoleReq.Query(), "create role")
+
+	// WHEN
+	roleBindingIn := graphql.RoleBindingInput{
+		RoleID:      role.ID,
+		SubjectType: graphql.RoleBindingSubjectTypeUser,
+		SubjectID:   user.ID,
+		Tenant:      &tenantID,
+	}
+	roleBindingInGQL, err := tc.graphqlizer.RoleBindingInputToGQL(roleBindingIn)
+	require.NoError(t, err)
+	createRoleBindingReq := fixCreateRoleBindingRequest(roleBindingInGQL)
+	roleBinding := graphql.RoleBinding{}
+	err = tc.RunOperationWithoutTenant(ctx, createRoleBindingReq, &roleBinding)
+	require.NoError(t, err)
+	require.NotEmpty(t, roleBinding.ID)
+	saveExample(t, createRoleBindingReq.Query(), "create role binding")
+
+	// THEN
+	assert.Equal(t, role.ID, roleBinding.RoleID)
+	assert.Equal(t, graphql.RoleBindingSubjectTypeUser, roleBinding.SubjectType)
+	assert.Equal(t, user.ID, roleBinding.SubjectID)
+	require.NotNil(t, roleBinding.Tenant)
+	assert.Equal(t, tenantID, *roleBinding.Tenant)
+
+	roleBindingsReq := fixRoleBindingsRequest()
+	var roleBindings []*graphql.RoleBinding
+	err = tc.RunOperationWithoutTenant(ctx, roleBindingsReq, &roleBindings)
+	require.NoError(t, err)
+	assert.Contains(t, roleBindings, &roleBinding)
+	saveExample(t, roleBindingsReq.Query(), "query role bindings")
+
+	t.Log("Delete role binding")
+	deleteRoleBindingReq := fixDeleteRoleBindingRequest(roleBinding.ID)
+	deletedRoleBinding := graphql.RoleBinding{}
+	err = tc.RunOperationWithoutTenant(ctx, deleteRoleBindingReq, &deletedRoleBinding)
+	require.NoError(t, err)
+	assert.Equal(t, roleBinding, deletedRoleBinding)
+}
+
+func deleteUser(t *testing.T, ctx context.Context, id string) {
+	req := fixDeleteUserRequest(id)
+	err := tc.RunOperationWithoutTenant(ctx, req, nil)
+	require.NoError(t, err)
+}
+
+func deleteRole(t *testing.T, ctx context.Context, id string) {
+	req := fixDeleteRoleRequest(id)
+	err := tc.RunOperationWithoutTenant(ctx, req, nil)
+	require.NoError(t, err)
+}
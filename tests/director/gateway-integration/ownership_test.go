@@ -0,0 +1,86 @@
+package gateway_integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/tests/director/pkg/gql"
+	"github.com/kyma-incubator/compass/tests/director/pkg/idtokenprovider"
+	"github.com/kyma-incubator/compass/tests/director/pkg/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const forbiddenErrMsg = "Access to the object is forbidden"
+
+func TestObjectOwnership(t *testing.T) {
+	ctx := context.Background()
+
+	t.Log("Get Dex id_token")
+	dexToken, err := idtokenprovider.GetDexToken()
+	require.NoError(t, err)
+
+	dexGraphQLClient := gql.NewAuthorizedGraphQLClient(dexToken)
+
+	t.Log("Register Applications with Dex id token")
+	app := registerApplicationFromInputWithinTenant(t, ctx, dexGraphQLClient, testConfig.DefaultTenant, graphql.ApplicationRegisterInput{Name: "owned-app"})
+	defer unregisterApplication(t, ctx, dexGraphQLClient, testConfig.DefaultTenant, app.ID)
+
+	otherApp := registerApplicationFromInputWithinTenant(t, ctx, dexGraphQLClient, testConfig.DefaultTenant, graphql.ApplicationRegisterInput{Name: "other-app"})
+	defer unregisterApplication(t, ctx, dexGraphQLClient, testConfig.DefaultTenant, otherApp.ID)
+
+	t.Run("Application can modify only itself", func(t *testing.T) {
+		t.Log("Request Client Credentials for Application")
+		appAuth := requestClientCredentialsForApplication(t, ctx, dexGraphQLClient, testConfig.DefaultTenant, app.ID)
+		appOauthCredentialData, ok := appAuth.Auth.Credential.(*graphql.OAuthCredentialData)
+		require.True(t, ok)
+
+		t.Log("Issue a Hydra token with Client Credentials")
+		accessToken := getAccessToken(t, appOauthCredentialData, applicationScopes)
+		oauthGraphQLClient := gql.NewAuthorizedGraphQLClientWithCustomURL(accessToken, testConfig.DirectorURL)
+
+		t.Log("Update Application as the Application itself")
+		updatedApp, err := updateApplicationWithinTenant(t, ctx, oauthGraphQLClient, testConfig.DefaultTenant, app.ID, graphql.ApplicationUpdateInput{ProviderName: ptr.String("updated-by-itself")})
+		require.NoError(t, err)
+		assert.Equal(t, "updated-by-itself", *updatedApp.ProviderName)
+
+		t.Log("Update other Application as the Application")
+		_, err = updateApplicationWithinTenant(t, ctx, oauthGraphQLClient, testConfig.DefaultTenant, otherApp.ID, graphql.ApplicationUpdateInput{ProviderName: ptr.String("updated-by-other")})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), forbiddenErrMsg)
+	})
+
+	t.Run("Integration System can modify only managed Applications", func(t *testing.T) {
+		t.Log("Register Integration System with Dex id token")
+		intSys := registerIntegrationSystem(t, ctx, dexGraphQLClient, testConfig.DefaultTenant, "ownership-integration-system")
+		defer unregisterIntegrationSystem(t, ctx, dexGraphQLClient, testConfig.DefaultTenant, intSys.ID)
+
+		t.Log("Request Client Credentials for Integration System")
+		intSysOauthCredentialData := requestClientCredentialsForIntegrationSystem(t, ctx, dexGraphQLClient, testConfig.DefaultTenant, intSys.ID)
+
+		t.Log("Issue a Hydra token with Client Credentials")
+		accessToken := getAccessToken(t, intSysOauthCredentialData, integrationSystemScopes)
+		oauthGraphQLClient := gql.NewAuthorizedGraphQLClientWithCustomURL(accessToken, testConfig.DirectorURL)
+
+		t.Log("Register Application as Integration System")
+		managedApp := registerApplicationFromInputWithinTenant(t, ctx, oauthGraphQLClient, testConfig.DefaultTenant, graphql.ApplicationRegisterInput{
+			Name:                "managed-app",
+			IntegrationSystemID: &intSys.ID,
+		})
+		require.NotEmpty(t, managedApp.ID)
+		defer unregisterApplication(t, ctx, dexGraphQLClient, testConfig.DefaultTenant, managedApp.ID)
+
+		t.Log("Update managed Application as Integration System")
+		_, err := updateApplicationWithinTenant(t, ctx, oauthGraphQLClient, testConfig.DefaultTenant, managedApp.ID, graphql.ApplicationUpdateInput{
+			ProviderName:        ptr.String("updated-by-int-sys"),
+			IntegrationSystemID: &intSys.ID,
+		})
+		require.NoError(t, err)
+
+		t.Log("Update not managed Application as Integration System")
+		_, err = updateApplicationWithinTenant(t, ctx, oauthGraphQLClient, testConfig.DefaultTenant, otherApp.ID, graphql.ApplicationUpdateInput{ProviderName: ptr.String("updated-by-int-sys")})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), forbiddenErrMsg)
+	})
+}